@@ -340,16 +340,7 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 		crypto.XPrivateKeyHRP = "txsecret"
 	}
 
-	var defConf *config.Config
-	switch gen.ChainType() {
-	case genesis.Mainnet:
-		panic("not yet implemented!")
-	case genesis.Testnet:
-		defConf = config.DefaultConfigTestnet()
-	case genesis.Localnet:
-		defConf = config.DefaultConfigLocalnet()
-	}
-
+	defConf := defaultConfig(gen.ChainType())
 	confPath := PactusConfigPath(workingDir)
 	conf, err := config.LoadFromFile(confPath, true, defConf)
 	if err != nil {
@@ -394,6 +385,17 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 		conf, _ = config.LoadFromFile(confPath, true, defConf) // This time it should be OK
 	}
 
+	// Environment variables take precedence over the config file.
+	err = conf.ApplyEnv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = conf.Network.LoadBootstrapPeersFile()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = conf.BasicCheck()
 	if err != nil {
 		return nil, nil, err
@@ -430,26 +432,28 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 		valKeys[i] = bls.NewValidatorKey(prv.(*bls.PrivateKey))
 	}
 
-	// Create reward addresses
+	// Create reward addresses.
+	// Validators without an assigned reward address in the config use the wallet addresses.
 	rewardAddrs := make([]crypto.Address, 0, conf.Node.NumValidators)
-	if len(conf.Node.RewardAddresses) != 0 {
-		for _, addrStr := range conf.Node.RewardAddresses {
-			addr, _ := crypto.AddressFromString(addrStr)
-			rewardAddrs = append(rewardAddrs, addr)
-		}
-	} else {
-		for i := conf.Node.NumValidators; i < len(addrLabels); i++ {
-			addr, _ := crypto.AddressFromString(addrLabels[i].Address)
-			if addr.IsAccountAddress() {
-				rewardAddrs = append(rewardAddrs, addr)
-				if len(rewardAddrs) == conf.Node.NumValidators {
+	walletIndex := conf.Node.NumValidators
+	for i := 0; i < conf.Node.NumValidators; i++ {
+		addr, ok := conf.Node.RewardAddress(i)
+		if !ok {
+			for ; walletIndex < len(addrLabels); walletIndex++ {
+				walletAddr, _ := crypto.AddressFromString(addrLabels[walletIndex].Address)
+				if walletAddr.IsAccountAddress() {
+					addr = walletAddr
+					ok = true
+					walletIndex++
+
 					break
 				}
 			}
 		}
-	}
-	if len(rewardAddrs) != conf.Node.NumValidators {
-		return nil, nil, fmt.Errorf("not enough addresses in wallet")
+		if !ok {
+			return nil, nil, fmt.Errorf("not enough addresses in wallet")
+		}
+		rewardAddrs = append(rewardAddrs, addr)
 	}
 
 	nodeInstance, err := node.NewNode(gen, conf, valKeys, rewardAddrs)
@@ -465,6 +469,40 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 	return nodeInstance, walletInstance, nil
 }
 
+// LoadConfig loads the config of the node inside the working directory.
+// Fields that are not defined in the config file take the default values of the chain,
+// and the environment variables override the config file.
+func LoadConfig(workingDir string) (*config.Config, error) {
+	gen, err := genesis.LoadFromFile(PactusGenesisPath(workingDir))
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := config.LoadFromFile(PactusConfigPath(workingDir), false, defaultConfig(gen.ChainType()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conf.ApplyEnv(); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+func defaultConfig(chainType genesis.ChainType) *config.Config {
+	switch chainType {
+	case genesis.Mainnet:
+		panic("not yet implemented!")
+	case genesis.Testnet:
+		return config.DefaultConfigTestnet()
+	case genesis.Localnet:
+		return config.DefaultConfigLocalnet()
+	}
+
+	return nil
+}
+
 // makeLocalGenesis makes genesis file for the local network.
 func makeLocalGenesis(w wallet.Wallet) *genesis.Genesis {
 	// Treasury account
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/spf13/cobra"
+)
+
+// buildConfigCmd builds a sub-command to print the effective config of the node.
+func buildConfigCmd(parentCmd *cobra.Command) {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Print the effective config of the Pactus node in TOML format",
+	}
+	parentCmd.AddCommand(configCmd)
+
+	workingDirOpt := configCmd.Flags().StringP("working-dir", "w",
+		cmd.PactusHomeDir(), "A path to the working directory to read the node files")
+
+	configCmd.Run = func(c *cobra.Command, _ []string) {
+		workingDir, _ := filepath.Abs(*workingDirOpt)
+		conf, err := cmd.LoadConfig(workingDir)
+		cmd.FatalErrorCheck(err)
+
+		c.Print(string(conf.ToTOML()))
+	}
+}
@@ -18,6 +18,7 @@ func main() {
 	buildVersionCmd(rootCmd)
 	buildInitCmd(rootCmd)
 	buildStartCmd(rootCmd)
+	buildConfigCmd(rootCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
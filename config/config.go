@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/pactus-project/pactus/consensus"
@@ -38,20 +39,54 @@ type Config struct {
 	Nanomsg   *nanomsg.Config   `toml:"nanomsg"`
 }
 
+// Node modes define how the node participates in the network.
+const (
+	// NodeModeValidator runs the validators and takes part in the consensus.
+	NodeModeValidator = "validator"
+
+	// NodeModeFull follows the chain and relays the consensus messages,
+	// but it doesn't run the validators.
+	NodeModeFull = "full"
+
+	// NodeModeArchive only keeps and serves the blockchain data.
+	// It doesn't join the consensus topic and ignores the consensus messages.
+	NodeModeArchive = "archive"
+)
+
 type NodeConfig struct {
+	Mode            string   `toml:"mode"`
 	NumValidators   int      `toml:"num_validators"` // TODO: we can remove this now
 	RewardAddresses []string `toml:"reward_addresses"`
+
+	// RewardRotationInterval is the number of blocks that a rotating reward address stays active.
+	RewardRotationInterval uint32 `toml:"reward_rotation_interval"`
+
+	// RewardAddressMap maps the validator index to its reward address.
+	RewardAddressMap map[string]string `toml:"reward_address_map"`
+
+	// RewardAddressRotation maps the validator index to the reward addresses
+	// that are used in turn, each for `RewardRotationInterval` blocks.
+	RewardAddressRotation map[string][]string `toml:"reward_address_rotation"`
 }
 
 func DefaultNodeConfig() *NodeConfig {
 	// TODO: We should have default config per network: Testnet, Mainnet.
 	return &NodeConfig{
-		NumValidators: 7,
+		Mode:                  NodeModeValidator,
+		NumValidators:         7,
+		RewardAddressMap:      map[string]string{},
+		RewardAddressRotation: map[string][]string{},
 	}
 }
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *NodeConfig) BasicCheck() error {
+	switch conf.Mode {
+	case NodeModeValidator, NodeModeFull, NodeModeArchive:
+	default:
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid node mode: %s", conf.Mode)
+	}
+
 	if conf.NumValidators < 1 || conf.NumValidators > 32 {
 		return errors.Errorf(errors.ErrInvalidConfig, "number of validators must be between 1 and 32")
 	}
@@ -61,15 +96,120 @@ func (conf *NodeConfig) BasicCheck() error {
 		return errors.Errorf(errors.ErrInvalidConfig, "reward addresses should be %v", conf.NumValidators)
 	}
 
+	if len(conf.RewardAddresses) > 0 &&
+		len(conf.RewardAddressMap) > 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "reward addresses and reward address map can't be defined together")
+	}
+
 	for _, addrStr := range conf.RewardAddresses {
+		if err := checkRewardAddress(addrStr); err != nil {
+			return err
+		}
+	}
+
+	for indexStr, addrStr := range conf.RewardAddressMap {
+		index, err := strconv.Atoi(indexStr)
+		if err != nil || index < 0 || index >= conf.NumValidators {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid validator index in reward address map: %s", indexStr)
+		}
+
+		if err := checkRewardAddress(addrStr); err != nil {
+			return err
+		}
+	}
+
+	if len(conf.RewardAddressRotation) > 0 {
+		if len(conf.RewardAddresses) > 0 {
+			return errors.Errorf(errors.ErrInvalidConfig, "reward addresses and reward address rotation can't be defined together")
+		}
+		if conf.RewardRotationInterval == 0 {
+			return errors.Errorf(errors.ErrInvalidConfig, "reward rotation interval should be positive")
+		}
+	}
+
+	for indexStr, addrStrs := range conf.RewardAddressRotation {
+		index, err := strconv.Atoi(indexStr)
+		if err != nil || index < 0 || index >= conf.NumValidators {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid validator index in reward address rotation: %s", indexStr)
+		}
+
+		if _, ok := conf.RewardAddressMap[indexStr]; ok {
+			return errors.Errorf(errors.ErrInvalidConfig, "validator %s is defined in both reward address map and rotation", indexStr)
+		}
+
+		if len(addrStrs) == 0 {
+			return errors.Errorf(errors.ErrInvalidConfig, "no reward address is defined for validator %s", indexStr)
+		}
+
+		for _, addrStr := range addrStrs {
+			if err := checkRewardAddress(addrStr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RewardAddress returns the reward address that is assigned to the validator with the given index.
+// It returns false if no reward address is assigned to the validator.
+func (conf *NodeConfig) RewardAddress(index int) (crypto.Address, bool) {
+	var addrStr string
+	if len(conf.RewardAddresses) > 0 {
+		if index < 0 || index >= len(conf.RewardAddresses) {
+			return crypto.Address{}, false
+		}
+		addrStr = conf.RewardAddresses[index]
+	} else if str, ok := conf.RewardAddressMap[strconv.Itoa(index)]; ok {
+		addrStr = str
+	} else {
+		addrs, ok := conf.RotatingRewardAddresses(index)
+		if !ok {
+			return crypto.Address{}, false
+		}
+
+		return addrs[0], true
+	}
+
+	addr, err := crypto.AddressFromString(addrStr)
+	if err != nil {
+		return crypto.Address{}, false
+	}
+	return addr, true
+}
+
+// RunsConsensus returns true if the node takes part in the consensus.
+func (conf *NodeConfig) RunsConsensus() bool {
+	return conf.Mode == NodeModeValidator
+}
+
+// RotatingRewardAddresses returns the rotating reward addresses of the validator with the given index.
+// It returns false if no rotation is defined for the validator.
+func (conf *NodeConfig) RotatingRewardAddresses(index int) ([]crypto.Address, bool) {
+	addrStrs, ok := conf.RewardAddressRotation[strconv.Itoa(index)]
+	if !ok || len(addrStrs) == 0 {
+		return nil, false
+	}
+
+	addrs := make([]crypto.Address, 0, len(addrStrs))
+	for _, addrStr := range addrStrs {
 		addr, err := crypto.AddressFromString(addrStr)
 		if err != nil {
-			return errors.Errorf(errors.ErrInvalidConfig, "invalid reward address: %v", err.Error())
+			return nil, false
 		}
+		addrs = append(addrs, addr)
+	}
 
-		if !addr.IsAccountAddress() {
-			return errors.Errorf(errors.ErrInvalidConfig, "reward address is not an account address: %s", addrStr)
-		}
+	return addrs, true
+}
+
+func checkRewardAddress(addrStr string) error {
+	addr, err := crypto.AddressFromString(addrStr)
+	if err != nil {
+		return errors.Errorf(errors.ErrInvalidConfig, "invalid reward address: %v", err.Error())
+	}
+
+	if !addr.IsAccountAddress() {
+		return errors.Errorf(errors.ErrInvalidConfig, "reward address is not an account address: %s", addrStr)
 	}
 	return nil
 }
@@ -171,16 +311,18 @@ func SaveMainnetConfig(path string, numValidators int) error {
 func SaveTestnetConfig(path string, numValidators int) error {
 	conf := DefaultConfigTestnet()
 	conf.Node.NumValidators = numValidators
-	return util.WriteFile(path, conf.toTOML())
+	return util.WriteFile(path, conf.ToTOML())
 }
 
 func SaveLocalnetConfig(path string, numValidators int) error {
 	conf := DefaultConfigLocalnet()
 	conf.Node.NumValidators = numValidators
-	return util.WriteFile(path, conf.toTOML())
+	return util.WriteFile(path, conf.ToTOML())
 }
 
-func (conf *Config) toTOML() []byte {
+// ToTOML returns the TOML encoding of the config, preserving the field order.
+// It can be used to dump the effective config of a running node.
+func (conf *Config) ToTOML() []byte {
 	buf := new(bytes.Buffer)
 	encoder := toml.NewEncoder(buf)
 	encoder.Order(toml.OrderPreserve)
@@ -3,7 +3,9 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
@@ -78,7 +80,7 @@ func TestExampleConfig(t *testing.T) {
 	}
 
 	defaultConf := DefaultConfigMainnet()
-	defaultToml := string(defaultConf.toTOML())
+	defaultToml := string(defaultConf.ToTOML())
 
 	exampleToml = strings.ReplaceAll(exampleToml, "%num_validators%", "7")
 	exampleToml = strings.ReplaceAll(exampleToml, "##", "")
@@ -92,6 +94,26 @@ func TestExampleConfig(t *testing.T) {
 func TestNodeConfigBasicCheck(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
+	t.Run("invalid mode", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.Mode = "light"
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("valid modes", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		assert.True(t, conf.RunsConsensus())
+
+		conf.Mode = NodeModeFull
+		assert.NoError(t, conf.BasicCheck())
+		assert.False(t, conf.RunsConsensus())
+
+		conf.Mode = NodeModeArchive
+		assert.NoError(t, conf.BasicCheck())
+		assert.False(t, conf.RunsConsensus())
+	})
+
 	t.Run("invalid number of validators", func(t *testing.T) {
 		conf := DefaultNodeConfig()
 		conf.NumValidators = 0
@@ -140,6 +162,110 @@ func TestNodeConfigBasicCheck(t *testing.T) {
 		assert.NoError(t, conf.BasicCheck())
 	})
 
+	t.Run("both reward addresses and reward address map", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 1
+		conf.RewardAddresses = []string{
+			ts.RandAccAddress().String(),
+		}
+		conf.RewardAddressMap = map[string]string{
+			"0": ts.RandAccAddress().String(),
+		}
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("invalid validator index in reward address map", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+
+		conf.RewardAddressMap = map[string]string{"2": ts.RandAccAddress().String()}
+		assert.Error(t, conf.BasicCheck())
+
+		conf.RewardAddressMap = map[string]string{"-1": ts.RandAccAddress().String()}
+		assert.Error(t, conf.BasicCheck())
+
+		conf.RewardAddressMap = map[string]string{"foo": ts.RandAccAddress().String()}
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("validator address inside reward address map", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+		conf.RewardAddressMap = map[string]string{
+			"1": ts.RandValAddress().String(),
+		}
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("partial reward address map, Ok", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 3
+		conf.RewardAddressMap = map[string]string{
+			"1": ts.RandAccAddress().String(),
+		}
+
+		assert.NoError(t, conf.BasicCheck())
+	})
+
+	t.Run("reward address rotation without interval", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+		conf.RewardAddressRotation = map[string][]string{
+			"1": {ts.RandAccAddress().String(), ts.RandAccAddress().String()},
+		}
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("invalid reward address rotation", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+		conf.RewardRotationInterval = 100
+
+		conf.RewardAddressRotation = map[string][]string{"2": {ts.RandAccAddress().String()}}
+		assert.Error(t, conf.BasicCheck())
+
+		conf.RewardAddressRotation = map[string][]string{"1": {}}
+		assert.Error(t, conf.BasicCheck())
+
+		conf.RewardAddressRotation = map[string][]string{"1": {ts.RandValAddress().String()}}
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("validator in both reward address map and rotation", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+		conf.RewardRotationInterval = 100
+		conf.RewardAddressMap = map[string]string{"1": ts.RandAccAddress().String()}
+		conf.RewardAddressRotation = map[string][]string{"1": {ts.RandAccAddress().String()}}
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("both reward addresses and reward address rotation", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 1
+		conf.RewardRotationInterval = 100
+		conf.RewardAddresses = []string{ts.RandAccAddress().String()}
+		conf.RewardAddressRotation = map[string][]string{"0": {ts.RandAccAddress().String()}}
+
+		assert.Error(t, conf.BasicCheck())
+	})
+
+	t.Run("reward address rotation, Ok", func(t *testing.T) {
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+		conf.RewardRotationInterval = 100
+		conf.RewardAddressMap = map[string]string{"0": ts.RandAccAddress().String()}
+		conf.RewardAddressRotation = map[string][]string{
+			"1": {ts.RandAccAddress().String(), ts.RandAccAddress().String()},
+		}
+
+		assert.NoError(t, conf.BasicCheck())
+	})
+
 	t.Run("no reward addresses inside config, Ok", func(t *testing.T) {
 		conf := DefaultNodeConfig()
 		conf.NumValidators = 2
@@ -148,3 +274,121 @@ func TestNodeConfigBasicCheck(t *testing.T) {
 		assert.NoError(t, conf.BasicCheck())
 	})
 }
+
+func TestToTOML(t *testing.T) {
+	conf := DefaultConfigTestnet()
+	conf.Node.NumValidators = 3
+	conf.Node.RewardAddresses = []string{}
+	conf.Sync.Moniker = "moniker"
+
+	path := util.TempFilePath()
+	assert.NoError(t, util.WriteFile(path, conf.ToTOML()))
+
+	loaded, err := LoadFromFile(path, true, DefaultConfigTestnet())
+	assert.NoError(t, err)
+	assert.Equal(t, conf, loaded)
+}
+
+func TestNodeConfigRewardAddress(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("reward addresses", func(t *testing.T) {
+		addr1 := ts.RandAccAddress()
+		addr2 := ts.RandAccAddress()
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+		conf.RewardAddresses = []string{addr1.String(), addr2.String()}
+
+		addr, ok := conf.RewardAddress(1)
+		assert.True(t, ok)
+		assert.Equal(t, addr2, addr)
+
+		_, ok = conf.RewardAddress(2)
+		assert.False(t, ok)
+	})
+
+	t.Run("reward address map", func(t *testing.T) {
+		addr1 := ts.RandAccAddress()
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 3
+		conf.RewardAddressMap = map[string]string{"1": addr1.String()}
+
+		addr, ok := conf.RewardAddress(1)
+		assert.True(t, ok)
+		assert.Equal(t, addr1, addr)
+
+		_, ok = conf.RewardAddress(0)
+		assert.False(t, ok)
+	})
+
+	t.Run("reward address rotation", func(t *testing.T) {
+		addr1 := ts.RandAccAddress()
+		addr2 := ts.RandAccAddress()
+		conf := DefaultNodeConfig()
+		conf.NumValidators = 2
+		conf.RewardRotationInterval = 100
+		conf.RewardAddressRotation = map[string][]string{"1": {addr1.String(), addr2.String()}}
+
+		addrs, ok := conf.RotatingRewardAddresses(1)
+		assert.True(t, ok)
+		assert.Equal(t, []crypto.Address{addr1, addr2}, addrs)
+
+		addr, ok := conf.RewardAddress(1)
+		assert.True(t, ok)
+		assert.Equal(t, addr1, addr)
+
+		_, ok = conf.RotatingRewardAddresses(0)
+		assert.False(t, ok)
+	})
+}
+
+func TestApplyEnv(t *testing.T) {
+	t.Run("Environment variables override the config file", func(t *testing.T) {
+		path := util.TempFilePath()
+		assert.NoError(t, SaveTestnetConfig(path, 7))
+		conf, err := LoadFromFile(path, true, DefaultConfigTestnet())
+		assert.NoError(t, err)
+
+		t.Setenv("PACTUS_GRPC_LISTEN", "127.0.0.1:9090")
+		t.Setenv("PACTUS_GRPC_GATEWAY_ENABLE", "true")
+		t.Setenv("PACTUS_NETWORK_MAX_CONNECTIONS", "64")
+		t.Setenv("PACTUS_SYNC_TOPICS", "general, ")
+		t.Setenv("PACTUS_SYNC_UPDATE_INTERVAL", "30s")
+		t.Setenv("PACTUS_SYNC_FIREWALL_ENABLE", "true")
+		t.Setenv("PACTUS_TX_POOL_MAX_SIZE", "2000")
+
+		assert.NoError(t, conf.ApplyEnv())
+		assert.NoError(t, conf.BasicCheck())
+
+		assert.Equal(t, "127.0.0.1:9090", conf.GRPC.Listen)
+		assert.True(t, conf.GRPC.Gateway.Enable)
+		assert.Equal(t, 64, conf.Network.MaxConns)
+		assert.Equal(t, []string{"general"}, conf.Sync.Topics)
+		assert.Equal(t, 30*time.Second, conf.Sync.UpdateInterval)
+		assert.True(t, conf.Sync.Firewall.Enabled)
+		assert.Equal(t, 2000, conf.TxPool.MaxSize)
+	})
+
+	t.Run("Config is not changed without environment variables", func(t *testing.T) {
+		conf := DefaultConfigTestnet()
+
+		assert.NoError(t, conf.ApplyEnv())
+		assert.Equal(t, DefaultConfigTestnet(), conf)
+	})
+
+	t.Run("Invalid value", func(t *testing.T) {
+		conf := DefaultConfigTestnet()
+		t.Setenv("PACTUS_NETWORK_MAX_CONNECTIONS", "many")
+
+		err := conf.ApplyEnv()
+		assert.ErrorContains(t, err, "PACTUS_NETWORK_MAX_CONNECTIONS")
+	})
+
+	t.Run("Merged config is validated", func(t *testing.T) {
+		conf := DefaultConfigTestnet()
+		t.Setenv("PACTUS_SYNC_MAX_OPEN_SESSIONS", "0")
+
+		assert.NoError(t, conf.ApplyEnv())
+		assert.Error(t, conf.BasicCheck())
+	})
+}
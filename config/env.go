@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// EnvPrefix is the prefix of the environment variables that override the config.
+const EnvPrefix = "PACTUS"
+
+// ApplyEnv overrides the config fields with the environment variables.
+// The name of the environment variable is made of the prefix, the section and
+// the key in the config file, upper-cased and joined by underscores.
+// For example, `PACTUS_GRPC_LISTEN` overrides the `listen` key in the `[grpc]` section,
+// and `PACTUS_SYNC_FIREWALL_ENABLE` overrides `enable` in the `[sync.firewall]` section.
+// Lists are comma-separated, like `PACTUS_NETWORK_BOOTSTRAP_ADDRS="addr1,addr2"`.
+// Maps can't be set by the environment variables.
+//
+// It should be called after loading the config file,
+// so the environment variables take precedence over the config file.
+func (conf *Config) ApplyEnv() error {
+	return applyEnv(reflect.ValueOf(conf).Elem(), EnvPrefix)
+}
+
+func applyEnv(val reflect.Value, prefix string) error {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := val.Field(i)
+		tag := strings.Split(typ.Field(i).Tag.Get("toml"), ",")[0]
+		if tag == "" || tag == "-" || !field.CanSet() {
+			continue
+		}
+		name := prefix + "_" + strings.ToUpper(tag)
+
+		if field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.Struct {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+
+		if field.Kind() == reflect.Struct {
+			if err := applyEnv(field, name); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		str, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setFieldValue(field, str); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig,
+				"invalid value for %s: %s", name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func setFieldValue(field reflect.Value, str string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(str)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if field.Type() == reflect.TypeOf(time.Duration(0)) {
+			d, err := time.ParseDuration(str)
+			if err != nil {
+				return err
+			}
+			field.SetInt(int64(d))
+
+			return nil
+		}
+
+		n, err := strconv.ParseInt(str, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(str, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(str, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported list type: %s", field.Type())
+		}
+
+		items := []string{}
+		for _, item := range strings.Split(str, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+
+	default:
+		return fmt.Errorf("unsupported type: %s", field.Type())
+	}
+
+	return nil
+}
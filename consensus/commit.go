@@ -19,6 +19,7 @@ func (s *commitState) decide() {
 	precommits := s.log.PrecommitVoteSet(s.round)
 	votes := precommits.BlockVotes(certBlock.Hash())
 	cert := s.makeCertificate(votes)
+	s.finishRound(true)
 	err := s.bcState.CommitBlock(certBlock, cert)
 	if err != nil {
 		s.logger.Error("committing block failed", "block", certBlock, "error", err)
@@ -9,12 +9,14 @@ import (
 type Config struct {
 	ChangeProposerTimeout time.Duration `toml:"change_proposer_timeout"`
 	ChangeProposerDelta   time.Duration `toml:"change_proposer_delta"`
+	HistorySize           int           `toml:"history_size"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
 		ChangeProposerTimeout: 6 * time.Second,
 		ChangeProposerDelta:   2 * time.Second,
+		HistorySize:           16,
 	}
 }
 
@@ -26,6 +28,9 @@ func (conf *Config) BasicCheck() error {
 	if conf.ChangeProposerDelta <= 0 {
 		return errors.Errorf(errors.ErrInvalidConfig, "change proposer delta can't be negative")
 	}
+	if conf.HistorySize < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "history size can't be negative")
+	}
 
 	return nil
 }
@@ -35,7 +35,7 @@ type consensus struct {
 	round           int16
 	cpRound         int16
 	valKey          *bls.ValidatorKey
-	rewardAddr      crypto.Address
+	rewardPolicy    RewardPolicy
 	bcState         state.Facade // Blockchain state
 	changeProposer  *changeProposer
 	newHeightState  consState
@@ -50,13 +50,18 @@ type consensus struct {
 	broadcaster     broadcaster
 	mediator        mediator
 	active          bool
+	roundStartTime  time.Time
+	roundTimings    []RoundTiming
+	history         []*HeightActivity
+	proposerRounds  []int16 // proposerRounds are the rounds of the current height that we were the proposer.
+	missed          MissedStats
 }
 
 func NewConsensus(
 	conf *Config,
 	bcState state.Facade,
 	valKey *bls.ValidatorKey,
-	rewardAddr crypto.Address,
+	rewardPolicy RewardPolicy,
 	broadcastCh chan message.Message,
 	mediator mediator,
 ) Consensus {
@@ -64,14 +69,14 @@ func NewConsensus(
 		broadcastCh <- msg
 	}
 	return newConsensus(conf, bcState,
-		valKey, rewardAddr, broadcaster, mediator)
+		valKey, rewardPolicy, broadcaster, mediator)
 }
 
 func newConsensus(
 	conf *Config,
 	bcState state.Facade,
 	valKey *bls.ValidatorKey,
-	rewardAddr crypto.Address,
+	rewardPolicy RewardPolicy,
 	broadcaster broadcaster,
 	mediator mediator,
 ) *consensus {
@@ -85,7 +90,7 @@ func newConsensus(
 	// Update height later, See enterNewHeight.
 	cs.log = log.NewLog()
 	cs.logger = logger.NewSubLogger("_consensus", cs)
-	cs.rewardAddr = rewardAddr
+	cs.rewardPolicy = rewardPolicy
 
 	cs.changeProposer = &changeProposer{cs}
 	cs.newHeightState = &newHeightState{cs}
@@ -108,7 +113,8 @@ func newConsensus(
 
 	logger.Info("consensus instance created",
 		"validator address", valKey.Address().String(),
-		"reward address", rewardAddr.String())
+		"reward addresses", rewardPolicy.Addresses,
+		"rotation interval", rewardPolicy.RotationInterval)
 
 	return cs
 }
@@ -137,6 +143,14 @@ func (cs *consensus) ConsensusKey() *bls.PublicKey {
 	return cs.valKey.PublicKey()
 }
 
+// RewardPolicy returns the reward policy of the validator.
+func (cs *consensus) RewardPolicy() RewardPolicy {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	return cs.rewardPolicy
+}
+
 func (cs *consensus) HeightRound() (uint32, int16) {
 	cs.lk.RLock()
 	defer cs.lk.RUnlock()
@@ -158,6 +172,17 @@ func (cs *consensus) HasVote(h hash.Hash) bool {
 	return cs.log.HasVote(h)
 }
 
+// RoundTimings returns the timings of the recent finished rounds, from oldest to newest.
+func (cs *consensus) RoundTimings() []RoundTiming {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	timings := make([]RoundTiming, len(cs.roundTimings))
+	copy(timings, cs.roundTimings)
+
+	return timings
+}
+
 // AllVotes returns all valid votes inside the consensus log up to and including
 // the current consensus round.
 // Valid votes from subsequent rounds are not included.
@@ -165,6 +190,10 @@ func (cs *consensus) AllVotes() []*vote.Vote {
 	cs.lk.RLock()
 	defer cs.lk.RUnlock()
 
+	return cs.allVotes()
+}
+
+func (cs *consensus) allVotes() []*vote.Vote {
 	votes := []*vote.Vote{}
 	for r := int16(0); r <= cs.round; r++ {
 		m := cs.log.RoundMessages(r)
@@ -57,6 +57,7 @@ func testConfig() *Config {
 	return &Config{
 		ChangeProposerTimeout: 1 * time.Hour, // Disabling timers
 		ChangeProposerDelta:   1 * time.Hour, // Disabling timers
+		HistorySize:           4,
 	}
 }
 
@@ -121,13 +122,13 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 		})
 	}
 	td.consX = newConsensus(testConfig(), stX, valKeys[tIndexX],
-		valKeys[tIndexX].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
+		NewRewardPolicy(valKeys[tIndexX].PublicKey().AccountAddress()), broadcasterFunc, newConcreteMediator())
 	td.consY = newConsensus(testConfig(), stY, valKeys[tIndexY],
-		valKeys[tIndexY].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
+		NewRewardPolicy(valKeys[tIndexY].PublicKey().AccountAddress()), broadcasterFunc, newConcreteMediator())
 	td.consB = newConsensus(testConfig(), stB, valKeys[tIndexB],
-		valKeys[tIndexB].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
+		NewRewardPolicy(valKeys[tIndexB].PublicKey().AccountAddress()), broadcasterFunc, newConcreteMediator())
 	td.consP = newConsensus(testConfig(), stP, valKeys[tIndexP],
-		valKeys[tIndexP].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
+		NewRewardPolicy(valKeys[tIndexP].PublicKey().AccountAddress()), broadcasterFunc, newConcreteMediator())
 
 	// -------------------------------
 	// Better logging during testing
@@ -368,22 +369,22 @@ func (td *testData) makeProposal(t *testing.T, height uint32, round int16) *prop
 	var p *proposal.Proposal
 	switch (height % 4) + uint32(round%4) {
 	case 1:
-		blk, err := td.consX.bcState.ProposeBlock(td.consX.valKey, td.consX.rewardAddr)
+		blk, err := td.consX.bcState.ProposeBlock(td.consX.valKey, td.consX.rewardPolicy.Addresses[0])
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
 		td.HelperSignProposal(td.consX.valKey, p)
 	case 2:
-		blk, err := td.consY.bcState.ProposeBlock(td.consY.valKey, td.consY.rewardAddr)
+		blk, err := td.consY.bcState.ProposeBlock(td.consY.valKey, td.consY.rewardPolicy.Addresses[0])
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
 		td.HelperSignProposal(td.consY.valKey, p)
 	case 3:
-		blk, err := td.consB.bcState.ProposeBlock(td.consB.valKey, td.consB.rewardAddr)
+		blk, err := td.consB.bcState.ProposeBlock(td.consB.valKey, td.consB.rewardPolicy.Addresses[0])
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
 		td.HelperSignProposal(td.consB.valKey, p)
 	case 0, 4:
-		blk, err := td.consP.bcState.ProposeBlock(td.consP.valKey, td.consP.rewardAddr)
+		blk, err := td.consP.bcState.ProposeBlock(td.consP.valKey, td.consP.rewardPolicy.Addresses[0])
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
 		td.HelperSignProposal(td.consP.valKey, p)
@@ -407,7 +408,7 @@ func TestNotInCommittee(t *testing.T) {
 	str := store.MockingStore(td.TestSuite)
 
 	st, _ := state.LoadOrNewState(td.genDoc, []*bls.ValidatorKey{valKey}, str, td.txPool, nil)
-	Cons := NewConsensus(testConfig(), st, valKey, valKey.Address(), make(chan message.Message, 100),
+	Cons := NewConsensus(testConfig(), st, valKey, NewRewardPolicy(valKey.Address()), make(chan message.Message, 100),
 		newConcreteMediator())
 	cons := Cons.(*consensus)
 
@@ -453,6 +454,87 @@ func TestConsensusNormalCase(t *testing.T) {
 	td.shouldPublishVote(t, td.consX, vote.VoteTypePrecommit, p.Block().Hash())
 
 	td.shouldPublishBlockAnnounce(t, td.consX, p.Block().Hash())
+
+	timings := td.consX.RoundTimings()
+	assert.Equal(t, uint32(2), timings[len(timings)-1].Height)
+	assert.Equal(t, int16(0), timings[len(timings)-1].Round)
+	assert.True(t, timings[len(timings)-1].Committed)
+}
+
+func TestRoundTimingsLimit(t *testing.T) {
+	td := setup(t)
+
+	td.consX.lk.Lock()
+	for i := 0; i < maxRoundTimings+2; i++ {
+		td.consX.round = int16(i)
+		td.consX.startRound()
+		td.consX.finishRound(false)
+	}
+	td.consX.lk.Unlock()
+
+	timings := td.consX.RoundTimings()
+	assert.Len(t, timings, maxRoundTimings)
+	assert.Equal(t, int16(2), timings[0].Round)
+	assert.Equal(t, int16(maxRoundTimings+1), timings[maxRoundTimings-1].Round)
+}
+
+func TestHeightActivity(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+	td.enterNewHeight(td.consX)
+
+	p := td.makeProposal(t, 2, 0)
+	td.consX.SetProposal(p)
+	v := td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexY)
+
+	assert.Nil(t, td.consX.ActivityAt(2))
+
+	td.commitBlockForAllStates(t) // height 2
+	td.enterNewHeight(td.consX)
+
+	activity := td.consX.ActivityAt(2)
+	require.NotNil(t, activity)
+	assert.Equal(t, uint32(2), activity.Height)
+	assert.Equal(t, int16(0), activity.Round)
+	assert.Equal(t, p.Hash(), activity.Proposal.Hash())
+	assert.Contains(t, activity.Votes, v)
+	assert.Nil(t, td.consX.ActivityAt(3))
+}
+
+func TestHeightActivityLimit(t *testing.T) {
+	td := setup(t)
+
+	for i := 0; i < td.consX.config.HistorySize+2; i++ {
+		td.commitBlockForAllStates(t)
+		td.enterNewHeight(td.consX)
+	}
+
+	td.consX.lk.RLock()
+	assert.Len(t, td.consX.history, td.consX.config.HistorySize)
+	td.consX.lk.RUnlock()
+	assert.Nil(t, td.consX.ActivityAt(1))
+	assert.NotNil(t, td.consX.ActivityAt(uint32(td.consX.config.HistorySize+2)))
+}
+
+func TestMissedStats(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+	td.enterNewHeight(td.consX)
+	td.enterNewHeight(td.consB)
+
+	// consB is the proposer of the second round, but the block is committed in the first round.
+	td.enterNextRound(td.consB)
+	td.shouldPublishProposal(t, td.consB, 2, 1)
+
+	// consB is absent in the certificate.
+	td.commitBlockForAllStates(t) // height 2
+	td.enterNewHeight(td.consX)
+	td.enterNewHeight(td.consB)
+
+	assert.Equal(t, MissedStats{Proposals: 0, Votes: 0}, td.consX.MissedStats())
+	assert.Equal(t, MissedStats{Proposals: 1, Votes: 1}, td.consB.MissedStats())
 }
 
 func TestConsensusAddVote(t *testing.T) {
@@ -635,7 +717,7 @@ func TestDuplicateProposal(t *testing.T) {
 	h := uint32(4)
 	r := int16(0)
 	p1 := td.makeProposal(t, h, r)
-	trx := tx.NewTransferTx(h, td.consX.rewardAddr,
+	trx := tx.NewTransferTx(h, td.consX.rewardPolicy.Addresses[0],
 		td.RandAccAddress(), 1000, 1000, "proposal changer")
 	td.HelperSignTransaction(td.consX.valKey.PrivateKey(), trx)
 
@@ -654,7 +736,7 @@ func TestNonActiveValidator(t *testing.T) {
 
 	valKey := td.RandValKey()
 	Cons := NewConsensus(testConfig(), state.MockingState(td.TestSuite),
-		valKey, valKey.Address(), make(chan message.Message, 100), newConcreteMediator())
+		valKey, NewRewardPolicy(valKey.Address()), make(chan message.Message, 100), newConcreteMediator())
 	nonActiveCons := Cons.(*consensus)
 
 	t.Run("non-active instances should be in new-height state", func(t *testing.T) {
@@ -828,7 +910,7 @@ func TestByzantine(t *testing.T) {
 	// P votes
 	// Byzantine node create the second proposal and send it to the partitioned node P
 	byzTrx := tx.NewTransferTx(h,
-		td.consB.rewardAddr, td.RandAccAddress(), 1000, 1000, "")
+		td.consB.rewardPolicy.Addresses[0], td.RandAccAddress(), 1000, 1000, "")
 	td.HelperSignTransaction(td.consB.valKey.PrivateKey(), byzTrx)
 	assert.NoError(t, td.txPool.AppendTx(byzTrx))
 	p2 := td.makeProposal(t, h, r)
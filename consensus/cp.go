@@ -365,6 +365,7 @@ func (cp *changeProposer) checkForTermination(v *vote.Vote) {
 
 func (cp *changeProposer) cpDecide(cpValue vote.CPValue) {
 	if cpValue == vote.CPValueOne {
+		cp.finishRound(false)
 		cp.round++
 		cp.cpDecided = 1
 		cp.enterNewState(cp.proposeState)
@@ -54,6 +54,12 @@ func TestChangeProposerAgreement1(t *testing.T) {
 
 	td.shouldPublishVote(t, td.consP, vote.VoteTypeCPDecided, hash.UndefHash)
 	checkHeightRound(t, td.consP, h, r+1)
+
+	timings := td.consP.RoundTimings()
+	assert.Len(t, timings, 1)
+	assert.Equal(t, h, timings[0].Height)
+	assert.Equal(t, r, timings[0].Round)
+	assert.False(t, timings[0].Committed)
 }
 
 func TestChangeProposerAgreement0(t *testing.T) {
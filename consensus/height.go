@@ -17,6 +17,8 @@ func (s *newHeightState) enter() {
 func (s *newHeightState) decide() {
 	sateHeight := s.bcState.LastBlockHeight()
 	validators := s.bcState.CommitteeValidators()
+	s.recordMissed()
+	s.recordHistory()
 	s.log.MoveToNewHeight(validators)
 
 	s.validators = validators
@@ -0,0 +1,49 @@
+package consensus
+
+import (
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// HeightActivity holds the consensus activity that was seen at a past height.
+// It helps to diagnose the liveness incidents after the height is finished.
+type HeightActivity struct {
+	Height   uint32
+	Round    int16 // Round is the last round of the height.
+	Votes    []*vote.Vote
+	Proposal *proposal.Proposal // Proposal of the last round, can be nil.
+}
+
+// recordHistory keeps the activity of the current height before moving to the next height.
+func (cs *consensus) recordHistory() {
+	if cs.config.HistorySize == 0 || cs.height == 0 {
+		return
+	}
+
+	activity := &HeightActivity{
+		Height:   cs.height,
+		Round:    cs.round,
+		Votes:    cs.allVotes(),
+		Proposal: cs.log.RoundProposal(cs.round),
+	}
+
+	cs.history = append(cs.history, activity)
+	if len(cs.history) > cs.config.HistorySize {
+		cs.history = cs.history[len(cs.history)-cs.config.HistorySize:]
+	}
+}
+
+// ActivityAt returns the recorded consensus activity for the given height.
+// It returns nil if the height is not retained.
+func (cs *consensus) ActivityAt(height uint32) *HeightActivity {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	for _, activity := range cs.history {
+		if activity.Height == height {
+			return activity
+		}
+	}
+
+	return nil
+}
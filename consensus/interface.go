@@ -9,12 +9,16 @@ import (
 
 type Reader interface {
 	ConsensusKey() *bls.PublicKey
+	RewardPolicy() RewardPolicy
 	AllVotes() []*vote.Vote
 	PickRandomVote(round int16) *vote.Vote
 	Proposal() *proposal.Proposal
 	HasVote(h hash.Hash) bool
 	HeightRound() (uint32, int16)
 	IsActive() bool
+	RoundTimings() []RoundTiming
+	ActivityAt(height uint32) *HeightActivity
+	MissedStats() MissedStats
 }
 
 type Consensus interface {
@@ -1,7 +1,6 @@
 package consensus
 
 import (
-	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -23,13 +22,13 @@ type manager struct {
 }
 
 // NewManager creates a new manager instance that manages a set of consensus instances,
-// each associated with a validator key and a reward address.
+// each associated with a validator key and a reward policy.
 // It is not thread-safe.
 func NewManager(
 	conf *Config,
 	st state.Facade,
 	valKeys []*bls.ValidatorKey,
-	rewardAddrs []crypto.Address,
+	rewardPolicies []RewardPolicy,
 	broadcastCh chan message.Message,
 ) Manager {
 	mgr := &manager{
@@ -41,7 +40,7 @@ func NewManager(
 	mediatorConcrete := newConcreteMediator()
 
 	for i, key := range valKeys {
-		cons := NewConsensus(conf, st, key, rewardAddrs[i], broadcastCh, mediatorConcrete)
+		cons := NewConsensus(conf, st, key, rewardPolicies[i], broadcastCh, mediatorConcrete)
 
 		mgr.instances[i] = cons
 	}
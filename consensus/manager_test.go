@@ -3,7 +3,6 @@ package consensus
 import (
 	"testing"
 
-	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -21,7 +20,9 @@ func TestManager(t *testing.T) {
 	st := state.MockingState(ts)
 	st.TestCommittee.Validators()
 
-	rewardAddrs := []crypto.Address{ts.RandAccAddress(), ts.RandAccAddress()}
+	rewardPolicies := []RewardPolicy{
+		NewRewardPolicy(ts.RandAccAddress()), NewRewardPolicy(ts.RandAccAddress()),
+	}
 	valKeys := []*bls.ValidatorKey{st.TestValKeys[0], ts.RandValKey()}
 	broadcastCh := make(chan message.Message, 500)
 
@@ -29,7 +30,7 @@ func TestManager(t *testing.T) {
 	blk, cert := ts.GenerateTestBlock(stateHeight)
 	st.TestStore.SaveBlock(blk, cert)
 
-	Mgr := NewManager(testConfig(), st, valKeys, rewardAddrs, broadcastCh)
+	Mgr := NewManager(testConfig(), st, valKeys, rewardPolicies, broadcastCh)
 	mgr := Mgr.(*manager)
 
 	consA := mgr.instances[0].(*consensus) // active
@@ -162,9 +163,9 @@ func TestMediator(t *testing.T) {
 	st.TestCommittee = cmt
 	st.TestParams.BlockIntervalInSecond = 1
 
-	rewardAddrs := []crypto.Address{
-		ts.RandAccAddress(), ts.RandAccAddress(),
-		ts.RandAccAddress(), ts.RandAccAddress(),
+	rewardPolicies := []RewardPolicy{
+		NewRewardPolicy(ts.RandAccAddress()), NewRewardPolicy(ts.RandAccAddress()),
+		NewRewardPolicy(ts.RandAccAddress()), NewRewardPolicy(ts.RandAccAddress()),
 	}
 	broadcastCh := make(chan message.Message, 500)
 
@@ -172,7 +173,7 @@ func TestMediator(t *testing.T) {
 	blk, cert := ts.GenerateTestBlock(stateHeight)
 	st.TestStore.SaveBlock(blk, cert)
 
-	Mgr := NewManager(testConfig(), st, valKeys, rewardAddrs, broadcastCh)
+	Mgr := NewManager(testConfig(), st, valKeys, rewardPolicies, broadcastCh)
 	mgr := Mgr.(*manager)
 
 	mgr.MoveToNewHeight()
@@ -0,0 +1,77 @@
+package consensus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/exp/slices"
+)
+
+// MissedStats holds the number of proposals and votes that the validator missed,
+// while it was in the committee.
+// A validator that keeps missing them is probably failing silently.
+type MissedStats struct {
+	Proposals int64 // Proposals is the number of rounds that the validator was the proposer but didn't commit.
+	Votes     int64 // Votes is the number of committed blocks that the validator was absent in their certificate.
+}
+
+var (
+	missedProposals = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "missed_proposals_total",
+		Help:      "Number of rounds that the local validator was the proposer but the round didn't commit.",
+	}, []string{"validator"})
+
+	missedVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pactus",
+		Subsystem: "consensus",
+		Name:      "missed_votes_total",
+		Help:      "Number of committed blocks that the local validator was absent in their certificate.",
+	}, []string{"validator"})
+)
+
+// recordMissed checks the certificate of the current height, once it is committed,
+// and counts the proposals and votes that the validator missed.
+func (cs *consensus) recordMissed() {
+	proposerRounds := cs.proposerRounds
+	cs.proposerRounds = nil
+
+	if !cs.active || cs.height == 0 {
+		return
+	}
+
+	// The height might be committed by syncing, without seeing the certificate.
+	cert := cs.bcState.LastCertificate()
+	if cert == nil || cert.Height() != cs.height {
+		return
+	}
+
+	addr := cs.valKey.Address().String()
+	for _, round := range proposerRounds {
+		if round != cert.Round() {
+			cs.missed.Proposals++
+			missedProposals.WithLabelValues(addr).Inc()
+			cs.logger.Warn("missed proposing a block", "height", cs.height, "round", round)
+		}
+	}
+
+	for _, val := range cs.validators {
+		if val.Address() == cs.valKey.Address() {
+			if slices.Contains(cert.Absentees(), val.Number()) {
+				cs.missed.Votes++
+				missedVotes.WithLabelValues(addr).Inc()
+				cs.logger.Warn("missed voting for a block", "height", cs.height, "round", cert.Round())
+			}
+
+			break
+		}
+	}
+}
+
+// MissedStats returns the number of proposals and votes that the validator missed.
+func (cs *consensus) MissedStats() MissedStats {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	return cs.missed
+}
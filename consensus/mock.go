@@ -18,11 +18,15 @@ type MockConsensus struct {
 	ts *testsuite.TestSuite
 
 	ValKey      *bls.ValidatorKey
+	Reward      RewardPolicy
 	Votes       []*vote.Vote
 	CurProposal *proposal.Proposal
 	Active      bool
 	Height      uint32
 	Round       int16
+	Timings     []RoundTiming
+	Activities  []*HeightActivity
+	Missed      MissedStats
 }
 
 func MockingManager(ts *testsuite.TestSuite, valKeys []*bls.ValidatorKey) (Manager, []*MockConsensus) {
@@ -45,6 +49,7 @@ func MockingConsensus(ts *testsuite.TestSuite, valKey *bls.ValidatorKey) *MockCo
 	return &MockConsensus{
 		ts:     ts,
 		ValKey: valKey,
+		Reward: NewRewardPolicy(ts.RandAccAddress()),
 	}
 }
 
@@ -52,6 +57,10 @@ func (m *MockConsensus) ConsensusKey() *bls.PublicKey {
 	return m.ValKey.PublicKey()
 }
 
+func (m *MockConsensus) RewardPolicy() RewardPolicy {
+	return m.Reward
+}
+
 func (m *MockConsensus) MoveToNewHeight() {
 	m.lk.Lock()
 	defer m.lk.Unlock()
@@ -130,9 +139,36 @@ func (m *MockConsensus) IsActive() bool {
 	return m.Active
 }
 
+func (m *MockConsensus) RoundTimings() []RoundTiming {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	return m.Timings
+}
+
 func (m *MockConsensus) SetActive(active bool) {
 	m.lk.Lock()
 	defer m.lk.Unlock()
 
 	m.Active = active
 }
+
+func (m *MockConsensus) ActivityAt(height uint32) *HeightActivity {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	for _, activity := range m.Activities {
+		if activity.Height == height {
+			return activity
+		}
+	}
+
+	return nil
+}
+
+func (m *MockConsensus) MissedStats() MissedStats {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	return m.Missed
+}
@@ -39,7 +39,7 @@ func TestPrecommitDuplicatedProposal(t *testing.T) {
 	r := int16(0)
 
 	p1 := td.makeProposal(t, h, r)
-	trx := tx.NewTransferTx(h, td.consX.rewardAddr,
+	trx := tx.NewTransferTx(h, td.consX.rewardPolicy.Addresses[0],
 		td.RandAccAddress(), 1000, 1000, "invalid proposal")
 	td.HelperSignTransaction(td.consX.valKey.PrivateKey(), trx)
 
@@ -14,9 +14,12 @@ func (s *proposeState) enter() {
 }
 
 func (s *proposeState) decide() {
+	s.startRound()
+
 	proposer := s.proposer(s.round)
 	if proposer.Address() == s.valKey.Address() {
 		s.logger.Info("our turn to propose", "proposer", proposer.Address())
+		s.proposerRounds = append(s.proposerRounds, s.round)
 		s.createProposal(s.height, s.round)
 	} else {
 		s.logger.Debug("not our turn to propose", "proposer", proposer.Address())
@@ -29,7 +32,7 @@ func (s *proposeState) decide() {
 }
 
 func (s *proposeState) createProposal(height uint32, round int16) {
-	block, err := s.bcState.ProposeBlock(s.valKey, s.rewardAddr)
+	block, err := s.bcState.ProposeBlock(s.valKey, s.rewardPolicy.AddressAt(height))
 	if err != nil {
 		s.logger.Error("unable to propose a block!", "error", err)
 		return
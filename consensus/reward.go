@@ -0,0 +1,37 @@
+package consensus
+
+import "github.com/pactus-project/pactus/crypto"
+
+// RewardPolicy defines the reward addresses of a validator.
+// If more than one address is defined, the validator uses them in turn,
+// switching to the next address every `RotationInterval` blocks.
+type RewardPolicy struct {
+	Addresses        []crypto.Address
+	RotationInterval uint32
+}
+
+// NewRewardPolicy creates a reward policy with a single reward address.
+func NewRewardPolicy(addr crypto.Address) RewardPolicy {
+	return RewardPolicy{
+		Addresses: []crypto.Address{addr},
+	}
+}
+
+// NewRotatingRewardPolicy creates a reward policy that rotates the reward addresses
+// every `interval` blocks.
+func NewRotatingRewardPolicy(addrs []crypto.Address, interval uint32) RewardPolicy {
+	return RewardPolicy{
+		Addresses:        addrs,
+		RotationInterval: interval,
+	}
+}
+
+// AddressAt returns the reward address for the block at the given height.
+func (p RewardPolicy) AddressAt(height uint32) crypto.Address {
+	if len(p.Addresses) == 1 || p.RotationInterval == 0 {
+		return p.Addresses[0]
+	}
+	index := (height / p.RotationInterval) % uint32(len(p.Addresses))
+
+	return p.Addresses[index]
+}
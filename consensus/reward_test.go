@@ -0,0 +1,34 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewardPolicy(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr1 := ts.RandAccAddress()
+	addr2 := ts.RandAccAddress()
+	addr3 := ts.RandAccAddress()
+
+	t.Run("Single reward address", func(t *testing.T) {
+		policy := NewRewardPolicy(addr1)
+
+		assert.Equal(t, addr1, policy.AddressAt(1))
+		assert.Equal(t, addr1, policy.AddressAt(ts.RandHeight()))
+	})
+
+	t.Run("Rotating reward addresses", func(t *testing.T) {
+		policy := NewRotatingRewardPolicy([]crypto.Address{addr1, addr2, addr3}, 10)
+
+		assert.Equal(t, addr1, policy.AddressAt(1))
+		assert.Equal(t, addr1, policy.AddressAt(9))
+		assert.Equal(t, addr2, policy.AddressAt(10))
+		assert.Equal(t, addr3, policy.AddressAt(29))
+		assert.Equal(t, addr1, policy.AddressAt(30))
+	})
+}
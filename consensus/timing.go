@@ -0,0 +1,62 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// maxRoundTimings is the number of recent round timings that are kept.
+const maxRoundTimings = 16
+
+// RoundTiming holds the duration of a finished consensus round.
+type RoundTiming struct {
+	Height    uint32
+	Round     int16
+	Duration  time.Duration
+	Committed bool // Committed is false if the round ended by changing the proposer.
+}
+
+var roundDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "pactus",
+	Subsystem: "consensus",
+	Name:      "round_duration_seconds",
+	Help:      "Duration of the consensus rounds, partitioned by how the round ended.",
+	Buckets:   prometheus.ExponentialBuckets(0.5, 2, 10),
+}, []string{"result"})
+
+// startRound marks the beginning of the current round.
+func (cs *consensus) startRound() {
+	cs.roundStartTime = time.Now()
+}
+
+// finishRound records the duration of the current round.
+func (cs *consensus) finishRound(committed bool) {
+	if cs.roundStartTime.IsZero() {
+		return
+	}
+
+	timing := RoundTiming{
+		Height:    cs.height,
+		Round:     cs.round,
+		Duration:  time.Since(cs.roundStartTime),
+		Committed: committed,
+	}
+	cs.roundStartTime = time.Time{}
+
+	cs.roundTimings = append(cs.roundTimings, timing)
+	if len(cs.roundTimings) > maxRoundTimings {
+		cs.roundTimings = cs.roundTimings[1:]
+	}
+
+	result := "change_proposer"
+	if committed {
+		result = "commit"
+	}
+	roundDuration.WithLabelValues(result).Observe(timing.Duration.Seconds())
+
+	cs.logger.Debug("round finished",
+		"height", timing.Height, "round", timing.Round,
+		"duration", timing.Duration, "committed", committed)
+}
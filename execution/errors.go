@@ -34,21 +34,27 @@ func (e UnknownPayloadTypeError) Error() string {
 // PastLockTimeError is returned when the lock time of a transaction
 // is in the past and has expired,
 // indicating the transaction can no longer be executed.
+// MinLockTime is the earliest lock time that is accepted at the current height.
 type PastLockTimeError struct {
-	LockTime uint32
+	LockTime    uint32
+	MinLockTime uint32
 }
 
 func (e PastLockTimeError) Error() string {
-	return fmt.Sprintf("Transaction's lock time is in the past: %v", e.LockTime)
+	return fmt.Sprintf("Transaction's lock time is in the past: %v, minimum lock time: %v",
+		e.LockTime, e.MinLockTime)
 }
 
 // FutureLockTimeError is returned when the lock time of a transaction
 // is in the future,
 // indicating the transaction is not yet eligible for processing.
+// MaxLockTime is the latest lock time that is accepted at the current height.
 type FutureLockTimeError struct {
-	LockTime uint32
+	LockTime    uint32
+	MaxLockTime uint32
 }
 
 func (e FutureLockTimeError) Error() string {
-	return fmt.Sprintf("Transaction's lock time is in the future: %v", e.LockTime)
+	return fmt.Sprintf("Transaction's lock time is in the future: %v, maximum lock time: %v",
+		e.LockTime, e.MaxLockTime)
 }
@@ -83,7 +83,8 @@ func (exe *Execution) checkLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if sb.CurrentHeight() > interval {
 		if trx.LockTime() < sb.CurrentHeight()-interval {
 			return PastLockTimeError{
-				LockTime: trx.LockTime(),
+				LockTime:    trx.LockTime(),
+				MinLockTime: sb.CurrentHeight() - interval,
 			}
 		}
 	}
@@ -94,7 +95,8 @@ func (exe *Execution) checkLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
 		// processed once eligible.
 		if trx.LockTime() > sb.CurrentHeight() {
 			return FutureLockTimeError{
-				LockTime: trx.LockTime(),
+				LockTime:    trx.LockTime(),
+				MaxLockTime: sb.CurrentHeight(),
 			}
 		}
 	}
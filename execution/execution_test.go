@@ -33,7 +33,7 @@ func TestLockTime(t *testing.T) {
 		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "future-lockTime")
 		ts.HelperSignTransaction(rndPrvKey, trx)
 		err := exe.Execute(trx, sb)
-		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime})
+		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime, MaxLockTime: sb.CurrentHeight()})
 	})
 
 	t.Run("Past LockTime, Should returns error (-8641)", func(t *testing.T) {
@@ -41,7 +41,7 @@ func TestLockTime(t *testing.T) {
 		trx := tx.NewTransferTx(lockTime, rndAccAddr, ts.RandAccAddress(), 1000, 1000, "past-lockTime")
 		ts.HelperSignTransaction(rndPrvKey, trx)
 		err := exe.Execute(trx, sb)
-		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime})
+		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime, MinLockTime: lockTime + 1})
 	})
 
 	t.Run("Transaction has valid LockTime (-8640)", func(t *testing.T) {
@@ -65,7 +65,7 @@ func TestLockTime(t *testing.T) {
 		trx := tx.NewSubsidyTx(lockTime, ts.RandAccAddress(), 1000,
 			"invalid-lockTime")
 		err := exe.Execute(trx, sb)
-		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime})
+		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime, MaxLockTime: sb.CurrentHeight()})
 	})
 
 	t.Run("Subsidy transaction has invalid LockTime (-1)", func(t *testing.T) {
@@ -73,7 +73,7 @@ func TestLockTime(t *testing.T) {
 		trx := tx.NewSubsidyTx(lockTime, ts.RandAccAddress(), 1000,
 			"invalid-lockTime")
 		err := exe.Execute(trx, sb)
-		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime})
+		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime, MinLockTime: lockTime + 1})
 	})
 
 	t.Run("Subsidy transaction has valid LockTime (0)", func(t *testing.T) {
@@ -89,7 +89,7 @@ func TestLockTime(t *testing.T) {
 		trx := tx.NewSortitionTx(lockTime, rndValAddr, proof)
 		ts.HelperSignTransaction(rndPrvKey, trx)
 		err := exe.Execute(trx, sb)
-		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime})
+		assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime, MaxLockTime: sb.CurrentHeight()})
 	})
 
 	t.Run("Sortition transaction has invalid LockTime (-8)", func(t *testing.T) {
@@ -98,7 +98,7 @@ func TestLockTime(t *testing.T) {
 		trx := tx.NewSortitionTx(lockTime, rndValAddr, proof)
 		ts.HelperSignTransaction(rndPrvKey, trx)
 		err := exe.Execute(trx, sb)
-		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime})
+		assert.ErrorIs(t, err, PastLockTimeError{LockTime: lockTime, MinLockTime: lockTime + 1})
 	})
 
 	t.Run("Sortition transaction has valid LockTime (-7)", func(t *testing.T) {
@@ -212,7 +212,7 @@ func TestChecker(t *testing.T) {
 	ts.HelperSignTransaction(rndPrvKey, trx)
 
 	err := executor.Execute(trx, sb)
-	assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime})
+	assert.ErrorIs(t, err, FutureLockTimeError{LockTime: lockTime, MaxLockTime: sb.CurrentHeight()})
 	err = checker.Execute(trx, sb)
 	assert.NoError(t, err)
 }
@@ -1,25 +1,39 @@
 package network
 
 import (
+	"bufio"
+	"os"
+	"strings"
+	"time"
+
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/logger"
 )
 
 type Config struct {
-	NetworkKey           string   `toml:"network_key"`
-	PublicAddrString     string   `toml:"public_addr"`
-	ListenAddrStrings    []string `toml:"listen_addrs"`
-	RelayAddrStrings     []string `toml:"relay_addrs"`
-	BootstrapAddrStrings []string `toml:"bootstrap_addrs"`
-	MinConns             int      `toml:"min_connections"`
-	MaxConns             int      `toml:"max_connections"`
-	EnableNAT            bool     `toml:"enable_nat"`
-	EnableRelay          bool     `toml:"enable_relay"`
-	EnableMdns           bool     `toml:"enable_mdns"`
-	EnableMetrics        bool     `toml:"enable_metrics"`
-	ForcePrivateNetwork  bool     `toml:"force_private_network"`
-	Bootstrapper         bool     `toml:"bootstrapper"` // TODO: detect it automatically
+	NetworkKey           string        `toml:"network_key"`
+	PublicAddrString     string        `toml:"public_addr"`
+	ListenAddrStrings    []string      `toml:"listen_addrs"`
+	RelayAddrStrings     []string      `toml:"relay_addrs"`
+	BootstrapAddrStrings []string      `toml:"bootstrap_addrs"`
+	BootstrapPeersFile   string        `toml:"bootstrap_peers_file"`
+	DNSSeeds             []string      `toml:"dns_seeds"`
+	MinConns             int           `toml:"min_connections"`
+	MaxConns             int           `toml:"max_connections"`
+	RejectWhenFull       bool          `toml:"reject_when_full"`
+	EnableTCP            bool          `toml:"enable_tcp"`
+	EnableQUIC           bool          `toml:"enable_quic"`
+	EnableNAT            bool          `toml:"enable_nat"`
+	EnableRelay          bool          `toml:"enable_relay"`
+	EnableMdns           bool          `toml:"enable_mdns"`
+	EnableMetrics        bool          `toml:"enable_metrics"`
+	ForcePrivateNetwork  bool          `toml:"force_private_network"`
+	Bootstrapper         bool          `toml:"bootstrapper"` // TODO: detect it automatically
+	HeartbeatInterval    time.Duration `toml:"heartbeat_interval"`
+	MaxMissedHeartbeats  int           `toml:"max_missed_heartbeats"`
 
 	// Private configs
 	NetworkName string `toml:"-"`
@@ -33,14 +47,21 @@ func DefaultConfig() *Config {
 		ListenAddrStrings:    []string{},
 		RelayAddrStrings:     []string{},
 		BootstrapAddrStrings: []string{},
+		BootstrapPeersFile:   "",
+		DNSSeeds:             []string{},
 		MinConns:             16,
 		MaxConns:             32,
+		RejectWhenFull:       true,
+		EnableTCP:            true,
+		EnableQUIC:           true,
 		EnableNAT:            false,
 		EnableRelay:          false,
 		EnableMdns:           false,
 		EnableMetrics:        false,
 		ForcePrivateNetwork:  false,
 		Bootstrapper:         false,
+		HeartbeatInterval:    30 * time.Second,
+		MaxMissedHeartbeats:  3,
 		DefaultPort:          21888,
 	}
 }
@@ -62,6 +83,9 @@ func (conf *Config) BasicCheck() error {
 			return errors.Errorf(errors.ErrInvalidConfig, "at least one relay address should be defined")
 		}
 	}
+	if !conf.EnableTCP && !conf.EnableQUIC {
+		return errors.Errorf(errors.ErrInvalidConfig, "at least one of TCP or QUIC transports should be enabled")
+	}
 	if conf.PublicAddrString != "" {
 		if err := validateMultiAddr(conf.PublicAddrString); err != nil {
 			return err
@@ -73,9 +97,58 @@ func (conf *Config) BasicCheck() error {
 	if err := validateAddrInfo(conf.RelayAddrStrings...); err != nil {
 		return err
 	}
+	if conf.HeartbeatInterval < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "heartbeat interval can't be negative")
+	}
+	if conf.HeartbeatInterval > 0 && conf.MaxMissedHeartbeats <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max missed heartbeats should be positive")
+	}
+	for _, seed := range conf.DNSSeeds {
+		if seed == "" {
+			return errors.Errorf(errors.ErrInvalidConfig, "DNS seed can't be empty")
+		}
+	}
 	return validateAddrInfo(conf.BootstrapAddrStrings...)
 }
 
+// LoadBootstrapPeersFile appends the addresses of the bootstrap peers file to the bootstrap addresses.
+// Each line of the file holds one address. Empty lines and lines starting with `#` are ignored.
+// Malformed addresses are skipped with a warning.
+func (conf *Config) LoadBootstrapPeersFile() error {
+	if conf.BootstrapPeersFile == "" {
+		return nil
+	}
+
+	file, err := os.Open(util.MakeAbs(conf.BootstrapPeersFile))
+	if err != nil {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"unable to open bootstrap peers file: %s", err.Error())
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if err := validateAddrInfo(line); err != nil {
+			logger.Warn("skipping malformed bootstrap peer address",
+				"file", conf.BootstrapPeersFile, "line", lineNo, "addr", line, "error", err)
+
+			continue
+		}
+		conf.BootstrapAddrStrings = append(conf.BootstrapAddrStrings, line)
+	}
+
+	return scanner.Err()
+}
+
 func (conf *Config) PublicAddr() multiaddr.Multiaddr {
 	if conf.PublicAddrString != "" {
 		addr, _ := multiaddr.NewMultiaddr(conf.PublicAddrString)
@@ -84,9 +157,34 @@ func (conf *Config) PublicAddr() multiaddr.Multiaddr {
 	return nil
 }
 
+// ListenAddrs returns the listen addresses, excluding the addresses of the disabled transports.
 func (conf *Config) ListenAddrs() []multiaddr.Multiaddr {
 	addrs, _ := MakeMultiAddrs(conf.ListenAddrStrings)
-	return addrs
+
+	enabledAddrs := make([]multiaddr.Multiaddr, 0, len(addrs))
+	for _, addr := range addrs {
+		if conf.isTransportEnabled(addr) {
+			enabledAddrs = append(enabledAddrs, addr)
+		}
+	}
+
+	return enabledAddrs
+}
+
+// isTransportEnabled checks if the transport of the address is enabled.
+// The addresses of the other transports are not filtered.
+func (conf *Config) isTransportEnabled(addr multiaddr.Multiaddr) bool {
+	if _, err := addr.ValueForProtocol(multiaddr.P_QUIC_V1); err == nil {
+		return conf.EnableQUIC
+	}
+	if _, err := addr.ValueForProtocol(multiaddr.P_QUIC); err == nil {
+		return conf.EnableQUIC
+	}
+	if _, err := addr.ValueForProtocol(multiaddr.P_TCP); err == nil {
+		return conf.EnableTCP
+	}
+
+	return true
 }
 
 func (conf *Config) RelayAddrInfos() []lp2ppeer.AddrInfo {
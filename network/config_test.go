@@ -3,6 +3,7 @@ package network
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,6 +36,23 @@ func TestDefaultConfigCheck(t *testing.T) {
 	conf.BootstrapAddrStrings = []string{"/ip4/127.0.0.1/"}
 	assert.Error(t, conf.BasicCheck())
 
+	conf = DefaultConfig()
+	conf.DNSSeeds = []string{""}
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.EnableTCP = false
+	conf.EnableQUIC = false
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.HeartbeatInterval = -1
+	assert.Error(t, conf.BasicCheck())
+
+	conf = DefaultConfig()
+	conf.MaxMissedHeartbeats = 0
+	assert.Error(t, conf.BasicCheck())
+
 	conf = DefaultConfig()
 	conf.PublicAddrString = "/ip4/127.0.0.1/"
 	assert.NoError(t, conf.BasicCheck())
@@ -51,3 +69,70 @@ func TestDefaultConfigCheck(t *testing.T) {
 	conf.BootstrapAddrStrings = []string{"/ip4/127.0.0.1/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT"}
 	assert.NoError(t, conf.BasicCheck())
 }
+
+func TestListenAddrsTransports(t *testing.T) {
+	listenAddrs := []string{
+		"/ip4/0.0.0.0/tcp/21888", "/ip4/0.0.0.0/udp/21888/quic-v1",
+		"/ip6/::/tcp/21888", "/ip6/::/udp/21888/quic-v1",
+	}
+	toStrings := func(conf *Config) []string {
+		strs := []string{}
+		for _, addr := range conf.ListenAddrs() {
+			strs = append(strs, addr.String())
+		}
+
+		return strs
+	}
+
+	conf := DefaultConfig()
+	conf.ListenAddrStrings = listenAddrs
+	assert.NoError(t, conf.BasicCheck())
+	assert.Equal(t, listenAddrs, toStrings(conf))
+
+	conf.EnableQUIC = false
+	assert.NoError(t, conf.BasicCheck())
+	assert.Equal(t, []string{"/ip4/0.0.0.0/tcp/21888", "/ip6/::/tcp/21888"}, toStrings(conf))
+
+	conf.EnableQUIC = true
+	conf.EnableTCP = false
+	assert.NoError(t, conf.BasicCheck())
+	assert.Equal(t, []string{"/ip4/0.0.0.0/udp/21888/quic-v1", "/ip6/::/udp/21888/quic-v1"}, toStrings(conf))
+}
+
+func TestLoadBootstrapPeersFile(t *testing.T) {
+	t.Run("No file", func(t *testing.T) {
+		conf := DefaultConfig()
+		assert.NoError(t, conf.LoadBootstrapPeersFile())
+		assert.Empty(t, conf.BootstrapAddrStrings)
+	})
+
+	t.Run("File not exists", func(t *testing.T) {
+		conf := DefaultConfig()
+		conf.BootstrapPeersFile = util.TempFilePath()
+		assert.Error(t, conf.LoadBootstrapPeersFile())
+	})
+
+	t.Run("Skipping malformed addresses", func(t *testing.T) {
+		path := util.TempFilePath()
+		content := `
+# curated peers
+/ip4/127.0.0.1/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT
+/ip4/127.0.0.1/
+
+  /ip4/127.0.0.2/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT
+invalid
+`
+		assert.NoError(t, util.WriteFile(path, []byte(content)))
+
+		conf := DefaultConfig()
+		conf.BootstrapAddrStrings = []string{"/ip4/127.0.0.3/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT"}
+		conf.BootstrapPeersFile = path
+		assert.NoError(t, conf.LoadBootstrapPeersFile())
+		assert.Equal(t, []string{
+			"/ip4/127.0.0.3/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT",
+			"/ip4/127.0.0.1/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT",
+			"/ip4/127.0.0.2/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT",
+		}, conf.BootstrapAddrStrings)
+		assert.NoError(t, conf.BasicCheck())
+	})
+}
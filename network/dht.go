@@ -6,6 +6,7 @@ import (
 	lp2pdht "github.com/libp2p/go-libp2p-kad-dht"
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2phost "github.com/libp2p/go-libp2p/core/host"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
@@ -17,7 +18,7 @@ type dhtService struct {
 }
 
 func newDHTService(ctx context.Context, host lp2phost.Host, protocolID lp2pcore.ProtocolID,
-	conf *Config, log *logger.SubLogger,
+	conf *Config, bootstrapAddrs []lp2ppeer.AddrInfo, log *logger.SubLogger,
 ) *dhtService {
 	mode := lp2pdht.ModeAuto
 	if conf.Bootstrapper {
@@ -26,7 +27,7 @@ func newDHTService(ctx context.Context, host lp2phost.Host, protocolID lp2pcore.
 	opts := []lp2pdht.Option{
 		lp2pdht.Mode(mode),
 		lp2pdht.ProtocolPrefix(protocolID),
-		lp2pdht.BootstrapPeers(conf.BootstrapAddrInfos()...),
+		lp2pdht.BootstrapPeers(bootstrapAddrs...),
 	}
 
 	kademlia, err := lp2pdht.New(ctx, host, opts...)
@@ -0,0 +1,71 @@
+package network
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// dnsSeedTimeout is the maximum time to wait for resolving a DNS seed.
+const dnsSeedTimeout = 10 * time.Second
+
+// lookupTXT resolves the TXT records of a hostname. It can be replaced in tests.
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// resolveDNSSeeds resolves the TXT records of the DNS seeds to obtain the bootstrap addresses.
+// Each TXT record should contain a bootstrap multiaddress, like
+// "/dns/bootstrap.pactus.org/tcp/21888/p2p/12D3KooW...". Invalid records are ignored.
+// The seeds are resolved concurrently, so the startup is delayed by the slowest seed at most.
+// If no address can be resolved, it returns the static bootstrap addresses.
+func resolveDNSSeeds(conf *Config, log *logger.SubLogger) []string {
+	if len(conf.DNSSeeds) == 0 {
+		return conf.BootstrapAddrStrings
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsSeedTimeout)
+	defer cancel()
+
+	// The records of each seed are kept in its own slot, to keep the order of the seeds.
+	results := make([][]string, len(conf.DNSSeeds))
+	wg := sync.WaitGroup{}
+	for i, seed := range conf.DNSSeeds {
+		wg.Add(1)
+		go func(i int, seed string) {
+			defer wg.Done()
+
+			records, err := lookupTXT(ctx, seed)
+			if err != nil {
+				log.Warn("unable to resolve the DNS seed", "seed", seed, "error", err)
+
+				return
+			}
+			results[i] = records
+		}(i, seed)
+	}
+	wg.Wait()
+
+	addrs := []string{}
+	for i, records := range results {
+		for _, record := range records {
+			if err := validateAddrInfo(record); err != nil {
+				log.Debug("invalid bootstrap address in DNS seed", "seed", conf.DNSSeeds[i], "record", record)
+
+				continue
+			}
+			addrs = append(addrs, record)
+		}
+	}
+
+	if len(addrs) == 0 {
+		log.Warn("no bootstrap address resolved from DNS seeds, using the static list")
+
+		return conf.BootstrapAddrStrings
+	}
+
+	log.Info("bootstrap addresses resolved from DNS seeds", "count", len(addrs))
+
+	return addrs
+}
@@ -0,0 +1,115 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockLookupTXT(t *testing.T, records map[string][]string) {
+	t.Helper()
+
+	original := lookupTXT
+	lookupTXT = func(_ context.Context, host string) ([]string, error) {
+		txts, ok := records[host]
+		if !ok {
+			return nil, errors.New("no such host")
+		}
+
+		return txts, nil
+	}
+	t.Cleanup(func() { lookupTXT = original })
+}
+
+func TestResolveDNSSeeds(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	log := logger.NewSubLogger("_network", nil)
+
+	staticAddr := fmt.Sprintf("/ip4/1.1.1.1/tcp/21888/p2p/%s", ts.RandPeerID())
+	seedAddr1 := fmt.Sprintf("/ip4/2.2.2.2/tcp/21888/p2p/%s", ts.RandPeerID())
+	seedAddr2 := fmt.Sprintf("/dns/bootstrap.example.com/tcp/21888/p2p/%s", ts.RandPeerID())
+
+	mockLookupTXT(t, map[string][]string{
+		"seed1.example.com": {seedAddr1, "invalid-address"},
+		"seed2.example.com": {seedAddr2},
+		"empty.example.com": {},
+	})
+
+	t.Run("No DNS seeds, should use the static list", func(t *testing.T) {
+		conf := testConfig()
+		conf.BootstrapAddrStrings = []string{staticAddr}
+
+		assert.Equal(t, []string{staticAddr}, resolveDNSSeeds(conf, log))
+	})
+
+	t.Run("Should resolve the bootstrap addresses and ignore invalid records", func(t *testing.T) {
+		conf := testConfig()
+		conf.BootstrapAddrStrings = []string{staticAddr}
+		conf.DNSSeeds = []string{"seed1.example.com", "unknown.example.com", "seed2.example.com"}
+
+		assert.Equal(t, []string{seedAddr1, seedAddr2}, resolveDNSSeeds(conf, log))
+	})
+
+	t.Run("Resolution fails, should fall back to the static list", func(t *testing.T) {
+		conf := testConfig()
+		conf.BootstrapAddrStrings = []string{staticAddr}
+		conf.DNSSeeds = []string{"unknown.example.com", "empty.example.com"}
+
+		assert.Equal(t, []string{staticAddr}, resolveDNSSeeds(conf, log))
+	})
+}
+
+func TestResolveDNSSeedsConcurrently(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	log := logger.NewSubLogger("_network", nil)
+
+	seedAddr := fmt.Sprintf("/ip4/2.2.2.2/tcp/21888/p2p/%s", ts.RandPeerID())
+	delay := 200 * time.Millisecond
+
+	original := lookupTXT
+	lookupTXT = func(_ context.Context, host string) ([]string, error) {
+		time.Sleep(delay)
+		if host == "seed.example.com" {
+			return []string{seedAddr}, nil
+		}
+
+		return nil, errors.New("no such host")
+	}
+	t.Cleanup(func() { lookupTXT = original })
+
+	conf := testConfig()
+	conf.DNSSeeds = []string{"slow1.example.com", "slow2.example.com", "slow3.example.com", "seed.example.com"}
+
+	start := time.Now()
+	assert.Equal(t, []string{seedAddr}, resolveDNSSeeds(conf, log))
+	assert.Less(t, time.Since(start), 2*delay)
+}
+
+func TestBootstrapFromDNSSeeds(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	seedPeer := ts.RandPeerID()
+	seedAddr := fmt.Sprintf("/ip4/2.2.2.2/tcp/21888/p2p/%s", seedPeer)
+	mockLookupTXT(t, map[string][]string{
+		"seed.example.com": {seedAddr},
+	})
+
+	conf := testConfig()
+	conf.DNSSeeds = []string{"seed.example.com"}
+
+	net, err := newNetwork(conf, logger.NewSubLogger("_network", nil), nil)
+	require.NoError(t, err)
+
+	// The resolved addresses are not written back to the shared config.
+	assert.Empty(t, conf.BootstrapAddrStrings)
+	assert.True(t, net.connGater.trustyPeers[seedPeer])
+	assert.Equal(t, seedPeer, net.peerMgr.bootstrapAddrs[0].ID)
+	net.Stop()
+}
@@ -16,26 +16,60 @@ var _ lp2pconnmgr.ConnectionGater = &ConnectionGater{}
 type ConnectionGater struct {
 	lk sync.RWMutex
 
-	filters *multiaddr.Filters
-	peerMgr *peerMgr
-	maxConn int
-	logger  *logger.SubLogger
+	filters        *multiaddr.Filters
+	peerMgr        *peerMgr
+	maxConn        int
+	rejectWhenFull bool
+	trustyPeers    map[lp2ppeer.ID]bool
+	trustyIPs      map[string]bool
+	logger         *logger.SubLogger
 }
 
-func NewConnectionGater(conf *Config, log *logger.SubLogger) (*ConnectionGater, error) {
+// NewConnectionGater creates a new connection gater.
+// The bootstrap and relay peers are trusty and they are always accepted.
+func NewConnectionGater(conf *Config, bootstrapAddrs []lp2ppeer.AddrInfo,
+	log *logger.SubLogger,
+) (*ConnectionGater, error) {
 	filters := multiaddr.NewFilters()
 	if !conf.ForcePrivateNetwork {
 		privateSubnets := PrivateSubnets()
 		filters = SubnetsToFilters(privateSubnets, multiaddr.ActionDeny)
 	}
 
+	trustyPeers := make(map[lp2ppeer.ID]bool)
+	trustyIPs := make(map[string]bool)
+	trustyAddrs := append(conf.RelayAddrInfos(), bootstrapAddrs...)
+	for _, ai := range trustyAddrs {
+		trustyPeers[ai.ID] = true
+		for _, ma := range ai.Addrs {
+			if ip := ipOf(ma); ip != "" {
+				trustyIPs[ip] = true
+			}
+		}
+	}
+
 	return &ConnectionGater{
-		filters: filters,
-		maxConn: conf.MaxConns,
-		logger:  log,
+		filters:        filters,
+		maxConn:        conf.MaxConns,
+		rejectWhenFull: conf.RejectWhenFull,
+		trustyPeers:    trustyPeers,
+		trustyIPs:      trustyIPs,
+		logger:         log,
 	}, nil
 }
 
+// ipOf returns the IP address of the multiaddress, or an empty string if it has none.
+func ipOf(ma multiaddr.Multiaddr) string {
+	if ip, err := ma.ValueForProtocol(multiaddr.P_IP4); err == nil {
+		return ip
+	}
+	if ip, err := ma.ValueForProtocol(multiaddr.P_IP6); err == nil {
+		return ip
+	}
+
+	return ""
+}
+
 func (g *ConnectionGater) SetPeerManager(peerMgr *peerMgr) {
 	g.lk.Lock()
 	defer g.lk.Unlock()
@@ -51,6 +85,24 @@ func (g *ConnectionGater) hasMaxConnections() bool {
 	return g.peerMgr.NumOfConnected() > g.maxConn
 }
 
+// shouldRejectInbound checks if a new inbound connection should be refused
+// because the node has already reached the maximum number of connections.
+// If rejecting is disabled, the connection is accepted and trimmed later by the connection manager,
+// but only up to a hard ceiling, so the peers can't exhaust the resources before the trimming.
+func (g *ConnectionGater) shouldRejectInbound() bool {
+	if g.peerMgr == nil {
+		return false
+	}
+
+	limit := g.maxConn
+	if !g.rejectWhenFull {
+		// The connection manager trims the connections above the maximum connections (high watermark).
+		limit += max(g.maxConn/4, 1)
+	}
+
+	return g.peerMgr.NumOfConnected() >= limit
+}
+
 func (g *ConnectionGater) InterceptPeerDial(pid lp2ppeer.ID) bool {
 	g.lk.RLock()
 	defer g.lk.RUnlock()
@@ -85,7 +137,7 @@ func (g *ConnectionGater) InterceptAccept(cma lp2pnetwork.ConnMultiaddrs) bool {
 	g.lk.RLock()
 	defer g.lk.RUnlock()
 
-	if g.hasMaxConnections() {
+	if g.shouldRejectInbound() && !g.trustyIPs[ipOf(cma.RemoteMultiaddr())] {
 		g.logger.Debug("InterceptAccept rejected: many connections")
 		return false
 	}
@@ -99,7 +151,17 @@ func (g *ConnectionGater) InterceptAccept(cma lp2pnetwork.ConnMultiaddrs) bool {
 	return true
 }
 
-func (g *ConnectionGater) InterceptSecured(_ lp2pnetwork.Direction, _ lp2ppeer.ID, _ lp2pnetwork.ConnMultiaddrs) bool {
+func (g *ConnectionGater) InterceptSecured(dir lp2pnetwork.Direction, pid lp2ppeer.ID,
+	_ lp2pnetwork.ConnMultiaddrs,
+) bool {
+	g.lk.RLock()
+	defer g.lk.RUnlock()
+
+	if dir == lp2pnetwork.DirInbound && g.shouldRejectInbound() && !g.trustyPeers[pid] {
+		g.logger.Debug("InterceptSecured rejected: many connections", "pid", pid)
+		return false
+	}
+
 	return true
 }
 
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"fmt"
 	"testing"
 
 	lp2pnet "github.com/libp2p/go-libp2p/core/network"
@@ -81,3 +82,57 @@ func TestMaxConnection(t *testing.T) {
 	assert.False(t, net.connGater.InterceptAccept(cmaPrivate))
 	assert.False(t, net.connGater.InterceptAccept(cmaPublic))
 }
+
+func TestRejectInboundWhenFull(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	trustyPID := ts.RandPeerID()
+	conf := testConfig()
+	conf.MaxConns = 1
+	conf.BootstrapAddrStrings = []string{
+		fmt.Sprintf("/ip4/4.4.4.4/tcp/21888/p2p/%s", trustyPID),
+	}
+	net := makeTestNetwork(t, conf, nil)
+
+	cmaPublic := &mockConnMultiaddrs{remote: multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")}
+	cmaTrusty := &mockConnMultiaddrs{remote: multiaddr.StringCast("/ip4/4.4.4.4/tcp/5678")}
+	pid := ts.RandPeerID()
+
+	assert.True(t, net.connGater.InterceptAccept(cmaPublic))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnet.DirInbound, pid, cmaPublic))
+
+	net.peerMgr.AddPeer(ts.RandPeerID(),
+		multiaddr.StringCast("/ip4/2.2.2.2/tcp/1234"), lp2pnet.DirInbound, nil)
+
+	assert.False(t, net.connGater.InterceptAccept(cmaPublic))
+	assert.False(t, net.connGater.InterceptSecured(lp2pnet.DirInbound, pid, cmaPublic))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnet.DirOutbound, pid, cmaPublic))
+
+	assert.True(t, net.connGater.InterceptAccept(cmaTrusty))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnet.DirInbound, trustyPID, cmaTrusty))
+}
+
+func TestTrimWhenFull(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+	conf.MaxConns = 1
+	conf.RejectWhenFull = false
+	net := makeTestNetwork(t, conf, nil)
+
+	cmaPublic := &mockConnMultiaddrs{remote: multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")}
+	pid := ts.RandPeerID()
+
+	net.peerMgr.AddPeer(ts.RandPeerID(),
+		multiaddr.StringCast("/ip4/2.2.2.2/tcp/1234"), lp2pnet.DirInbound, nil)
+
+	// Inbound connections are accepted and trimmed later by the connection manager.
+	assert.True(t, net.connGater.InterceptAccept(cmaPublic))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnet.DirInbound, pid, cmaPublic))
+
+	net.peerMgr.AddPeer(ts.RandPeerID(),
+		multiaddr.StringCast("/ip4/3.3.3.3/tcp/1234"), lp2pnet.DirInbound, nil)
+
+	// Beyond the hard ceiling, inbound connections are rejected.
+	assert.False(t, net.connGater.InterceptAccept(cmaPublic))
+	assert.False(t, net.connGater.InterceptSecured(lp2pnet.DirInbound, pid, cmaPublic))
+	assert.False(t, net.connGater.InterceptPeerDial(pid))
+}
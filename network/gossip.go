@@ -84,6 +84,27 @@ func (g *gossipService) JoinTopic(name string) (*lp2pps.Topic, error) {
 	return topic, nil
 }
 
+// LeaveTopic cancels the subscription to the given topic and closes it.
+func (g *gossipService) LeaveTopic(topic *lp2pps.Topic) error {
+	for i, t := range g.topics {
+		if t != topic {
+			continue
+		}
+
+		g.subs[i].Cancel()
+		if err := t.Close(); err != nil {
+			return LibP2PError{Err: err}
+		}
+
+		g.topics = append(g.topics[:i], g.topics[i+1:]...)
+		g.subs = append(g.subs[:i], g.subs[i+1:]...)
+
+		return nil
+	}
+
+	return nil
+}
+
 // Start starts the gossip service.
 func (g *gossipService) Start() {
 }
@@ -20,6 +20,25 @@ func TestJoinConsensusTopic(t *testing.T) {
 	require.NoError(t, net.Broadcast(msg, TopicIDConsensus))
 }
 
+func TestLeaveConsensusTopic(t *testing.T) {
+	net := makeTestNetwork(t, testConfig(), nil)
+
+	msg := []byte("test-consensus-topic")
+
+	require.NoError(t, net.LeaveConsensusTopic())
+	require.NoError(t, net.JoinConsensusTopic())
+	require.NoError(t, net.Broadcast(msg, TopicIDConsensus))
+	require.NoError(t, net.LeaveConsensusTopic())
+	require.ErrorIs(t, net.Broadcast(msg, TopicIDConsensus),
+		NotSubscribedError{
+			TopicID: TopicIDConsensus,
+		})
+
+	// Joining again after leaving
+	require.NoError(t, net.JoinConsensusTopic())
+	require.NoError(t, net.Broadcast(msg, TopicIDConsensus))
+}
+
 func TestInvalidTopic(t *testing.T) {
 	net, err := NewNetwork(testConfig())
 	assert.NoError(t, err)
@@ -0,0 +1,139 @@
+package network
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lp2phost "github.com/libp2p/go-libp2p/core/host"
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	lp2pping "github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// heartbeatService pings the connected peers periodically to detect half-open connections.
+// Peers behind NAT may vanish without closing the connection.
+// If a peer misses several consecutive heartbeats, its connection is closed
+// and the disconnect event is emitted.
+type heartbeatService struct {
+	lk sync.Mutex
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	host      lp2phost.Host
+	interval  time.Duration
+	maxMisses int
+	missed    map[lp2ppeer.ID]int
+	ping      func(ctx context.Context, pid lp2ppeer.ID) error
+	logger    *logger.SubLogger
+}
+
+func newHeartbeatService(ctx context.Context, host lp2phost.Host, conf *Config,
+	log *logger.SubLogger,
+) *heartbeatService {
+	ctx, cancel := context.WithCancel(ctx)
+	hb := &heartbeatService{
+		ctx:       ctx,
+		cancel:    cancel,
+		host:      host,
+		interval:  conf.HeartbeatInterval,
+		maxMisses: conf.MaxMissedHeartbeats,
+		missed:    make(map[lp2ppeer.ID]int),
+		logger:    log,
+	}
+	hb.ping = hb.pingPeer
+
+	return hb
+}
+
+func (hb *heartbeatService) Start() {
+	if hb.interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(hb.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-hb.ctx.Done():
+				return
+			case <-ticker.C:
+				hb.beat()
+			}
+		}
+	}()
+}
+
+// Stop stops the heartbeats and cancels the ongoing pings.
+func (hb *heartbeatService) Stop() {
+	hb.cancel()
+}
+
+// beat pings all the connected peers in parallel.
+func (hb *heartbeatService) beat() {
+	wg := sync.WaitGroup{}
+	for _, pid := range hb.host.Network().Peers() {
+		wg.Add(1)
+		go func(pid lp2ppeer.ID) {
+			defer wg.Done()
+
+			hb.checkPeer(pid)
+		}(pid)
+	}
+	wg.Wait()
+
+	hb.removeDisconnectedPeers()
+}
+
+// checkPeer pings the peer and closes the connection if it misses too many heartbeats.
+func (hb *heartbeatService) checkPeer(pid lp2ppeer.ID) {
+	ctx, cancel := context.WithTimeout(hb.ctx, hb.interval)
+	defer cancel()
+
+	err := hb.ping(ctx, pid)
+
+	hb.lk.Lock()
+	defer hb.lk.Unlock()
+
+	if err == nil {
+		delete(hb.missed, pid)
+
+		return
+	}
+
+	hb.missed[pid]++
+	hb.logger.Debug("peer missed a heartbeat", "pid", pid, "missed", hb.missed[pid], "error", err)
+
+	if hb.missed[pid] >= hb.maxMisses {
+		hb.logger.Info("closing connection to unresponsive peer", "pid", pid, "missed", hb.missed[pid])
+
+		delete(hb.missed, pid)
+		if err := hb.host.Network().ClosePeer(pid); err != nil {
+			hb.logger.Warn("unable to close connection", "pid", pid, "error", err)
+		}
+	}
+}
+
+// removeDisconnectedPeers forgets the missed heartbeats of the peers that are not connected anymore.
+func (hb *heartbeatService) removeDisconnectedPeers() {
+	hb.lk.Lock()
+	defer hb.lk.Unlock()
+
+	for pid := range hb.missed {
+		if hb.host.Network().Connectedness(pid) != lp2pnet.Connected {
+			delete(hb.missed, pid)
+		}
+	}
+}
+
+func (hb *heartbeatService) pingPeer(ctx context.Context, pid lp2ppeer.ID) error {
+	select {
+	case res := <-lp2pping.Ping(ctx, hb.host, pid):
+		return res.Error
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,84 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeartbeat(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+	conf.HeartbeatInterval = time.Second
+	conf.MaxMissedHeartbeats = 3
+	conf.EnableQUIC = false
+	conf.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0"}
+	net := makeTestNetwork(t, conf, nil)
+	hb := net.heartbeat
+
+	responsive := true
+	hb.ping = func(_ context.Context, _ lp2ppeer.ID) error {
+		if responsive {
+			return nil
+		}
+
+		return errors.New("timeout")
+	}
+	pid := ts.RandPeerID()
+
+	t.Run("Peer misses heartbeats", func(t *testing.T) {
+		responsive = false
+		hb.checkPeer(pid)
+		hb.checkPeer(pid)
+
+		assert.Equal(t, 2, hb.missed[pid])
+	})
+
+	t.Run("Peer responds, should reset the missed heartbeats", func(t *testing.T) {
+		responsive = true
+		hb.checkPeer(pid)
+
+		assert.NotContains(t, hb.missed, pid)
+	})
+
+	t.Run("Peer misses too many heartbeats, should close the connection", func(t *testing.T) {
+		confPeer := testConfig()
+		confPeer.EnableQUIC = false
+		confPeer.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0"}
+		netPeer := makeTestNetwork(t, confPeer, nil)
+		defer netPeer.Stop()
+
+		peerInfo := lp2ppeer.AddrInfo{ID: netPeer.SelfID(), Addrs: netPeer.host.Addrs()}
+		require.NoError(t, net.host.Connect(context.Background(), peerInfo))
+		require.Equal(t, lp2pnet.Connected, net.host.Network().Connectedness(netPeer.SelfID()))
+
+		responsive = false
+		for i := 0; i < conf.MaxMissedHeartbeats; i++ {
+			hb.checkPeer(netPeer.SelfID())
+		}
+
+		assert.NotContains(t, hb.missed, netPeer.SelfID())
+		assert.NotEqual(t, lp2pnet.Connected, net.host.Network().Connectedness(netPeer.SelfID()))
+	})
+
+	t.Run("Disconnected peers are forgotten", func(t *testing.T) {
+		responsive = false
+		hb.checkPeer(pid)
+		hb.removeDisconnectedPeers()
+
+		assert.NotContains(t, hb.missed, pid)
+	})
+
+	t.Run("Stopping cancels the heartbeats", func(t *testing.T) {
+		hb.Stop()
+
+		assert.Error(t, hb.ctx.Err())
+	})
+}
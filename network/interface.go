@@ -4,6 +4,8 @@ import (
 	"io"
 
 	lp2pcore "github.com/libp2p/go-libp2p/core"
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 )
 
 type TopicID int
@@ -78,7 +80,9 @@ func (*StreamMessage) Type() EventType {
 type ConnectEvent struct {
 	PeerID        lp2pcore.PeerID
 	RemoteAddress string
+	Direction     lp2pnetwork.Direction
 	SupportStream bool
+	Relayed       bool
 }
 
 func (*ConnectEvent) Type() EventType {
@@ -100,9 +104,14 @@ type Network interface {
 	EventChannel() <-chan Event
 	Broadcast([]byte, TopicID) error
 	SendTo([]byte, lp2pcore.PeerID) error
+	OpenStream(lp2pcore.PeerID) (io.WriteCloser, error)
 	JoinGeneralTopic() error
 	JoinConsensusTopic() error
+	LeaveConsensusTopic() error
 	CloseConnection(pid lp2pcore.PeerID)
+	ProtectPeer(pid lp2pcore.PeerID)
+	ConnectPeer(addrInfo lp2ppeer.AddrInfo)
+	ConnectBootstrapPeers()
 	SelfID() lp2pcore.PeerID
 	NumConnectedPeers() int
 }
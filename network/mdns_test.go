@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,6 +27,7 @@ func TestMDNS(t *testing.T) {
 
 	ce := shouldReceiveEvent(t, net2, EventTypeConnect).(*ConnectEvent)
 	assert.Equal(t, ce.PeerID, net1.SelfID())
+	assert.NotEqual(t, ce.Direction, lp2pnetwork.DirUnknown)
 
 	msg := []byte("test-mdns")
 	assert.NoError(t, net1.SendTo(msg, net2.SelfID()))
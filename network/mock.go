@@ -7,6 +7,7 @@ import (
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/util/testsuite"
+	"golang.org/x/exp/slices"
 )
 
 var _ Network = &MockNetwork{}
@@ -24,6 +25,11 @@ type MockNetwork struct {
 	ID        lp2ppeer.ID
 	OtherNets []*MockNetwork
 	SendError error
+
+	ConsensusTopicJoined bool
+	ProtectedPeers       []lp2ppeer.ID
+	ConnectedAddrs       []lp2ppeer.AddrInfo
+	BootstrapConnects    int
 }
 
 func MockingNetwork(ts *testsuite.TestSuite, id lp2ppeer.ID) *MockNetwork {
@@ -52,6 +58,12 @@ func (mock *MockNetwork) JoinGeneralTopic() error {
 }
 
 func (mock *MockNetwork) JoinConsensusTopic() error {
+	mock.ConsensusTopicJoined = true
+	return nil
+}
+
+func (mock *MockNetwork) LeaveConsensusTopic() error {
+	mock.ConsensusTopicJoined = false
 	return nil
 }
 
@@ -70,6 +82,36 @@ func (mock *MockNetwork) SendTo(data []byte, pid lp2pcore.PeerID) error {
 	return nil
 }
 
+func (mock *MockNetwork) OpenStream(pid lp2pcore.PeerID) (io.WriteCloser, error) {
+	if mock.SendError != nil {
+		return nil, mock.SendError
+	}
+
+	return &mockStreamWriter{mock: mock, pid: pid}, nil
+}
+
+// mockStreamWriter publishes each write as a separate message.
+type mockStreamWriter struct {
+	mock *MockNetwork
+	pid  lp2pcore.PeerID
+}
+
+func (w *mockStreamWriter) Write(data []byte) (int, error) {
+	if w.mock.SendError != nil {
+		return 0, w.mock.SendError
+	}
+	w.mock.PublishCh <- PublishData{
+		Data:   slices.Clone(data),
+		Target: &w.pid,
+	}
+
+	return len(data), nil
+}
+
+func (w *mockStreamWriter) Close() error {
+	return nil
+}
+
 func (mock *MockNetwork) Broadcast(data []byte, _ TopicID) error {
 	mock.PublishCh <- PublishData{
 		Data:   data,
@@ -107,6 +149,18 @@ func (mock *MockNetwork) CloseConnection(pid lp2ppeer.ID) {
 	}
 }
 
+func (mock *MockNetwork) ProtectPeer(pid lp2ppeer.ID) {
+	mock.ProtectedPeers = append(mock.ProtectedPeers, pid)
+}
+
+func (mock *MockNetwork) ConnectPeer(addrInfo lp2ppeer.AddrInfo) {
+	mock.ConnectedAddrs = append(mock.ConnectedAddrs, addrInfo)
+}
+
+func (mock *MockNetwork) ConnectBootstrapPeers() {
+	mock.BootstrapConnects++
+}
+
 func (mock *MockNetwork) IsClosed(pid lp2ppeer.ID) bool {
 	for _, net := range mock.OtherNets {
 		if net.ID == pid {
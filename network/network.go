@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"sync"
 	"time"
 
 	lp2p "github.com/libp2p/go-libp2p"
@@ -11,9 +13,12 @@ import (
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
 	lp2phost "github.com/libp2p/go-libp2p/core/host"
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	lp2prcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	lp2pconnmgr "github.com/libp2p/go-libp2p/p2p/net/connmgr"
+	lp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	lp2ptcp "github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
@@ -28,6 +33,7 @@ type network struct {
 	// Read more here: https://go.dev/blog/context-and-structs
 	// We should remove it from here and pass it as first argument of functions
 	// Adding these linter later:  contextcheck and containedctx
+	lk             sync.RWMutex
 	ctx            context.Context
 	cancel         func()
 	config         *Config
@@ -35,6 +41,7 @@ type network struct {
 	mdns           *mdnsService
 	dht            *dhtService
 	peerMgr        *peerMgr
+	heartbeat      *heartbeatService
 	connGater      *ConnectionGater
 	stream         *streamService
 	gossip         *gossipService
@@ -83,6 +90,14 @@ func NewNetwork(conf *Config) (Network, error) {
 }
 
 func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*network, error) {
+	// The bootstrap addresses are resolved once at startup,
+	// so the bootstrap nodes can be rotated without shipping a new binary.
+	// The config is not updated, since it is shared with the other modules.
+	bootstrapAddrs, err := MakeAddrInfos(resolveDNSSeeds(conf, log))
+	if err != nil {
+		return nil, LibP2PError{Err: err}
+	}
+
 	networkKey, err := loadOrCreateKey(conf.NetworkKey)
 	if err != nil {
 		return nil, LibP2PError{Err: err}
@@ -129,6 +144,19 @@ func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netwo
 		lp2p.ConnectionManager(connMgr),
 	)
 
+	// All the default transports are used when both TCP and QUIC are enabled.
+	// Otherwise, only the enabled transport is used.
+	if !conf.EnableTCP || !conf.EnableQUIC {
+		if conf.EnableTCP {
+			log.Info("tcp-only transport")
+			opts = append(opts, lp2p.Transport(lp2ptcp.NewTCPTransport))
+		}
+		if conf.EnableQUIC {
+			log.Info("quic-only transport")
+			opts = append(opts, lp2p.Transport(lp2pquic.NewTransport))
+		}
+	}
+
 	if conf.EnableNAT {
 		log.Info("nat enabled")
 		opts = append(opts,
@@ -181,7 +209,7 @@ func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netwo
 	})
 	opts = append(opts, addrFactory)
 
-	connGater, err := NewConnectionGater(conf, log)
+	connGater, err := NewConnectionGater(conf, bootstrapAddrs, log)
 	if err != nil {
 		return nil, LibP2PError{Err: err}
 	}
@@ -212,10 +240,11 @@ func newNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netwo
 	if conf.EnableMdns {
 		n.mdns = newMdnsService(ctx, n.host, n.logger)
 	}
-	n.dht = newDHTService(n.ctx, n.host, kadProtocolID, conf, n.logger)
-	n.peerMgr = newPeerMgr(ctx, host, n.dht.kademlia, streamProtocolID, conf, n.logger)
+	n.dht = newDHTService(n.ctx, n.host, kadProtocolID, conf, bootstrapAddrs, n.logger)
+	n.peerMgr = newPeerMgr(ctx, host, n.dht.kademlia, streamProtocolID, conf, bootstrapAddrs, n.logger)
 	n.stream = newStreamService(ctx, n.host, streamProtocolID, n.eventChannel, n.logger)
 	n.gossip = newGossipService(ctx, n.host, n.eventChannel, conf, n.logger)
+	n.heartbeat = newHeartbeatService(ctx, n.host, conf, n.logger)
 	n.notifee = newNotifeeService(n.host, n.eventChannel, n.peerMgr, streamProtocolID, conf.Bootstrapper, n.logger)
 
 	n.host.Network().Notify(n.notifee)
@@ -245,6 +274,7 @@ func (n *network) Start() error {
 	n.gossip.Start()
 	n.stream.Start()
 	n.peerMgr.Start()
+	n.heartbeat.Start()
 
 	n.logger.Info("network started", "addr", n.host.Addrs(), "id", n.host.ID())
 	return nil
@@ -261,6 +291,7 @@ func (n *network) Stop() {
 	n.gossip.Stop()
 	n.stream.Stop()
 	n.peerMgr.Stop()
+	n.heartbeat.Stop()
 
 	if err := n.host.Close(); err != nil {
 		n.logger.Error("unable to close the network", "error", err)
@@ -276,7 +307,15 @@ func (n *network) SendTo(msg []byte, pid lp2pcore.PeerID) error {
 	return n.stream.SendRequest(msg, pid)
 }
 
+func (n *network) OpenStream(pid lp2pcore.PeerID) (io.WriteCloser, error) {
+	n.logger.Trace("opening new stream", "to", pid)
+	return n.stream.OpenStream(pid)
+}
+
 func (n *network) Broadcast(msg []byte, topicID TopicID) error {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
 	n.logger.Trace("publishing new message", "topic", topicID)
 	switch topicID {
 	case TopicIDGeneral:
@@ -297,6 +336,9 @@ func (n *network) Broadcast(msg []byte, topicID TopicID) error {
 }
 
 func (n *network) JoinGeneralTopic() error {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
 	if n.generalTopic != nil {
 		n.logger.Debug("already subscribed to general topic")
 		return nil
@@ -310,6 +352,9 @@ func (n *network) JoinGeneralTopic() error {
 }
 
 func (n *network) JoinConsensusTopic() error {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
 	if n.consensusTopic != nil {
 		n.logger.Debug("already subscribed to consensus topic")
 		return nil
@@ -322,6 +367,21 @@ func (n *network) JoinConsensusTopic() error {
 	return nil
 }
 
+func (n *network) LeaveConsensusTopic() error {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	if n.consensusTopic == nil {
+		n.logger.Debug("not subscribed to consensus topic")
+		return nil
+	}
+	if err := n.gossip.LeaveTopic(n.consensusTopic); err != nil {
+		return err
+	}
+	n.consensusTopic = nil
+	return nil
+}
+
 func (n *network) generalTopicName() string {
 	return n.TopicName("general")
 }
@@ -340,6 +400,30 @@ func (n *network) CloseConnection(pid lp2ppeer.ID) {
 	}
 }
 
+// ProtectPeer prevents the connection manager from trimming the connection with the peer.
+// The peer doesn't need to be connected yet.
+func (n *network) ProtectPeer(pid lp2ppeer.ID) {
+	n.host.ConnManager().Protect(pid, protectedTag)
+}
+
+// ConnectPeer tries to connect to the peer in the background.
+// It does nothing if the peer is already connected or the maximum number of connections is reached.
+func (n *network) ConnectPeer(addrInfo lp2ppeer.AddrInfo) {
+	if n.NumConnectedPeers() >= n.config.MaxConns {
+		return
+	}
+	if n.host.Network().Connectedness(addrInfo.ID) == lp2pnetwork.Connected {
+		return
+	}
+
+	ConnectAsync(n.ctx, n.host, addrInfo, n.logger)
+}
+
+// ConnectBootstrapPeers tries to connect to the bootstrap and relay peers in the background.
+func (n *network) ConnectBootstrapPeers() {
+	n.peerMgr.ConnectBootstrapPeers()
+}
+
 func (n *network) String() string {
 	return fmt.Sprintf("{%d}", n.NumConnectedPeers())
 }
@@ -67,6 +67,9 @@ func testConfig() *Config {
 		BootstrapAddrStrings: []string{},
 		MinConns:             4,
 		MaxConns:             8,
+		RejectWhenFull:       true,
+		EnableTCP:            true,
+		EnableQUIC:           true,
 		EnableNAT:            false,
 		EnableRelay:          false,
 		EnableMdns:           false,
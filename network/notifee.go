@@ -12,6 +12,13 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// outboundTag is the connection manager tag that protects the outbound connections.
+// We chose these peers ourselves, so they are kept over inbound ones when trimming.
+const outboundTag = "outbound"
+
+// protectedTag is the connection manager tag that protects the peers that should never be trimmed.
+const protectedTag = "protected"
+
 type NotifeeService struct {
 	host             lp2phost.Host
 	eventChannel     chan<- Event
@@ -38,7 +45,12 @@ func newNotifeeService(host lp2phost.Host, eventChannel chan<- Event, peerMgr *p
 
 func (n *NotifeeService) Connected(lp2pn lp2pnetwork.Network, conn lp2pnetwork.Conn) {
 	pid := conn.RemotePeer()
-	n.logger.Info("connected to peer", "pid", pid, "direction", conn.Stat().Direction)
+	direction := conn.Stat().Direction
+	n.logger.Info("connected to peer", "pid", pid, "direction", direction)
+
+	if direction == lp2pnetwork.DirOutbound {
+		n.host.ConnManager().Protect(pid, outboundTag)
+	}
 
 	var protocols []lp2pcore.ProtocolID
 	go func() {
@@ -59,13 +71,15 @@ func (n *NotifeeService) Connected(lp2pn lp2pnetwork.Network, conn lp2pnetwork.C
 			n.logger.Debug("get supported protocols", "pid", pid, "protocols", protocols)
 		}
 
-		n.peerMgr.AddPeer(pid, conn.RemoteMultiaddr(), conn.Stat().Direction, protocols)
+		n.peerMgr.AddPeer(pid, conn.RemoteMultiaddr(), direction, protocols)
 
 		supportStream := slices.Contains(protocols, n.streamProtocolID)
 		n.eventChannel <- &ConnectEvent{
 			PeerID:        pid,
 			RemoteAddress: conn.RemoteMultiaddr().String(),
+			Direction:     direction,
 			SupportStream: supportStream,
+			Relayed:       IsRelayedAddr(conn.RemoteMultiaddr()),
 		}
 	}()
 }
@@ -73,6 +87,11 @@ func (n *NotifeeService) Connected(lp2pn lp2pnetwork.Network, conn lp2pnetwork.C
 func (n *NotifeeService) Disconnected(_ lp2pnetwork.Network, conn lp2pnetwork.Conn) {
 	pid := conn.RemotePeer()
 	n.logger.Info("disconnected from peer", "pid", pid)
+	// The peer may still be connected through another connection.
+	if conn.Stat().Direction == lp2pnetwork.DirOutbound &&
+		n.host.Network().Connectedness(pid) != lp2pnetwork.Connected {
+		n.host.ConnManager().Unprotect(pid, outboundTag)
+	}
 	n.eventChannel <- &DisconnectEvent{PeerID: pid}
 
 	n.peerMgr.RemovePeer(pid)
@@ -0,0 +1,61 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockConn is a closed connection to the remote peer.
+type mockConn struct {
+	lp2pnet.Conn
+
+	remote    lp2ppeer.ID
+	direction lp2pnet.Direction
+}
+
+func (c *mockConn) RemotePeer() lp2ppeer.ID {
+	return c.remote
+}
+
+func (c *mockConn) Stat() lp2pnet.ConnStats {
+	return lp2pnet.ConnStats{Stats: lp2pnet.Stats{Direction: c.direction}}
+}
+
+func TestUnprotectOutboundPeer(t *testing.T) {
+	conf := testConfig()
+	conf.EnableQUIC = false
+	conf.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0"}
+	net := makeTestNetwork(t, conf, nil)
+	defer net.Stop()
+
+	confPeer := testConfig()
+	confPeer.EnableQUIC = false
+	confPeer.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0"}
+	netPeer := makeTestNetwork(t, confPeer, nil)
+	defer netPeer.Stop()
+
+	pid := netPeer.SelfID()
+	peerInfo := lp2ppeer.AddrInfo{ID: pid, Addrs: netPeer.host.Addrs()}
+	require.NoError(t, net.host.Connect(context.Background(), peerInfo))
+	require.True(t, net.host.ConnManager().IsProtected(pid, outboundTag))
+
+	t.Run("Peer is still connected, should keep the protection", func(t *testing.T) {
+		net.notifee.Disconnected(net.host.Network(), &mockConn{remote: pid, direction: lp2pnet.DirOutbound})
+
+		assert.True(t, net.host.ConnManager().IsProtected(pid, outboundTag))
+	})
+
+	t.Run("Peer is disconnected, should remove the protection", func(t *testing.T) {
+		require.NoError(t, net.host.Network().ClosePeer(pid))
+
+		assert.Eventually(t, func() bool {
+			return !net.host.ConnManager().IsProtected(pid, outboundTag)
+		}, time.Second, 10*time.Millisecond)
+	})
+}
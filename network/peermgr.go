@@ -13,6 +13,7 @@ import (
 	lp2pswarm "github.com/libp2p/go-libp2p/p2p/net/swarm"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pactus-project/pactus/util/logger"
+	"golang.org/x/exp/slices"
 )
 
 type peerInfo struct {
@@ -28,6 +29,7 @@ type peerMgr struct {
 
 	ctx              context.Context
 	bootstrapAddrs   []lp2ppeer.AddrInfo
+	relayAddrs       []lp2ppeer.AddrInfo
 	minConns         int
 	maxConns         int
 	host             lp2phost.Host
@@ -39,11 +41,18 @@ type peerMgr struct {
 
 // newPeerMgr creates a new Peer Manager instance.
 func newPeerMgr(ctx context.Context, h lp2phost.Host, dht *lp2pdht.IpfsDHT,
-	streamProtocolID lp2pcore.ProtocolID, conf *Config, log *logger.SubLogger,
+	streamProtocolID lp2pcore.ProtocolID, conf *Config, bootstrapAddrs []lp2ppeer.AddrInfo,
+	log *logger.SubLogger,
 ) *peerMgr {
+	relayAddrs := []lp2ppeer.AddrInfo{}
+	if conf.EnableRelay {
+		relayAddrs = conf.RelayAddrInfos()
+	}
+
 	b := &peerMgr{
 		ctx:              ctx,
-		bootstrapAddrs:   conf.BootstrapAddrInfos(),
+		bootstrapAddrs:   bootstrapAddrs,
+		relayAddrs:       relayAddrs,
 		minConns:         conf.MinConns,
 		maxConns:         conf.MaxConns,
 		streamProtocolID: streamProtocolID,
@@ -115,20 +124,7 @@ func (mgr *peerMgr) CheckConnectivity() {
 
 	mgr.logger.Debug("check connectivity", "peers", len(mgr.peers))
 
-	net := mgr.host.Network()
-
-	// Let's check if some peers are disconnected
-	var connectedPeers []lp2ppeer.ID
-	for pid := range mgr.peers {
-		connectedness := net.Connectedness(pid)
-		if connectedness == lp2pnet.Connected {
-			connectedPeers = append(connectedPeers, pid)
-		} else {
-			mgr.logger.Debug("peer is not connected to us", "peer", pid)
-			delete(mgr.peers, pid)
-		}
-	}
-
+	connectedPeers := mgr.connectedPeers()
 	if len(connectedPeers) > mgr.maxConns {
 		mgr.logger.Debug("peer count is about maximum threshold",
 			"count", len(connectedPeers),
@@ -158,3 +154,46 @@ func (mgr *peerMgr) CheckConnectivity() {
 		}
 	}
 }
+
+// ConnectBootstrapPeers tries to connect to the bootstrap and relay peers
+// that are not connected yet, regardless of the number of connections.
+// Unlike CheckConnectivity, it respects the dial backoff of the peers,
+// so it can be called frequently.
+func (mgr *peerMgr) ConnectBootstrapPeers() {
+	mgr.lk.Lock()
+	defer mgr.lk.Unlock()
+
+	connectedPeers := mgr.connectedPeers()
+	if len(connectedPeers) >= mgr.maxConns {
+		return
+	}
+
+	addrs := append(slices.Clone(mgr.bootstrapAddrs), mgr.relayAddrs...)
+	for _, pi := range addrs {
+		if HasPID(connectedPeers, pi.ID) {
+			continue
+		}
+
+		mgr.logger.Debug("try connecting to a bootstrap or relay peer", "peer", pi.String())
+		ConnectAsync(mgr.ctx, mgr.host, pi, mgr.logger)
+	}
+}
+
+// connectedPeers removes the disconnected peers and returns the connected ones.
+// It should be called while the lock is held.
+func (mgr *peerMgr) connectedPeers() []lp2ppeer.ID {
+	net := mgr.host.Network()
+
+	var connectedPeers []lp2ppeer.ID
+	for pid := range mgr.peers {
+		connectedness := net.Connectedness(pid)
+		if connectedness == lp2pnet.Connected {
+			connectedPeers = append(connectedPeers, pid)
+		} else {
+			mgr.logger.Debug("peer is not connected to us", "peer", pid)
+			delete(mgr.peers, pid)
+		}
+	}
+
+	return connectedPeers
+}
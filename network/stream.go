@@ -2,6 +2,7 @@ package network
 
 import (
 	"context"
+	"io"
 	"time"
 
 	lp2pcore "github.com/libp2p/go-libp2p/core"
@@ -58,11 +59,32 @@ func (s *streamService) handleStream(stream lp2pnetwork.Stream) {
 // Returns an error if the sending process fails.
 func (s *streamService) SendRequest(msg []byte, pid lp2peer.ID) error {
 	s.logger.Trace("sending stream", "to", pid)
-	_, err := s.host.Peerstore().SupportsProtocols(pid, s.protocolID)
+	w, err := s.OpenStream(pid)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return LibP2PError{Err: err}
+	}
+	err = w.Close()
 	if err != nil {
 		return LibP2PError{Err: err}
 	}
 
+	return nil
+}
+
+// OpenStream opens a new stream to a specific peer,
+// so multiple messages can be written into it one after another.
+// Closing the returned writer closes the stream for writing only.
+func (s *streamService) OpenStream(pid lp2peer.ID) (io.WriteCloser, error) {
+	_, err := s.host.Peerstore().SupportsProtocols(pid, s.protocolID)
+	if err != nil {
+		return nil, LibP2PError{Err: err}
+	}
+
 	// To prevent a broken stream from being open forever.
 	ctxWithTimeout, cancel := context.WithTimeout(s.ctx, 2*time.Second)
 	defer cancel()
@@ -71,17 +93,18 @@ func (s *streamService) SendRequest(msg []byte, pid lp2peer.ID) error {
 	stream, err := s.host.NewStream(
 		lp2pnetwork.WithNoDial(ctxWithTimeout, "should already have connection"), pid, s.protocolID)
 	if err != nil {
-		return LibP2PError{Err: err}
+		return nil, LibP2PError{Err: err}
 	}
 
-	_, err = stream.Write(msg)
-	if err != nil {
-		return LibP2PError{Err: err}
-	}
-	err = stream.CloseWrite()
-	if err != nil {
-		return LibP2PError{Err: err}
-	}
+	return &streamWriter{Stream: stream}, nil
+}
 
-	return nil
+// streamWriter closes the stream for writing only,
+// so the remote peer can still read the remaining data.
+type streamWriter struct {
+	lp2pnetwork.Stream
+}
+
+func (w *streamWriter) Close() error {
+	return w.Stream.CloseWrite()
 }
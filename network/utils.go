@@ -61,6 +61,13 @@ func IPToMultiAddr(ip string, port int) (multiaddr.Multiaddr, error) {
 	return ma, nil
 }
 
+// IsRelayedAddr checks if the address is a circuit address,
+// which means the peer is reached through a relay node.
+func IsRelayedAddr(addr multiaddr.Multiaddr) bool {
+	_, err := addr.ValueForProtocol(multiaddr.P_CIRCUIT)
+	return err == nil
+}
+
 // HasPID checks if a peer ID exists in a list of peer IDs.
 func HasPID(pids []lp2ppeer.ID, pid lp2ppeer.ID) bool {
 	for _, p := range pids {
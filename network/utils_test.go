@@ -159,6 +159,16 @@ func TestHasPID(t *testing.T) {
 	assert.False(t, HasPID(pids, lp2ppeer.ID("peer4")))
 }
 
+func TestIsRelayedAddr(t *testing.T) {
+	directAddr, _ := multiaddr.NewMultiaddr(
+		"/ip4/127.0.0.1/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT")
+	relayedAddr, _ := multiaddr.NewMultiaddr(
+		"/ip4/127.0.0.1/tcp/21888/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT/p2p-circuit")
+
+	assert.False(t, IsRelayedAddr(directAddr))
+	assert.True(t, IsRelayedAddr(relayedAddr))
+}
+
 func TestSubnetsToFilters(t *testing.T) {
 	sns := PrivateSubnets()
 	f := SubnetsToFilters(sns, multiaddr.ActionDeny)
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"path/filepath"
 	"time"
 
 	"github.com/pactus-project/pactus/config"
@@ -22,6 +23,7 @@ import (
 	"github.com/pactus-project/pactus/www/nanomsg"
 	"github.com/pactus-project/pactus/www/nanomsg/event"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 )
 
 type Node struct {
@@ -58,7 +60,7 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 		eventCh = nil
 	}
 
-	txPool := txpool.NewTxPool(conf.TxPool, messageCh)
+	txPool := txpool.NewTxPool(conf.TxPool, messageCh, eventCh)
 
 	// TODO implement dequeue for recent transaction
 	str, err := store.NewStore(conf.Store)
@@ -71,9 +73,35 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 		return nil, err
 	}
 
-	consMgr := consensus.NewManager(conf.Consensus, st, valKeys, rewardAddrs, messageCh)
+	rewardPolicies := make([]consensus.RewardPolicy, len(rewardAddrs))
+	for i, addr := range rewardAddrs {
+		if addrs, ok := conf.Node.RotatingRewardAddresses(i); ok {
+			rewardPolicies[i] = consensus.NewRotatingRewardPolicy(addrs, conf.Node.RewardRotationInterval)
+		} else {
+			rewardPolicies[i] = consensus.NewRewardPolicy(addr)
+		}
+	}
+
+	consMgr := consensus.NewManager(conf.Consensus, st, valKeys, rewardPolicies, messageCh)
 
-	syn, err := sync.NewSynchronizer(conf.Sync, valKeys, st, consMgr, net, messageCh)
+	if !conf.Node.RunsConsensus() {
+		conf.Sync.RunConsensus = false
+	}
+	conf.Sync.MinConns = conf.Network.MinConns
+	conf.Sync.ForcePrivateNetwork = conf.Network.ForcePrivateNetwork
+	if conf.Sync.PendingPath != "" && !filepath.IsAbs(conf.Sync.PendingPath) {
+		// The pending blocks are kept next to the store, not in the working directory.
+		conf.Sync.PendingPath = filepath.Join(conf.Store.DataPath(), conf.Sync.PendingPath)
+	}
+	if conf.Node.Mode == config.NodeModeArchive {
+		// Archive nodes don't need the consensus messages, not even to relay them.
+		conf.Sync.Topics = slices.DeleteFunc(conf.Sync.Topics, func(topic string) bool {
+			return topic == network.TopicIDConsensus.String()
+		})
+	}
+
+	syn, err := sync.NewSynchronizer(conf.Sync, valKeys,
+		message.NewBLSHelloSigner(valKeys), message.BLSHelloVerifier{}, st, consMgr, net, messageCh)
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +146,10 @@ func (n *Node) Start() error {
 		return err
 	}
 
-	if err := n.consMgr.Start(); err != nil {
-		return err
+	if n.config.Node.RunsConsensus() {
+		if err := n.consMgr.Start(); err != nil {
+			return err
+		}
 	}
 
 	err := n.grpc.StartServer()
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/pactus-project/pactus/config"
@@ -43,8 +44,37 @@ func TestRunningNode(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Equal(t, n.state.LastBlockHash(), hash.UndefHash)
+	assert.Equal(t, filepath.Join(conf.Store.DataPath(), "pending_blocks"), conf.Sync.PendingPath)
 
 	err = n.Start()
 	require.NoError(t, err)
 	n.Stop()
 }
+
+func TestArchiveNode(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	logger.LogFilename = util.TempFilePath()
+	pub, _ := ts.RandBLSKeyPair()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(21 * 1e14)
+	val := validator.NewValidator(pub, 0)
+	gen := genesis.MakeGenesis(util.Now(),
+		map[crypto.Address]*account.Account{crypto.TreasuryAddress: acc},
+		[]*validator.Validator{val}, param.DefaultParams())
+	conf := config.DefaultConfigMainnet()
+	conf.Node.Mode = config.NodeModeArchive
+	conf.GRPC.Enable = false
+	conf.HTTP.Enable = false
+	conf.Store.Path = util.TempDirPath()
+	conf.Network.EnableRelay = false
+	conf.Network.NetworkKey = util.TempFilePath()
+
+	valKeys := []*bls.ValidatorKey{ts.RandValKey()}
+	rewardAddrs := []crypto.Address{ts.RandAccAddress()}
+	_, err := NewNode(gen, conf, valKeys, rewardAddrs)
+	require.NoError(t, err)
+
+	assert.False(t, conf.Sync.RunConsensus)
+	assert.Equal(t, []string{"general"}, conf.Sync.Topics)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
@@ -28,6 +29,8 @@ type Facade interface {
 	ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Address) (*block.Block, error)
 	ValidateBlock(blk *block.Block) error
 	CommitBlock(blk *block.Block, cert *certificate.Certificate) error
+	CommitTrustedBlock(blk *block.Block, cert *certificate.Certificate) error
+	SubscribeCommittedBlocks() (<-chan uint32, func())
 	CommitteeValidators() []*validator.Validator
 	IsInCommittee(addr crypto.Address) bool
 	Proposer(round int16) *validator.Validator
@@ -35,11 +38,14 @@ type Facade interface {
 	IsValidator(addr crypto.Address) bool
 	TotalPower() int64
 	TotalAccounts() int32
+	TotalAccountsAt(height uint32) (int32, error)
 	TotalValidators() int32
 	CommitteePower() int64
 	PendingTx(id tx.ID) *tx.Tx
 	AddPendingTx(trx *tx.Tx) error
 	AddPendingTxAndBroadcast(trx *tx.Tx) error
+	TxPoolStats() *txpool.Stats
+	TxPoolMinFee() int64
 	CommittedBlock(height uint32) *store.CommittedBlock
 	CommittedTx(id tx.ID) *store.CommittedTx
 	BlockHash(height uint32) hash.Hash
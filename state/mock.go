@@ -37,6 +37,13 @@ type MockState struct {
 	TestCommittee committee.Committee
 	TestValKeys   []*bls.ValidatorKey
 	TestParams    *param.Params
+
+	// TestTrustedBlocks keeps the heights of the blocks committed without verifying their certificates.
+	TestTrustedBlocks []uint32
+	// TestTrustedCerts keeps the certificates of the blocks committed without verifying them.
+	TestTrustedCerts []*certificate.Certificate
+
+	commitNotifier *commitNotifier
 }
 
 func MockingState(ts *testsuite.TestSuite) *MockState {
@@ -50,23 +57,33 @@ func MockingState(ts *testsuite.TestSuite) *MockState {
 		TestCommittee: cmt,
 		TestValKeys:   valKeys,
 		TestParams:    genDoc.Params(),
+
+		commitNotifier: newCommitNotifier(),
 	}
 }
 
 func (m *MockState) CommitTestBlocks(num int) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
 	for i := 0; i < num; i++ {
-		height := m.LastBlockHeight() + 1
+		height := m.TestStore.LastHeight + 1
 		blk, cert := m.ts.GenerateTestBlock(height)
 
 		m.TestStore.SaveBlock(blk, cert)
+		m.commitNotifier.notify(height)
 	}
 }
 
 func (m *MockState) LastBlockHeight() uint32 {
-	m.lk.RLock()
-	defer m.lk.RUnlock()
+	// The tests may add blocks to the store directly,
+	// so the height is read through the store's lock.
+	lastCert := m.TestStore.LastCertificate()
+	if lastCert == nil {
+		return 0
+	}
 
-	return m.TestStore.LastHeight
+	return lastCert.Height()
 }
 
 func (m *MockState) Genesis() *genesis.Genesis {
@@ -107,6 +124,26 @@ func (m *MockState) CommitBlock(b *block.Block, cert *certificate.Certificate) e
 		return fmt.Errorf("invalid height")
 	}
 	m.TestStore.SaveBlock(b, cert)
+	m.commitNotifier.notify(cert.Height())
+
+	return nil
+}
+
+func (m *MockState) SubscribeCommittedBlocks() (<-chan uint32, func()) {
+	return m.commitNotifier.subscribe()
+}
+
+func (m *MockState) CommitTrustedBlock(b *block.Block, cert *certificate.Certificate) error {
+	if err := m.CommitBlock(b, cert); err != nil {
+		return err
+	}
+
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	m.TestTrustedBlocks = append(m.TestTrustedBlocks, cert.Height())
+	m.TestTrustedCerts = append(m.TestTrustedCerts, cert)
+
 	return nil
 }
 
@@ -151,6 +188,10 @@ func (m *MockState) TotalAccounts() int32 {
 	return m.TestStore.TotalAccounts()
 }
 
+func (m *MockState) TotalAccountsAt(height uint32) (int32, error) {
+	return m.TestStore.TotalAccountsAt(height)
+}
+
 func (m *MockState) TotalPower() int64 {
 	p := int64(0)
 	m.TestStore.IterateValidators(func(val *validator.Validator) bool {
@@ -236,6 +277,14 @@ func (m *MockState) AddPendingTxAndBroadcast(trx *tx.Tx) error {
 	return m.TestPool.AppendTxAndBroadcast(trx)
 }
 
+func (m *MockState) TxPoolStats() *txpool.Stats {
+	return m.TestPool.Stats()
+}
+
+func (m *MockState) TxPoolMinFee() int64 {
+	return m.TestPool.MinFee()
+}
+
 func (m *MockState) Params() *param.Params {
 	return m.TestParams
 }
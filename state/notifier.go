@@ -0,0 +1,45 @@
+package state
+
+import "sync"
+
+// commitNotifier fans out the height of the committed blocks to the subscribers.
+type commitNotifier struct {
+	lk          sync.Mutex
+	subscribers map[chan uint32]struct{}
+}
+
+func newCommitNotifier() *commitNotifier {
+	return &commitNotifier{
+		subscribers: make(map[chan uint32]struct{}),
+	}
+}
+
+func (n *commitNotifier) subscribe() (<-chan uint32, func()) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	// The channel keeps only the last height, so committing a block never
+	// waits for the subscribers.
+	ch := make(chan uint32, 1)
+	n.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		n.lk.Lock()
+		defer n.lk.Unlock()
+
+		delete(n.subscribers, ch)
+	}
+}
+
+func (n *commitNotifier) notify(height uint32) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	for ch := range n.subscribers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- height
+	}
+}
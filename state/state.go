@@ -49,6 +49,7 @@ type state struct {
 	validatorMerkle *persistentmerkle.Tree
 	logger          *logger.SubLogger
 	eventCh         chan event.Event
+	commitNotifier  *commitNotifier
 }
 
 func LoadOrNewState(
@@ -67,6 +68,7 @@ func LoadOrNewState(
 		accountMerkle:   persistentmerkle.New(),
 		validatorMerkle: persistentmerkle.New(),
 		eventCh:         eventCh,
+		commitNotifier:  newCommitNotifier(),
 	}
 	st.logger = logger.NewSubLogger("_state", st)
 	st.store = str
@@ -379,6 +381,16 @@ func (st *state) ValidateBlock(blk *block.Block) error {
 }
 
 func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) error {
+	return st.commitBlock(blk, cert, false)
+}
+
+func (st *state) CommitTrustedBlock(blk *block.Block, cert *certificate.Certificate) error {
+	return st.commitBlock(blk, cert, true)
+}
+
+// commitBlock validates and commits the block. For trusted blocks, the signatures of
+// the certificate and the sortition seed are not verified.
+func (st *state) commitBlock(blk *block.Block, cert *certificate.Certificate, trusted bool) error {
 	st.lk.Lock()
 	defer st.lk.Unlock()
 
@@ -388,7 +400,12 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 		return nil
 	}
 
-	err := st.validateCertificate(cert, blk.Hash())
+	var err error
+	if trusted {
+		err = cert.ValidateCommitters(height, st.committee.Validators())
+	} else {
+		err = st.validateCertificate(cert, blk.Hash())
+	}
 	if err != nil {
 		return err
 	}
@@ -420,7 +437,7 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	}
 	// Validate sortition seed
 	seed := blk.Header().SortitionSeed()
-	if !seed.Verify(proposer.PublicKey(), st.lastInfo.SortitionSeed()) {
+	if !trusted && !seed.Verify(proposer.PublicKey(), st.lastInfo.SortitionSeed()) {
 		return errors.Errorf(errors.ErrInvalidBlock, "invalid sortition seed")
 	}
 
@@ -465,9 +482,18 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.Certificate) er
 	// Publishing the events to the zmq
 	st.publishEvents(height, blk)
 
+	st.commitNotifier.notify(height)
+
 	return nil
 }
 
+// SubscribeCommittedBlocks registers a new subscriber for the height of the committed blocks.
+// A slow subscriber skips the heights it couldn't consume in time.
+// The returned function should be called to unsubscribe.
+func (st *state) SubscribeCommittedBlocks() (<-chan uint32, func()) {
+	return st.commitNotifier.subscribe()
+}
+
 func (st *state) evaluateSortition() bool {
 	evaluated := false
 	for _, key := range st.valKeys {
@@ -601,6 +627,10 @@ func (st *state) TotalAccounts() int32 {
 	return st.store.TotalAccounts()
 }
 
+func (st *state) TotalAccountsAt(height uint32) (int32, error) {
+	return st.store.TotalAccountsAt(height)
+}
+
 func (st *state) TotalValidators() int32 {
 	return st.store.TotalValidators()
 }
@@ -696,6 +726,14 @@ func (st *state) AddPendingTxAndBroadcast(trx *tx.Tx) error {
 	return st.txPool.AppendTxAndBroadcast(trx)
 }
 
+func (st *state) TxPoolStats() *txpool.Stats {
+	return st.txPool.Stats()
+}
+
+func (st *state) TxPoolMinFee() int64 {
+	return st.txPool.MinFee()
+}
+
 func (st *state) Params() *param.Params {
 	return st.params
 }
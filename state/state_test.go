@@ -267,6 +267,48 @@ func TestCommitBlocks(t *testing.T) {
 	assert.Equal(t, td.state1.Genesis().Hash(), td.state2.Genesis().Hash())
 }
 
+func TestCommitTrustedBlock(t *testing.T) {
+	td := setup(t)
+
+	b1, _ := td.makeBlockAndCertificate(t, 1, td.valKey1, td.valKey2, td.valKey3)
+	// The certificate is signed for another block, so its signature is invalid.
+	c1 := td.makeCertificateAndSign(t, td.RandHash(), 1, td.valKey1, td.valKey2, td.valKey3)
+	assert.Error(t, td.state1.CommitBlock(b1, c1))
+
+	t.Run("Insufficient power, should return error", func(t *testing.T) {
+		invCert := td.makeCertificateAndSign(t, b1.Hash(), 1, td.valKey1, td.valKey2)
+		assert.Error(t, td.state1.CommitTrustedBlock(b1, invCert))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		assert.NoError(t, td.state1.CommitTrustedBlock(b1, c1))
+		assert.Equal(t, td.state1.LastBlockHash(), b1.Hash())
+		assert.Equal(t, td.state1.LastBlockHeight(), uint32(1))
+	})
+}
+
+func TestSubscribeCommittedBlocks(t *testing.T) {
+	td := setup(t)
+
+	ch, unsubscribe := td.state1.SubscribeCommittedBlocks()
+
+	b1, c1 := td.makeBlockAndCertificate(t, 1, td.valKey1, td.valKey2, td.valKey3)
+	assert.NoError(t, td.state1.CommitBlock(b1, c1))
+	assert.Equal(t, uint32(1), <-ch)
+
+	// Committing the same block again doesn't notify the subscribers.
+	assert.NoError(t, td.state1.CommitBlock(b1, c1))
+	assert.Empty(t, ch)
+
+	unsubscribe()
+	assert.NoError(t, td.state2.CommitBlock(b1, c1))
+	assert.NoError(t, td.state3.CommitBlock(b1, c1))
+	assert.NoError(t, td.state4.CommitBlock(b1, c1))
+	td.moveToNextHeightForAllStates(t)
+	assert.Equal(t, uint32(2), td.state1.LastBlockHeight())
+	assert.Empty(t, ch)
+}
+
 func TestCommitSandbox(t *testing.T) {
 	t.Run("Add new account", func(t *testing.T) {
 		td := setup(t)
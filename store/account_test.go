@@ -142,3 +142,24 @@ func TestAccountDeepCopy(t *testing.T) {
 	acc2.AddToBalance(1)
 	assert.NotEqual(t, td.store.accountStore.addressMap[addr].Hash(), acc2.Hash())
 }
+
+func TestTotalAccountsAt(t *testing.T) {
+	td := setup(t)
+
+	acc, addr := td.GenerateTestAccount(0)
+	td.store.UpdateAccount(addr, acc)
+	blk, cert := td.GenerateTestBlock(11)
+	td.store.SaveBlock(blk, cert)
+	assert.NoError(t, td.store.WriteBatch())
+
+	total, err := td.store.TotalAccountsAt(10)
+	assert.NoError(t, err)
+	assert.Zero(t, total)
+
+	total, err = td.store.TotalAccountsAt(11)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), total)
+
+	_, err = td.store.TotalAccountsAt(12)
+	assert.Error(t, err)
+}
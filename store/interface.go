@@ -87,6 +87,7 @@ type Reader interface {
 	HasAccount(crypto.Address) bool
 	Account(addr crypto.Address) (*account.Account, error)
 	TotalAccounts() int32
+	TotalAccountsAt(height uint32) (int32, error)
 	HasValidator(addr crypto.Address) bool
 	ValidatorAddresses() []crypto.Address
 	Validator(addr crypto.Address) (*validator.Validator, error)
@@ -2,6 +2,7 @@ package store
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -17,6 +18,10 @@ import (
 var _ Store = &MockStore{}
 
 type MockStore struct {
+	// lk guards the blocks, the accounts and the last certificate,
+	// since the tests may add them while the servers are reading them.
+	lk sync.RWMutex
+
 	ts *testsuite.TestSuite
 
 	Blocks     map[uint32]*block.Block
@@ -24,6 +29,9 @@ type MockStore struct {
 	Validators map[crypto.Address]*validator.Validator
 	LastCert   *certificate.Certificate
 	LastHeight uint32
+
+	// TotalAccountsAtHeight keeps the number of accounts at each height, when the block is saved.
+	TotalAccountsAtHeight map[uint32]int32
 }
 
 func MockingStore(ts *testsuite.TestSuite) *MockStore {
@@ -32,10 +40,15 @@ func MockingStore(ts *testsuite.TestSuite) *MockStore {
 		Blocks:     make(map[uint32]*block.Block),
 		Accounts:   make(map[crypto.Address]*account.Account),
 		Validators: make(map[crypto.Address]*validator.Validator),
+
+		TotalAccountsAtHeight: make(map[uint32]int32),
 	}
 }
 
 func (m *MockStore) Block(height uint32) (*CommittedBlock, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	b, ok := m.Blocks[height]
 	if ok {
 		d, _ := b.Bytes()
@@ -49,6 +62,9 @@ func (m *MockStore) Block(height uint32) (*CommittedBlock, error) {
 }
 
 func (m *MockStore) BlockHash(height uint32) hash.Hash {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	b, ok := m.Blocks[height]
 	if ok {
 		return b.Hash()
@@ -57,6 +73,9 @@ func (m *MockStore) BlockHash(height uint32) hash.Hash {
 }
 
 func (m *MockStore) BlockHeight(h hash.Hash) uint32 {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	for height, b := range m.Blocks {
 		if b.Hash() == h {
 			return height
@@ -110,11 +129,17 @@ func (m *MockStore) AnyRecentTransaction(id tx.ID) bool {
 }
 
 func (m *MockStore) HasAccount(addr crypto.Address) bool {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	_, ok := m.Accounts[addr]
 	return ok
 }
 
 func (m *MockStore) Account(addr crypto.Address) (*account.Account, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	a, ok := m.Accounts[addr]
 	if ok {
 		return a.Clone(), nil
@@ -123,6 +148,9 @@ func (m *MockStore) Account(addr crypto.Address) (*account.Account, error) {
 }
 
 func (m *MockStore) AccountByNumber(number int32) (*account.Account, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	for _, v := range m.Accounts {
 		if v.Number() == number {
 			return v.Clone(), nil
@@ -132,13 +160,31 @@ func (m *MockStore) AccountByNumber(number int32) (*account.Account, error) {
 }
 
 func (m *MockStore) UpdateAccount(addr crypto.Address, acc *account.Account) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
 	m.Accounts[addr] = acc
 }
 
 func (m *MockStore) TotalAccounts() int32 {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	return int32(len(m.Accounts))
 }
 
+func (m *MockStore) TotalAccountsAt(height uint32) (int32, error) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	total, ok := m.TotalAccountsAtHeight[height]
+	if !ok {
+		return 0, ErrNotFound
+	}
+
+	return total, nil
+}
+
 func (m *MockStore) HasValidator(addr crypto.Address) bool {
 	_, ok := m.Validators[addr]
 	return ok
@@ -186,6 +232,9 @@ func (m *MockStore) HasAnyBlock() bool {
 }
 
 func (m *MockStore) IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool)) {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	for addr, acc := range m.Accounts {
 		stopped := consumer(addr, acc.Clone())
 		if stopped {
@@ -204,12 +253,23 @@ func (m *MockStore) IterateValidators(consumer func(*validator.Validator) (stop
 }
 
 func (m *MockStore) SaveBlock(b *block.Block, cert *certificate.Certificate) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	// The hash and the bytes are memorized here, so the readers don't memorize them concurrently.
+	_ = b.Hash()
+	_, _ = b.Bytes()
+
 	m.Blocks[cert.Height()] = b
+	m.TotalAccountsAtHeight[cert.Height()] = int32(len(m.Accounts))
 	m.LastHeight = cert.Height()
 	m.LastCert = cert
 }
 
 func (m *MockStore) LastCertificate() *certificate.Certificate {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
 	if m.LastHeight == 0 {
 		return nil
 	}
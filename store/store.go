@@ -37,8 +37,14 @@ var (
 	validatorPrefix   = []byte{0x07}
 	blockHeightPrefix = []byte{0x09}
 	publicKeyPrefix   = []byte{0x0a}
+
+	totalAccountsPrefix = []byte{0x0b}
 )
 
+func totalAccountsKey(height uint32) []byte {
+	return append(totalAccountsPrefix, util.Uint32ToSlice(height)...)
+}
+
 func tryGet(db *leveldb.DB, key []byte) ([]byte, error) {
 	data, err := db.Get(key, nil)
 	if err != nil {
@@ -111,6 +117,10 @@ func (s *store) SaveBlock(blk *block.Block, cert *certificate.Certificate) {
 	}
 
 	s.batch.Put(lastInfoKey, w.Bytes())
+
+	// The accounts are numbered in the order of their creation,
+	// so the number of accounts at each height tells which accounts existed at that height.
+	s.batch.Put(totalAccountsKey(height), util.Int32ToSlice(s.accountStore.total))
 }
 
 func (s *store) Block(height uint32) (*CommittedBlock, error) {
@@ -227,6 +237,20 @@ func (s *store) TotalAccounts() int32 {
 	return s.accountStore.total
 }
 
+// TotalAccountsAt returns the number of accounts at the given height.
+// It returns an error if the block at this height is saved before the number of accounts was recorded.
+func (s *store) TotalAccountsAt(height uint32) (int32, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	data, err := tryGet(s.db, totalAccountsKey(height))
+	if err != nil {
+		return 0, err
+	}
+
+	return util.SliceToInt32(data), nil
+}
+
 func (s *store) IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool)) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -83,10 +83,43 @@ func (b *Bundle) Encode() ([]byte, error) {
 }
 
 func (b *Bundle) Decode(r io.Reader) (int, error) {
+	return NewDecoder(r).Decode(b)
+}
+
+// Decoder decodes the bundles one after another from a single reader.
+// The bundles can't be decoded by separate decoders,
+// since each decoder may read ahead more data than a single bundle.
+type Decoder struct {
+	r         *peekReader
+	dec       *cbor.Decoder
+	bytesRead int
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	pr := &peekReader{r: r}
+
+	return &Decoder{
+		r:   pr,
+		dec: cbor.NewDecoder(pr),
+	}
+}
+
+// More reports whether there is more data to decode.
+// It blocks until some data is available or the reader is closed.
+func (d *Decoder) More() bool {
+	if buf, ok := d.dec.Buffered().(interface{ Len() int }); ok && buf.Len() > 0 {
+		return true
+	}
+
+	return d.r.peek()
+}
+
+// Decode decodes the next bundle and returns the number of bytes read for it.
+func (d *Decoder) Decode(b *Bundle) (int, error) {
 	var bdl _Bundle
-	d := cbor.NewDecoder(r)
-	err := d.Decode(&bdl)
-	bytesRead := d.NumBytesRead()
+	err := d.dec.Decode(&bdl)
+	bytesRead := d.dec.NumBytesRead() - d.bytesRead
+	d.bytesRead = d.dec.NumBytesRead()
 	if err != nil {
 		return bytesRead, errors.Errorf(errors.ErrInvalidMessage, err.Error())
 	}
@@ -110,3 +143,32 @@ func (b *Bundle) Decode(r io.Reader) (int, error) {
 	b.Message = msg
 	return bytesRead, cbor.Unmarshal(data, msg)
 }
+
+// peekReader can read one byte ahead without losing it.
+type peekReader struct {
+	r      io.Reader
+	peeked []byte
+}
+
+func (p *peekReader) Read(b []byte) (int, error) {
+	if len(p.peeked) > 0 && len(b) > 0 {
+		n := copy(b, p.peeked)
+		p.peeked = p.peeked[n:]
+
+		return n, nil
+	}
+
+	return p.r.Read(b)
+}
+
+func (p *peekReader) peek() bool {
+	if len(p.peeked) > 0 {
+		return true
+	}
+
+	buf := make([]byte, 1)
+	n, _ := io.ReadFull(p.r, buf)
+	p.peeked = buf[:n]
+
+	return n > 0
+}
@@ -101,3 +101,31 @@ func TestDecodeVoteCBOR(t *testing.T) {
 
 	assert.Equal(t, bdl1.Message, bdl2.Message)
 }
+
+func TestDecoder(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	bdl1 := NewBundle(ts.RandPeerID(), message.NewQueryProposalMessage(100))
+	bdl2 := NewBundle(ts.RandPeerID(), message.NewQueryVotesMessage(101, 1))
+	bdl2.CompressIt()
+	d1, _ := bdl1.Encode()
+	d2, _ := bdl2.Encode()
+
+	dec := NewDecoder(bytes.NewReader(append(d1, d2...)))
+
+	assert.True(t, dec.More())
+	decoded1 := new(Bundle)
+	n, err := dec.Decode(decoded1)
+	require.NoError(t, err)
+	assert.Equal(t, len(d1), n)
+	assert.Equal(t, bdl1, decoded1)
+
+	assert.True(t, dec.More())
+	decoded2 := new(Bundle)
+	n, err = dec.Decode(decoded2)
+	require.NoError(t, err)
+	assert.Equal(t, len(d2), n)
+	assert.Equal(t, bdl2, decoded2)
+
+	assert.False(t, dec.More())
+}
@@ -13,6 +13,8 @@ type BlocksResponseMessage struct {
 	CommittedBlocksData [][]byte                 `cbor:"4,keyasint"`
 	LastCertificate     *certificate.Certificate `cbor:"5,keyasint"`
 	Reason              string                   `cbor:"6,keyasint"`
+	RejectCode          RejectCode               `cbor:"7,keyasint"`
+	Continuation        uint32                   `cbor:"8,keyasint"` // zero means no continuation
 }
 
 func NewBlocksResponseMessage(code ResponseCode, reason string, sid int, from uint32,
@@ -28,6 +30,17 @@ func NewBlocksResponseMessage(code ResponseCode, reason string, sid int, from ui
 	}
 }
 
+// NewBlocksRejectedMessage creates a blocks response message that rejects the request
+// with the given reject code.
+func NewBlocksRejectedMessage(code RejectCode, reason string, sid int) *BlocksResponseMessage {
+	return &BlocksResponseMessage{
+		ResponseCode: ResponseCodeRejected,
+		RejectCode:   code,
+		SessionID:    sid,
+		Reason:       reason,
+	}
+}
+
 func (m *BlocksResponseMessage) BasicCheck() error {
 	if m.LastCertificate != nil {
 		if err := m.LastCertificate.BasicCheck(); err != nil {
@@ -55,9 +68,18 @@ func (m *BlocksResponseMessage) To() uint32 {
 }
 
 func (m *BlocksResponseMessage) String() string {
+	if m.HasContinuation() {
+		return fmt.Sprintf("{⚓ %d %s %v-%v ↪ %v}", m.SessionID, m.ResponseCode, m.From, m.To(), m.Continuation)
+	}
 	return fmt.Sprintf("{⚓ %d %s %v-%v}", m.SessionID, m.ResponseCode, m.From, m.To())
 }
 
+// HasContinuation returns true if the response is partial and
+// the requester should continue from the continuation height.
+func (m *BlocksResponseMessage) HasContinuation() bool {
+	return m.Continuation != 0
+}
+
 func (m *BlocksResponseMessage) IsRequestRejected() bool {
 	return m.ResponseCode == ResponseCodeRejected
 }
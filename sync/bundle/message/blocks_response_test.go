@@ -42,6 +42,19 @@ func TestBlocksResponseMessage(t *testing.T) {
 		assert.NoError(t, m.BasicCheck())
 		assert.Contains(t, m.String(), "100")
 		assert.Equal(t, m.Reason, ResponseCodeMoreBlocks.String())
+		assert.False(t, m.HasContinuation())
+	})
+
+	t.Run("Partial response", func(t *testing.T) {
+		blk, _ := ts.GenerateTestBlock(100)
+		d, _ := blk.Bytes()
+		m := NewBlocksResponseMessage(ResponseCodeMoreBlocks, ResponseCodeMoreBlocks.String(),
+			sid, 100, [][]byte{d}, nil)
+		m.Continuation = 101
+
+		assert.NoError(t, m.BasicCheck())
+		assert.True(t, m.HasContinuation())
+		assert.Contains(t, m.String(), "101")
 	})
 }
 
@@ -60,6 +73,17 @@ func TestLatestBlocksResponseCode(t *testing.T) {
 		assert.Equal(t, m.Reason, reason)
 	})
 
+	t.Run("rejected with reject code", func(t *testing.T) {
+		reason := ts.RandString(16)
+		m := NewBlocksRejectedMessage(RejectCodeBusy, reason, 1)
+
+		assert.NoError(t, m.BasicCheck())
+		assert.Zero(t, m.Count())
+		assert.True(t, m.IsRequestRejected())
+		assert.Equal(t, m.RejectCode, RejectCodeBusy)
+		assert.Equal(t, m.Reason, reason)
+	})
+
 	t.Run("OK - MoreBlocks", func(t *testing.T) {
 		height := ts.RandHeight()
 		blk1, _ := ts.GenerateTestBlock(height)
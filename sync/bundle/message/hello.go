@@ -12,6 +12,17 @@ import (
 	"github.com/pactus-project/pactus/version"
 )
 
+const (
+	// HelloFlagCompression indicates that the peer accepts compressed bundles.
+	HelloFlagCompression = 0x0001
+	// HelloFlagPartialBlocks indicates that the peer can continue a partial blocks response.
+	HelloFlagPartialBlocks = 0x0002
+	// HelloFlagBlocksStream indicates that the peer can serve and consume the blocks in a stream.
+	HelloFlagBlocksStream = 0x0004
+	// HelloFlagPing indicates that the peer responds to the Ping messages.
+	HelloFlagPing = 0x0008
+)
+
 type HelloMessage struct {
 	PeerID          peer.ID          `cbor:"1,keyasint"`
 	Agent           string           `cbor:"2,keyasint"`
@@ -23,6 +34,7 @@ type HelloMessage struct {
 	GenesisHash     hash.Hash        `cbor:"8,keyasint"`
 	BlockHash       hash.Hash        `cbor:"9,keyasint"`
 	MyTimeUnixMilli int64            `cbor:"10,keyasint"`
+	Flags           int              `cbor:"11,keyasint"`
 }
 
 func NewHelloMessage(pid peer.ID, moniker string,
@@ -40,6 +52,8 @@ func NewHelloMessage(pid peer.ID, moniker string,
 	}
 }
 
+// BasicCheck checks that the message is signed.
+// The signature itself is verified by the HelloVerifier of the receiver.
 func (m *HelloMessage) BasicCheck() error {
 	if m.Signature == nil {
 		return errors.Error(errors.ErrInvalidSignature)
@@ -47,8 +61,8 @@ func (m *HelloMessage) BasicCheck() error {
 	if len(m.PublicKeys) == 0 {
 		return errors.Error(errors.ErrInvalidPublicKey)
 	}
-	aggPublicKey := bls.PublicKeyAggregate(m.PublicKeys...)
-	return aggPublicKey.Verify(m.SignBytes(), m.Signature)
+
+	return nil
 }
 
 func (m *HelloMessage) MyTime() time.Time {
@@ -67,15 +81,12 @@ func (m *HelloMessage) String() string {
 	return fmt.Sprintf("{%s %d %s}", m.Moniker, m.Height, m.Services)
 }
 
+// Sign signs the message with the BLS validator keys.
 func (m *HelloMessage) Sign(valKeys []*bls.ValidatorKey) {
-	signatures := make([]*bls.Signature, len(valKeys))
-	publicKeys := make([]*bls.PublicKey, len(valKeys))
-	signBytes := m.SignBytes()
-	for i, key := range valKeys {
-		signatures[i] = key.Sign(signBytes)
-		publicKeys[i] = key.PublicKey()
-	}
-	aggSignature := bls.SignatureAggregate(signatures...)
-	m.Signature = aggSignature
-	m.PublicKeys = publicKeys
+	m.SignWith(NewBLSHelloSigner(valKeys))
+}
+
+// SignWith signs the message with the given signer.
+func (m *HelloMessage) SignWith(signer HelloSigner) {
+	signer.SignHello(m)
 }
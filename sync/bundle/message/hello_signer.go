@@ -0,0 +1,61 @@
+package message
+
+import (
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// HelloSigner signs the Hello messages with the keys of the node.
+// It fills the signature and the public keys of the message.
+type HelloSigner interface {
+	SignHello(m *HelloMessage)
+}
+
+// HelloVerifier verifies the signature of the Hello messages.
+type HelloVerifier interface {
+	VerifyHello(m *HelloMessage) error
+}
+
+var (
+	_ HelloSigner   = &BLSHelloSigner{}
+	_ HelloVerifier = BLSHelloVerifier{}
+)
+
+// BLSHelloSigner signs the Hello messages with the BLS validator keys.
+// The signatures of all keys are aggregated into one signature.
+type BLSHelloSigner struct {
+	valKeys []*bls.ValidatorKey
+}
+
+func NewBLSHelloSigner(valKeys []*bls.ValidatorKey) *BLSHelloSigner {
+	return &BLSHelloSigner{
+		valKeys: valKeys,
+	}
+}
+
+func (s *BLSHelloSigner) SignHello(m *HelloMessage) {
+	signatures := make([]*bls.Signature, len(s.valKeys))
+	publicKeys := make([]*bls.PublicKey, len(s.valKeys))
+	signBytes := m.SignBytes()
+	for i, key := range s.valKeys {
+		signatures[i] = key.Sign(signBytes)
+		publicKeys[i] = key.PublicKey()
+	}
+	m.Signature = bls.SignatureAggregate(signatures...)
+	m.PublicKeys = publicKeys
+}
+
+// BLSHelloVerifier verifies the aggregated BLS signature of the Hello messages.
+type BLSHelloVerifier struct{}
+
+func (BLSHelloVerifier) VerifyHello(m *HelloMessage) error {
+	if m.Signature == nil {
+		return errors.Error(errors.ErrInvalidSignature)
+	}
+	if len(m.PublicKeys) == 0 {
+		return errors.Error(errors.ErrInvalidPublicKey)
+	}
+	aggPublicKey := bls.PublicKeyAggregate(m.PublicKeys...)
+
+	return aggPublicKey.Verify(m.SignBytes(), m.Signature)
+}
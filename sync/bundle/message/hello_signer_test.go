@@ -0,0 +1,66 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingSigner is a custom signer that delegates to the BLS signer
+// and records the signed messages.
+type recordingSigner struct {
+	*BLSHelloSigner
+	signed []*HelloMessage
+}
+
+func (s *recordingSigner) SignHello(m *HelloMessage) {
+	s.signed = append(s.signed, m)
+	s.BLSHelloSigner.SignHello(m)
+}
+
+func TestBLSHelloSigner(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
+
+	t.Run("Should produce the aggregated signature of the keys", func(t *testing.T) {
+		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, ts.RandHash(), ts.RandHash())
+		NewBLSHelloSigner(valKeys).SignHello(m)
+
+		signBytes := m.SignBytes()
+		expected := bls.SignatureAggregate(valKeys[0].Sign(signBytes), valKeys[1].Sign(signBytes))
+		assert.True(t, expected.EqualsTo(m.Signature))
+		assert.Equal(t, []*bls.PublicKey{valKeys[0].PublicKey(), valKeys[1].PublicKey()}, m.PublicKeys)
+		assert.NoError(t, BLSHelloVerifier{}.VerifyHello(m))
+	})
+
+	t.Run("Should be the same as signing with the keys", func(t *testing.T) {
+		m1 := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, ts.RandHash(), ts.RandHash())
+		m2 := *m1
+		m1.Sign(valKeys)
+		m2.SignWith(NewBLSHelloSigner(valKeys))
+
+		assert.True(t, m1.Signature.EqualsTo(m2.Signature))
+		assert.Equal(t, m1.PublicKeys, m2.PublicKeys)
+	})
+
+	t.Run("Should sign with a custom signer", func(t *testing.T) {
+		signer := &recordingSigner{BLSHelloSigner: NewBLSHelloSigner(valKeys)}
+		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, ts.RandHash(), ts.RandHash())
+		m.SignWith(signer)
+
+		assert.Equal(t, []*HelloMessage{m}, signer.signed)
+		assert.NoError(t, BLSHelloVerifier{}.VerifyHello(m))
+	})
+
+	t.Run("Should reject the signature of another message", func(t *testing.T) {
+		m := NewHelloMessage(ts.RandPeerID(), "Alice", 100, 0, ts.RandHash(), ts.RandHash())
+		NewBLSHelloSigner(valKeys).SignHello(m)
+		m.Agent = "tampered"
+
+		assert.ErrorIs(t, BLSHelloVerifier{}.VerifyHello(m), crypto.ErrInvalidSignature)
+	})
+}
@@ -25,7 +25,8 @@ func TestHelloMessage(t *testing.T) {
 		m.Sign([]*bls.ValidatorKey{valKey})
 		m.Signature = ts.RandBLSSignature()
 
-		assert.ErrorIs(t, crypto.ErrInvalidSignature, m.BasicCheck())
+		assert.NoError(t, m.BasicCheck())
+		assert.ErrorIs(t, crypto.ErrInvalidSignature, BLSHelloVerifier{}.VerifyHello(m))
 	})
 
 	t.Run("Signature is nil", func(t *testing.T) {
@@ -32,6 +32,33 @@ func (c ResponseCode) String() string {
 	return fmt.Sprintf("%d", c)
 }
 
+// RejectCode describes why a request was rejected.
+type RejectCode int
+
+const (
+	RejectCodeUnspecified   = RejectCode(0)
+	RejectCodePeerNotKnown  = RejectCode(1) // The requester is unknown or banned
+	RejectCodeOutOfRange    = RejectCode(2) // The requested heights are not served
+	RejectCodeTooManyBlocks = RejectCode(3) // Too many blocks are requested
+	RejectCodeBusy          = RejectCode(4) // The peer is busy, try again later
+)
+
+func (c RejectCode) String() string {
+	switch c {
+	case RejectCodeUnspecified:
+		return "unspecified"
+	case RejectCodePeerNotKnown:
+		return "peer-not-known"
+	case RejectCodeOutOfRange:
+		return "out-of-range"
+	case RejectCodeTooManyBlocks:
+		return "too-many-blocks"
+	case RejectCodeBusy:
+		return "busy"
+	}
+	return fmt.Sprintf("%d", c)
+}
+
 type Type int32
 
 const (
@@ -46,6 +73,9 @@ const (
 	TypeBlockAnnounce  = Type(8)
 	TypeBlocksRequest  = Type(9)
 	TypeBlocksResponse = Type(10)
+	TypePeers          = Type(11)
+	TypePing           = Type(12)
+	TypePong           = Type(13)
 )
 
 func (t Type) TopicID() network.TopicID {
@@ -86,6 +116,12 @@ func (t Type) String() string {
 		return "blocks-req"
 	case TypeBlocksResponse:
 		return "blocks-res"
+	case TypePeers:
+		return "peers"
+	case TypePing:
+		return "ping"
+	case TypePong:
+		return "pong"
 	}
 	return fmt.Sprintf("%d", t)
 }
@@ -112,6 +148,12 @@ func MakeMessage(t Type) Message {
 		return &BlocksRequestMessage{}
 	case TypeBlocksResponse:
 		return &BlocksResponseMessage{}
+	case TypePeers:
+		return &PeersMessage{}
+	case TypePing:
+		return &PingMessage{}
+	case TypePong:
+		return &PongMessage{}
 	}
 
 	//
@@ -0,0 +1,49 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// MaxPeerAddresses is the maximum number of peer addresses in a Peers message.
+const MaxPeerAddresses = 32
+
+// PeersMessage shares a sample of the known peers, as part of the peer exchange.
+// Each address is a full multiaddress that includes the peer ID, like
+// "/ip4/1.2.3.4/tcp/21888/p2p/12D3KooW...".
+type PeersMessage struct {
+	Addresses []string `cbor:"1,keyasint"`
+}
+
+func NewPeersMessage(addrs []string) *PeersMessage {
+	return &PeersMessage{
+		Addresses: addrs,
+	}
+}
+
+func (m *PeersMessage) BasicCheck() error {
+	if len(m.Addresses) == 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "no address")
+	}
+	if len(m.Addresses) > MaxPeerAddresses {
+		return errors.Errorf(errors.ErrInvalidMessage,
+			"too many addresses: %d, max: %d", len(m.Addresses), MaxPeerAddresses)
+	}
+	for _, addr := range m.Addresses {
+		if _, err := peer.AddrInfoFromString(addr); err != nil {
+			return errors.Errorf(errors.ErrInvalidMessage, "invalid address %s: %s", addr, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (m *PeersMessage) Type() Type {
+	return TypePeers
+}
+
+func (m *PeersMessage) String() string {
+	return fmt.Sprintf("{%d}", len(m.Addresses))
+}
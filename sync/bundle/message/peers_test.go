@@ -0,0 +1,49 @@
+package message
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeersType(t *testing.T) {
+	m := &PeersMessage{}
+	assert.Equal(t, m.Type(), TypePeers)
+}
+
+func TestPeersMessage(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("No address", func(t *testing.T) {
+		m := NewPeersMessage(nil)
+
+		assert.Equal(t, errors.Code(m.BasicCheck()), errors.ErrInvalidMessage)
+	})
+
+	t.Run("Too many addresses", func(t *testing.T) {
+		addrs := make([]string, MaxPeerAddresses+1)
+		for i := range addrs {
+			addrs[i] = fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", ts.RandPeerID())
+		}
+		m := NewPeersMessage(addrs)
+
+		assert.Equal(t, errors.Code(m.BasicCheck()), errors.ErrInvalidMessage)
+	})
+
+	t.Run("Address without peer ID", func(t *testing.T) {
+		m := NewPeersMessage([]string{"/ip4/1.2.3.4/tcp/21888"})
+
+		assert.Equal(t, errors.Code(m.BasicCheck()), errors.ErrInvalidMessage)
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		addr := fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", ts.RandPeerID())
+		m := NewPeersMessage([]string{addr})
+
+		assert.NoError(t, m.BasicCheck())
+		assert.Contains(t, m.String(), "1")
+	})
+}
@@ -0,0 +1,21 @@
+package message
+
+// PingMessage is sent to the idle peers periodically, to measure their latency.
+// The peer responds to it immediately with a Pong message.
+type PingMessage struct{}
+
+func NewPingMessage() *PingMessage {
+	return &PingMessage{}
+}
+
+func (m *PingMessage) BasicCheck() error {
+	return nil
+}
+
+func (m *PingMessage) Type() Type {
+	return TypePing
+}
+
+func (m *PingMessage) String() string {
+	return "{}"
+}
@@ -0,0 +1,18 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingType(t *testing.T) {
+	m := &PingMessage{}
+	assert.Equal(t, m.Type(), TypePing)
+}
+
+func TestPingMessage(t *testing.T) {
+	m := NewPingMessage()
+	assert.NoError(t, m.BasicCheck())
+	assert.Equal(t, "{}", m.String())
+}
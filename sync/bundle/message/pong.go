@@ -0,0 +1,20 @@
+package message
+
+// PongMessage is the response to a Ping message.
+type PongMessage struct{}
+
+func NewPongMessage() *PongMessage {
+	return &PongMessage{}
+}
+
+func (m *PongMessage) BasicCheck() error {
+	return nil
+}
+
+func (m *PongMessage) Type() Type {
+	return TypePong
+}
+
+func (m *PongMessage) String() string {
+	return "{}"
+}
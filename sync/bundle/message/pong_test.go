@@ -0,0 +1,18 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPongType(t *testing.T) {
+	m := &PongMessage{}
+	assert.Equal(t, m.Type(), TypePong)
+}
+
+func TestPongMessage(t *testing.T) {
+	m := NewPongMessage()
+	assert.NoError(t, m.BasicCheck())
+	assert.Equal(t, "{}", m.String())
+}
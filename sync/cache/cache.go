@@ -1,39 +1,86 @@
 package cache
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/util"
 )
 
+const blockFileExt = ".blk"
+
+// Cache is safe for concurrent use, so the blocks can be downloaded from several sessions at the same time.
+// The LRU caches are thread safe by themselves, but adding or removing a block updates both caches
+// and the persisted file, and these updates should not be interleaved.
 type Cache struct {
-	blocks *lru.Cache[uint32, *block.Block] // it's thread safe
+	lk sync.RWMutex
+
+	blocks *lru.Cache[uint32, *block.Block]
 	certs  *lru.Cache[uint32, *certificate.Certificate]
+	path   string // the directory for persisting the blocks, empty means no persistence
 }
 
 func NewCache(size int) (*Cache, error) {
-	b, err := lru.New[uint32, *block.Block](size)
+	return newCache(size, "")
+}
+
+// NewPersistentCache creates a cache that keeps a copy of the blocks inside the given directory.
+// The persisted blocks are loaded back into the cache, so the node can resume syncing after restart.
+// The number of persisted blocks is bounded by the cache size,
+// and a persisted block is deleted once it's removed or evicted from the cache.
+func NewPersistentCache(size int, path string) (*Cache, error) {
+	c, err := newCache(size, path)
 	if err != nil {
 		return nil, err
 	}
 
-	c, err := lru.New[uint32, *certificate.Certificate](size)
+	if err := c.loadBlocks(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func newCache(size int, path string) (*Cache, error) {
+	c := &Cache{
+		path: path,
+	}
+
+	b, err := lru.NewWithEvict[uint32, *block.Block](size, c.onEvictBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := lru.New[uint32, *certificate.Certificate](size)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Cache{
-		blocks: b,
-		certs:  c,
-	}, nil
+	c.blocks = b
+	c.certs = certs
+
+	return c, nil
 }
 
 func (c *Cache) HasBlockInCache(height uint32) bool {
+	c.lk.RLock()
+	defer c.lk.RUnlock()
+
 	return c.blocks.Contains(height)
 }
 
 func (c *Cache) GetBlock(height uint32) *block.Block {
+	c.lk.RLock()
+	defer c.lk.RUnlock()
+
 	blk, ok := c.blocks.Get(height)
 	if ok {
 		return blk
@@ -43,16 +90,71 @@ func (c *Cache) GetBlock(height uint32) *block.Block {
 }
 
 func (c *Cache) AddBlock(blk *block.Block) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	height := c.addBlock(blk)
+
+	if c.path != "" {
+		// Persisting the blocks is the best effort.
+		// In case of failure, the block will be downloaded again after restart.
+		data, err := blk.Bytes()
+		if err == nil {
+			_ = util.WriteFile(c.blockPath(height), data)
+		}
+	}
+}
+
+func (c *Cache) addBlock(blk *block.Block) uint32 {
+	height := blockHeight(blk)
+	c.blocks.Add(height, blk)
+	if prvCert := blk.PrevCertificate(); prvCert != nil {
+		c.certs.Add(prvCert.Height(), prvCert)
+	}
+
+	return height
+}
+
+// blockHeight returns the height of the block based on its previous certificate.
+func blockHeight(blk *block.Block) uint32 {
 	prvCert := blk.PrevCertificate()
 	if prvCert == nil {
-		c.blocks.Add(1, blk)
-	} else {
-		c.blocks.Add(prvCert.Height()+1, blk)
-		c.certs.Add(prvCert.Height(), prvCert)
+		return 1
+	}
+
+	return prvCert.Height() + 1
+}
+
+// RemoveBlock removes the block and the certificate at the given height from the cache.
+func (c *Cache) RemoveBlock(height uint32) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	c.blocks.Remove(height)
+	c.certs.Remove(height)
+}
+
+// Prune removes the blocks and the certificates at or below the given height from the cache.
+func (c *Cache) Prune(height uint32) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	for _, h := range c.blocks.Keys() {
+		if h <= height {
+			c.blocks.Remove(h)
+		}
+	}
+	for _, h := range c.certs.Keys() {
+		if h <= height {
+			c.certs.Remove(h)
+		}
 	}
 }
 
 func (c *Cache) GetCertificate(height uint32) *certificate.Certificate {
+	c.lk.RLock()
+	defer c.lk.RUnlock()
+
 	cert, ok := c.certs.Get(height)
 	if ok {
 		return cert
@@ -62,6 +164,9 @@ func (c *Cache) GetCertificate(height uint32) *certificate.Certificate {
 }
 
 func (c *Cache) AddCertificate(cert *certificate.Certificate) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
 	if cert != nil {
 		c.certs.Add(cert.Height(), cert)
 	}
@@ -69,10 +174,78 @@ func (c *Cache) AddCertificate(cert *certificate.Certificate) {
 
 // Len returns the maximum number of items in the blocks and certificates cache.
 func (c *Cache) Len() int {
+	c.lk.RLock()
+	defer c.lk.RUnlock()
+
 	return util.Max(c.blocks.Len(), c.certs.Len())
 }
 
 func (c *Cache) Clear() {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
 	c.blocks.Purge()
 	c.certs.Purge()
 }
+
+func (c *Cache) blockPath(height uint32) string {
+	return filepath.Join(c.path, fmt.Sprintf("%d%s", height, blockFileExt))
+}
+
+func (c *Cache) onEvictBlock(height uint32, _ *block.Block) {
+	if c.path != "" {
+		_ = os.Remove(c.blockPath(height))
+	}
+}
+
+// loadBlocks loads the persisted blocks into the cache.
+// The files that can't be decoded are deleted.
+func (c *Cache) loadBlocks() error {
+	entries, err := os.ReadDir(c.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	heights := make([]uint32, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, blockFileExt) {
+			continue
+		}
+
+		height, err := strconv.ParseUint(strings.TrimSuffix(name, blockFileExt), 10, 32)
+		if err != nil {
+			continue
+		}
+		heights = append(heights, uint32(height))
+	}
+
+	// Loading the lower blocks first, so they are evicted first if the cache size is reduced.
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	// The unreadable or corrupted files are removed, they should not prevent the node from starting.
+	for _, height := range heights {
+		blk, err := c.readBlock(height)
+		if err != nil || blockHeight(blk) != height {
+			_ = os.Remove(c.blockPath(height))
+
+			continue
+		}
+		c.addBlock(blk)
+	}
+
+	return nil
+}
+
+func (c *Cache) readBlock(height uint32) (*block.Block, error) {
+	data, err := util.ReadFile(c.blockPath(height))
+	if err != nil {
+		return nil, err
+	}
+
+	return block.FromBytes(data)
+}
@@ -1,8 +1,14 @@
 package cache
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
@@ -90,3 +96,157 @@ func TestAddAgain(t *testing.T) {
 	cache.AddBlock(secondBlk)
 	assert.Equal(t, secondBlk, cache.GetBlock(height))
 }
+
+func TestPersistentCache(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	path := util.TempDirPath()
+
+	cache1, err := NewPersistentCache(10, path)
+	assert.NoError(t, err)
+
+	testHeight := ts.RandHeight()
+	blk1, _ := ts.GenerateTestBlock(testHeight)
+	blk2, _ := ts.GenerateTestBlock(testHeight + 1)
+	cache1.AddBlock(blk1)
+	cache1.AddBlock(blk2)
+
+	assert.FileExists(t, cache1.blockPath(testHeight))
+	assert.FileExists(t, cache1.blockPath(testHeight+1))
+
+	t.Run("Should load the persisted blocks", func(t *testing.T) {
+		cache2, err := NewPersistentCache(10, path)
+		assert.NoError(t, err)
+
+		assert.Equal(t, blk1.Hash(), cache2.GetBlock(testHeight).Hash())
+		assert.Equal(t, blk2.Hash(), cache2.GetBlock(testHeight+1).Hash())
+		assert.Equal(t, blk2.PrevCertificate().Hash(), cache2.GetCertificate(testHeight).Hash())
+	})
+
+	t.Run("Should delete the removed blocks", func(t *testing.T) {
+		cache1.RemoveBlock(testHeight)
+
+		assert.False(t, cache1.HasBlockInCache(testHeight))
+		assert.NoFileExists(t, cache1.blockPath(testHeight))
+	})
+
+	t.Run("Should delete the pruned blocks", func(t *testing.T) {
+		cache1.Prune(testHeight + 1)
+
+		assert.Zero(t, cache1.Len())
+		assert.NoFileExists(t, cache1.blockPath(testHeight+1))
+	})
+}
+
+func TestPersistentCacheEviction(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	path := util.TempDirPath()
+
+	cache, _ := NewPersistentCache(1, path)
+
+	testHeight := ts.RandHeight()
+	blk1, _ := ts.GenerateTestBlock(testHeight)
+	blk2, _ := ts.GenerateTestBlock(testHeight + 1)
+	cache.AddBlock(blk1)
+	cache.AddBlock(blk2)
+
+	assert.NoFileExists(t, cache.blockPath(testHeight))
+	assert.FileExists(t, cache.blockPath(testHeight+1))
+}
+
+func TestPersistentCacheInvalidFile(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	path := util.TempDirPath()
+
+	testHeight := ts.RandHeight()
+	invalidPath := filepath.Join(path, fmt.Sprintf("%d.blk", testHeight))
+	assert.NoError(t, util.WriteFile(invalidPath, []byte("invalid-data")))
+
+	cache, err := NewPersistentCache(10, path)
+	assert.NoError(t, err)
+
+	assert.False(t, cache.HasBlockInCache(testHeight))
+	assert.NoFileExists(t, invalidPath)
+}
+
+func TestPersistentCacheUnreadableFile(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	path := util.TempDirPath()
+
+	cache1, err := NewPersistentCache(10, path)
+	assert.NoError(t, err)
+
+	testHeight := ts.RandHeight()
+	blk, _ := ts.GenerateTestBlock(testHeight)
+	cache1.AddBlock(blk)
+
+	// A dangling symbolic link can't be read.
+	unreadablePath := filepath.Join(path, fmt.Sprintf("%d.blk", testHeight+1))
+	assert.NoError(t, os.Symlink(filepath.Join(path, "not-exist"), unreadablePath))
+
+	cache2, err := NewPersistentCache(10, path)
+	assert.NoError(t, err)
+
+	assert.True(t, cache2.HasBlockInCache(testHeight))
+	assert.False(t, cache2.HasBlockInCache(testHeight+1))
+	_, err = os.Lstat(unreadablePath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+// TestConcurrentAccess hammers the cache from several goroutines, like concurrent download sessions.
+// Run it with the race detector: `go test -race ./sync/cache/`.
+func TestConcurrentAccess(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	path := util.TempDirPath()
+
+	// A small cache, so the blocks are evicted while other goroutines are adding them.
+	cache, err := NewPersistentCache(16, path)
+	assert.NoError(t, err)
+
+	baseHeight := ts.RandHeight()
+	numWorkers := 8
+	blocksPerWorker := 8
+	blocks := make([]*block.Block, numWorkers*blocksPerWorker)
+	for i := range blocks {
+		blocks[i], _ = ts.GenerateTestBlock(baseHeight + uint32(i))
+	}
+
+	wg := sync.WaitGroup{}
+	for w := 0; w < numWorkers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < blocksPerWorker; i++ {
+				index := w*blocksPerWorker + i
+				height := baseHeight + uint32(index)
+				blk := blocks[index]
+
+				cache.AddBlock(blk)
+				cache.AddCertificate(blk.PrevCertificate())
+				if cache.HasBlockInCache(height) {
+					_ = cache.GetBlock(height)
+				}
+				_ = cache.GetCertificate(height - 1)
+				_ = cache.Len()
+
+				if i%3 == 0 {
+					cache.RemoveBlock(height)
+				}
+				if i%5 == 0 {
+					cache.Prune(height - 2)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	// The persisted blocks should match the blocks in the cache.
+	for i := range blocks {
+		height := baseHeight + uint32(i)
+		if cache.HasBlockInCache(height) {
+			assert.FileExists(t, cache.blockPath(height))
+		} else {
+			assert.NoFileExists(t, cache.blockPath(height))
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package sync
+
+// minClockSamples is the minimum number of peers that should report their clocks,
+// before the local clock can be judged as skewed.
+const minClockSamples = 3
+
+// checkClockSkew compares the local clock with the clocks that the accepted peers reported in the Hello messages.
+// The peers with a time discrepancy above the maximum clock skew are rejected, so the local clock is
+// considered skewed once the median offset exceeds half of the maximum clock skew.
+// A node with a skewed clock keeps syncing, but it doesn't take part in the consensus,
+// since it may misjudge the timing of the rounds and disrupt the consensus.
+func (sync *synchronizer) checkClockSkew() {
+	offset, samples := sync.peerSet.MedianClockOffset()
+	if samples < minClockSamples {
+		return
+	}
+
+	skewed := offset.Abs() > sync.config.MaxClockSkew/2
+	if sync.clockSkewed.Swap(skewed) == skewed {
+		return
+	}
+
+	if skewed {
+		sync.logger.Error("local clock is skewed, not taking part in the consensus. Please synchronize the clock",
+			"offset", offset, "max", sync.config.MaxClockSkew, "peers", samples)
+	} else {
+		sync.logger.Warn("local clock is synchronized again, taking part in the consensus",
+			"offset", offset, "peers", samples)
+	}
+
+	if err := sync.updateConsensusTopic(sync.numOfBlocksBehind()); err != nil {
+		sync.logger.Warn("unable to update consensus topic subscription", "error", err)
+	}
+}
+
+// participateInConsensus checks if the node should take part in the consensus.
+func (sync *synchronizer) participateInConsensus() bool {
+	return sync.config.RunConsensus && !sync.clockSkewed.Load()
+}
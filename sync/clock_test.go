@@ -0,0 +1,126 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClockSkew(t *testing.T) {
+	td := setup(t, nil)
+
+	sayHelloWithClockOffset := func(offset time.Duration, direction lp2pnetwork.Direction) (
+		peer.ID, *message.HelloAckMessage,
+	) {
+		pid := td.RandPeerID()
+		td.sync.peerSet.UpdateStatus(pid, peerset.StatusCodeConnected)
+		td.sync.peerSet.UpdateDirection(pid, direction)
+
+		msg := message.NewHelloMessage(pid, "kitty", td.state.LastBlockHeight(), service.New(service.Network),
+			td.state.LastBlockHash(), td.state.Genesis().Hash())
+		msg.MyTimeUnixMilli = msg.MyTime().Add(-offset).UnixMilli()
+		msg.Sign([]*bls.ValidatorKey{td.RandValKey()})
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+
+		return pid, bdl.Message.(*message.HelloAckMessage)
+	}
+
+	// Above half of the max clock skew, but the peers are still accepted.
+	offset := 8 * time.Second
+
+	t.Run("Inbound peers are not counted", func(t *testing.T) {
+		for i := 0; i < minClockSamples; i++ {
+			_, ack := sayHelloWithClockOffset(offset, lp2pnetwork.DirInbound)
+			assert.Equal(t, message.ResponseCodeOK, ack.ResponseCode)
+		}
+
+		assert.False(t, td.sync.clockSkewed.Load())
+	})
+
+	t.Run("Rejected peers are not counted", func(t *testing.T) {
+		for i := 0; i < minClockSamples; i++ {
+			_, ack := sayHelloWithClockOffset(time.Minute, lp2pnetwork.DirOutbound)
+			assert.Equal(t, message.ResponseCodeRejected, ack.ResponseCode)
+		}
+
+		assert.False(t, td.sync.clockSkewed.Load())
+	})
+
+	skewedPeers := []peer.ID{}
+	t.Run("Not enough peers to judge the local clock", func(t *testing.T) {
+		for i := 0; i < minClockSamples-1; i++ {
+			pid, ack := sayHelloWithClockOffset(offset, lp2pnetwork.DirOutbound)
+			assert.Equal(t, message.ResponseCodeOK, ack.ResponseCode)
+			skewedPeers = append(skewedPeers, pid)
+		}
+
+		assert.False(t, td.sync.clockSkewed.Load())
+		assert.True(t, td.sync.participateInConsensus())
+	})
+
+	t.Run("Local clock is skewed", func(t *testing.T) {
+		pid, ack := sayHelloWithClockOffset(offset, lp2pnetwork.DirOutbound)
+		assert.Equal(t, message.ResponseCodeOK, ack.ResponseCode)
+		skewedPeers = append(skewedPeers, pid)
+
+		assert.True(t, td.sync.clockSkewed.Load())
+		assert.False(t, td.sync.participateInConsensus())
+
+		// The time discrepancy is still checked.
+		_, ack = sayHelloWithClockOffset(time.Minute, lp2pnetwork.DirOutbound)
+		assert.Equal(t, message.ResponseCodeRejected, ack.ResponseCode)
+	})
+
+	t.Run("Disconnected peers are not counted", func(t *testing.T) {
+		for i := 0; i < minClockSamples-1; i++ {
+			sayHelloWithClockOffset(0, lp2pnetwork.DirOutbound)
+		}
+		assert.True(t, td.sync.clockSkewed.Load())
+
+		td.sync.processDisconnectEvent(&network.DisconnectEvent{PeerID: skewedPeers[0]})
+
+		assert.False(t, td.sync.clockSkewed.Load())
+		assert.False(t, td.sync.peerSet.GetPeer(skewedPeers[0]).HasClockOffset)
+		assert.True(t, td.sync.participateInConsensus())
+	})
+}
+
+func TestMedianClockOffset(t *testing.T) {
+	ps := peerset.NewPeerSet(time.Minute)
+
+	_, samples := ps.MedianClockOffset()
+	assert.Zero(t, samples)
+
+	addPeer := func(pid peer.ID, offset time.Duration, status peerset.StatusCode, direction lp2pnetwork.Direction) {
+		ps.UpdateStatus(pid, status)
+		ps.UpdateDirection(pid, direction)
+		ps.UpdateClockOffset(pid, offset)
+	}
+
+	addPeer("peer-1", 3*time.Second, peerset.StatusCodeKnown, lp2pnetwork.DirOutbound)
+	addPeer("peer-2", -time.Second, peerset.StatusCodeKnown, lp2pnetwork.DirOutbound)
+	addPeer("peer-3", time.Minute, peerset.StatusCodeConnected, lp2pnetwork.DirOutbound)
+	addPeer("peer-4", time.Hour, peerset.StatusCodeKnown, lp2pnetwork.DirInbound)
+	addPeer("peer-5", time.Hour, peerset.StatusCodeBanned, lp2pnetwork.DirOutbound)
+	addPeer("peer-6", time.Hour, peerset.StatusCodeDisconnected, lp2pnetwork.DirOutbound)
+	ps.UpdateStatus("peer-7", peerset.StatusCodeKnown)
+
+	offset, samples := ps.MedianClockOffset()
+	assert.Equal(t, 3, samples)
+	assert.Equal(t, 3*time.Second, offset)
+
+	ps.ResetClockOffset("peer-3")
+	offset, samples = ps.MedianClockOffset()
+	assert.Equal(t, 2, samples)
+	assert.Equal(t, -time.Second, offset)
+}
@@ -1,33 +1,230 @@
 package sync
 
 import (
+	"path"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/firewall"
+	"github.com/pactus-project/pactus/util/errors"
+	"golang.org/x/exp/slices"
 )
 
 var LatestBlockInterval = uint32(720) // 720 blocks is about two hours
 
+// StreamBlockInterval is the maximum number of blocks that can be requested at once
+// from the peers that serve the blocks in a stream.
+var StreamBlockInterval = uint32(8640) // 8640 blocks is about one day
+
+// ConsensusTopicBlockGap is the maximum number of blocks that the node can be behind the network
+// and still be subscribed to the consensus topic.
+var ConsensusTopicBlockGap = uint32(10)
+
 type Config struct {
-	Moniker         string           `toml:"moniker"`
-	SessionTimeout  time.Duration    `toml:"session_timeout"`
-	BlockPerMessage uint32           `toml:"block_per_message"` // TODO: Does the user need to change it?
-	CacheSize       int              `toml:"cache_size"`        // TODO: Does the user need to change it?
-	NodeNetwork     bool             `toml:"node_network"`
-	Firewall        *firewall.Config `toml:"firewall"`
+	Moniker             string           `toml:"moniker"`
+	SessionTimeout      time.Duration    `toml:"session_timeout"`
+	BlockRequestTimeout time.Duration    `toml:"block_request_timeout"`
+	StallTimeout        time.Duration    `toml:"stall_timeout"`
+	BlockPerMessage     uint32           `toml:"block_per_message"` // TODO: Does the user need to change it?
+	CacheSize           int              `toml:"cache_size"`        // TODO: Does the user need to change it?
+	CacheHighWatermark  int              `toml:"cache_high_watermark"`
+	CacheLowWatermark   int              `toml:"cache_low_watermark"`
+	NodeNetwork         bool             `toml:"node_network"`
+	Compression         bool             `toml:"compression"`
+	PartialBlocks       bool             `toml:"partial_blocks"`
+	ContinuationTimeout time.Duration    `toml:"continuation_timeout"`
+	MinSyncPeers        int              `toml:"min_sync_peers"`
+	MaxOpenSessions     int              `toml:"max_open_sessions"`
+	CheckpointHeight    uint32           `toml:"checkpoint_height"`
+	CheckpointHash      string           `toml:"checkpoint_hash"`
+	StrictCertificates  bool             `toml:"strict_certificates"`
+	UpdateInterval      time.Duration    `toml:"update_interval"`
+	UpdateJitter        int              `toml:"update_jitter"`
+	PendingPath         string           `toml:"pending_path"`
+	Topics              []string         `toml:"topics"`
+	MaxTxsPerMessage    int              `toml:"max_txs_per_message"`
+	MaxServeRatio       float64          `toml:"max_serve_ratio"`
+	FreeServeBlocks     int64            `toml:"free_serve_blocks"`
+	MaxSendFailures     int              `toml:"max_send_failures"`
+	SuspendDuration     time.Duration    `toml:"suspend_duration"`
+	IdleTimeout         time.Duration    `toml:"idle_timeout"`
+	DeniedAgents        []string         `toml:"denied_agents"`
+	ProtectedPeers      []string         `toml:"protected_peers"`
+	BroadcastBacklog    int              `toml:"broadcast_backlog"`
+	PexInterval         time.Duration    `toml:"pex_interval"`
+	PexSampleSize       int              `toml:"pex_sample_size"`
+	PingInterval        time.Duration    `toml:"ping_interval"`
+	MaxClockSkew        time.Duration    `toml:"max_clock_skew"`
+	Firewall            *firewall.Config `toml:"firewall"`
+
+	// RunConsensus is false when the node doesn't take part in the consensus.
+	// In this case, the consensus messages are ignored.
+	// It is set by the node, based on the node mode.
+	RunConsensus bool `toml:"-"`
+
+	// MinConns is the minimum number of connected peers for the node to be healthy.
+	// It is set by the node, based on the network config.
+	MinConns int `toml:"-"`
+
+	// ForcePrivateNetwork is true when the node connects to the peers in the private networks.
+	// It is set by the node, based on the network config.
+	ForcePrivateNetwork bool `toml:"-"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		SessionTimeout:  time.Second * 10,
-		NodeNetwork:     true,
-		BlockPerMessage: 60,
-		CacheSize:       50000,
-		Firewall:        firewall.DefaultConfig(),
+		SessionTimeout:      time.Second * 10,
+		BlockRequestTimeout: time.Second * 60,
+		StallTimeout:        time.Minute * 5,
+		NodeNetwork:         true,
+		Compression:         true,
+		PartialBlocks:       true,
+		ContinuationTimeout: time.Second * 3,
+		MinSyncPeers:        1,
+		MaxOpenSessions:     8,
+		StrictCertificates:  false,
+		UpdateInterval:      time.Second * 10,
+		UpdateJitter:        10,
+		PendingPath:         "pending_blocks",
+		Topics:              []string{network.TopicIDGeneral.String(), network.TopicIDConsensus.String()},
+		MaxTxsPerMessage:    100,
+		MaxServeRatio:       0,
+		FreeServeBlocks:     8640,
+		MaxSendFailures:     3,
+		SuspendDuration:     time.Minute * 5,
+		IdleTimeout:         0,
+		DeniedAgents:        []string{},
+		ProtectedPeers:      []string{},
+		BroadcastBacklog:    100,
+		PexInterval:         time.Minute * 5,
+		PexSampleSize:       16,
+		PingInterval:        time.Minute,
+		MaxClockSkew:        time.Second * 10,
+		BlockPerMessage:     60,
+		CacheSize:           50000,
+		CacheHighWatermark:  90,
+		CacheLowWatermark:   50,
+		Firewall:            firewall.DefaultConfig(),
+		RunConsensus:        true,
 	}
 }
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
-	return nil
+	if !isValidMoniker(conf.Moniker) {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"moniker should contain only printable characters and be at most %d characters", MaxMonikerLength)
+	}
+	if conf.MinSyncPeers < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "min sync peers can't be negative")
+	}
+	if conf.MaxOpenSessions <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max open sessions should be positive")
+	}
+	if conf.BlockRequestTimeout < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "block request timeout can't be negative")
+	}
+	if conf.ContinuationTimeout < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "continuation timeout can't be negative")
+	}
+	if conf.StallTimeout < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "stall timeout can't be negative")
+	}
+	if conf.UpdateInterval < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "update interval can't be negative")
+	}
+	if conf.UpdateJitter < 0 || conf.UpdateJitter > 50 {
+		return errors.Errorf(errors.ErrInvalidConfig, "update jitter should be between 0 and 50 percent")
+	}
+	if conf.CacheHighWatermark <= 0 || conf.CacheHighWatermark > 100 {
+		return errors.Errorf(errors.ErrInvalidConfig, "cache high watermark should be between 1 and 100 percent")
+	}
+	if conf.CacheLowWatermark < 0 || conf.CacheLowWatermark > conf.CacheHighWatermark {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"cache low watermark should be between 0 and the high watermark")
+	}
+	if conf.MaxTxsPerMessage <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max transactions per message should be positive")
+	}
+	if conf.MaxServeRatio < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max serve ratio can't be negative")
+	}
+	if conf.FreeServeBlocks < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "free serve blocks can't be negative")
+	}
+	if conf.MaxSendFailures < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max send failures can't be negative")
+	}
+	if conf.SuspendDuration < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "suspend duration can't be negative")
+	}
+	if conf.IdleTimeout < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "idle timeout can't be negative")
+	}
+	if conf.BroadcastBacklog < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "broadcast backlog can't be negative")
+	}
+	if conf.PexInterval < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "pex interval can't be negative")
+	}
+	if conf.PexSampleSize <= 0 || conf.PexSampleSize > message.MaxPeerAddresses {
+		return errors.Errorf(errors.ErrInvalidConfig,
+			"pex sample size should be between 1 and %d", message.MaxPeerAddresses)
+	}
+	if conf.PingInterval < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "ping interval can't be negative")
+	}
+	if conf.MaxClockSkew <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max clock skew should be positive")
+	}
+	for _, pattern := range conf.DeniedAgents {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid denied agent pattern: %s", pattern)
+		}
+	}
+	for _, pid := range conf.ProtectedPeers {
+		if _, err := peer.Decode(pid); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid protected peer: %s", pid)
+		}
+	}
+	for _, topic := range conf.Topics {
+		if topic != network.TopicIDGeneral.String() && topic != network.TopicIDConsensus.String() {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid topic: %s", topic)
+		}
+	}
+	if !conf.HasTopic(network.TopicIDGeneral) {
+		return errors.Errorf(errors.ErrInvalidConfig, "the general topic should always be joined")
+	}
+	if conf.CheckpointHeight > 0 {
+		if _, err := hash.FromString(conf.CheckpointHash); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid checkpoint hash: %s", err.Error())
+		}
+	} else if conf.CheckpointHash != "" {
+		return errors.Errorf(errors.ErrInvalidConfig, "checkpoint height is not defined")
+	}
+	return conf.Firewall.BasicCheck()
+}
+
+// IsAgentDenied checks if the agent matches any of the denied agent patterns.
+func (conf *Config) IsAgentDenied(agent string) bool {
+	for _, pattern := range conf.DeniedAgents {
+		if matched, _ := path.Match(pattern, agent); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsPeerProtected checks if the peer is in the protected peers.
+func (conf *Config) IsPeerProtected(pid peer.ID) bool {
+	return slices.Contains(conf.ProtectedPeers, pid.String())
+}
+
+// HasTopic checks if the node should join the given topic.
+func (conf *Config) HasTopic(topicID network.TopicID) bool {
+	return slices.Contains(conf.Topics, topicID.String())
 }
@@ -1,8 +1,12 @@
 package sync
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,3 +14,256 @@ func TestDefaultConfigCheck(t *testing.T) {
 	c := DefaultConfig()
 	assert.NoError(t, c.BasicCheck())
 }
+
+func TestInvalidMinSyncPeers(t *testing.T) {
+	c := DefaultConfig()
+	c.MinSyncPeers = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidMaxOpenSessions(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxOpenSessions = 0
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidMaxTxsPerMessage(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxTxsPerMessage = 0
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidServePolicy(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxServeRatio = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.FreeServeBlocks = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidBroadcastBacklog(t *testing.T) {
+	c := DefaultConfig()
+	c.BroadcastBacklog = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidPex(t *testing.T) {
+	c := DefaultConfig()
+	c.PexInterval = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.PexInterval = 0
+	assert.NoError(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.PexSampleSize = 0
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.PexSampleSize = message.MaxPeerAddresses + 1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidPingInterval(t *testing.T) {
+	c := DefaultConfig()
+	c.PingInterval = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.PingInterval = 0
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestInvalidMaxClockSkew(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxClockSkew = 0
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidSendFailures(t *testing.T) {
+	c := DefaultConfig()
+	c.MaxSendFailures = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.SuspendDuration = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidMoniker(t *testing.T) {
+	c := DefaultConfig()
+	c.Moniker = "moniker\n"
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.Moniker = strings.Repeat("a", MaxMonikerLength+1)
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.Moniker = "Pactus ✨ node"
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestCheckpointConfig(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Invalid checkpoint hash", func(t *testing.T) {
+		c := DefaultConfig()
+		c.CheckpointHeight = ts.RandHeight()
+		c.CheckpointHash = "invalid"
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Checkpoint hash without height", func(t *testing.T) {
+		c := DefaultConfig()
+		c.CheckpointHash = ts.RandHash().String()
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		c := DefaultConfig()
+		c.CheckpointHeight = ts.RandHeight()
+		c.CheckpointHash = ts.RandHash().String()
+		assert.NoError(t, c.BasicCheck())
+	})
+}
+
+func TestInvalidBlockRequestTimeout(t *testing.T) {
+	c := DefaultConfig()
+	c.BlockRequestTimeout = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.BlockRequestTimeout = 0
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestInvalidStallTimeout(t *testing.T) {
+	c := DefaultConfig()
+	c.StallTimeout = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.StallTimeout = 0
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestInvalidContinuationTimeout(t *testing.T) {
+	c := DefaultConfig()
+	c.ContinuationTimeout = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.ContinuationTimeout = 0
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestInvalidIdleTimeout(t *testing.T) {
+	c := DefaultConfig()
+	c.IdleTimeout = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.IdleTimeout = 0
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestInvalidUpdateInterval(t *testing.T) {
+	c := DefaultConfig()
+	c.UpdateInterval = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.UpdateJitter = 51
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.UpdateJitter = -1
+	assert.Error(t, c.BasicCheck())
+}
+
+func TestInvalidCacheWatermarks(t *testing.T) {
+	c := DefaultConfig()
+	c.CacheHighWatermark = 0
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.CacheHighWatermark = 101
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.CacheLowWatermark = -1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.CacheLowWatermark = c.CacheHighWatermark + 1
+	assert.Error(t, c.BasicCheck())
+
+	c = DefaultConfig()
+	c.CacheLowWatermark = c.CacheHighWatermark
+	assert.NoError(t, c.BasicCheck())
+}
+
+func TestProtectedPeersConfig(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Invalid peer ID", func(t *testing.T) {
+		c := DefaultConfig()
+		c.ProtectedPeers = []string{"invalid"}
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Protected peers", func(t *testing.T) {
+		pid := ts.RandPeerID()
+		c := DefaultConfig()
+		c.ProtectedPeers = []string{pid.String()}
+		assert.NoError(t, c.BasicCheck())
+
+		assert.True(t, c.IsPeerProtected(pid))
+		assert.False(t, c.IsPeerProtected(ts.RandPeerID()))
+	})
+}
+
+func TestTopicsConfig(t *testing.T) {
+	t.Run("Invalid topic", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Topics = []string{"general", "invalid"}
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Without general topic", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Topics = []string{"consensus"}
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Only general topic", func(t *testing.T) {
+		c := DefaultConfig()
+		c.Topics = []string{"general"}
+		assert.NoError(t, c.BasicCheck())
+		assert.True(t, c.HasTopic(network.TopicIDGeneral))
+		assert.False(t, c.HasTopic(network.TopicIDConsensus))
+	})
+}
+
+func TestDeniedAgentsConfig(t *testing.T) {
+	t.Run("Invalid pattern", func(t *testing.T) {
+		c := DefaultConfig()
+		c.DeniedAgents = []string{"pactus/[1.0"}
+		assert.Error(t, c.BasicCheck())
+	})
+
+	t.Run("Matching agents", func(t *testing.T) {
+		c := DefaultConfig()
+		c.DeniedAgents = []string{"pactus/1.0.*", "other/*"}
+		assert.NoError(t, c.BasicCheck())
+
+		assert.True(t, c.IsAgentDenied("pactus/1.0.3"))
+		assert.True(t, c.IsAgentDenied("other/0.1.0"))
+		assert.False(t, c.IsAgentDenied("pactus/1.1.0"))
+		assert.False(t, c.IsAgentDenied(""))
+	})
+}
@@ -1,16 +1,42 @@
 package firewall
 
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
 type Config struct {
-	Enabled bool `toml:"enable"`
+	Enabled              bool          `toml:"enable"`
+	SeenBundlesCacheSize int           `toml:"seen_bundles_cache_size"`
+	SeenBundlesCacheTTL  time.Duration `toml:"seen_bundles_cache_ttl"`
+	MaxBundleSize        int64         `toml:"max_bundle_size"`
+	MaxBundlesPerSecond  int           `toml:"max_bundles_per_second"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Enabled: false,
+		Enabled:              false,
+		SeenBundlesCacheSize: 4096,
+		SeenBundlesCacheTTL:  30 * time.Second,
+		MaxBundleSize:        16 << 20, // 16 MiB
+		MaxBundlesPerSecond:  100,
 	}
 }
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
+	if conf.SeenBundlesCacheSize <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "seen bundles cache size should be positive")
+	}
+	if conf.SeenBundlesCacheTTL <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "seen bundles cache TTL should be positive")
+	}
+	if conf.MaxBundleSize <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max bundle size should be positive")
+	}
+	if conf.MaxBundlesPerSecond < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "max bundles per second should not be negative")
+	}
 	return nil
 }
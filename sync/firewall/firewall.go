@@ -3,36 +3,54 @@ package firewall
 import (
 	"bytes"
 	"io"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
+// rateLimitCacheSize is the maximum number of peers whose bundle arrival times are tracked.
+const rateLimitCacheSize = 1024
+
 // Firewall check packets before passing them to sync module.
 type Firewall struct {
-	config  *Config
-	network network.Network
-	peerSet *peerset.PeerSet
-	state   state.Facade
-	logger  *logger.SubLogger
+	config      *Config
+	network     network.Network
+	peerSet     *peerset.PeerSet
+	state       state.Facade
+	seenBundles *expirable.LRU[hash.Hash, message.Type]
+	arrivalsLk  sync.Mutex
+	arrivals    *expirable.LRU[peer.ID, []time.Time]
+	logger      *logger.SubLogger
 }
 
 func NewFirewall(conf *Config, net network.Network, peerSet *peerset.PeerSet, st state.Facade,
 	log *logger.SubLogger,
 ) *Firewall {
+	seenBundles := expirable.NewLRU[hash.Hash, message.Type](
+		conf.SeenBundlesCacheSize, nil, conf.SeenBundlesCacheTTL)
+	arrivals := expirable.NewLRU[peer.ID, []time.Time](
+		rateLimitCacheSize, nil, time.Second)
+
 	return &Firewall{
-		config:  conf,
-		network: net,
-		peerSet: peerSet,
-		state:   st,
-		logger:  log,
+		config:      conf,
+		network:     net,
+		peerSet:     peerSet,
+		state:       st,
+		seenBundles: seenBundles,
+		arrivals:    arrivals,
+		logger:      log,
 	}
 }
 
@@ -46,12 +64,36 @@ func (f *Firewall) OpenGossipBundle(data []byte, source, from peer.ID) *bundle.B
 		}
 	}
 
-	bdl, err := f.openBundle(bytes.NewReader(data), source)
+	// The same bundle can be received from multiple peers in the gossip mesh.
+	// Drop the duplicated bundles before decoding them.
+	bdlHash := hash.CalcHash(data)
+	if msgType, ok := f.seenBundles.Get(bdlHash); ok {
+		f.peerSet.UpdateLastReceived(source)
+		f.peerSet.IncreaseReceivedBundlesCounter(source)
+		f.peerSet.IncreaseReceivedBytesCounter(source, msgType, int64(len(data)))
+
+		f.logger.Debug("firewall: duplicated gossip bundle", "source", source)
+		return nil
+	}
+
+	// Drop the bundles of a peer that sends them too frequently, before decoding them.
+	if f.isRateExceeded(source) {
+		f.peerSet.UpdateLastReceived(source)
+		f.peerSet.IncreaseReceivedBundlesCounter(source)
+		f.peerSet.IncreaseInvalidBundlesCounter(source)
+
+		f.logger.Debug("firewall: gossip bundle rate exceeded", "source", source)
+		return nil
+	}
+
+	lr := &limitedReader{r: bytes.NewReader(data), remaining: f.config.MaxBundleSize}
+	bdl, err := f.openBundle(bundle.NewDecoder(lr), lr, source)
 	if err != nil {
 		f.logger.Warn("firewall: unable to open a gossip bundle",
 			"error", err, "bundle", bdl, "source", source)
 		return nil
 	}
+	f.seenBundles.Add(bdlHash, bdl.Message.Type())
 
 	// TODO: check if gossip flag is set
 	// TODO: check if bundle is a gossip bundle
@@ -60,10 +102,46 @@ func (f *Firewall) OpenGossipBundle(data []byte, source, from peer.ID) *bundle.B
 }
 
 func (f *Firewall) OpenStreamBundle(r io.Reader, source peer.ID) *bundle.Bundle {
-	bdl, err := f.openBundle(r, source)
+	return f.NewStreamReader(r, source).Next()
+}
+
+// StreamReader opens the bundles of a stream one after another.
+type StreamReader struct {
+	firewall *Firewall
+	source   peer.ID
+	limiter  *limitedReader
+	decoder  *bundle.Decoder
+}
+
+// NewStreamReader returns a reader for opening the bundles of the stream.
+// Each bundle is limited separately by the maximum bundle size.
+func (f *Firewall) NewStreamReader(r io.Reader, source peer.ID) *StreamReader {
+	lr := &limitedReader{r: r}
+
+	return &StreamReader{
+		firewall: f,
+		source:   source,
+		limiter:  lr,
+		decoder:  bundle.NewDecoder(lr),
+	}
+}
+
+// More reports whether there are more bundles in the stream.
+func (s *StreamReader) More() bool {
+	s.limiter.reset(s.firewall.config.MaxBundleSize)
+
+	return s.decoder.More()
+}
+
+// Next opens the next bundle of the stream.
+// It returns nil if the bundle is invalid.
+func (s *StreamReader) Next() *bundle.Bundle {
+	s.limiter.reset(s.firewall.config.MaxBundleSize)
+
+	bdl, err := s.firewall.openBundle(s.decoder, s.limiter, s.source)
 	if err != nil {
-		f.logger.Warn("firewall: unable to open a stream bundle",
-			"error", err, "bundle", bdl, "source", source)
+		s.firewall.logger.Warn("firewall: unable to open a stream bundle",
+			"error", err, "bundle", bdl, "source", s.source)
 		return nil
 	}
 
@@ -73,7 +151,7 @@ func (f *Firewall) OpenStreamBundle(r io.Reader, source peer.ID) *bundle.Bundle
 	return bdl
 }
 
-func (f *Firewall) openBundle(r io.Reader, source peer.ID) (*bundle.Bundle, error) {
+func (f *Firewall) openBundle(dec *bundle.Decoder, lr *limitedReader, source peer.ID) (*bundle.Bundle, error) {
 	f.peerSet.UpdateLastReceived(source)
 	f.peerSet.IncreaseReceivedBundlesCounter(source)
 
@@ -83,7 +161,7 @@ func (f *Firewall) openBundle(r io.Reader, source peer.ID) (*bundle.Bundle, erro
 		return nil, errors.Errorf(errors.ErrInvalidMessage, "source peer is banned: %s", source)
 	}
 
-	bdl, err := f.decodeBundle(r, source)
+	bdl, err := f.decodeBundle(dec, lr, source)
 	if err != nil {
 		f.peerSet.IncreaseInvalidBundlesCounter(source)
 		return nil, err
@@ -97,9 +175,16 @@ func (f *Firewall) openBundle(r io.Reader, source peer.ID) (*bundle.Bundle, erro
 	return bdl, nil
 }
 
-func (f *Firewall) decodeBundle(r io.Reader, pid peer.ID) (*bundle.Bundle, error) {
+// decodeBundle decodes the next bundle. The reader of the decoder should be limited,
+// so an oversized bundle can't exhaust the memory.
+func (f *Firewall) decodeBundle(dec *bundle.Decoder, lr *limitedReader, pid peer.ID) (*bundle.Bundle, error) {
 	bdl := new(bundle.Bundle)
-	bytesRead, err := bdl.Decode(r)
+	bytesRead, err := dec.Decode(bdl)
+	if lr.exceeded {
+		f.peerSet.IncreaseReceivedBytesCounter(pid, message.TypeUnspecified, int64(bytesRead))
+		return nil, errors.Errorf(errors.ErrInvalidMessage,
+			"bundle size exceeds the limit of %d bytes", f.config.MaxBundleSize)
+	}
 	if err != nil {
 		f.peerSet.IncreaseReceivedBytesCounter(pid, message.TypeUnspecified, int64(bytesRead))
 		return nil, errors.Errorf(errors.ErrInvalidMessage, err.Error())
@@ -110,6 +195,11 @@ func (f *Firewall) decodeBundle(r io.Reader, pid peer.ID) (*bundle.Bundle, error
 }
 
 func (f *Firewall) checkBundle(bdl *bundle.Bundle, pid peer.ID) error {
+	// Drop the cross-network bundles as early as possible.
+	if err := f.checkNetworkFlags(bdl); err != nil {
+		return err
+	}
+
 	if err := bdl.BasicCheck(); err != nil {
 		return errors.Errorf(errors.ErrInvalidMessage, err.Error())
 	}
@@ -119,6 +209,10 @@ func (f *Firewall) checkBundle(bdl *bundle.Bundle, pid peer.ID) error {
 			"source is not same as initiator. source: %v, initiator: %v", pid, bdl.Initiator)
 	}
 
+	return nil
+}
+
+func (f *Firewall) checkNetworkFlags(bdl *bundle.Bundle) error {
 	switch f.state.Genesis().ChainType() {
 	case genesis.Mainnet:
 		if bdl.Flags&0x3 != bundle.BundleFlagNetworkMainnet {
@@ -142,6 +236,34 @@ func (f *Firewall) checkBundle(bdl *bundle.Bundle, pid peer.ID) error {
 	return nil
 }
 
+// isRateExceeded records the arrival of a bundle from the peer and checks
+// if the peer has sent more than `MaxBundlesPerSecond` bundles in the last second.
+func (f *Firewall) isRateExceeded(pid peer.ID) bool {
+	if !f.config.Enabled || f.config.MaxBundlesPerSecond == 0 {
+		return false
+	}
+
+	f.arrivalsLk.Lock()
+	defer f.arrivalsLk.Unlock()
+
+	now := util.Now()
+	times, _ := f.arrivals.Get(pid)
+
+	// Keep only the arrival times within the last second.
+	start := 0
+	for start < len(times) && now.Sub(times[start]) >= time.Second {
+		start++
+	}
+	times = times[start:]
+
+	if len(times) >= f.config.MaxBundlesPerSecond {
+		return true
+	}
+	f.arrivals.Add(pid, append(times, now))
+
+	return false
+}
+
 func (f *Firewall) isPeerBanned(pid peer.ID) bool {
 	if !f.config.Enabled {
 		return false
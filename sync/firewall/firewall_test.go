@@ -2,6 +2,7 @@ package firewall
 
 import (
 	"bytes"
+	"io"
 	"testing"
 	"time"
 
@@ -189,6 +190,75 @@ func TestStreamMessage(t *testing.T) {
 	})
 }
 
+// endlessReader produces an endless stream of zeros and counts the bytes read.
+type endlessReader struct {
+	bytesRead int64
+}
+
+func (r *endlessReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	r.bytesRead += int64(len(p))
+
+	return len(p), nil
+}
+
+func TestOversizedStreamBundle(t *testing.T) {
+	td := setup(t)
+
+	td.firewall.config.MaxBundleSize = 1024
+
+	// A CBOR byte string that claims to be 4 GiB long, followed by endless zeros.
+	header := []byte{0x5b, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}
+	endless := &endlessReader{}
+	r := io.MultiReader(bytes.NewReader(header), endless)
+
+	assert.Nil(t, td.firewall.OpenStreamBundle(r, td.goodPeerID))
+	assert.LessOrEqual(t, endless.bytesRead, td.firewall.config.MaxBundleSize)
+
+	p := td.firewall.peerSet.GetPeer(td.goodPeerID)
+	assert.Equal(t, 1, p.InvalidBundles)
+}
+
+func TestBundleWithinSizeLimit(t *testing.T) {
+	td := setup(t)
+
+	bdl := bundle.NewBundle(td.goodPeerID, message.NewBlocksRequestMessage(td.RandInt(100), 1, 100))
+	bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkTestnet)
+	d, _ := bdl.Encode()
+
+	td.firewall.config.MaxBundleSize = int64(len(d))
+	assert.NotNil(t, td.firewall.OpenStreamBundle(bytes.NewReader(d), td.goodPeerID))
+
+	td.firewall.config.MaxBundleSize = int64(len(d) - 1)
+	assert.Nil(t, td.firewall.OpenStreamBundle(bytes.NewReader(d), td.goodPeerID))
+}
+
+func TestStreamReader(t *testing.T) {
+	td := setup(t)
+
+	bdl1 := bundle.NewBundle(td.goodPeerID, message.NewBlocksRequestMessage(td.RandInt(100), 1, 100))
+	bdl1.Flags = util.SetFlag(bdl1.Flags, bundle.BundleFlagNetworkTestnet)
+	d1, _ := bdl1.Encode()
+	bdl2 := bundle.NewBundle(td.goodPeerID, message.NewBlocksRequestMessage(td.RandInt(100), 101, 100))
+	bdl2.Flags = util.SetFlag(bdl2.Flags, bundle.BundleFlagNetworkTestnet)
+	d2, _ := bdl2.Encode()
+
+	// Each bundle is limited separately.
+	td.firewall.config.MaxBundleSize = int64(util.Max(len(d1), len(d2)))
+
+	sr := td.firewall.NewStreamReader(bytes.NewReader(append(d1, d2...)), td.goodPeerID)
+	assert.Equal(t, bdl1.Message, sr.Next().Message)
+	assert.True(t, sr.More())
+	assert.Equal(t, bdl2.Message, sr.Next().Message)
+	assert.False(t, sr.More())
+
+	p := td.firewall.peerSet.GetPeer(td.goodPeerID)
+	assert.Equal(t, 2, p.ReceivedBundles)
+	assert.Zero(t, p.InvalidBundles)
+}
+
 func TestDisabledFirewall(t *testing.T) {
 	td := setup(t)
 
@@ -234,3 +304,98 @@ func TestNetworkFlags(t *testing.T) {
 	bdl.Flags = 1
 	assert.Error(t, td.firewall.checkBundle(bdl, td.goodPeerID))
 }
+
+func TestMismatchedNetworkFlags(t *testing.T) {
+	t.Run("Mainnet gossip bundle on testnet => should be dropped", func(t *testing.T) {
+		td := setup(t)
+
+		bdl := bundle.NewBundle(td.goodPeerID, message.NewQueryVotesMessage(100, 1))
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkMainnet)
+		d, _ := bdl.Encode()
+
+		assert.Nil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID, td.goodPeerID))
+		assert.Equal(t, 1, td.firewall.peerSet.GetPeer(td.goodPeerID).InvalidBundles)
+	})
+
+	t.Run("Mainnet stream bundle on testnet => should be dropped", func(t *testing.T) {
+		td := setup(t)
+
+		bdl := bundle.NewBundle(td.goodPeerID, message.NewBlocksRequestMessage(td.RandInt(100), 1, 100))
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkMainnet)
+		d, _ := bdl.Encode()
+
+		assert.Nil(t, td.firewall.OpenStreamBundle(bytes.NewReader(d), td.goodPeerID))
+		assert.Equal(t, 1, td.firewall.peerSet.GetPeer(td.goodPeerID).InvalidBundles)
+	})
+
+	t.Run("Bundle with both network flags => should be dropped", func(t *testing.T) {
+		td := setup(t)
+
+		bdl := bundle.NewBundle(td.goodPeerID, message.NewQueryVotesMessage(100, 1))
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkMainnet)
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkTestnet)
+		d, _ := bdl.Encode()
+
+		assert.Nil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID, td.goodPeerID))
+		assert.Equal(t, 1, td.firewall.peerSet.GetPeer(td.goodPeerID).InvalidBundles)
+	})
+}
+
+func TestDuplicatedGossipBundle(t *testing.T) {
+	td := setup(t)
+
+	bdl := bundle.NewBundle(td.goodPeerID, message.NewQueryVotesMessage(100, 1))
+	bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkTestnet)
+	d, _ := bdl.Encode()
+
+	assert.NotNil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID, td.goodPeerID))
+	assert.Nil(t, td.firewall.OpenGossipBundle(d, td.goodPeerID, td.unknownPeerID))
+
+	p := td.firewall.peerSet.GetPeer(td.goodPeerID)
+	assert.Equal(t, 2, p.ReceivedBundles)
+	assert.Equal(t, 0, p.InvalidBundles)
+	assert.Equal(t, int64(2*len(d)), p.ReceivedBytes[message.TypeQueryVotes])
+}
+
+func TestGossipBundleRateLimit(t *testing.T) {
+	td := setup(t)
+	td.firewall.config.MaxBundlesPerSecond = 5
+
+	gossipBundle := func(source peer.ID, height uint32) *bundle.Bundle {
+		bdl := bundle.NewBundle(source, message.NewQueryVotesMessage(height, 1))
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkTestnet)
+		d, _ := bdl.Encode()
+
+		return td.firewall.OpenGossipBundle(d, source, source)
+	}
+
+	t.Run("Burst from a single peer", func(t *testing.T) {
+		for i := uint32(1); i <= 5; i++ {
+			assert.NotNil(t, gossipBundle(td.goodPeerID, i))
+		}
+		assert.Nil(t, gossipBundle(td.goodPeerID, 6))
+		assert.Nil(t, gossipBundle(td.goodPeerID, 7))
+
+		p := td.firewall.peerSet.GetPeer(td.goodPeerID)
+		assert.Equal(t, 7, p.ReceivedBundles)
+		assert.Equal(t, 2, p.InvalidBundles)
+	})
+
+	t.Run("Other peers are not affected", func(t *testing.T) {
+		assert.NotNil(t, gossipBundle(td.unknownPeerID, 1))
+	})
+
+	t.Run("Accepts bundles again after a second", func(t *testing.T) {
+		time.Sleep(time.Second)
+
+		assert.NotNil(t, gossipBundle(td.goodPeerID, 8))
+	})
+
+	t.Run("Disabled rate limit", func(t *testing.T) {
+		td.firewall.config.MaxBundlesPerSecond = 0
+
+		for i := uint32(10); i <= 20; i++ {
+			assert.NotNil(t, gossipBundle(td.goodPeerID, i))
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package firewall
+
+import (
+	"errors"
+	"io"
+)
+
+var errBundleTooLarge = errors.New("bundle too large")
+
+// limitedReader reads from r but stops after the remaining bytes are consumed.
+// Unlike io.LimitReader, it remembers whether the decoder asked for more data
+// than allowed, so an oversized bundle can be told apart from a malformed one.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		l.exceeded = true
+
+		return 0, errBundleTooLarge
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+
+	return n, err
+}
+
+// reset allows reading the given number of bytes again.
+func (l *limitedReader) reset(remaining int64) {
+	l.remaining = remaining
+	l.exceeded = false
+}
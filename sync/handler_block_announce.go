@@ -20,6 +20,13 @@ func (handler *blockAnnounceHandler) ParseMessage(m message.Message, initiator p
 	msg := m.(*message.BlockAnnounceMessage)
 	handler.logger.Trace("parsing BlockAnnounce message", "message", msg)
 
+	if handler.paused.Load() {
+		handler.peerSet.UpdateHeight(initiator, msg.Height(), msg.Block.Hash())
+		handler.logger.Debug("sync is paused, ignoring the announced block", "height", msg.Height())
+
+		return nil
+	}
+
 	handler.cache.AddCertificate(msg.Certificate)
 	handler.cache.AddBlock(msg.Block)
 
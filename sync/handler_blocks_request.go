@@ -2,6 +2,7 @@ package sync
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/bundle"
@@ -25,8 +26,8 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, initiator p
 
 	p := handler.peerSet.GetPeer(initiator)
 	if !p.IsKnownOrTrusty() {
-		response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
-			fmt.Sprintf("peer status is %v", p.Status), msg.SessionID, 0, nil, nil)
+		response := message.NewBlocksRejectedMessage(message.RejectCodePeerNotKnown,
+			fmt.Sprintf("peer status is %v", p.Status), msg.SessionID)
 
 		return handler.respond(response, initiator)
 	}
@@ -34,8 +35,8 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, initiator p
 	if !handler.config.NodeNetwork {
 		ourHeight := handler.state.LastBlockHeight()
 		if msg.From < ourHeight-LatestBlockInterval {
-			response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
-				fmt.Sprintf("the request height is not acceptable: %v", msg.From), msg.SessionID, 0, nil, nil)
+			response := message.NewBlocksRejectedMessage(message.RejectCodeOutOfRange,
+				fmt.Sprintf("the request height is not acceptable: %v", msg.From), msg.SessionID)
 
 			return handler.respond(response, initiator)
 		}
@@ -43,13 +44,42 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, initiator p
 	height := msg.From
 	count := msg.Count
 
-	if count > LatestBlockInterval {
-		response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
-			fmt.Sprintf("too many blocks requested: %v-%v", msg.From, msg.Count), msg.SessionID, 0, nil, nil)
+	if msg.From > math.MaxUint32-count {
+		response := message.NewBlocksRejectedMessage(message.RejectCodeOutOfRange,
+			fmt.Sprintf("the request range is not acceptable: %v-%v", msg.From, msg.Count), msg.SessionID)
 
 		return handler.respond(response, initiator)
 	}
 
+	// The peers that consume the blocks in a stream can request larger ranges.
+	maxCount := LatestBlockInterval
+	if p.BlocksStream {
+		maxCount = StreamBlockInterval
+	}
+	if count > maxCount {
+		response := message.NewBlocksRejectedMessage(message.RejectCodeTooManyBlocks,
+			fmt.Sprintf("too many blocks requested: %v-%v", msg.From, msg.Count), msg.SessionID)
+
+		return handler.respond(response, initiator)
+	}
+
+	// Serving the peers that serve back first.
+	// The free riders are asked to try later, so they download the blocks from other peers.
+	if handler.config.MaxServeRatio > 0 &&
+		p.IsFreeRider(handler.config.MaxServeRatio, handler.config.FreeServeBlocks) {
+		response := message.NewBlocksRejectedMessage(message.RejectCodeBusy,
+			fmt.Sprintf("serving other peers first, served: %v, received: %v", p.ServedBlocks, p.ReceivedBlocks),
+			msg.SessionID)
+
+		return handler.respond(response, initiator)
+	}
+
+	// Large ranges are streamed to the peers that support it.
+	// The small ranges are served by the request/response bundles as before.
+	if p.BlocksStream && count > handler.config.BlockPerMessage {
+		return handler.startStreamBlocks(msg, initiator)
+	}
+
 	// Help this peer to sync up
 	for {
 		blockToRead := util.Min(handler.config.BlockPerMessage, count)
@@ -60,33 +90,119 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, initiator p
 
 		response := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
 			message.ResponseCodeMoreBlocks.String(), msg.SessionID, height, blocksData, nil)
+
+		height += uint32(len(blocksData))
+		count -= uint32(len(blocksData))
+		handler.peerSet.IncreaseServedBlocksCounter(initiator, int64(len(blocksData)))
+
+		// If the peer supports partial responses, we send only one message at a time.
+		// The peer acknowledges it by requesting the rest of the blocks from the continuation height.
+		if p.PartialBlocks && count > 0 && height <= handler.state.LastBlockHeight() {
+			response.Continuation = height
+
+			return handler.respond(response, initiator)
+		}
+
 		err := handler.respond(response, initiator)
 		if err != nil {
 			return err
 		}
 
-		height += uint32(len(blocksData))
-		count -= uint32(len(blocksData))
 		if count <= 0 {
 			break
 		}
 	}
+
+	return handler.respond(handler.finalResponse(msg, height), initiator)
+}
+
+// startStreamBlocks streams the blocks in its own goroutine, so a slow peer can't stall the receive loop.
+// If there are too many streams being served, the peer is asked to try later.
+func (handler *blocksRequestHandler) startStreamBlocks(msg *message.BlocksRequestMessage, to peer.ID) error {
+	select {
+	case handler.streamWriters <- struct{}{}:
+	default:
+		response := message.NewBlocksRejectedMessage(message.RejectCodeBusy,
+			"too many streams are being served", msg.SessionID)
+
+		return handler.respond(response, to)
+	}
+
+	go func() {
+		defer func() { <-handler.streamWriters }()
+
+		if err := handler.streamBlocks(msg, to); err != nil {
+			handler.logger.Debug("error on streaming blocks", "error", err, "to", to)
+		}
+	}()
+
+	return nil
+}
+
+// streamBlocks writes the requested blocks into a single stream.
+// The blocks are read from the store and written in batches,
+// so the memory usage is bounded regardless of the number of blocks.
+func (handler *blocksRequestHandler) streamBlocks(msg *message.BlocksRequestMessage, to peer.ID) error {
+	handler.logger.Info("streaming blocks", "message", msg, "to", to)
+
+	w, err := handler.network.OpenStream(to)
+	if err != nil {
+		handler.recordSendFailure(to)
+
+		return err
+	}
+	defer func() {
+		if err := w.Close(); err != nil {
+			handler.logger.Debug("error on closing stream", "error", err, "to", to)
+		}
+	}()
+
+	height := msg.From
+	count := msg.Count
+	for count > 0 {
+		blocksData := handler.prepareBlocks(height, count)
+		if len(blocksData) == 0 {
+			break
+		}
+
+		response := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+			message.ResponseCodeMoreBlocks.String(), msg.SessionID, height, blocksData, nil)
+
+		height += uint32(len(blocksData))
+		count -= uint32(len(blocksData))
+		handler.peerSet.IncreaseServedBlocksCounter(to, int64(len(blocksData)))
+
+		// A stalled peer shouldn't keep us writing forever.
+		// The deadline is renewed for each batch, so the long streams are not cut off.
+		handler.setWriteDeadline(w)
+		if err := handler.writeTo(w, response, to); err != nil {
+			return err
+		}
+	}
+
+	handler.setWriteDeadline(w)
+
+	return handler.writeTo(w, handler.finalResponse(msg, height), to)
+}
+
+// finalResponse returns the response that closes the session,
+// once the blocks are served up to the given height.
+func (handler *blocksRequestHandler) finalResponse(msg *message.BlocksRequestMessage,
+	height uint32,
+) *message.BlocksResponseMessage {
 	// To avoid sending blocks again, we update height for this peer
 	// Height is always greater than zeo.
 	peerHeight := height - 1
 
 	if msg.To() >= handler.state.LastBlockHeight() {
 		lastCert := handler.state.LastCertificate()
-		response := message.NewBlocksResponseMessage(message.ResponseCodeSynced,
-			message.ResponseCodeSynced.String(), msg.SessionID, peerHeight, nil, lastCert)
 
-		return handler.respond(response, initiator)
+		return message.NewBlocksResponseMessage(message.ResponseCodeSynced,
+			message.ResponseCodeSynced.String(), msg.SessionID, peerHeight, nil, lastCert)
 	}
 
-	response := message.NewBlocksResponseMessage(message.ResponseCodeNoMoreBlocks,
+	return message.NewBlocksResponseMessage(message.ResponseCodeNoMoreBlocks,
 		message.ResponseCodeNoMoreBlocks.String(), msg.SessionID, 0, nil, nil)
-
-	return handler.respond(response, initiator)
 }
 
 func (handler *blocksRequestHandler) PrepareBundle(m message.Message) *bundle.Bundle {
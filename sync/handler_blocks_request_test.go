@@ -2,10 +2,13 @@ package sync
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
+	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/service"
+	"github.com/pactus-project/pactus/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,6 +31,7 @@ func TestLatestBlocksRequestMessages(t *testing.T) {
 
 			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
 			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeRejected)
+			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).RejectCode, message.RejectCodePeerNotKnown)
 			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).From, uint32(0))
 		})
 
@@ -40,6 +44,7 @@ func TestLatestBlocksRequestMessages(t *testing.T) {
 
 			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
 			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeRejected)
+			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).RejectCode, message.RejectCodeOutOfRange)
 			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).From, uint32(0))
 		})
 
@@ -49,6 +54,25 @@ func TestLatestBlocksRequestMessages(t *testing.T) {
 
 			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
 			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeRejected)
+			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).RejectCode, message.RejectCodeTooManyBlocks)
+		})
+
+		t.Run("Request an oversized number of blocks", func(t *testing.T) {
+			msg := message.NewBlocksRequestMessage(sid, 10, math.MaxUint32-10)
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeRejected)
+			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).RejectCode, message.RejectCodeTooManyBlocks)
+		})
+
+		t.Run("Request a range beyond the maximum height", func(t *testing.T) {
+			msg := message.NewBlocksRequestMessage(sid, math.MaxUint32-10, 20)
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeRejected)
+			assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).RejectCode, message.RejectCodeOutOfRange)
 		})
 
 		t.Run("Accept request within `LatestBlockInterval`", func(t *testing.T) {
@@ -119,3 +143,254 @@ func TestLatestBlocksRequestMessages(t *testing.T) {
 		assert.ErrorIs(t, err, td.network.SendError)
 	})
 }
+
+func TestCompressedBlocksResponse(t *testing.T) {
+	config := testConfig()
+	config.Compression = true
+
+	td := setup(t, config)
+	sid := td.RandInt(100)
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+
+	td.state.CommitTestBlocks(31)
+	curHeight := td.state.LastBlockHeight()
+
+	pub1, _ := td.RandBLSKeyPair()
+	pub2, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub1, pid1, service.New(service.None))
+	td.addPeer(t, pub2, pid2, service.New(service.None))
+	td.sync.peerSet.UpdateCompression(pid1, true)
+
+	t.Run("Peer supports compression", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-1, 1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		bdl1 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, bdl1.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeMoreBlocks)
+		assert.True(t, util.IsFlagSet(bdl1.Flags, bundle.BundleFlagCompressed))
+
+		bdl2 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, bdl2.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeNoMoreBlocks)
+		assert.True(t, util.IsFlagSet(bdl2.Flags, bundle.BundleFlagCompressed))
+	})
+
+	t.Run("Peer doesn't support compression", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-1, 1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid2))
+
+		bdl1 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, bdl1.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeMoreBlocks)
+		assert.False(t, util.IsFlagSet(bdl1.Flags, bundle.BundleFlagCompressed))
+
+		bdl2 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, bdl2.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeNoMoreBlocks)
+		assert.False(t, util.IsFlagSet(bdl2.Flags, bundle.BundleFlagCompressed))
+	})
+}
+
+func TestPartialBlocksResponse(t *testing.T) {
+	config := testConfig()
+	config.BlockPerMessage = 2
+
+	td := setup(t, config)
+	sid := td.RandInt(100)
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+
+	td.state.CommitTestBlocks(31)
+	curHeight := td.state.LastBlockHeight()
+
+	pub1, _ := td.RandBLSKeyPair()
+	pub2, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub1, pid1, service.New(service.None))
+	td.addPeer(t, pub2, pid2, service.New(service.None))
+	td.sync.peerSet.UpdatePartialBlocks(pid1, true)
+
+	t.Run("Peer supports partial responses", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-4, 5)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		res := bdl.Message.(*message.BlocksResponseMessage)
+		assert.Equal(t, message.ResponseCodeMoreBlocks, res.ResponseCode)
+		assert.Equal(t, curHeight-4, res.From)
+		assert.Equal(t, uint32(2), res.Count())
+		assert.Equal(t, curHeight-2, res.Continuation)
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+	})
+
+	t.Run("Last part of the partial response", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-1, 2)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		bdl1 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		res1 := bdl1.Message.(*message.BlocksResponseMessage)
+		assert.Equal(t, message.ResponseCodeMoreBlocks, res1.ResponseCode)
+		assert.Equal(t, uint32(2), res1.Count())
+		assert.False(t, res1.HasContinuation())
+
+		bdl2 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeSynced, bdl2.Message.(*message.BlocksResponseMessage).ResponseCode)
+	})
+
+	t.Run("Peer doesn't support partial responses", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-4, 5)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid2))
+
+		for i := 0; i < 3; i++ {
+			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+			res := bdl.Message.(*message.BlocksResponseMessage)
+			assert.Equal(t, message.ResponseCodeMoreBlocks, res.ResponseCode)
+			assert.False(t, res.HasContinuation())
+		}
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeSynced, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
+	})
+}
+
+func TestStreamBlocksResponse(t *testing.T) {
+	td := setup(t, nil)
+	sid := td.RandInt(100)
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+
+	td.state.CommitTestBlocks(100)
+	curHeight := td.state.LastBlockHeight()
+
+	pub1, _ := td.RandBLSKeyPair()
+	pub2, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub1, pid1, service.New(service.None))
+	td.addPeer(t, pub2, pid2, service.New(service.None))
+	td.sync.peerSet.UpdateBlocksStream(pid1, true)
+
+	t.Run("Large range is streamed", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-29, 30)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		for _, count := range []uint32{11, 11, 8} {
+			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+			res := bdl.Message.(*message.BlocksResponseMessage)
+			assert.Equal(t, message.ResponseCodeMoreBlocks, res.ResponseCode)
+			assert.Equal(t, count, res.Count())
+			assert.False(t, res.HasContinuation())
+		}
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeSynced, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
+		assert.Equal(t, int64(30), td.sync.peerSet.GetPeer(pid1).ServedBlocks)
+	})
+
+	t.Run("Too many streams are being served", func(t *testing.T) {
+		for i := 0; i < maxStreamWriters; i++ {
+			td.sync.streamWriters <- struct{}{}
+		}
+
+		msg := message.NewBlocksRequestMessage(sid, curHeight-29, 30)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.RejectCodeBusy, bdl.Message.(*message.BlocksResponseMessage).RejectCode)
+
+		for i := 0; i < maxStreamWriters; i++ {
+			<-td.sync.streamWriters
+		}
+	})
+
+	t.Run("Small range is not streamed", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-9, 10)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		bdl1 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, uint32(10), bdl1.Message.(*message.BlocksResponseMessage).Count())
+
+		bdl2 := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeSynced, bdl2.Message.(*message.BlocksResponseMessage).ResponseCode)
+	})
+
+	t.Run("Peer doesn't support streams, too many blocks requested", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-29, 30)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid2))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.RejectCodeTooManyBlocks, bdl.Message.(*message.BlocksResponseMessage).RejectCode)
+	})
+
+	t.Run("Too many blocks requested for a stream", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, 1, StreamBlockInterval+1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.RejectCodeTooManyBlocks, bdl.Message.(*message.BlocksResponseMessage).RejectCode)
+	})
+}
+
+func TestServeReciprocity(t *testing.T) {
+	config := testConfig()
+	config.MaxServeRatio = 2
+	config.FreeServeBlocks = 10
+
+	td := setup(t, config)
+	sid := td.RandInt(100)
+	pid := td.RandPeerID()
+
+	td.state.CommitTestBlocks(31)
+	curHeight := td.state.LastBlockHeight()
+
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+
+	t.Run("Serve the free blocks", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-10, 11)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeMoreBlocks, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+
+		assert.Equal(t, int64(11), td.sync.peerSet.GetPeer(pid).ServedBlocks)
+	})
+
+	t.Run("Deprioritize the free rider", func(t *testing.T) {
+		msg := message.NewBlocksRequestMessage(sid, curHeight-1, 1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeRejected, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
+		assert.Equal(t, message.RejectCodeBusy, bdl.Message.(*message.BlocksResponseMessage).RejectCode)
+	})
+
+	t.Run("Serve again when the peer serves back", func(t *testing.T) {
+		td.sync.peerSet.IncreaseReceivedBlocksCounter(pid, 6)
+
+		msg := message.NewBlocksRequestMessage(sid, curHeight-1, 1)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksResponse)
+		assert.Equal(t, message.ResponseCodeMoreBlocks, bdl.Message.(*message.BlocksResponseMessage).ResponseCode)
+	})
+}
+
+func TestPrepareBlocks(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(31)
+	curHeight := td.state.LastBlockHeight()
+
+	t.Run("Oversized count is clamped to the blocks per message", func(t *testing.T) {
+		blocks := td.sync.prepareBlocks(1, math.MaxUint32)
+		assert.Len(t, blocks, int(td.config.BlockPerMessage))
+	})
+
+	t.Run("Count is clamped to the last block", func(t *testing.T) {
+		blocks := td.sync.prepareBlocks(curHeight-1, math.MaxUint32)
+		assert.Len(t, blocks, 2)
+	})
+
+	t.Run("Invalid from", func(t *testing.T) {
+		assert.Nil(t, td.sync.prepareBlocks(0, 1))
+		assert.Nil(t, td.sync.prepareBlocks(curHeight+1, 1))
+		assert.Nil(t, td.sync.prepareBlocks(math.MaxUint32, math.MaxUint32))
+	})
+}
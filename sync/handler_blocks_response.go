@@ -4,6 +4,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/types/block"
 )
 
@@ -20,9 +21,17 @@ func newBlocksResponseHandler(sync *synchronizer) messageHandler {
 func (handler *blocksResponseHandler) ParseMessage(m message.Message, initiator peer.ID) error {
 	msg := m.(*message.BlocksResponseMessage)
 	handler.logger.Trace("parsing BlocksResponse message", "message", msg)
+	handler.peerSet.FinishRoundTrip(initiator)
+
+	if handler.paused.Load() {
+		handler.logger.Debug("sync is paused, ignoring the blocks", "pid", initiator)
+
+		return nil
+	}
 
 	if msg.IsRequestRejected() {
-		handler.logger.Warn("blocks request is rejected", "pid", initiator, "reason", msg.Reason)
+		handler.logger.Warn("blocks request is rejected", "pid", initiator,
+			"code", msg.RejectCode, "reason", msg.Reason)
 	} else {
 		// TODO:
 		// It is good to check the latest height before adding blocks to the cache.
@@ -35,29 +44,35 @@ func (handler *blocksResponseHandler) ParseMessage(m message.Message, initiator
 				return err
 			}
 			handler.cache.AddBlock(blk)
+			handler.recordBlockSource(height, initiator)
 
 			height++
 		}
 		handler.cache.AddCertificate(msg.LastCertificate)
 		err := handler.tryCommitBlocks()
 		if err != nil {
+			if handler.config.StrictCertificates {
+				// Request the rejected blocks from other peers.
+				handler.updateBlockchain()
+			}
+
 			return err
 		}
 	}
 
-	handler.updateSession(msg.SessionID, initiator, msg.ResponseCode)
+	handler.updateSession(msg.SessionID, initiator, msg.ResponseCode, msg.RejectCode,
+		msg.From, uint32(len(msg.CommittedBlocksData)), msg.Continuation)
 
 	return nil
 }
 
 func (handler *blocksResponseHandler) PrepareBundle(m message.Message) *bundle.Bundle {
-	bdl := bundle.NewBundle(handler.SelfID(), m)
-	bdl.CompressIt()
-
-	return bdl
+	return bundle.NewBundle(handler.SelfID(), m)
 }
 
-func (handler *blocksResponseHandler) updateSession(sessionID int, pid peer.ID, code message.ResponseCode) {
+func (handler *blocksResponseHandler) updateSession(sessionID int, pid peer.ID,
+	code message.ResponseCode, rejectCode message.RejectCode, from, receivedBlocks, continuation uint32,
+) {
 	s := handler.peerSet.FindSession(sessionID)
 	if s == nil {
 		// TODO: test me
@@ -71,15 +86,44 @@ func (handler *blocksResponseHandler) updateSession(sessionID int, pid peer.ID,
 		return
 	}
 
+	// The remaining blocks might be requested again, if the response was late.
+	// In this case, the same response can be received twice.
+	if continuation != 0 && continuation == s.Continuation() {
+		handler.logger.Debug("duplicated partial response", "session-id", sessionID, "continuation", continuation)
+
+		return
+	}
+
 	s.SetLastResponseCode(code)
+	s.AddReceivedBlocks(receivedBlocks)
 
 	switch code {
 	case message.ResponseCodeRejected:
-		handler.logger.Debug("session rejected, close session", "session-id", sessionID)
+		s.SetLastRejectCode(rejectCode)
+		handler.logger.Debug("session rejected, close session",
+			"session-id", sessionID, "reject-code", rejectCode)
 		handler.peerSet.CloseSession(sessionID)
-		handler.updateBlockchain()
+
+		switch rejectCode {
+		case message.RejectCodeBusy:
+			// Back off. The blocks will be requested again in the next round.
+
+		case message.RejectCodePeerNotKnown:
+			// The peer doesn't accept our requests, stop asking it.
+			if !handler.config.IsPeerProtected(pid) {
+				handler.network.CloseConnection(pid)
+			}
+
+		default:
+			handler.updateBlockchain()
+		}
 
 	case message.ResponseCodeMoreBlocks:
+		if continuation != 0 {
+			handler.continueSession(s, from+receivedBlocks, continuation)
+
+			return
+		}
 		handler.logger.Debug("peer responding us. keep session open", "session-id", sessionID)
 
 	case message.ResponseCodeNoMoreBlocks:
@@ -93,3 +137,38 @@ func (handler *blocksResponseHandler) updateSession(sessionID int, pid peer.ID,
 		handler.moveConsensusToNewHeight()
 	}
 }
+
+// continueSession acknowledges a partial blocks response by requesting
+// the remaining blocks of the session from the continuation height.
+// The continuation height should be right after the received blocks,
+// otherwise the response is invalid and the session is closed.
+func (handler *blocksResponseHandler) continueSession(s *peerset.Session, expected, continuation uint32) {
+	sessionID := s.SessionID()
+	if continuation != expected {
+		handler.logger.Warn("invalid continuation height. close session", "session-id", sessionID,
+			"continuation", continuation, "expected", expected, "pid", s.PeerID())
+		handler.peerSet.IncreaseInvalidBundlesCounter(s.PeerID())
+		handler.peerSet.CloseSession(sessionID)
+
+		return
+	}
+
+	if s.Progress() >= s.RequestedBlocks() {
+		handler.logger.Debug("all requested blocks received. close session", "session-id", sessionID)
+		handler.peerSet.CloseSession(sessionID)
+		handler.updateBlockchain()
+
+		return
+	}
+
+	remaining := s.RequestedBlocks() - s.Progress()
+	handler.logger.Debug("partial response received. continue session",
+		"session-id", sessionID, "from", continuation, "remaining", remaining)
+
+	s.SetContinuation(continuation)
+	msg := message.NewBlocksRequestMessage(sessionID, continuation, remaining)
+	if err := handler.sendTo(msg, s.PeerID()); err != nil {
+		handler.logger.Warn("error on continuing session", "session-id", sessionID, "error", err)
+		handler.peerSet.CloseSession(sessionID)
+	}
+}
@@ -1,16 +1,20 @@
 package sync
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/service"
@@ -52,7 +56,9 @@ func TestInvalidBlockData(t *testing.T) {
 
 	for _, test := range tests {
 		pid := td.RandPeerID()
-		sid := td.sync.peerSet.OpenSession(pid).SessionID()
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.None))
+		sid := td.openSession(t, pid).SessionID()
 		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, message.ResponseCodeMoreBlocks.String(), sid,
 			lastHeight+1, [][]byte{test.data}, cert)
 
@@ -61,6 +67,69 @@ func TestInvalidBlockData(t *testing.T) {
 	}
 }
 
+func TestStrictCertificates(t *testing.T) {
+	receiveInvalidBlock := func(td *testData, from peer.ID) (int, error) {
+		td.state.CommitTestBlocks(10)
+
+		lastHeight := td.state.LastBlockHeight()
+		prevCert := td.GenerateTestCertificate(lastHeight)
+		cert := td.GenerateTestCertificate(lastHeight + 1)
+		blk := block.MakeBlock(1, time.Now(), nil, td.RandHash(), td.RandHash(),
+			prevCert, td.RandSeed(), td.RandValAddress())
+		data, _ := blk.Bytes()
+
+		sid := td.openSession(t, from).SessionID()
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, message.ResponseCodeMoreBlocks.String(), sid,
+			lastHeight+1, [][]byte{data}, cert)
+
+		return sid, td.receivingNewMessage(td.sync, msg, from)
+	}
+
+	t.Run("Should keep the invalid block in non-strict mode", func(t *testing.T) {
+		td := setup(t, nil)
+
+		pid := td.RandPeerID()
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+
+		_, err := receiveInvalidBlock(td, pid)
+		assert.Error(t, err)
+		assert.True(t, td.sync.cache.HasBlockInCache(td.state.LastBlockHeight()+1))
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.False(t, p.IsPenalized())
+		assert.Zero(t, p.ReceivedBlocks)
+	})
+
+	t.Run("Should reject the invalid block and request it from another peer", func(t *testing.T) {
+		config := testConfig()
+		config.StrictCertificates = true
+		config.MaxOpenSessions = 1
+		td := setup(t, config)
+
+		pids := []peer.ID{td.RandPeerID(), td.RandPeerID()}
+		for _, pid := range pids {
+			pub, _ := td.RandBLSKeyPair()
+			td.addPeer(t, pub, pid, service.New(service.Network))
+		}
+
+		for _, pid := range pids {
+			// The peers are ahead of us, so the blocks are requested again.
+			td.sync.peerSet.UpdateHeight(pid, 1000, td.RandHash())
+		}
+
+		sid, err := receiveInvalidBlock(td, pids[0])
+		assert.Error(t, err)
+		assert.False(t, td.sync.cache.HasBlockInCache(td.state.LastBlockHeight()+1))
+		p := td.sync.peerSet.GetPeer(pids[0])
+		assert.True(t, p.IsPenalized())
+		assert.Nil(t, td.sync.peerSet.FindSession(sid))
+
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.False(t, td.sync.peerSet.HasOpenSession(pids[0]))
+		assert.True(t, td.sync.peerSet.HasOpenSession(pids[1]))
+	})
+}
+
 func TestOneBlockShorter(t *testing.T) {
 	td := setup(t, nil)
 
@@ -72,13 +141,14 @@ func TestOneBlockShorter(t *testing.T) {
 	pub, _ := td.RandBLSKeyPair()
 	td.addPeer(t, pub, pid, service.New(service.None))
 
-	sid := td.sync.peerSet.OpenSession(pid).SessionID()
+	sid := td.openSession(t, pid).SessionID()
 	msg := message.NewBlocksResponseMessage(message.ResponseCodeSynced, t.Name(), sid,
 		lastHeight+1, [][]byte{d1}, cert1)
 	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
 
 	assert.Nil(t, td.sync.peerSet.FindSession(sid))
 	assert.Equal(t, td.state.LastBlockHeight(), lastHeight+1)
+	assert.Equal(t, int64(1), td.sync.peerSet.GetPeer(pid).ReceivedBlocks)
 }
 
 func TestStrippedPublicKey(t *testing.T) {
@@ -137,7 +207,7 @@ func TestStrippedPublicKey(t *testing.T) {
 
 	for _, test := range tests {
 		blkData, _ := test.blk.Bytes()
-		sid := td.sync.peerSet.OpenSession(pid).SessionID()
+		sid := td.openSession(t, pid).SessionID()
 		cert := td.GenerateTestCertificate(lastHeight + 1)
 		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, message.ResponseCodeRejected.String(), sid,
 			lastHeight+1, [][]byte{blkData}, cert)
@@ -147,6 +217,179 @@ func TestStrippedPublicKey(t *testing.T) {
 	}
 }
 
+func TestSessionProgress(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(10)
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.Network))
+
+	session := td.openSession(t, pid)
+	session.SetRequestedBlocks(LatestBlockInterval)
+
+	// Receiving the blocks that can't be committed yet.
+	from := td.state.LastBlockHeight() + 10
+	blk1, _ := td.GenerateTestBlock(from)
+	blk2, _ := td.GenerateTestBlock(from + 1)
+	blk3, _ := td.GenerateTestBlock(from + 2)
+	data1, _ := blk1.Bytes()
+	data2, _ := blk2.Bytes()
+	data3, _ := blk3.Bytes()
+
+	msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+		message.ResponseCodeMoreBlocks.String(), session.SessionID(), from, [][]byte{data1, data2}, nil)
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	assert.Equal(t, uint32(2), session.Progress())
+
+	msg = message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+		message.ResponseCodeMoreBlocks.String(), session.SessionID(), from+2, [][]byte{data3}, nil)
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	assert.Equal(t, uint32(3), session.Progress())
+	assert.Equal(t, LatestBlockInterval, session.RequestedBlocks())
+	// The blocks are not credited until they are committed.
+	assert.Zero(t, td.sync.peerSet.GetPeer(pid).ReceivedBlocks)
+}
+
+func TestStreamedBlocksResponse(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(10)
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.Network))
+
+	session := td.openSession(t, pid)
+	session.SetRequestedBlocks(StreamBlockInterval)
+
+	from := td.state.LastBlockHeight() + 10
+	blk1, _ := td.GenerateTestBlock(from)
+	blk2, _ := td.GenerateTestBlock(from + 1)
+	blk3, _ := td.GenerateTestBlock(from + 2)
+	data1, _ := blk1.Bytes()
+	data2, _ := blk2.Bytes()
+	data3, _ := blk3.Bytes()
+
+	// Writing all the bundles into a single stream.
+	stream := new(bytes.Buffer)
+	msg1 := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+		message.ResponseCodeMoreBlocks.String(), session.SessionID(), from, [][]byte{data1, data2}, nil)
+	msg2 := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+		message.ResponseCodeMoreBlocks.String(), session.SessionID(), from+2, [][]byte{data3}, nil)
+	for _, msg := range []message.Message{msg1, msg2} {
+		bdl := bundle.NewBundle(pid, msg)
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagCarrierLibP2P|bundle.BundleFlagNetworkTestnet)
+		data, _ := bdl.Encode()
+		stream.Write(data)
+	}
+
+	// The stream is read in its own goroutine and the bundles are processed by the receive loop.
+	td.network.EventCh <- &network.StreamMessage{
+		Source: pid,
+		Reader: io.NopCloser(stream),
+	}
+
+	assert.Eventually(t, func() bool {
+		return session.Progress() == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Zero(t, td.sync.peerSet.GetPeer(pid).ReceivedBlocks)
+}
+
+func TestTooManyStreamReaders(t *testing.T) {
+	td := setup(t, nil)
+
+	// The receive loop only accesses the queues after receiving the event.
+	for i := 0; i < maxStreamReaders; i++ {
+		td.sync.streamQueues[td.RandPeerID()] = make(chan *network.StreamMessage, maxQueuedStreams)
+	}
+
+	reader := &closeRecorder{Reader: new(bytes.Buffer)}
+	td.network.EventCh <- &network.StreamMessage{
+		Source: td.RandPeerID(),
+		Reader: reader,
+	}
+
+	assert.Eventually(t, reader.closed.Load, time.Second, 10*time.Millisecond)
+}
+
+// closeRecorder records closing the reader.
+type closeRecorder struct {
+	io.Reader
+	closed atomic.Bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed.Store(true)
+
+	return nil
+}
+
+func TestContinueSession(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(10)
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.Network))
+
+	from := td.state.LastBlockHeight() + 10
+	blk1, _ := td.GenerateTestBlock(from)
+	blk2, _ := td.GenerateTestBlock(from + 1)
+	data1, _ := blk1.Bytes()
+	data2, _ := blk2.Bytes()
+
+	t.Run("Request the remaining blocks from the continuation height", func(t *testing.T) {
+		session := td.openSession(t, pid)
+		session.SetRequestedBlocks(5)
+
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+			message.ResponseCodeMoreBlocks.String(), session.SessionID(), from, [][]byte{data1, data2}, nil)
+		msg.Continuation = from + 2
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		req := bdl.Message.(*message.BlocksRequestMessage)
+		assert.Equal(t, session.SessionID(), req.SessionID)
+		assert.Equal(t, from+2, req.From)
+		assert.Equal(t, uint32(3), req.Count)
+		assert.NotNil(t, td.sync.peerSet.FindSession(session.SessionID()))
+		assert.Equal(t, from+2, session.Continuation())
+
+		// The remaining blocks were requested again, and the same response is received twice.
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Equal(t, uint32(2), session.Progress())
+	})
+
+	t.Run("All requested blocks received", func(t *testing.T) {
+		session := td.openSession(t, pid)
+		session.SetRequestedBlocks(2)
+
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+			message.ResponseCodeMoreBlocks.String(), session.SessionID(), from, [][]byte{data1, data2}, nil)
+		msg.Continuation = from + 2
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		assert.Nil(t, td.sync.peerSet.FindSession(session.SessionID()))
+	})
+
+	t.Run("Invalid continuation height", func(t *testing.T) {
+		session := td.openSession(t, pid)
+		session.SetRequestedBlocks(5)
+		invalidBundles := td.sync.peerSet.GetPeer(pid).InvalidBundles
+
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+			message.ResponseCodeMoreBlocks.String(), session.SessionID(), from, [][]byte{data1, data2}, nil)
+		msg.Continuation = from + 100
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Nil(t, td.sync.peerSet.FindSession(session.SessionID()))
+		assert.Equal(t, invalidBundles+1, td.sync.peerSet.GetPeer(pid).InvalidBundles)
+	})
+}
+
 // TestSyncing is an important test to verify the syncing process between two
 // test nodes, Alice and Bob. In real-world scenarios, multiple nodes are typically
 // involved, but the procedure remains similar.
@@ -183,6 +426,8 @@ func TestSyncing(t *testing.T) {
 
 	sync1, err := NewSynchronizer(configAlice,
 		valKeyAlice,
+		message.NewBLSHelloSigner(valKeyAlice),
+		message.BLSHelloVerifier{},
 		stateAlice,
 		consMgrAlice,
 		networkAlice,
@@ -193,6 +438,8 @@ func TestSyncing(t *testing.T) {
 
 	sync2, err := NewSynchronizer(configBob,
 		valKeyBob,
+		message.NewBLSHelloSigner(valKeyBob),
+		message.BLSHelloVerifier{},
 		stateBob,
 		consMgrBob,
 		networkBob,
@@ -235,35 +482,29 @@ func TestSyncing(t *testing.T) {
 	assert.Equal(t, uint32(0), syncAlice.state.LastBlockHeight())
 	assert.Equal(t, uint32(100), syncBob.state.LastBlockHeight())
 
-	// Perform block syncing
+	// Perform block syncing.
+	// Both peers support streaming the blocks, so Alice requests larger ranges
+	// and Bob writes the blocks of each range into a single stream.
 	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksRequest)
-	shouldPublishMessageWithThisType(t, networkAlice, message.TypeBlocksRequest)
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 1-11
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 12-22
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 23-23
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // NoMoreBlock
-
-	shouldPublishMessageWithThisType(t, networkAlice, message.TypeBlocksRequest)
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 24-34
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 35-45
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 46-46
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // NoMoreBlock
-
-	shouldPublishMessageWithThisType(t, networkAlice, message.TypeBlocksRequest)
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 47-57
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 58-68
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 69-69
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // NoMoreBlock
-
-	shouldPublishMessageWithThisType(t, networkAlice, message.TypeBlocksRequest)
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 70-80
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 81-91
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // 92-92
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // NoMoreBlock
+	shouldPublishMessageWithThisType(t, networkAlice, message.TypeBlocksRequest) // 1-47
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 1-11
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 12-22
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 23-33
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 34-44
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 45-47
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // NoMoreBlock
+
+	shouldPublishMessageWithThisType(t, networkAlice, message.TypeBlocksRequest) // 48-94
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 48-58
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 59-69
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 70-80
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 81-91
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // 92-94
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)  // NoMoreBlock
 
 	// Last block requests
 	shouldPublishMessageWithThisType(t, networkAlice, message.TypeBlocksRequest)
-	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)        // 93-100
+	shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse)        // 95-100
 	bdl := shouldPublishMessageWithThisType(t, networkBob, message.TypeBlocksResponse) // Synced
 	assert.Equal(t, bdl.Message.(*message.BlocksResponseMessage).ResponseCode, message.ResponseCodeSynced)
 
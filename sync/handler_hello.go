@@ -2,7 +2,6 @@ package sync
 
 import (
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -24,6 +23,8 @@ func newHelloHandler(sync *synchronizer) messageHandler {
 
 func (handler *helloHandler) ParseMessage(m message.Message, initiator peer.ID) error {
 	msg := m.(*message.HelloMessage)
+	// The moniker is not part of the sign bytes, and it can be sanitized before logging it.
+	msg.Moniker = sanitizeMoniker(msg.Moniker)
 	handler.logger.Trace("parsing Hello message", "message", msg)
 
 	handler.logger.Debug("updating peer info",
@@ -36,6 +37,21 @@ func (handler *helloHandler) ParseMessage(m message.Message, initiator peer.ID)
 		msg.Agent,
 		msg.PublicKeys,
 		msg.Services)
+	handler.peerSet.UpdateCompression(initiator,
+		util.IsFlagSet(msg.Flags, message.HelloFlagCompression))
+	handler.peerSet.UpdatePartialBlocks(initiator,
+		util.IsFlagSet(msg.Flags, message.HelloFlagPartialBlocks))
+	handler.peerSet.UpdateBlocksStream(initiator,
+		util.IsFlagSet(msg.Flags, message.HelloFlagBlocksStream))
+	handler.peerSet.UpdatePingable(initiator,
+		util.IsFlagSet(msg.Flags, message.HelloFlagPing))
+
+	if err := handler.helloVerifier.VerifyHello(msg); err != nil {
+		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
+			fmt.Sprintf("invalid signature: %v", err))
+
+		return handler.acknowledge(response, initiator)
+	}
 
 	if msg.PeerID != initiator {
 		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
@@ -45,6 +61,13 @@ func (handler *helloHandler) ParseMessage(m message.Message, initiator peer.ID)
 		return handler.acknowledge(response, initiator)
 	}
 
+	if handler.config.IsAgentDenied(msg.Agent) {
+		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
+			fmt.Sprintf("agent is denied: %v", msg.Agent))
+
+		return handler.acknowledge(response, initiator)
+	}
+
 	if msg.GenesisHash != handler.state.Genesis().Hash() {
 		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
 			fmt.Sprintf("invalid genesis hash, expected: %v, got: %v",
@@ -53,9 +76,10 @@ func (handler *helloHandler) ParseMessage(m message.Message, initiator peer.ID)
 		return handler.acknowledge(response, initiator)
 	}
 
-	if math.Abs(time.Since(msg.MyTime()).Seconds()) > 10 {
+	clockOffset := time.Since(msg.MyTime())
+	if clockOffset.Abs() > handler.config.MaxClockSkew {
 		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
-			"time discrepancy exceeds 10 seconds")
+			fmt.Sprintf("time discrepancy exceeds %v", handler.config.MaxClockSkew))
 
 		return handler.acknowledge(response, initiator)
 	}
@@ -63,6 +87,10 @@ func (handler *helloHandler) ParseMessage(m message.Message, initiator peer.ID)
 	handler.peerSet.UpdateHeight(initiator, msg.Height, msg.BlockHash)
 	handler.peerSet.UpdateStatus(initiator, peerset.StatusCodeKnown)
 
+	// Only the accepted peers report their clocks.
+	handler.peerSet.UpdateClockOffset(initiator, clockOffset)
+	handler.checkClockSkew()
+
 	response := message.NewHelloAckMessage(message.ResponseCodeOK, "Ok")
 	return handler.acknowledge(response, initiator)
 }
@@ -75,11 +103,15 @@ func (handler *helloHandler) PrepareBundle(m message.Message) *bundle.Bundle {
 
 func (handler *helloHandler) acknowledge(msg *message.HelloAckMessage, to peer.ID) error {
 	if msg.ResponseCode == message.ResponseCodeRejected {
-		handler.peerSet.UpdateStatus(to, peerset.StatusCodeBanned)
-
 		handler.logger.Debug("rejecting hello message", "message", msg,
 			"to", to, "reason", msg.Reason)
-		handler.network.CloseConnection(to)
+
+		// The protected peers are never banned. They are kept connected
+		// and can say hello again, for example after an upgrade.
+		if !handler.config.IsPeerProtected(to) {
+			handler.peerSet.UpdateStatus(to, peerset.StatusCodeBanned)
+			handler.network.CloseConnection(to)
+		}
 	} else {
 		handler.logger.Info("acknowledging hello message", "message", msg,
 			"to", to)
@@ -29,6 +29,7 @@ func (handler *helloAckHandler) ParseMessage(m message.Message, initiator peer.I
 		handler.network.CloseConnection(initiator)
 		return nil
 	}
+	handler.peerSet.FinishRoundTrip(initiator)
 	handler.peerSet.UpdateStatus(initiator, peerset.StatusCodeKnown)
 	handler.logger.Debug("hello message acknowledged",
 		"from", initiator)
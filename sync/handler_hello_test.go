@@ -10,6 +10,7 @@ import (
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/service"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/version"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,6 +35,21 @@ func TestParsingHelloMessages(t *testing.T) {
 			assert.Equal(t, bdl.Message.(*message.HelloAckMessage).ResponseCode, message.ResponseCodeRejected)
 		})
 
+	t.Run("Receiving Hello message from a peer. Signature is invalid.",
+		func(t *testing.T) {
+			valKey := td.RandValKey()
+			pid := td.RandPeerID()
+			msg := message.NewHelloMessage(pid, "bad-signature", 0, 0,
+				td.state.LastBlockHash(), td.state.Genesis().Hash())
+			msg.Sign([]*bls.ValidatorKey{valKey})
+			msg.Signature = td.RandBLSSignature()
+
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+			td.checkPeerStatus(t, pid, peerset.StatusCodeBanned)
+			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+			assert.Equal(t, bdl.Message.(*message.HelloAckMessage).ResponseCode, message.ResponseCodeRejected)
+		})
+
 	t.Run("Receiving Hello message from a peer. Genesis hash is wrong.",
 		func(t *testing.T) {
 			invGenHash := td.RandHash()
@@ -107,6 +123,20 @@ func TestParsingHelloMessages(t *testing.T) {
 			assert.Equal(t, p.Height, height)
 			assert.True(t, p.HasNetworkService())
 		})
+
+	t.Run("Receiving Hello message from a peer. The moniker should be sanitized",
+		func(t *testing.T) {
+			valKey := td.RandValKey()
+			pid := td.RandPeerID()
+			msg := message.NewHelloMessage(pid, "kitty\n\x1b[31m", td.state.LastBlockHeight(),
+				service.New(service.Network), td.state.LastBlockHash(), td.state.Genesis().Hash())
+			msg.Sign([]*bls.ValidatorKey{valKey})
+
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+			p := td.sync.peerSet.GetPeer(pid)
+			assert.Equal(t, "kitty[31m", p.Moniker)
+		})
 }
 
 func TestSendingHelloMessage(t *testing.T) {
@@ -118,4 +148,154 @@ func TestSendingHelloMessage(t *testing.T) {
 	bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHello)
 	assert.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagHandshaking))
 	assert.True(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Services, service.New(service.Network)))
+	assert.False(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Flags, message.HelloFlagPartialBlocks))
+	assert.True(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Flags, message.HelloFlagBlocksStream))
+	assert.True(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Flags, message.HelloFlagPing))
+}
+
+func TestSendingHelloMessageWithCompression(t *testing.T) {
+	config := testConfig()
+	config.Compression = true
+	td := setup(t, config)
+
+	to := td.RandPeerID()
+	assert.NoError(t, td.sync.sayHello(to))
+
+	bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHello)
+	assert.True(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Flags, message.HelloFlagCompression))
+}
+
+func TestSendingHelloMessageWithPartialBlocks(t *testing.T) {
+	config := testConfig()
+	config.PartialBlocks = true
+	td := setup(t, config)
+
+	to := td.RandPeerID()
+	assert.NoError(t, td.sync.sayHello(to))
+
+	bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHello)
+	assert.True(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Flags, message.HelloFlagPartialBlocks))
+}
+
+func TestParsingHelloMessageWithCompression(t *testing.T) {
+	td := setup(t, nil)
+
+	valKey := td.RandValKey()
+	pid := td.RandPeerID()
+	msg := message.NewHelloMessage(pid, "kitty", td.state.LastBlockHeight(), service.New(service.Network),
+		td.state.LastBlockHash(), td.state.Genesis().Hash())
+	msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagCompression)
+	msg.Sign([]*bls.ValidatorKey{valKey})
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+
+	assert.True(t, td.sync.peerSet.GetPeer(pid).Compression)
+	assert.False(t, td.sync.peerSet.GetPeer(pid).PartialBlocks)
+	assert.False(t, td.sync.peerSet.GetPeer(pid).BlocksStream)
+}
+
+func TestParsingHelloMessageWithPartialBlocks(t *testing.T) {
+	td := setup(t, nil)
+
+	valKey := td.RandValKey()
+	pid := td.RandPeerID()
+	msg := message.NewHelloMessage(pid, "kitty", td.state.LastBlockHeight(), service.New(service.Network),
+		td.state.LastBlockHash(), td.state.Genesis().Hash())
+	msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagPartialBlocks)
+	msg.Sign([]*bls.ValidatorKey{valKey})
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+
+	assert.True(t, td.sync.peerSet.GetPeer(pid).PartialBlocks)
+}
+
+func TestParsingHelloMessageWithBlocksStream(t *testing.T) {
+	td := setup(t, nil)
+
+	valKey := td.RandValKey()
+	pid := td.RandPeerID()
+	msg := message.NewHelloMessage(pid, "kitty", td.state.LastBlockHeight(), service.New(service.Network),
+		td.state.LastBlockHash(), td.state.Genesis().Hash())
+	msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagBlocksStream)
+	msg.Sign([]*bls.ValidatorKey{valKey})
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+
+	assert.True(t, td.sync.peerSet.GetPeer(pid).BlocksStream)
+}
+
+func TestParsingHelloMessageWithPing(t *testing.T) {
+	td := setup(t, nil)
+
+	valKey := td.RandValKey()
+	pid := td.RandPeerID()
+	msg := message.NewHelloMessage(pid, "kitty", td.state.LastBlockHeight(), service.New(service.Network),
+		td.state.LastBlockHash(), td.state.Genesis().Hash())
+	msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagPing)
+	msg.Sign([]*bls.ValidatorKey{valKey})
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+
+	assert.True(t, td.sync.peerSet.GetPeer(pid).Pingable)
+}
+
+// rejectingVerifier is a custom verifier that rejects all the Hello messages.
+type rejectingVerifier struct{}
+
+func (rejectingVerifier) VerifyHello(_ *message.HelloMessage) error {
+	return errors.Error(errors.ErrInvalidSignature)
+}
+
+func TestParsingHelloMessageWithCustomVerifier(t *testing.T) {
+	td := setup(t, nil)
+	td.sync.helloVerifier = rejectingVerifier{}
+
+	valKey := td.RandValKey()
+	pid := td.RandPeerID()
+	msg := message.NewHelloMessage(pid, "kitty", td.state.LastBlockHeight(), service.New(service.Network),
+		td.state.LastBlockHash(), td.state.Genesis().Hash())
+	msg.Sign([]*bls.ValidatorKey{valKey})
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	td.checkPeerStatus(t, pid, peerset.StatusCodeBanned)
+	bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+	assert.Equal(t, bdl.Message.(*message.HelloAckMessage).ResponseCode, message.ResponseCodeRejected)
+}
+
+func TestDeniedAgents(t *testing.T) {
+	config := testConfig()
+	config.DeniedAgents = []string{"pactus/0.1.*"}
+	td := setup(t, config)
+
+	t.Run("Agent is denied", func(t *testing.T) {
+		valKey := td.RandValKey()
+		pid := td.RandPeerID()
+		msg := message.NewHelloMessage(pid, "buggy", 0, service.New(service.Network),
+			td.state.LastBlockHash(), td.state.Genesis().Hash())
+		msg.Agent = "pactus/0.1.2"
+		msg.Sign([]*bls.ValidatorKey{valKey})
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		td.checkPeerStatus(t, pid, peerset.StatusCodeBanned)
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+		assert.Equal(t, message.ResponseCodeRejected, bdl.Message.(*message.HelloAckMessage).ResponseCode)
+	})
+
+	t.Run("Agent is not denied", func(t *testing.T) {
+		valKey := td.RandValKey()
+		pid := td.RandPeerID()
+		msg := message.NewHelloMessage(pid, "healthy", 0, service.New(service.Network),
+			td.state.LastBlockHash(), td.state.Genesis().Hash())
+		msg.Agent = "pactus/0.2.0"
+		msg.Sign([]*bls.ValidatorKey{valKey})
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		td.checkPeerStatus(t, pid, peerset.StatusCodeKnown)
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+		assert.Equal(t, message.ResponseCodeOK, bdl.Message.(*message.HelloAckMessage).ResponseCode)
+	})
 }
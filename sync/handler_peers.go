@@ -0,0 +1,85 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"golang.org/x/exp/slices"
+)
+
+type peersHandler struct {
+	*synchronizer
+}
+
+func newPeersHandler(sync *synchronizer) messageHandler {
+	return &peersHandler{
+		sync,
+	}
+}
+
+// ParseMessage tries to connect to the shared peers that we are not connected to yet.
+// The peers are accepted only from the known peers, at most once per pex interval,
+// and the banned peers and the private addresses are skipped.
+func (handler *peersHandler) ParseMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.PeersMessage)
+	handler.logger.Trace("parsing Peers message", "message", msg)
+
+	if handler.config.PexInterval == 0 {
+		handler.logger.Debug("ignoring shared peers, peer exchange is disabled", "from", initiator)
+
+		return nil
+	}
+
+	if from := handler.peerSet.GetPeer(initiator); !from.IsKnownOrTrusty() {
+		handler.logger.Debug("ignoring peers shared by an unknown peer", "from", initiator)
+
+		return nil
+	}
+
+	if receivedAt, ok := handler.peersReceivedAt[initiator]; ok &&
+		time.Since(receivedAt) < handler.config.PexInterval {
+		handler.logger.Debug("ignoring peers shared too frequently", "from", initiator)
+
+		return nil
+	}
+	handler.peersReceivedAt[initiator] = time.Now()
+
+	dials := 0
+	for _, addr := range msg.Addresses {
+		if dials >= handler.config.PexSampleSize {
+			break
+		}
+
+		// The addresses are validated by the basic check.
+		addrInfo, _ := peer.AddrInfoFromString(addr)
+		if addrInfo.ID == handler.SelfID() {
+			continue
+		}
+
+		if !handler.config.ForcePrivateNetwork &&
+			slices.ContainsFunc(addrInfo.Addrs, handler.privateFilters.AddrBlocked) {
+			handler.logger.Debug("ignoring a shared private address", "addr", addr, "from", initiator)
+
+			continue
+		}
+
+		p := handler.peerSet.GetPeer(addrInfo.ID)
+		if p.IsConnected() || p.IsBanned() {
+			continue
+		}
+
+		handler.logger.Debug("connecting to a shared peer", "addr", addr, "from", initiator)
+		handler.network.ConnectPeer(*addrInfo)
+		dials++
+	}
+
+	return nil
+}
+
+func (handler *peersHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	bdl := bundle.NewBundle(handler.SelfID(), m)
+
+	return bdl
+}
@@ -0,0 +1,168 @@
+package sync
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/sync/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingPeersMessages(t *testing.T) {
+	conf := testConfig()
+	conf.PexInterval = time.Minute
+	conf.PexSampleSize = 2
+	td := setup(t, conf)
+
+	addKnownPeer := func() peer.ID {
+		pid := td.RandPeerID()
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.None))
+
+		return pid
+	}
+
+	t.Run("Receiving Peers message from an unknown peer", func(t *testing.T) {
+		pid := td.RandPeerID()
+		addr := fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", td.RandPeerID())
+		msg := message.NewPeersMessage([]string{addr})
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Empty(t, td.network.ConnectedAddrs)
+	})
+
+	t.Run("Receiving Peers message from a known peer", func(t *testing.T) {
+		pid := addKnownPeer()
+
+		newPeer := td.RandPeerID()
+		connectedPeer := td.RandPeerID()
+		bannedPeer := td.RandPeerID()
+		td.sync.peerSet.UpdateStatus(connectedPeer, peerset.StatusCodeConnected)
+		td.sync.peerSet.BanPeer(bannedPeer, 0)
+
+		msg := message.NewPeersMessage([]string{
+			fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", newPeer),
+			fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", connectedPeer),
+			fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", bannedPeer),
+			fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", td.sync.SelfID()),
+		})
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Len(t, td.network.ConnectedAddrs, 1)
+		assert.Equal(t, newPeer, td.network.ConnectedAddrs[0].ID)
+	})
+
+	t.Run("Receiving Peers message too frequently", func(t *testing.T) {
+		td.network.ConnectedAddrs = nil
+		pid := addKnownPeer()
+
+		msg := message.NewPeersMessage([]string{
+			fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", td.RandPeerID()),
+		})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Len(t, td.network.ConnectedAddrs, 1)
+
+		msg = message.NewPeersMessage([]string{
+			fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", td.RandPeerID()),
+		})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Len(t, td.network.ConnectedAddrs, 1)
+	})
+
+	t.Run("Private addresses are ignored", func(t *testing.T) {
+		td.network.ConnectedAddrs = nil
+		pid := addKnownPeer()
+
+		msg := message.NewPeersMessage([]string{
+			fmt.Sprintf("/ip4/192.168.1.1/tcp/21888/p2p/%s", td.RandPeerID()),
+			fmt.Sprintf("/ip4/127.0.0.1/tcp/21888/p2p/%s", td.RandPeerID()),
+		})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Empty(t, td.network.ConnectedAddrs)
+	})
+
+	t.Run("Private addresses are accepted in the private network", func(t *testing.T) {
+		td.network.ConnectedAddrs = nil
+		td.sync.config.ForcePrivateNetwork = true
+		defer func() { td.sync.config.ForcePrivateNetwork = false }()
+		pid := addKnownPeer()
+
+		msg := message.NewPeersMessage([]string{
+			fmt.Sprintf("/ip4/192.168.1.1/tcp/21888/p2p/%s", td.RandPeerID()),
+		})
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Len(t, td.network.ConnectedAddrs, 1)
+	})
+
+	t.Run("Number of dials is limited", func(t *testing.T) {
+		td.network.ConnectedAddrs = nil
+		pid := addKnownPeer()
+
+		addrs := make([]string, 0, 4)
+		for i := 0; i < 4; i++ {
+			addrs = append(addrs, fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", td.RandPeerID()))
+		}
+		assert.NoError(t, td.receivingNewMessage(td.sync, message.NewPeersMessage(addrs), pid))
+		assert.Len(t, td.network.ConnectedAddrs, 2)
+	})
+}
+
+func TestParsingPeersMessagesPexDisabled(t *testing.T) {
+	td := setup(t, nil)
+
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+
+	msg := message.NewPeersMessage([]string{
+		fmt.Sprintf("/ip4/1.2.3.4/tcp/21888/p2p/%s", td.RandPeerID()),
+	})
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+	assert.Empty(t, td.network.ConnectedAddrs)
+}
+
+func TestExchangePeers(t *testing.T) {
+	conf := testConfig()
+	conf.PexSampleSize = 2
+	td := setup(t, conf)
+
+	t.Run("No exchangeable peer", func(t *testing.T) {
+		td.sync.exchangePeers()
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypePeers)
+	})
+
+	t.Run("Sharing a sample of the outbound peers", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			pid := td.RandPeerID()
+			pub, _ := td.RandBLSKeyPair()
+			td.addPeer(t, pub, pid, service.New(service.Network))
+			td.sync.peerSet.UpdateDirection(pid, lp2pnetwork.DirOutbound)
+			td.sync.peerSet.UpdateAddress(pid, "/ip4/1.2.3.4/tcp/21888")
+		}
+
+		// The protected peers are not shared.
+		protected := td.RandPeerID()
+		td.sync.config.ProtectedPeers = []string{protected.String()}
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, protected, service.New(service.Network))
+		td.sync.peerSet.UpdateDirection(protected, lp2pnetwork.DirOutbound)
+		td.sync.peerSet.UpdateAddress(protected, "/ip4/1.2.3.4/tcp/21888")
+
+		td.sync.exchangePeers()
+
+		for i := 0; i < 4; i++ {
+			bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypePeers)
+			addrs := bdl.Message.(*message.PeersMessage).Addresses
+			assert.Len(t, addrs, 2)
+			for _, addr := range addrs {
+				assert.NotContains(t, addr, protected.String())
+			}
+		}
+	})
+}
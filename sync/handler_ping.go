@@ -0,0 +1,35 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+type pingHandler struct {
+	*synchronizer
+}
+
+func newPingHandler(sync *synchronizer) messageHandler {
+	return &pingHandler{
+		sync,
+	}
+}
+
+func (handler *pingHandler) ParseMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.PingMessage)
+	handler.logger.Trace("parsing Ping message", "message", msg)
+
+	p := handler.peerSet.GetPeer(initiator)
+	if !p.IsKnownOrTrusty() {
+		return nil
+	}
+
+	return handler.sendTo(message.NewPongMessage(), initiator)
+}
+
+func (handler *pingHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	bdl := bundle.NewBundle(handler.SelfID(), m)
+
+	return bdl
+}
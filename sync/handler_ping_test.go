@@ -0,0 +1,68 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingPingMessages(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Receiving Ping message from an unknown peer", func(t *testing.T) {
+		pid := td.RandPeerID()
+		msg := message.NewPingMessage()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypePong)
+	})
+
+	t.Run("Receiving Ping message from a known peer", func(t *testing.T) {
+		pid := td.RandPeerID()
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.None))
+		msg := message.NewPingMessage()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypePong)
+	})
+}
+
+func TestPingPeers(t *testing.T) {
+	conf := testConfig()
+	conf.PingInterval = time.Minute
+	td := setup(t, conf)
+
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.None))
+
+	t.Run("Not pinging the peers that don't support Ping", func(t *testing.T) {
+		td.sync.pingPeers()
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypePing)
+	})
+
+	t.Run("Pinging the idle peers", func(t *testing.T) {
+		td.sync.peerSet.UpdatePingable(pid, true)
+		td.sync.pingPeers()
+
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypePing)
+	})
+
+	t.Run("Not pinging the peers while waiting for their Pong", func(t *testing.T) {
+		td.sync.pingPeers()
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypePing)
+	})
+
+	t.Run("Not pinging the peers with a recently measured latency", func(t *testing.T) {
+		assert.NoError(t, td.receivingNewMessage(td.sync, message.NewPongMessage(), pid))
+		td.sync.pingPeers()
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypePing)
+	})
+}
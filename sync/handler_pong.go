@@ -0,0 +1,32 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+type pongHandler struct {
+	*synchronizer
+}
+
+func newPongHandler(sync *synchronizer) messageHandler {
+	return &pongHandler{
+		sync,
+	}
+}
+
+func (handler *pongHandler) ParseMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.PongMessage)
+	handler.logger.Trace("parsing Pong message", "message", msg)
+
+	handler.peerSet.FinishRoundTrip(initiator)
+
+	return nil
+}
+
+func (handler *pongHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	bdl := bundle.NewBundle(handler.SelfID(), m)
+
+	return bdl
+}
@@ -0,0 +1,31 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingPongMessages(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Receiving Pong message without sending Ping", func(t *testing.T) {
+		pid := td.RandPeerID()
+		msg := message.NewPongMessage()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.Zero(t, td.sync.peerSet.Latency(pid))
+	})
+
+	t.Run("Receiving Pong message measures the latency", func(t *testing.T) {
+		pid := td.RandPeerID()
+		td.sync.peerSet.StartRoundTrip(pid)
+		time.Sleep(10 * time.Millisecond)
+		msg := message.NewPongMessage()
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		assert.GreaterOrEqual(t, td.sync.peerSet.Latency(pid), 10*time.Millisecond)
+	})
+}
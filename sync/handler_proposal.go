@@ -20,6 +20,10 @@ func (handler *proposalHandler) ParseMessage(m message.Message, _ peer.ID) error
 	msg := m.(*message.ProposalMessage)
 	handler.logger.Trace("parsing Proposal message", "message", msg)
 
+	if !handler.participateInConsensus() {
+		return nil
+	}
+
 	handler.consMgr.SetProposal(msg.Proposal)
 
 	return nil
@@ -20,6 +20,10 @@ func (handler *queryProposalHandler) ParseMessage(m message.Message, _ peer.ID)
 	msg := m.(*message.QueryProposalMessage)
 	handler.logger.Trace("parsing QueryProposal message", "message", msg)
 
+	if !handler.participateInConsensus() {
+		return nil
+	}
+
 	height, _ := handler.consMgr.HeightRound()
 	if msg.Height == height {
 		prop := handler.consMgr.Proposal()
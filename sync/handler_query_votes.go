@@ -20,6 +20,10 @@ func (handler *queryVotesHandler) ParseMessage(m message.Message, _ peer.ID) err
 	msg := m.(*message.QueryVotesMessage)
 	handler.logger.Trace("parsing QueryVotes message", "message", msg)
 
+	if !handler.participateInConsensus() {
+		return nil
+	}
+
 	height, _ := handler.consMgr.HeightRound()
 	if msg.Height == height {
 		v := handler.consMgr.PickRandomVote(msg.Round)
@@ -4,6 +4,8 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/util/errors"
 )
 
 type transactionsHandler struct {
@@ -20,7 +22,23 @@ func (handler *transactionsHandler) ParseMessage(m message.Message, _ peer.ID) e
 	msg := m.(*message.TransactionsMessage)
 	handler.logger.Trace("parsing Transactions message", "message", msg)
 
+	// Reject the whole batch before validating any transaction,
+	// otherwise a peer can force us to validate an enormous batch.
+	if len(msg.Transactions) > handler.config.MaxTxsPerMessage {
+		return errors.Errorf(errors.ErrInvalidMessage, "too many transactions: %d, max: %d",
+			len(msg.Transactions), handler.config.MaxTxsPerMessage)
+	}
+
+	// Transactions below our minimum fee are skipped,
+	// so we don't validate or relay them.
+	minFee := handler.state.TxPoolMinFee()
 	for _, trx := range msg.Transactions {
+		if txpool.IsBelowMinFee(trx, minFee) {
+			handler.logger.Trace("skipping low fee transaction", "tx", trx, "minFee", minFee)
+
+			continue
+		}
+
 		if err := handler.state.AddPendingTx(trx); err != nil {
 			handler.logger.Debug("cannot append transaction", "tx", trx, "error", err)
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,4 +20,33 @@ func TestParsingTransactionsMessages(t *testing.T) {
 
 		assert.NotNil(t, td.sync.state.PendingTx(trx1.ID()))
 	})
+
+	t.Run("Oversized transactions message", func(t *testing.T) {
+		td.sync.config.MaxTxsPerMessage = 2
+
+		trx1, _ := td.GenerateTestTransferTx()
+		trx2, _ := td.GenerateTestTransferTx()
+		trx3, _ := td.GenerateTestTransferTx()
+		msg := message.NewTransactionsMessage([]*tx.Tx{trx1, trx2, trx3})
+
+		err := td.receivingNewMessage(td.sync, msg, td.RandPeerID())
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(err))
+
+		assert.Nil(t, td.sync.state.PendingTx(trx1.ID()))
+		assert.Nil(t, td.sync.state.PendingTx(trx2.ID()))
+		assert.Nil(t, td.sync.state.PendingTx(trx3.ID()))
+	})
+	t.Run("Transactions below the minimum fee", func(t *testing.T) {
+		td.sync.config.MaxTxsPerMessage = 10
+		td.state.TestPool.MinimumFee = 1e10
+
+		trx1, _ := td.GenerateTestTransferTx()
+		trx2, _ := td.GenerateTestSortitionTx()
+		msg := message.NewTransactionsMessage([]*tx.Tx{trx1, trx2})
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, td.RandPeerID()))
+
+		assert.Nil(t, td.sync.state.PendingTx(trx1.ID()))
+		assert.NotNil(t, td.sync.state.PendingTx(trx2.ID()))
+	})
 }
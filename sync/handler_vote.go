@@ -20,6 +20,10 @@ func (handler *voteHandler) ParseMessage(m message.Message, _ peer.ID) error {
 	msg := m.(*message.VoteMessage)
 	handler.logger.Trace("parsing Vote message", "message", msg)
 
+	if !handler.participateInConsensus() {
+		return nil
+	}
+
 	handler.consMgr.AddVote(msg.Vote)
 
 	return nil
@@ -18,3 +18,15 @@ func TestParsingVoteMessages(t *testing.T) {
 		assert.Equal(t, td.consMgr.PickRandomVote(0).Hash(), v.Hash())
 	})
 }
+
+func TestIgnoringVoteMessages(t *testing.T) {
+	conf := testConfig()
+	conf.RunConsensus = false
+	td := setup(t, conf)
+
+	v, _ := td.GenerateTestPrecommitVote(1, 0)
+	msg := message.NewVoteMessage(v)
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, td.RandPeerID()))
+	assert.Nil(t, td.consMgr.PickRandomVote(0))
+}
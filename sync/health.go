@@ -0,0 +1,39 @@
+package sync
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var healthy = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: "pactus",
+	Subsystem: "sync",
+	Name:      "healthy",
+	Help:      "Whether the node is ready to serve: 1 if it is healthy, otherwise 0.",
+})
+
+// IsHealthy checks if the node is ready to serve. The node is healthy when:
+//   - it is ready, and synced with the network to within one block,
+//   - it is connected to at least the minimum number of peers,
+//   - if it runs validators, the consensus has moved to the next height.
+//
+// The consensus is checked by its height rather than being active,
+// since the validators that are not in the committee are never active.
+func (sync *synchronizer) IsHealthy() bool {
+	isHealthy := sync.IsReady() &&
+		sync.numOfBlocksBehind() <= 1 &&
+		sync.peerSet.NumberOfConnectedPeers() >= sync.config.MinConns
+
+	if isHealthy && sync.config.RunConsensus && len(sync.valKeys) > 0 {
+		consHeight, _ := sync.consMgr.HeightRound()
+		isHealthy = consHeight == sync.state.LastBlockHeight()+1
+	}
+
+	if isHealthy {
+		healthy.Set(1)
+	} else {
+		healthy.Set(0)
+	}
+
+	return isHealthy
+}
@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"time"
+
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/sync/peerset"
 )
@@ -11,4 +13,12 @@ type Synchronizer interface {
 	Moniker() string
 	SelfID() peer.ID
 	PeerSet() *peerset.PeerSet
+	EstimatedSyncTime() time.Duration
+	IsReady() bool
+	IsHealthy() bool
+	BanPeer(pid peer.ID, duration time.Duration)
+	UnbanPeer(pid peer.ID)
+	Pause()
+	Resume()
+	IsPaused() bool
 }
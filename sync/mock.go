@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -14,8 +15,13 @@ import (
 var _ Synchronizer = &MockSync{}
 
 type MockSync struct {
-	TestID      peer.ID
-	TestPeerSet *peerset.PeerSet
+	TestID                peer.ID
+	TestPeerSet           *peerset.PeerSet
+	TestEstimatedSyncTime time.Duration
+	TestReady             bool
+	// TestHealthy is read by the health checker of the gRPC server in the background.
+	TestHealthy atomic.Bool
+	TestPaused  atomic.Bool
 }
 
 func MockingSync(ts *testsuite.TestSuite) *MockSync {
@@ -64,3 +70,35 @@ func (m *MockSync) Moniker() string {
 func (m *MockSync) PeerSet() *peerset.PeerSet {
 	return m.TestPeerSet
 }
+
+func (m *MockSync) BanPeer(pid peer.ID, duration time.Duration) {
+	m.TestPeerSet.BanPeer(pid, duration)
+}
+
+func (m *MockSync) UnbanPeer(pid peer.ID) {
+	m.TestPeerSet.UnbanPeer(pid)
+}
+
+func (m *MockSync) EstimatedSyncTime() time.Duration {
+	return m.TestEstimatedSyncTime
+}
+
+func (m *MockSync) IsReady() bool {
+	return m.TestReady
+}
+
+func (m *MockSync) IsHealthy() bool {
+	return m.TestHealthy.Load()
+}
+
+func (m *MockSync) Pause() {
+	m.TestPaused.Store(true)
+}
+
+func (m *MockSync) Resume() {
+	m.TestPaused.Store(false)
+}
+
+func (m *MockSync) IsPaused() bool {
+	return m.TestPaused.Load()
+}
@@ -0,0 +1,46 @@
+package sync
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxMonikerLength is the maximum number of characters in a moniker.
+const MaxMonikerLength = 64
+
+// isValidMoniker checks if the moniker is a valid UTF-8 string,
+// contains only printable characters and is not longer than MaxMonikerLength.
+func isValidMoniker(moniker string) bool {
+	if !utf8.ValidString(moniker) {
+		return false
+	}
+	if utf8.RuneCountInString(moniker) > MaxMonikerLength {
+		return false
+	}
+
+	return strings.IndexFunc(moniker, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) == -1
+}
+
+// sanitizeMoniker removes the invalid and non-printable characters from the moniker
+// and truncates it to MaxMonikerLength characters.
+// It is used for the monikers received from other peers,
+// to prevent them from messing up the logs and the peer listings.
+func sanitizeMoniker(moniker string) string {
+	builder := strings.Builder{}
+	count := 0
+	for _, r := range moniker {
+		if r == utf8.RuneError || !unicode.IsPrint(r) {
+			continue
+		}
+		if count == MaxMonikerLength {
+			break
+		}
+		builder.WriteRune(r)
+		count++
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,29 @@
+package sync
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeMoniker(t *testing.T) {
+	tests := []struct {
+		moniker  string
+		expected string
+	}{
+		{"", ""},
+		{"kitty", "kitty"},
+		{"Pactus ✨ node", "Pactus ✨ node"},
+		{"kitty\nERR fake log", "kittyERR fake log"},
+		{"\x1b[31mred\x1b[0m", "[31mred[0m"},
+		{"invalid\xffutf8", "invalidutf8"},
+		{strings.Repeat("✨", MaxMonikerLength+1), strings.Repeat("✨", MaxMonikerLength)},
+	}
+
+	for _, tt := range tests {
+		sanitized := sanitizeMoniker(tt.moniker)
+		assert.Equal(t, tt.expected, sanitized)
+		assert.True(t, isValidMoniker(sanitized))
+	}
+}
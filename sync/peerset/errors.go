@@ -0,0 +1,19 @@
+package peerset
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// PeerNotKnownError is returned when opening a session with a peer
+// that is not known or trusty, such as an unknown or banned peer.
+type PeerNotKnownError struct {
+	PeerID peer.ID
+	Status StatusCode
+}
+
+func (e PeerNotKnownError) Error() string {
+	return fmt.Sprintf("unable to open a session with peer %s, status: %s",
+		e.PeerID, e.Status.String())
+}
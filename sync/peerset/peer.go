@@ -3,11 +3,15 @@ package peerset
 import (
 	"time"
 
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/service"
+	"github.com/pactus-project/pactus/util"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 type Peer struct {
@@ -15,17 +19,35 @@ type Peer struct {
 	Moniker         string
 	Agent           string
 	Address         string
+	Direction       lp2pnetwork.Direction
 	PeerID          peer.ID
 	ConsensusKeys   []*bls.PublicKey
 	Services        service.Services
 	LastSent        time.Time
 	LastReceived    time.Time
 	LastBlockHash   hash.Hash
+	BannedUntil     time.Time
+	SuspendedUntil  time.Time     // SuspendedUntil is the time until the peer is not selected for downloading blocks.
+	PenalizedUntil  time.Time     // PenalizedUntil is the time until the peer is not selected, because it served invalid blocks.
+	Latency         time.Duration // Latency is the smoothed round-trip time.
+	ClockOffset     time.Duration // ClockOffset is the difference between our clock and the clock of the peer.
+	HasClockOffset  bool          // HasClockOffset is true once the peer has reported its clock.
 	Height          uint32
+	Compression     bool
+	PartialBlocks   bool
+	BlocksStream    bool
+	Pingable        bool // Pingable indicates that the peer responds to the Ping messages.
+	Relayed         bool // Relayed indicates that the peer is reached through a relay node.
 	ReceivedBundles int
 	InvalidBundles  int
+	ServedBlocks    int64 // ServedBlocks is the number of blocks served to this peer.
+	ReceivedBlocks  int64 // ReceivedBlocks is the number of blocks received from this peer and committed.
+	SendFailures    int   // SendFailures is the number of consecutive failures in sending to this peer.
 	ReceivedBytes   map[message.Type]int64
 	SentBytes       map[message.Type]int64
+
+	roundTripStartedAt time.Time
+	latencyMeasuredAt  time.Time
 }
 
 func NewPeer(peerID peer.ID) *Peer {
@@ -42,10 +64,70 @@ func (p *Peer) IsKnownOrTrusty() bool {
 	return p.Status == StatusCodeKnown || p.Status == StatusCodeTrusty
 }
 
+// IsConnected checks if the peer is connected, regardless of the handshake result.
+func (p *Peer) IsConnected() bool {
+	return p.Status == StatusCodeConnected || p.IsKnownOrTrusty()
+}
+
+// IsBanned checks if the peer is banned. A peer banned for a limited duration
+// is no longer considered banned once the duration has elapsed.
 func (p *Peer) IsBanned() bool {
-	return p.Status == StatusCodeBanned
+	if p.Status != StatusCodeBanned {
+		return false
+	}
+	return p.BannedUntil.IsZero() || util.Now().Before(p.BannedUntil)
+}
+
+// IsSuspended checks if sending to the peer is suspended, because of repeated send failures.
+func (p *Peer) IsSuspended() bool {
+	return util.Now().Before(p.SuspendedUntil)
+}
+
+// IsPenalized checks if the peer is penalized, because it served an invalid block.
+// Unlike the suspension, the penalty is not lifted by a successful send.
+func (p *Peer) IsPenalized() bool {
+	return util.Now().Before(p.PenalizedUntil)
 }
 
 func (p *Peer) HasNetworkService() bool {
 	return p.Services.IsNetwork()
 }
+
+// IsFreeRider checks if the peer downloads blocks from us without serving back.
+// The first `freeBlocks` blocks are served freely, then the peer is a free rider
+// if the number of served blocks is more than `maxRatio` times the number of received blocks.
+func (p *Peer) IsFreeRider(maxRatio float64, freeBlocks int64) bool {
+	if p.ServedBlocks <= freeBlocks {
+		return false
+	}
+
+	return float64(p.ServedBlocks) > maxRatio*float64(p.ReceivedBlocks)
+}
+
+// clone returns a deep copy of the peer, so it can be read without holding the lock.
+func (p *Peer) clone() Peer {
+	cloned := *p
+	cloned.ConsensusKeys = slices.Clone(p.ConsensusKeys)
+	cloned.ReceivedBytes = maps.Clone(p.ReceivedBytes)
+	cloned.SentBytes = maps.Clone(p.SentBytes)
+
+	return cloned
+}
+
+// TotalSentBytes returns the total number of bytes sent to this peer.
+func (p *Peer) TotalSentBytes() int64 {
+	total := int64(0)
+	for _, c := range p.SentBytes {
+		total += c
+	}
+	return total
+}
+
+// TotalReceivedBytes returns the total number of bytes received from this peer.
+func (p *Peer) TotalReceivedBytes() int64 {
+	total := int64(0)
+	for _, c := range p.ReceivedBytes {
+		total += c
+	}
+	return total
+}
@@ -1,9 +1,11 @@
 package peerset
 
 import (
+	"sort"
 	"sync"
 	"time"
 
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
@@ -16,6 +18,9 @@ import (
 // - Add tests for peerset
 // - Is it thread safe (GetPeer and IteratePeers) ??
 
+// latencySmoothing is the smoothing factor of the peers' latency moving average.
+const latencySmoothing = 0.2
+
 type PeerSet struct {
 	lk sync.RWMutex
 
@@ -41,15 +46,25 @@ func NewPeerSet(sessionTimeout time.Duration) *PeerSet {
 	}
 }
 
-func (ps *PeerSet) OpenSession(pid peer.ID) *Session {
+// OpenSession opens a new download session with the given peer.
+// It refuses to open a session if the peer is not known or trusty.
+func (ps *PeerSet) OpenSession(pid peer.ID) (*Session, error) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
 
+	p := ps.getPeer(pid)
+	if p == nil {
+		return nil, PeerNotKnownError{PeerID: pid, Status: StatusCodeUnknown}
+	}
+	if !p.IsKnownOrTrusty() {
+		return nil, PeerNotKnownError{PeerID: pid, Status: p.Status}
+	}
+
 	s := newSession(ps.nextSessionID, pid)
 	ps.sessions[s.SessionID()] = s
 	ps.nextSessionID++
 
-	return s
+	return s, nil
 }
 
 func (ps *PeerSet) FindSession(id int) *Session {
@@ -64,6 +79,24 @@ func (ps *PeerSet) FindSession(id int) *Session {
 	return nil
 }
 
+// Sessions returns a snapshot of the open sessions, sorted by their IDs.
+func (ps *PeerSet) Sessions() []SessionInfo {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	ps.removeExpiredSessions()
+
+	sessions := make([]SessionInfo, 0, len(ps.sessions))
+	for _, s := range ps.sessions {
+		sessions = append(sessions, s.Info())
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].SessionID < sessions[j].SessionID
+	})
+
+	return sessions
+}
+
 func (ps *PeerSet) NumberOfOpenSessions() int {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -118,6 +151,21 @@ func (ps *PeerSet) Len() int {
 	return len(ps.peers)
 }
 
+// NumberOfConnectedPeers returns the number of peers that are currently connected.
+func (ps *PeerSet) NumberOfConnectedPeers() int {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	count := 0
+	for _, p := range ps.peers {
+		if p.IsConnected() {
+			count++
+		}
+	}
+
+	return count
+}
+
 func (ps *PeerSet) RemovePeer(pid peer.ID) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -145,12 +193,137 @@ func (ps *PeerSet) GetPeer(pid peer.ID) Peer {
 
 	p := ps.getPeer(pid)
 	if p != nil {
-		return *p
+		return p.clone()
 	}
 
 	return Peer{}
 }
 
+// MedianNetworkHeight returns the median of the heights reported by the known or trusty peers.
+// The median is robust against a few peers that report wrong heights.
+// It returns zero if no peer has reported its height yet.
+func (ps *PeerSet) MedianNetworkHeight() uint32 {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	heights := make([]uint32, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		if p.IsKnownOrTrusty() && p.Height > 0 {
+			heights = append(heights, p.Height)
+		}
+	}
+	if len(heights) == 0 {
+		return 0
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	// For an even number of heights, the lower middle is chosen.
+	return heights[(len(heights)-1)/2]
+}
+
+// MedianClockOffset returns the median of the clock offsets reported by the peers,
+// together with the number of the reported offsets.
+// Only the connected outbound peers are included, since the inbound connections are cheap to make
+// and a few of them shouldn't be able to make us believe that our clock is skewed.
+func (ps *PeerSet) MedianClockOffset() (time.Duration, int) {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	offsets := make([]time.Duration, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		if p.HasClockOffset && p.IsConnected() && p.Direction == lp2pnetwork.DirOutbound {
+			offsets = append(offsets, p.ClockOffset)
+		}
+	}
+	if len(offsets) == 0 {
+		return 0, 0
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	// For an even number of offsets, the lower middle is chosen.
+	return offsets[(len(offsets)-1)/2], len(offsets)
+}
+
+// NetworkPeers returns a copy of the known or trusty peers that advertise the Network service,
+// which means they are able to serve the complete blockchain.
+func (ps *PeerSet) NetworkPeers() []Peer {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	l := make([]Peer, 0)
+	for _, p := range ps.peers {
+		if p.IsKnownOrTrusty() && p.HasNetworkService() {
+			l = append(l, p.clone())
+		}
+	}
+
+	return l
+}
+
+// ExchangeablePeers returns a copy of the known or trusty peers that can be shared with other peers.
+// Only the outbound and direct connections are included, since their addresses are reachable.
+func (ps *PeerSet) ExchangeablePeers() []Peer {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	l := make([]Peer, 0)
+	for _, p := range ps.peers {
+		if p.IsKnownOrTrusty() &&
+			p.Direction == lp2pnetwork.DirOutbound &&
+			!p.Relayed &&
+			p.Address != "" {
+			l = append(l, p.clone())
+		}
+	}
+
+	return l
+}
+
+// KnownPeers returns the IDs of the known or trusty peers.
+func (ps *PeerSet) KnownPeers() []peer.ID {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	l := make([]peer.ID, 0)
+	for _, p := range ps.peers {
+		if p.IsKnownOrTrusty() {
+			l = append(l, p.PeerID)
+		}
+	}
+
+	return l
+}
+
+// IdlePeers returns the connected peers that neither sent nor received anything within the timeout.
+// The trusty peers and the peers with no activity yet are not considered idle.
+func (ps *PeerSet) IdlePeers(timeout time.Duration) []peer.ID {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	l := make([]peer.ID, 0)
+	for _, p := range ps.peers {
+		if !p.IsConnected() || p.Status == StatusCodeTrusty {
+			continue
+		}
+
+		lastActivity := p.LastSent
+		if p.LastReceived.After(lastActivity) {
+			lastActivity = p.LastReceived
+		}
+		if lastActivity.IsZero() {
+			continue
+		}
+
+		if time.Since(lastActivity) > timeout {
+			l = append(l, p.PeerID)
+		}
+	}
+
+	return l
+}
+
 func (ps *PeerSet) getPeer(pid peer.ID) *Peer {
 	if p, ok := ps.peers[pid]; ok {
 		return p
@@ -184,6 +357,71 @@ func (ps *PeerSet) UpdateInfo(
 	p.Services = services
 }
 
+// UpdateCompression updates whether the peer accepts compressed bundles or not.
+func (ps *PeerSet) UpdateCompression(pid peer.ID, compression bool) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Compression = compression
+}
+
+// UpdatePartialBlocks updates whether the peer can continue partial blocks responses or not.
+func (ps *PeerSet) UpdatePartialBlocks(pid peer.ID, partialBlocks bool) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.PartialBlocks = partialBlocks
+}
+
+// UpdateBlocksStream updates whether the peer can serve and consume the blocks in a stream or not.
+func (ps *PeerSet) UpdateBlocksStream(pid peer.ID, blocksStream bool) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.BlocksStream = blocksStream
+}
+
+// UpdatePingable updates whether the peer responds to the Ping messages or not.
+func (ps *PeerSet) UpdatePingable(pid peer.ID, pingable bool) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Pingable = pingable
+}
+
+// UpdateRelayed updates whether the peer is reached through a relay node.
+func (ps *PeerSet) UpdateRelayed(pid peer.ID, relayed bool) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Relayed = relayed
+}
+
+// UpdateClockOffset records the difference between our clock and the clock of the peer.
+func (ps *PeerSet) UpdateClockOffset(pid peer.ID, offset time.Duration) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.ClockOffset = offset
+	p.HasClockOffset = true
+}
+
+// ResetClockOffset forgets the clock offset of the peer, for example when it is disconnected.
+func (ps *PeerSet) ResetClockOffset(pid peer.ID) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.ClockOffset = 0
+	p.HasClockOffset = false
+}
+
 func (ps *PeerSet) UpdateHeight(pid peer.ID, height uint32, lastBlockHash hash.Hash) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -201,6 +439,15 @@ func (ps *PeerSet) UpdateAddress(pid peer.ID, addr string) {
 	p.Address = addr
 }
 
+// UpdateDirection updates whether we dialed the peer (outbound) or the peer dialed us (inbound).
+func (ps *PeerSet) UpdateDirection(pid peer.ID, direction lp2pnetwork.Direction) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Direction = direction
+}
+
 func (ps *PeerSet) UpdateStatus(pid peer.ID, status StatusCode) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -209,6 +456,74 @@ func (ps *PeerSet) UpdateStatus(pid peer.ID, status StatusCode) {
 	p.Status = status
 }
 
+// BanPeer bans the peer for the given duration.
+// If the duration is zero, the peer is banned permanently.
+func (ps *PeerSet) BanPeer(pid peer.ID, duration time.Duration) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Status = StatusCodeBanned
+	p.BannedUntil = time.Time{}
+	if duration > 0 {
+		p.BannedUntil = util.Now().Add(duration)
+	}
+}
+
+// IncreaseSendFailures increases the number of consecutive send failures to the peer
+// and returns the updated number.
+func (ps *PeerSet) IncreaseSendFailures(pid peer.ID) int {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.SendFailures++
+
+	return p.SendFailures
+}
+
+// ResetSendFailures resets the number of send failures to the peer and lifts its suspension.
+func (ps *PeerSet) ResetSendFailures(pid peer.ID) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.SendFailures = 0
+	p.SuspendedUntil = time.Time{}
+}
+
+// SuspendPeer stops selecting the peer for downloading blocks for the given duration.
+func (ps *PeerSet) SuspendPeer(pid peer.ID, duration time.Duration) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.SuspendedUntil = util.Now().Add(duration)
+}
+
+// PenalizePeer stops selecting the peer for downloading blocks for the given duration,
+// because it served an invalid block.
+func (ps *PeerSet) PenalizePeer(pid peer.ID, duration time.Duration) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.PenalizedUntil = util.Now().Add(duration)
+}
+
+// UnbanPeer lifts the ban of the peer. The peer should say hello again
+// to be known.
+func (ps *PeerSet) UnbanPeer(pid peer.ID) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	if p.Status == StatusCodeBanned {
+		p.Status = StatusCodeUnknown
+	}
+	p.BannedUntil = time.Time{}
+}
+
 func (ps *PeerSet) UpdateLastSent(pid peer.ID) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -225,6 +540,75 @@ func (ps *PeerSet) UpdateLastReceived(pid peer.ID) {
 	p.LastReceived = time.Now()
 }
 
+// StartRoundTrip records the time of sending a request that the peer responds to immediately,
+// like the Hello or the BlocksRequest messages.
+func (ps *PeerSet) StartRoundTrip(pid peer.ID) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.roundTripStartedAt = util.Now()
+}
+
+// FinishRoundTrip measures the round-trip time since the last started round trip
+// and updates the smoothed latency of the peer.
+// It does nothing if no round trip is started.
+func (ps *PeerSet) FinishRoundTrip(pid peer.ID) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	if p.roundTripStartedAt.IsZero() {
+		return
+	}
+	rtt := util.Now().Sub(p.roundTripStartedAt)
+	p.roundTripStartedAt = time.Time{}
+	p.latencyMeasuredAt = util.Now()
+
+	if p.Latency == 0 {
+		p.Latency = rtt
+	} else {
+		p.Latency = time.Duration(latencySmoothing*float64(rtt) + (1-latencySmoothing)*float64(p.Latency))
+	}
+}
+
+// PeersToPing returns the known peers that respond to the Ping messages,
+// and their latency is not measured within the given interval.
+// The peers with a round trip started within the interval are skipped,
+// so a peer that doesn't respond is not pinged repeatedly.
+func (ps *PeerSet) PeersToPing(interval time.Duration) []peer.ID {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	l := make([]peer.ID, 0)
+	for _, p := range ps.peers {
+		if !p.IsKnownOrTrusty() || !p.Pingable {
+			continue
+		}
+		if util.Now().Sub(p.latencyMeasuredAt) < interval ||
+			util.Now().Sub(p.roundTripStartedAt) < interval {
+			continue
+		}
+		l = append(l, p.PeerID)
+	}
+
+	return l
+}
+
+// Latency returns the smoothed round-trip time of the peer.
+// It returns zero if the latency is not measured yet.
+func (ps *PeerSet) Latency(pid peer.ID) time.Duration {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	p := ps.getPeer(pid)
+	if p == nil {
+		return 0
+	}
+
+	return p.Latency
+}
+
 func (ps *PeerSet) IncreaseReceivedBundlesCounter(pid peer.ID) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -241,6 +625,24 @@ func (ps *PeerSet) IncreaseInvalidBundlesCounter(pid peer.ID) {
 	p.InvalidBundles++
 }
 
+// IncreaseServedBlocksCounter increases the number of blocks served to the peer.
+func (ps *PeerSet) IncreaseServedBlocksCounter(pid peer.ID, count int64) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.ServedBlocks += count
+}
+
+// IncreaseReceivedBlocksCounter increases the number of blocks received from the peer and committed.
+func (ps *PeerSet) IncreaseReceivedBlocksCounter(pid peer.ID, count int64) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.ReceivedBlocks += count
+}
+
 func (ps *PeerSet) IncreaseReceivedBytesCounter(pid peer.ID, msgType message.Type, c int64) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -301,6 +703,33 @@ func (ps *PeerSet) ReceivedBytes() map[message.Type]int64 {
 	return ps.receivedBytes
 }
 
+// Bandwidth holds the number of bytes sent and received.
+type Bandwidth struct {
+	Sent     int64
+	Received int64
+}
+
+// BandwidthByType sums the sent and received bytes of all peers per message type.
+func (ps *PeerSet) BandwidthByType() map[message.Type]Bandwidth {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	bandwidth := make(map[message.Type]Bandwidth)
+	for _, p := range ps.peers {
+		for msgType, c := range p.SentBytes {
+			b := bandwidth[msgType]
+			b.Sent += c
+			bandwidth[msgType] = b
+		}
+		for msgType, c := range p.ReceivedBytes {
+			b := bandwidth[msgType]
+			b.Received += c
+			bandwidth[msgType] = b
+		}
+	}
+	return bandwidth
+}
+
 func (ps *PeerSet) StartedAt() time.Time {
 	ps.lk.RLock()
 	defer ps.lk.RUnlock()
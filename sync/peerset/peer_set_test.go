@@ -4,12 +4,14 @@ import (
 	"testing"
 	"time"
 
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/service"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPeerSet(t *testing.T) {
@@ -63,6 +65,18 @@ func TestPeerSet(t *testing.T) {
 		assert.Equal(t, StatusCodeUnknown, p.Status)
 	})
 
+	t.Run("Testing NetworkPeers", func(t *testing.T) {
+		assert.Empty(t, peerSet.NetworkPeers())
+
+		peerSet.UpdateStatus(pid1, StatusCodeKnown)
+		peerSet.UpdateStatus(pid2, StatusCodeKnown)
+		peerSet.UpdateStatus(pid3, StatusCodeBanned)
+
+		networkPeers := peerSet.NetworkPeers()
+		assert.Len(t, networkPeers, 1)
+		assert.Equal(t, pid1, networkPeers[0].PeerID)
+	})
+
 	t.Run("Testing PublicKeys", func(t *testing.T) {
 		p := peerSet.GetPeer(pid3)
 
@@ -77,6 +91,9 @@ func TestPeerSet(t *testing.T) {
 		peerSet.IncreaseReceivedBytesCounter(pid1, message.TypeTransactions, 150)
 		peerSet.IncreaseSentBytesCounter(message.TypeBlocksRequest, 200, nil)
 		peerSet.IncreaseSentBytesCounter(message.TypeBlocksRequest, 250, &pid1)
+		peerSet.IncreaseServedBlocksCounter(pid1, 10)
+		peerSet.IncreaseServedBlocksCounter(pid1, 5)
+		peerSet.IncreaseReceivedBlocksCounter(pid1, 3)
 
 		peer1 := peerSet.getPeer(pid1)
 
@@ -89,6 +106,8 @@ func TestPeerSet(t *testing.T) {
 
 		assert.Equal(t, peer1.InvalidBundles, 1)
 		assert.Equal(t, peer1.ReceivedBundles, 1)
+		assert.Equal(t, peer1.ServedBlocks, int64(15))
+		assert.Equal(t, peer1.ReceivedBlocks, int64(3))
 		assert.Equal(t, peer1.ReceivedBytes[message.TypeBlocksResponse], int64(100))
 		assert.Equal(t, peer1.ReceivedBytes[message.TypeTransactions], int64(150))
 		assert.Equal(t, peer1.SentBytes[message.TypeBlocksRequest], int64(250))
@@ -100,6 +119,14 @@ func TestPeerSet(t *testing.T) {
 		assert.Equal(t, peerSet.TotalSentBytes(), int64(450))
 		assert.Equal(t, peerSet.SentBytesMessageType(message.TypeBlocksRequest), int64(450))
 		assert.Equal(t, peerSet.SentBytes(), sentBytes)
+
+		assert.Equal(t, peer1.TotalReceivedBytes(), int64(250))
+		assert.Equal(t, peer1.TotalSentBytes(), int64(250))
+
+		bandwidth := peerSet.BandwidthByType()
+		assert.Equal(t, Bandwidth{Sent: 0, Received: 100}, bandwidth[message.TypeBlocksResponse])
+		assert.Equal(t, Bandwidth{Sent: 0, Received: 150}, bandwidth[message.TypeTransactions])
+		assert.Equal(t, Bandwidth{Sent: 250, Received: 0}, bandwidth[message.TypeBlocksRequest])
 	})
 
 	t.Run("Testing UpdateStatus", func(t *testing.T) {
@@ -138,21 +165,217 @@ func TestPeerSet(t *testing.T) {
 	})
 }
 
+func TestGetPeerReturnsCopy(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+	pid := peer.ID("peer1")
+
+	ps.IncreaseReceivedBytesCounter(pid, message.TypeBlocksResponse, 100)
+	ps.IncreaseSentBytesCounter(message.TypeBlocksRequest, 50, &pid)
+
+	p := ps.GetPeer(pid)
+	ps.IncreaseReceivedBytesCounter(pid, message.TypeBlocksResponse, 100)
+	ps.IncreaseSentBytesCounter(message.TypeBlocksRequest, 50, &pid)
+
+	assert.Equal(t, int64(100), p.TotalReceivedBytes())
+	assert.Equal(t, int64(50), p.TotalSentBytes())
+}
+
+func TestNetworkPeersReturnCopies(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	ps := NewPeerSet(time.Minute)
+	pid := ts.RandPeerID()
+	ps.UpdateInfo(pid, "moniker", "agent", []*bls.PublicKey{}, service.New(service.Network))
+	ps.UpdateStatus(pid, StatusCodeKnown)
+	ps.IncreaseReceivedBytesCounter(pid, message.TypeBlocksResponse, 100)
+
+	p := ps.NetworkPeers()[0]
+	ps.UpdateHeight(pid, 100, ts.RandHash())
+	ps.IncreaseReceivedBytesCounter(pid, message.TypeBlocksResponse, 100)
+
+	assert.Zero(t, p.Height)
+	assert.Equal(t, int64(100), p.TotalReceivedBytes())
+}
+
+func TestBanPeer(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+	pid := peer.ID("peer1")
+
+	t.Run("Ban permanently", func(t *testing.T) {
+		ps.BanPeer(pid, 0)
+
+		p := ps.GetPeer(pid)
+		assert.True(t, p.IsBanned())
+		assert.True(t, p.BannedUntil.IsZero())
+	})
+
+	t.Run("Unban peer", func(t *testing.T) {
+		ps.UnbanPeer(pid)
+
+		p := ps.GetPeer(pid)
+		assert.False(t, p.IsBanned())
+		assert.Equal(t, StatusCodeUnknown, p.Status)
+	})
+
+	t.Run("Ban for a limited duration", func(t *testing.T) {
+		ps.BanPeer(pid, 100*time.Millisecond)
+
+		p := ps.GetPeer(pid)
+		assert.True(t, p.IsBanned())
+
+		time.Sleep(100 * time.Millisecond)
+		p = ps.GetPeer(pid)
+		assert.False(t, p.IsBanned())
+	})
+}
+
+func openTestSession(t *testing.T, ps *PeerSet, pid peer.ID) *Session {
+	t.Helper()
+
+	ps.UpdateStatus(pid, StatusCodeKnown)
+	session, err := ps.OpenSession(pid)
+	require.NoError(t, err)
+
+	return session
+}
+
+func TestLatency(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	peerSet := NewPeerSet(time.Second)
+	pid := ts.RandPeerID()
+
+	t.Run("Unknown peer has no latency", func(t *testing.T) {
+		assert.Zero(t, peerSet.Latency(pid))
+	})
+
+	t.Run("Finishing round trip without starting it", func(t *testing.T) {
+		peerSet.FinishRoundTrip(pid)
+		assert.Zero(t, peerSet.Latency(pid))
+	})
+
+	t.Run("First round trip", func(t *testing.T) {
+		peerSet.StartRoundTrip(pid)
+		time.Sleep(10 * time.Millisecond)
+		peerSet.FinishRoundTrip(pid)
+
+		assert.GreaterOrEqual(t, peerSet.Latency(pid), 10*time.Millisecond)
+		assert.Equal(t, peerSet.Latency(pid), peerSet.GetPeer(pid).Latency)
+	})
+
+	t.Run("Latency is smoothed", func(t *testing.T) {
+		peerSet.getPeer(pid).Latency = 100 * time.Millisecond
+		peerSet.StartRoundTrip(pid)
+		peerSet.FinishRoundTrip(pid)
+
+		latency := peerSet.Latency(pid)
+		assert.Less(t, latency, 100*time.Millisecond)
+		assert.Greater(t, latency, 70*time.Millisecond)
+	})
+}
+
+func TestPeersToPing(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	peerSet := NewPeerSet(time.Minute)
+
+	pid1 := ts.RandPeerID()
+	pid2 := ts.RandPeerID()
+	pid3 := ts.RandPeerID()
+	pid4 := ts.RandPeerID()
+	peerSet.UpdateStatus(pid1, StatusCodeKnown)
+	peerSet.UpdateStatus(pid2, StatusCodeKnown)
+	peerSet.UpdateStatus(pid3, StatusCodeConnected)
+	peerSet.UpdateStatus(pid4, StatusCodeTrusty)
+	peerSet.UpdatePingable(pid1, true)
+	peerSet.UpdatePingable(pid3, true)
+	peerSet.UpdatePingable(pid4, true)
+
+	assert.ElementsMatch(t, []peer.ID{pid1, pid4}, peerSet.PeersToPing(time.Minute))
+
+	peerSet.StartRoundTrip(pid1)
+	assert.Equal(t, []peer.ID{pid4}, peerSet.PeersToPing(time.Minute))
+
+	peerSet.FinishRoundTrip(pid1)
+	assert.Equal(t, []peer.ID{pid4}, peerSet.PeersToPing(time.Minute))
+	assert.ElementsMatch(t, []peer.ID{pid1, pid4}, peerSet.PeersToPing(0))
+}
+
+func TestMedianNetworkHeight(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	peerSet := NewPeerSet(time.Minute)
+	assert.Zero(t, peerSet.MedianNetworkHeight())
+
+	addPeer := func(height uint32, status StatusCode) {
+		pid := ts.RandPeerID()
+		peerSet.UpdateStatus(pid, status)
+		peerSet.UpdateHeight(pid, height, ts.RandHash())
+	}
+
+	addPeer(100, StatusCodeKnown)
+	assert.Equal(t, uint32(100), peerSet.MedianNetworkHeight())
+
+	addPeer(102, StatusCodeTrusty)
+	assert.Equal(t, uint32(100), peerSet.MedianNetworkHeight())
+
+	// Lying peer
+	addPeer(1_000_000, StatusCodeKnown)
+	assert.Equal(t, uint32(102), peerSet.MedianNetworkHeight())
+
+	// Peers that are not known or trusty are ignored
+	addPeer(2_000_000, StatusCodeBanned)
+	addPeer(2_000_000, StatusCodeConnected)
+	assert.Equal(t, uint32(102), peerSet.MedianNetworkHeight())
+}
+
 func TestOpenSession(t *testing.T) {
 	ps := NewPeerSet(time.Minute)
 
 	pid := peer.ID("peer1")
-	session := ps.OpenSession(pid)
+	_, err := ps.OpenSession(pid)
+	assert.ErrorIs(t, err, PeerNotKnownError{PeerID: pid, Status: StatusCodeUnknown})
+
+	ps.UpdateStatus(pid, StatusCodeBanned)
+	_, err = ps.OpenSession(pid)
+	assert.ErrorIs(t, err, PeerNotKnownError{PeerID: pid, Status: StatusCodeBanned})
+	assert.False(t, ps.HasOpenSession(pid))
 
+	ps.UpdateStatus(pid, StatusCodeKnown)
+	session, err := ps.OpenSession(pid)
+	assert.NoError(t, err)
 	assert.NotNil(t, session)
 	assert.True(t, ps.HasOpenSession(pid))
 	assert.False(t, ps.HasOpenSession("peer2"))
 	assert.Equal(t, 1, ps.NumberOfOpenSessions())
 }
 
+func TestSessionProgress(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+	session1 := openTestSession(t, ps, "peer1")
+	session2 := openTestSession(t, ps, "peer2")
+
+	session1.SetRequestedBlocks(10)
+	session1.AddReceivedBlocks(3)
+	session1.AddReceivedBlocks(4)
+
+	assert.Equal(t, uint32(10), session1.RequestedBlocks())
+	assert.Equal(t, uint32(7), session1.Progress())
+	assert.Zero(t, session2.Progress())
+	assert.Equal(t, []SessionInfo{session1.Info(), session2.Info()}, ps.Sessions())
+
+	assert.Zero(t, session1.Continuation())
+	session1.SetContinuation(8)
+	assert.Equal(t, uint32(8), session1.Continuation())
+	assert.Equal(t, uint32(8), ps.Sessions()[0].Continuation)
+
+	ps.CloseSession(session1.SessionID())
+	assert.Equal(t, []SessionInfo{session2.Info()}, ps.Sessions())
+}
+
 func TestFindSession(t *testing.T) {
 	ps := NewPeerSet(time.Minute)
-	session := ps.OpenSession("peer1")
+	session := openTestSession(t, ps, "peer1")
 
 	// Test finding an existing session
 	foundSession := ps.FindSession(session.SessionID())
@@ -172,9 +395,9 @@ func TestNumberOfOpenSessions(t *testing.T) {
 	assert.Equal(t, 0, ps.NumberOfOpenSessions())
 
 	// Test when there are multiple open sessions
-	ps.OpenSession("peer1")
-	ps.OpenSession("peer2")
-	ps.OpenSession("peer3")
+	openTestSession(t, ps, "peer1")
+	openTestSession(t, ps, "peer2")
+	openTestSession(t, ps, "peer3")
 
 	assert.Equal(t, 3, ps.NumberOfOpenSessions())
 }
@@ -186,14 +409,14 @@ func TestHasAnyOpenSession(t *testing.T) {
 	assert.False(t, ps.HasAnyOpenSession())
 
 	// Test when there are open sessions
-	ps.OpenSession("peer1")
+	openTestSession(t, ps, "peer1")
 
 	assert.True(t, ps.HasAnyOpenSession())
 }
 
 func TestCloseSession(t *testing.T) {
 	ps := NewPeerSet(time.Minute)
-	session := ps.OpenSession("peer1")
+	session := openTestSession(t, ps, "peer1")
 
 	// Test closing an existing session
 	ps.CloseSession(session.SessionID())
@@ -210,7 +433,7 @@ func TestRemoveExpiredSessions(t *testing.T) {
 	ps := NewPeerSet(time.Second)
 
 	pid := peer.ID("peer1")
-	session := ps.OpenSession(pid)
+	session := openTestSession(t, ps, pid)
 	session.SetLastResponseCode(message.ResponseCodeOK)
 
 	assert.NotNil(t, session)
@@ -219,3 +442,54 @@ func TestRemoveExpiredSessions(t *testing.T) {
 	time.Sleep(time.Second)
 	assert.False(t, ps.HasAnyOpenSession())
 }
+
+func TestIdlePeers(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	idle := peer.ID("idle")
+	active := peer.ID("active")
+	trusty := peer.ID("trusty")
+	silent := peer.ID("silent")
+	disconnected := peer.ID("disconnected")
+
+	ps.UpdateStatus(idle, StatusCodeKnown)
+	ps.UpdateStatus(active, StatusCodeKnown)
+	ps.UpdateStatus(trusty, StatusCodeTrusty)
+	ps.UpdateStatus(silent, StatusCodeConnected)
+	ps.UpdateStatus(disconnected, StatusCodeDisconnected)
+
+	ps.UpdateLastSent(idle)
+	ps.UpdateLastSent(trusty)
+	ps.UpdateLastReceived(disconnected)
+	time.Sleep(20 * time.Millisecond)
+	ps.UpdateLastReceived(active)
+
+	assert.Equal(t, []peer.ID{idle}, ps.IdlePeers(10*time.Millisecond))
+	assert.Empty(t, ps.IdlePeers(time.Minute))
+}
+
+func TestExchangeablePeers(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	good := peer.ID("good")
+	inbound := peer.ID("inbound")
+	relayed := peer.ID("relayed")
+	unknown := peer.ID("unknown")
+	noAddr := peer.ID("no-addr")
+
+	for _, pid := range []peer.ID{good, inbound, relayed, unknown, noAddr} {
+		ps.UpdateStatus(pid, StatusCodeKnown)
+		ps.UpdateDirection(pid, lp2pnetwork.DirOutbound)
+		ps.UpdateAddress(pid, "/ip4/1.2.3.4/tcp/21888")
+	}
+	ps.UpdateDirection(inbound, lp2pnetwork.DirInbound)
+	ps.UpdateRelayed(relayed, true)
+	ps.UpdateStatus(unknown, StatusCodeConnected)
+	ps.UpdateAddress(noAddr, "")
+
+	peers := ps.ExchangeablePeers()
+	assert.Len(t, peers, 1)
+	assert.Equal(t, good, peers[0].PeerID)
+
+	assert.ElementsMatch(t, []peer.ID{good, inbound, relayed, noAddr}, ps.KnownPeers())
+}
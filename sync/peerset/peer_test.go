@@ -42,3 +42,26 @@ func TestPeer(t *testing.T) {
 		assert.False(t, p2.HasNetworkService())
 	})
 }
+
+func TestIsFreeRider(t *testing.T) {
+	tests := []struct {
+		served   int64
+		received int64
+		freeRide bool
+	}{
+		{100, 0, false}, // within the free blocks
+		{101, 0, true},
+		{300, 100, false}, // ratio is 3
+		{301, 100, true},
+		{1000, 1000, false},
+	}
+
+	for _, tt := range tests {
+		p := NewPeer(peer.ID("peer-1"))
+		p.ServedBlocks = tt.served
+		p.ReceivedBlocks = tt.received
+
+		assert.Equal(t, tt.freeRide, p.IsFreeRider(3, 100),
+			"served: %v, received: %v", tt.served, tt.received)
+	}
+}
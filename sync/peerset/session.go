@@ -11,22 +11,29 @@ import (
 
 type Session struct {
 	lk   sync.RWMutex
-	data sessionData
+	data SessionInfo
 }
 
-type sessionData struct {
+// SessionInfo is a snapshot of the state of a download session.
+type SessionInfo struct {
 	SessionID        int
 	PeerID           peer.ID
 	LastResponseCode message.ResponseCode
+	LastRejectCode   message.RejectCode
 	LastActivityAt   time.Time
+	OpenedAt         time.Time
+	RequestedBlocks  uint32
+	ReceivedBlocks   uint32
+	Continuation     uint32
 }
 
 func newSession(id int, peerID peer.ID) *Session {
 	return &Session{
-		data: sessionData{
+		data: SessionInfo{
 			SessionID:      id,
 			PeerID:         peerID,
 			LastActivityAt: util.Now(),
+			OpenedAt:       util.Now(),
 		},
 	}
 }
@@ -39,6 +46,51 @@ func (s *Session) SetLastResponseCode(code message.ResponseCode) {
 	s.data.LastActivityAt = util.Now()
 }
 
+func (s *Session) SetLastRejectCode(code message.RejectCode) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.data.LastRejectCode = code
+}
+
+// SetRequestedBlocks sets the number of blocks requested in this session.
+func (s *Session) SetRequestedBlocks(count uint32) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.data.RequestedBlocks = count
+}
+
+// AddReceivedBlocks increases the number of blocks received in this session.
+func (s *Session) AddReceivedBlocks(count uint32) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.data.ReceivedBlocks += count
+}
+
+// SetContinuation sets the height that the remaining blocks of this session are requested from.
+func (s *Session) SetContinuation(height uint32) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.data.Continuation = height
+}
+
+func (s *Session) LastResponseCode() message.ResponseCode {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.data.LastResponseCode
+}
+
+func (s *Session) LastRejectCode() message.RejectCode {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.data.LastRejectCode
+}
+
 func (s *Session) PeerID() peer.ID {
 	s.lk.RLock()
 	defer s.lk.RUnlock()
@@ -59,3 +111,52 @@ func (s *Session) LastActivityAt() time.Time {
 
 	return s.data.LastActivityAt
 }
+
+func (s *Session) OpenedAt() time.Time {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.data.OpenedAt
+}
+
+func (s *Session) RequestedBlocks() uint32 {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.data.RequestedBlocks
+}
+
+// Progress returns the number of blocks received so far in this session.
+func (s *Session) Progress() uint32 {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.data.ReceivedBlocks
+}
+
+// Continuation returns the height that the remaining blocks of this session are requested from.
+// It is zero if the peer hasn't sent any partial response.
+func (s *Session) Continuation() uint32 {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.data.Continuation
+}
+
+// Info returns a snapshot of the session state.
+func (s *Session) Info() SessionInfo {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.data
+}
+
+// Age returns how long the session has been open.
+func (si SessionInfo) Age() time.Duration {
+	return util.Now().Sub(si.OpenedAt)
+}
+
+// IdleTime returns how long the session has been without any response.
+func (si SessionInfo) IdleTime() time.Duration {
+	return util.Now().Sub(si.LastActivityAt)
+}
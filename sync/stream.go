@@ -0,0 +1,187 @@
+package sync
+
+import (
+	"io"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+const (
+	// maxStreamReaders is the maximum number of the peers whose streams are read at the same time.
+	maxStreamReaders = 16
+
+	// maxQueuedStreams is the maximum number of the streams of a peer that wait to be read.
+	maxQueuedStreams = 32
+
+	// maxStreamWriters is the maximum number of the block streams that are served at the same time.
+	maxStreamWriters = 4
+)
+
+// streamBundle is a bundle read from a stream, waiting to be processed by the receive loop.
+type streamBundle struct {
+	bdl    *bundle.Bundle
+	result chan error
+}
+
+// startStreamReader queues the stream to be read in its own goroutine,
+// so a slow peer can't stall the receive loop.
+// The streams of each peer are read in order, one at a time.
+// If there are too many streams to read, the stream is dropped.
+func (sync *synchronizer) startStreamReader(se *network.StreamMessage) {
+	queue, ok := sync.streamQueues[se.Source]
+	if !ok {
+		if len(sync.streamQueues) >= maxStreamReaders {
+			sync.logger.Debug("too many peers are streaming, dropping the stream", "source", se.Source)
+			sync.closeStream(se)
+
+			return
+		}
+
+		queue = make(chan *network.StreamMessage, maxQueuedStreams)
+		sync.streamQueues[se.Source] = queue
+		go sync.readStreams(se.Source, queue)
+	}
+
+	select {
+	case queue <- se:
+	default:
+		sync.logger.Debug("too many queued streams, dropping the stream", "source", se.Source)
+		sync.closeStream(se)
+	}
+}
+
+// readStreams reads the queued streams of the peer, until the receive loop closes the queue.
+func (sync *synchronizer) readStreams(pid peer.ID, queue chan *network.StreamMessage) {
+	for se := range queue {
+		sync.readStream(se, sync.processInLoop)
+
+		if len(queue) == 0 {
+			select {
+			case sync.streamIdleCh <- pid:
+			case <-sync.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// closeIdleStreamQueue closes the stream queue of the peer, if no stream is waiting in it.
+func (sync *synchronizer) closeIdleStreamQueue(pid peer.ID) {
+	queue, ok := sync.streamQueues[pid]
+	if ok && len(queue) == 0 {
+		close(queue)
+		delete(sync.streamQueues, pid)
+	}
+}
+
+// processInLoop passes the bundle to the receive loop and waits for the result.
+func (sync *synchronizer) processInLoop(bdl *bundle.Bundle) error {
+	sb := streamBundle{bdl: bdl, result: make(chan error, 1)}
+	select {
+	case sync.streamCh <- sb:
+	case <-sync.ctx.Done():
+		return sync.ctx.Err()
+	}
+
+	select {
+	case err := <-sb.result:
+		return err
+	case <-sync.ctx.Done():
+		return sync.ctx.Err()
+	}
+}
+
+// readStream reads the bundles of the stream one by one and processes them.
+// A stream usually carries a single bundle, but the blocks can be streamed
+// in multiple bundles. They are committed as they arrive,
+// so the memory usage is bounded regardless of the number of blocks.
+func (sync *synchronizer) readStream(se *network.StreamMessage, process func(*bundle.Bundle) error) {
+	reset := false
+	defer func() {
+		if reset {
+			sync.resetStream(se)
+		} else {
+			sync.closeStream(se)
+		}
+	}()
+
+	sr := sync.firewall.NewStreamReader(se.Reader, se.Source)
+	for {
+		// A stalled peer shouldn't keep us reading forever.
+		// The deadline is renewed for each bundle, so the long streams are not cut off.
+		sync.setReadDeadline(se.Reader)
+
+		bdl := sr.Next()
+		if bdl == nil {
+			return
+		}
+
+		err := process(bdl)
+		if err != nil {
+			sync.logger.Warn("error on parsing a Stream bundle",
+				"source", se.Source, "bundle", bdl, "error", err)
+			sync.peerSet.IncreaseInvalidBundlesCounter(bdl.Initiator)
+
+			return
+		}
+
+		// Only the blocks responses can be streamed.
+		if bdl.Message.Type() != message.TypeBlocksResponse {
+			return
+		}
+
+		// The sessions are closed on pausing the sync,
+		// so the rest of the blocks are not needed anymore.
+		if sync.paused.Load() {
+			sync.logger.Debug("sync is paused, resetting the stream", "source", se.Source)
+			reset = true
+
+			return
+		}
+
+		sync.setReadDeadline(se.Reader)
+		if !sr.More() {
+			return
+		}
+	}
+}
+
+func (sync *synchronizer) closeStream(se *network.StreamMessage) {
+	if err := se.Reader.Close(); err != nil {
+		// TODO: write test for me
+		sync.logger.Warn("error on closing stream", "error", err, "source", se.Source)
+	}
+}
+
+// resetStream aborts the stream, so the peer stops writing into it.
+// If the stream can't be reset, it is closed.
+func (sync *synchronizer) resetStream(se *network.StreamMessage) {
+	r, ok := se.Reader.(interface{ Reset() error })
+	if !ok {
+		sync.closeStream(se)
+
+		return
+	}
+
+	if err := r.Reset(); err != nil {
+		sync.logger.Warn("error on resetting stream", "error", err, "source", se.Source)
+	}
+}
+
+func (sync *synchronizer) setReadDeadline(r io.Reader) {
+	if d, ok := r.(interface{ SetReadDeadline(time.Time) error }); ok &&
+		sync.config.BlockRequestTimeout > 0 {
+		_ = d.SetReadDeadline(time.Now().Add(sync.config.BlockRequestTimeout))
+	}
+}
+
+func (sync *synchronizer) setWriteDeadline(w io.Writer) {
+	if d, ok := w.(interface{ SetWriteDeadline(time.Time) error }); ok &&
+		sync.config.BlockRequestTimeout > 0 {
+		_ = d.SetWriteDeadline(time.Now().Add(sync.config.BlockRequestTimeout))
+	}
+}
@@ -3,10 +3,18 @@ package sync
 import (
 	"context"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sort"
+	"sync/atomic"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
@@ -16,9 +24,12 @@ import (
 	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/service"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
+	"golang.org/x/exp/slices"
 )
 
 // IMPORTANT NOTES:
@@ -43,39 +54,112 @@ type synchronizer struct {
 	networkCh   <-chan network.Event
 	network     network.Network
 	logger      *logger.SubLogger
+
+	// helloSigner signs the Hello messages of the node,
+	// and helloVerifier verifies the Hello messages of the peers.
+	helloSigner   message.HelloSigner
+	helloVerifier message.HelloVerifier
+
+	// peerOrder arranges the candidate peers for downloading blocks, before they are sorted by latency.
+	// It can be replaced in tests to make the peer selection deterministic.
+	peerOrder func(peers []peerset.Peer)
+
+	// started is true once the node has joined the topics, until the synchronizer is stopped.
+	started atomic.Bool
+
+	// syncRate is the moving average of the committed blocks per second, stored as float64 bits.
+	syncRate       atomic.Uint64
+	lastCommitTime time.Time
+
+	// blockSources keeps the peers that served the cached blocks.
+	// The peers are credited once their blocks are committed.
+	// In the strict certificates mode, the peers that serve invalid blocks are suspended.
+	blockSources map[uint32]peer.ID
+
+	// peersReceivedAt keeps the time of the last accepted Peers message from each peer.
+	peersReceivedAt map[peer.ID]time.Time
+
+	// privateFilters blocks the private addresses shared by the peers,
+	// unless the node is forced to connect to the private networks.
+	privateFilters *multiaddr.Filters
+
+	// progressHeight is the last height that the stall watchdog observed, and progressAt is the time it changed.
+	progressHeight uint32
+	progressAt     time.Time
+
+	// paused is true when the sync is paused by the operator.
+	paused atomic.Bool
+
+	// clockSkewed is true when the local clock deviates from the clocks of the peers
+	// by more than the maximum clock skew.
+	clockSkewed atomic.Bool
+
+	// streamCh passes the bundles read by the stream readers to the receive loop,
+	// so the bundles are processed one at a time.
+	streamCh chan streamBundle
+
+	// streamQueues keeps the incoming streams of each peer, so they are read in order, one at a time.
+	// It is only accessed by the receive loop. The readers report their empty queues through streamIdleCh.
+	streamQueues map[peer.ID]chan *network.StreamMessage
+	streamIdleCh chan peer.ID
+
+	// streamWriters limits the number of the block streams that are served at the same time.
+	streamWriters chan struct{}
+
+	// cacheFull is true when the cache has reached the high watermark,
+	// until it is drained down to the low watermark.
+	cacheFull bool
 }
 
+// syncRateSmoothing is the smoothing factor of the sync rate moving average.
+const syncRateSmoothing = 0.2
+
 func NewSynchronizer(
 	conf *Config,
 	valKeys []*bls.ValidatorKey,
+	helloSigner message.HelloSigner,
+	helloVerifier message.HelloVerifier,
 	st state.Facade,
 	consMgr consensus.Manager,
 	net network.Network,
 	broadcastCh <-chan message.Message,
 ) (Synchronizer, error) {
 	sync := &synchronizer{
-		ctx:         context.Background(), // TODO, set proper context
-		config:      conf,
-		valKeys:     valKeys,
-		state:       st,
-		consMgr:     consMgr,
-		network:     net,
-		broadcastCh: broadcastCh,
-		networkCh:   net.EventChannel(),
+		ctx:           context.Background(), // TODO, set proper context
+		config:        conf,
+		valKeys:       valKeys,
+		state:         st,
+		helloSigner:   helloSigner,
+		helloVerifier: helloVerifier,
+		consMgr:       consMgr,
+		network:       net,
+		broadcastCh:   broadcastCh,
+		networkCh:     net.EventChannel(),
+		peerOrder:     shufflePeers,
+
+		streamCh:      make(chan streamBundle),
+		streamQueues:  make(map[peer.ID]chan *network.StreamMessage),
+		streamIdleCh:  make(chan peer.ID),
+		streamWriters: make(chan struct{}, maxStreamWriters),
 	}
 
 	peerSet := peerset.NewPeerSet(conf.SessionTimeout)
 	subLogger := logger.NewSubLogger("_sync", sync)
 	fw := firewall.NewFirewall(conf.Firewall, net, peerSet, st, subLogger)
-	ca, err := cache.NewCache(conf.CacheSize)
+	ca, err := newCache(conf)
 	if err != nil {
 		return nil, err
 	}
+	// Blocks persisted before restart might be committed already.
+	ca.Prune(st.LastBlockHeight())
 
 	sync.logger = subLogger
 	sync.cache = ca
 	sync.peerSet = peerSet
 	sync.firewall = fw
+	sync.blockSources = make(map[uint32]peer.ID)
+	sync.peersReceivedAt = make(map[peer.ID]time.Time)
+	sync.privateFilters = network.SubnetsToFilters(network.PrivateSubnets(), multiaddr.ActionDeny)
 
 	handlers := make(map[message.Type]messageHandler)
 
@@ -89,6 +173,9 @@ func NewSynchronizer(
 	handlers[message.TypeBlockAnnounce] = newBlockAnnounceHandler(sync)
 	handlers[message.TypeBlocksRequest] = newBlocksRequestHandler(sync)
 	handlers[message.TypeBlocksResponse] = newBlocksResponseHandler(sync)
+	handlers[message.TypePeers] = newPeersHandler(sync)
+	handlers[message.TypePing] = newPingHandler(sync)
+	handlers[message.TypePong] = newPongHandler(sync)
 
 	sync.handlers = handlers
 
@@ -99,22 +186,39 @@ func (sync *synchronizer) Start() error {
 	if err := sync.network.JoinGeneralTopic(); err != nil {
 		return err
 	}
-	// TODO: Not joining consensus topic when we are syncing
-	if err := sync.network.JoinConsensusTopic(); err != nil {
+	if err := sync.updateConsensusTopic(sync.numOfBlocksBehind()); err != nil {
 		return err
 	}
 
+	for _, str := range sync.config.ProtectedPeers {
+		// The protected peers are validated by the config check.
+		pid, _ := peer.Decode(str)
+		sync.network.ProtectPeer(pid)
+	}
+
 	go sync.receiveLoop()
 	go sync.broadcastLoop()
 
+	sync.started.Store(true)
+
 	return nil
 }
 
 func (sync *synchronizer) Stop() {
+	sync.started.Store(false)
 	sync.ctx.Done()
 }
 
+// IsReady checks if the node has joined the topics and is connected to at least one peer.
+func (sync *synchronizer) IsReady() bool {
+	return sync.started.Load() && sync.peerSet.NumberOfConnectedPeers() > 0
+}
+
 func (sync *synchronizer) moveConsensusToNewHeight() {
+	if !sync.participateInConsensus() {
+		return
+	}
+
 	stateHeight := sync.state.LastBlockHeight()
 	consHeight, _ := sync.consMgr.HeightRound()
 	if stateHeight >= consHeight {
@@ -136,10 +240,23 @@ func (sync *synchronizer) sayHello(to peer.ID) error {
 		sync.state.LastBlockHash(),
 		sync.state.Genesis().Hash(),
 	)
-	msg.Sign(sync.valKeys)
+	if sync.config.Compression {
+		msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagCompression)
+	}
+	if sync.config.PartialBlocks {
+		msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagPartialBlocks)
+	}
+	msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagBlocksStream)
+	msg.Flags = util.SetFlag(msg.Flags, message.HelloFlagPing)
+	msg.SignWith(sync.helloSigner)
 
 	sync.logger.Info("sending Hello message", "to", to)
-	return sync.sendTo(msg, to)
+	if err := sync.sendTo(msg, to); err != nil {
+		return err
+	}
+	sync.peerSet.StartRoundTrip(to)
+
+	return nil
 }
 
 func (sync *synchronizer) broadcastLoop() {
@@ -149,17 +266,93 @@ func (sync *synchronizer) broadcastLoop() {
 			return
 
 		case msg := <-sync.broadcastCh:
+			if backlog := len(sync.broadcastCh); sync.shouldDropBroadcast(msg, backlog) {
+				sync.logger.Debug("dropping low-priority message, broadcast channel is backed up",
+					"message", msg, "backlog", backlog)
+
+				continue
+			}
 			sync.broadcast(msg)
 		}
 	}
 }
 
+// shouldDropBroadcast checks if the message should be dropped instead of broadcasting it.
+// When the network is slow, the broadcast channel backs up and the producers stall.
+// In this case, the queries are dropped, since they are repeated by the consensus anyway.
+// The other messages, like votes and proposals, are always broadcasted.
+func (sync *synchronizer) shouldDropBroadcast(msg message.Message, backlog int) bool {
+	if sync.config.BroadcastBacklog == 0 || backlog < sync.config.BroadcastBacklog {
+		return false
+	}
+
+	switch msg.Type() {
+	case message.TypeQueryVotes, message.TypeQueryProposal:
+		return true
+	default:
+		return false
+	}
+}
+
 func (sync *synchronizer) receiveLoop() {
+	// The periodic update is disabled if the update interval is zero.
+	var updateTimer *time.Timer
+	var updateCh <-chan time.Time
+	if sync.config.UpdateInterval > 0 {
+		updateTimer = time.NewTimer(sync.nextUpdateInterval())
+		defer updateTimer.Stop()
+		updateCh = updateTimer.C
+	}
+
+	// The peer exchange is disabled if the pex interval is zero.
+	var pexCh <-chan time.Time
+	if sync.config.PexInterval > 0 {
+		pexTicker := time.NewTicker(sync.config.PexInterval)
+		defer pexTicker.Stop()
+		pexCh = pexTicker.C
+	}
+
+	// Pinging the idle peers is disabled if the ping interval is zero.
+	var pingCh <-chan time.Time
+	if sync.config.PingInterval > 0 {
+		pingTicker := time.NewTicker(sync.config.PingInterval)
+		defer pingTicker.Stop()
+		pingCh = pingTicker.C
+	}
+
+	// Requesting the missing partial blocks responses again is disabled if the continuation timeout is zero.
+	var continuationCh <-chan time.Time
+	if sync.config.PartialBlocks && sync.config.ContinuationTimeout > 0 {
+		continuationTicker := time.NewTicker(sync.config.ContinuationTimeout)
+		defer continuationTicker.Stop()
+		continuationCh = continuationTicker.C
+	}
+
 	for {
 		select {
 		case <-sync.ctx.Done():
 			return
 
+		case <-updateCh:
+			sync.disconnectIdlePeers()
+			sync.updateBlockchain()
+			updateTimer.Reset(sync.nextUpdateInterval())
+
+		case <-pexCh:
+			sync.exchangePeers()
+
+		case <-pingCh:
+			sync.pingPeers()
+
+		case <-continuationCh:
+			sync.resumePartialSessions()
+
+		case sb := <-sync.streamCh:
+			sb.result <- sync.processIncomingBundle(sb.bdl)
+
+		case pid := <-sync.streamIdleCh:
+			sync.closeIdleStreamQueue(pid)
+
 		case e := <-sync.networkCh:
 			switch e.Type() {
 			case network.EventTypeGossip:
@@ -174,17 +367,8 @@ func (sync *synchronizer) receiveLoop() {
 
 			case network.EventTypeStream:
 				se := e.(*network.StreamMessage)
-				bdl := sync.firewall.OpenStreamBundle(se.Reader, se.Source)
-				if err := se.Reader.Close(); err != nil {
-					// TODO: write test for me
-					sync.logger.Warn("error on closing stream", "error", err, "source", se.Source)
-				}
-				err := sync.processIncomingBundle(bdl)
-				if err != nil {
-					sync.logger.Warn("error on parsing a Stream bundle",
-						"source", se.Source, "bundle", bdl, "error", err)
-					sync.peerSet.IncreaseInvalidBundlesCounter(bdl.Initiator)
-				}
+				sync.startStreamReader(se)
+
 			case network.EventTypeConnect:
 				ce := e.(*network.ConnectEvent)
 				sync.processConnectEvent(ce)
@@ -197,9 +381,30 @@ func (sync *synchronizer) receiveLoop() {
 	}
 }
 
+// nextUpdateInterval returns the update interval with a random jitter.
+// The jitter prevents the nodes that restarted together from requesting blocks
+// from the same peers at the same time.
+func (sync *synchronizer) nextUpdateInterval() time.Duration {
+	interval := sync.config.UpdateInterval
+	jitter := int64(interval) * int64(sync.config.UpdateJitter) / 100
+	if jitter > 0 {
+		interval += time.Duration(util.RandInt64(2*jitter+1) - jitter)
+	}
+
+	return interval
+}
+
 func (sync *synchronizer) processConnectEvent(ce *network.ConnectEvent) {
+	if p := sync.peerSet.GetPeer(ce.PeerID); p.IsBanned() {
+		sync.logger.Debug("banned peer connected, closing the connection", "pid", ce.PeerID)
+		sync.network.CloseConnection(ce.PeerID)
+		return
+	}
+
 	sync.peerSet.UpdateStatus(ce.PeerID, peerset.StatusCodeConnected)
 	sync.peerSet.UpdateAddress(ce.PeerID, ce.RemoteAddress)
+	sync.peerSet.UpdateDirection(ce.PeerID, ce.Direction)
+	sync.peerSet.UpdateRelayed(ce.PeerID, ce.Relayed)
 
 	if ce.SupportStream {
 		if err := sync.sayHello(ce.PeerID); err != nil {
@@ -209,8 +414,75 @@ func (sync *synchronizer) processConnectEvent(ce *network.ConnectEvent) {
 	}
 }
 
+// disconnectIdlePeers closes the connections with the peers that have been idle for the idle timeout.
+// The peers with an open session are kept, since they are serving us, and so are the protected peers.
+func (sync *synchronizer) disconnectIdlePeers() {
+	if sync.config.IdleTimeout == 0 {
+		return
+	}
+
+	for _, pid := range sync.peerSet.IdlePeers(sync.config.IdleTimeout) {
+		if sync.peerSet.HasOpenSession(pid) || sync.config.IsPeerProtected(pid) {
+			continue
+		}
+
+		sync.logger.Debug("peer is idle, closing the connection", "pid", pid, "timeout", sync.config.IdleTimeout)
+		sync.network.CloseConnection(pid)
+	}
+}
+
+// exchangePeers shares a random sample of the known peers with the connected known peers,
+// so they can discover new peers over time.
+// The protected peers are never shared, to keep them private.
+func (sync *synchronizer) exchangePeers() {
+	candidates := slices.DeleteFunc(sync.peerSet.ExchangeablePeers(), func(p peerset.Peer) bool {
+		return sync.config.IsPeerProtected(p.PeerID)
+	})
+	if len(candidates) == 0 {
+		return
+	}
+
+	//nolint:gosec // the sample doesn't need a secure random
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > sync.config.PexSampleSize {
+		candidates = candidates[:sync.config.PexSampleSize]
+	}
+
+	addrs := make([]string, 0, len(candidates))
+	for _, p := range candidates {
+		addrs = append(addrs, fmt.Sprintf("%s/p2p/%s", p.Address, p.PeerID))
+	}
+	msg := message.NewPeersMessage(addrs)
+
+	for _, pid := range sync.peerSet.KnownPeers() {
+		if err := sync.sendTo(msg, pid); err != nil {
+			sync.logger.Debug("sending Peers message failed", "to", pid, "error", err)
+		}
+	}
+}
+
+// pingPeers sends a Ping message to the peers that their latency is not measured recently.
+// The latency of the peers is measured by the block requests as well,
+// so only the idle peers are pinged.
+func (sync *synchronizer) pingPeers() {
+	msg := message.NewPingMessage()
+	for _, pid := range sync.peerSet.PeersToPing(sync.config.PingInterval) {
+		if err := sync.sendTo(msg, pid); err != nil {
+			sync.logger.Debug("sending Ping message failed", "to", pid, "error", err)
+
+			continue
+		}
+		sync.peerSet.StartRoundTrip(pid)
+	}
+}
+
 func (sync *synchronizer) processDisconnectEvent(de *network.DisconnectEvent) {
 	sync.peerSet.UpdateStatus(de.PeerID, peerset.StatusCodeDisconnected)
+	sync.peerSet.ResetClockOffset(de.PeerID)
+	delete(sync.peersReceivedAt, de.PeerID)
+	sync.checkClockSkew()
 }
 
 func (sync *synchronizer) processIncomingBundle(bdl *bundle.Bundle) error {
@@ -218,9 +490,25 @@ func (sync *synchronizer) processIncomingBundle(bdl *bundle.Bundle) error {
 		return nil
 	}
 
-	sync.logger.Info("received a bundle",
-		"initiator", bdl.Initiator, "bundle", bdl)
-	h := sync.handlers[bdl.Message.Type()]
+	// Gossip may echo back the bundles that we broadcasted ourselves.
+	if bdl.Initiator == sync.SelfID() {
+		sync.logger.Debug("dropping our own bundle", "bundle", bdl)
+
+		return nil
+	}
+
+	// The consensus messages are never sampled.
+	msgType := bdl.Message.Type()
+	switch msgType {
+	case message.TypeVote, message.TypeProposal,
+		message.TypeQueryVotes, message.TypeQueryProposal:
+		sync.logger.Info("received a bundle",
+			"initiator", bdl.Initiator, "bundle", bdl)
+	default:
+		sync.logger.InfoSampled(msgType.String(), "received a bundle",
+			"initiator", bdl.Initiator, "bundle", bdl)
+	}
+	h := sync.handlers[msgType]
 	if h == nil {
 		return errors.Errorf(errors.ErrInvalidMessage, "invalid message type: %v", bdl.Message.Type())
 	}
@@ -234,11 +522,69 @@ func (sync *synchronizer) String() string {
 		sync.cache.Len())
 }
 
+// BanPeer bans the peer for the given duration and closes the connection with it.
+// If the duration is zero, the peer is banned permanently.
+func (sync *synchronizer) BanPeer(pid peer.ID, duration time.Duration) {
+	sync.logger.Info("banning peer", "pid", pid, "duration", duration)
+
+	sync.peerSet.BanPeer(pid, duration)
+	sync.network.CloseConnection(pid)
+}
+
+// UnbanPeer lifts the ban of the peer.
+func (sync *synchronizer) UnbanPeer(pid peer.ID) {
+	sync.logger.Info("unbanning peer", "pid", pid)
+
+	sync.peerSet.UnbanPeer(pid)
+}
+
+// Pause stops downloading and processing the blocks, while the node stays connected to the peers.
+// The open sessions are closed, and the blocks received afterward are ignored.
+// It is useful for maintenance, like taking a snapshot of the store.
+// Note that the consensus of a validator node keeps committing blocks.
+func (sync *synchronizer) Pause() {
+	if sync.paused.Swap(true) {
+		return
+	}
+
+	sync.logger.Info("pausing the sync")
+	for _, s := range sync.peerSet.Sessions() {
+		sync.peerSet.CloseSession(s.SessionID)
+	}
+}
+
+// Resume resumes the sync. The blocks are downloaded again in the next update.
+func (sync *synchronizer) Resume() {
+	if !sync.paused.Swap(false) {
+		return
+	}
+
+	sync.logger.Info("resuming the sync")
+}
+
+// IsPaused checks if the sync is paused.
+func (sync *synchronizer) IsPaused() bool {
+	return sync.paused.Load()
+}
+
 // updateBlockchain checks whether the node's height is shorter than the network's height or not.
 // If the node's height is shorter than the network's height by more than two hours (720 blocks),
 // it should start downloading blocks from the network's nodes.
 // Otherwise, the node can request the latest blocks from the network.
 func (sync *synchronizer) updateBlockchain() {
+	numOfBlocks := sync.numOfBlocksBehind()
+	if err := sync.updateConsensusTopic(numOfBlocks); err != nil {
+		sync.logger.Warn("unable to update consensus topic subscription", "error", err)
+	}
+
+	if sync.paused.Load() {
+		sync.logger.Debug("sync is paused")
+		return
+	}
+
+	stalledPeers := sync.abandonStalledSessions()
+	stalledPeers = append(stalledPeers, sync.restartStalledSync(numOfBlocks)...)
+
 	// First, let's check if we have any open sessions.
 	// If there are any open sessions, we should wait for them to be closed.
 	// Otherwise, we can request the same blocks from different peers.
@@ -248,31 +594,212 @@ func (sync *synchronizer) updateBlockchain() {
 		return
 	}
 
-	blockInterval := sync.state.Params().BlockInterval()
-	curTime := util.RoundNow(int(blockInterval.Seconds()))
-	lastBlockTime := sync.state.LastBlockTime()
-	diff := curTime.Sub(lastBlockTime)
-	numOfBlocks := uint32(diff.Seconds() / blockInterval.Seconds())
-
 	if numOfBlocks <= 1 {
 		// We are sync
 		return
 	}
 
-	// Make sure we have committed the latest blocks inside the cache
 	LastBlockHeight := sync.state.LastBlockHeight()
+	maxHeight := uint32(math.MaxUint32)
+	if sync.checkCacheCapacity() {
+		// The cached blocks can't be committed while the next block is missing.
+		// Keep requesting the missing blocks below the cached ones,
+		// but don't request new blocks beyond them.
+		if sync.cache.HasBlockInCache(LastBlockHeight + 1) {
+			sync.logger.Debug("cache is full, waiting for the blocks to be committed",
+				"cached", sync.cache.Len())
+
+			return
+		}
+		maxHeight = sync.missingBlocksEnd(LastBlockHeight)
+		sync.logger.Debug("cache is full, requesting the missing blocks",
+			"from", LastBlockHeight+1, "to", maxHeight)
+	}
+
+	// Make sure we have committed the latest blocks inside the cache
 	for sync.cache.HasBlockInCache(LastBlockHeight + 1) {
 		LastBlockHeight++
 	}
 
 	sync.logger.Info("start syncing with the network", "numOfBlocks", numOfBlocks)
 	if numOfBlocks > LatestBlockInterval {
-		sync.downloadBlocks(LastBlockHeight, true)
+		sync.downloadBlocks(LastBlockHeight, maxHeight, true, stalledPeers)
 	} else {
-		sync.downloadBlocks(LastBlockHeight, false)
+		sync.downloadBlocks(LastBlockHeight, maxHeight, false, stalledPeers)
+	}
+}
+
+// missingBlocksEnd returns the height of the last missing block after the given height,
+// right before the first cached block.
+// The search is limited to the stream block interval.
+func (sync *synchronizer) missingBlocksEnd(height uint32) uint32 {
+	end := height + 1
+	for end-height < StreamBlockInterval && !sync.cache.HasBlockInCache(end+1) {
+		end++
+	}
+
+	return end
+}
+
+// abandonStalledSessions closes the sessions that have received no response
+// within the block request timeout. The sessions that keep receiving blocks are not abandoned,
+// no matter how long they have been open.
+// It returns the peers of the abandoned sessions.
+func (sync *synchronizer) abandonStalledSessions() []peer.ID {
+	if sync.config.BlockRequestTimeout == 0 {
+		return nil
+	}
+
+	stalledPeers := []peer.ID{}
+	for _, s := range sync.peerSet.Sessions() {
+		if s.IdleTime() > sync.config.BlockRequestTimeout {
+			sync.logger.Debug("block request timed out, abandon session",
+				"session-id", s.SessionID, "pid", s.PeerID, "progress", s.ReceivedBlocks, "age", s.Age())
+			sync.peerSet.CloseSession(s.SessionID)
+			stalledPeers = append(stalledPeers, s.PeerID)
+		}
+	}
+
+	return stalledPeers
+}
+
+// resumePartialSessions requests the remaining blocks of the partial sessions again,
+// if the next response has not been received within the continuation timeout.
+// The request or the response might be dropped, and waiting for them only stalls the session until it expires.
+func (sync *synchronizer) resumePartialSessions() {
+	for _, s := range sync.peerSet.Sessions() {
+		if s.Continuation == 0 || s.IdleTime() < sync.config.ContinuationTimeout {
+			continue
+		}
+
+		if sync.paused.Load() {
+			return
+		}
+
+		remaining := s.RequestedBlocks - s.ReceivedBlocks
+		sync.logger.Debug("partial response is missing, request the remaining blocks again",
+			"session-id", s.SessionID, "from", s.Continuation, "remaining", remaining, "pid", s.PeerID)
+
+		msg := message.NewBlocksRequestMessage(s.SessionID, s.Continuation, remaining)
+		if err := sync.sendTo(msg, s.PeerID); err != nil {
+			sync.logger.Warn("error on resuming session", "session-id", s.SessionID, "error", err)
+			sync.peerSet.CloseSession(s.SessionID)
+		}
 	}
 }
 
+// restartStalledSync is a watchdog for the syncing process.
+// If the node is behind the network and its height hasn't advanced within the stall timeout,
+// it closes all the sessions, so the blocks are requested again from other peers.
+// It returns the peers of the closed sessions.
+func (sync *synchronizer) restartStalledSync(numOfBlocks uint32) []peer.ID {
+	if sync.config.StallTimeout == 0 {
+		return nil
+	}
+
+	height := sync.state.LastBlockHeight()
+	if height != sync.progressHeight || numOfBlocks <= 1 {
+		sync.progressHeight = height
+		sync.progressAt = util.Now()
+
+		return nil
+	}
+
+	stalledFor := util.Now().Sub(sync.progressAt)
+	if stalledFor < sync.config.StallTimeout {
+		return nil
+	}
+
+	sessions := sync.peerSet.Sessions()
+	sync.logger.Warn("syncing has stalled, restarting the download",
+		"height", height, "stalled-for", stalledFor, "sessions", len(sessions))
+
+	stalledPeers := make([]peer.ID, 0, len(sessions))
+	for _, s := range sessions {
+		sync.peerSet.CloseSession(s.SessionID)
+		stalledPeers = append(stalledPeers, s.PeerID)
+	}
+	sync.progressAt = util.Now()
+
+	return stalledPeers
+}
+
+// numOfBlocksBehind estimates the number of blocks that the node is behind the network,
+// based on the time of the last committed block.
+// The time based estimation drifts if the local clock is not accurate,
+// therefore it is compared with the median height reported by the peers.
+func (sync *synchronizer) numOfBlocksBehind() uint32 {
+	blockInterval := sync.state.Params().BlockInterval()
+	curTime := util.RoundNow(int(blockInterval.Seconds()))
+	lastBlockTime := sync.state.LastBlockTime()
+	diff := curTime.Sub(lastBlockTime)
+	numOfBlocks := uint32(diff.Seconds() / blockInterval.Seconds())
+
+	networkHeight := sync.peerSet.MedianNetworkHeight()
+	lastHeight := sync.state.LastBlockHeight()
+	if networkHeight > lastHeight {
+		numOfBlocks = util.Max(numOfBlocks, networkHeight-lastHeight)
+	}
+
+	return numOfBlocks
+}
+
+// updateConsensusTopic joins the consensus topic when the node is close to the network's height.
+// A node that is far behind can't act on the consensus messages,
+// so it leaves the consensus topic until it catches up with the network.
+func (sync *synchronizer) updateConsensusTopic(numOfBlocks uint32) error {
+	if !sync.config.HasTopic(network.TopicIDConsensus) {
+		return nil
+	}
+
+	if sync.clockSkewed.Load() {
+		return sync.network.LeaveConsensusTopic()
+	}
+
+	if numOfBlocks <= ConsensusTopicBlockGap {
+		return sync.network.JoinConsensusTopic()
+	}
+
+	return sync.network.LeaveConsensusTopic()
+}
+
+// EstimatedSyncTime returns a rough estimation of the time needed to catch up with the network.
+// It returns zero if the node is synced or the sync rate is not known yet.
+func (sync *synchronizer) EstimatedSyncTime() time.Duration {
+	numOfBlocks := sync.numOfBlocksBehind()
+	if numOfBlocks <= 1 {
+		return 0
+	}
+
+	rate := math.Float64frombits(sync.syncRate.Load())
+	if rate == 0 {
+		return 0
+	}
+
+	return time.Duration(float64(numOfBlocks) / rate * float64(time.Second))
+}
+
+// updateSyncRate updates the moving average of the committed blocks per second.
+func (sync *synchronizer) updateSyncRate(committed int) {
+	if committed == 0 {
+		return
+	}
+
+	now := time.Now()
+	if !sync.lastCommitTime.IsZero() {
+		elapsed := now.Sub(sync.lastCommitTime).Seconds()
+		if elapsed > 0 {
+			rate := float64(committed) / elapsed
+			oldRate := math.Float64frombits(sync.syncRate.Load())
+			if oldRate != 0 {
+				rate = syncRateSmoothing*rate + (1-syncRateSmoothing)*oldRate
+			}
+			sync.syncRate.Store(math.Float64bits(rate))
+		}
+	}
+	sync.lastCommitTime = now
+}
+
 func (sync *synchronizer) prepareBundle(msg message.Message) *bundle.Bundle {
 	h := sync.handlers[msg.Type()]
 	if h == nil {
@@ -300,22 +827,77 @@ func (sync *synchronizer) prepareBundle(msg message.Message) *bundle.Bundle {
 }
 
 func (sync *synchronizer) sendTo(msg message.Message, to peer.ID) error {
-	bdl := sync.prepareBundle(msg)
+	bdl, data := sync.encodeBundle(msg, to)
 	if bdl != nil {
-		data, _ := bdl.Encode()
-		sync.peerSet.UpdateLastSent(to)
-		sync.peerSet.IncreaseSentBytesCounter(msg.Type(), int64(len(data)), &to)
-
 		err := sync.network.SendTo(data, to)
 		if err != nil {
+			sync.recordSendFailure(to)
+
 			return err
 		}
+		sync.peerSet.ResetSendFailures(to)
 		sync.logger.Info("sending bundle to a peer",
 			"bundle", bdl, "to", to)
 	}
 	return nil
 }
 
+// writeTo writes the message into an open stream to the peer.
+func (sync *synchronizer) writeTo(w io.Writer, msg message.Message, to peer.ID) error {
+	bdl, data := sync.encodeBundle(msg, to)
+	if bdl != nil {
+		_, err := w.Write(data)
+		if err != nil {
+			sync.recordSendFailure(to)
+
+			return err
+		}
+		sync.peerSet.ResetSendFailures(to)
+		sync.logger.Info("writing bundle to a stream",
+			"bundle", bdl, "to", to)
+	}
+	return nil
+}
+
+// encodeBundle prepares and encodes the bundle of the message for sending to the peer.
+func (sync *synchronizer) encodeBundle(msg message.Message, to peer.ID) (*bundle.Bundle, []byte) {
+	bdl := sync.prepareBundle(msg)
+	if bdl == nil {
+		return nil, nil
+	}
+
+	// Blocks responses are bandwidth-heavy,
+	// compress them if both sides support compression.
+	if msg.Type() == message.TypeBlocksResponse &&
+		sync.config.Compression &&
+		sync.peerSet.GetPeer(to).Compression {
+		bdl.CompressIt()
+	}
+
+	data, _ := bdl.Encode()
+	sync.peerSet.UpdateLastSent(to)
+	sync.peerSet.IncreaseSentBytesCounter(msg.Type(), int64(len(data)), &to)
+
+	return bdl, data
+}
+
+// recordSendFailure counts the consecutive send failures to the peer.
+// Once they reach the maximum, the peer is not selected for downloading blocks for a while
+// and the connection is closed, so the peer can reconnect with a fresh connection.
+func (sync *synchronizer) recordSendFailure(pid peer.ID) {
+	failures := sync.peerSet.IncreaseSendFailures(pid)
+	if sync.config.MaxSendFailures == 0 || failures < sync.config.MaxSendFailures {
+		return
+	}
+
+	sync.logger.Info("too many send failures, suspending peer",
+		"pid", pid, "failures", failures, "duration", sync.config.SuspendDuration)
+	sync.peerSet.SuspendPeer(pid, sync.config.SuspendDuration)
+	if !sync.config.IsPeerProtected(pid) {
+		sync.network.CloseConnection(pid)
+	}
+}
+
 func (sync *synchronizer) broadcast(msg message.Message) {
 	bdl := sync.prepareBundle(msg)
 	if bdl != nil {
@@ -344,91 +926,333 @@ func (sync *synchronizer) PeerSet() *peerset.PeerSet {
 	return sync.peerSet
 }
 
+// shufflePeers shuffles the peers randomly,
+// so the download requests are spread among the peers with the same latency.
+func shufflePeers(peers []peerset.Peer) {
+	//nolint:gosec // the peer order doesn't need a secure random
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+}
+
 // downloadBlocks starts downloading blocks from the network.
-func (sync *synchronizer) downloadBlocks(from uint32, onlyNodeNetwork bool) {
-	sync.logger.Debug("downloading blocks", "from", from)
+// The stalled peers are skipped, so their blocks are requested from other peers.
+// downloadBlocks requests the blocks after the given height, up to the maxHeight, from the peers.
+func (sync *synchronizer) downloadBlocks(from, maxHeight uint32, onlyNodeNetwork bool, stalledPeers []peer.ID) {
+	sync.logger.Debug("downloading blocks", "from", from, "maxHeight", maxHeight)
+
+	var peers []peerset.Peer
+	if onlyNodeNetwork {
+		// Close the connections with the peers that can't serve the complete blockchain.
+		sync.peerSet.IteratePeers(func(p *peerset.Peer) {
+			if p.IsKnownOrTrusty() && !p.HasNetworkService() &&
+				!sync.config.IsPeerProtected(p.PeerID) {
+				sync.network.CloseConnection(p.PeerID)
+			}
+		})
+		peers = sync.peerSet.NetworkPeers()
+	} else {
+		for _, pid := range sync.peerSet.KnownPeers() {
+			peers = append(peers, sync.peerSet.GetPeer(pid))
+		}
+	}
+
+	// Wait for enough peers to pick a healthy source.
+	// Meanwhile, ask the network to connect to the bootstrap and relay peers.
+	if len(peers) < sync.config.MinSyncPeers {
+		sync.logger.Info("not enough peers to download blocks",
+			"peers", len(peers), "min", sync.config.MinSyncPeers)
+		sync.network.ConnectBootstrapPeers()
+
+		return
+	}
+
+	// The peers are collected from a map, so they have no specific order.
+	sync.peerOrder(peers)
+
+	// Prefer the peers with lower latency. The peers with unknown latency come last.
+	// The relayed peers are slower and less reliable, so they come after the direct peers.
+	latencies := make(map[peer.ID]time.Duration, len(peers))
+	for _, p := range peers {
+		latencies[p.PeerID] = sync.peerSet.Latency(p.PeerID)
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		if peers[i].Relayed != peers[j].Relayed {
+			return !peers[i].Relayed
+		}
+
+		li, lj := latencies[peers[i].PeerID], latencies[peers[j].PeerID]
+		if li == 0 || lj == 0 {
+			return lj == 0 && li != 0
+		}
+
+		return li < lj
+	})
+
+	for _, p := range peers {
+		if from >= maxHeight {
+			break
+		}
 
-	sync.peerSet.IteratePeers(func(p *peerset.Peer) {
 		// Don't open a new session if we already have an open session with the same peer.
 		// This helps us to get blocks from different peers.
 		// TODO: write test for me
 		if sync.peerSet.HasOpenSession(p.PeerID) {
-			return
+			continue
 		}
 
-		if !p.IsKnownOrTrusty() {
-			return
+		if slices.Contains(stalledPeers, p.PeerID) {
+			continue
 		}
 
-		if onlyNodeNetwork && !p.HasNetworkService() {
-			if onlyNodeNetwork {
-				sync.network.CloseConnection(p.PeerID)
-			}
-			return
+		if p.IsSuspended() || p.IsPenalized() {
+			continue
 		}
 
+		if sync.peerSet.NumberOfOpenSessions() >= sync.config.MaxOpenSessions {
+			sync.logger.Debug("reached the maximum number of open sessions",
+				"max", sync.config.MaxOpenSessions)
+			break
+		}
+
+		// The sync might be paused by the operator meanwhile.
+		if sync.paused.Load() {
+			sync.logger.Debug("sync is paused, stop downloading blocks")
+			break
+		}
+
+		// Larger ranges are requested from the peers that stream the blocks,
+		// unless they are reached through a relay.
 		count := LatestBlockInterval
+		if p.BlocksStream && !p.Relayed {
+			count = StreamBlockInterval
+		}
+		count = min(count, maxHeight-from)
 		sync.logger.Debug("sending download request", "from", from+1, "count", count, "pid", p.PeerID)
-		session := sync.peerSet.OpenSession(p.PeerID)
+		session, err := sync.peerSet.OpenSession(p.PeerID)
+		if err != nil {
+			sync.logger.Warn("unable to open a session", "error", err)
+			continue
+		}
+		session.SetRequestedBlocks(count)
 		msg := message.NewBlocksRequestMessage(session.SessionID(), from+1, count)
-		err := sync.sendTo(msg, p.PeerID)
+		err = sync.sendTo(msg, p.PeerID)
 		if err != nil {
 			sync.peerSet.CloseSession(session.SessionID())
 		} else {
+			sync.peerSet.StartRoundTrip(p.PeerID)
 			from += count
 		}
-	})
+	}
+}
+
+func newCache(conf *Config) (*cache.Cache, error) {
+	if conf.PendingPath == "" {
+		return cache.NewCache(conf.CacheSize)
+	}
+
+	return cache.NewPersistentCache(conf.CacheSize, conf.PendingPath)
 }
 
 func (sync *synchronizer) tryCommitBlocks() error {
+	committed := 0
+	defer func() {
+		sync.updateSyncRate(committed)
+		sync.pruneBlockSources()
+		sync.checkCacheCapacity()
+	}()
+
 	height := sync.state.LastBlockHeight() + 1
+	trusted := !sync.config.StrictCertificates && sync.isLinkedToCheckpoint(height)
 	for {
 		blk := sync.cache.GetBlock(height)
 		if blk == nil {
 			break
 		}
-		cert := sync.cache.GetCertificate(height)
+		if sync.config.CheckpointHeight == height &&
+			blk.Hash().String() != sync.config.CheckpointHash {
+			return errors.Errorf(errors.ErrInvalidBlock,
+				"block hash doesn't match the checkpoint, expected %s, got %s",
+				sync.config.CheckpointHash, blk.Hash())
+		}
+		// The certificates of the blocks below the checkpoint are linked to the checkpoint hash,
+		// through the previous certificate of the next block. Only that certificate is trusted,
+		// since the cached certificate can be replaced by any other message.
+		// The certificate of the checkpoint block itself is not linked, so it is verified.
+		cert, linked := sync.linkedCertificate(height, trusted)
+		if cert == nil {
+			cert = sync.cache.GetCertificate(height)
+		}
 		if cert == nil {
 			break
 		}
-		trxs := blk.Transactions()
-		for i := 0; i < trxs.Len(); i++ {
-			trx := trxs[i]
-			if trx.IsPublicKeyStriped() {
-				pub, err := sync.state.PublicKey(trx.Payload().Signer())
-				if err != nil {
-					return err
-				}
-				trx.SetPublicKey(pub)
+		if err := sync.commitCachedBlock(blk, cert, linked); err != nil {
+			if sync.config.StrictCertificates {
+				sync.rejectCachedBlock(height, err)
 			}
-		}
 
-		if err := blk.BasicCheck(); err != nil {
 			return err
 		}
-		if err := cert.BasicCheck(); err != nil {
-			return err
-		}
-
-		sync.logger.Trace("committing block", "height", height, "block", blk)
-		if err := sync.state.CommitBlock(blk, cert); err != nil {
-			return err
+		sync.cache.RemoveBlock(height)
+		if pid, ok := sync.blockSources[height]; ok {
+			// Only the committed blocks are credited, so invalid blocks don't count as served.
+			sync.peerSet.IncreaseReceivedBlocksCounter(pid, 1)
+			delete(sync.blockSources, height)
 		}
+		committed++
 		height++
 	}
 
 	return nil
 }
 
+// linkedCertificate returns the certificate of the block at the given height,
+// from the previous certificate of the next cached block, if the blocks are linked to the checkpoint.
+// It returns nil if the certificate is not linked, so it should be verified.
+func (sync *synchronizer) linkedCertificate(height uint32, trusted bool) (*certificate.Certificate, bool) {
+	if !trusted || height >= sync.config.CheckpointHeight {
+		return nil, false
+	}
+
+	next := sync.cache.GetBlock(height + 1)
+	if next == nil || next.PrevCertificate() == nil {
+		return nil, false
+	}
+
+	return next.PrevCertificate(), true
+}
+
+// commitCachedBlock verifies and commits a block from the cache.
+// For the trusted blocks, the signatures are not verified.
+func (sync *synchronizer) commitCachedBlock(blk *block.Block, cert *certificate.Certificate, trusted bool) error {
+	trxs := blk.Transactions()
+	for i := 0; i < trxs.Len(); i++ {
+		trx := trxs[i]
+		if trx.IsPublicKeyStriped() {
+			pub, err := sync.state.PublicKey(trx.Payload().Signer())
+			if err != nil {
+				return err
+			}
+			trx.SetPublicKey(pub)
+		}
+	}
+
+	if err := blk.BasicCheck(); err != nil {
+		return err
+	}
+	if err := cert.BasicCheck(); err != nil {
+		return err
+	}
+
+	if trusted {
+		sync.logger.Trace("committing trusted block", "height", cert.Height(), "block", blk)
+
+		return sync.state.CommitTrustedBlock(blk, cert)
+	}
+
+	sync.logger.Trace("committing block", "height", cert.Height(), "block", blk)
+
+	return sync.state.CommitBlock(blk, cert)
+}
+
+// recordBlockSource remembers the peer that served the block at the given height.
+func (sync *synchronizer) recordBlockSource(height uint32, pid peer.ID) {
+	sync.blockSources[height] = pid
+}
+
+// pruneBlockSources forgets the peers that served the blocks which are
+// already committed or evicted from the cache.
+func (sync *synchronizer) pruneBlockSources() {
+	lastHeight := sync.state.LastBlockHeight()
+	for h := range sync.blockSources {
+		if h <= lastHeight || !sync.cache.HasBlockInCache(h) {
+			delete(sync.blockSources, h)
+		}
+	}
+}
+
+// rejectCachedBlock drops the block that failed the verification from the cache,
+// together with the next block that carries its certificate.
+// The peer that served the invalid block is penalized and its sessions are closed,
+// so the blocks are requested again from other peers.
+func (sync *synchronizer) rejectCachedBlock(height uint32, err error) {
+	sync.cache.RemoveBlock(height)
+	sync.cache.RemoveBlock(height + 1)
+	delete(sync.blockSources, height+1)
+
+	pid, ok := sync.blockSources[height]
+	if !ok {
+		return
+	}
+	delete(sync.blockSources, height)
+
+	sync.logger.Warn("invalid block received, penalizing peer",
+		"height", height, "pid", pid, "duration", sync.config.SuspendDuration, "error", err)
+	sync.peerSet.PenalizePeer(pid, sync.config.SuspendDuration)
+	for _, s := range sync.peerSet.Sessions() {
+		if s.PeerID == pid {
+			sync.peerSet.CloseSession(s.SessionID)
+		}
+	}
+}
+
+// checkCacheCapacity checks the cache against the watermarks and returns true if
+// the cache is full. The cache is full once it reaches the high watermark,
+// and remains full until it is drained down to the low watermark.
+// Requesting more blocks while the cache is full only evicts the downloaded blocks.
+func (sync *synchronizer) checkCacheCapacity() bool {
+	cached := sync.cache.Len()
+	if sync.cacheFull {
+		if cached <= sync.config.CacheSize*sync.config.CacheLowWatermark/100 {
+			sync.logger.Info("cache is drained, resuming the download", "cached", cached)
+			sync.cacheFull = false
+		}
+	} else if cached >= sync.config.CacheSize*sync.config.CacheHighWatermark/100 {
+		sync.logger.Info("cache is near capacity, pausing the download", "cached", cached)
+		sync.cacheFull = true
+	}
+
+	return sync.cacheFull
+}
+
+// isLinkedToCheckpoint checks if the cached blocks, from the given height up to the checkpoint,
+// are linked together and to the checkpoint hash.
+// If so, the blocks below the checkpoint can be trusted and committed without verifying their signatures.
+func (sync *synchronizer) isLinkedToCheckpoint(height uint32) bool {
+	if sync.config.CheckpointHeight == 0 || height > sync.config.CheckpointHeight {
+		return false
+	}
+
+	expectedHash, err := hash.FromString(sync.config.CheckpointHash)
+	if err != nil {
+		return false
+	}
+
+	for h := sync.config.CheckpointHeight; h >= height; h-- {
+		blk := sync.cache.GetBlock(h)
+		if blk == nil || blk.Hash() != expectedHash {
+			return false
+		}
+		expectedHash = blk.Header().PrevBlockHash()
+	}
+
+	return true
+}
+
 func (sync *synchronizer) prepareBlocks(from, count uint32) [][]byte {
 	ourHeight := sync.state.LastBlockHeight()
 
-	if from > ourHeight {
+	if from == 0 || from > ourHeight {
 		sync.logger.Debug("we don't have block at this height", "height", from)
 		return nil
 	}
 
-	if from+count > ourHeight {
+	// The count comes from the request, so it is clamped before allocating the blocks.
+	// The subtraction can't overflow, unlike `from+count`.
+	if count > sync.config.BlockPerMessage {
+		count = sync.config.BlockPerMessage
+	}
+	if count > ourHeight-from+1 {
 		count = ourHeight - from + 1
 	}
 
@@ -3,13 +3,17 @@ package sync
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"sort"
 	"testing"
 	"time"
 
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle"
@@ -17,8 +21,12 @@ import (
 	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/service"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/pactus-project/pactus/version"
@@ -40,16 +48,29 @@ type testData struct {
 
 func init() {
 	LatestBlockInterval = 23
+	StreamBlockInterval = 47
 }
 
 func testConfig() *Config {
 	return &Config{
-		Moniker:         "test",
-		SessionTimeout:  time.Second * 1,
-		NodeNetwork:     true,
-		BlockPerMessage: 11,
-		CacheSize:       1000,
-		Firewall:        firewall.DefaultConfig(),
+		Moniker:            "test",
+		SessionTimeout:     time.Second * 1,
+		NodeNetwork:        true,
+		BlockPerMessage:    11,
+		CacheSize:          1000,
+		CacheHighWatermark: 90,
+		CacheLowWatermark:  50,
+		MinSyncPeers:       1,
+		MaxOpenSessions:    8,
+		Topics:             []string{"general", "consensus"},
+		MaxTxsPerMessage:   100,
+		MaxSendFailures:    3,
+		SuspendDuration:    time.Minute,
+		BroadcastBacklog:   100,
+		PexSampleSize:      16,
+		MaxClockSkew:       time.Second * 10,
+		Firewall:           firewall.DefaultConfig(),
+		RunConsensus:       true,
 	}
 }
 
@@ -73,6 +94,8 @@ func setup(t *testing.T, config *Config) *testData {
 
 	Sync, err := NewSynchronizer(config,
 		valKeys,
+		message.NewBLSHelloSigner(valKeys),
+		message.BLSHelloVerifier{},
 		mockState,
 		consMgr,
 		mockNetwork,
@@ -188,6 +211,15 @@ func (td *testData) addPeer(t *testing.T, pub crypto.PublicKey, pid peer.ID, ser
 	td.sync.peerSet.UpdateStatus(pid, peerset.StatusCodeKnown)
 }
 
+func (td *testData) openSession(t *testing.T, pid peer.ID) *peerset.Session {
+	t.Helper()
+
+	session, err := td.sync.peerSet.OpenSession(pid)
+	require.NoError(t, err)
+
+	return session
+}
+
 func (td *testData) addPeerToCommittee(t *testing.T, pid peer.ID, pub crypto.PublicKey) {
 	t.Helper()
 
@@ -220,6 +252,93 @@ func TestStop(t *testing.T) {
 	td.sync.Stop()
 }
 
+func TestDropSelfOriginatedBundles(t *testing.T) {
+	td := setup(t, nil)
+
+	trx, _ := td.GenerateTestBondTx()
+	msg := message.NewTransactionsMessage([]*tx.Tx{trx})
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, td.sync.SelfID()))
+
+	assert.Nil(t, td.sync.state.PendingTx(trx.ID()))
+}
+
+func TestDropBroadcastOnBacklog(t *testing.T) {
+	td := setup(t, nil)
+
+	queryVotes := message.NewQueryVotesMessage(td.RandHeight(), td.RandRound())
+	queryProposal := message.NewQueryProposalMessage(td.RandHeight())
+	v, _ := td.GenerateTestPrepareVote(td.RandHeight(), td.RandRound())
+	vote := message.NewVoteMessage(v)
+
+	t.Run("Nothing is dropped below the backlog threshold", func(t *testing.T) {
+		assert.False(t, td.sync.shouldDropBroadcast(queryVotes, 99))
+		assert.False(t, td.sync.shouldDropBroadcast(queryProposal, 99))
+		assert.False(t, td.sync.shouldDropBroadcast(vote, 99))
+	})
+
+	t.Run("Queries are dropped when the channel backs up", func(t *testing.T) {
+		assert.True(t, td.sync.shouldDropBroadcast(queryVotes, 100))
+		assert.True(t, td.sync.shouldDropBroadcast(queryProposal, 100))
+		assert.False(t, td.sync.shouldDropBroadcast(vote, 100))
+	})
+
+	t.Run("Dropping is disabled", func(t *testing.T) {
+		td.sync.config.BroadcastBacklog = 0
+
+		assert.False(t, td.sync.shouldDropBroadcast(queryVotes, 1000))
+	})
+}
+
+func TestIsReady(t *testing.T) {
+	td := setup(t, nil)
+
+	assert.False(t, td.sync.IsReady(), "no connected peer")
+
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.Network))
+	assert.True(t, td.sync.IsReady())
+
+	td.sync.peerSet.UpdateStatus(pid, peerset.StatusCodeDisconnected)
+	assert.False(t, td.sync.IsReady(), "peer is disconnected")
+
+	td.sync.peerSet.UpdateStatus(pid, peerset.StatusCodeConnected)
+	assert.True(t, td.sync.IsReady())
+
+	td.sync.Stop()
+	assert.False(t, td.sync.IsReady(), "sync is stopped")
+}
+
+func TestIsHealthy(t *testing.T) {
+	config := testConfig()
+	config.MinConns = 2
+	td := setup(t, config)
+
+	// The last block is just committed, so the node is synced.
+	td.state.CommitTestBlocks(1)
+
+	pids := []peer.ID{td.RandPeerID(), td.RandPeerID()}
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pids[0], service.New(service.Network))
+	assert.False(t, td.sync.IsHealthy(), "not enough peers")
+
+	pub, _ = td.RandBLSKeyPair()
+	td.addPeer(t, pub, pids[1], service.New(service.Network))
+	assert.False(t, td.sync.IsHealthy(), "consensus is behind")
+
+	td.consMgr.MoveToNewHeight()
+	assert.True(t, td.sync.IsHealthy())
+
+	td.sync.config.RunConsensus = false
+	td.state.CommitTestBlocks(1)
+	assert.True(t, td.sync.IsHealthy(), "consensus is not checked")
+
+	td.sync.peerSet.UpdateHeight(pids[0], td.state.LastBlockHeight()+10, td.RandHash())
+	td.sync.peerSet.UpdateHeight(pids[1], td.state.LastBlockHeight()+10, td.RandHash())
+	assert.False(t, td.sync.IsHealthy(), "node is behind the network")
+}
+
 func TestConnectEvents(t *testing.T) {
 	td := setup(t, nil)
 
@@ -228,12 +347,29 @@ func TestConnectEvents(t *testing.T) {
 		td.network.EventCh <- &network.ConnectEvent{
 			PeerID:        pid,
 			RemoteAddress: "address_1",
+			Direction:     lp2pnetwork.DirOutbound,
 			SupportStream: true,
 		}
 		td.shouldPublishMessageWithThisType(t, td.network, message.TypeHello)
 		p := td.sync.peerSet.GetPeer(pid)
 		assert.Equal(t, p.Status, peerset.StatusCodeConnected)
 		assert.Equal(t, p.Address, "address_1")
+		assert.Equal(t, p.Direction, lp2pnetwork.DirOutbound)
+		assert.False(t, p.Relayed)
+	})
+
+	t.Run("Should record the peers reached through a relay", func(t *testing.T) {
+		pid := td.RandPeerID()
+		td.network.EventCh <- &network.ConnectEvent{
+			PeerID:        pid,
+			RemoteAddress: "address_1/p2p-circuit",
+			Direction:     lp2pnetwork.DirOutbound,
+			SupportStream: true,
+			Relayed:       true,
+		}
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeHello)
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.True(t, p.Relayed)
 	})
 
 	t.Run("Should NOT say hello when there is no stream connection", func(t *testing.T) {
@@ -241,12 +377,27 @@ func TestConnectEvents(t *testing.T) {
 		td.network.EventCh <- &network.ConnectEvent{
 			PeerID:        pid,
 			RemoteAddress: "address_1",
+			Direction:     lp2pnetwork.DirInbound,
 			SupportStream: false,
 		}
 		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeHello)
 		p := td.sync.peerSet.GetPeer(pid)
 		assert.Equal(t, p.Status, peerset.StatusCodeConnected)
 		assert.Equal(t, p.Address, "address_1")
+		assert.Equal(t, p.Direction, lp2pnetwork.DirInbound)
+	})
+
+	t.Run("Should NOT accept connection from banned peers", func(t *testing.T) {
+		pid := td.RandPeerID()
+		td.sync.BanPeer(pid, time.Minute)
+		td.network.EventCh <- &network.ConnectEvent{
+			PeerID:        pid,
+			RemoteAddress: "address_1",
+			SupportStream: true,
+		}
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeHello)
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.Equal(t, p.Status, peerset.StatusCodeBanned)
 	})
 }
 
@@ -297,7 +448,7 @@ func TestDownload(t *testing.T) {
 	})
 
 	t.Run("download request is rejected", func(t *testing.T) {
-		session := td.sync.peerSet.OpenSession(pid)
+		session := td.openSession(t, pid)
 		msg2 := message.NewBlocksResponseMessage(message.ResponseCodeRejected, t.Name(),
 			session.SessionID(), 1, nil, nil)
 		assert.NoError(t, td.receivingNewMessage(td.sync, msg2, pid))
@@ -307,11 +458,841 @@ func TestDownload(t *testing.T) {
 		td.sync.peerSet.CloseSession(bdl.Message.(*message.BlocksRequestMessage).SessionID)
 	})
 
+	t.Run("download request is rejected, peer is busy", func(t *testing.T) {
+		session := td.openSession(t, pid)
+		msg2 := message.NewBlocksRejectedMessage(message.RejectCodeBusy, t.Name(), session.SessionID())
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg2, pid))
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Equal(t, message.ResponseCodeRejected, session.LastResponseCode())
+		assert.Equal(t, message.RejectCodeBusy, session.LastRejectCode())
+	})
+
+	t.Run("download request is rejected, peer doesn't know us", func(t *testing.T) {
+		session := td.openSession(t, pid)
+		msg2 := message.NewBlocksRejectedMessage(message.RejectCodePeerNotKnown, t.Name(), session.SessionID())
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg2, pid))
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Equal(t, message.RejectCodePeerNotKnown, session.LastRejectCode())
+	})
+
 	t.Run("testing send failure", func(t *testing.T) {
 		td.network.SendError = fmt.Errorf("send error")
 
 		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
 
 		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.Equal(t, 1, p.SendFailures)
+		assert.False(t, p.IsSuspended())
 	})
+
+	t.Run("peer is suspended after repeated send failures", func(t *testing.T) {
+		for i := 1; i < td.sync.config.MaxSendFailures; i++ {
+			assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		}
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.True(t, p.IsSuspended())
+
+		// The peer is skipped, even though sending doesn't fail anymore.
+		td.network.SendError = nil
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	})
+
+	t.Run("peer is selected again after a successful send", func(t *testing.T) {
+		assert.NoError(t, td.sync.sayHello(pid))
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeHello)
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.Zero(t, p.SendFailures)
+		assert.False(t, p.IsSuspended())
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	})
+}
+
+func TestEstimatedSyncTime(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Unknown sync rate", func(t *testing.T) {
+		assert.Zero(t, td.sync.EstimatedSyncTime())
+	})
+
+	t.Run("Node is behind the network", func(t *testing.T) {
+		td.sync.updateSyncRate(1)
+		td.sync.lastCommitTime = time.Now().Add(-10 * time.Second)
+		td.sync.updateSyncRate(100)
+
+		numOfBlocks := td.sync.numOfBlocksBehind()
+		estimated := td.sync.EstimatedSyncTime()
+		assert.Greater(t, numOfBlocks, uint32(1))
+		assert.InDelta(t, float64(numOfBlocks)/10, estimated.Seconds(), 1)
+	})
+
+	t.Run("Node is synced", func(t *testing.T) {
+		blk, cert := td.GenerateTestBlockWithTime(td.state.LastBlockHeight()+1, time.Now())
+		td.state.TestStore.SaveBlock(blk, cert)
+
+		assert.Zero(t, td.sync.EstimatedSyncTime())
+	})
+}
+
+func TestConsensusTopic(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Node is behind the network, should not join the consensus topic", func(t *testing.T) {
+		assert.False(t, td.network.ConsensusTopicJoined)
+	})
+
+	t.Run("Node is synced, should join the consensus topic", func(t *testing.T) {
+		blk, cert := td.GenerateTestBlockWithTime(td.state.LastBlockHeight()+1, time.Now())
+		td.state.TestStore.SaveBlock(blk, cert)
+		td.sync.updateBlockchain()
+
+		assert.True(t, td.network.ConsensusTopicJoined)
+	})
+
+	t.Run("Node falls behind the network, should leave the consensus topic", func(t *testing.T) {
+		blockInterval := td.state.Params().BlockInterval()
+		blockTime := time.Now().Add(-blockInterval * time.Duration(ConsensusTopicBlockGap+2))
+		blk, cert := td.GenerateTestBlockWithTime(td.state.LastBlockHeight()+1, blockTime)
+		td.state.TestStore.SaveBlock(blk, cert)
+		td.sync.updateBlockchain()
+
+		assert.False(t, td.network.ConsensusTopicJoined)
+	})
+}
+
+func TestSkipConsensusTopic(t *testing.T) {
+	config := testConfig()
+	config.Topics = []string{"general"}
+	td := setup(t, config)
+
+	blk, cert := td.GenerateTestBlockWithTime(td.state.LastBlockHeight()+1, time.Now())
+	td.state.TestStore.SaveBlock(blk, cert)
+	td.sync.updateBlockchain()
+
+	assert.False(t, td.network.ConsensusTopicJoined)
+}
+
+func TestMinSyncPeers(t *testing.T) {
+	config := testConfig()
+	config.MinSyncPeers = 2
+	td := setup(t, config)
+
+	blk, cert := td.GenerateTestBlock(td.RandHeight())
+	pid1 := td.RandPeerID()
+	pid2 := td.RandPeerID()
+	msg := message.NewBlockAnnounceMessage(blk, cert)
+
+	t.Run("should not download blocks below the threshold", func(t *testing.T) {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid1, service.New(service.Network))
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Equal(t, 1, td.network.BootstrapConnects)
+	})
+
+	t.Run("should download blocks when the threshold is met", func(t *testing.T) {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid2, service.New(service.Network))
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid1))
+
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Equal(t, 1, td.network.BootstrapConnects)
+	})
+}
+
+func TestMaxOpenSessions(t *testing.T) {
+	config := testConfig()
+	config.MaxOpenSessions = 2
+	td := setup(t, config)
+
+	blk, cert := td.GenerateTestBlock(td.RandHeight())
+	msg := message.NewBlockAnnounceMessage(blk, cert)
+
+	pids := []peer.ID{td.RandPeerID(), td.RandPeerID(), td.RandPeerID()}
+	for _, pid := range pids {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+	}
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pids[0]))
+
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	assert.Equal(t, 2, td.sync.peerSet.NumberOfOpenSessions())
+}
+
+func TestCheckpoint(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	// Generating the blocks linked together, starting from the height 1.
+	blocks := make([]*block.Block, 0)
+	certs := make([]*certificate.Certificate, 0)
+	prevHash := hash.UndefHash
+	for height := uint32(1); height <= 5; height++ {
+		blk, cert := ts.GenerateTestBlock(height)
+		linkedBlk := block.MakeBlock(blk.Header().Version(), blk.Header().Time(), blk.Transactions(),
+			prevHash, blk.Header().StateRoot(), blk.PrevCertificate(),
+			blk.Header().SortitionSeed(), blk.Header().ProposerAddress())
+
+		blocks = append(blocks, linkedBlk)
+		certs = append(certs, cert)
+		prevHash = linkedBlk.Hash()
+	}
+
+	t.Run("Blocks are linked to the checkpoint", func(t *testing.T) {
+		config := testConfig()
+		config.CheckpointHeight = 5
+		config.CheckpointHash = blocks[4].Hash().String()
+		td := setup(t, config)
+
+		for i := 0; i < 4; i++ {
+			td.sync.cache.AddBlock(blocks[i])
+			td.sync.cache.AddCertificate(certs[i])
+		}
+		assert.False(t, td.sync.isLinkedToCheckpoint(1))
+
+		td.sync.cache.AddBlock(blocks[4])
+		td.sync.cache.AddCertificate(certs[4])
+		assert.True(t, td.sync.isLinkedToCheckpoint(1))
+		assert.True(t, td.sync.isLinkedToCheckpoint(3))
+		assert.False(t, td.sync.isLinkedToCheckpoint(6))
+
+		assert.NoError(t, td.sync.tryCommitBlocks())
+		assert.Equal(t, uint32(5), td.state.LastBlockHeight())
+
+		// The certificate of the checkpoint block is verified.
+		assert.Equal(t, []uint32{1, 2, 3, 4}, td.state.TestTrustedBlocks)
+	})
+
+	t.Run("Trusted certificates are taken from the next block", func(t *testing.T) {
+		config := testConfig()
+		config.CheckpointHeight = 5
+		config.CheckpointHash = blocks[4].Hash().String()
+		td := setup(t, config)
+
+		for i := 0; i < 5; i++ {
+			td.sync.cache.AddBlock(blocks[i])
+			td.sync.cache.AddCertificate(certs[i])
+		}
+		// Overwriting the cached certificate with a forged one.
+		td.sync.cache.AddCertificate(ts.GenerateTestCertificate(2))
+
+		assert.NoError(t, td.sync.tryCommitBlocks())
+		assert.Equal(t, uint32(5), td.state.LastBlockHeight())
+
+		assert.Equal(t, []uint32{1, 2, 3, 4}, td.state.TestTrustedBlocks)
+		for i, cert := range td.state.TestTrustedCerts {
+			assert.Equal(t, blocks[i+1].PrevCertificate().Hash(), cert.Hash())
+		}
+	})
+
+	t.Run("Blocks are not linked to the checkpoint", func(t *testing.T) {
+		config := testConfig()
+		config.CheckpointHeight = 5
+		config.CheckpointHash = ts.RandHash().String()
+		td := setup(t, config)
+
+		for i := 0; i < 5; i++ {
+			td.sync.cache.AddBlock(blocks[i])
+			td.sync.cache.AddCertificate(certs[i])
+		}
+		assert.False(t, td.sync.isLinkedToCheckpoint(1))
+
+		err := td.sync.tryCommitBlocks()
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidBlock)
+		assert.Equal(t, uint32(4), td.state.LastBlockHeight())
+	})
+
+	t.Run("Checkpoint is disabled", func(t *testing.T) {
+		td := setup(t, nil)
+
+		for i := 0; i < 5; i++ {
+			td.sync.cache.AddBlock(blocks[i])
+			td.sync.cache.AddCertificate(certs[i])
+		}
+		assert.False(t, td.sync.isLinkedToCheckpoint(1))
+	})
+}
+
+func TestDownloadPreferLowLatency(t *testing.T) {
+	config := testConfig()
+	config.MaxOpenSessions = 1
+	td := setup(t, config)
+
+	blk, cert := td.GenerateTestBlock(td.RandHeight())
+	msg := message.NewBlockAnnounceMessage(blk, cert)
+
+	pids := []peer.ID{td.RandPeerID(), td.RandPeerID(), td.RandPeerID()}
+	for _, pid := range pids {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+	}
+	td.sync.peerSet.StartRoundTrip(pids[1])
+	time.Sleep(time.Millisecond)
+	td.sync.peerSet.FinishRoundTrip(pids[1])
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pids[0]))
+
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	assert.True(t, td.sync.peerSet.HasOpenSession(pids[1]))
+}
+
+func TestDownloadDeprioritizeRelayedPeers(t *testing.T) {
+	config := testConfig()
+	config.MaxOpenSessions = 1
+	td := setup(t, config)
+
+	blk, cert := td.GenerateTestBlock(td.RandHeight())
+	msg := message.NewBlockAnnounceMessage(blk, cert)
+
+	pids := []peer.ID{td.RandPeerID(), td.RandPeerID()}
+	for _, pid := range pids {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+	}
+
+	// The relayed peer has a lower latency, but the direct peer is preferred.
+	td.sync.peerSet.UpdateRelayed(pids[1], true)
+	td.sync.peerSet.StartRoundTrip(pids[1])
+	time.Sleep(time.Millisecond)
+	td.sync.peerSet.FinishRoundTrip(pids[1])
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pids[0]))
+
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	assert.True(t, td.sync.peerSet.HasOpenSession(pids[0]))
+	assert.False(t, td.sync.peerSet.HasOpenSession(pids[1]))
+}
+
+func TestDownloadDeterministicPeerOrder(t *testing.T) {
+	config := testConfig()
+	config.MaxOpenSessions = 1
+	td := setup(t, config)
+
+	// Ordering the peers by their IDs makes the peer selection reproducible.
+	td.sync.peerOrder = func(peers []peerset.Peer) {
+		sort.Slice(peers, func(i, j int) bool {
+			return peers[i].PeerID < peers[j].PeerID
+		})
+	}
+
+	blk, cert := td.GenerateTestBlock(td.RandHeight())
+	msg := message.NewBlockAnnounceMessage(blk, cert)
+
+	pids := []peer.ID{td.RandPeerID(), td.RandPeerID(), td.RandPeerID()}
+	for _, pid := range pids {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		return pids[i] < pids[j]
+	})
+
+	assert.NoError(t, td.receivingNewMessage(td.sync, msg, pids[2]))
+
+	td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	assert.True(t, td.sync.peerSet.HasOpenSession(pids[0]))
+	assert.False(t, td.sync.peerSet.HasOpenSession(pids[1]))
+	assert.False(t, td.sync.peerSet.HasOpenSession(pids[2]))
+}
+
+func TestAbandonStalledSession(t *testing.T) {
+	config := testConfig()
+	config.MaxOpenSessions = 1
+	config.BlockRequestTimeout = 50 * time.Millisecond
+	td := setup(t, config)
+
+	blk, cert := td.GenerateTestBlock(td.RandHeight())
+	msg := message.NewBlockAnnounceMessage(blk, cert)
+
+	pids := []peer.ID{td.RandPeerID(), td.RandPeerID()}
+	for _, pid := range pids {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+	}
+	session := td.openSession(t, pids[0])
+
+	t.Run("Should keep the session that is receiving blocks", func(t *testing.T) {
+		// The session is older than the timeout, but it keeps receiving blocks.
+		for i := 0; i < 4; i++ {
+			time.Sleep(config.BlockRequestTimeout / 2)
+			session.SetLastResponseCode(message.ResponseCodeMoreBlocks)
+			session.AddReceivedBlocks(10)
+		}
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pids[0]))
+
+		assert.NotNil(t, td.sync.peerSet.FindSession(session.SessionID()))
+		assert.False(t, td.sync.peerSet.HasOpenSession(pids[1]))
+	})
+
+	t.Run("Should abandon the idle session", func(t *testing.T) {
+		time.Sleep(2 * config.BlockRequestTimeout)
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pids[0]))
+
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Nil(t, td.sync.peerSet.FindSession(session.SessionID()))
+		assert.False(t, td.sync.peerSet.HasOpenSession(pids[0]))
+		assert.True(t, td.sync.peerSet.HasOpenSession(pids[1]))
+	})
+}
+
+func TestRestartStalledSync(t *testing.T) {
+	config := testConfig()
+	config.MaxOpenSessions = 1
+	config.BlockRequestTimeout = 0
+	config.StallTimeout = 50 * time.Millisecond
+	td := setup(t, config)
+
+	blk, cert := td.GenerateTestBlock(td.RandHeight())
+	msg := message.NewBlockAnnounceMessage(blk, cert)
+
+	pids := []peer.ID{td.RandPeerID(), td.RandPeerID()}
+	for _, pid := range pids {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+	}
+
+	// The watchdog starts watching the height.
+	assert.Empty(t, td.sync.restartStalledSync(td.sync.numOfBlocksBehind()))
+	stalled := td.openSession(t, pids[0])
+
+	t.Run("Should not restart before the stall timeout", func(t *testing.T) {
+		assert.Empty(t, td.sync.restartStalledSync(td.sync.numOfBlocksBehind()))
+		assert.NotNil(t, td.sync.peerSet.FindSession(stalled.SessionID()))
+	})
+
+	t.Run("Should restart the download after the stall timeout", func(t *testing.T) {
+		// The peer keeps the session open, but the height doesn't advance.
+		time.Sleep(2 * config.StallTimeout)
+		stalled.SetLastResponseCode(message.ResponseCodeMoreBlocks)
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pids[0]))
+
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.Nil(t, td.sync.peerSet.FindSession(stalled.SessionID()))
+		assert.False(t, td.sync.peerSet.HasOpenSession(pids[0]))
+		assert.True(t, td.sync.peerSet.HasOpenSession(pids[1]))
+	})
+}
+
+func TestResumePartialSessions(t *testing.T) {
+	config := testConfig()
+	config.PartialBlocks = true
+	config.ContinuationTimeout = 50 * time.Millisecond
+	td := setup(t, config)
+
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.Network))
+
+	full := td.openSession(t, pid)
+	full.SetRequestedBlocks(10)
+	partial := td.openSession(t, pid)
+	partial.SetRequestedBlocks(10)
+	partial.AddReceivedBlocks(4)
+	partial.SetContinuation(24)
+
+	t.Run("Should wait for the response before the continuation timeout", func(t *testing.T) {
+		td.sync.resumePartialSessions()
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	})
+
+	t.Run("Should request the remaining blocks again after the continuation timeout", func(t *testing.T) {
+		time.Sleep(2 * config.ContinuationTimeout)
+		td.sync.resumePartialSessions()
+
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		req := bdl.Message.(*message.BlocksRequestMessage)
+		assert.Equal(t, partial.SessionID(), req.SessionID)
+		assert.Equal(t, uint32(24), req.From)
+		assert.Equal(t, uint32(6), req.Count)
+	})
+}
+
+func TestDisconnectIdlePeers(t *testing.T) {
+	config := testConfig()
+	config.IdleTimeout = 20 * time.Millisecond
+	td := setup(t, config)
+
+	idle := td.RandPeerID()
+	active := td.RandPeerID()
+	serving := td.RandPeerID()
+	trusty := td.RandPeerID()
+	for _, pid := range []peer.ID{idle, active, serving, trusty} {
+		td.network.AddAnotherNetwork(network.MockingNetwork(td.TestSuite, pid))
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+		td.sync.peerSet.UpdateLastSent(pid)
+	}
+	td.sync.peerSet.UpdateStatus(trusty, peerset.StatusCodeTrusty)
+	td.openSession(t, serving)
+
+	time.Sleep(2 * config.IdleTimeout)
+	td.sync.peerSet.UpdateLastReceived(active)
+
+	td.sync.disconnectIdlePeers()
+
+	assert.True(t, td.network.IsClosed(idle))
+	assert.False(t, td.network.IsClosed(active))
+	assert.False(t, td.network.IsClosed(serving))
+	assert.False(t, td.network.IsClosed(trusty))
+}
+
+func TestNumOfBlocksBehindWithSkewedClock(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(10)
+	lastHeight := td.state.LastBlockHeight()
+	numOfBlocks := td.sync.numOfBlocksBehind()
+
+	// Peers report the heights that are far ahead of the time based estimation.
+	for _, height := range []uint32{lastHeight + numOfBlocks + 100, lastHeight + numOfBlocks + 200} {
+		pid := td.RandPeerID()
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+		td.sync.peerSet.UpdateHeight(pid, height, td.RandHash())
+	}
+
+	assert.GreaterOrEqual(t, td.sync.numOfBlocksBehind(), numOfBlocks+100)
+	assert.Less(t, td.sync.numOfBlocksBehind(), numOfBlocks+200)
+}
+
+func TestNextUpdateInterval(t *testing.T) {
+	config := testConfig()
+	config.UpdateInterval = 10 * time.Second
+	config.UpdateJitter = 10
+	td := setup(t, config)
+
+	intervals := make(map[time.Duration]bool)
+	for i := 0; i < 100; i++ {
+		interval := td.sync.nextUpdateInterval()
+		assert.GreaterOrEqual(t, interval, 9*time.Second)
+		assert.LessOrEqual(t, interval, 11*time.Second)
+
+		intervals[interval] = true
+	}
+	assert.Greater(t, len(intervals), 1)
+
+	t.Run("No jitter", func(t *testing.T) {
+		// The receive loop reads the config, so a new synchronizer is set up.
+		config := testConfig()
+		config.UpdateInterval = 10 * time.Second
+		config.UpdateJitter = 0
+		td := setup(t, config)
+
+		assert.Equal(t, 10*time.Second, td.sync.nextUpdateInterval())
+	})
+}
+
+func TestResumeSyncingAfterRestart(t *testing.T) {
+	config := testConfig()
+	config.PendingPath = util.TempDirPath()
+	td := setup(t, config)
+
+	blk1, cert1 := td.GenerateTestBlock(1)
+	blk2, _ := td.GenerateTestBlock(2)
+	blk3, _ := td.GenerateTestBlock(3)
+	td.sync.cache.AddBlock(blk1)
+	td.sync.cache.AddCertificate(cert1)
+	td.sync.cache.AddBlock(blk2)
+	td.sync.cache.AddBlock(blk3)
+
+	// The third block can't be committed, its certificate is missing.
+	assert.NoError(t, td.sync.tryCommitBlocks())
+	assert.Equal(t, uint32(2), td.state.LastBlockHeight())
+	assert.False(t, td.sync.cache.HasBlockInCache(1))
+	assert.False(t, td.sync.cache.HasBlockInCache(2))
+
+	// Restarting the synchronizer
+	td.sync.Stop()
+	sync, err := NewSynchronizer(config, td.sync.valKeys,
+		td.sync.helloSigner, td.sync.helloVerifier, td.state, td.consMgr, td.network, td.broadcastCh)
+	assert.NoError(t, err)
+
+	restarted := sync.(*synchronizer)
+	assert.False(t, restarted.cache.HasBlockInCache(2))
+	assert.Equal(t, blk3.Hash(), restarted.cache.GetBlock(3).Hash())
+}
+
+func TestCacheFlowControl(t *testing.T) {
+	config := testConfig()
+	config.CacheSize = 10
+	config.CacheHighWatermark = 50
+	config.CacheLowWatermark = 20
+	td := setup(t, config)
+
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.Network))
+	td.sync.peerSet.UpdateHeight(pid, 1000, td.RandHash())
+
+	// These blocks can't be committed, since the next block is missing.
+	lastHeight := td.state.LastBlockHeight()
+	for i := uint32(2); i <= 6; i++ {
+		blk, _ := td.GenerateTestBlock(lastHeight + i)
+		td.sync.cache.AddBlock(blk)
+	}
+
+	t.Run("Should request the missing blocks when the cache is full", func(t *testing.T) {
+		td.sync.updateBlockchain()
+
+		assert.True(t, td.sync.cacheFull)
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		msg := bdl.Message.(*message.BlocksRequestMessage)
+		assert.Equal(t, lastHeight+1, msg.From)
+		assert.Equal(t, uint32(1), msg.Count)
+
+		td.sync.peerSet.CloseSession(msg.SessionID)
+	})
+
+	t.Run("Should not request new blocks while the next block is cached", func(t *testing.T) {
+		blk, _ := td.GenerateTestBlock(lastHeight + 1)
+		td.sync.cache.AddBlock(blk)
+		td.sync.updateBlockchain()
+
+		assert.True(t, td.sync.cacheFull)
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	})
+
+	t.Run("Should resume when the gap is filled and the cache is drained", func(t *testing.T) {
+		assert.NoError(t, td.sync.tryCommitBlocks())
+		assert.Equal(t, lastHeight+5, td.state.LastBlockHeight())
+		assert.Equal(t, 1, td.sync.cache.Len())
+		td.sync.updateBlockchain()
+
+		assert.False(t, td.sync.cacheFull)
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		msg := bdl.Message.(*message.BlocksRequestMessage)
+		assert.Equal(t, lastHeight+7, msg.From)
+	})
+}
+
+func TestPruneBlockSources(t *testing.T) {
+	config := testConfig()
+	config.StrictCertificates = true
+	config.CacheSize = 2
+	td := setup(t, config)
+
+	td.state.CommitTestBlocks(5)
+	lastHeight := td.state.LastBlockHeight()
+	pid := td.RandPeerID()
+
+	// The committed block
+	td.sync.recordBlockSource(lastHeight, pid)
+
+	// The cached blocks, the first one is evicted from the cache.
+	for i := uint32(2); i <= 4; i++ {
+		blk, _ := td.GenerateTestBlock(lastHeight + i)
+		td.sync.cache.AddBlock(blk)
+		td.sync.recordBlockSource(lastHeight+i, pid)
+	}
+
+	assert.NoError(t, td.sync.tryCommitBlocks())
+	assert.Equal(t, map[uint32]peer.ID{
+		lastHeight + 3: pid,
+		lastHeight + 4: pid,
+	}, td.sync.blockSources)
+}
+
+func TestRejectCachedBlock(t *testing.T) {
+	td := setup(t, nil)
+
+	td.state.CommitTestBlocks(5)
+	lastHeight := td.state.LastBlockHeight()
+	invalidServer := td.RandPeerID()
+	nextServer := td.RandPeerID()
+	for _, pid := range []peer.ID{invalidServer, nextServer} {
+		pub, _ := td.RandBLSKeyPair()
+		td.addPeer(t, pub, pid, service.New(service.Network))
+	}
+
+	blk1, _ := td.GenerateTestBlock(lastHeight + 1)
+	blk2, _ := td.GenerateTestBlock(lastHeight + 2)
+	td.sync.cache.AddBlock(blk1)
+	td.sync.cache.AddBlock(blk2)
+	td.sync.recordBlockSource(lastHeight+1, invalidServer)
+	td.sync.recordBlockSource(lastHeight+2, nextServer)
+
+	td.sync.rejectCachedBlock(lastHeight+1, errors.Errorf(errors.ErrInvalidBlock, "invalid"))
+	assert.False(t, td.sync.cache.HasBlockInCache(lastHeight+1))
+	assert.False(t, td.sync.cache.HasBlockInCache(lastHeight+2))
+	assert.Empty(t, td.sync.blockSources)
+
+	t.Run("Should penalize the peer that served the invalid block", func(t *testing.T) {
+		p := td.sync.peerSet.GetPeer(invalidServer)
+		assert.True(t, p.IsPenalized())
+	})
+
+	t.Run("Should not penalize the peer that served the next block", func(t *testing.T) {
+		p := td.sync.peerSet.GetPeer(nextServer)
+		assert.False(t, p.IsPenalized())
+		assert.False(t, p.IsSuspended())
+	})
+
+	t.Run("A successful send should not lift the penalty", func(t *testing.T) {
+		td.sync.peerSet.ResetSendFailures(invalidServer)
+
+		p := td.sync.peerSet.GetPeer(invalidServer)
+		assert.True(t, p.IsPenalized())
+	})
+}
+
+func TestProtectedPeers(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	protected := ts.RandPeerID()
+
+	config := testConfig()
+	config.IdleTimeout = 20 * time.Millisecond
+	config.MaxSendFailures = 1
+	config.ProtectedPeers = []string{protected.String()}
+	td := setup(t, config)
+
+	td.network.AddAnotherNetwork(network.MockingNetwork(td.TestSuite, protected))
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, protected, service.New(service.Network))
+
+	t.Run("Should protect the peer in the network", func(t *testing.T) {
+		assert.Contains(t, td.network.ProtectedPeers, protected)
+	})
+
+	t.Run("Should not disconnect the idle protected peer", func(t *testing.T) {
+		td.sync.peerSet.UpdateLastSent(protected)
+		time.Sleep(2 * config.IdleTimeout)
+
+		td.sync.disconnectIdlePeers()
+		assert.False(t, td.network.IsClosed(protected))
+	})
+
+	t.Run("Should suspend the protected peer without disconnecting it", func(t *testing.T) {
+		td.sync.recordSendFailure(protected)
+
+		p := td.sync.peerSet.GetPeer(protected)
+		assert.True(t, p.IsSuspended())
+		assert.False(t, td.network.IsClosed(protected))
+	})
+
+	t.Run("Should not ban the protected peer on rejecting its Hello", func(t *testing.T) {
+		valKey := td.RandValKey()
+		msg := message.NewHelloMessage(protected, "bad-genesis", 0, 0,
+			td.state.LastBlockHash(), td.RandHash())
+		msg.Sign([]*bls.ValidatorKey{valKey})
+
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, protected))
+		bdl := td.shouldPublishMessageWithThisType(t, td.network, message.TypeHelloAck)
+		assert.Equal(t, message.ResponseCodeRejected, bdl.Message.(*message.HelloAckMessage).ResponseCode)
+
+		p := td.sync.peerSet.GetPeer(protected)
+		assert.False(t, p.IsBanned())
+		assert.False(t, td.network.IsClosed(protected))
+	})
+}
+
+func TestPauseSync(t *testing.T) {
+	td := setup(t, nil)
+
+	pid := td.RandPeerID()
+	pub, _ := td.RandBLSKeyPair()
+	td.addPeer(t, pub, pid, service.New(service.Network))
+	td.sync.peerSet.UpdateHeight(pid, 1000, td.RandHash())
+	session := td.openSession(t, pid)
+
+	lastHeight := td.state.LastBlockHeight()
+	blk, cert := td.GenerateTestBlock(lastHeight + 1)
+	blkData, _ := blk.Bytes()
+
+	t.Run("Should close the open sessions on pausing", func(t *testing.T) {
+		td.sync.Pause()
+
+		assert.True(t, td.sync.IsPaused())
+		assert.False(t, td.sync.peerSet.HasAnyOpenSession())
+	})
+
+	t.Run("Should not request blocks while paused", func(t *testing.T) {
+		td.sync.updateBlockchain()
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	})
+
+	t.Run("Should ignore the received blocks while paused", func(t *testing.T) {
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, "", session.SessionID(),
+			lastHeight+1, [][]byte{blkData}, cert)
+		assert.NoError(t, td.receivingNewMessage(td.sync, msg, pid))
+
+		assert.Zero(t, td.sync.cache.Len())
+		assert.Equal(t, lastHeight, td.state.LastBlockHeight())
+
+		announce := message.NewBlockAnnounceMessage(blk, cert)
+		assert.NoError(t, td.receivingNewMessage(td.sync, announce, pid))
+
+		assert.Zero(t, td.sync.cache.Len())
+		assert.Equal(t, lastHeight, td.state.LastBlockHeight())
+	})
+
+	t.Run("Should not download blocks while paused", func(t *testing.T) {
+		td.sync.downloadBlocks(lastHeight, lastHeight+100, true, nil)
+
+		td.shouldNotPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+		assert.False(t, td.sync.peerSet.HasAnyOpenSession())
+	})
+
+	t.Run("Should reset the blocks streams while paused", func(t *testing.T) {
+		stream := new(bytes.Buffer)
+		for i := 0; i < 2; i++ {
+			msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, "", session.SessionID(),
+				lastHeight+1, [][]byte{blkData}, nil)
+			bdl := bundle.NewBundle(pid, msg)
+			bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagCarrierLibP2P|bundle.BundleFlagNetworkTestnet)
+			data, _ := bdl.Encode()
+			stream.Write(data)
+		}
+
+		reader := &resetRecorder{Reader: stream}
+		td.sync.readStream(&network.StreamMessage{Source: pid, Reader: reader}, func(_ *bundle.Bundle) error {
+			return nil
+		})
+
+		assert.True(t, reader.reset)
+		assert.False(t, reader.closed)
+		assert.NotZero(t, stream.Len(), "the second bundle should not be read")
+	})
+
+	t.Run("Should request blocks after resuming", func(t *testing.T) {
+		td.sync.Resume()
+		assert.False(t, td.sync.IsPaused())
+
+		td.sync.updateBlockchain()
+
+		td.shouldPublishMessageWithThisType(t, td.network, message.TypeBlocksRequest)
+	})
+}
+
+// resetRecorder records resetting and closing the stream.
+type resetRecorder struct {
+	io.Reader
+	reset  bool
+	closed bool
+}
+
+func (r *resetRecorder) Reset() error {
+	r.reset = true
+
+	return nil
+}
+
+func (r *resetRecorder) Close() error {
+	r.closed = true
+
+	return nil
 }
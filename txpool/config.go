@@ -5,12 +5,14 @@ import (
 )
 
 type Config struct {
-	MaxSize int `toml:"max_size"`
+	MaxSize int   `toml:"max_size"`
+	MinFee  int64 `toml:"min_fee"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
 		MaxSize: 2000,
+		MinFee:  0,
 	}
 }
 
@@ -19,6 +21,9 @@ func (conf *Config) BasicCheck() error {
 	if conf.MaxSize == 0 {
 		return errors.Errorf(errors.ErrInvalidConfig, "maxSize can't be negative or zero")
 	}
+	if conf.MinFee < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "minFee can't be negative")
+	}
 	return nil
 }
 
@@ -20,3 +20,9 @@ func TestDefaultConfigCheck(t *testing.T) {
 	c.MaxSize = 0
 	assert.Error(t, c.BasicCheck())
 }
+
+func TestNegativeMinFee(t *testing.T) {
+	c := DefaultConfig()
+	c.MinFee = -1
+	assert.Error(t, c.BasicCheck())
+}
@@ -11,6 +11,8 @@ type Reader interface {
 	PendingTx(id tx.ID) *tx.Tx
 	HasTx(id tx.ID) bool
 	Size() int
+	Stats() *Stats
+	MinFee() int64
 }
 
 type TxPool interface {
@@ -5,6 +5,7 @@ import (
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 )
 
 var _ TxPool = &MockTxPool{}
@@ -12,6 +13,12 @@ var _ TxPool = &MockTxPool{}
 // MockTxPool is a testing mock.
 type MockTxPool struct {
 	Txs []*tx.Tx
+
+	// AppendErr, if set, is returned when appending a transaction.
+	AppendErr error
+
+	// MinimumFee is returned as the minimum fee of the pool.
+	MinimumFee int64
 }
 
 func MockingTxPool() *MockTxPool {
@@ -46,16 +53,38 @@ func (m *MockTxPool) Size() int {
 	return len(m.Txs)
 }
 
+func (m *MockTxPool) Stats() *Stats {
+	stats := &Stats{
+		Total:    len(m.Txs),
+		PerType:  make(map[payload.Type]int),
+		Capacity: DefaultConfig().MaxSize,
+	}
+	for _, trx := range m.Txs {
+		stats.PerType[trx.Payload().Type()]++
+	}
+	return stats
+}
+
+func (m *MockTxPool) MinFee() int64 {
+	return m.MinimumFee
+}
+
 func (m *MockTxPool) String() string {
 	return ""
 }
 
 func (m *MockTxPool) AppendTx(trx *tx.Tx) error {
+	if m.AppendErr != nil {
+		return m.AppendErr
+	}
 	m.Txs = append(m.Txs, trx)
 	return nil
 }
 
 func (m *MockTxPool) AppendTxAndBroadcast(trx *tx.Tx) error {
+	if m.AppendErr != nil {
+		return m.AppendErr
+	}
 	m.Txs = append(m.Txs, trx)
 	return nil
 }
@@ -3,6 +3,7 @@ package txpool
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/sandbox"
@@ -10,9 +11,12 @@ import (
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/linkedlist"
 	"github.com/pactus-project/pactus/util/linkedmap"
 	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
 )
 
 type txPool struct {
@@ -22,11 +26,13 @@ type txPool struct {
 	checker     *execution.Execution
 	sandbox     sandbox.Sandbox
 	pools       map[payload.Type]*linkedmap.LinkedMap[tx.ID, *tx.Tx]
+	arrivals    map[tx.ID]time.Time
 	broadcastCh chan message.Message
+	eventCh     chan event.Event
 	logger      *logger.SubLogger
 }
 
-func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
+func NewTxPool(conf *Config, broadcastCh chan message.Message, eventCh chan event.Event) TxPool {
 	pending := make(map[payload.Type]*linkedmap.LinkedMap[tx.ID, *tx.Tx])
 
 	pending[payload.TypeTransfer] = linkedmap.NewLinkedMap[tx.ID, *tx.Tx](conf.sendPoolSize())
@@ -39,7 +45,9 @@ func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
 		config:      conf,
 		checker:     execution.NewChecker(),
 		pools:       pending,
+		arrivals:    make(map[tx.ID]time.Time),
 		broadcastCh: broadcastCh,
+		eventCh:     eventCh,
 	}
 
 	pool.logger = logger.NewSubLogger("_pool", pool)
@@ -62,6 +70,7 @@ func (p *txPool) SetNewSandboxAndRecheck(sb sandbox.Sandbox) {
 			if err := p.checkTx(trx); err != nil {
 				p.logger.Debug("invalid transaction after rechecking", "id", trx.ID())
 				pool.Remove(trx.ID())
+				delete(p.arrivals, trx.ID())
 			}
 		}
 	}
@@ -100,16 +109,36 @@ func (p *txPool) appendTx(trx *tx.Tx) error {
 		return nil
 	}
 
+	if IsBelowMinFee(trx, p.config.MinFee) {
+		err := errors.Errorf(errors.ErrInvalidFee,
+			"fee is below the minimum: %v, min: %v", trx.Fee(), p.config.MinFee)
+		p.logger.Debug("low fee transaction", "tx", trx, "error", err)
+		p.publishRejectedEvent(trx, err)
+		return err
+	}
+
 	if err := p.checkTx(trx); err != nil {
+		p.publishRejectedEvent(trx, err)
 		return err
 	}
 
+	if pool.Full() {
+		// The oldest transaction will be pruned from the pool.
+		delete(p.arrivals, pool.HeadNode().Data.Key)
+	}
 	pool.PushBack(trx.ID(), trx)
+	p.arrivals[trx.ID()] = util.Now()
 	p.logger.Debug("transaction appended into pool", "tx", trx)
 
 	return nil
 }
 
+// IsBelowMinFee checks if the fee of the transaction is below the given minimum.
+// Free transactions, like sortition transactions, are never below the minimum.
+func IsBelowMinFee(trx *tx.Tx, minFee int64) bool {
+	return !trx.IsFreeTx() && trx.Fee() < minFee
+}
+
 func (p *txPool) checkTx(trx *tx.Tx) error {
 	if err := p.checker.Execute(trx, p.sandbox); err != nil {
 		p.logger.Debug("invalid transaction", "tx", trx, "error", err)
@@ -118,12 +147,21 @@ func (p *txPool) checkTx(trx *tx.Tx) error {
 	return nil
 }
 
+// publishRejectedEvent publishes an event for the rejected transaction.
+func (p *txPool) publishRejectedEvent(trx *tx.Tx, err error) {
+	if p.eventCh == nil {
+		return
+	}
+	p.eventCh <- event.CreateRejectedTransactionEvent(trx.ID(), err.Error())
+}
+
 func (p *txPool) RemoveTx(id tx.ID) {
 	p.lk.Lock()
 	defer p.lk.Unlock()
 
 	for _, pool := range p.pools {
 		if pool.Remove(id) {
+			delete(p.arrivals, id)
 			break
 		}
 	}
@@ -208,6 +246,37 @@ func (p *txPool) Size() int {
 	return size
 }
 
+// Stats returns the statistics of the transaction pool.
+func (p *txPool) Stats() *Stats {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	stats := &Stats{
+		PerType: make(map[payload.Type]int),
+	}
+	for typ, pool := range p.pools {
+		stats.Total += pool.Size()
+		stats.Capacity += pool.Capacity()
+		stats.PerType[typ] = pool.Size()
+
+		// The head of each pool is the oldest transaction in it.
+		head := pool.HeadNode()
+		if head != nil {
+			age := util.Now().Sub(p.arrivals[head.Data.Key])
+			if age > stats.OldestTxAge {
+				stats.OldestTxAge = age
+			}
+		}
+	}
+
+	return stats
+}
+
+// MinFee returns the minimum fee for accepting transactions into the pool.
+func (p *txPool) MinFee() int64 {
+	return p.config.MinFee
+}
+
 func (p *txPool) String() string {
 	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v}",
 		p.pools[payload.TypeTransfer].Size(),
@@ -10,9 +10,12 @@ import (
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,6 +26,7 @@ type testData struct {
 	pool    *txPool
 	sandbox *sandbox.MockSandbox
 	ch      chan message.Message
+	eventCh chan event.Event
 }
 
 func setup(t *testing.T) *testData {
@@ -31,8 +35,9 @@ func setup(t *testing.T) *testData {
 	ts := testsuite.NewTestSuite(t)
 
 	ch := make(chan message.Message, 10)
+	eventCh := make(chan event.Event, 100)
 	sb := sandbox.MockingSandbox(ts)
-	p := NewTxPool(DefaultConfig(), ch)
+	p := NewTxPool(DefaultConfig(), ch, eventCh)
 	p.SetNewSandboxAndRecheck(sb)
 	pool := p.(*txPool)
 	assert.NotNil(t, pool)
@@ -42,6 +47,7 @@ func setup(t *testing.T) *testData {
 		pool:      pool,
 		sandbox:   sb,
 		ch:        ch,
+		eventCh:   eventCh,
 	}
 }
 
@@ -90,7 +96,11 @@ func TestAppendInvalidTransaction(t *testing.T) {
 	td := setup(t)
 
 	invalidTx, _ := td.GenerateTestTransferTx()
-	assert.Error(t, td.pool.AppendTx(invalidTx))
+	err := td.pool.AppendTx(invalidTx)
+	assert.Error(t, err)
+
+	e := <-td.eventCh
+	assert.Equal(t, event.CreateRejectedTransactionEvent(invalidTx.ID(), err.Error()), e)
 }
 
 // TestFullPool tests if the pool prunes the old transactions when it is full.
@@ -120,6 +130,34 @@ func TestFullPool(t *testing.T) {
 	assert.False(t, td.pool.HasTx(trxs[0].ID()))
 	assert.True(t, td.pool.HasTx(trxs[1].ID()))
 	assert.Equal(t, td.pool.Size(), td.pool.config.sendPoolSize())
+	assert.Len(t, td.pool.arrivals, td.pool.config.sendPoolSize())
+}
+
+func TestStats(t *testing.T) {
+	td := setup(t)
+
+	stats := td.pool.Stats()
+	assert.Zero(t, stats.Total)
+	assert.Zero(t, stats.OldestTxAge)
+	assert.Zero(t, stats.Utilization())
+	assert.Equal(t, td.pool.config.MaxSize, stats.Capacity)
+
+	height := td.RandHeight()
+	td.sandbox.TestStore.AddTestBlock(height)
+	testTrx := tx.NewSubsidyTx(height+1, td.RandAccAddress(), 1, "subsidy-tx")
+	assert.NoError(t, td.pool.AppendTx(testTrx))
+	td.pool.arrivals[testTrx.ID()] = time.Now().Add(-time.Minute)
+
+	stats = td.pool.Stats()
+	assert.Equal(t, 1, stats.Total)
+	assert.Equal(t, 1, stats.PerType[payload.TypeTransfer])
+	assert.Zero(t, stats.PerType[payload.TypeBond])
+	assert.GreaterOrEqual(t, stats.OldestTxAge, time.Minute)
+	assert.InDelta(t, 1/float64(stats.Capacity), stats.Utilization(), 1e-9)
+
+	td.pool.RemoveTx(testTrx.ID())
+	assert.Empty(t, td.pool.arrivals)
+	assert.Zero(t, td.pool.Stats().OldestTxAge)
 }
 
 func TestEmptyPool(t *testing.T) {
@@ -206,6 +244,49 @@ func TestAppendAndBroadcast(t *testing.T) {
 	assert.Error(t, td.pool.AppendTxAndBroadcast(invTrx))
 }
 
+func TestMinFee(t *testing.T) {
+	td := setup(t)
+
+	td.pool.config.MinFee = 1000
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	valKey := td.RandValKey()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(valKey.Address(), acc)
+
+	t.Run("Fee below the minimum", func(t *testing.T) {
+		trx := tx.NewTransferTx(randHeight+1, valKey.Address(),
+			td.RandAccAddress(), 1000, 999, "low-fee")
+		valKey.Sign(trx.SignBytes())
+
+		err := td.pool.AppendTxAndBroadcast(trx)
+		assert.Equal(t, errors.ErrInvalidFee, errors.Code(err))
+		assert.False(t, td.pool.HasTx(trx.ID()))
+		assert.Empty(t, td.ch)
+
+		e := <-td.eventCh
+		assert.Equal(t, event.CreateRejectedTransactionEvent(trx.ID(), err.Error()), e)
+	})
+
+	t.Run("Fee equal to the minimum", func(t *testing.T) {
+		trx := tx.NewTransferTx(randHeight+1, valKey.Address(),
+			td.RandAccAddress(), 1000, 1000, "ok")
+		valKey.Sign(trx.SignBytes())
+
+		assert.NoError(t, td.pool.AppendTxAndBroadcast(trx))
+		td.shouldPublishTransaction(t, trx.ID())
+	})
+
+	t.Run("Free transactions are accepted", func(t *testing.T) {
+		trx := tx.NewSubsidyTx(randHeight+1, td.RandAccAddress(), 1, "subsidy-tx")
+
+		assert.NoError(t, td.pool.AppendTx(trx))
+		assert.True(t, td.pool.HasTx(trx.ID()))
+	})
+}
+
 func TestAddSubsidyTransactions(t *testing.T) {
 	td := setup(t)
 
@@ -218,7 +299,7 @@ func TestAddSubsidyTransactions(t *testing.T) {
 	trx3 := tx.NewSubsidyTx(randHeight+1, proposer2, 25000000, "subsidy-tx-2")
 
 	err := td.pool.AppendTx(trx1)
-	assert.ErrorIs(t, err, execution.PastLockTimeError{LockTime: randHeight})
+	assert.ErrorIs(t, err, execution.PastLockTimeError{LockTime: randHeight, MinLockTime: randHeight + 1})
 
 	err = td.pool.AppendTx(trx2)
 	assert.NoError(t, err)
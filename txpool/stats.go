@@ -0,0 +1,23 @@
+package txpool
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+// Stats holds the statistics of the transaction pool.
+type Stats struct {
+	Total       int
+	PerType     map[payload.Type]int
+	Capacity    int
+	OldestTxAge time.Duration
+}
+
+// Utilization returns the ratio of the pending transactions to the pool capacity.
+func (s *Stats) Utilization() float64 {
+	if s.Capacity == 0 {
+		return 0
+	}
+	return float64(s.Total) / float64(s.Capacity)
+}
@@ -30,12 +30,23 @@ func (txs *Txs) Remove(i int) {
 }
 
 func (txs Txs) Root() hash.Hash {
+	return txs.merkleTree().Root()
+}
+
+// Proof returns the Merkle proof that links the ID of the transaction
+// at the given index to the transactions root.
+// It can be verified using `simplemerkle.VerifyProof`.
+func (txs Txs) Proof(index int) ([]hash.Hash, bool) {
+	return txs.merkleTree().Proof(index)
+}
+
+func (txs Txs) merkleTree() *simplemerkle.Tree {
 	hashes := make([]hash.Hash, txs.Len())
 	for i, trx := range txs {
 		hashes[i] = trx.ID()
 	}
-	merkle := simplemerkle.NewTreeFromHashes(hashes)
-	return merkle.Root()
+
+	return simplemerkle.NewTreeFromHashes(hashes)
 }
 
 func (txs Txs) IsEmpty() bool {
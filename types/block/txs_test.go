@@ -5,6 +5,7 @@ import (
 
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util/simplemerkle"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
@@ -27,6 +28,25 @@ func TestTxsMerkle(t *testing.T) {
 	assert.Equal(t, merkle, hash.CalcHash(data))
 }
 
+func TestTxsProof(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	txs := block.NewTxs()
+	for i := 0; i < 5; i++ {
+		trx, _ := ts.GenerateTestTransferTx()
+		txs.Append(trx)
+	}
+
+	for i, trx := range txs {
+		proof, ok := txs.Proof(i)
+		assert.True(t, ok)
+		assert.True(t, simplemerkle.VerifyProof(trx.ID(), i, proof, txs.Root()))
+	}
+
+	_, ok := txs.Proof(txs.Len())
+	assert.False(t, ok)
+}
+
 func TestAppendPrependRemove(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
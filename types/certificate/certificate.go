@@ -227,15 +227,43 @@ func (cert *Certificate) Decode(r io.Reader) error {
 func (cert *Certificate) Validate(height uint32,
 	validators []*validator.Validator, signBytes []byte,
 ) error {
+	pubs, err := cert.validateCommitters(height, validators)
+	if err != nil {
+		return err
+	}
+
+	// Check signature
+	err = bls.VerifyAggregated(cert.Signature(), pubs, signBytes)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateCommitters checks the height, the committers and the signed power of the certificate.
+// Unlike Validate, it doesn't verify the aggregated signature.
+func (cert *Certificate) ValidateCommitters(height uint32,
+	validators []*validator.Validator,
+) error {
+	_, err := cert.validateCommitters(height, validators)
+
+	return err
+}
+
+// validateCommitters returns the public keys of the signers.
+func (cert *Certificate) validateCommitters(height uint32,
+	validators []*validator.Validator,
+) ([]*bls.PublicKey, error) {
 	if cert.Height() != height {
-		return UnexpectedHeightError{
+		return nil, UnexpectedHeightError{
 			Expected: height,
 			Got:      cert.Height(),
 		}
 	}
 
 	if len(validators) != len(cert.Committers()) {
-		return UnexpectedCommittersError{
+		return nil, UnexpectedCommittersError{
 			Committers: cert.Committers(),
 		}
 	}
@@ -247,7 +275,7 @@ func (cert *Certificate) Validate(height uint32,
 	for index, num := range cert.Committers() {
 		val := validators[index]
 		if val.Number() != num {
-			return UnexpectedCommittersError{
+			return nil, UnexpectedCommittersError{
 				Committers: cert.Committers(),
 			}
 		}
@@ -261,19 +289,13 @@ func (cert *Certificate) Validate(height uint32,
 
 	// Check if signers have 2/3+ of total power
 	if signedPower <= committeePower*2/3 {
-		return InsufficientPowerError{
+		return nil, InsufficientPowerError{
 			SignedPower:   signedPower,
 			RequiredPower: committeePower*2/3 + 1,
 		}
 	}
 
-	// Check signature
-	err := bls.VerifyAggregated(cert.Signature(), pubs, signBytes)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return pubs, nil
 }
 
 // AddSignature adds a new signature to the certificate.
@@ -269,6 +269,26 @@ func TestCertificateValidation(t *testing.T) {
 		err := cert.Validate(blockHeight, validators, signBytes)
 		assert.ErrorIs(t, err, crypto.ErrInvalidSignature)
 	})
+
+	t.Run("Invalid signature, committers are valid", func(t *testing.T) {
+		cert := certificate.NewCertificate(blockHeight, blockRound, committers,
+			[]int32{val3.Number()}, aggSig)
+
+		err := cert.ValidateCommitters(blockHeight, validators)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Doesn't have 2/3 majority, committers are invalid", func(t *testing.T) {
+		cert := certificate.NewCertificate(blockHeight, blockRound, committers,
+			[]int32{val1.Number(), val2.Number()}, aggSig)
+
+		err := cert.ValidateCommitters(blockHeight, validators)
+		assert.ErrorIs(t, err, certificate.InsufficientPowerError{
+			SignedPower:   2,
+			RequiredPower: 3,
+		})
+	})
+
 	t.Run("Ok, should return no error", func(t *testing.T) {
 		cert := certificate.NewCertificate(blockHeight, blockRound, committers,
 			[]int32{val2.Number()}, aggSig)
@@ -1,14 +1,21 @@
 package logger
 
+import "github.com/pactus-project/pactus/util/errors"
+
 type Config struct {
 	Colorful bool              `toml:"colorful"`
 	Levels   map[string]string `toml:"levels"`
+
+	// Sampling maps a sampling key to a rate N, so only one in N of the sampled logs
+	// with this key is written. Warnings and errors are never sampled.
+	Sampling map[string]int `toml:"sampling"`
 }
 
 func DefaultConfig() *Config {
 	conf := &Config{
 		Levels:   make(map[string]string),
 		Colorful: true,
+		Sampling: make(map[string]int),
 	}
 
 	conf.Levels["default"] = "info"
@@ -26,5 +33,11 @@ func DefaultConfig() *Config {
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
+	for key, rate := range conf.Sampling {
+		if rate <= 0 {
+			return errors.Errorf(errors.ErrInvalidConfig, "sampling rate of %s should be positive", key)
+		}
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"sync"
 
 	"github.com/pactus-project/pactus/util"
 	"github.com/rs/zerolog"
@@ -33,6 +34,9 @@ type SubLogger struct {
 	logger zerolog.Logger
 	name   string
 	obj    fmt.Stringer
+
+	samplesLk sync.Mutex
+	samples   map[string]int
 }
 
 func getLoggersInst() *logger {
@@ -117,9 +121,10 @@ func NewSubLogger(name string, obj fmt.Stringer) *SubLogger {
 	inst := getLoggersInst()
 
 	sl := &SubLogger{
-		logger: zerolog.New(inst.writers()).With().Timestamp().Logger(),
-		name:   name,
-		obj:    obj,
+		logger:  zerolog.New(inst.writers()).With().Timestamp().Logger(),
+		name:    name,
+		obj:     obj,
+		samples: make(map[string]int),
 	}
 
 	lvlStr := inst.config.Levels[name]
@@ -178,6 +183,36 @@ func (sl *SubLogger) Info(msg string, keyvals ...interface{}) {
 	sl.logObj(sl.logger.Info(), msg, keyvals...)
 }
 
+// DebugSampled writes the debug log, sampled by the given key.
+func (sl *SubLogger) DebugSampled(key, msg string, keyvals ...interface{}) {
+	if sl.sampled(key) {
+		sl.Debug(msg, keyvals...)
+	}
+}
+
+// InfoSampled writes the info log, sampled by the given key.
+// If a sampling rate N is defined for the key, only one in N of the logs is written.
+func (sl *SubLogger) InfoSampled(key, msg string, keyvals ...interface{}) {
+	if sl.sampled(key) {
+		sl.Info(msg, keyvals...)
+	}
+}
+
+func (sl *SubLogger) sampled(key string) bool {
+	rate := getLoggersInst().config.Sampling[key]
+	if rate <= 1 {
+		return true
+	}
+
+	sl.samplesLk.Lock()
+	defer sl.samplesLk.Unlock()
+
+	count := sl.samples[key]
+	sl.samples[key] = (count + 1) % rate
+
+	return count == 0
+}
+
 func (sl *SubLogger) Warn(msg string, keyvals ...interface{}) {
 	sl.logObj(sl.logger.Warn(), msg, keyvals...)
 }
@@ -3,6 +3,7 @@ package logger
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/rs/zerolog/log"
@@ -155,3 +156,33 @@ func TestShortStringer(t *testing.T) {
 
 	assert.Contains(t, out, "bar")
 }
+
+func TestSampledLogger(t *testing.T) {
+	globalInst = nil
+	c := DefaultConfig()
+	c.Colorful = false
+	c.Sampling["sampled"] = 3
+	InitGlobalLogger(c)
+
+	globalInst.config.Levels["test"] = "info"
+	l := NewSubLogger("test", nil)
+	var buf bytes.Buffer
+	l.logger = l.logger.Output(&buf)
+
+	for i := 0; i < 7; i++ {
+		l.InfoSampled("sampled", "one-in-three")
+		l.InfoSampled("not-sampled", "every-time")
+	}
+
+	out := buf.String()
+	assert.Equal(t, 3, strings.Count(out, "one-in-three"))
+	assert.Equal(t, 7, strings.Count(out, "every-time"))
+}
+
+func TestSamplingConfig(t *testing.T) {
+	c := DefaultConfig()
+	assert.NoError(t, c.BasicCheck())
+
+	c.Sampling["txs"] = 0
+	assert.Error(t, c.BasicCheck())
+}
@@ -110,3 +110,52 @@ func (tree *Tree) Depth() int {
 	}
 	return int(math.Log2(float64(len(tree.merkles))))
 }
+
+// Proof returns the sibling hashes on the path from the leaf at the given index to the root.
+// It returns false if the index is out of range.
+func (tree *Tree) Proof(index int) ([]hash.Hash, bool) {
+	if tree == nil || index < 0 {
+		return nil, false
+	}
+
+	width := (len(tree.merkles) + 1) / 2
+	if index >= width || tree.merkles[index] == nil {
+		return nil, false
+	}
+
+	proof := make([]hash.Hash, 0, tree.Depth())
+	offset := 0
+	for ; width > 1; width /= 2 {
+		sibling := tree.merkles[offset+(index^1)]
+		// When there is no right sibling, the node is hashed with itself.
+		if sibling == nil {
+			sibling = tree.merkles[offset+index]
+		}
+		proof = append(proof, *sibling)
+
+		offset += width
+		index /= 2
+	}
+
+	return proof, true
+}
+
+// VerifyProof checks if the leaf at the given index belongs to the tree with the given root,
+// using the proof returned by the `Proof` function.
+func VerifyProof(leaf hash.Hash, index int, proof []hash.Hash, root hash.Hash) bool {
+	if index < 0 {
+		return false
+	}
+
+	node := &leaf
+	for i := range proof {
+		if index%2 == 0 {
+			node = HashMerkleBranches(node, &proof[i])
+		} else {
+			node = HashMerkleBranches(&proof[i], node)
+		}
+		index /= 2
+	}
+
+	return index == 0 && *node == root
+}
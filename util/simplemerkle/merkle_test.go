@@ -128,3 +128,32 @@ func TestMerkleTree_Bitcoin_Block153342(t *testing.T) {
 	root2 := HashMerkleBranches(&left, &right)
 	assert.Equal(t, root, *root2)
 }
+
+func TestMerkleProof(t *testing.T) {
+	for count := 1; count <= 9; count++ {
+		hashes := make([]hash.Hash, count)
+		for i := 0; i < count; i++ {
+			hashes[i] = strToHash(fmt.Sprintf("leaf-%d", i))
+		}
+		tree := NewTreeFromHashes(hashes)
+		root := tree.Root()
+
+		for i, leaf := range hashes {
+			proof, ok := tree.Proof(i)
+			assert.True(t, ok)
+			assert.Len(t, proof, tree.Depth())
+			assert.True(t, VerifyProof(leaf, i, proof, root), "count: %d, index: %d", count, i)
+
+			// The proof is bound to the leaf and its index.
+			assert.False(t, VerifyProof(strToHash("invalid"), i, proof, root))
+			if count > 1 {
+				assert.False(t, VerifyProof(leaf, (i+1)%count, proof, root))
+			}
+		}
+
+		_, ok := tree.Proof(count)
+		assert.False(t, ok)
+		_, ok = tree.Proof(-1)
+		assert.False(t, ok)
+	}
+}
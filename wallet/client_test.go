@@ -8,9 +8,15 @@ import (
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 )
 
-type blockchainServer struct{}
+// The unimplemented servers are embedded, so the test servers only need
+// to implement the methods that the wallet uses.
+type blockchainServer struct {
+	pactus.UnimplementedBlockchainServer
+}
 
-type transactionServer struct{}
+type transactionServer struct {
+	pactus.UnimplementedTransactionServer
+}
 
 var (
 	tBlockchainInfoResponse *pactus.GetBlockchainInfoResponse
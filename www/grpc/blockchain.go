@@ -1,25 +1,65 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// The default and the maximum number of items in a page, for paginated responses.
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// maxAccountsPerRequest is the maximum number of accounts that can be queried at once.
+const maxAccountsPerRequest = 100
+
+// maxRecentBlocks is the maximum number of blocks returned by GetRecentBlocks.
+const maxRecentBlocks = 100
+
+// maxAccountLookback is the maximum number of blocks that are reverted to reconstruct
+// the account state at a past height. 8640 blocks is about one day.
+// Each reverted block is read and decoded, so the lookback bounds the cost of a single request,
+// the same as the other limits of the requests.
+const maxAccountLookback = uint32(8640)
+
 type blockchainServer struct {
-	state   state.Facade
-	consMgr consensus.ManagerReader
-	logger  *logger.SubLogger
+	state    state.Facade
+	sync     sync.Synchronizer
+	consMgr  consensus.ManagerReader
+	notifier *blockNotifier
+	logger   *logger.SubLogger
+
+	// maxStreamBacklog is the maximum number of blocks that a subscriber can fall behind.
+	// Zero means no limit.
+	maxStreamBacklog uint32
+
+	validatorHistory *validatorHistoryIndex
+	txStats          *txStatsCache
 }
 
 func (s *blockchainServer) GetBlockchainInfo(_ context.Context,
@@ -31,6 +71,12 @@ func (s *blockchainServer) GetBlockchainInfo(_ context.Context,
 		cv = append(cv, validatorToProto(v))
 	}
 
+	totalSupply := s.state.Genesis().TotalSupply()
+	treasuryBalance := int64(0)
+	if acc := s.state.AccountByAddress(crypto.TreasuryAddress); acc != nil {
+		treasuryBalance = acc.Balance()
+	}
+
 	return &pactus.GetBlockchainInfoResponse{
 		LastBlockHeight:     s.state.LastBlockHeight(),
 		LastBlockHash:       s.state.LastBlockHash().Bytes(),
@@ -39,34 +85,113 @@ func (s *blockchainServer) GetBlockchainInfo(_ context.Context,
 		TotalPower:          s.state.TotalPower(),
 		CommitteePower:      s.state.CommitteePower(),
 		CommitteeValidators: cv,
+		EstimatedSyncTime:   int64(s.sync.EstimatedSyncTime().Seconds()),
+		TotalSupply:         totalSupply,
+		TreasuryBalance:     treasuryBalance,
+		CirculatingSupply:   totalSupply - treasuryBalance,
+		TxCountPerType:      s.txStats.stats(),
+		TxStatsBlocks:       txStatsBlocks,
 	}, nil
 }
 
 func (s *blockchainServer) GetConsensusInfo(_ context.Context,
-	_ *pactus.GetConsensusInfoRequest,
+	req *pactus.GetConsensusInfoRequest,
 ) (*pactus.GetConsensusInfoResponse, error) {
+	curHeight, _ := s.consMgr.HeightRound()
+	if req.Height != 0 && req.Height != curHeight {
+		return s.pastConsensusInfo(req.Height)
+	}
+
 	instances := make([]*pactus.ConsensusInfo, 0)
 	for _, cons := range s.consMgr.Instances() {
 		height, round := cons.HeightRound()
-		votes := cons.AllVotes()
-		voteInfos := make([]*pactus.VoteInfo, 0, len(votes))
-		for _, v := range votes {
-			voteInfos = append(voteInfos, voteToProto(v))
+		timings := cons.RoundTimings()
+		missed := cons.MissedStats()
+		roundTimings := make([]*pactus.RoundTiming, 0, len(timings))
+		for _, rt := range timings {
+			roundTimings = append(roundTimings, &pactus.RoundTiming{
+				Height:     rt.Height,
+				Round:      int32(rt.Round),
+				DurationMs: rt.Duration.Milliseconds(),
+				Committed:  rt.Committed,
+			})
+		}
+
+		instances = append(instances,
+			&pactus.ConsensusInfo{
+				Address:         cons.ConsensusKey().ValidatorAddress().String(),
+				Active:          cons.IsActive(),
+				Height:          height,
+				Round:           int32(round),
+				Votes:           votesToProto(cons.AllVotes()),
+				RoundTimings:    roundTimings,
+				Proposal:        proposalToProto(cons.Proposal()),
+				MissedProposals: missed.Proposals,
+				MissedVotes:     missed.Votes,
+			})
+	}
+
+	return &pactus.GetConsensusInfoResponse{Instances: instances}, nil
+}
+
+// pastConsensusInfo returns the recorded consensus activity of a past height.
+func (s *blockchainServer) pastConsensusInfo(height uint32) (*pactus.GetConsensusInfoResponse, error) {
+	instances := make([]*pactus.ConsensusInfo, 0)
+	for _, cons := range s.consMgr.Instances() {
+		activity := cons.ActivityAt(height)
+		if activity == nil {
+			continue
 		}
 
 		instances = append(instances,
 			&pactus.ConsensusInfo{
-				Address: cons.ConsensusKey().ValidatorAddress().String(),
-				Active:  cons.IsActive(),
-				Height:  height,
-				Round:   int32(round),
-				Votes:   voteInfos,
+				Address:  cons.ConsensusKey().ValidatorAddress().String(),
+				Active:   cons.IsActive(),
+				Height:   activity.Height,
+				Round:    int32(activity.Round),
+				Votes:    votesToProto(activity.Votes),
+				Proposal: proposalToProto(activity.Proposal),
 			})
 	}
 
+	if len(instances) == 0 {
+		return nil, status.Errorf(codes.NotFound, "consensus activity is not retained for this height")
+	}
+
 	return &pactus.GetConsensusInfoResponse{Instances: instances}, nil
 }
 
+// GetCommittee returns the committee members in the proposer rotation order.
+// The first member is the proposer of the current round,
+// and the next members become the proposer if the round changes.
+func (s *blockchainServer) GetCommittee(_ context.Context,
+	_ *pactus.GetCommitteeRequest,
+) (*pactus.GetCommitteeResponse, error) {
+	height, round := s.consMgr.HeightRound()
+	size := len(s.state.CommitteeValidators())
+	members := make([]*pactus.CommitteeMember, 0, size)
+	for i := 0; i < size; i++ {
+		next := int(round) + i
+		if next > math.MaxInt16 {
+			// The rotation repeats after a full cycle of the committee.
+			next -= size
+		}
+		val := s.state.Proposer(int16(next))
+		members = append(members, &pactus.CommitteeMember{
+			Address:    val.Address().String(),
+			Number:     val.Number(),
+			PublicKey:  val.PublicKey().String(),
+			IsProposer: i == 0,
+		})
+	}
+
+	return &pactus.GetCommitteeResponse{
+		Height:  height,
+		Round:   int32(round),
+		Members: members,
+	}, nil
+}
+
 func (s *blockchainServer) GetBlockHash(_ context.Context,
 	req *pactus.GetBlockHashRequest,
 ) (*pactus.GetBlockHashResponse, error) {
@@ -80,6 +205,40 @@ func (s *blockchainServer) GetBlockHash(_ context.Context,
 	}, nil
 }
 
+func (s *blockchainServer) GetBlockHashByTime(_ context.Context,
+	req *pactus.GetBlockHashByTimeRequest,
+) (*pactus.GetBlockHashByTimeResponse, error) {
+	var searchErr error
+	// Block times are monotonic, so we can use binary search to find
+	// the number of blocks committed at or before the requested time.
+	count := sort.Search(int(s.state.LastBlockHeight()), func(i int) bool {
+		blockTime, err := s.blockTime(uint32(i + 1))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return blockTime > req.GetTime()
+	})
+	if searchErr != nil {
+		return nil, status.Errorf(codes.Internal, searchErr.Error())
+	}
+	if count == 0 {
+		return nil, status.Errorf(codes.NotFound, "no block found at or before this time")
+	}
+
+	height := uint32(count)
+	committedBlock := s.state.CommittedBlock(height)
+	blockTime, err := s.blockTime(height)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &pactus.GetBlockHashByTimeResponse{
+		Height:    height,
+		Hash:      committedBlock.BlockHash.Bytes(),
+		BlockTime: blockTime,
+	}, nil
+}
+
 func (s *blockchainServer) GetBlockHeight(_ context.Context,
 	req *pactus.GetBlockHeightRequest,
 ) (*pactus.GetBlockHeightResponse, error) {
@@ -107,7 +266,11 @@ func (s *blockchainServer) GetBlock(_ context.Context,
 	res := &pactus.GetBlockResponse{
 		Height: committedBlock.Height,
 		Hash:   committedBlock.BlockHash.Bytes(),
-		Data:   committedBlock.Data,
+		Size:   uint32(len(committedBlock.Data)),
+	}
+
+	if req.Verbosity != pactus.BlockVerbosity_BLOCK_HEADER {
+		res.Data = committedBlock.Data
 	}
 
 	if req.Verbosity > pactus.BlockVerbosity_BLOCK_DATA {
@@ -117,6 +280,22 @@ func (s *blockchainServer) GetBlock(_ context.Context,
 		}
 		blockTime := block.Header().UnixTime()
 		seed := block.Header().SortitionSeed()
+		header := &pactus.BlockHeaderInfo{
+			Version:         int32(block.Header().Version()),
+			PrevBlockHash:   block.Header().PrevBlockHash().Bytes(),
+			StateRoot:       block.Header().StateRoot().Bytes(),
+			SortitionSeed:   seed[:],
+			ProposerAddress: block.Header().ProposerAddress().String(),
+		}
+
+		res.BlockTime = blockTime
+		res.Header = header
+
+		// Light clients only need the header.
+		if req.Verbosity == pactus.BlockVerbosity_BLOCK_HEADER {
+			return res, nil
+		}
+
 		cert := block.PrevCertificate()
 		var prevCert *pactus.CertificateInfo
 
@@ -137,14 +316,6 @@ func (s *blockchainServer) GetBlock(_ context.Context,
 				Signature:  cert.Signature().Bytes(),
 			}
 		}
-		header := &pactus.BlockHeaderInfo{
-			Version:         int32(block.Header().Version()),
-			PrevBlockHash:   block.Header().PrevBlockHash().Bytes(),
-			StateRoot:       block.Header().StateRoot().Bytes(),
-			SortitionSeed:   seed[:],
-			ProposerAddress: block.Header().ProposerAddress().String(),
-		}
-
 		trxs := make([]*pactus.TransactionInfo, 0, block.Transactions().Len())
 		for _, trx := range block.Transactions() {
 			if req.Verbosity == pactus.BlockVerbosity_BLOCK_INFO {
@@ -154,8 +325,6 @@ func (s *blockchainServer) GetBlock(_ context.Context,
 			}
 		}
 
-		res.BlockTime = blockTime
-		res.Header = header
 		res.Txs = trxs
 		res.PrevCert = prevCert
 	}
@@ -163,6 +332,133 @@ func (s *blockchainServer) GetBlock(_ context.Context,
 	return res, nil
 }
 
+// GetRawBlock returns the block and its certificate as they are stored,
+// the same way they are sent to the other peers while syncing.
+// The certificate of the last block is the last certificate of the state,
+// and for the other blocks, it is the previous certificate of the next block.
+func (s *blockchainServer) GetRawBlock(_ context.Context,
+	req *pactus.GetRawBlockRequest,
+) (*pactus.GetRawBlockResponse, error) {
+	height := req.GetHeight()
+	committedBlock := s.state.CommittedBlock(height)
+	if committedBlock == nil {
+		return nil, status.Errorf(codes.NotFound, "block not found")
+	}
+
+	var cert *certificate.Certificate
+	if height == s.state.LastBlockHeight() {
+		cert = s.state.LastCertificate()
+	} else {
+		nextBlock := s.state.CommittedBlock(height + 1)
+		if nextBlock == nil {
+			return nil, status.Errorf(codes.NotFound, "certificate not found")
+		}
+		blk, err := nextBlock.ToBlock()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		cert = blk.PrevCertificate()
+	}
+	if cert == nil {
+		return nil, status.Errorf(codes.NotFound, "certificate not found")
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, cert.SerializeSize()))
+	if err := cert.Encode(buf); err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pactus.GetRawBlockResponse{
+		Height:          committedBlock.Height,
+		BlockData:       committedBlock.Data,
+		CertificateData: buf.Bytes(),
+	}, nil
+}
+
+// GetRecentBlocks returns the summaries of the latest blocks, starting from the last block.
+func (s *blockchainServer) GetRecentBlocks(_ context.Context,
+	req *pactus.GetRecentBlocksRequest,
+) (*pactus.GetRecentBlocksResponse, error) {
+	if req.Count == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "count should be positive")
+	}
+	count := util.Min(req.Count, maxRecentBlocks)
+
+	blocks := make([]*pactus.BlockSummary, 0, count)
+	for height := s.state.LastBlockHeight(); height > 0 && len(blocks) < int(count); height-- {
+		committedBlock := s.state.CommittedBlock(height)
+		if committedBlock == nil {
+			break
+		}
+		blk, err := committedBlock.ToBlock()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%s", err.Error())
+		}
+
+		blocks = append(blocks, &pactus.BlockSummary{
+			Height:          height,
+			Hash:            committedBlock.BlockHash.Bytes(),
+			BlockTime:       blk.Header().UnixTime(),
+			TxCount:         int32(blk.Transactions().Len()),
+			ProposerAddress: blk.Header().ProposerAddress().String(),
+			Size:            uint32(len(committedBlock.Data)),
+		})
+	}
+
+	return &pactus.GetRecentBlocksResponse{Blocks: blocks}, nil
+}
+
+// GetBlockTransactions returns the transactions of the block page by page.
+// The page token is the index of the first transaction in the page.
+// An empty next page token means there are no more transactions.
+func (s *blockchainServer) GetBlockTransactions(_ context.Context,
+	req *pactus.GetBlockTransactionsRequest,
+) (*pactus.GetBlockTransactionsResponse, error) {
+	committedBlock := s.state.CommittedBlock(req.Height)
+	if committedBlock == nil {
+		return nil, status.Errorf(codes.NotFound, "block not found")
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	start := 0
+	if req.PageToken != "" {
+		token, err := strconv.ParseUint(req.PageToken, 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		start = int(token)
+	}
+
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	txs := blk.Transactions()
+	if start > txs.Len() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: out of range")
+	}
+
+	end := util.Min(start+pageSize, txs.Len())
+	res := &pactus.GetBlockTransactionsResponse{
+		Txs: make([]*pactus.TransactionInfo, 0, end-start),
+	}
+	for _, trx := range txs[start:end] {
+		res.Txs = append(res.Txs, transactionToProto(trx))
+	}
+	if end < txs.Len() {
+		res.NextPageToken = strconv.Itoa(end)
+	}
+
+	return res, nil
+}
+
 func (s *blockchainServer) GetAccount(_ context.Context,
 	req *pactus.GetAccountRequest,
 ) (*pactus.GetAccountResponse, error) {
@@ -174,6 +470,12 @@ func (s *blockchainServer) GetAccount(_ context.Context,
 	if acc == nil {
 		return nil, status.Errorf(codes.NotFound, "account not found")
 	}
+	if req.Height != 0 {
+		acc, err = s.accountAtHeight(addr, acc, req.Height)
+		if err != nil {
+			return nil, err
+		}
+	}
 	res := &pactus.GetAccountResponse{
 		Account: accountToProto(addr, acc),
 	}
@@ -181,6 +483,105 @@ func (s *blockchainServer) GetAccount(_ context.Context,
 	return res, nil
 }
 
+// GetAccounts returns the accounts of the given addresses at once.
+func (s *blockchainServer) GetAccounts(_ context.Context,
+	req *pactus.GetAccountsRequest,
+) (*pactus.GetAccountsResponse, error) {
+	if len(req.Addresses) > maxAccountsPerRequest {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"too many addresses: %d, the maximum is %d", len(req.Addresses), maxAccountsPerRequest)
+	}
+
+	addrs := make([]crypto.Address, 0, len(req.Addresses))
+	for _, str := range req.Addresses {
+		addr, err := crypto.AddressFromString(str)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", str, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	res := &pactus.GetAccountsResponse{
+		Accounts:         make([]*pactus.AccountInfo, 0, len(addrs)),
+		MissingAddresses: make([]string, 0),
+	}
+	for _, addr := range addrs {
+		acc := s.state.AccountByAddress(addr)
+		if acc == nil {
+			res.MissingAddresses = append(res.MissingAddresses, addr.String())
+
+			continue
+		}
+		res.Accounts = append(res.Accounts, accountToProto(addr, acc))
+	}
+
+	return res, nil
+}
+
+// accountAtHeight reconstructs the account state as of the given height,
+// by reverting the balance changes of the blocks committed after that height.
+// The other fields of the account, like the account number, are not reverted.
+// The accounts are numbered in the order of their creation, so the account existed
+// at the given height if its number is less than the number of accounts at that height.
+func (s *blockchainServer) accountAtHeight(addr crypto.Address,
+	acc *account.Account, height uint32,
+) (*account.Account, error) {
+	lastHeight := s.state.LastBlockHeight()
+	if height > lastHeight {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"height %d is greater than the last block height %d", height, lastHeight)
+	}
+	if lastHeight-height > maxAccountLookback {
+		return nil, status.Errorf(codes.OutOfRange,
+			"height %d is more than %d blocks behind the last block height %d",
+			height, maxAccountLookback, lastHeight)
+	}
+
+	totalAccounts, err := s.state.TotalAccountsAt(height)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable,
+			"number of accounts at height %d is not available", height)
+	}
+	if acc.Number() >= totalAccounts {
+		return nil, status.Errorf(codes.NotFound, "account not found at height %d", height)
+	}
+
+	histAcc := acc.Clone()
+	for h := lastHeight; h > height; h-- {
+		committedBlock := s.state.CommittedBlock(h)
+		if committedBlock == nil {
+			return nil, status.Errorf(codes.Unavailable, "block at height %d is not available", h)
+		}
+		// Public keys are not needed here, so there is no need to use `ToBlock`.
+		blk, err := block.FromBytes(committedBlock.Data)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		for _, trx := range blk.Transactions() {
+			switch pld := trx.Payload().(type) {
+			case *payload.TransferPayload:
+				if pld.From == addr {
+					histAcc.AddToBalance(pld.Amount + trx.Fee())
+				}
+				if pld.To == addr {
+					histAcc.SubtractFromBalance(pld.Amount)
+				}
+			case *payload.BondPayload:
+				if pld.From == addr {
+					histAcc.AddToBalance(pld.Stake + trx.Fee())
+				}
+			case *payload.WithdrawPayload:
+				if pld.To == addr {
+					histAcc.SubtractFromBalance(pld.Amount)
+				}
+			}
+		}
+	}
+
+	return histAcc, nil
+}
+
 func (s *blockchainServer) GetValidatorByNumber(_ context.Context,
 	req *pactus.GetValidatorByNumberRequest,
 ) (*pactus.GetValidatorResponse, error) {
@@ -189,9 +590,7 @@ func (s *blockchainServer) GetValidatorByNumber(_ context.Context,
 		return nil, status.Errorf(codes.NotFound, "validator not found")
 	}
 
-	return &pactus.GetValidatorResponse{
-		Validator: validatorToProto(val),
-	}, nil
+	return s.validatorResponse(val), nil
 }
 
 func (s *blockchainServer) GetValidator(_ context.Context,
@@ -206,8 +605,33 @@ func (s *blockchainServer) GetValidator(_ context.Context,
 		return nil, status.Errorf(codes.NotFound, "validator not found")
 	}
 
-	return &pactus.GetValidatorResponse{
-		Validator: validatorToProto(val),
+	return s.validatorResponse(val), nil
+}
+
+// GetValidatorHistory returns the committed Bond, Unbond and Withdraw transactions of the validator.
+// The blocks are indexed in the background, and until the index catches up, it returns Unavailable.
+// It is available only if the validator history is enabled in the config.
+func (s *blockchainServer) GetValidatorHistory(_ context.Context,
+	req *pactus.GetValidatorHistoryRequest,
+) (*pactus.GetValidatorHistoryResponse, error) {
+	if s.validatorHistory == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "validator history is disabled")
+	}
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	if !addr.IsValidatorAddress() {
+		return nil, status.Errorf(codes.InvalidArgument, "not a validator address")
+	}
+
+	events, err := s.validatorHistory.history(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.GetValidatorHistoryResponse{
+		Events: events,
 	}, nil
 }
 
@@ -216,10 +640,24 @@ func (s *blockchainServer) GetValidatorAddresses(_ context.Context,
 ) (*pactus.GetValidatorAddressesResponse, error) {
 	addresses := s.state.ValidatorAddresses()
 	addressesPB := make([]string, 0, len(addresses))
+	validatorsPB := make([]*pactus.ValidatorAddressInfo, 0, len(addresses))
 	for _, address := range addresses {
 		addressesPB = append(addressesPB, address.String())
+
+		val := s.state.ValidatorByAddress(address)
+		if val == nil {
+			continue
+		}
+		validatorsPB = append(validatorsPB, &pactus.ValidatorAddressInfo{
+			Address:   address.String(),
+			Number:    val.Number(),
+			PublicKey: val.PublicKey().String(),
+		})
 	}
-	return &pactus.GetValidatorAddressesResponse{Addresses: addressesPB}, nil
+	return &pactus.GetValidatorAddressesResponse{
+		Addresses:  addressesPB,
+		Validators: validatorsPB,
+	}, nil
 }
 
 func (s *blockchainServer) GetPublicKey(_ context.Context,
@@ -238,6 +676,104 @@ func (s *blockchainServer) GetPublicKey(_ context.Context,
 	return &pactus.GetPublicKeyResponse{PublicKey: publicKey.String()}, nil
 }
 
+func (s *blockchainServer) GetAddressByPublicKey(_ context.Context,
+	req *pactus.GetAddressByPublicKeyRequest,
+) (*pactus.GetAddressByPublicKeyResponse, error) {
+	// A BLS public key maps to both an account address and a validator address.
+	pub, err := bls.PublicKeyFromString(req.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key: %v", err.Error())
+	}
+
+	return &pactus.GetAddressByPublicKeyResponse{
+		AccountAddress:   pub.AccountAddress().String(),
+		ValidatorAddress: pub.ValidatorAddress().String(),
+	}, nil
+}
+
+// GetGenesis returns the genesis document that the node is booted from.
+// The data is the JSON encoded genesis, same as the genesis file.
+func (s *blockchainServer) GetGenesis(_ context.Context,
+	_ *pactus.GetGenesisRequest,
+) (*pactus.GetGenesisResponse, error) {
+	gen := s.state.Genesis()
+	data, err := gen.MarshalJSON()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pactus.GetGenesisResponse{
+		Hash:      gen.Hash().Bytes(),
+		Data:      data,
+		ChainType: gen.ChainType().String(),
+	}, nil
+}
+
+// GetParams returns the protocol parameters that the node is running with.
+func (s *blockchainServer) GetParams(_ context.Context,
+	_ *pactus.GetParamsRequest,
+) (*pactus.GetParamsResponse, error) {
+	params := s.state.Params()
+
+	return &pactus.GetParamsResponse{
+		BlockVersion:              uint32(params.BlockVersion),
+		BlockIntervalInSecond:     int32(params.BlockIntervalInSecond),
+		CommitteeSize:             int32(params.CommitteeSize),
+		BlockReward:               params.BlockReward,
+		TransactionToLiveInterval: params.TransactionToLiveInterval,
+		BondInterval:              params.BondInterval,
+		UnbondInterval:            params.UnbondInterval,
+		SortitionInterval:         params.SortitionInterval,
+		FeeFraction:               params.FeeFraction,
+		MinimumFee:                params.MinimumFee,
+		MaximumFee:                params.MaximumFee,
+		MinimumStake:              params.MinimumStake,
+		MaximumStake:              params.MaximumStake,
+	}, nil
+}
+
+func (s *blockchainServer) blockTime(height uint32) (uint32, error) {
+	committedBlock := s.state.CommittedBlock(height)
+	if committedBlock == nil {
+		return 0, fmt.Errorf("block not found at height %v", height)
+	}
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return 0, err
+	}
+	return blk.Header().UnixTime(), nil
+}
+
+// validatorResponse builds the validator response. If the validator is unbonding,
+// it also includes the height at which its stake can be withdrawn.
+func (s *blockchainServer) validatorResponse(val *validator.Validator) *pactus.GetValidatorResponse {
+	res := &pactus.GetValidatorResponse{
+		Validator: validatorToProto(val),
+	}
+	if val.UnbondingHeight() > 0 {
+		res.WithdrawableHeight = val.UnbondingHeight() + s.state.Params().UnbondInterval
+	}
+
+	for _, cons := range s.consMgr.Instances() {
+		if cons.ConsensusKey().ValidatorAddress() != val.Address() {
+			continue
+		}
+
+		res.RewardKnown = true
+		addrs := cons.RewardPolicy().Addresses
+		for i, addr := range addrs {
+			// An address may repeat in the rotation.
+			if slices.Contains(addrs[:i], addr) {
+				continue
+			}
+			if acc := s.state.AccountByAddress(addr); acc != nil {
+				res.RewardBalance += acc.Balance()
+			}
+		}
+	}
+	return res
+}
+
 func validatorToProto(val *validator.Validator) *pactus.ValidatorInfo {
 	data, _ := val.Bytes()
 	return &pactus.ValidatorInfo{
@@ -264,6 +800,28 @@ func accountToProto(addr crypto.Address, acc *account.Account) *pactus.AccountIn
 	}
 }
 
+func votesToProto(votes []*vote.Vote) []*pactus.VoteInfo {
+	voteInfos := make([]*pactus.VoteInfo, 0, len(votes))
+	for _, v := range votes {
+		voteInfos = append(voteInfos, voteToProto(v))
+	}
+	return voteInfos
+}
+
+func proposalToProto(p *proposal.Proposal) *pactus.ProposalInfo {
+	if p == nil {
+		return nil
+	}
+
+	data, _ := p.Block().Bytes()
+	return &pactus.ProposalInfo{
+		Height:    p.Height(),
+		Round:     int32(p.Round()),
+		BlockData: data,
+		Signature: p.Signature().String(),
+	}
+}
+
 func voteToProto(v *vote.Vote) *pactus.VoteInfo {
 	return &pactus.VoteInfo{
 		Type:      pactus.VoteType(v.Type()),
@@ -272,3 +830,105 @@ func voteToProto(v *vote.Vote) *pactus.VoteInfo {
 		Round:     int32(v.Round()),
 	}
 }
+
+// SubscribeBlockHeight streams the current height first, then the new height
+// whenever a block is committed, until the client cancels the stream.
+func (s *blockchainServer) SubscribeBlockHeight(_ *pactus.SubscribeBlockHeightRequest,
+	stream pactus.Blockchain_SubscribeBlockHeightServer,
+) error {
+	ch, unsubscribe := s.notifier.subscribe()
+	defer unsubscribe()
+
+	err := stream.Send(&pactus.SubscribeBlockHeightResponse{
+		Height: s.state.LastBlockHeight(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case height := <-ch:
+			err := stream.Send(&pactus.SubscribeBlockHeightResponse{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeAccount streams the transactions that touch the given address,
+// as their blocks are committed, until the client cancels the stream.
+// The stream is dropped if the client falls behind by more than the maximum backlog.
+func (s *blockchainServer) SubscribeAccount(req *pactus.SubscribeAccountRequest,
+	stream pactus.Blockchain_SubscribeAccountServer,
+) error {
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	ch, unsubscribe := s.notifier.subscribe()
+	defer unsubscribe()
+
+	// The notifier may skip heights for slow subscribers,
+	// so all the blocks since the last sent height are checked.
+	lastHeight := s.state.LastBlockHeight()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case height := <-ch:
+			if s.maxStreamBacklog > 0 && height > lastHeight+s.maxStreamBacklog {
+				s.logger.Warn("subscriber is too slow, dropping the stream",
+					"method", "SubscribeAccount", "backlog", height-lastHeight)
+
+				return status.Errorf(codes.ResourceExhausted,
+					"subscriber is too slow, %d blocks behind", height-lastHeight)
+			}
+
+			for ; lastHeight < height; lastHeight++ {
+				if err := s.sendAccountTransactions(stream, addr, lastHeight+1); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (s *blockchainServer) sendAccountTransactions(stream pactus.Blockchain_SubscribeAccountServer,
+	addr crypto.Address, height uint32,
+) error {
+	committedBlock := s.state.CommittedBlock(height)
+	if committedBlock == nil {
+		return status.Errorf(codes.NotFound, "block %d not found", height)
+	}
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	for _, trx := range blk.Transactions() {
+		receiver := trx.Payload().Receiver()
+		if trx.Payload().Signer() != addr &&
+			(receiver == nil || *receiver != addr) {
+			continue
+		}
+
+		err := stream.Send(&pactus.SubscribeAccountResponse{
+			Height:      height,
+			Transaction: transactionToProto(trx),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
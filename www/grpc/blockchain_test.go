@@ -1,11 +1,34 @@
 package grpc
 
 import (
+	"bytes"
+	"context"
+	"math"
 	"testing"
-
+	"time"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetBlock(t *testing.T) {
@@ -33,6 +56,7 @@ func TestGetBlock(t *testing.T) {
 		assert.Equal(t, res.Height, height)
 		assert.Equal(t, res.Hash, b.Hash().Bytes())
 		assert.Equal(t, res.Data, data)
+		assert.Equal(t, uint32(len(data)), res.Size)
 		assert.Empty(t, res.Header)
 		assert.Empty(t, res.Txs)
 	})
@@ -71,6 +95,179 @@ func TestGetBlock(t *testing.T) {
 		}
 	})
 
+	t.Run("Should return only the header (verbosity: 3)", func(t *testing.T) {
+		res, err := client.GetBlock(tCtx,
+			&pactus.GetBlockRequest{Height: height, Verbosity: pactus.BlockVerbosity_BLOCK_HEADER})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, res.Height, height)
+		assert.Equal(t, res.Hash, b.Hash().Bytes())
+		assert.Equal(t, res.BlockTime, b.Header().UnixTime())
+		assert.Equal(t, res.Header.PrevBlockHash, b.Header().PrevBlockHash().Bytes())
+		assert.Equal(t, res.Header.StateRoot, b.Header().StateRoot().Bytes())
+		assert.Equal(t, res.Header.ProposerAddress, b.Header().ProposerAddress().String())
+		assert.Empty(t, res.Data)
+		assert.Empty(t, res.Txs)
+		assert.Nil(t, res.PrevCert)
+		assert.Equal(t, uint32(len(data)), res.Size)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetRecentBlocks(t *testing.T) {
+	conn, client := testBlockchainClient(t)
+
+	// The blocks are added far from the heights used by the other tests.
+	height := uint32(1000)
+	for h := height + 1; h <= height+maxRecentBlocks+1; h++ {
+		tMockState.TestStore.AddTestBlock(h)
+	}
+	height += maxRecentBlocks + 1
+
+	t.Run("Should return an error for zero count", func(t *testing.T) {
+		res, err := client.GetRecentBlocks(tCtx, &pactus.GetRecentBlocksRequest{Count: 0})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the latest blocks", func(t *testing.T) {
+		res, err := client.GetRecentBlocks(tCtx, &pactus.GetRecentBlocksRequest{Count: 2})
+
+		assert.NoError(t, err)
+		require.Len(t, res.Blocks, 2)
+		for i, summary := range res.Blocks {
+			expectedHeight := height - uint32(i)
+			committedBlock := tMockState.CommittedBlock(expectedHeight)
+			blk, _ := committedBlock.ToBlock()
+
+			assert.Equal(t, expectedHeight, summary.Height)
+			assert.Equal(t, blk.Hash().Bytes(), summary.Hash)
+			assert.Equal(t, blk.Header().UnixTime(), summary.BlockTime)
+			assert.Equal(t, int32(blk.Transactions().Len()), summary.TxCount)
+			assert.Equal(t, blk.Header().ProposerAddress().String(), summary.ProposerAddress)
+		}
+	})
+
+	t.Run("Should cap the number of blocks", func(t *testing.T) {
+		res, err := client.GetRecentBlocks(tCtx, &pactus.GetRecentBlocksRequest{Count: maxRecentBlocks + 1})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Blocks, maxRecentBlocks)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetRawBlock(t *testing.T) {
+	conn, client := testBlockchainClient(t)
+
+	height := uint32(300)
+	b1 := tMockState.TestStore.AddTestBlock(height)
+	data1, _ := b1.Bytes()
+	b2 := tMockState.TestStore.AddTestBlock(height + 1)
+	data2, _ := b2.Bytes()
+	lastCert := tMockState.TestStore.LastCert
+
+	decodeCert := func(data []byte) *certificate.Certificate {
+		cert := new(certificate.Certificate)
+		require.NoError(t, cert.Decode(bytes.NewReader(data)))
+
+		return cert
+	}
+
+	t.Run("Should return an error for non existing block", func(t *testing.T) {
+		res, err := client.GetRawBlock(tCtx, &pactus.GetRawBlockRequest{Height: height + 2})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the certificate of the next block", func(t *testing.T) {
+		res, err := client.GetRawBlock(tCtx, &pactus.GetRawBlockRequest{Height: height})
+
+		assert.NoError(t, err)
+		assert.Equal(t, height, res.Height)
+		assert.Equal(t, data1, res.BlockData)
+		assert.Equal(t, b2.PrevCertificate().Hash(), decodeCert(res.CertificateData).Hash())
+	})
+
+	t.Run("Should return the last certificate for the last block", func(t *testing.T) {
+		res, err := client.GetRawBlock(tCtx, &pactus.GetRawBlockRequest{Height: height + 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, height+1, res.Height)
+		assert.Equal(t, data2, res.BlockData)
+		assert.Equal(t, lastCert.Hash(), decodeCert(res.CertificateData).Hash())
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetBlockTransactions(t *testing.T) {
+	conn, client := testBlockchainClient(t)
+
+	height := uint32(100)
+	b := tMockState.TestStore.AddTestBlock(height)
+	txs := b.Transactions()
+
+	t.Run("Should return error for non existing block", func(t *testing.T) {
+		res, err := client.GetBlockTransactions(tCtx,
+			&pactus.GetBlockTransactionsRequest{Height: height + 1})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid page token", func(t *testing.T) {
+		res, err := client.GetBlockTransactions(tCtx,
+			&pactus.GetBlockTransactionsRequest{Height: height, PageToken: "invalid"})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+
+		res, err = client.GetBlockTransactions(tCtx,
+			&pactus.GetBlockTransactionsRequest{Height: height, PageToken: "1000"})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return all transactions in one page", func(t *testing.T) {
+		res, err := client.GetBlockTransactions(tCtx,
+			&pactus.GetBlockTransactionsRequest{Height: height})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Txs, txs.Len())
+		assert.Empty(t, res.NextPageToken)
+	})
+
+	t.Run("Should return transactions page by page", func(t *testing.T) {
+		ids := make([][]byte, 0, txs.Len())
+		pageToken := ""
+		for {
+			res, err := client.GetBlockTransactions(tCtx,
+				&pactus.GetBlockTransactionsRequest{Height: height, PageSize: 2, PageToken: pageToken})
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(res.Txs), 2)
+
+			for _, trx := range res.Txs {
+				ids = append(ids, trx.Id)
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			pageToken = res.NextPageToken
+		}
+
+		assert.Len(t, ids, txs.Len())
+		for i, trx := range txs {
+			assert.Equal(t, trx.ID().Bytes(), ids[i])
+		}
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
@@ -98,6 +295,54 @@ func TestGetBlockHash(t *testing.T) {
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
+func TestGetBlockHashByTime(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	st := state.MockingState(ts)
+	server := &blockchainServer{state: st}
+
+	genTime := util.RoundNow(10)
+	for height := uint32(1); height <= 10; height++ {
+		blk, cert := ts.GenerateTestBlockWithTime(height, genTime.Add(time.Duration(height)*10*time.Second))
+		st.TestStore.SaveBlock(blk, cert)
+	}
+
+	t.Run("Should return error for time before the first block", func(t *testing.T) {
+		res, err := server.GetBlockHashByTime(tCtx,
+			&pactus.GetBlockHashByTimeRequest{Time: uint32(genTime.Unix())})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the block at the given time", func(t *testing.T) {
+		blockTime := uint32(genTime.Add(50 * time.Second).Unix())
+		res, err := server.GetBlockHashByTime(tCtx,
+			&pactus.GetBlockHashByTimeRequest{Time: blockTime})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(5), res.Height)
+		assert.Equal(t, st.TestStore.BlockHash(5).Bytes(), res.Hash)
+		assert.Equal(t, blockTime, res.BlockTime)
+	})
+
+	t.Run("Should return the block just before the given time", func(t *testing.T) {
+		res, err := server.GetBlockHashByTime(tCtx,
+			&pactus.GetBlockHashByTimeRequest{Time: uint32(genTime.Add(55 * time.Second).Unix())})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(5), res.Height)
+	})
+
+	t.Run("Should return the last block for time after the last block", func(t *testing.T) {
+		res, err := server.GetBlockHashByTime(tCtx,
+			&pactus.GetBlockHashByTimeRequest{Time: uint32(genTime.Add(time.Hour).Unix())})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(10), res.Height)
+	})
+}
+
 func TestGetBlockHeight(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -133,6 +378,7 @@ func TestGetBlockHeight(t *testing.T) {
 }
 
 func TestGetBlockchainInfo(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
 	conn, client := testBlockchainClient(t)
 
 	t.Run("Should return the last block height", func(t *testing.T) {
@@ -144,6 +390,28 @@ func TestGetBlockchainInfo(t *testing.T) {
 		assert.NotEmpty(t, res.LastBlockHash)
 	})
 
+	t.Run("Should return the estimated sync time", func(t *testing.T) {
+		tMockSync.TestEstimatedSyncTime = 90 * time.Second
+		res, err := client.GetBlockchainInfo(tCtx,
+			&pactus.GetBlockchainInfoRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(90), res.EstimatedSyncTime)
+	})
+
+	t.Run("Should return the supply", func(t *testing.T) {
+		treasury, _ := ts.GenerateTestAccount(0)
+		tMockState.TestStore.UpdateAccount(crypto.TreasuryAddress, treasury)
+
+		res, err := client.GetBlockchainInfo(tCtx,
+			&pactus.GetBlockchainInfoRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, tMockState.Genesis().TotalSupply(), res.TotalSupply)
+		assert.Equal(t, treasury.Balance(), res.TreasuryBalance)
+		assert.Equal(t, res.TotalSupply-res.TreasuryBalance, res.CirculatingSupply)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
@@ -181,6 +449,152 @@ func TestGetAccount(t *testing.T) {
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
+func TestGetAccounts(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conn, client := testBlockchainClient(t)
+	acc1, addr1 := tMockState.TestStore.AddTestAccount()
+	acc2, addr2 := tMockState.TestStore.AddTestAccount()
+	missingAddr := ts.RandAccAddress()
+
+	t.Run("Should return error for non-parsable address", func(t *testing.T) {
+		res, err := client.GetAccounts(tCtx,
+			&pactus.GetAccountsRequest{Addresses: []string{addr1.String(), ""}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for too many addresses", func(t *testing.T) {
+		addrs := make([]string, maxAccountsPerRequest+1)
+		for i := range addrs {
+			addrs[i] = ts.RandAccAddress().String()
+		}
+		res, err := client.GetAccounts(tCtx,
+			&pactus.GetAccountsRequest{Addresses: addrs})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the accounts and the missing addresses", func(t *testing.T) {
+		res, err := client.GetAccounts(tCtx,
+			&pactus.GetAccountsRequest{Addresses: []string{
+				addr2.String(), missingAddr.String(), addr1.String(),
+			}})
+
+		assert.NoError(t, err)
+		require.Len(t, res.Accounts, 2)
+		assert.Equal(t, addr2.String(), res.Accounts[0].Address)
+		assert.Equal(t, acc2.Balance(), res.Accounts[0].Balance)
+		assert.Equal(t, addr1.String(), res.Accounts[1].Address)
+		assert.Equal(t, acc1.Balance(), res.Accounts[1].Balance)
+		assert.Equal(t, []string{missingAddr.String()}, res.MissingAddresses)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetAccountAtHeight(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	st := state.MockingState(ts)
+	server := &blockchainServer{state: st}
+
+	for height := uint32(1); height <= 3; height++ {
+		blk, cert := ts.GenerateTestBlock(height)
+		st.TestStore.SaveBlock(blk, cert)
+	}
+
+	// The sender of the transfer transaction in the last block
+	trx := st.TestStore.Blocks[3].Transactions()[0]
+	pld := trx.Payload().(*payload.TransferPayload)
+	acc := account.NewAccount(ts.RandInt32(1000))
+	acc.AddToBalance(ts.RandInt64(1e14))
+	st.TestStore.UpdateAccount(pld.From, acc)
+	// The sender exists from the first block.
+	for height := uint32(1); height <= 3; height++ {
+		st.TestStore.TotalAccountsAtHeight[height] = acc.Number() + 1
+	}
+
+	t.Run("Should return the current balance", func(t *testing.T) {
+		res, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.From.String(), Height: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Balance(), res.Account.Balance)
+	})
+
+	t.Run("Should return the balance before the last block", func(t *testing.T) {
+		res, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.From.String(), Height: 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Balance()+pld.Amount+trx.Fee(), res.Account.Balance)
+		assert.Equal(t, acc.Number(), res.Account.Number)
+	})
+
+	t.Run("Should return error for the future height", func(t *testing.T) {
+		_, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.From.String(), Height: 4})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	receiverAcc := account.NewAccount(acc.Number() + 1)
+	receiverAcc.AddToBalance(pld.Amount)
+	st.TestStore.UpdateAccount(pld.To, receiverAcc)
+
+	t.Run("Should return not found if the account is created after the height", func(t *testing.T) {
+		st.TestStore.TotalAccountsAtHeight[3] = receiverAcc.Number() + 1
+
+		_, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.To.String(), Height: 2})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		res, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.To.String(), Height: 3})
+		assert.NoError(t, err)
+		assert.Equal(t, pld.Amount, res.Account.Balance)
+	})
+
+	t.Run("Should return the account that existed with zero balance", func(t *testing.T) {
+		st.TestStore.TotalAccountsAtHeight[2] = receiverAcc.Number() + 1
+
+		res, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.To.String(), Height: 2})
+		assert.NoError(t, err)
+		assert.Zero(t, res.Account.Balance)
+	})
+
+	t.Run("Should return unavailable if the number of accounts is not recorded", func(t *testing.T) {
+		delete(st.TestStore.TotalAccountsAtHeight, 2)
+
+		_, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.From.String(), Height: 2})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("Should return unavailable if the block is pruned", func(t *testing.T) {
+		delete(st.TestStore.Blocks, 2)
+
+		_, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.From.String(), Height: 1})
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("Should return out of range if the height is too far behind", func(t *testing.T) {
+		blk, cert := ts.GenerateTestBlock(maxAccountLookback + 2)
+		st.TestStore.SaveBlock(blk, cert)
+
+		_, err := server.GetAccount(tCtx,
+			&pactus.GetAccountRequest{Address: pld.From.String(), Height: 1})
+
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+}
+
 func TestGetValidator(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -210,11 +624,205 @@ func TestGetValidator(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.Equal(t, val1.PublicKey().String(), res.GetValidator().PublicKey)
+		assert.Zero(t, res.GetWithdrawableHeight())
+	})
+
+	t.Run("Should not return the reward balance of the other validators", func(t *testing.T) {
+		res, err := client.GetValidator(tCtx,
+			&pactus.GetValidatorRequest{Address: val1.Address().String()})
+
+		assert.NoError(t, err)
+		assert.False(t, res.RewardKnown)
+		assert.Zero(t, res.RewardBalance)
+	})
+
+	t.Run("Should return withdrawable height for unbonding validator", func(t *testing.T) {
+		unbondingHeight := ts.RandHeight()
+		val1.UpdateUnbondingHeight(unbondingHeight)
+
+		res, err := client.GetValidator(tCtx,
+			&pactus.GetValidatorRequest{Address: val1.Address().String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, unbondingHeight, res.GetValidator().GetUnbondingHeight())
+		assert.Equal(t, unbondingHeight+tMockState.TestParams.UnbondInterval, res.GetWithdrawableHeight())
 	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
+func TestGetValidatorRewardBalance(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockState := state.MockingState(ts)
+	valKey := ts.RandValKey()
+	consMgr, consMocks := consensus.MockingManager(ts, []*bls.ValidatorKey{valKey})
+	s := &blockchainServer{state: mockState, consMgr: consMgr}
+
+	rewardAddrs := []crypto.Address{ts.RandAccAddress(), ts.RandAccAddress()}
+	consMocks[0].Reward = consensus.NewRotatingRewardPolicy(rewardAddrs, 100)
+
+	val := validator.NewValidator(valKey.PublicKey(), ts.RandInt32(1000))
+	mockState.TestStore.UpdateValidator(val)
+
+	// The second reward address has no account yet.
+	rewardAcc, _ := ts.GenerateTestAccount(ts.RandInt32(1000))
+	mockState.TestStore.UpdateAccount(rewardAddrs[0], rewardAcc)
+
+	res, err := s.GetValidator(tCtx,
+		&pactus.GetValidatorRequest{Address: valKey.Address().String()})
+
+	assert.NoError(t, err)
+	assert.True(t, res.RewardKnown)
+	assert.Equal(t, rewardAcc.Balance(), res.RewardBalance)
+}
+
+func TestGetValidatorHistory(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockState := state.MockingState(ts)
+	log := logger.NewSubLogger("_grpc", nil)
+	s := &blockchainServer{state: mockState, validatorHistory: newValidatorHistoryIndex(mockState, log)}
+
+	valPub, valPrv := ts.RandBLSKeyPair()
+	accPub, accPrv := ts.RandBLSKeyPair()
+	valAddr := valPub.ValidatorAddress()
+	bondTx := tx.NewBondTx(1, accPub.AccountAddress(), valAddr, valPub, 100e9, 1e9, "")
+	unbondTx := tx.NewUnbondTx(2, valAddr, "")
+	withdrawTx := tx.NewWithdrawTx(3, valAddr, ts.RandAccAddress(), 60e9, 1e9, "")
+	ts.HelperSignTransaction(accPrv, bondTx)
+	ts.HelperSignTransaction(valPrv, unbondTx)
+	ts.HelperSignTransaction(valPrv, withdrawTx)
+
+	commitBlockWithTxs := func(trxs ...*tx.Tx) {
+		txs := block.NewTxs()
+		for _, trx := range trxs {
+			txs.Append(trx)
+		}
+		height := mockState.LastBlockHeight() + 1
+		header := block.NewHeader(1, util.Now(), ts.RandHash(), ts.RandHash(), ts.RandSeed(), ts.RandValAddress())
+		blk := block.NewBlock(header, ts.GenerateTestCertificate(height), txs)
+		require.NoError(t, mockState.CommitBlock(blk, ts.GenerateTestCertificate(height)))
+	}
+
+	t.Run("Disabled validator history", func(t *testing.T) {
+		disabled := &blockchainServer{state: mockState}
+		_, err := disabled.GetValidatorHistory(tCtx,
+			&pactus.GetValidatorHistoryRequest{Address: valAddr.String()})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Invalid address", func(t *testing.T) {
+		_, err := s.GetValidatorHistory(tCtx,
+			&pactus.GetValidatorHistoryRequest{Address: ts.RandAccAddress().String()})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	// historyAt waits for the index to reach the given height, then it returns the history.
+	historyAt := func(height uint32) *pactus.GetValidatorHistoryResponse {
+		require.Eventually(t, func() bool {
+			s.validatorHistory.lk.Lock()
+			defer s.validatorHistory.lk.Unlock()
+
+			return s.validatorHistory.indexedHeight == height
+		}, 5*time.Second, 10*time.Millisecond)
+
+		res, err := s.GetValidatorHistory(tCtx,
+			&pactus.GetValidatorHistoryRequest{Address: valAddr.String()})
+		require.NoError(t, err)
+
+		return res
+	}
+
+	t.Run("Unavailable until the index catches up", func(t *testing.T) {
+		commitBlockWithTxs(bondTx)
+		mockState.CommitTestBlocks(2)
+
+		_, err := s.GetValidatorHistory(tCtx,
+			&pactus.GetValidatorHistoryRequest{Address: valAddr.String()})
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	ctx, cancel := context.WithCancel(tCtx)
+	defer cancel()
+	notifier := newBlockNotifier(mockState, log)
+	s.validatorHistory.Start(ctx, notifier)
+	notifier.Start(ctx)
+
+	t.Run("Bond", func(t *testing.T) {
+		res := historyAt(3)
+
+		require.Len(t, res.Events, 1)
+		assert.Equal(t, uint32(1), res.Events[0].Height)
+		assert.Equal(t, bondTx.ID().Bytes(), res.Events[0].Transaction.Id)
+		assert.Equal(t, int64(100e9), res.Events[0].Amount)
+		assert.Equal(t, int64(100e9), res.Events[0].Stake)
+	})
+
+	t.Run("Unbond and withdraw", func(t *testing.T) {
+		commitBlockWithTxs(unbondTx)
+		commitBlockWithTxs(withdrawTx)
+
+		res := historyAt(5)
+
+		require.Len(t, res.Events, 3)
+		assert.Equal(t, uint32(4), res.Events[1].Height)
+		assert.Equal(t, int64(100e9), res.Events[1].Amount)
+		assert.Equal(t, int64(100e9), res.Events[1].Stake)
+
+		assert.Equal(t, uint32(5), res.Events[2].Height)
+		assert.Equal(t, int64(60e9), res.Events[2].Amount)
+		assert.Equal(t, int64(39e9), res.Events[2].Stake)
+	})
+}
+
+func TestGetBlockchainInfoTxStats(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockState := state.MockingState(ts)
+	mockSync := sync.MockingSync(ts)
+	s := &blockchainServer{state: mockState, sync: mockSync, txStats: newTxStatsCache(mockState)}
+
+	expected := make(map[int32]int32)
+	commitBlocks := func(num int) {
+		for i := 0; i < num; i++ {
+			mockState.CommitTestBlocks(1)
+			blk := mockState.TestStore.Blocks[mockState.LastBlockHeight()]
+			for _, trx := range blk.Transactions() {
+				expected[int32(trx.Payload().Type())]++
+			}
+		}
+	}
+
+	t.Run("No blocks", func(t *testing.T) {
+		res, err := s.GetBlockchainInfo(tCtx, &pactus.GetBlockchainInfoRequest{})
+		require.NoError(t, err)
+
+		assert.Empty(t, res.TxCountPerType)
+		assert.Equal(t, txStatsBlocks, res.TxStatsBlocks)
+	})
+
+	t.Run("Counts the transactions of the committed blocks", func(t *testing.T) {
+		commitBlocks(3)
+
+		res, err := s.GetBlockchainInfo(tCtx, &pactus.GetBlockchainInfoRequest{})
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, res.TxCountPerType)
+	})
+
+	t.Run("Scans only the new blocks", func(t *testing.T) {
+		commitBlocks(2)
+
+		res, err := s.GetBlockchainInfo(tCtx, &pactus.GetBlockchainInfoRequest{})
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, res.TxCountPerType)
+		assert.Len(t, s.txStats.perBlock, 5)
+		assert.Equal(t, uint32(5), s.txStats.lastHeight)
+	})
+}
+
 func TestGetValidatorByNumber(t *testing.T) {
 	conn, client := testBlockchainClient(t)
 
@@ -261,6 +869,22 @@ func TestGetValidatorAddresses(t *testing.T) {
 		assert.Equal(t, 2, len(res.GetAddresses()))
 	})
 
+	t.Run("should return validator numbers and public keys", func(t *testing.T) {
+		res, err := client.GetValidatorAddresses(tCtx,
+			&pactus.GetValidatorAddressesRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(res.GetAddresses()), len(res.GetValidators()))
+		for i, info := range res.GetValidators() {
+			addr, _ := crypto.AddressFromString(info.Address)
+			val := tMockState.ValidatorByAddress(addr)
+
+			assert.Equal(t, res.GetAddresses()[i], info.Address)
+			assert.Equal(t, val.Number(), info.Number)
+			assert.Equal(t, val.PublicKey().String(), info.PublicKey)
+		}
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
@@ -297,6 +921,71 @@ func TestGetPublicKey(t *testing.T) {
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
+func TestGetAddressByPublicKey(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conn, client := testBlockchainClient(t)
+
+	t.Run("Should return error for invalid public key", func(t *testing.T) {
+		res, err := client.GetAddressByPublicKey(tCtx,
+			&pactus.GetAddressByPublicKeyRequest{PublicKey: "invalid"})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the account and validator addresses", func(t *testing.T) {
+		pub, _ := ts.RandBLSKeyPair()
+		res, err := client.GetAddressByPublicKey(tCtx,
+			&pactus.GetAddressByPublicKeyRequest{PublicKey: pub.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pub.AccountAddress().String(), res.AccountAddress)
+		assert.Equal(t, pub.ValidatorAddress().String(), res.ValidatorAddress)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetGenesis(t *testing.T) {
+	conn, client := testBlockchainClient(t)
+
+	res, err := client.GetGenesis(tCtx, &pactus.GetGenesisRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, tMockState.Genesis().Hash().Bytes(), res.Hash)
+	assert.Equal(t, tMockState.Genesis().ChainType().String(), res.ChainType)
+
+	gen := new(genesis.Genesis)
+	assert.NoError(t, gen.UnmarshalJSON(res.Data))
+	assert.Equal(t, tMockState.Genesis().Hash(), gen.Hash())
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetParams(t *testing.T) {
+	conn, client := testBlockchainClient(t)
+
+	res, err := client.GetParams(tCtx, &pactus.GetParamsRequest{})
+	assert.NoError(t, err)
+
+	params := tMockState.Params()
+	assert.Equal(t, uint32(params.BlockVersion), res.BlockVersion)
+	assert.Equal(t, int32(params.BlockIntervalInSecond), res.BlockIntervalInSecond)
+	assert.Equal(t, int32(params.CommitteeSize), res.CommitteeSize)
+	assert.Equal(t, params.BlockReward, res.BlockReward)
+	assert.Equal(t, params.TransactionToLiveInterval, res.TransactionToLiveInterval)
+	assert.Equal(t, params.BondInterval, res.BondInterval)
+	assert.Equal(t, params.UnbondInterval, res.UnbondInterval)
+	assert.Equal(t, params.SortitionInterval, res.SortitionInterval)
+	assert.Equal(t, params.FeeFraction, res.FeeFraction)
+	assert.Equal(t, params.MinimumFee, res.MinimumFee)
+	assert.Equal(t, params.MaximumFee, res.MaximumFee)
+	assert.Equal(t, params.MinimumStake, res.MinimumStake)
+	assert.Equal(t, params.MaximumStake, res.MaximumStake)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
 func TestConsensusInfo(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -306,6 +995,11 @@ func TestConsensusInfo(t *testing.T) {
 	v2, _ := ts.GenerateTestPrepareVote(100, 2)
 	tConsMocks[1].Active = true
 	tConsMocks[1].Height = 100
+	tConsMocks[1].Timings = []consensus.RoundTiming{
+		{Height: 99, Round: 0, Duration: 3 * time.Second, Committed: false},
+		{Height: 99, Round: 1, Duration: 2 * time.Second, Committed: true},
+	}
+	tConsMocks[1].Missed = consensus.MissedStats{Proposals: 1, Votes: 3}
 	tConsMocks[0].AddVote(v1)
 	tConsMocks[1].AddVote(v2)
 
@@ -318,7 +1012,215 @@ func TestConsensusInfo(t *testing.T) {
 		assert.True(t, res.Instances[1].Active, true)
 		assert.Equal(t, res.Instances[1].Height, uint32(100))
 		assert.Equal(t, res.Instances[0].Votes[0].Type, pactus.VoteType_VOTE_PREPARE)
+		assert.Empty(t, res.Instances[0].RoundTimings)
+		assert.Len(t, res.Instances[1].RoundTimings, 2)
+		assert.Equal(t, int64(2000), res.Instances[1].RoundTimings[1].DurationMs)
+		assert.True(t, res.Instances[1].RoundTimings[1].Committed)
+		assert.False(t, res.Instances[1].RoundTimings[0].Committed)
+		assert.Zero(t, res.Instances[0].MissedProposals)
+		assert.Zero(t, res.Instances[0].MissedVotes)
+		assert.Equal(t, int64(1), res.Instances[1].MissedProposals)
+		assert.Equal(t, int64(3), res.Instances[1].MissedVotes)
+	})
+
+	t.Run("Should return the recorded activity of a past height", func(t *testing.T) {
+		prop, _ := ts.GenerateTestProposal(90, 1)
+		v3, _ := ts.GenerateTestPrepareVote(90, 1)
+		tConsMocks[1].Activities = []*consensus.HeightActivity{
+			{Height: 90, Round: 1, Votes: []*vote.Vote{v3}, Proposal: prop},
+		}
+
+		res, err := client.GetConsensusInfo(tCtx, &pactus.GetConsensusInfoRequest{Height: 90})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Instances, 1)
+		assert.Equal(t, uint32(90), res.Instances[0].Height)
+		assert.Equal(t, int32(1), res.Instances[0].Round)
+		assert.Equal(t, v3.BlockHash().Bytes(), res.Instances[0].Votes[0].BlockHash)
+		assert.Equal(t, uint32(90), res.Instances[0].Proposal.Height)
+		assert.Equal(t, prop.Signature().String(), res.Instances[0].Proposal.Signature)
+	})
+
+	t.Run("Should return error for a height that is not retained", func(t *testing.T) {
+		res, err := client.GetConsensusInfo(tCtx, &pactus.GetConsensusInfoRequest{Height: 80})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
 	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
+
+func TestGetCommittee(t *testing.T) {
+	conn, client := testBlockchainClient(t)
+
+	res, err := client.GetCommittee(tCtx, &pactus.GetCommitteeRequest{})
+	assert.NoError(t, err)
+
+	committee := tMockState.CommitteeValidators()
+	assert.NotEmpty(t, res.Members)
+	assert.Len(t, res.Members, len(committee))
+	for i, member := range res.Members {
+		proposer := tMockState.Proposer(int16(res.Round) + int16(i))
+
+		assert.Equal(t, proposer.Address().String(), member.Address)
+		assert.Equal(t, proposer.Number(), member.Number)
+		assert.Equal(t, proposer.PublicKey().String(), member.PublicKey)
+		assert.Equal(t, i == 0, member.IsProposer)
+	}
+
+	t.Run("Should not overflow the round", func(t *testing.T) {
+		for _, cons := range tConsMocks {
+			cons.Round = math.MaxInt16
+		}
+		defer func() {
+			for _, cons := range tConsMocks {
+				cons.Round = 0
+			}
+		}()
+
+		res, err := client.GetCommittee(tCtx, &pactus.GetCommitteeRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(math.MaxInt16), res.Round)
+		for i, member := range res.Members {
+			next := (int(res.Round) + i) % len(committee)
+			proposer := tMockState.Proposer(int16(next))
+
+			assert.Equal(t, proposer.Address().String(), member.Address)
+		}
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestSubscribeBlockHeight(t *testing.T) {
+	conn, client := testBlockchainClient(t)
+
+	ctx, cancel := context.WithCancel(tCtx)
+	stream, err := client.SubscribeBlockHeight(ctx, &pactus.SubscribeBlockHeightRequest{})
+	require.NoError(t, err)
+
+	t.Run("Should send the current height on subscribing", func(t *testing.T) {
+		res, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, tMockState.LastBlockHeight(), res.Height)
+	})
+
+	t.Run("Should send the new height on committing a block", func(t *testing.T) {
+		tMockState.CommitTestBlocks(1)
+
+		res, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, tMockState.LastBlockHeight(), res.Height)
+	})
+
+	cancel()
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestSubscribeAccount(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conn, client := testBlockchainClient(t)
+
+	t.Run("Should fail for an invalid address", func(t *testing.T) {
+		stream, err := client.SubscribeAccount(tCtx,
+			&pactus.SubscribeAccountRequest{Address: "invalid"})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should send the transactions touching the address", func(t *testing.T) {
+		height := tMockState.LastBlockHeight() + 1
+		blk, cert := ts.GenerateTestBlock(height)
+		trx := blk.Transactions()[0]
+
+		ctx, cancel := context.WithCancel(tCtx)
+		stream, err := client.SubscribeAccount(ctx,
+			&pactus.SubscribeAccountRequest{Address: trx.Payload().Signer().String()})
+		require.NoError(t, err)
+
+		// Give the server time to subscribe before committing the block.
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, tMockState.CommitBlock(blk, cert))
+
+		res, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, height, res.Height)
+		assert.Equal(t, trx.ID().Bytes(), res.Transaction.Id)
+
+		cancel()
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+// slowAccountStream is a server stream whose client reads the messages slowly.
+// Each message is blocked until the client is ready to receive it.
+type slowAccountStream struct {
+	grpc.ServerStream
+
+	ctx   context.Context
+	ready chan struct{}
+	sent  []*pactus.SubscribeAccountResponse
+}
+
+func (s *slowAccountStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *slowAccountStream) Send(res *pactus.SubscribeAccountResponse) error {
+	<-s.ready
+	s.sent = append(s.sent, res)
+
+	return nil
+}
+
+func TestSubscribeAccountSlowClient(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockState := state.MockingState(ts)
+	subLogger := logger.NewSubLogger("_grpc", nil)
+	notifier := newBlockNotifier(mockState, subLogger)
+	s := &blockchainServer{
+		state:            mockState,
+		notifier:         notifier,
+		maxStreamBacklog: 2,
+		logger:           subLogger,
+	}
+
+	height := mockState.LastBlockHeight() + 1
+	blk, cert := ts.GenerateTestBlock(height)
+	trx := blk.Transactions()[0]
+
+	ctx, cancel := context.WithCancel(tCtx)
+	defer cancel()
+	stream := &slowAccountStream{ctx: ctx, ready: make(chan struct{})}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- s.SubscribeAccount(&pactus.SubscribeAccountRequest{
+			Address: trx.Payload().Signer().String(),
+		}, stream)
+	}()
+	assert.Eventually(t, func() bool {
+		notifier.lk.RLock()
+		defer notifier.lk.RUnlock()
+
+		return len(notifier.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// The server is blocked on sending the first transaction,
+	// while more blocks are committed.
+	require.NoError(t, mockState.CommitBlock(blk, cert))
+	notifier.notify(mockState.LastBlockHeight())
+	time.Sleep(50 * time.Millisecond)
+	mockState.CommitTestBlocks(5)
+	notifier.notify(mockState.LastBlockHeight())
+	close(stream.ready)
+
+	err := <-errCh
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Len(t, stream.sent, 1)
+	assert.Equal(t, trx.ID().Bytes(), stream.sent[0].Transaction.Id)
+}
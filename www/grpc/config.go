@@ -1,15 +1,29 @@
 package grpc
 
+import "time"
+
 type Config struct {
-	Enable  bool          `toml:"enable"`
-	Listen  string        `toml:"listen"`
-	Gateway GatewayConfig `toml:"gateway"`
+	Enable                 bool          `toml:"enable"`
+	Listen                 string        `toml:"listen"`
+	EnableAdmin            bool          `toml:"enable_admin"`
+	EnableValidatorHistory bool          `toml:"enable_validator_history"`
+	SlowCallThreshold      time.Duration `toml:"slow_call_threshold"`
+	MaxConcurrentStreams   uint32        `toml:"max_concurrent_streams"`
+	MaxConcurrentCalls     uint32        `toml:"max_concurrent_calls"`
+	MaxStreamBacklog       uint32        `toml:"max_stream_backlog"`
+	Gateway                GatewayConfig `toml:"gateway"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Enable: false,
-		Listen: "50051",
+		Enable:                 false,
+		Listen:                 "50051",
+		EnableAdmin:            false,
+		EnableValidatorHistory: false,
+		SlowCallThreshold:      time.Second,
+		MaxConcurrentStreams:   100,
+		MaxConcurrentCalls:     0,
+		MaxStreamBacklog:       100,
 		Gateway: GatewayConfig{
 			Enable:     false,
 			Listen:     "8080",
@@ -1,8 +1,11 @@
 package grpc
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"mime"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -11,6 +14,8 @@ import (
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	_ "github.com/pactus-project/pactus/www/grpc/statik" // Static files.
 	"github.com/rakyll/statik/fs"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -19,6 +24,7 @@ type GatewayConfig struct {
 	Enable     bool   `toml:"enable"`
 	Listen     string `toml:"listen"`
 	EnableCORS bool   `toml:"enable_cors"`
+	Multiplex  bool   `toml:"multiplex"`
 }
 
 // getOpenAPIHandler serves an OpenAPI UI.
@@ -37,42 +43,36 @@ func (s *Server) getOpenAPIHandler() (http.Handler, error) {
 	return http.FileServer(statikFS), nil
 }
 
-func (s *Server) startGateway() error {
-	if !s.config.Gateway.Enable {
-		return nil
-	}
-
-	conn, err := grpc.DialContext(
-		s.ctx,
-		s.config.Listen,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-	)
+// newGateway creates the HTTP server of the gateway, that proxies the requests to the gRPC server.
+// When multiplexed, the gRPC requests are served by the same HTTP server over h2c.
+func (s *Server) newGateway() (*http.Server, error) {
+	// The connection is established lazily, so the gateway can be created before serving the gRPC.
+	conn, err := grpc.DialContext(s.ctx, s.Address(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		return fmt.Errorf("failed to dial server: %w", err)
+		return nil, fmt.Errorf("failed to dial server: %w", err)
 	}
 
 	gwMux := runtime.NewServeMux()
 	err = pactus.RegisterBlockchainHandler(s.ctx, gwMux, conn)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = pactus.RegisterTransactionHandler(s.ctx, gwMux, conn)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = pactus.RegisterNetworkHandler(s.ctx, gwMux, conn)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	oa, err := s.getOpenAPIHandler()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	gwServer := &http.Server{
-		Addr:              s.config.Gateway.Listen,
 		ReadHeaderTimeout: 3 * time.Second,
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if strings.HasPrefix(r.URL.Path, "/v1") {
@@ -85,7 +85,46 @@ func (s *Server) startGateway() error {
 	if s.config.Gateway.EnableCORS {
 		gwServer.Handler = allowCORS(gwServer.Handler)
 	}
-	return gwServer.ListenAndServe()
+
+	if s.isMultiplexed() {
+		// Like the gRPC server, zero means no limit on the concurrent streams.
+		maxStreams := s.config.MaxConcurrentStreams
+		if maxStreams == 0 {
+			maxStreams = math.MaxUint32
+		}
+		gwServer.Handler = h2c.NewHandler(
+			multiplexHandler(s.grpc, gwServer.Handler),
+			&http2.Server{MaxConcurrentStreams: maxStreams})
+	}
+
+	return gwServer, nil
+}
+
+// serveGateway serves the gateway on the given listener, until the gateway server is closed.
+func (s *Server) serveGateway(listener net.Listener) {
+	s.logger.Info("grpc-gateway started listening", "address", listener.Addr().String())
+	err := s.gwServer.Serve(listener)
+	if err != nil && !errors.Is(err, net.ErrClosed) && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error("error on grpc-gateway serve", "error", err)
+	}
+}
+
+// isMultiplexed checks if the gRPC and the gateway are served on the same listener.
+func (s *Server) isMultiplexed() bool {
+	return s.config.Gateway.Enable && s.config.Gateway.Multiplex
+}
+
+// multiplexHandler passes the gRPC requests, which are HTTP/2 requests with the
+// "application/grpc" content type, to the gRPC server and the rest to the gateway.
+func multiplexHandler(grpcServer *grpc.Server, gwHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 &&
+			strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		gwHandler.ServeHTTP(w, r)
+	})
 }
 
 // preflightHandler adds the necessary headers in order to serve
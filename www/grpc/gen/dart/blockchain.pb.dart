@@ -20,17 +20,22 @@ export 'blockchain.pbenum.dart';
 class GetAccountRequest extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetAccountRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'address')
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
     ..hasRequiredFields = false
   ;
 
   GetAccountRequest._() : super();
   factory GetAccountRequest({
     $core.String? address,
+    $core.int? height,
   }) {
     final _result = create();
     if (address != null) {
       _result.address = address;
     }
+    if (height != null) {
+      _result.height = height;
+    }
     return _result;
   }
   factory GetAccountRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -62,6 +67,15 @@ class GetAccountRequest extends $pb.GeneratedMessage {
   $core.bool hasAddress() => $_has(0);
   @$pb.TagNumber(1)
   void clearAddress() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get height => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set height($core.int v) { $_setUnsignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasHeight() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearHeight() => clearField(2);
 }
 
 class GetAccountResponse extends $pb.GeneratedMessage {
@@ -113,6 +127,96 @@ class GetAccountResponse extends $pb.GeneratedMessage {
   AccountInfo ensureAccount() => $_ensure(0);
 }
 
+class GetAccountsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetAccountsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..pPS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'addresses')
+    ..hasRequiredFields = false
+  ;
+
+  GetAccountsRequest._() : super();
+  factory GetAccountsRequest({
+    $core.Iterable<$core.String>? addresses,
+  }) {
+    final _result = create();
+    if (addresses != null) {
+      _result.addresses.addAll(addresses);
+    }
+    return _result;
+  }
+  factory GetAccountsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetAccountsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetAccountsRequest clone() => GetAccountsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetAccountsRequest copyWith(void Function(GetAccountsRequest) updates) => super.copyWith((message) => updates(message as GetAccountsRequest)) as GetAccountsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetAccountsRequest create() => GetAccountsRequest._();
+  GetAccountsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetAccountsRequest> createRepeated() => $pb.PbList<GetAccountsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetAccountsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetAccountsRequest>(create);
+  static GetAccountsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.String> get addresses => $_getList(0);
+}
+
+class GetAccountsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetAccountsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..pc<AccountInfo>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'accounts', $pb.PbFieldType.PM, subBuilder: AccountInfo.create)
+    ..pPS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'missingAddresses')
+    ..hasRequiredFields = false
+  ;
+
+  GetAccountsResponse._() : super();
+  factory GetAccountsResponse({
+    $core.Iterable<AccountInfo>? accounts,
+    $core.Iterable<$core.String>? missingAddresses,
+  }) {
+    final _result = create();
+    if (accounts != null) {
+      _result.accounts.addAll(accounts);
+    }
+    if (missingAddresses != null) {
+      _result.missingAddresses.addAll(missingAddresses);
+    }
+    return _result;
+  }
+  factory GetAccountsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetAccountsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetAccountsResponse clone() => GetAccountsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetAccountsResponse copyWith(void Function(GetAccountsResponse) updates) => super.copyWith((message) => updates(message as GetAccountsResponse)) as GetAccountsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetAccountsResponse create() => GetAccountsResponse._();
+  GetAccountsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetAccountsResponse> createRepeated() => $pb.PbList<GetAccountsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetAccountsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetAccountsResponse>(create);
+  static GetAccountsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<AccountInfo> get accounts => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.String> get missingAddresses => $_getList(1);
+}
+
 class GetValidatorAddressesRequest extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidatorAddressesRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..hasRequiredFields = false
@@ -145,17 +249,22 @@ class GetValidatorAddressesRequest extends $pb.GeneratedMessage {
 class GetValidatorAddressesResponse extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidatorAddressesResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..pPS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'addresses')
+    ..pc<ValidatorAddressInfo>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'validators', $pb.PbFieldType.PM, subBuilder: ValidatorAddressInfo.create)
     ..hasRequiredFields = false
   ;
 
   GetValidatorAddressesResponse._() : super();
   factory GetValidatorAddressesResponse({
     $core.Iterable<$core.String>? addresses,
+    $core.Iterable<ValidatorAddressInfo>? validators,
   }) {
     final _result = create();
     if (addresses != null) {
       _result.addresses.addAll(addresses);
     }
+    if (validators != null) {
+      _result.validators.addAll(validators);
+    }
     return _result;
   }
   factory GetValidatorAddressesResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -181,6 +290,84 @@ class GetValidatorAddressesResponse extends $pb.GeneratedMessage {
 
   @$pb.TagNumber(1)
   $core.List<$core.String> get addresses => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $core.List<ValidatorAddressInfo> get validators => $_getList(1);
+}
+
+class ValidatorAddressInfo extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ValidatorAddressInfo', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'address')
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'number', $pb.PbFieldType.O3)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'publicKey')
+    ..hasRequiredFields = false
+  ;
+
+  ValidatorAddressInfo._() : super();
+  factory ValidatorAddressInfo({
+    $core.String? address,
+    $core.int? number,
+    $core.String? publicKey,
+  }) {
+    final _result = create();
+    if (address != null) {
+      _result.address = address;
+    }
+    if (number != null) {
+      _result.number = number;
+    }
+    if (publicKey != null) {
+      _result.publicKey = publicKey;
+    }
+    return _result;
+  }
+  factory ValidatorAddressInfo.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ValidatorAddressInfo.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ValidatorAddressInfo clone() => ValidatorAddressInfo()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ValidatorAddressInfo copyWith(void Function(ValidatorAddressInfo) updates) => super.copyWith((message) => updates(message as ValidatorAddressInfo)) as ValidatorAddressInfo; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ValidatorAddressInfo create() => ValidatorAddressInfo._();
+  ValidatorAddressInfo createEmptyInstance() => create();
+  static $pb.PbList<ValidatorAddressInfo> createRepeated() => $pb.PbList<ValidatorAddressInfo>();
+  @$core.pragma('dart2js:noInline')
+  static ValidatorAddressInfo getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ValidatorAddressInfo>(create);
+  static ValidatorAddressInfo? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get address => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set address($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasAddress() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearAddress() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get number => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set number($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasNumber() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNumber() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get publicKey => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set publicKey($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasPublicKey() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearPublicKey() => clearField(3);
 }
 
 class GetValidatorRequest extends $pb.GeneratedMessage {
@@ -280,17 +467,32 @@ class GetValidatorByNumberRequest extends $pb.GeneratedMessage {
 class GetValidatorResponse extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidatorResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..aOM<ValidatorInfo>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'validator', subBuilder: ValidatorInfo.create)
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'withdrawableHeight', $pb.PbFieldType.OU3)
+    ..aOB(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'rewardKnown')
+    ..aInt64(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'rewardBalance')
     ..hasRequiredFields = false
   ;
 
   GetValidatorResponse._() : super();
   factory GetValidatorResponse({
     ValidatorInfo? validator,
+    $core.int? withdrawableHeight,
+    $core.bool? rewardKnown,
+    $fixnum.Int64? rewardBalance,
   }) {
     final _result = create();
     if (validator != null) {
       _result.validator = validator;
     }
+    if (withdrawableHeight != null) {
+      _result.withdrawableHeight = withdrawableHeight;
+    }
+    if (rewardKnown != null) {
+      _result.rewardKnown = rewardKnown;
+    }
+    if (rewardBalance != null) {
+      _result.rewardBalance = rewardBalance;
+    }
     return _result;
   }
   factory GetValidatorResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -324,16 +526,43 @@ class GetValidatorResponse extends $pb.GeneratedMessage {
   void clearValidator() => clearField(1);
   @$pb.TagNumber(1)
   ValidatorInfo ensureValidator() => $_ensure(0);
+
+  @$pb.TagNumber(2)
+  $core.int get withdrawableHeight => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set withdrawableHeight($core.int v) { $_setUnsignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasWithdrawableHeight() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearWithdrawableHeight() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.bool get rewardKnown => $_getBF(2);
+  @$pb.TagNumber(3)
+  set rewardKnown($core.bool v) { $_setBool(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasRewardKnown() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearRewardKnown() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get rewardBalance => $_getI64(3);
+  @$pb.TagNumber(4)
+  set rewardBalance($fixnum.Int64 v) { $_setInt64(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasRewardBalance() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearRewardBalance() => clearField(4);
 }
 
-class GetPublicKeyRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPublicKeyRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+class GetValidatorHistoryRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidatorHistoryRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'address')
     ..hasRequiredFields = false
   ;
 
-  GetPublicKeyRequest._() : super();
-  factory GetPublicKeyRequest({
+  GetValidatorHistoryRequest._() : super();
+  factory GetValidatorHistoryRequest({
     $core.String? address,
   }) {
     final _result = create();
@@ -342,26 +571,26 @@ class GetPublicKeyRequest extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory GetPublicKeyRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPublicKeyRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetValidatorHistoryRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetValidatorHistoryRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPublicKeyRequest clone() => GetPublicKeyRequest()..mergeFromMessage(this);
+  GetValidatorHistoryRequest clone() => GetValidatorHistoryRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPublicKeyRequest copyWith(void Function(GetPublicKeyRequest) updates) => super.copyWith((message) => updates(message as GetPublicKeyRequest)) as GetPublicKeyRequest; // ignore: deprecated_member_use
+  GetValidatorHistoryRequest copyWith(void Function(GetValidatorHistoryRequest) updates) => super.copyWith((message) => updates(message as GetValidatorHistoryRequest)) as GetValidatorHistoryRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPublicKeyRequest create() => GetPublicKeyRequest._();
-  GetPublicKeyRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPublicKeyRequest> createRepeated() => $pb.PbList<GetPublicKeyRequest>();
+  static GetValidatorHistoryRequest create() => GetValidatorHistoryRequest._();
+  GetValidatorHistoryRequest createEmptyInstance() => create();
+  static $pb.PbList<GetValidatorHistoryRequest> createRepeated() => $pb.PbList<GetValidatorHistoryRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetPublicKeyRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPublicKeyRequest>(create);
-  static GetPublicKeyRequest? _defaultInstance;
+  static GetValidatorHistoryRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetValidatorHistoryRequest>(create);
+  static GetValidatorHistoryRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get address => $_getSZ(0);
@@ -373,94 +602,98 @@ class GetPublicKeyRequest extends $pb.GeneratedMessage {
   void clearAddress() => clearField(1);
 }
 
-class GetPublicKeyResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPublicKeyResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'publicKey')
+class GetValidatorHistoryResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetValidatorHistoryResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..pc<ValidatorHistoryEvent>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'events', $pb.PbFieldType.PM, subBuilder: ValidatorHistoryEvent.create)
     ..hasRequiredFields = false
   ;
 
-  GetPublicKeyResponse._() : super();
-  factory GetPublicKeyResponse({
-    $core.String? publicKey,
+  GetValidatorHistoryResponse._() : super();
+  factory GetValidatorHistoryResponse({
+    $core.Iterable<ValidatorHistoryEvent>? events,
   }) {
     final _result = create();
-    if (publicKey != null) {
-      _result.publicKey = publicKey;
+    if (events != null) {
+      _result.events.addAll(events);
     }
     return _result;
   }
-  factory GetPublicKeyResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPublicKeyResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetValidatorHistoryResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetValidatorHistoryResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPublicKeyResponse clone() => GetPublicKeyResponse()..mergeFromMessage(this);
+  GetValidatorHistoryResponse clone() => GetValidatorHistoryResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPublicKeyResponse copyWith(void Function(GetPublicKeyResponse) updates) => super.copyWith((message) => updates(message as GetPublicKeyResponse)) as GetPublicKeyResponse; // ignore: deprecated_member_use
+  GetValidatorHistoryResponse copyWith(void Function(GetValidatorHistoryResponse) updates) => super.copyWith((message) => updates(message as GetValidatorHistoryResponse)) as GetValidatorHistoryResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPublicKeyResponse create() => GetPublicKeyResponse._();
-  GetPublicKeyResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPublicKeyResponse> createRepeated() => $pb.PbList<GetPublicKeyResponse>();
+  static GetValidatorHistoryResponse create() => GetValidatorHistoryResponse._();
+  GetValidatorHistoryResponse createEmptyInstance() => create();
+  static $pb.PbList<GetValidatorHistoryResponse> createRepeated() => $pb.PbList<GetValidatorHistoryResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPublicKeyResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPublicKeyResponse>(create);
-  static GetPublicKeyResponse? _defaultInstance;
+  static GetValidatorHistoryResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetValidatorHistoryResponse>(create);
+  static GetValidatorHistoryResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get publicKey => $_getSZ(0);
-  @$pb.TagNumber(1)
-  set publicKey($core.String v) { $_setString(0, v); }
-  @$pb.TagNumber(1)
-  $core.bool hasPublicKey() => $_has(0);
-  @$pb.TagNumber(1)
-  void clearPublicKey() => clearField(1);
+  $core.List<ValidatorHistoryEvent> get events => $_getList(0);
 }
 
-class GetBlockRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+class ValidatorHistoryEvent extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ValidatorHistoryEvent', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
-    ..e<BlockVerbosity>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'verbosity', $pb.PbFieldType.OE, defaultOrMaker: BlockVerbosity.BLOCK_DATA, valueOf: BlockVerbosity.valueOf, enumValues: BlockVerbosity.values)
+    ..aOM<$0.TransactionInfo>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'transaction', subBuilder: $0.TransactionInfo.create)
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'amount')
+    ..aInt64(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'stake')
     ..hasRequiredFields = false
   ;
 
-  GetBlockRequest._() : super();
-  factory GetBlockRequest({
+  ValidatorHistoryEvent._() : super();
+  factory ValidatorHistoryEvent({
     $core.int? height,
-    BlockVerbosity? verbosity,
+    $0.TransactionInfo? transaction,
+    $fixnum.Int64? amount,
+    $fixnum.Int64? stake,
   }) {
     final _result = create();
     if (height != null) {
       _result.height = height;
     }
-    if (verbosity != null) {
-      _result.verbosity = verbosity;
+    if (transaction != null) {
+      _result.transaction = transaction;
+    }
+    if (amount != null) {
+      _result.amount = amount;
+    }
+    if (stake != null) {
+      _result.stake = stake;
     }
     return _result;
   }
-  factory GetBlockRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ValidatorHistoryEvent.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ValidatorHistoryEvent.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockRequest clone() => GetBlockRequest()..mergeFromMessage(this);
+  ValidatorHistoryEvent clone() => ValidatorHistoryEvent()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockRequest copyWith(void Function(GetBlockRequest) updates) => super.copyWith((message) => updates(message as GetBlockRequest)) as GetBlockRequest; // ignore: deprecated_member_use
+  ValidatorHistoryEvent copyWith(void Function(ValidatorHistoryEvent) updates) => super.copyWith((message) => updates(message as ValidatorHistoryEvent)) as ValidatorHistoryEvent; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockRequest create() => GetBlockRequest._();
-  GetBlockRequest createEmptyInstance() => create();
-  static $pb.PbList<GetBlockRequest> createRepeated() => $pb.PbList<GetBlockRequest>();
+  static ValidatorHistoryEvent create() => ValidatorHistoryEvent._();
+  ValidatorHistoryEvent createEmptyInstance() => create();
+  static $pb.PbList<ValidatorHistoryEvent> createRepeated() => $pb.PbList<ValidatorHistoryEvent>();
   @$core.pragma('dart2js:noInline')
-  static GetBlockRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockRequest>(create);
-  static GetBlockRequest? _defaultInstance;
+  static ValidatorHistoryEvent getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ValidatorHistoryEvent>(create);
+  static ValidatorHistoryEvent? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.int get height => $_getIZ(0);
@@ -472,355 +705,1765 @@ class GetBlockRequest extends $pb.GeneratedMessage {
   void clearHeight() => clearField(1);
 
   @$pb.TagNumber(2)
-  BlockVerbosity get verbosity => $_getN(1);
+  $0.TransactionInfo get transaction => $_getN(1);
   @$pb.TagNumber(2)
-  set verbosity(BlockVerbosity v) { setField(2, v); }
+  set transaction($0.TransactionInfo v) { setField(2, v); }
   @$pb.TagNumber(2)
-  $core.bool hasVerbosity() => $_has(1);
+  $core.bool hasTransaction() => $_has(1);
   @$pb.TagNumber(2)
-  void clearVerbosity() => clearField(2);
+  void clearTransaction() => clearField(2);
+  @$pb.TagNumber(2)
+  $0.TransactionInfo ensureTransaction() => $_ensure(1);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get amount => $_getI64(2);
+  @$pb.TagNumber(3)
+  set amount($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasAmount() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearAmount() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get stake => $_getI64(3);
+  @$pb.TagNumber(4)
+  set stake($fixnum.Int64 v) { $_setInt64(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasStake() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearStake() => clearField(4);
 }
 
-class GetBlockResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
-    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
-    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
-    ..a<$core.List<$core.int>>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'data', $pb.PbFieldType.OY)
-    ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockTime', $pb.PbFieldType.OU3)
-    ..aOM<BlockHeaderInfo>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'header', subBuilder: BlockHeaderInfo.create)
-    ..aOM<CertificateInfo>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'prevCert', subBuilder: CertificateInfo.create)
-    ..pc<$0.TransactionInfo>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txs', $pb.PbFieldType.PM, subBuilder: $0.TransactionInfo.create)
+class GetPublicKeyRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPublicKeyRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'address')
     ..hasRequiredFields = false
   ;
 
-  GetBlockResponse._() : super();
-  factory GetBlockResponse({
-    $core.int? height,
-    $core.List<$core.int>? hash,
-    $core.List<$core.int>? data,
-    $core.int? blockTime,
-    BlockHeaderInfo? header,
-    CertificateInfo? prevCert,
-    $core.Iterable<$0.TransactionInfo>? txs,
+  GetPublicKeyRequest._() : super();
+  factory GetPublicKeyRequest({
+    $core.String? address,
   }) {
     final _result = create();
-    if (height != null) {
-      _result.height = height;
-    }
-    if (hash != null) {
-      _result.hash = hash;
-    }
-    if (data != null) {
-      _result.data = data;
-    }
-    if (blockTime != null) {
-      _result.blockTime = blockTime;
-    }
-    if (header != null) {
-      _result.header = header;
-    }
-    if (prevCert != null) {
-      _result.prevCert = prevCert;
-    }
-    if (txs != null) {
-      _result.txs.addAll(txs);
+    if (address != null) {
+      _result.address = address;
     }
     return _result;
   }
-  factory GetBlockResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPublicKeyRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPublicKeyRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockResponse clone() => GetBlockResponse()..mergeFromMessage(this);
+  GetPublicKeyRequest clone() => GetPublicKeyRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockResponse copyWith(void Function(GetBlockResponse) updates) => super.copyWith((message) => updates(message as GetBlockResponse)) as GetBlockResponse; // ignore: deprecated_member_use
+  GetPublicKeyRequest copyWith(void Function(GetPublicKeyRequest) updates) => super.copyWith((message) => updates(message as GetPublicKeyRequest)) as GetPublicKeyRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockResponse create() => GetBlockResponse._();
-  GetBlockResponse createEmptyInstance() => create();
-  static $pb.PbList<GetBlockResponse> createRepeated() => $pb.PbList<GetBlockResponse>();
+  static GetPublicKeyRequest create() => GetPublicKeyRequest._();
+  GetPublicKeyRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPublicKeyRequest> createRepeated() => $pb.PbList<GetPublicKeyRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetBlockResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockResponse>(create);
-  static GetBlockResponse? _defaultInstance;
+  static GetPublicKeyRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPublicKeyRequest>(create);
+  static GetPublicKeyRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.int get height => $_getIZ(0);
+  $core.String get address => $_getSZ(0);
   @$pb.TagNumber(1)
-  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  set address($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasHeight() => $_has(0);
+  $core.bool hasAddress() => $_has(0);
   @$pb.TagNumber(1)
-  void clearHeight() => clearField(1);
-
-  @$pb.TagNumber(2)
-  $core.List<$core.int> get hash => $_getN(1);
-  @$pb.TagNumber(2)
-  set hash($core.List<$core.int> v) { $_setBytes(1, v); }
-  @$pb.TagNumber(2)
-  $core.bool hasHash() => $_has(1);
-  @$pb.TagNumber(2)
-  void clearHash() => clearField(2);
-
-  @$pb.TagNumber(3)
-  $core.List<$core.int> get data => $_getN(2);
-  @$pb.TagNumber(3)
-  set data($core.List<$core.int> v) { $_setBytes(2, v); }
-  @$pb.TagNumber(3)
-  $core.bool hasData() => $_has(2);
-  @$pb.TagNumber(3)
-  void clearData() => clearField(3);
-
-  @$pb.TagNumber(4)
-  $core.int get blockTime => $_getIZ(3);
-  @$pb.TagNumber(4)
-  set blockTime($core.int v) { $_setUnsignedInt32(3, v); }
-  @$pb.TagNumber(4)
-  $core.bool hasBlockTime() => $_has(3);
-  @$pb.TagNumber(4)
-  void clearBlockTime() => clearField(4);
-
-  @$pb.TagNumber(5)
-  BlockHeaderInfo get header => $_getN(4);
-  @$pb.TagNumber(5)
-  set header(BlockHeaderInfo v) { setField(5, v); }
-  @$pb.TagNumber(5)
-  $core.bool hasHeader() => $_has(4);
-  @$pb.TagNumber(5)
-  void clearHeader() => clearField(5);
-  @$pb.TagNumber(5)
-  BlockHeaderInfo ensureHeader() => $_ensure(4);
-
-  @$pb.TagNumber(6)
-  CertificateInfo get prevCert => $_getN(5);
-  @$pb.TagNumber(6)
-  set prevCert(CertificateInfo v) { setField(6, v); }
-  @$pb.TagNumber(6)
-  $core.bool hasPrevCert() => $_has(5);
-  @$pb.TagNumber(6)
-  void clearPrevCert() => clearField(6);
-  @$pb.TagNumber(6)
-  CertificateInfo ensurePrevCert() => $_ensure(5);
-
-  @$pb.TagNumber(7)
-  $core.List<$0.TransactionInfo> get txs => $_getList(6);
+  void clearAddress() => clearField(1);
 }
 
-class GetBlockHashRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHashRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
-    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+class GetPublicKeyResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPublicKeyResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'publicKey')
     ..hasRequiredFields = false
   ;
 
-  GetBlockHashRequest._() : super();
-  factory GetBlockHashRequest({
-    $core.int? height,
+  GetPublicKeyResponse._() : super();
+  factory GetPublicKeyResponse({
+    $core.String? publicKey,
   }) {
     final _result = create();
-    if (height != null) {
-      _result.height = height;
+    if (publicKey != null) {
+      _result.publicKey = publicKey;
     }
     return _result;
   }
-  factory GetBlockHashRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockHashRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPublicKeyResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPublicKeyResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockHashRequest clone() => GetBlockHashRequest()..mergeFromMessage(this);
+  GetPublicKeyResponse clone() => GetPublicKeyResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockHashRequest copyWith(void Function(GetBlockHashRequest) updates) => super.copyWith((message) => updates(message as GetBlockHashRequest)) as GetBlockHashRequest; // ignore: deprecated_member_use
+  GetPublicKeyResponse copyWith(void Function(GetPublicKeyResponse) updates) => super.copyWith((message) => updates(message as GetPublicKeyResponse)) as GetPublicKeyResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockHashRequest create() => GetBlockHashRequest._();
-  GetBlockHashRequest createEmptyInstance() => create();
-  static $pb.PbList<GetBlockHashRequest> createRepeated() => $pb.PbList<GetBlockHashRequest>();
+  static GetPublicKeyResponse create() => GetPublicKeyResponse._();
+  GetPublicKeyResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPublicKeyResponse> createRepeated() => $pb.PbList<GetPublicKeyResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetBlockHashRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHashRequest>(create);
-  static GetBlockHashRequest? _defaultInstance;
+  static GetPublicKeyResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPublicKeyResponse>(create);
+  static GetPublicKeyResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.int get height => $_getIZ(0);
+  $core.String get publicKey => $_getSZ(0);
   @$pb.TagNumber(1)
-  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  set publicKey($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasHeight() => $_has(0);
+  $core.bool hasPublicKey() => $_has(0);
   @$pb.TagNumber(1)
-  void clearHeight() => clearField(1);
+  void clearPublicKey() => clearField(1);
 }
 
-class GetBlockHashResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHashResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
-    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+class GetAddressByPublicKeyRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetAddressByPublicKeyRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'publicKey')
     ..hasRequiredFields = false
   ;
 
-  GetBlockHashResponse._() : super();
-  factory GetBlockHashResponse({
-    $core.List<$core.int>? hash,
+  GetAddressByPublicKeyRequest._() : super();
+  factory GetAddressByPublicKeyRequest({
+    $core.String? publicKey,
   }) {
     final _result = create();
-    if (hash != null) {
-      _result.hash = hash;
+    if (publicKey != null) {
+      _result.publicKey = publicKey;
     }
     return _result;
   }
-  factory GetBlockHashResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockHashResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetAddressByPublicKeyRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetAddressByPublicKeyRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockHashResponse clone() => GetBlockHashResponse()..mergeFromMessage(this);
+  GetAddressByPublicKeyRequest clone() => GetAddressByPublicKeyRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockHashResponse copyWith(void Function(GetBlockHashResponse) updates) => super.copyWith((message) => updates(message as GetBlockHashResponse)) as GetBlockHashResponse; // ignore: deprecated_member_use
+  GetAddressByPublicKeyRequest copyWith(void Function(GetAddressByPublicKeyRequest) updates) => super.copyWith((message) => updates(message as GetAddressByPublicKeyRequest)) as GetAddressByPublicKeyRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockHashResponse create() => GetBlockHashResponse._();
-  GetBlockHashResponse createEmptyInstance() => create();
-  static $pb.PbList<GetBlockHashResponse> createRepeated() => $pb.PbList<GetBlockHashResponse>();
+  static GetAddressByPublicKeyRequest create() => GetAddressByPublicKeyRequest._();
+  GetAddressByPublicKeyRequest createEmptyInstance() => create();
+  static $pb.PbList<GetAddressByPublicKeyRequest> createRepeated() => $pb.PbList<GetAddressByPublicKeyRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetBlockHashResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHashResponse>(create);
-  static GetBlockHashResponse? _defaultInstance;
+  static GetAddressByPublicKeyRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetAddressByPublicKeyRequest>(create);
+  static GetAddressByPublicKeyRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.List<$core.int> get hash => $_getN(0);
+  $core.String get publicKey => $_getSZ(0);
   @$pb.TagNumber(1)
-  set hash($core.List<$core.int> v) { $_setBytes(0, v); }
+  set publicKey($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasHash() => $_has(0);
+  $core.bool hasPublicKey() => $_has(0);
   @$pb.TagNumber(1)
-  void clearHash() => clearField(1);
+  void clearPublicKey() => clearField(1);
 }
 
-class GetBlockHeightRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHeightRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
-    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+class GetAddressByPublicKeyResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetAddressByPublicKeyResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'accountAddress')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'validatorAddress')
     ..hasRequiredFields = false
   ;
 
-  GetBlockHeightRequest._() : super();
-  factory GetBlockHeightRequest({
-    $core.List<$core.int>? hash,
+  GetAddressByPublicKeyResponse._() : super();
+  factory GetAddressByPublicKeyResponse({
+    $core.String? accountAddress,
+    $core.String? validatorAddress,
   }) {
     final _result = create();
-    if (hash != null) {
-      _result.hash = hash;
+    if (accountAddress != null) {
+      _result.accountAddress = accountAddress;
+    }
+    if (validatorAddress != null) {
+      _result.validatorAddress = validatorAddress;
     }
     return _result;
   }
-  factory GetBlockHeightRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockHeightRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetAddressByPublicKeyResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetAddressByPublicKeyResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockHeightRequest clone() => GetBlockHeightRequest()..mergeFromMessage(this);
+  GetAddressByPublicKeyResponse clone() => GetAddressByPublicKeyResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockHeightRequest copyWith(void Function(GetBlockHeightRequest) updates) => super.copyWith((message) => updates(message as GetBlockHeightRequest)) as GetBlockHeightRequest; // ignore: deprecated_member_use
+  GetAddressByPublicKeyResponse copyWith(void Function(GetAddressByPublicKeyResponse) updates) => super.copyWith((message) => updates(message as GetAddressByPublicKeyResponse)) as GetAddressByPublicKeyResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockHeightRequest create() => GetBlockHeightRequest._();
-  GetBlockHeightRequest createEmptyInstance() => create();
-  static $pb.PbList<GetBlockHeightRequest> createRepeated() => $pb.PbList<GetBlockHeightRequest>();
+  static GetAddressByPublicKeyResponse create() => GetAddressByPublicKeyResponse._();
+  GetAddressByPublicKeyResponse createEmptyInstance() => create();
+  static $pb.PbList<GetAddressByPublicKeyResponse> createRepeated() => $pb.PbList<GetAddressByPublicKeyResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetBlockHeightRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHeightRequest>(create);
-  static GetBlockHeightRequest? _defaultInstance;
+  static GetAddressByPublicKeyResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetAddressByPublicKeyResponse>(create);
+  static GetAddressByPublicKeyResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.List<$core.int> get hash => $_getN(0);
+  $core.String get accountAddress => $_getSZ(0);
   @$pb.TagNumber(1)
-  set hash($core.List<$core.int> v) { $_setBytes(0, v); }
+  set accountAddress($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasHash() => $_has(0);
+  $core.bool hasAccountAddress() => $_has(0);
   @$pb.TagNumber(1)
-  void clearHash() => clearField(1);
+  void clearAccountAddress() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get validatorAddress => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set validatorAddress($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasValidatorAddress() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearValidatorAddress() => clearField(2);
 }
 
-class GetBlockHeightResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHeightResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
-    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+class GetGenesisRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetGenesisRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..hasRequiredFields = false
   ;
 
-  GetBlockHeightResponse._() : super();
-  factory GetBlockHeightResponse({
-    $core.int? height,
+  GetGenesisRequest._() : super();
+  factory GetGenesisRequest() => create();
+  factory GetGenesisRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetGenesisRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetGenesisRequest clone() => GetGenesisRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetGenesisRequest copyWith(void Function(GetGenesisRequest) updates) => super.copyWith((message) => updates(message as GetGenesisRequest)) as GetGenesisRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetGenesisRequest create() => GetGenesisRequest._();
+  GetGenesisRequest createEmptyInstance() => create();
+  static $pb.PbList<GetGenesisRequest> createRepeated() => $pb.PbList<GetGenesisRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetGenesisRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetGenesisRequest>(create);
+  static GetGenesisRequest? _defaultInstance;
+}
+
+class GetGenesisResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetGenesisResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'data', $pb.PbFieldType.OY)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'chainType')
+    ..hasRequiredFields = false
+  ;
+
+  GetGenesisResponse._() : super();
+  factory GetGenesisResponse({
+    $core.List<$core.int>? hash,
+    $core.List<$core.int>? data,
+    $core.String? chainType,
   }) {
     final _result = create();
-    if (height != null) {
-      _result.height = height;
+    if (hash != null) {
+      _result.hash = hash;
+    }
+    if (data != null) {
+      _result.data = data;
+    }
+    if (chainType != null) {
+      _result.chainType = chainType;
     }
     return _result;
   }
-  factory GetBlockHeightResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockHeightResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetGenesisResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetGenesisResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockHeightResponse clone() => GetBlockHeightResponse()..mergeFromMessage(this);
+  GetGenesisResponse clone() => GetGenesisResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockHeightResponse copyWith(void Function(GetBlockHeightResponse) updates) => super.copyWith((message) => updates(message as GetBlockHeightResponse)) as GetBlockHeightResponse; // ignore: deprecated_member_use
+  GetGenesisResponse copyWith(void Function(GetGenesisResponse) updates) => super.copyWith((message) => updates(message as GetGenesisResponse)) as GetGenesisResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockHeightResponse create() => GetBlockHeightResponse._();
-  GetBlockHeightResponse createEmptyInstance() => create();
-  static $pb.PbList<GetBlockHeightResponse> createRepeated() => $pb.PbList<GetBlockHeightResponse>();
+  static GetGenesisResponse create() => GetGenesisResponse._();
+  GetGenesisResponse createEmptyInstance() => create();
+  static $pb.PbList<GetGenesisResponse> createRepeated() => $pb.PbList<GetGenesisResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetBlockHeightResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHeightResponse>(create);
-  static GetBlockHeightResponse? _defaultInstance;
+  static GetGenesisResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetGenesisResponse>(create);
+  static GetGenesisResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.int get height => $_getIZ(0);
+  $core.List<$core.int> get hash => $_getN(0);
   @$pb.TagNumber(1)
-  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  set hash($core.List<$core.int> v) { $_setBytes(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasHeight() => $_has(0);
+  $core.bool hasHash() => $_has(0);
   @$pb.TagNumber(1)
-  void clearHeight() => clearField(1);
+  void clearHash() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get data => $_getN(1);
+  @$pb.TagNumber(2)
+  set data($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasData() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearData() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get chainType => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set chainType($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasChainType() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearChainType() => clearField(3);
 }
 
-class GetBlockchainInfoRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockchainInfoRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+class GetParamsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetParamsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..hasRequiredFields = false
   ;
 
-  GetBlockchainInfoRequest._() : super();
-  factory GetBlockchainInfoRequest() => create();
-  factory GetBlockchainInfoRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockchainInfoRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  GetParamsRequest._() : super();
+  factory GetParamsRequest() => create();
+  factory GetParamsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetParamsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockchainInfoRequest clone() => GetBlockchainInfoRequest()..mergeFromMessage(this);
+  GetParamsRequest clone() => GetParamsRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockchainInfoRequest copyWith(void Function(GetBlockchainInfoRequest) updates) => super.copyWith((message) => updates(message as GetBlockchainInfoRequest)) as GetBlockchainInfoRequest; // ignore: deprecated_member_use
+  GetParamsRequest copyWith(void Function(GetParamsRequest) updates) => super.copyWith((message) => updates(message as GetParamsRequest)) as GetParamsRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockchainInfoRequest create() => GetBlockchainInfoRequest._();
-  GetBlockchainInfoRequest createEmptyInstance() => create();
+  static GetParamsRequest create() => GetParamsRequest._();
+  GetParamsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetParamsRequest> createRepeated() => $pb.PbList<GetParamsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetParamsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetParamsRequest>(create);
+  static GetParamsRequest? _defaultInstance;
+}
+
+class GetParamsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetParamsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockVersion', $pb.PbFieldType.OU3)
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockIntervalInSecond', $pb.PbFieldType.O3)
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'committeeSize', $pb.PbFieldType.O3)
+    ..aInt64(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockReward')
+    ..a<$core.int>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'transactionToLiveInterval', $pb.PbFieldType.OU3)
+    ..a<$core.int>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'bondInterval', $pb.PbFieldType.OU3)
+    ..a<$core.int>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'unbondInterval', $pb.PbFieldType.OU3)
+    ..a<$core.int>(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sortitionInterval', $pb.PbFieldType.OU3)
+    ..a<$core.double>(9, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'feeFraction', $pb.PbFieldType.OD)
+    ..aInt64(10, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'minimumFee')
+    ..aInt64(11, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'maximumFee')
+    ..aInt64(12, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'minimumStake')
+    ..aInt64(13, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'maximumStake')
+    ..hasRequiredFields = false
+  ;
+
+  GetParamsResponse._() : super();
+  factory GetParamsResponse({
+    $core.int? blockVersion,
+    $core.int? blockIntervalInSecond,
+    $core.int? committeeSize,
+    $fixnum.Int64? blockReward,
+    $core.int? transactionToLiveInterval,
+    $core.int? bondInterval,
+    $core.int? unbondInterval,
+    $core.int? sortitionInterval,
+    $core.double? feeFraction,
+    $fixnum.Int64? minimumFee,
+    $fixnum.Int64? maximumFee,
+    $fixnum.Int64? minimumStake,
+    $fixnum.Int64? maximumStake,
+  }) {
+    final _result = create();
+    if (blockVersion != null) {
+      _result.blockVersion = blockVersion;
+    }
+    if (blockIntervalInSecond != null) {
+      _result.blockIntervalInSecond = blockIntervalInSecond;
+    }
+    if (committeeSize != null) {
+      _result.committeeSize = committeeSize;
+    }
+    if (blockReward != null) {
+      _result.blockReward = blockReward;
+    }
+    if (transactionToLiveInterval != null) {
+      _result.transactionToLiveInterval = transactionToLiveInterval;
+    }
+    if (bondInterval != null) {
+      _result.bondInterval = bondInterval;
+    }
+    if (unbondInterval != null) {
+      _result.unbondInterval = unbondInterval;
+    }
+    if (sortitionInterval != null) {
+      _result.sortitionInterval = sortitionInterval;
+    }
+    if (feeFraction != null) {
+      _result.feeFraction = feeFraction;
+    }
+    if (minimumFee != null) {
+      _result.minimumFee = minimumFee;
+    }
+    if (maximumFee != null) {
+      _result.maximumFee = maximumFee;
+    }
+    if (minimumStake != null) {
+      _result.minimumStake = minimumStake;
+    }
+    if (maximumStake != null) {
+      _result.maximumStake = maximumStake;
+    }
+    return _result;
+  }
+  factory GetParamsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetParamsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetParamsResponse clone() => GetParamsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetParamsResponse copyWith(void Function(GetParamsResponse) updates) => super.copyWith((message) => updates(message as GetParamsResponse)) as GetParamsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetParamsResponse create() => GetParamsResponse._();
+  GetParamsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetParamsResponse> createRepeated() => $pb.PbList<GetParamsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetParamsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetParamsResponse>(create);
+  static GetParamsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get blockVersion => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set blockVersion($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasBlockVersion() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearBlockVersion() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get blockIntervalInSecond => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set blockIntervalInSecond($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasBlockIntervalInSecond() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearBlockIntervalInSecond() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get committeeSize => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set committeeSize($core.int v) { $_setSignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasCommitteeSize() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCommitteeSize() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get blockReward => $_getI64(3);
+  @$pb.TagNumber(4)
+  set blockReward($fixnum.Int64 v) { $_setInt64(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasBlockReward() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearBlockReward() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.int get transactionToLiveInterval => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set transactionToLiveInterval($core.int v) { $_setUnsignedInt32(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasTransactionToLiveInterval() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearTransactionToLiveInterval() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.int get bondInterval => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set bondInterval($core.int v) { $_setUnsignedInt32(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasBondInterval() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearBondInterval() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.int get unbondInterval => $_getIZ(6);
+  @$pb.TagNumber(7)
+  set unbondInterval($core.int v) { $_setUnsignedInt32(6, v); }
+  @$pb.TagNumber(7)
+  $core.bool hasUnbondInterval() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearUnbondInterval() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.int get sortitionInterval => $_getIZ(7);
+  @$pb.TagNumber(8)
+  set sortitionInterval($core.int v) { $_setUnsignedInt32(7, v); }
+  @$pb.TagNumber(8)
+  $core.bool hasSortitionInterval() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearSortitionInterval() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.double get feeFraction => $_getN(8);
+  @$pb.TagNumber(9)
+  set feeFraction($core.double v) { $_setDouble(8, v); }
+  @$pb.TagNumber(9)
+  $core.bool hasFeeFraction() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearFeeFraction() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $fixnum.Int64 get minimumFee => $_getI64(9);
+  @$pb.TagNumber(10)
+  set minimumFee($fixnum.Int64 v) { $_setInt64(9, v); }
+  @$pb.TagNumber(10)
+  $core.bool hasMinimumFee() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearMinimumFee() => clearField(10);
+
+  @$pb.TagNumber(11)
+  $fixnum.Int64 get maximumFee => $_getI64(10);
+  @$pb.TagNumber(11)
+  set maximumFee($fixnum.Int64 v) { $_setInt64(10, v); }
+  @$pb.TagNumber(11)
+  $core.bool hasMaximumFee() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearMaximumFee() => clearField(11);
+
+  @$pb.TagNumber(12)
+  $fixnum.Int64 get minimumStake => $_getI64(11);
+  @$pb.TagNumber(12)
+  set minimumStake($fixnum.Int64 v) { $_setInt64(11, v); }
+  @$pb.TagNumber(12)
+  $core.bool hasMinimumStake() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearMinimumStake() => clearField(12);
+
+  @$pb.TagNumber(13)
+  $fixnum.Int64 get maximumStake => $_getI64(12);
+  @$pb.TagNumber(13)
+  set maximumStake($fixnum.Int64 v) { $_setInt64(12, v); }
+  @$pb.TagNumber(13)
+  $core.bool hasMaximumStake() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearMaximumStake() => clearField(13);
+}
+
+class GetBlockRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..e<BlockVerbosity>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'verbosity', $pb.PbFieldType.OE, defaultOrMaker: BlockVerbosity.BLOCK_DATA, valueOf: BlockVerbosity.valueOf, enumValues: BlockVerbosity.values)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockRequest._() : super();
+  factory GetBlockRequest({
+    $core.int? height,
+    BlockVerbosity? verbosity,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (verbosity != null) {
+      _result.verbosity = verbosity;
+    }
+    return _result;
+  }
+  factory GetBlockRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockRequest clone() => GetBlockRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockRequest copyWith(void Function(GetBlockRequest) updates) => super.copyWith((message) => updates(message as GetBlockRequest)) as GetBlockRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockRequest create() => GetBlockRequest._();
+  GetBlockRequest createEmptyInstance() => create();
+  static $pb.PbList<GetBlockRequest> createRepeated() => $pb.PbList<GetBlockRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockRequest>(create);
+  static GetBlockRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  BlockVerbosity get verbosity => $_getN(1);
+  @$pb.TagNumber(2)
+  set verbosity(BlockVerbosity v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasVerbosity() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearVerbosity() => clearField(2);
+}
+
+class GetBlockResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+    ..a<$core.List<$core.int>>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'data', $pb.PbFieldType.OY)
+    ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockTime', $pb.PbFieldType.OU3)
+    ..aOM<BlockHeaderInfo>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'header', subBuilder: BlockHeaderInfo.create)
+    ..aOM<CertificateInfo>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'prevCert', subBuilder: CertificateInfo.create)
+    ..pc<$0.TransactionInfo>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txs', $pb.PbFieldType.PM, subBuilder: $0.TransactionInfo.create)
+    ..a<$core.int>(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'size', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockResponse._() : super();
+  factory GetBlockResponse({
+    $core.int? height,
+    $core.List<$core.int>? hash,
+    $core.List<$core.int>? data,
+    $core.int? blockTime,
+    BlockHeaderInfo? header,
+    CertificateInfo? prevCert,
+    $core.Iterable<$0.TransactionInfo>? txs,
+    $core.int? size,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (hash != null) {
+      _result.hash = hash;
+    }
+    if (data != null) {
+      _result.data = data;
+    }
+    if (blockTime != null) {
+      _result.blockTime = blockTime;
+    }
+    if (header != null) {
+      _result.header = header;
+    }
+    if (prevCert != null) {
+      _result.prevCert = prevCert;
+    }
+    if (txs != null) {
+      _result.txs.addAll(txs);
+    }
+    if (size != null) {
+      _result.size = size;
+    }
+    return _result;
+  }
+  factory GetBlockResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockResponse clone() => GetBlockResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockResponse copyWith(void Function(GetBlockResponse) updates) => super.copyWith((message) => updates(message as GetBlockResponse)) as GetBlockResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockResponse create() => GetBlockResponse._();
+  GetBlockResponse createEmptyInstance() => create();
+  static $pb.PbList<GetBlockResponse> createRepeated() => $pb.PbList<GetBlockResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockResponse>(create);
+  static GetBlockResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get hash => $_getN(1);
+  @$pb.TagNumber(2)
+  set hash($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasHash() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearHash() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.List<$core.int> get data => $_getN(2);
+  @$pb.TagNumber(3)
+  set data($core.List<$core.int> v) { $_setBytes(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasData() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearData() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get blockTime => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set blockTime($core.int v) { $_setUnsignedInt32(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasBlockTime() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearBlockTime() => clearField(4);
+
+  @$pb.TagNumber(5)
+  BlockHeaderInfo get header => $_getN(4);
+  @$pb.TagNumber(5)
+  set header(BlockHeaderInfo v) { setField(5, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasHeader() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearHeader() => clearField(5);
+  @$pb.TagNumber(5)
+  BlockHeaderInfo ensureHeader() => $_ensure(4);
+
+  @$pb.TagNumber(6)
+  CertificateInfo get prevCert => $_getN(5);
+  @$pb.TagNumber(6)
+  set prevCert(CertificateInfo v) { setField(6, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasPrevCert() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearPrevCert() => clearField(6);
+  @$pb.TagNumber(6)
+  CertificateInfo ensurePrevCert() => $_ensure(5);
+
+  @$pb.TagNumber(7)
+  $core.List<$0.TransactionInfo> get txs => $_getList(6);
+
+  @$pb.TagNumber(8)
+  $core.int get size => $_getIZ(7);
+  @$pb.TagNumber(8)
+  set size($core.int v) { $_setUnsignedInt32(7, v); }
+  @$pb.TagNumber(8)
+  $core.bool hasSize() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearSize() => clearField(8);
+}
+
+class GetRawBlockRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRawBlockRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  GetRawBlockRequest._() : super();
+  factory GetRawBlockRequest({
+    $core.int? height,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    return _result;
+  }
+  factory GetRawBlockRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRawBlockRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRawBlockRequest clone() => GetRawBlockRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRawBlockRequest copyWith(void Function(GetRawBlockRequest) updates) => super.copyWith((message) => updates(message as GetRawBlockRequest)) as GetRawBlockRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRawBlockRequest create() => GetRawBlockRequest._();
+  GetRawBlockRequest createEmptyInstance() => create();
+  static $pb.PbList<GetRawBlockRequest> createRepeated() => $pb.PbList<GetRawBlockRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetRawBlockRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRawBlockRequest>(create);
+  static GetRawBlockRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+}
+
+class GetRawBlockResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRawBlockResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockData', $pb.PbFieldType.OY)
+    ..a<$core.List<$core.int>>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'certificateData', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false
+  ;
+
+  GetRawBlockResponse._() : super();
+  factory GetRawBlockResponse({
+    $core.int? height,
+    $core.List<$core.int>? blockData,
+    $core.List<$core.int>? certificateData,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (blockData != null) {
+      _result.blockData = blockData;
+    }
+    if (certificateData != null) {
+      _result.certificateData = certificateData;
+    }
+    return _result;
+  }
+  factory GetRawBlockResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRawBlockResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRawBlockResponse clone() => GetRawBlockResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRawBlockResponse copyWith(void Function(GetRawBlockResponse) updates) => super.copyWith((message) => updates(message as GetRawBlockResponse)) as GetRawBlockResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRawBlockResponse create() => GetRawBlockResponse._();
+  GetRawBlockResponse createEmptyInstance() => create();
+  static $pb.PbList<GetRawBlockResponse> createRepeated() => $pb.PbList<GetRawBlockResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetRawBlockResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRawBlockResponse>(create);
+  static GetRawBlockResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get blockData => $_getN(1);
+  @$pb.TagNumber(2)
+  set blockData($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasBlockData() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearBlockData() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.List<$core.int> get certificateData => $_getN(2);
+  @$pb.TagNumber(3)
+  set certificateData($core.List<$core.int> v) { $_setBytes(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasCertificateData() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCertificateData() => clearField(3);
+}
+
+class GetRecentBlocksRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRecentBlocksRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'count', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  GetRecentBlocksRequest._() : super();
+  factory GetRecentBlocksRequest({
+    $core.int? count,
+  }) {
+    final _result = create();
+    if (count != null) {
+      _result.count = count;
+    }
+    return _result;
+  }
+  factory GetRecentBlocksRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRecentBlocksRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRecentBlocksRequest clone() => GetRecentBlocksRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRecentBlocksRequest copyWith(void Function(GetRecentBlocksRequest) updates) => super.copyWith((message) => updates(message as GetRecentBlocksRequest)) as GetRecentBlocksRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRecentBlocksRequest create() => GetRecentBlocksRequest._();
+  GetRecentBlocksRequest createEmptyInstance() => create();
+  static $pb.PbList<GetRecentBlocksRequest> createRepeated() => $pb.PbList<GetRecentBlocksRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetRecentBlocksRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRecentBlocksRequest>(create);
+  static GetRecentBlocksRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get count => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set count($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCount() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCount() => clearField(1);
+}
+
+class GetRecentBlocksResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRecentBlocksResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..pc<BlockSummary>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blocks', $pb.PbFieldType.PM, subBuilder: BlockSummary.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetRecentBlocksResponse._() : super();
+  factory GetRecentBlocksResponse({
+    $core.Iterable<BlockSummary>? blocks,
+  }) {
+    final _result = create();
+    if (blocks != null) {
+      _result.blocks.addAll(blocks);
+    }
+    return _result;
+  }
+  factory GetRecentBlocksResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRecentBlocksResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRecentBlocksResponse clone() => GetRecentBlocksResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRecentBlocksResponse copyWith(void Function(GetRecentBlocksResponse) updates) => super.copyWith((message) => updates(message as GetRecentBlocksResponse)) as GetRecentBlocksResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRecentBlocksResponse create() => GetRecentBlocksResponse._();
+  GetRecentBlocksResponse createEmptyInstance() => create();
+  static $pb.PbList<GetRecentBlocksResponse> createRepeated() => $pb.PbList<GetRecentBlocksResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetRecentBlocksResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRecentBlocksResponse>(create);
+  static GetRecentBlocksResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<BlockSummary> get blocks => $_getList(0);
+}
+
+class BlockSummary extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'BlockSummary', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockTime', $pb.PbFieldType.OU3)
+    ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txCount', $pb.PbFieldType.O3)
+    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'proposerAddress')
+    ..a<$core.int>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'size', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  BlockSummary._() : super();
+  factory BlockSummary({
+    $core.int? height,
+    $core.List<$core.int>? hash,
+    $core.int? blockTime,
+    $core.int? txCount,
+    $core.String? proposerAddress,
+    $core.int? size,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (hash != null) {
+      _result.hash = hash;
+    }
+    if (blockTime != null) {
+      _result.blockTime = blockTime;
+    }
+    if (txCount != null) {
+      _result.txCount = txCount;
+    }
+    if (proposerAddress != null) {
+      _result.proposerAddress = proposerAddress;
+    }
+    if (size != null) {
+      _result.size = size;
+    }
+    return _result;
+  }
+  factory BlockSummary.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory BlockSummary.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  BlockSummary clone() => BlockSummary()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  BlockSummary copyWith(void Function(BlockSummary) updates) => super.copyWith((message) => updates(message as BlockSummary)) as BlockSummary; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static BlockSummary create() => BlockSummary._();
+  BlockSummary createEmptyInstance() => create();
+  static $pb.PbList<BlockSummary> createRepeated() => $pb.PbList<BlockSummary>();
+  @$core.pragma('dart2js:noInline')
+  static BlockSummary getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<BlockSummary>(create);
+  static BlockSummary? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get hash => $_getN(1);
+  @$pb.TagNumber(2)
+  set hash($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasHash() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearHash() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get blockTime => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set blockTime($core.int v) { $_setUnsignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasBlockTime() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBlockTime() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get txCount => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set txCount($core.int v) { $_setSignedInt32(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasTxCount() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTxCount() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get proposerAddress => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set proposerAddress($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasProposerAddress() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearProposerAddress() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.int get size => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set size($core.int v) { $_setUnsignedInt32(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasSize() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearSize() => clearField(6);
+}
+
+class GetBlockTransactionsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockTransactionsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pageSize', $pb.PbFieldType.OU3)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pageToken')
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockTransactionsRequest._() : super();
+  factory GetBlockTransactionsRequest({
+    $core.int? height,
+    $core.int? pageSize,
+    $core.String? pageToken,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (pageSize != null) {
+      _result.pageSize = pageSize;
+    }
+    if (pageToken != null) {
+      _result.pageToken = pageToken;
+    }
+    return _result;
+  }
+  factory GetBlockTransactionsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockTransactionsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockTransactionsRequest clone() => GetBlockTransactionsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockTransactionsRequest copyWith(void Function(GetBlockTransactionsRequest) updates) => super.copyWith((message) => updates(message as GetBlockTransactionsRequest)) as GetBlockTransactionsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockTransactionsRequest create() => GetBlockTransactionsRequest._();
+  GetBlockTransactionsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetBlockTransactionsRequest> createRepeated() => $pb.PbList<GetBlockTransactionsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockTransactionsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockTransactionsRequest>(create);
+  static GetBlockTransactionsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get pageSize => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set pageSize($core.int v) { $_setUnsignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasPageSize() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearPageSize() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get pageToken => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set pageToken($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasPageToken() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearPageToken() => clearField(3);
+}
+
+class GetBlockTransactionsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockTransactionsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..pc<$0.TransactionInfo>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txs', $pb.PbFieldType.PM, subBuilder: $0.TransactionInfo.create)
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'nextPageToken')
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockTransactionsResponse._() : super();
+  factory GetBlockTransactionsResponse({
+    $core.Iterable<$0.TransactionInfo>? txs,
+    $core.String? nextPageToken,
+  }) {
+    final _result = create();
+    if (txs != null) {
+      _result.txs.addAll(txs);
+    }
+    if (nextPageToken != null) {
+      _result.nextPageToken = nextPageToken;
+    }
+    return _result;
+  }
+  factory GetBlockTransactionsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockTransactionsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockTransactionsResponse clone() => GetBlockTransactionsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockTransactionsResponse copyWith(void Function(GetBlockTransactionsResponse) updates) => super.copyWith((message) => updates(message as GetBlockTransactionsResponse)) as GetBlockTransactionsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockTransactionsResponse create() => GetBlockTransactionsResponse._();
+  GetBlockTransactionsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetBlockTransactionsResponse> createRepeated() => $pb.PbList<GetBlockTransactionsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockTransactionsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockTransactionsResponse>(create);
+  static GetBlockTransactionsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$0.TransactionInfo> get txs => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $core.String get nextPageToken => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set nextPageToken($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasNextPageToken() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNextPageToken() => clearField(2);
+}
+
+class GetBlockHashRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHashRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockHashRequest._() : super();
+  factory GetBlockHashRequest({
+    $core.int? height,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    return _result;
+  }
+  factory GetBlockHashRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockHashRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockHashRequest clone() => GetBlockHashRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockHashRequest copyWith(void Function(GetBlockHashRequest) updates) => super.copyWith((message) => updates(message as GetBlockHashRequest)) as GetBlockHashRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashRequest create() => GetBlockHashRequest._();
+  GetBlockHashRequest createEmptyInstance() => create();
+  static $pb.PbList<GetBlockHashRequest> createRepeated() => $pb.PbList<GetBlockHashRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHashRequest>(create);
+  static GetBlockHashRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+}
+
+class GetBlockHashResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHashResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockHashResponse._() : super();
+  factory GetBlockHashResponse({
+    $core.List<$core.int>? hash,
+  }) {
+    final _result = create();
+    if (hash != null) {
+      _result.hash = hash;
+    }
+    return _result;
+  }
+  factory GetBlockHashResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockHashResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockHashResponse clone() => GetBlockHashResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockHashResponse copyWith(void Function(GetBlockHashResponse) updates) => super.copyWith((message) => updates(message as GetBlockHashResponse)) as GetBlockHashResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashResponse create() => GetBlockHashResponse._();
+  GetBlockHashResponse createEmptyInstance() => create();
+  static $pb.PbList<GetBlockHashResponse> createRepeated() => $pb.PbList<GetBlockHashResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHashResponse>(create);
+  static GetBlockHashResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get hash => $_getN(0);
+  @$pb.TagNumber(1)
+  set hash($core.List<$core.int> v) { $_setBytes(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHash() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHash() => clearField(1);
+}
+
+class GetBlockHashByTimeRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHashByTimeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'time', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockHashByTimeRequest._() : super();
+  factory GetBlockHashByTimeRequest({
+    $core.int? time,
+  }) {
+    final _result = create();
+    if (time != null) {
+      _result.time = time;
+    }
+    return _result;
+  }
+  factory GetBlockHashByTimeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockHashByTimeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockHashByTimeRequest clone() => GetBlockHashByTimeRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockHashByTimeRequest copyWith(void Function(GetBlockHashByTimeRequest) updates) => super.copyWith((message) => updates(message as GetBlockHashByTimeRequest)) as GetBlockHashByTimeRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashByTimeRequest create() => GetBlockHashByTimeRequest._();
+  GetBlockHashByTimeRequest createEmptyInstance() => create();
+  static $pb.PbList<GetBlockHashByTimeRequest> createRepeated() => $pb.PbList<GetBlockHashByTimeRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashByTimeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHashByTimeRequest>(create);
+  static GetBlockHashByTimeRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get time => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set time($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasTime() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTime() => clearField(1);
+}
+
+class GetBlockHashByTimeResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHashByTimeResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockTime', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockHashByTimeResponse._() : super();
+  factory GetBlockHashByTimeResponse({
+    $core.int? height,
+    $core.List<$core.int>? hash,
+    $core.int? blockTime,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (hash != null) {
+      _result.hash = hash;
+    }
+    if (blockTime != null) {
+      _result.blockTime = blockTime;
+    }
+    return _result;
+  }
+  factory GetBlockHashByTimeResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockHashByTimeResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockHashByTimeResponse clone() => GetBlockHashByTimeResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockHashByTimeResponse copyWith(void Function(GetBlockHashByTimeResponse) updates) => super.copyWith((message) => updates(message as GetBlockHashByTimeResponse)) as GetBlockHashByTimeResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashByTimeResponse create() => GetBlockHashByTimeResponse._();
+  GetBlockHashByTimeResponse createEmptyInstance() => create();
+  static $pb.PbList<GetBlockHashByTimeResponse> createRepeated() => $pb.PbList<GetBlockHashByTimeResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHashByTimeResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHashByTimeResponse>(create);
+  static GetBlockHashByTimeResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get hash => $_getN(1);
+  @$pb.TagNumber(2)
+  set hash($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasHash() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearHash() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get blockTime => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set blockTime($core.int v) { $_setUnsignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasBlockTime() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBlockTime() => clearField(3);
+}
+
+class GetBlockHeightRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHeightRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'hash', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockHeightRequest._() : super();
+  factory GetBlockHeightRequest({
+    $core.List<$core.int>? hash,
+  }) {
+    final _result = create();
+    if (hash != null) {
+      _result.hash = hash;
+    }
+    return _result;
+  }
+  factory GetBlockHeightRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockHeightRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockHeightRequest clone() => GetBlockHeightRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockHeightRequest copyWith(void Function(GetBlockHeightRequest) updates) => super.copyWith((message) => updates(message as GetBlockHeightRequest)) as GetBlockHeightRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHeightRequest create() => GetBlockHeightRequest._();
+  GetBlockHeightRequest createEmptyInstance() => create();
+  static $pb.PbList<GetBlockHeightRequest> createRepeated() => $pb.PbList<GetBlockHeightRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHeightRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHeightRequest>(create);
+  static GetBlockHeightRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get hash => $_getN(0);
+  @$pb.TagNumber(1)
+  set hash($core.List<$core.int> v) { $_setBytes(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHash() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHash() => clearField(1);
+}
+
+class GetBlockHeightResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockHeightResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockHeightResponse._() : super();
+  factory GetBlockHeightResponse({
+    $core.int? height,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    return _result;
+  }
+  factory GetBlockHeightResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockHeightResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockHeightResponse clone() => GetBlockHeightResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockHeightResponse copyWith(void Function(GetBlockHeightResponse) updates) => super.copyWith((message) => updates(message as GetBlockHeightResponse)) as GetBlockHeightResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHeightResponse create() => GetBlockHeightResponse._();
+  GetBlockHeightResponse createEmptyInstance() => create();
+  static $pb.PbList<GetBlockHeightResponse> createRepeated() => $pb.PbList<GetBlockHeightResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockHeightResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockHeightResponse>(create);
+  static GetBlockHeightResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+}
+
+class SubscribeBlockHeightRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SubscribeBlockHeightRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  SubscribeBlockHeightRequest._() : super();
+  factory SubscribeBlockHeightRequest() => create();
+  factory SubscribeBlockHeightRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SubscribeBlockHeightRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SubscribeBlockHeightRequest clone() => SubscribeBlockHeightRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SubscribeBlockHeightRequest copyWith(void Function(SubscribeBlockHeightRequest) updates) => super.copyWith((message) => updates(message as SubscribeBlockHeightRequest)) as SubscribeBlockHeightRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SubscribeBlockHeightRequest create() => SubscribeBlockHeightRequest._();
+  SubscribeBlockHeightRequest createEmptyInstance() => create();
+  static $pb.PbList<SubscribeBlockHeightRequest> createRepeated() => $pb.PbList<SubscribeBlockHeightRequest>();
+  @$core.pragma('dart2js:noInline')
+  static SubscribeBlockHeightRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SubscribeBlockHeightRequest>(create);
+  static SubscribeBlockHeightRequest? _defaultInstance;
+}
+
+class SubscribeBlockHeightResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SubscribeBlockHeightResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  SubscribeBlockHeightResponse._() : super();
+  factory SubscribeBlockHeightResponse({
+    $core.int? height,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    return _result;
+  }
+  factory SubscribeBlockHeightResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SubscribeBlockHeightResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SubscribeBlockHeightResponse clone() => SubscribeBlockHeightResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SubscribeBlockHeightResponse copyWith(void Function(SubscribeBlockHeightResponse) updates) => super.copyWith((message) => updates(message as SubscribeBlockHeightResponse)) as SubscribeBlockHeightResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SubscribeBlockHeightResponse create() => SubscribeBlockHeightResponse._();
+  SubscribeBlockHeightResponse createEmptyInstance() => create();
+  static $pb.PbList<SubscribeBlockHeightResponse> createRepeated() => $pb.PbList<SubscribeBlockHeightResponse>();
+  @$core.pragma('dart2js:noInline')
+  static SubscribeBlockHeightResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SubscribeBlockHeightResponse>(create);
+  static SubscribeBlockHeightResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+}
+
+class SubscribeAccountRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SubscribeAccountRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'address')
+    ..hasRequiredFields = false
+  ;
+
+  SubscribeAccountRequest._() : super();
+  factory SubscribeAccountRequest({
+    $core.String? address,
+  }) {
+    final _result = create();
+    if (address != null) {
+      _result.address = address;
+    }
+    return _result;
+  }
+  factory SubscribeAccountRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SubscribeAccountRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SubscribeAccountRequest clone() => SubscribeAccountRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SubscribeAccountRequest copyWith(void Function(SubscribeAccountRequest) updates) => super.copyWith((message) => updates(message as SubscribeAccountRequest)) as SubscribeAccountRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SubscribeAccountRequest create() => SubscribeAccountRequest._();
+  SubscribeAccountRequest createEmptyInstance() => create();
+  static $pb.PbList<SubscribeAccountRequest> createRepeated() => $pb.PbList<SubscribeAccountRequest>();
+  @$core.pragma('dart2js:noInline')
+  static SubscribeAccountRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SubscribeAccountRequest>(create);
+  static SubscribeAccountRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get address => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set address($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasAddress() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearAddress() => clearField(1);
+}
+
+class SubscribeAccountResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SubscribeAccountResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..aOM<$0.TransactionInfo>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'transaction', subBuilder: $0.TransactionInfo.create)
+    ..hasRequiredFields = false
+  ;
+
+  SubscribeAccountResponse._() : super();
+  factory SubscribeAccountResponse({
+    $core.int? height,
+    $0.TransactionInfo? transaction,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (transaction != null) {
+      _result.transaction = transaction;
+    }
+    return _result;
+  }
+  factory SubscribeAccountResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SubscribeAccountResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SubscribeAccountResponse clone() => SubscribeAccountResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SubscribeAccountResponse copyWith(void Function(SubscribeAccountResponse) updates) => super.copyWith((message) => updates(message as SubscribeAccountResponse)) as SubscribeAccountResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SubscribeAccountResponse create() => SubscribeAccountResponse._();
+  SubscribeAccountResponse createEmptyInstance() => create();
+  static $pb.PbList<SubscribeAccountResponse> createRepeated() => $pb.PbList<SubscribeAccountResponse>();
+  @$core.pragma('dart2js:noInline')
+  static SubscribeAccountResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SubscribeAccountResponse>(create);
+  static SubscribeAccountResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $0.TransactionInfo get transaction => $_getN(1);
+  @$pb.TagNumber(2)
+  set transaction($0.TransactionInfo v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasTransaction() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearTransaction() => clearField(2);
+  @$pb.TagNumber(2)
+  $0.TransactionInfo ensureTransaction() => $_ensure(1);
+}
+
+class GetBlockchainInfoRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetBlockchainInfoRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  GetBlockchainInfoRequest._() : super();
+  factory GetBlockchainInfoRequest() => create();
+  factory GetBlockchainInfoRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockchainInfoRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockchainInfoRequest clone() => GetBlockchainInfoRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockchainInfoRequest copyWith(void Function(GetBlockchainInfoRequest) updates) => super.copyWith((message) => updates(message as GetBlockchainInfoRequest)) as GetBlockchainInfoRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockchainInfoRequest create() => GetBlockchainInfoRequest._();
+  GetBlockchainInfoRequest createEmptyInstance() => create();
   static $pb.PbList<GetBlockchainInfoRequest> createRepeated() => $pb.PbList<GetBlockchainInfoRequest>();
   @$core.pragma('dart2js:noInline')
   static GetBlockchainInfoRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockchainInfoRequest>(create);
@@ -836,6 +2479,12 @@ class GetBlockchainInfoResponse extends $pb.GeneratedMessage {
     ..aInt64(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'totalPower')
     ..aInt64(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'committeePower')
     ..pc<ValidatorInfo>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'committeeValidators', $pb.PbFieldType.PM, subBuilder: ValidatorInfo.create)
+    ..aInt64(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'estimatedSyncTime')
+    ..aInt64(9, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'totalSupply')
+    ..aInt64(10, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'treasuryBalance')
+    ..aInt64(11, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'circulatingSupply')
+    ..m<$core.int, $core.int>(12, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txCountPerType', entryClassName: 'GetBlockchainInfoResponse.TxCountPerTypeEntry', keyFieldType: $pb.PbFieldType.O3, valueFieldType: $pb.PbFieldType.O3, packageName: const $pb.PackageName('pactus'))
+    ..a<$core.int>(13, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txStatsBlocks', $pb.PbFieldType.OU3)
     ..hasRequiredFields = false
   ;
 
@@ -848,117 +2497,385 @@ class GetBlockchainInfoResponse extends $pb.GeneratedMessage {
     $fixnum.Int64? totalPower,
     $fixnum.Int64? committeePower,
     $core.Iterable<ValidatorInfo>? committeeValidators,
+    $fixnum.Int64? estimatedSyncTime,
+    $fixnum.Int64? totalSupply,
+    $fixnum.Int64? treasuryBalance,
+    $fixnum.Int64? circulatingSupply,
+    $core.Map<$core.int, $core.int>? txCountPerType,
+    $core.int? txStatsBlocks,
+  }) {
+    final _result = create();
+    if (lastBlockHeight != null) {
+      _result.lastBlockHeight = lastBlockHeight;
+    }
+    if (lastBlockHash != null) {
+      _result.lastBlockHash = lastBlockHash;
+    }
+    if (totalAccounts != null) {
+      _result.totalAccounts = totalAccounts;
+    }
+    if (totalValidators != null) {
+      _result.totalValidators = totalValidators;
+    }
+    if (totalPower != null) {
+      _result.totalPower = totalPower;
+    }
+    if (committeePower != null) {
+      _result.committeePower = committeePower;
+    }
+    if (committeeValidators != null) {
+      _result.committeeValidators.addAll(committeeValidators);
+    }
+    if (estimatedSyncTime != null) {
+      _result.estimatedSyncTime = estimatedSyncTime;
+    }
+    if (totalSupply != null) {
+      _result.totalSupply = totalSupply;
+    }
+    if (treasuryBalance != null) {
+      _result.treasuryBalance = treasuryBalance;
+    }
+    if (circulatingSupply != null) {
+      _result.circulatingSupply = circulatingSupply;
+    }
+    if (txCountPerType != null) {
+      _result.txCountPerType.addAll(txCountPerType);
+    }
+    if (txStatsBlocks != null) {
+      _result.txStatsBlocks = txStatsBlocks;
+    }
+    return _result;
+  }
+  factory GetBlockchainInfoResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetBlockchainInfoResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetBlockchainInfoResponse clone() => GetBlockchainInfoResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetBlockchainInfoResponse copyWith(void Function(GetBlockchainInfoResponse) updates) => super.copyWith((message) => updates(message as GetBlockchainInfoResponse)) as GetBlockchainInfoResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetBlockchainInfoResponse create() => GetBlockchainInfoResponse._();
+  GetBlockchainInfoResponse createEmptyInstance() => create();
+  static $pb.PbList<GetBlockchainInfoResponse> createRepeated() => $pb.PbList<GetBlockchainInfoResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetBlockchainInfoResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockchainInfoResponse>(create);
+  static GetBlockchainInfoResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get lastBlockHeight => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set lastBlockHeight($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasLastBlockHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearLastBlockHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get lastBlockHash => $_getN(1);
+  @$pb.TagNumber(2)
+  set lastBlockHash($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasLastBlockHash() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearLastBlockHash() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get totalAccounts => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set totalAccounts($core.int v) { $_setSignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasTotalAccounts() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTotalAccounts() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get totalValidators => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set totalValidators($core.int v) { $_setSignedInt32(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasTotalValidators() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTotalValidators() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get totalPower => $_getI64(4);
+  @$pb.TagNumber(5)
+  set totalPower($fixnum.Int64 v) { $_setInt64(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasTotalPower() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearTotalPower() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get committeePower => $_getI64(5);
+  @$pb.TagNumber(6)
+  set committeePower($fixnum.Int64 v) { $_setInt64(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasCommitteePower() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearCommitteePower() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.List<ValidatorInfo> get committeeValidators => $_getList(6);
+
+  @$pb.TagNumber(8)
+  $fixnum.Int64 get estimatedSyncTime => $_getI64(7);
+  @$pb.TagNumber(8)
+  set estimatedSyncTime($fixnum.Int64 v) { $_setInt64(7, v); }
+  @$pb.TagNumber(8)
+  $core.bool hasEstimatedSyncTime() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearEstimatedSyncTime() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $fixnum.Int64 get totalSupply => $_getI64(8);
+  @$pb.TagNumber(9)
+  set totalSupply($fixnum.Int64 v) { $_setInt64(8, v); }
+  @$pb.TagNumber(9)
+  $core.bool hasTotalSupply() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearTotalSupply() => clearField(9);
+
+  @$pb.TagNumber(10)
+  $fixnum.Int64 get treasuryBalance => $_getI64(9);
+  @$pb.TagNumber(10)
+  set treasuryBalance($fixnum.Int64 v) { $_setInt64(9, v); }
+  @$pb.TagNumber(10)
+  $core.bool hasTreasuryBalance() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearTreasuryBalance() => clearField(10);
+
+  @$pb.TagNumber(11)
+  $fixnum.Int64 get circulatingSupply => $_getI64(10);
+  @$pb.TagNumber(11)
+  set circulatingSupply($fixnum.Int64 v) { $_setInt64(10, v); }
+  @$pb.TagNumber(11)
+  $core.bool hasCirculatingSupply() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearCirculatingSupply() => clearField(11);
+
+  @$pb.TagNumber(12)
+  $core.Map<$core.int, $core.int> get txCountPerType => $_getMap(11);
+
+  @$pb.TagNumber(13)
+  $core.int get txStatsBlocks => $_getIZ(12);
+  @$pb.TagNumber(13)
+  set txStatsBlocks($core.int v) { $_setUnsignedInt32(12, v); }
+  @$pb.TagNumber(13)
+  $core.bool hasTxStatsBlocks() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearTxStatsBlocks() => clearField(13);
+}
+
+class GetCommitteeRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetCommitteeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  GetCommitteeRequest._() : super();
+  factory GetCommitteeRequest() => create();
+  factory GetCommitteeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetCommitteeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetCommitteeRequest clone() => GetCommitteeRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetCommitteeRequest copyWith(void Function(GetCommitteeRequest) updates) => super.copyWith((message) => updates(message as GetCommitteeRequest)) as GetCommitteeRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetCommitteeRequest create() => GetCommitteeRequest._();
+  GetCommitteeRequest createEmptyInstance() => create();
+  static $pb.PbList<GetCommitteeRequest> createRepeated() => $pb.PbList<GetCommitteeRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetCommitteeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetCommitteeRequest>(create);
+  static GetCommitteeRequest? _defaultInstance;
+}
+
+class GetCommitteeResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetCommitteeResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'round', $pb.PbFieldType.O3)
+    ..pc<CommitteeMember>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'members', $pb.PbFieldType.PM, subBuilder: CommitteeMember.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetCommitteeResponse._() : super();
+  factory GetCommitteeResponse({
+    $core.int? height,
+    $core.int? round,
+    $core.Iterable<CommitteeMember>? members,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (round != null) {
+      _result.round = round;
+    }
+    if (members != null) {
+      _result.members.addAll(members);
+    }
+    return _result;
+  }
+  factory GetCommitteeResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetCommitteeResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetCommitteeResponse clone() => GetCommitteeResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetCommitteeResponse copyWith(void Function(GetCommitteeResponse) updates) => super.copyWith((message) => updates(message as GetCommitteeResponse)) as GetCommitteeResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetCommitteeResponse create() => GetCommitteeResponse._();
+  GetCommitteeResponse createEmptyInstance() => create();
+  static $pb.PbList<GetCommitteeResponse> createRepeated() => $pb.PbList<GetCommitteeResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetCommitteeResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetCommitteeResponse>(create);
+  static GetCommitteeResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get round => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set round($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasRound() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRound() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.List<CommitteeMember> get members => $_getList(2);
+}
+
+class CommitteeMember extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CommitteeMember', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'address')
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'number', $pb.PbFieldType.O3)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'publicKey')
+    ..aOB(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'isProposer')
+    ..hasRequiredFields = false
+  ;
+
+  CommitteeMember._() : super();
+  factory CommitteeMember({
+    $core.String? address,
+    $core.int? number,
+    $core.String? publicKey,
+    $core.bool? isProposer,
   }) {
     final _result = create();
-    if (lastBlockHeight != null) {
-      _result.lastBlockHeight = lastBlockHeight;
-    }
-    if (lastBlockHash != null) {
-      _result.lastBlockHash = lastBlockHash;
-    }
-    if (totalAccounts != null) {
-      _result.totalAccounts = totalAccounts;
-    }
-    if (totalValidators != null) {
-      _result.totalValidators = totalValidators;
+    if (address != null) {
+      _result.address = address;
     }
-    if (totalPower != null) {
-      _result.totalPower = totalPower;
+    if (number != null) {
+      _result.number = number;
     }
-    if (committeePower != null) {
-      _result.committeePower = committeePower;
+    if (publicKey != null) {
+      _result.publicKey = publicKey;
     }
-    if (committeeValidators != null) {
-      _result.committeeValidators.addAll(committeeValidators);
+    if (isProposer != null) {
+      _result.isProposer = isProposer;
     }
     return _result;
   }
-  factory GetBlockchainInfoResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetBlockchainInfoResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory CommitteeMember.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CommitteeMember.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetBlockchainInfoResponse clone() => GetBlockchainInfoResponse()..mergeFromMessage(this);
+  CommitteeMember clone() => CommitteeMember()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetBlockchainInfoResponse copyWith(void Function(GetBlockchainInfoResponse) updates) => super.copyWith((message) => updates(message as GetBlockchainInfoResponse)) as GetBlockchainInfoResponse; // ignore: deprecated_member_use
+  CommitteeMember copyWith(void Function(CommitteeMember) updates) => super.copyWith((message) => updates(message as CommitteeMember)) as CommitteeMember; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetBlockchainInfoResponse create() => GetBlockchainInfoResponse._();
-  GetBlockchainInfoResponse createEmptyInstance() => create();
-  static $pb.PbList<GetBlockchainInfoResponse> createRepeated() => $pb.PbList<GetBlockchainInfoResponse>();
+  static CommitteeMember create() => CommitteeMember._();
+  CommitteeMember createEmptyInstance() => create();
+  static $pb.PbList<CommitteeMember> createRepeated() => $pb.PbList<CommitteeMember>();
   @$core.pragma('dart2js:noInline')
-  static GetBlockchainInfoResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetBlockchainInfoResponse>(create);
-  static GetBlockchainInfoResponse? _defaultInstance;
+  static CommitteeMember getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CommitteeMember>(create);
+  static CommitteeMember? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.int get lastBlockHeight => $_getIZ(0);
+  $core.String get address => $_getSZ(0);
   @$pb.TagNumber(1)
-  set lastBlockHeight($core.int v) { $_setUnsignedInt32(0, v); }
+  set address($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasLastBlockHeight() => $_has(0);
+  $core.bool hasAddress() => $_has(0);
   @$pb.TagNumber(1)
-  void clearLastBlockHeight() => clearField(1);
+  void clearAddress() => clearField(1);
 
   @$pb.TagNumber(2)
-  $core.List<$core.int> get lastBlockHash => $_getN(1);
+  $core.int get number => $_getIZ(1);
   @$pb.TagNumber(2)
-  set lastBlockHash($core.List<$core.int> v) { $_setBytes(1, v); }
+  set number($core.int v) { $_setSignedInt32(1, v); }
   @$pb.TagNumber(2)
-  $core.bool hasLastBlockHash() => $_has(1);
+  $core.bool hasNumber() => $_has(1);
   @$pb.TagNumber(2)
-  void clearLastBlockHash() => clearField(2);
+  void clearNumber() => clearField(2);
 
   @$pb.TagNumber(3)
-  $core.int get totalAccounts => $_getIZ(2);
+  $core.String get publicKey => $_getSZ(2);
   @$pb.TagNumber(3)
-  set totalAccounts($core.int v) { $_setSignedInt32(2, v); }
+  set publicKey($core.String v) { $_setString(2, v); }
   @$pb.TagNumber(3)
-  $core.bool hasTotalAccounts() => $_has(2);
+  $core.bool hasPublicKey() => $_has(2);
   @$pb.TagNumber(3)
-  void clearTotalAccounts() => clearField(3);
+  void clearPublicKey() => clearField(3);
 
   @$pb.TagNumber(4)
-  $core.int get totalValidators => $_getIZ(3);
+  $core.bool get isProposer => $_getBF(3);
   @$pb.TagNumber(4)
-  set totalValidators($core.int v) { $_setSignedInt32(3, v); }
+  set isProposer($core.bool v) { $_setBool(3, v); }
   @$pb.TagNumber(4)
-  $core.bool hasTotalValidators() => $_has(3);
+  $core.bool hasIsProposer() => $_has(3);
   @$pb.TagNumber(4)
-  void clearTotalValidators() => clearField(4);
-
-  @$pb.TagNumber(5)
-  $fixnum.Int64 get totalPower => $_getI64(4);
-  @$pb.TagNumber(5)
-  set totalPower($fixnum.Int64 v) { $_setInt64(4, v); }
-  @$pb.TagNumber(5)
-  $core.bool hasTotalPower() => $_has(4);
-  @$pb.TagNumber(5)
-  void clearTotalPower() => clearField(5);
-
-  @$pb.TagNumber(6)
-  $fixnum.Int64 get committeePower => $_getI64(5);
-  @$pb.TagNumber(6)
-  set committeePower($fixnum.Int64 v) { $_setInt64(5, v); }
-  @$pb.TagNumber(6)
-  $core.bool hasCommitteePower() => $_has(5);
-  @$pb.TagNumber(6)
-  void clearCommitteePower() => clearField(6);
-
-  @$pb.TagNumber(7)
-  $core.List<ValidatorInfo> get committeeValidators => $_getList(6);
+  void clearIsProposer() => clearField(4);
 }
 
 class GetConsensusInfoRequest extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetConsensusInfoRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
     ..hasRequiredFields = false
   ;
 
   GetConsensusInfoRequest._() : super();
-  factory GetConsensusInfoRequest() => create();
+  factory GetConsensusInfoRequest({
+    $core.int? height,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    return _result;
+  }
   factory GetConsensusInfoRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
   factory GetConsensusInfoRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
@@ -979,6 +2896,15 @@ class GetConsensusInfoRequest extends $pb.GeneratedMessage {
   @$core.pragma('dart2js:noInline')
   static GetConsensusInfoRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetConsensusInfoRequest>(create);
   static GetConsensusInfoRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
 }
 
 class GetConsensusInfoResponse extends $pb.GeneratedMessage {
@@ -1574,6 +3500,10 @@ class ConsensusInfo extends $pb.GeneratedMessage {
     ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
     ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'round', $pb.PbFieldType.O3)
     ..pc<VoteInfo>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'votes', $pb.PbFieldType.PM, subBuilder: VoteInfo.create)
+    ..pc<RoundTiming>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'roundTimings', $pb.PbFieldType.PM, subBuilder: RoundTiming.create)
+    ..aOM<ProposalInfo>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'proposal', subBuilder: ProposalInfo.create)
+    ..aInt64(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'missedProposals')
+    ..aInt64(9, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'missedVotes')
     ..hasRequiredFields = false
   ;
 
@@ -1584,6 +3514,10 @@ class ConsensusInfo extends $pb.GeneratedMessage {
     $core.int? height,
     $core.int? round,
     $core.Iterable<VoteInfo>? votes,
+    $core.Iterable<RoundTiming>? roundTimings,
+    ProposalInfo? proposal,
+    $fixnum.Int64? missedProposals,
+    $fixnum.Int64? missedVotes,
   }) {
     final _result = create();
     if (address != null) {
@@ -1601,6 +3535,18 @@ class ConsensusInfo extends $pb.GeneratedMessage {
     if (votes != null) {
       _result.votes.addAll(votes);
     }
+    if (roundTimings != null) {
+      _result.roundTimings.addAll(roundTimings);
+    }
+    if (proposal != null) {
+      _result.proposal = proposal;
+    }
+    if (missedProposals != null) {
+      _result.missedProposals = missedProposals;
+    }
+    if (missedVotes != null) {
+      _result.missedVotes = missedVotes;
+    }
     return _result;
   }
   factory ConsensusInfo.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -1662,6 +3608,216 @@ class ConsensusInfo extends $pb.GeneratedMessage {
 
   @$pb.TagNumber(5)
   $core.List<VoteInfo> get votes => $_getList(4);
+
+  @$pb.TagNumber(6)
+  $core.List<RoundTiming> get roundTimings => $_getList(5);
+
+  @$pb.TagNumber(7)
+  ProposalInfo get proposal => $_getN(6);
+  @$pb.TagNumber(7)
+  set proposal(ProposalInfo v) { setField(7, v); }
+  @$pb.TagNumber(7)
+  $core.bool hasProposal() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearProposal() => clearField(7);
+  @$pb.TagNumber(7)
+  ProposalInfo ensureProposal() => $_ensure(6);
+
+  @$pb.TagNumber(8)
+  $fixnum.Int64 get missedProposals => $_getI64(7);
+  @$pb.TagNumber(8)
+  set missedProposals($fixnum.Int64 v) { $_setInt64(7, v); }
+  @$pb.TagNumber(8)
+  $core.bool hasMissedProposals() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearMissedProposals() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $fixnum.Int64 get missedVotes => $_getI64(8);
+  @$pb.TagNumber(9)
+  set missedVotes($fixnum.Int64 v) { $_setInt64(8, v); }
+  @$pb.TagNumber(9)
+  $core.bool hasMissedVotes() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearMissedVotes() => clearField(9);
+}
+
+class ProposalInfo extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ProposalInfo', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'round', $pb.PbFieldType.O3)
+    ..a<$core.List<$core.int>>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockData', $pb.PbFieldType.OY)
+    ..aOS(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'signature')
+    ..hasRequiredFields = false
+  ;
+
+  ProposalInfo._() : super();
+  factory ProposalInfo({
+    $core.int? height,
+    $core.int? round,
+    $core.List<$core.int>? blockData,
+    $core.String? signature,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (round != null) {
+      _result.round = round;
+    }
+    if (blockData != null) {
+      _result.blockData = blockData;
+    }
+    if (signature != null) {
+      _result.signature = signature;
+    }
+    return _result;
+  }
+  factory ProposalInfo.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ProposalInfo.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ProposalInfo clone() => ProposalInfo()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ProposalInfo copyWith(void Function(ProposalInfo) updates) => super.copyWith((message) => updates(message as ProposalInfo)) as ProposalInfo; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ProposalInfo create() => ProposalInfo._();
+  ProposalInfo createEmptyInstance() => create();
+  static $pb.PbList<ProposalInfo> createRepeated() => $pb.PbList<ProposalInfo>();
+  @$core.pragma('dart2js:noInline')
+  static ProposalInfo getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ProposalInfo>(create);
+  static ProposalInfo? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get round => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set round($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasRound() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRound() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.List<$core.int> get blockData => $_getN(2);
+  @$pb.TagNumber(3)
+  set blockData($core.List<$core.int> v) { $_setBytes(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasBlockData() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBlockData() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get signature => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set signature($core.String v) { $_setString(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasSignature() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSignature() => clearField(4);
+}
+
+class RoundTiming extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'RoundTiming', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'height', $pb.PbFieldType.OU3)
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'round', $pb.PbFieldType.O3)
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'durationMs')
+    ..aOB(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'committed')
+    ..hasRequiredFields = false
+  ;
+
+  RoundTiming._() : super();
+  factory RoundTiming({
+    $core.int? height,
+    $core.int? round,
+    $fixnum.Int64? durationMs,
+    $core.bool? committed,
+  }) {
+    final _result = create();
+    if (height != null) {
+      _result.height = height;
+    }
+    if (round != null) {
+      _result.round = round;
+    }
+    if (durationMs != null) {
+      _result.durationMs = durationMs;
+    }
+    if (committed != null) {
+      _result.committed = committed;
+    }
+    return _result;
+  }
+  factory RoundTiming.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory RoundTiming.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  RoundTiming clone() => RoundTiming()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  RoundTiming copyWith(void Function(RoundTiming) updates) => super.copyWith((message) => updates(message as RoundTiming)) as RoundTiming; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static RoundTiming create() => RoundTiming._();
+  RoundTiming createEmptyInstance() => create();
+  static $pb.PbList<RoundTiming> createRepeated() => $pb.PbList<RoundTiming>();
+  @$core.pragma('dart2js:noInline')
+  static RoundTiming getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<RoundTiming>(create);
+  static RoundTiming? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get height => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set height($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get round => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set round($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasRound() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearRound() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get durationMs => $_getI64(2);
+  @$pb.TagNumber(3)
+  set durationMs($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasDurationMs() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearDurationMs() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.bool get committed => $_getBF(3);
+  @$pb.TagNumber(4)
+  set committed($core.bool v) { $_setBool(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasCommitted() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearCommitted() => clearField(4);
 }
 
 class BlockchainApi {
@@ -1672,10 +3828,26 @@ class BlockchainApi {
     var emptyResponse = GetBlockResponse();
     return _client.invoke<GetBlockResponse>(ctx, 'Blockchain', 'GetBlock', request, emptyResponse);
   }
+  $async.Future<GetRawBlockResponse> getRawBlock($pb.ClientContext? ctx, GetRawBlockRequest request) {
+    var emptyResponse = GetRawBlockResponse();
+    return _client.invoke<GetRawBlockResponse>(ctx, 'Blockchain', 'GetRawBlock', request, emptyResponse);
+  }
+  $async.Future<GetRecentBlocksResponse> getRecentBlocks($pb.ClientContext? ctx, GetRecentBlocksRequest request) {
+    var emptyResponse = GetRecentBlocksResponse();
+    return _client.invoke<GetRecentBlocksResponse>(ctx, 'Blockchain', 'GetRecentBlocks', request, emptyResponse);
+  }
+  $async.Future<GetBlockTransactionsResponse> getBlockTransactions($pb.ClientContext? ctx, GetBlockTransactionsRequest request) {
+    var emptyResponse = GetBlockTransactionsResponse();
+    return _client.invoke<GetBlockTransactionsResponse>(ctx, 'Blockchain', 'GetBlockTransactions', request, emptyResponse);
+  }
   $async.Future<GetBlockHashResponse> getBlockHash($pb.ClientContext? ctx, GetBlockHashRequest request) {
     var emptyResponse = GetBlockHashResponse();
     return _client.invoke<GetBlockHashResponse>(ctx, 'Blockchain', 'GetBlockHash', request, emptyResponse);
   }
+  $async.Future<GetBlockHashByTimeResponse> getBlockHashByTime($pb.ClientContext? ctx, GetBlockHashByTimeRequest request) {
+    var emptyResponse = GetBlockHashByTimeResponse();
+    return _client.invoke<GetBlockHashByTimeResponse>(ctx, 'Blockchain', 'GetBlockHashByTime', request, emptyResponse);
+  }
   $async.Future<GetBlockHeightResponse> getBlockHeight($pb.ClientContext? ctx, GetBlockHeightRequest request) {
     var emptyResponse = GetBlockHeightResponse();
     return _client.invoke<GetBlockHeightResponse>(ctx, 'Blockchain', 'GetBlockHeight', request, emptyResponse);
@@ -1692,6 +3864,10 @@ class BlockchainApi {
     var emptyResponse = GetAccountResponse();
     return _client.invoke<GetAccountResponse>(ctx, 'Blockchain', 'GetAccount', request, emptyResponse);
   }
+  $async.Future<GetAccountsResponse> getAccounts($pb.ClientContext? ctx, GetAccountsRequest request) {
+    var emptyResponse = GetAccountsResponse();
+    return _client.invoke<GetAccountsResponse>(ctx, 'Blockchain', 'GetAccounts', request, emptyResponse);
+  }
   $async.Future<GetValidatorResponse> getValidator($pb.ClientContext? ctx, GetValidatorRequest request) {
     var emptyResponse = GetValidatorResponse();
     return _client.invoke<GetValidatorResponse>(ctx, 'Blockchain', 'GetValidator', request, emptyResponse);
@@ -1700,6 +3876,10 @@ class BlockchainApi {
     var emptyResponse = GetValidatorResponse();
     return _client.invoke<GetValidatorResponse>(ctx, 'Blockchain', 'GetValidatorByNumber', request, emptyResponse);
   }
+  $async.Future<GetValidatorHistoryResponse> getValidatorHistory($pb.ClientContext? ctx, GetValidatorHistoryRequest request) {
+    var emptyResponse = GetValidatorHistoryResponse();
+    return _client.invoke<GetValidatorHistoryResponse>(ctx, 'Blockchain', 'GetValidatorHistory', request, emptyResponse);
+  }
   $async.Future<GetValidatorAddressesResponse> getValidatorAddresses($pb.ClientContext? ctx, GetValidatorAddressesRequest request) {
     var emptyResponse = GetValidatorAddressesResponse();
     return _client.invoke<GetValidatorAddressesResponse>(ctx, 'Blockchain', 'GetValidatorAddresses', request, emptyResponse);
@@ -1708,5 +3888,29 @@ class BlockchainApi {
     var emptyResponse = GetPublicKeyResponse();
     return _client.invoke<GetPublicKeyResponse>(ctx, 'Blockchain', 'GetPublicKey', request, emptyResponse);
   }
+  $async.Future<GetAddressByPublicKeyResponse> getAddressByPublicKey($pb.ClientContext? ctx, GetAddressByPublicKeyRequest request) {
+    var emptyResponse = GetAddressByPublicKeyResponse();
+    return _client.invoke<GetAddressByPublicKeyResponse>(ctx, 'Blockchain', 'GetAddressByPublicKey', request, emptyResponse);
+  }
+  $async.Future<GetGenesisResponse> getGenesis($pb.ClientContext? ctx, GetGenesisRequest request) {
+    var emptyResponse = GetGenesisResponse();
+    return _client.invoke<GetGenesisResponse>(ctx, 'Blockchain', 'GetGenesis', request, emptyResponse);
+  }
+  $async.Future<GetCommitteeResponse> getCommittee($pb.ClientContext? ctx, GetCommitteeRequest request) {
+    var emptyResponse = GetCommitteeResponse();
+    return _client.invoke<GetCommitteeResponse>(ctx, 'Blockchain', 'GetCommittee', request, emptyResponse);
+  }
+  $async.Future<SubscribeBlockHeightResponse> subscribeBlockHeight($pb.ClientContext? ctx, SubscribeBlockHeightRequest request) {
+    var emptyResponse = SubscribeBlockHeightResponse();
+    return _client.invoke<SubscribeBlockHeightResponse>(ctx, 'Blockchain', 'SubscribeBlockHeight', request, emptyResponse);
+  }
+  $async.Future<SubscribeAccountResponse> subscribeAccount($pb.ClientContext? ctx, SubscribeAccountRequest request) {
+    var emptyResponse = SubscribeAccountResponse();
+    return _client.invoke<SubscribeAccountResponse>(ctx, 'Blockchain', 'SubscribeAccount', request, emptyResponse);
+  }
+  $async.Future<GetParamsResponse> getParams($pb.ClientContext? ctx, GetParamsRequest request) {
+    var emptyResponse = GetParamsResponse();
+    return _client.invoke<GetParamsResponse>(ctx, 'Blockchain', 'GetParams', request, emptyResponse);
+  }
 }
 
@@ -13,11 +13,13 @@ class BlockVerbosity extends $pb.ProtobufEnum {
   static const BlockVerbosity BLOCK_DATA = BlockVerbosity._(0, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'BLOCK_DATA');
   static const BlockVerbosity BLOCK_INFO = BlockVerbosity._(1, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'BLOCK_INFO');
   static const BlockVerbosity BLOCK_TRANSACTIONS = BlockVerbosity._(2, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'BLOCK_TRANSACTIONS');
+  static const BlockVerbosity BLOCK_HEADER = BlockVerbosity._(3, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'BLOCK_HEADER');
 
   static const $core.List<BlockVerbosity> values = <BlockVerbosity> [
     BLOCK_DATA,
     BLOCK_INFO,
     BLOCK_TRANSACTIONS,
+    BLOCK_HEADER,
   ];
 
   static final $core.Map<$core.int, BlockVerbosity> _byValue = $pb.ProtobufEnum.initByValue(values);
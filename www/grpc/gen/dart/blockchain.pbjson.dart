@@ -17,11 +17,12 @@ const BlockVerbosity$json = const {
     const {'1': 'BLOCK_DATA', '2': 0},
     const {'1': 'BLOCK_INFO', '2': 1},
     const {'1': 'BLOCK_TRANSACTIONS', '2': 2},
+    const {'1': 'BLOCK_HEADER', '2': 3},
   ],
 };
 
 /// Descriptor for `BlockVerbosity`. Decode as a `google.protobuf.EnumDescriptorProto`.
-final $typed_data.Uint8List blockVerbosityDescriptor = $convert.base64Decode('Cg5CbG9ja1ZlcmJvc2l0eRIOCgpCTE9DS19EQVRBEAASDgoKQkxPQ0tfSU5GTxABEhYKEkJMT0NLX1RSQU5TQUNUSU9OUxAC');
+final $typed_data.Uint8List blockVerbosityDescriptor = $convert.base64Decode('Cg5CbG9ja1ZlcmJvc2l0eRIOCgpCTE9DS19EQVRBEAASDgoKQkxPQ0tfSU5GTxABEhYKEkJMT0NLX1RSQU5TQUNUSU9OUxACEhAKDEJMT0NLX0hFQURFUhAD');
 @$core.Deprecated('Use voteTypeDescriptor instead')
 const VoteType$json = const {
   '1': 'VoteType',
@@ -40,11 +41,12 @@ const GetAccountRequest$json = const {
   '1': 'GetAccountRequest',
   '2': const [
     const {'1': 'address', '3': 1, '4': 1, '5': 9, '10': 'address'},
+    const {'1': 'height', '3': 2, '4': 1, '5': 13, '10': 'height'},
   ],
 };
 
 /// Descriptor for `GetAccountRequest`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getAccountRequestDescriptor = $convert.base64Decode('ChFHZXRBY2NvdW50UmVxdWVzdBIYCgdhZGRyZXNzGAEgASgJUgdhZGRyZXNz');
+final $typed_data.Uint8List getAccountRequestDescriptor = $convert.base64Decode('ChFHZXRBY2NvdW50UmVxdWVzdBIYCgdhZGRyZXNzGAEgASgJUgdhZGRyZXNzEhYKBmhlaWdodBgCIAEoDVIGaGVpZ2h0');
 @$core.Deprecated('Use getAccountResponseDescriptor instead')
 const GetAccountResponse$json = const {
   '1': 'GetAccountResponse',
@@ -55,6 +57,27 @@ const GetAccountResponse$json = const {
 
 /// Descriptor for `GetAccountResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getAccountResponseDescriptor = $convert.base64Decode('ChJHZXRBY2NvdW50UmVzcG9uc2USLQoHYWNjb3VudBgBIAEoCzITLnBhY3R1cy5BY2NvdW50SW5mb1IHYWNjb3VudA==');
+@$core.Deprecated('Use getAccountsRequestDescriptor instead')
+const GetAccountsRequest$json = const {
+  '1': 'GetAccountsRequest',
+  '2': const [
+    const {'1': 'addresses', '3': 1, '4': 3, '5': 9, '10': 'addresses'},
+  ],
+};
+
+/// Descriptor for `GetAccountsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getAccountsRequestDescriptor = $convert.base64Decode('ChJHZXRBY2NvdW50c1JlcXVlc3QSHAoJYWRkcmVzc2VzGAEgAygJUglhZGRyZXNzZXM=');
+@$core.Deprecated('Use getAccountsResponseDescriptor instead')
+const GetAccountsResponse$json = const {
+  '1': 'GetAccountsResponse',
+  '2': const [
+    const {'1': 'accounts', '3': 1, '4': 3, '5': 11, '6': '.pactus.AccountInfo', '10': 'accounts'},
+    const {'1': 'missing_addresses', '3': 2, '4': 3, '5': 9, '10': 'missingAddresses'},
+  ],
+};
+
+/// Descriptor for `GetAccountsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getAccountsResponseDescriptor = $convert.base64Decode('ChNHZXRBY2NvdW50c1Jlc3BvbnNlEi8KCGFjY291bnRzGAEgAygLMhMucGFjdHVzLkFjY291bnRJbmZvUghhY2NvdW50cxIrChFtaXNzaW5nX2FkZHJlc3NlcxgCIAMoCVIQbWlzc2luZ0FkZHJlc3Nlcw==');
 @$core.Deprecated('Use getValidatorAddressesRequestDescriptor instead')
 const GetValidatorAddressesRequest$json = const {
   '1': 'GetValidatorAddressesRequest',
@@ -67,11 +90,24 @@ const GetValidatorAddressesResponse$json = const {
   '1': 'GetValidatorAddressesResponse',
   '2': const [
     const {'1': 'addresses', '3': 1, '4': 3, '5': 9, '10': 'addresses'},
+    const {'1': 'validators', '3': 2, '4': 3, '5': 11, '6': '.pactus.ValidatorAddressInfo', '10': 'validators'},
   ],
 };
 
 /// Descriptor for `GetValidatorAddressesResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getValidatorAddressesResponseDescriptor = $convert.base64Decode('Ch1HZXRWYWxpZGF0b3JBZGRyZXNzZXNSZXNwb25zZRIcCglhZGRyZXNzZXMYASADKAlSCWFkZHJlc3Nlcw==');
+final $typed_data.Uint8List getValidatorAddressesResponseDescriptor = $convert.base64Decode('Ch1HZXRWYWxpZGF0b3JBZGRyZXNzZXNSZXNwb25zZRIcCglhZGRyZXNzZXMYASADKAlSCWFkZHJlc3NlcxI8Cgp2YWxpZGF0b3JzGAIgAygLMhwucGFjdHVzLlZhbGlkYXRvckFkZHJlc3NJbmZvUgp2YWxpZGF0b3Jz');
+@$core.Deprecated('Use validatorAddressInfoDescriptor instead')
+const ValidatorAddressInfo$json = const {
+  '1': 'ValidatorAddressInfo',
+  '2': const [
+    const {'1': 'address', '3': 1, '4': 1, '5': 9, '10': 'address'},
+    const {'1': 'number', '3': 2, '4': 1, '5': 5, '10': 'number'},
+    const {'1': 'public_key', '3': 3, '4': 1, '5': 9, '10': 'publicKey'},
+  ],
+};
+
+/// Descriptor for `ValidatorAddressInfo`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List validatorAddressInfoDescriptor = $convert.base64Decode('ChRWYWxpZGF0b3JBZGRyZXNzSW5mbxIYCgdhZGRyZXNzGAEgASgJUgdhZGRyZXNzEhYKBm51bWJlchgCIAEoBVIGbnVtYmVyEh0KCnB1YmxpY19rZXkYAyABKAlSCXB1YmxpY0tleQ==');
 @$core.Deprecated('Use getValidatorRequestDescriptor instead')
 const GetValidatorRequest$json = const {
   '1': 'GetValidatorRequest',
@@ -97,11 +133,47 @@ const GetValidatorResponse$json = const {
   '1': 'GetValidatorResponse',
   '2': const [
     const {'1': 'validator', '3': 1, '4': 1, '5': 11, '6': '.pactus.ValidatorInfo', '10': 'validator'},
+    const {'1': 'withdrawable_height', '3': 2, '4': 1, '5': 13, '10': 'withdrawableHeight'},
+    const {'1': 'reward_known', '3': 3, '4': 1, '5': 8, '10': 'rewardKnown'},
+    const {'1': 'reward_balance', '3': 4, '4': 1, '5': 3, '10': 'rewardBalance'},
   ],
 };
 
 /// Descriptor for `GetValidatorResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getValidatorResponseDescriptor = $convert.base64Decode('ChRHZXRWYWxpZGF0b3JSZXNwb25zZRIzCgl2YWxpZGF0b3IYASABKAsyFS5wYWN0dXMuVmFsaWRhdG9ySW5mb1IJdmFsaWRhdG9y');
+final $typed_data.Uint8List getValidatorResponseDescriptor = $convert.base64Decode('ChRHZXRWYWxpZGF0b3JSZXNwb25zZRIzCgl2YWxpZGF0b3IYASABKAsyFS5wYWN0dXMuVmFsaWRhdG9ySW5mb1IJdmFsaWRhdG9yEi8KE3dpdGhkcmF3YWJsZV9oZWlnaHQYAiABKA1SEndpdGhkcmF3YWJsZUhlaWdodBIhCgxyZXdhcmRfa25vd24YAyABKAhSC3Jld2FyZEtub3duEiUKDnJld2FyZF9iYWxhbmNlGAQgASgDUg1yZXdhcmRCYWxhbmNl');
+@$core.Deprecated('Use getValidatorHistoryRequestDescriptor instead')
+const GetValidatorHistoryRequest$json = const {
+  '1': 'GetValidatorHistoryRequest',
+  '2': const [
+    const {'1': 'address', '3': 1, '4': 1, '5': 9, '10': 'address'},
+  ],
+};
+
+/// Descriptor for `GetValidatorHistoryRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getValidatorHistoryRequestDescriptor = $convert.base64Decode('ChpHZXRWYWxpZGF0b3JIaXN0b3J5UmVxdWVzdBIYCgdhZGRyZXNzGAEgASgJUgdhZGRyZXNz');
+@$core.Deprecated('Use getValidatorHistoryResponseDescriptor instead')
+const GetValidatorHistoryResponse$json = const {
+  '1': 'GetValidatorHistoryResponse',
+  '2': const [
+    const {'1': 'events', '3': 1, '4': 3, '5': 11, '6': '.pactus.ValidatorHistoryEvent', '10': 'events'},
+  ],
+};
+
+/// Descriptor for `GetValidatorHistoryResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getValidatorHistoryResponseDescriptor = $convert.base64Decode('ChtHZXRWYWxpZGF0b3JIaXN0b3J5UmVzcG9uc2USNQoGZXZlbnRzGAEgAygLMh0ucGFjdHVzLlZhbGlkYXRvckhpc3RvcnlFdmVudFIGZXZlbnRz');
+@$core.Deprecated('Use validatorHistoryEventDescriptor instead')
+const ValidatorHistoryEvent$json = const {
+  '1': 'ValidatorHistoryEvent',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'transaction', '3': 2, '4': 1, '5': 11, '6': '.pactus.TransactionInfo', '10': 'transaction'},
+    const {'1': 'amount', '3': 3, '4': 1, '5': 3, '10': 'amount'},
+    const {'1': 'stake', '3': 4, '4': 1, '5': 3, '10': 'stake'},
+  ],
+};
+
+/// Descriptor for `ValidatorHistoryEvent`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List validatorHistoryEventDescriptor = $convert.base64Decode('ChVWYWxpZGF0b3JIaXN0b3J5RXZlbnQSFgoGaGVpZ2h0GAEgASgNUgZoZWlnaHQSOQoLdHJhbnNhY3Rpb24YAiABKAsyFy5wYWN0dXMuVHJhbnNhY3Rpb25JbmZvUgt0cmFuc2FjdGlvbhIWCgZhbW91bnQYAyABKANSBmFtb3VudBIUCgVzdGFrZRgEIAEoA1IFc3Rha2U=');
 @$core.Deprecated('Use getPublicKeyRequestDescriptor instead')
 const GetPublicKeyRequest$json = const {
   '1': 'GetPublicKeyRequest',
@@ -122,6 +194,75 @@ const GetPublicKeyResponse$json = const {
 
 /// Descriptor for `GetPublicKeyResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getPublicKeyResponseDescriptor = $convert.base64Decode('ChRHZXRQdWJsaWNLZXlSZXNwb25zZRIdCgpwdWJsaWNfa2V5GAEgASgJUglwdWJsaWNLZXk=');
+@$core.Deprecated('Use getAddressByPublicKeyRequestDescriptor instead')
+const GetAddressByPublicKeyRequest$json = const {
+  '1': 'GetAddressByPublicKeyRequest',
+  '2': const [
+    const {'1': 'public_key', '3': 1, '4': 1, '5': 9, '10': 'publicKey'},
+  ],
+};
+
+/// Descriptor for `GetAddressByPublicKeyRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getAddressByPublicKeyRequestDescriptor = $convert.base64Decode('ChxHZXRBZGRyZXNzQnlQdWJsaWNLZXlSZXF1ZXN0Eh0KCnB1YmxpY19rZXkYASABKAlSCXB1YmxpY0tleQ==');
+@$core.Deprecated('Use getAddressByPublicKeyResponseDescriptor instead')
+const GetAddressByPublicKeyResponse$json = const {
+  '1': 'GetAddressByPublicKeyResponse',
+  '2': const [
+    const {'1': 'account_address', '3': 1, '4': 1, '5': 9, '10': 'accountAddress'},
+    const {'1': 'validator_address', '3': 2, '4': 1, '5': 9, '10': 'validatorAddress'},
+  ],
+};
+
+/// Descriptor for `GetAddressByPublicKeyResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getAddressByPublicKeyResponseDescriptor = $convert.base64Decode('Ch1HZXRBZGRyZXNzQnlQdWJsaWNLZXlSZXNwb25zZRInCg9hY2NvdW50X2FkZHJlc3MYASABKAlSDmFjY291bnRBZGRyZXNzEisKEXZhbGlkYXRvcl9hZGRyZXNzGAIgASgJUhB2YWxpZGF0b3JBZGRyZXNz');
+@$core.Deprecated('Use getGenesisRequestDescriptor instead')
+const GetGenesisRequest$json = const {
+  '1': 'GetGenesisRequest',
+};
+
+/// Descriptor for `GetGenesisRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getGenesisRequestDescriptor = $convert.base64Decode('ChFHZXRHZW5lc2lzUmVxdWVzdA==');
+@$core.Deprecated('Use getGenesisResponseDescriptor instead')
+const GetGenesisResponse$json = const {
+  '1': 'GetGenesisResponse',
+  '2': const [
+    const {'1': 'hash', '3': 1, '4': 1, '5': 12, '10': 'hash'},
+    const {'1': 'data', '3': 2, '4': 1, '5': 12, '10': 'data'},
+    const {'1': 'chain_type', '3': 3, '4': 1, '5': 9, '10': 'chainType'},
+  ],
+};
+
+/// Descriptor for `GetGenesisResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getGenesisResponseDescriptor = $convert.base64Decode('ChJHZXRHZW5lc2lzUmVzcG9uc2USEgoEaGFzaBgBIAEoDFIEaGFzaBISCgRkYXRhGAIgASgMUgRkYXRhEh0KCmNoYWluX3R5cGUYAyABKAlSCWNoYWluVHlwZQ==');
+@$core.Deprecated('Use getParamsRequestDescriptor instead')
+const GetParamsRequest$json = const {
+  '1': 'GetParamsRequest',
+};
+
+/// Descriptor for `GetParamsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getParamsRequestDescriptor = $convert.base64Decode('ChBHZXRQYXJhbXNSZXF1ZXN0');
+@$core.Deprecated('Use getParamsResponseDescriptor instead')
+const GetParamsResponse$json = const {
+  '1': 'GetParamsResponse',
+  '2': const [
+    const {'1': 'block_version', '3': 1, '4': 1, '5': 13, '10': 'blockVersion'},
+    const {'1': 'block_interval_in_second', '3': 2, '4': 1, '5': 5, '10': 'blockIntervalInSecond'},
+    const {'1': 'committee_size', '3': 3, '4': 1, '5': 5, '10': 'committeeSize'},
+    const {'1': 'block_reward', '3': 4, '4': 1, '5': 3, '10': 'blockReward'},
+    const {'1': 'transaction_to_live_interval', '3': 5, '4': 1, '5': 13, '10': 'transactionToLiveInterval'},
+    const {'1': 'bond_interval', '3': 6, '4': 1, '5': 13, '10': 'bondInterval'},
+    const {'1': 'unbond_interval', '3': 7, '4': 1, '5': 13, '10': 'unbondInterval'},
+    const {'1': 'sortition_interval', '3': 8, '4': 1, '5': 13, '10': 'sortitionInterval'},
+    const {'1': 'fee_fraction', '3': 9, '4': 1, '5': 1, '10': 'feeFraction'},
+    const {'1': 'minimum_fee', '3': 10, '4': 1, '5': 3, '10': 'minimumFee'},
+    const {'1': 'maximum_fee', '3': 11, '4': 1, '5': 3, '10': 'maximumFee'},
+    const {'1': 'minimum_stake', '3': 12, '4': 1, '5': 3, '10': 'minimumStake'},
+    const {'1': 'maximum_stake', '3': 13, '4': 1, '5': 3, '10': 'maximumStake'},
+  ],
+};
+
+/// Descriptor for `GetParamsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getParamsResponseDescriptor = $convert.base64Decode('ChFHZXRQYXJhbXNSZXNwb25zZRIjCg1ibG9ja192ZXJzaW9uGAEgASgNUgxibG9ja1ZlcnNpb24SNwoYYmxvY2tfaW50ZXJ2YWxfaW5fc2Vjb25kGAIgASgFUhVibG9ja0ludGVydmFsSW5TZWNvbmQSJQoOY29tbWl0dGVlX3NpemUYAyABKAVSDWNvbW1pdHRlZVNpemUSIQoMYmxvY2tfcmV3YXJkGAQgASgDUgtibG9ja1Jld2FyZBI/Chx0cmFuc2FjdGlvbl90b19saXZlX2ludGVydmFsGAUgASgNUhl0cmFuc2FjdGlvblRvTGl2ZUludGVydmFsEiMKDWJvbmRfaW50ZXJ2YWwYBiABKA1SDGJvbmRJbnRlcnZhbBInCg91bmJvbmRfaW50ZXJ2YWwYByABKA1SDnVuYm9uZEludGVydmFsEi0KEnNvcnRpdGlvbl9pbnRlcnZhbBgIIAEoDVIRc29ydGl0aW9uSW50ZXJ2YWwSIQoMZmVlX2ZyYWN0aW9uGAkgASgBUgtmZWVGcmFjdGlvbhIfCgttaW5pbXVtX2ZlZRgKIAEoA1IKbWluaW11bUZlZRIfCgttYXhpbXVtX2ZlZRgLIAEoA1IKbWF4aW11bUZlZRIjCg1taW5pbXVtX3N0YWtlGAwgASgDUgxtaW5pbXVtU3Rha2USIwoNbWF4aW11bV9zdGFrZRgNIAEoA1IMbWF4aW11bVN0YWtl');
 @$core.Deprecated('Use getBlockRequestDescriptor instead')
 const GetBlockRequest$json = const {
   '1': 'GetBlockRequest',
@@ -144,11 +285,92 @@ const GetBlockResponse$json = const {
     const {'1': 'header', '3': 5, '4': 1, '5': 11, '6': '.pactus.BlockHeaderInfo', '10': 'header'},
     const {'1': 'prev_cert', '3': 6, '4': 1, '5': 11, '6': '.pactus.CertificateInfo', '10': 'prevCert'},
     const {'1': 'txs', '3': 7, '4': 3, '5': 11, '6': '.pactus.TransactionInfo', '10': 'txs'},
+    const {'1': 'size', '3': 8, '4': 1, '5': 13, '10': 'size'},
   ],
 };
 
 /// Descriptor for `GetBlockResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getBlockResponseDescriptor = $convert.base64Decode('ChBHZXRCbG9ja1Jlc3BvbnNlEhYKBmhlaWdodBgBIAEoDVIGaGVpZ2h0EhIKBGhhc2gYAiABKAxSBGhhc2gSEgoEZGF0YRgDIAEoDFIEZGF0YRIdCgpibG9ja190aW1lGAQgASgNUglibG9ja1RpbWUSLwoGaGVhZGVyGAUgASgLMhcucGFjdHVzLkJsb2NrSGVhZGVySW5mb1IGaGVhZGVyEjQKCXByZXZfY2VydBgGIAEoCzIXLnBhY3R1cy5DZXJ0aWZpY2F0ZUluZm9SCHByZXZDZXJ0EikKA3R4cxgHIAMoCzIXLnBhY3R1cy5UcmFuc2FjdGlvbkluZm9SA3R4cw==');
+final $typed_data.Uint8List getBlockResponseDescriptor = $convert.base64Decode('ChBHZXRCbG9ja1Jlc3BvbnNlEhYKBmhlaWdodBgBIAEoDVIGaGVpZ2h0EhIKBGhhc2gYAiABKAxSBGhhc2gSEgoEZGF0YRgDIAEoDFIEZGF0YRIdCgpibG9ja190aW1lGAQgASgNUglibG9ja1RpbWUSLwoGaGVhZGVyGAUgASgLMhcucGFjdHVzLkJsb2NrSGVhZGVySW5mb1IGaGVhZGVyEjQKCXByZXZfY2VydBgGIAEoCzIXLnBhY3R1cy5DZXJ0aWZpY2F0ZUluZm9SCHByZXZDZXJ0EikKA3R4cxgHIAMoCzIXLnBhY3R1cy5UcmFuc2FjdGlvbkluZm9SA3R4cxISCgRzaXplGAggASgNUgRzaXpl');
+@$core.Deprecated('Use getRawBlockRequestDescriptor instead')
+const GetRawBlockRequest$json = const {
+  '1': 'GetRawBlockRequest',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+  ],
+};
+
+/// Descriptor for `GetRawBlockRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRawBlockRequestDescriptor = $convert.base64Decode('ChJHZXRSYXdCbG9ja1JlcXVlc3QSFgoGaGVpZ2h0GAEgASgNUgZoZWlnaHQ=');
+@$core.Deprecated('Use getRawBlockResponseDescriptor instead')
+const GetRawBlockResponse$json = const {
+  '1': 'GetRawBlockResponse',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'block_data', '3': 2, '4': 1, '5': 12, '10': 'blockData'},
+    const {'1': 'certificate_data', '3': 3, '4': 1, '5': 12, '10': 'certificateData'},
+  ],
+};
+
+/// Descriptor for `GetRawBlockResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRawBlockResponseDescriptor = $convert.base64Decode('ChNHZXRSYXdCbG9ja1Jlc3BvbnNlEhYKBmhlaWdodBgBIAEoDVIGaGVpZ2h0Eh0KCmJsb2NrX2RhdGEYAiABKAxSCWJsb2NrRGF0YRIpChBjZXJ0aWZpY2F0ZV9kYXRhGAMgASgMUg9jZXJ0aWZpY2F0ZURhdGE=');
+@$core.Deprecated('Use getRecentBlocksRequestDescriptor instead')
+const GetRecentBlocksRequest$json = const {
+  '1': 'GetRecentBlocksRequest',
+  '2': const [
+    const {'1': 'count', '3': 1, '4': 1, '5': 13, '10': 'count'},
+  ],
+};
+
+/// Descriptor for `GetRecentBlocksRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRecentBlocksRequestDescriptor = $convert.base64Decode('ChZHZXRSZWNlbnRCbG9ja3NSZXF1ZXN0EhQKBWNvdW50GAEgASgNUgVjb3VudA==');
+@$core.Deprecated('Use getRecentBlocksResponseDescriptor instead')
+const GetRecentBlocksResponse$json = const {
+  '1': 'GetRecentBlocksResponse',
+  '2': const [
+    const {'1': 'blocks', '3': 1, '4': 3, '5': 11, '6': '.pactus.BlockSummary', '10': 'blocks'},
+  ],
+};
+
+/// Descriptor for `GetRecentBlocksResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRecentBlocksResponseDescriptor = $convert.base64Decode('ChdHZXRSZWNlbnRCbG9ja3NSZXNwb25zZRIsCgZibG9ja3MYASADKAsyFC5wYWN0dXMuQmxvY2tTdW1tYXJ5UgZibG9ja3M=');
+@$core.Deprecated('Use blockSummaryDescriptor instead')
+const BlockSummary$json = const {
+  '1': 'BlockSummary',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'hash', '3': 2, '4': 1, '5': 12, '10': 'hash'},
+    const {'1': 'block_time', '3': 3, '4': 1, '5': 13, '10': 'blockTime'},
+    const {'1': 'tx_count', '3': 4, '4': 1, '5': 5, '10': 'txCount'},
+    const {'1': 'proposer_address', '3': 5, '4': 1, '5': 9, '10': 'proposerAddress'},
+    const {'1': 'size', '3': 6, '4': 1, '5': 13, '10': 'size'},
+  ],
+};
+
+/// Descriptor for `BlockSummary`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List blockSummaryDescriptor = $convert.base64Decode('CgxCbG9ja1N1bW1hcnkSFgoGaGVpZ2h0GAEgASgNUgZoZWlnaHQSEgoEaGFzaBgCIAEoDFIEaGFzaBIdCgpibG9ja190aW1lGAMgASgNUglibG9ja1RpbWUSGQoIdHhfY291bnQYBCABKAVSB3R4Q291bnQSKQoQcHJvcG9zZXJfYWRkcmVzcxgFIAEoCVIPcHJvcG9zZXJBZGRyZXNzEhIKBHNpemUYBiABKA1SBHNpemU=');
+@$core.Deprecated('Use getBlockTransactionsRequestDescriptor instead')
+const GetBlockTransactionsRequest$json = const {
+  '1': 'GetBlockTransactionsRequest',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'page_size', '3': 2, '4': 1, '5': 13, '10': 'pageSize'},
+    const {'1': 'page_token', '3': 3, '4': 1, '5': 9, '10': 'pageToken'},
+  ],
+};
+
+/// Descriptor for `GetBlockTransactionsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getBlockTransactionsRequestDescriptor = $convert.base64Decode('ChtHZXRCbG9ja1RyYW5zYWN0aW9uc1JlcXVlc3QSFgoGaGVpZ2h0GAEgASgNUgZoZWlnaHQSGwoJcGFnZV9zaXplGAIgASgNUghwYWdlU2l6ZRIdCgpwYWdlX3Rva2VuGAMgASgJUglwYWdlVG9rZW4=');
+@$core.Deprecated('Use getBlockTransactionsResponseDescriptor instead')
+const GetBlockTransactionsResponse$json = const {
+  '1': 'GetBlockTransactionsResponse',
+  '2': const [
+    const {'1': 'txs', '3': 1, '4': 3, '5': 11, '6': '.pactus.TransactionInfo', '10': 'txs'},
+    const {'1': 'next_page_token', '3': 2, '4': 1, '5': 9, '10': 'nextPageToken'},
+  ],
+};
+
+/// Descriptor for `GetBlockTransactionsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getBlockTransactionsResponseDescriptor = $convert.base64Decode('ChxHZXRCbG9ja1RyYW5zYWN0aW9uc1Jlc3BvbnNlEikKA3R4cxgBIAMoCzIXLnBhY3R1cy5UcmFuc2FjdGlvbkluZm9SA3R4cxImCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAlSDW5leHRQYWdlVG9rZW4=');
 @$core.Deprecated('Use getBlockHashRequestDescriptor instead')
 const GetBlockHashRequest$json = const {
   '1': 'GetBlockHashRequest',
@@ -169,6 +391,28 @@ const GetBlockHashResponse$json = const {
 
 /// Descriptor for `GetBlockHashResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getBlockHashResponseDescriptor = $convert.base64Decode('ChRHZXRCbG9ja0hhc2hSZXNwb25zZRISCgRoYXNoGAEgASgMUgRoYXNo');
+@$core.Deprecated('Use getBlockHashByTimeRequestDescriptor instead')
+const GetBlockHashByTimeRequest$json = const {
+  '1': 'GetBlockHashByTimeRequest',
+  '2': const [
+    const {'1': 'time', '3': 1, '4': 1, '5': 13, '10': 'time'},
+  ],
+};
+
+/// Descriptor for `GetBlockHashByTimeRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getBlockHashByTimeRequestDescriptor = $convert.base64Decode('ChlHZXRCbG9ja0hhc2hCeVRpbWVSZXF1ZXN0EhIKBHRpbWUYASABKA1SBHRpbWU=');
+@$core.Deprecated('Use getBlockHashByTimeResponseDescriptor instead')
+const GetBlockHashByTimeResponse$json = const {
+  '1': 'GetBlockHashByTimeResponse',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'hash', '3': 2, '4': 1, '5': 12, '10': 'hash'},
+    const {'1': 'block_time', '3': 3, '4': 1, '5': 13, '10': 'blockTime'},
+  ],
+};
+
+/// Descriptor for `GetBlockHashByTimeResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getBlockHashByTimeResponseDescriptor = $convert.base64Decode('ChpHZXRCbG9ja0hhc2hCeVRpbWVSZXNwb25zZRIWCgZoZWlnaHQYASABKA1SBmhlaWdodBISCgRoYXNoGAIgASgMUgRoYXNoEh0KCmJsb2NrX3RpbWUYAyABKA1SCWJsb2NrVGltZQ==');
 @$core.Deprecated('Use getBlockHeightRequestDescriptor instead')
 const GetBlockHeightRequest$json = const {
   '1': 'GetBlockHeightRequest',
@@ -189,6 +433,44 @@ const GetBlockHeightResponse$json = const {
 
 /// Descriptor for `GetBlockHeightResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getBlockHeightResponseDescriptor = $convert.base64Decode('ChZHZXRCbG9ja0hlaWdodFJlc3BvbnNlEhYKBmhlaWdodBgBIAEoDVIGaGVpZ2h0');
+@$core.Deprecated('Use subscribeBlockHeightRequestDescriptor instead')
+const SubscribeBlockHeightRequest$json = const {
+  '1': 'SubscribeBlockHeightRequest',
+};
+
+/// Descriptor for `SubscribeBlockHeightRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List subscribeBlockHeightRequestDescriptor = $convert.base64Decode('ChtTdWJzY3JpYmVCbG9ja0hlaWdodFJlcXVlc3Q=');
+@$core.Deprecated('Use subscribeBlockHeightResponseDescriptor instead')
+const SubscribeBlockHeightResponse$json = const {
+  '1': 'SubscribeBlockHeightResponse',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+  ],
+};
+
+/// Descriptor for `SubscribeBlockHeightResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List subscribeBlockHeightResponseDescriptor = $convert.base64Decode('ChxTdWJzY3JpYmVCbG9ja0hlaWdodFJlc3BvbnNlEhYKBmhlaWdodBgBIAEoDVIGaGVpZ2h0');
+@$core.Deprecated('Use subscribeAccountRequestDescriptor instead')
+const SubscribeAccountRequest$json = const {
+  '1': 'SubscribeAccountRequest',
+  '2': const [
+    const {'1': 'address', '3': 1, '4': 1, '5': 9, '10': 'address'},
+  ],
+};
+
+/// Descriptor for `SubscribeAccountRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List subscribeAccountRequestDescriptor = $convert.base64Decode('ChdTdWJzY3JpYmVBY2NvdW50UmVxdWVzdBIYCgdhZGRyZXNzGAEgASgJUgdhZGRyZXNz');
+@$core.Deprecated('Use subscribeAccountResponseDescriptor instead')
+const SubscribeAccountResponse$json = const {
+  '1': 'SubscribeAccountResponse',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'transaction', '3': 2, '4': 1, '5': 11, '6': '.pactus.TransactionInfo', '10': 'transaction'},
+  ],
+};
+
+/// Descriptor for `SubscribeAccountResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List subscribeAccountResponseDescriptor = $convert.base64Decode('ChhTdWJzY3JpYmVBY2NvdW50UmVzcG9uc2USFgoGaGVpZ2h0GAEgASgNUgZoZWlnaHQSOQoLdHJhbnNhY3Rpb24YAiABKAsyFy5wYWN0dXMuVHJhbnNhY3Rpb25JbmZvUgt0cmFuc2FjdGlvbg==');
 @$core.Deprecated('Use getBlockchainInfoRequestDescriptor instead')
 const GetBlockchainInfoRequest$json = const {
   '1': 'GetBlockchainInfoRequest',
@@ -207,18 +489,70 @@ const GetBlockchainInfoResponse$json = const {
     const {'1': 'total_power', '3': 5, '4': 1, '5': 3, '10': 'totalPower'},
     const {'1': 'committee_power', '3': 6, '4': 1, '5': 3, '10': 'committeePower'},
     const {'1': 'committee_validators', '3': 7, '4': 3, '5': 11, '6': '.pactus.ValidatorInfo', '10': 'committeeValidators'},
+    const {'1': 'estimated_sync_time', '3': 8, '4': 1, '5': 3, '10': 'estimatedSyncTime'},
+    const {'1': 'total_supply', '3': 9, '4': 1, '5': 3, '10': 'totalSupply'},
+    const {'1': 'treasury_balance', '3': 10, '4': 1, '5': 3, '10': 'treasuryBalance'},
+    const {'1': 'circulating_supply', '3': 11, '4': 1, '5': 3, '10': 'circulatingSupply'},
+    const {'1': 'tx_count_per_type', '3': 12, '4': 3, '5': 11, '6': '.pactus.GetBlockchainInfoResponse.TxCountPerTypeEntry', '10': 'txCountPerType'},
+    const {'1': 'tx_stats_blocks', '3': 13, '4': 1, '5': 13, '10': 'txStatsBlocks'},
   ],
+  '3': const [GetBlockchainInfoResponse_TxCountPerTypeEntry$json],
+};
+
+@$core.Deprecated('Use getBlockchainInfoResponseDescriptor instead')
+const GetBlockchainInfoResponse_TxCountPerTypeEntry$json = const {
+  '1': 'TxCountPerTypeEntry',
+  '2': const [
+    const {'1': 'key', '3': 1, '4': 1, '5': 5, '10': 'key'},
+    const {'1': 'value', '3': 2, '4': 1, '5': 5, '10': 'value'},
+  ],
+  '7': const {'7': true},
 };
 
 /// Descriptor for `GetBlockchainInfoResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getBlockchainInfoResponseDescriptor = $convert.base64Decode('ChlHZXRCbG9ja2NoYWluSW5mb1Jlc3BvbnNlEioKEWxhc3RfYmxvY2tfaGVpZ2h0GAEgASgNUg9sYXN0QmxvY2tIZWlnaHQSJgoPbGFzdF9ibG9ja19oYXNoGAIgASgMUg1sYXN0QmxvY2tIYXNoEiUKDnRvdGFsX2FjY291bnRzGAMgASgFUg10b3RhbEFjY291bnRzEikKEHRvdGFsX3ZhbGlkYXRvcnMYBCABKAVSD3RvdGFsVmFsaWRhdG9ycxIfCgt0b3RhbF9wb3dlchgFIAEoA1IKdG90YWxQb3dlchInCg9jb21taXR0ZWVfcG93ZXIYBiABKANSDmNvbW1pdHRlZVBvd2VyEkgKFGNvbW1pdHRlZV92YWxpZGF0b3JzGAcgAygLMhUucGFjdHVzLlZhbGlkYXRvckluZm9SE2NvbW1pdHRlZVZhbGlkYXRvcnM=');
+final $typed_data.Uint8List getBlockchainInfoResponseDescriptor = $convert.base64Decode('ChlHZXRCbG9ja2NoYWluSW5mb1Jlc3BvbnNlEioKEWxhc3RfYmxvY2tfaGVpZ2h0GAEgASgNUg9sYXN0QmxvY2tIZWlnaHQSJgoPbGFzdF9ibG9ja19oYXNoGAIgASgMUg1sYXN0QmxvY2tIYXNoEiUKDnRvdGFsX2FjY291bnRzGAMgASgFUg10b3RhbEFjY291bnRzEikKEHRvdGFsX3ZhbGlkYXRvcnMYBCABKAVSD3RvdGFsVmFsaWRhdG9ycxIfCgt0b3RhbF9wb3dlchgFIAEoA1IKdG90YWxQb3dlchInCg9jb21taXR0ZWVfcG93ZXIYBiABKANSDmNvbW1pdHRlZVBvd2VyEkgKFGNvbW1pdHRlZV92YWxpZGF0b3JzGAcgAygLMhUucGFjdHVzLlZhbGlkYXRvckluZm9SE2NvbW1pdHRlZVZhbGlkYXRvcnMSLgoTZXN0aW1hdGVkX3N5bmNfdGltZRgIIAEoA1IRZXN0aW1hdGVkU3luY1RpbWUSIQoMdG90YWxfc3VwcGx5GAkgASgDUgt0b3RhbFN1cHBseRIpChB0cmVhc3VyeV9iYWxhbmNlGAogASgDUg90cmVhc3VyeUJhbGFuY2USLQoSY2lyY3VsYXRpbmdfc3VwcGx5GAsgASgDUhFjaXJjdWxhdGluZ1N1cHBseRJgChF0eF9jb3VudF9wZXJfdHlwZRgMIAMoCzI1LnBhY3R1cy5HZXRCbG9ja2NoYWluSW5mb1Jlc3BvbnNlLlR4Q291bnRQZXJUeXBlRW50cnlSDnR4Q291bnRQZXJUeXBlEiYKD3R4X3N0YXRzX2Jsb2NrcxgNIAEoDVINdHhTdGF0c0Jsb2NrcxpBChNUeENvdW50UGVyVHlwZUVudHJ5EhAKA2tleRgBIAEoBVIDa2V5EhQKBXZhbHVlGAIgASgFUgV2YWx1ZToCOAE=');
+@$core.Deprecated('Use getCommitteeRequestDescriptor instead')
+const GetCommitteeRequest$json = const {
+  '1': 'GetCommitteeRequest',
+};
+
+/// Descriptor for `GetCommitteeRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getCommitteeRequestDescriptor = $convert.base64Decode('ChNHZXRDb21taXR0ZWVSZXF1ZXN0');
+@$core.Deprecated('Use getCommitteeResponseDescriptor instead')
+const GetCommitteeResponse$json = const {
+  '1': 'GetCommitteeResponse',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'round', '3': 2, '4': 1, '5': 5, '10': 'round'},
+    const {'1': 'members', '3': 3, '4': 3, '5': 11, '6': '.pactus.CommitteeMember', '10': 'members'},
+  ],
+};
+
+/// Descriptor for `GetCommitteeResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getCommitteeResponseDescriptor = $convert.base64Decode('ChRHZXRDb21taXR0ZWVSZXNwb25zZRIWCgZoZWlnaHQYASABKA1SBmhlaWdodBIUCgVyb3VuZBgCIAEoBVIFcm91bmQSMQoHbWVtYmVycxgDIAMoCzIXLnBhY3R1cy5Db21taXR0ZWVNZW1iZXJSB21lbWJlcnM=');
+@$core.Deprecated('Use committeeMemberDescriptor instead')
+const CommitteeMember$json = const {
+  '1': 'CommitteeMember',
+  '2': const [
+    const {'1': 'address', '3': 1, '4': 1, '5': 9, '10': 'address'},
+    const {'1': 'number', '3': 2, '4': 1, '5': 5, '10': 'number'},
+    const {'1': 'public_key', '3': 3, '4': 1, '5': 9, '10': 'publicKey'},
+    const {'1': 'is_proposer', '3': 4, '4': 1, '5': 8, '10': 'isProposer'},
+  ],
+};
+
+/// Descriptor for `CommitteeMember`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List committeeMemberDescriptor = $convert.base64Decode('Cg9Db21taXR0ZWVNZW1iZXISGAoHYWRkcmVzcxgBIAEoCVIHYWRkcmVzcxIWCgZudW1iZXIYAiABKAVSBm51bWJlchIdCgpwdWJsaWNfa2V5GAMgASgJUglwdWJsaWNLZXkSHwoLaXNfcHJvcG9zZXIYBCABKAhSCmlzUHJvcG9zZXI=');
 @$core.Deprecated('Use getConsensusInfoRequestDescriptor instead')
 const GetConsensusInfoRequest$json = const {
   '1': 'GetConsensusInfoRequest',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+  ],
 };
 
 /// Descriptor for `GetConsensusInfoRequest`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getConsensusInfoRequestDescriptor = $convert.base64Decode('ChdHZXRDb25zZW5zdXNJbmZvUmVxdWVzdA==');
+final $typed_data.Uint8List getConsensusInfoRequestDescriptor = $convert.base64Decode('ChdHZXRDb25zZW5zdXNJbmZvUmVxdWVzdBIWCgZoZWlnaHQYASABKA1SBmhlaWdodA==');
 @$core.Deprecated('Use getConsensusInfoResponseDescriptor instead')
 const GetConsensusInfoResponse$json = const {
   '1': 'GetConsensusInfoResponse',
@@ -311,24 +645,66 @@ const ConsensusInfo$json = const {
     const {'1': 'height', '3': 3, '4': 1, '5': 13, '10': 'height'},
     const {'1': 'round', '3': 4, '4': 1, '5': 5, '10': 'round'},
     const {'1': 'votes', '3': 5, '4': 3, '5': 11, '6': '.pactus.VoteInfo', '10': 'votes'},
+    const {'1': 'round_timings', '3': 6, '4': 3, '5': 11, '6': '.pactus.RoundTiming', '10': 'roundTimings'},
+    const {'1': 'proposal', '3': 7, '4': 1, '5': 11, '6': '.pactus.ProposalInfo', '10': 'proposal'},
+    const {'1': 'missed_proposals', '3': 8, '4': 1, '5': 3, '10': 'missedProposals'},
+    const {'1': 'missed_votes', '3': 9, '4': 1, '5': 3, '10': 'missedVotes'},
   ],
 };
 
 /// Descriptor for `ConsensusInfo`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List consensusInfoDescriptor = $convert.base64Decode('Cg1Db25zZW5zdXNJbmZvEhgKB2FkZHJlc3MYASABKAlSB2FkZHJlc3MSFgoGQWN0aXZlGAIgASgIUgZBY3RpdmUSFgoGaGVpZ2h0GAMgASgNUgZoZWlnaHQSFAoFcm91bmQYBCABKAVSBXJvdW5kEiYKBXZvdGVzGAUgAygLMhAucGFjdHVzLlZvdGVJbmZvUgV2b3Rlcw==');
+final $typed_data.Uint8List consensusInfoDescriptor = $convert.base64Decode('Cg1Db25zZW5zdXNJbmZvEhgKB2FkZHJlc3MYASABKAlSB2FkZHJlc3MSFgoGQWN0aXZlGAIgASgIUgZBY3RpdmUSFgoGaGVpZ2h0GAMgASgNUgZoZWlnaHQSFAoFcm91bmQYBCABKAVSBXJvdW5kEiYKBXZvdGVzGAUgAygLMhAucGFjdHVzLlZvdGVJbmZvUgV2b3RlcxI4Cg1yb3VuZF90aW1pbmdzGAYgAygLMhMucGFjdHVzLlJvdW5kVGltaW5nUgxyb3VuZFRpbWluZ3MSMAoIcHJvcG9zYWwYByABKAsyFC5wYWN0dXMuUHJvcG9zYWxJbmZvUghwcm9wb3NhbBIpChBtaXNzZWRfcHJvcG9zYWxzGAggASgDUg9taXNzZWRQcm9wb3NhbHMSIQoMbWlzc2VkX3ZvdGVzGAkgASgDUgttaXNzZWRWb3Rlcw==');
+@$core.Deprecated('Use proposalInfoDescriptor instead')
+const ProposalInfo$json = const {
+  '1': 'ProposalInfo',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'round', '3': 2, '4': 1, '5': 5, '10': 'round'},
+    const {'1': 'block_data', '3': 3, '4': 1, '5': 12, '10': 'blockData'},
+    const {'1': 'signature', '3': 4, '4': 1, '5': 9, '10': 'signature'},
+  ],
+};
+
+/// Descriptor for `ProposalInfo`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List proposalInfoDescriptor = $convert.base64Decode('CgxQcm9wb3NhbEluZm8SFgoGaGVpZ2h0GAEgASgNUgZoZWlnaHQSFAoFcm91bmQYAiABKAVSBXJvdW5kEh0KCmJsb2NrX2RhdGEYAyABKAxSCWJsb2NrRGF0YRIcCglzaWduYXR1cmUYBCABKAlSCXNpZ25hdHVyZQ==');
+@$core.Deprecated('Use roundTimingDescriptor instead')
+const RoundTiming$json = const {
+  '1': 'RoundTiming',
+  '2': const [
+    const {'1': 'height', '3': 1, '4': 1, '5': 13, '10': 'height'},
+    const {'1': 'round', '3': 2, '4': 1, '5': 5, '10': 'round'},
+    const {'1': 'duration_ms', '3': 3, '4': 1, '5': 3, '10': 'durationMs'},
+    const {'1': 'committed', '3': 4, '4': 1, '5': 8, '10': 'committed'},
+  ],
+};
+
+/// Descriptor for `RoundTiming`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List roundTimingDescriptor = $convert.base64Decode('CgtSb3VuZFRpbWluZxIWCgZoZWlnaHQYASABKA1SBmhlaWdodBIUCgVyb3VuZBgCIAEoBVIFcm91bmQSHwoLZHVyYXRpb25fbXMYAyABKANSCmR1cmF0aW9uTXMSHAoJY29tbWl0dGVkGAQgASgIUgljb21taXR0ZWQ=');
 const $core.Map<$core.String, $core.dynamic> BlockchainServiceBase$json = const {
   '1': 'Blockchain',
   '2': const [
     const {'1': 'GetBlock', '2': '.pactus.GetBlockRequest', '3': '.pactus.GetBlockResponse'},
+    const {'1': 'GetRawBlock', '2': '.pactus.GetRawBlockRequest', '3': '.pactus.GetRawBlockResponse'},
+    const {'1': 'GetRecentBlocks', '2': '.pactus.GetRecentBlocksRequest', '3': '.pactus.GetRecentBlocksResponse'},
+    const {'1': 'GetBlockTransactions', '2': '.pactus.GetBlockTransactionsRequest', '3': '.pactus.GetBlockTransactionsResponse'},
     const {'1': 'GetBlockHash', '2': '.pactus.GetBlockHashRequest', '3': '.pactus.GetBlockHashResponse'},
+    const {'1': 'GetBlockHashByTime', '2': '.pactus.GetBlockHashByTimeRequest', '3': '.pactus.GetBlockHashByTimeResponse'},
     const {'1': 'GetBlockHeight', '2': '.pactus.GetBlockHeightRequest', '3': '.pactus.GetBlockHeightResponse'},
     const {'1': 'GetBlockchainInfo', '2': '.pactus.GetBlockchainInfoRequest', '3': '.pactus.GetBlockchainInfoResponse'},
     const {'1': 'GetConsensusInfo', '2': '.pactus.GetConsensusInfoRequest', '3': '.pactus.GetConsensusInfoResponse'},
     const {'1': 'GetAccount', '2': '.pactus.GetAccountRequest', '3': '.pactus.GetAccountResponse'},
+    const {'1': 'GetAccounts', '2': '.pactus.GetAccountsRequest', '3': '.pactus.GetAccountsResponse'},
     const {'1': 'GetValidator', '2': '.pactus.GetValidatorRequest', '3': '.pactus.GetValidatorResponse'},
     const {'1': 'GetValidatorByNumber', '2': '.pactus.GetValidatorByNumberRequest', '3': '.pactus.GetValidatorResponse'},
+    const {'1': 'GetValidatorHistory', '2': '.pactus.GetValidatorHistoryRequest', '3': '.pactus.GetValidatorHistoryResponse'},
     const {'1': 'GetValidatorAddresses', '2': '.pactus.GetValidatorAddressesRequest', '3': '.pactus.GetValidatorAddressesResponse'},
     const {'1': 'GetPublicKey', '2': '.pactus.GetPublicKeyRequest', '3': '.pactus.GetPublicKeyResponse'},
+    const {'1': 'GetAddressByPublicKey', '2': '.pactus.GetAddressByPublicKeyRequest', '3': '.pactus.GetAddressByPublicKeyResponse'},
+    const {'1': 'GetGenesis', '2': '.pactus.GetGenesisRequest', '3': '.pactus.GetGenesisResponse'},
+    const {'1': 'GetCommittee', '2': '.pactus.GetCommitteeRequest', '3': '.pactus.GetCommitteeResponse'},
+    const {'1': 'SubscribeBlockHeight', '2': '.pactus.SubscribeBlockHeightRequest', '3': '.pactus.SubscribeBlockHeightResponse', '6': true},
+    const {'1': 'SubscribeAccount', '2': '.pactus.SubscribeAccountRequest', '3': '.pactus.SubscribeAccountResponse', '6': true},
+    const {'1': 'GetParams', '2': '.pactus.GetParamsRequest', '3': '.pactus.GetParamsResponse'},
   ],
 };
 
@@ -344,28 +720,59 @@ const $core.Map<$core.String, $core.Map<$core.String, $core.dynamic>> Blockchain
   '.pactus.PayloadSortition': $0.PayloadSortition$json,
   '.pactus.PayloadUnbond': $0.PayloadUnbond$json,
   '.pactus.PayloadWithdraw': $0.PayloadWithdraw$json,
+  '.pactus.GetRawBlockRequest': GetRawBlockRequest$json,
+  '.pactus.GetRawBlockResponse': GetRawBlockResponse$json,
+  '.pactus.GetRecentBlocksRequest': GetRecentBlocksRequest$json,
+  '.pactus.GetRecentBlocksResponse': GetRecentBlocksResponse$json,
+  '.pactus.BlockSummary': BlockSummary$json,
+  '.pactus.GetBlockTransactionsRequest': GetBlockTransactionsRequest$json,
+  '.pactus.GetBlockTransactionsResponse': GetBlockTransactionsResponse$json,
   '.pactus.GetBlockHashRequest': GetBlockHashRequest$json,
   '.pactus.GetBlockHashResponse': GetBlockHashResponse$json,
+  '.pactus.GetBlockHashByTimeRequest': GetBlockHashByTimeRequest$json,
+  '.pactus.GetBlockHashByTimeResponse': GetBlockHashByTimeResponse$json,
   '.pactus.GetBlockHeightRequest': GetBlockHeightRequest$json,
   '.pactus.GetBlockHeightResponse': GetBlockHeightResponse$json,
   '.pactus.GetBlockchainInfoRequest': GetBlockchainInfoRequest$json,
   '.pactus.GetBlockchainInfoResponse': GetBlockchainInfoResponse$json,
   '.pactus.ValidatorInfo': ValidatorInfo$json,
+  '.pactus.GetBlockchainInfoResponse.TxCountPerTypeEntry': GetBlockchainInfoResponse_TxCountPerTypeEntry$json,
   '.pactus.GetConsensusInfoRequest': GetConsensusInfoRequest$json,
   '.pactus.GetConsensusInfoResponse': GetConsensusInfoResponse$json,
   '.pactus.ConsensusInfo': ConsensusInfo$json,
   '.pactus.VoteInfo': VoteInfo$json,
+  '.pactus.RoundTiming': RoundTiming$json,
+  '.pactus.ProposalInfo': ProposalInfo$json,
   '.pactus.GetAccountRequest': GetAccountRequest$json,
   '.pactus.GetAccountResponse': GetAccountResponse$json,
   '.pactus.AccountInfo': AccountInfo$json,
+  '.pactus.GetAccountsRequest': GetAccountsRequest$json,
+  '.pactus.GetAccountsResponse': GetAccountsResponse$json,
   '.pactus.GetValidatorRequest': GetValidatorRequest$json,
   '.pactus.GetValidatorResponse': GetValidatorResponse$json,
   '.pactus.GetValidatorByNumberRequest': GetValidatorByNumberRequest$json,
+  '.pactus.GetValidatorHistoryRequest': GetValidatorHistoryRequest$json,
+  '.pactus.GetValidatorHistoryResponse': GetValidatorHistoryResponse$json,
+  '.pactus.ValidatorHistoryEvent': ValidatorHistoryEvent$json,
   '.pactus.GetValidatorAddressesRequest': GetValidatorAddressesRequest$json,
   '.pactus.GetValidatorAddressesResponse': GetValidatorAddressesResponse$json,
+  '.pactus.ValidatorAddressInfo': ValidatorAddressInfo$json,
   '.pactus.GetPublicKeyRequest': GetPublicKeyRequest$json,
   '.pactus.GetPublicKeyResponse': GetPublicKeyResponse$json,
+  '.pactus.GetAddressByPublicKeyRequest': GetAddressByPublicKeyRequest$json,
+  '.pactus.GetAddressByPublicKeyResponse': GetAddressByPublicKeyResponse$json,
+  '.pactus.GetGenesisRequest': GetGenesisRequest$json,
+  '.pactus.GetGenesisResponse': GetGenesisResponse$json,
+  '.pactus.GetCommitteeRequest': GetCommitteeRequest$json,
+  '.pactus.GetCommitteeResponse': GetCommitteeResponse$json,
+  '.pactus.CommitteeMember': CommitteeMember$json,
+  '.pactus.SubscribeBlockHeightRequest': SubscribeBlockHeightRequest$json,
+  '.pactus.SubscribeBlockHeightResponse': SubscribeBlockHeightResponse$json,
+  '.pactus.SubscribeAccountRequest': SubscribeAccountRequest$json,
+  '.pactus.SubscribeAccountResponse': SubscribeAccountResponse$json,
+  '.pactus.GetParamsRequest': GetParamsRequest$json,
+  '.pactus.GetParamsResponse': GetParamsResponse$json,
 };
 
 /// Descriptor for `Blockchain`. Decode as a `google.protobuf.ServiceDescriptorProto`.
-final $typed_data.Uint8List blockchainServiceDescriptor = $convert.base64Decode('CgpCbG9ja2NoYWluEj0KCEdldEJsb2NrEhcucGFjdHVzLkdldEJsb2NrUmVxdWVzdBoYLnBhY3R1cy5HZXRCbG9ja1Jlc3BvbnNlEkkKDEdldEJsb2NrSGFzaBIbLnBhY3R1cy5HZXRCbG9ja0hhc2hSZXF1ZXN0GhwucGFjdHVzLkdldEJsb2NrSGFzaFJlc3BvbnNlEk8KDkdldEJsb2NrSGVpZ2h0Eh0ucGFjdHVzLkdldEJsb2NrSGVpZ2h0UmVxdWVzdBoeLnBhY3R1cy5HZXRCbG9ja0hlaWdodFJlc3BvbnNlElgKEUdldEJsb2NrY2hhaW5JbmZvEiAucGFjdHVzLkdldEJsb2NrY2hhaW5JbmZvUmVxdWVzdBohLnBhY3R1cy5HZXRCbG9ja2NoYWluSW5mb1Jlc3BvbnNlElUKEEdldENvbnNlbnN1c0luZm8SHy5wYWN0dXMuR2V0Q29uc2Vuc3VzSW5mb1JlcXVlc3QaIC5wYWN0dXMuR2V0Q29uc2Vuc3VzSW5mb1Jlc3BvbnNlEkMKCkdldEFjY291bnQSGS5wYWN0dXMuR2V0QWNjb3VudFJlcXVlc3QaGi5wYWN0dXMuR2V0QWNjb3VudFJlc3BvbnNlEkkKDEdldFZhbGlkYXRvchIbLnBhY3R1cy5HZXRWYWxpZGF0b3JSZXF1ZXN0GhwucGFjdHVzLkdldFZhbGlkYXRvclJlc3BvbnNlElkKFEdldFZhbGlkYXRvckJ5TnVtYmVyEiMucGFjdHVzLkdldFZhbGlkYXRvckJ5TnVtYmVyUmVxdWVzdBocLnBhY3R1cy5HZXRWYWxpZGF0b3JSZXNwb25zZRJkChVHZXRWYWxpZGF0b3JBZGRyZXNzZXMSJC5wYWN0dXMuR2V0VmFsaWRhdG9yQWRkcmVzc2VzUmVxdWVzdBolLnBhY3R1cy5HZXRWYWxpZGF0b3JBZGRyZXNzZXNSZXNwb25zZRJJCgxHZXRQdWJsaWNLZXkSGy5wYWN0dXMuR2V0UHVibGljS2V5UmVxdWVzdBocLnBhY3R1cy5HZXRQdWJsaWNLZXlSZXNwb25zZQ==');
+final $typed_data.Uint8List blockchainServiceDescriptor = $convert.base64Decode('CgpCbG9ja2NoYWluEj0KCEdldEJsb2NrEhcucGFjdHVzLkdldEJsb2NrUmVxdWVzdBoYLnBhY3R1cy5HZXRCbG9ja1Jlc3BvbnNlEkYKC0dldFJhd0Jsb2NrEhoucGFjdHVzLkdldFJhd0Jsb2NrUmVxdWVzdBobLnBhY3R1cy5HZXRSYXdCbG9ja1Jlc3BvbnNlElIKD0dldFJlY2VudEJsb2NrcxIeLnBhY3R1cy5HZXRSZWNlbnRCbG9ja3NSZXF1ZXN0Gh8ucGFjdHVzLkdldFJlY2VudEJsb2Nrc1Jlc3BvbnNlEmEKFEdldEJsb2NrVHJhbnNhY3Rpb25zEiMucGFjdHVzLkdldEJsb2NrVHJhbnNhY3Rpb25zUmVxdWVzdBokLnBhY3R1cy5HZXRCbG9ja1RyYW5zYWN0aW9uc1Jlc3BvbnNlEkkKDEdldEJsb2NrSGFzaBIbLnBhY3R1cy5HZXRCbG9ja0hhc2hSZXF1ZXN0GhwucGFjdHVzLkdldEJsb2NrSGFzaFJlc3BvbnNlElsKEkdldEJsb2NrSGFzaEJ5VGltZRIhLnBhY3R1cy5HZXRCbG9ja0hhc2hCeVRpbWVSZXF1ZXN0GiIucGFjdHVzLkdldEJsb2NrSGFzaEJ5VGltZVJlc3BvbnNlEk8KDkdldEJsb2NrSGVpZ2h0Eh0ucGFjdHVzLkdldEJsb2NrSGVpZ2h0UmVxdWVzdBoeLnBhY3R1cy5HZXRCbG9ja0hlaWdodFJlc3BvbnNlElgKEUdldEJsb2NrY2hhaW5JbmZvEiAucGFjdHVzLkdldEJsb2NrY2hhaW5JbmZvUmVxdWVzdBohLnBhY3R1cy5HZXRCbG9ja2NoYWluSW5mb1Jlc3BvbnNlElUKEEdldENvbnNlbnN1c0luZm8SHy5wYWN0dXMuR2V0Q29uc2Vuc3VzSW5mb1JlcXVlc3QaIC5wYWN0dXMuR2V0Q29uc2Vuc3VzSW5mb1Jlc3BvbnNlEkMKCkdldEFjY291bnQSGS5wYWN0dXMuR2V0QWNjb3VudFJlcXVlc3QaGi5wYWN0dXMuR2V0QWNjb3VudFJlc3BvbnNlEkYKC0dldEFjY291bnRzEhoucGFjdHVzLkdldEFjY291bnRzUmVxdWVzdBobLnBhY3R1cy5HZXRBY2NvdW50c1Jlc3BvbnNlEkkKDEdldFZhbGlkYXRvchIbLnBhY3R1cy5HZXRWYWxpZGF0b3JSZXF1ZXN0GhwucGFjdHVzLkdldFZhbGlkYXRvclJlc3BvbnNlElkKFEdldFZhbGlkYXRvckJ5TnVtYmVyEiMucGFjdHVzLkdldFZhbGlkYXRvckJ5TnVtYmVyUmVxdWVzdBocLnBhY3R1cy5HZXRWYWxpZGF0b3JSZXNwb25zZRJeChNHZXRWYWxpZGF0b3JIaXN0b3J5EiIucGFjdHVzLkdldFZhbGlkYXRvckhpc3RvcnlSZXF1ZXN0GiMucGFjdHVzLkdldFZhbGlkYXRvckhpc3RvcnlSZXNwb25zZRJkChVHZXRWYWxpZGF0b3JBZGRyZXNzZXMSJC5wYWN0dXMuR2V0VmFsaWRhdG9yQWRkcmVzc2VzUmVxdWVzdBolLnBhY3R1cy5HZXRWYWxpZGF0b3JBZGRyZXNzZXNSZXNwb25zZRJJCgxHZXRQdWJsaWNLZXkSGy5wYWN0dXMuR2V0UHVibGljS2V5UmVxdWVzdBocLnBhY3R1cy5HZXRQdWJsaWNLZXlSZXNwb25zZRJkChVHZXRBZGRyZXNzQnlQdWJsaWNLZXkSJC5wYWN0dXMuR2V0QWRkcmVzc0J5UHVibGljS2V5UmVxdWVzdBolLnBhY3R1cy5HZXRBZGRyZXNzQnlQdWJsaWNLZXlSZXNwb25zZRJDCgpHZXRHZW5lc2lzEhkucGFjdHVzLkdldEdlbmVzaXNSZXF1ZXN0GhoucGFjdHVzLkdldEdlbmVzaXNSZXNwb25zZRJJCgxHZXRDb21taXR0ZWUSGy5wYWN0dXMuR2V0Q29tbWl0dGVlUmVxdWVzdBocLnBhY3R1cy5HZXRDb21taXR0ZWVSZXNwb25zZRJjChRTdWJzY3JpYmVCbG9ja0hlaWdodBIjLnBhY3R1cy5TdWJzY3JpYmVCbG9ja0hlaWdodFJlcXVlc3QaJC5wYWN0dXMuU3Vic2NyaWJlQmxvY2tIZWlnaHRSZXNwb25zZTABElcKEFN1YnNjcmliZUFjY291bnQSHy5wYWN0dXMuU3Vic2NyaWJlQWNjb3VudFJlcXVlc3QaIC5wYWN0dXMuU3Vic2NyaWJlQWNjb3VudFJlc3BvbnNlMAESQAoJR2V0UGFyYW1zEhgucGFjdHVzLkdldFBhcmFtc1JlcXVlc3QaGS5wYWN0dXMuR2V0UGFyYW1zUmVzcG9uc2U=');
@@ -17,28 +17,52 @@ export 'blockchain.pb.dart';
 
 abstract class BlockchainServiceBase extends $pb.GeneratedService {
   $async.Future<$1.GetBlockResponse> getBlock($pb.ServerContext ctx, $1.GetBlockRequest request);
+  $async.Future<$1.GetRawBlockResponse> getRawBlock($pb.ServerContext ctx, $1.GetRawBlockRequest request);
+  $async.Future<$1.GetRecentBlocksResponse> getRecentBlocks($pb.ServerContext ctx, $1.GetRecentBlocksRequest request);
+  $async.Future<$1.GetBlockTransactionsResponse> getBlockTransactions($pb.ServerContext ctx, $1.GetBlockTransactionsRequest request);
   $async.Future<$1.GetBlockHashResponse> getBlockHash($pb.ServerContext ctx, $1.GetBlockHashRequest request);
+  $async.Future<$1.GetBlockHashByTimeResponse> getBlockHashByTime($pb.ServerContext ctx, $1.GetBlockHashByTimeRequest request);
   $async.Future<$1.GetBlockHeightResponse> getBlockHeight($pb.ServerContext ctx, $1.GetBlockHeightRequest request);
   $async.Future<$1.GetBlockchainInfoResponse> getBlockchainInfo($pb.ServerContext ctx, $1.GetBlockchainInfoRequest request);
   $async.Future<$1.GetConsensusInfoResponse> getConsensusInfo($pb.ServerContext ctx, $1.GetConsensusInfoRequest request);
   $async.Future<$1.GetAccountResponse> getAccount($pb.ServerContext ctx, $1.GetAccountRequest request);
+  $async.Future<$1.GetAccountsResponse> getAccounts($pb.ServerContext ctx, $1.GetAccountsRequest request);
   $async.Future<$1.GetValidatorResponse> getValidator($pb.ServerContext ctx, $1.GetValidatorRequest request);
   $async.Future<$1.GetValidatorResponse> getValidatorByNumber($pb.ServerContext ctx, $1.GetValidatorByNumberRequest request);
+  $async.Future<$1.GetValidatorHistoryResponse> getValidatorHistory($pb.ServerContext ctx, $1.GetValidatorHistoryRequest request);
   $async.Future<$1.GetValidatorAddressesResponse> getValidatorAddresses($pb.ServerContext ctx, $1.GetValidatorAddressesRequest request);
   $async.Future<$1.GetPublicKeyResponse> getPublicKey($pb.ServerContext ctx, $1.GetPublicKeyRequest request);
+  $async.Future<$1.GetAddressByPublicKeyResponse> getAddressByPublicKey($pb.ServerContext ctx, $1.GetAddressByPublicKeyRequest request);
+  $async.Future<$1.GetGenesisResponse> getGenesis($pb.ServerContext ctx, $1.GetGenesisRequest request);
+  $async.Future<$1.GetCommitteeResponse> getCommittee($pb.ServerContext ctx, $1.GetCommitteeRequest request);
+  $async.Future<$1.SubscribeBlockHeightResponse> subscribeBlockHeight($pb.ServerContext ctx, $1.SubscribeBlockHeightRequest request);
+  $async.Future<$1.SubscribeAccountResponse> subscribeAccount($pb.ServerContext ctx, $1.SubscribeAccountRequest request);
+  $async.Future<$1.GetParamsResponse> getParams($pb.ServerContext ctx, $1.GetParamsRequest request);
 
   $pb.GeneratedMessage createRequest($core.String method) {
     switch (method) {
       case 'GetBlock': return $1.GetBlockRequest();
+      case 'GetRawBlock': return $1.GetRawBlockRequest();
+      case 'GetRecentBlocks': return $1.GetRecentBlocksRequest();
+      case 'GetBlockTransactions': return $1.GetBlockTransactionsRequest();
       case 'GetBlockHash': return $1.GetBlockHashRequest();
+      case 'GetBlockHashByTime': return $1.GetBlockHashByTimeRequest();
       case 'GetBlockHeight': return $1.GetBlockHeightRequest();
       case 'GetBlockchainInfo': return $1.GetBlockchainInfoRequest();
       case 'GetConsensusInfo': return $1.GetConsensusInfoRequest();
       case 'GetAccount': return $1.GetAccountRequest();
+      case 'GetAccounts': return $1.GetAccountsRequest();
       case 'GetValidator': return $1.GetValidatorRequest();
       case 'GetValidatorByNumber': return $1.GetValidatorByNumberRequest();
+      case 'GetValidatorHistory': return $1.GetValidatorHistoryRequest();
       case 'GetValidatorAddresses': return $1.GetValidatorAddressesRequest();
       case 'GetPublicKey': return $1.GetPublicKeyRequest();
+      case 'GetAddressByPublicKey': return $1.GetAddressByPublicKeyRequest();
+      case 'GetGenesis': return $1.GetGenesisRequest();
+      case 'GetCommittee': return $1.GetCommitteeRequest();
+      case 'SubscribeBlockHeight': return $1.SubscribeBlockHeightRequest();
+      case 'SubscribeAccount': return $1.SubscribeAccountRequest();
+      case 'GetParams': return $1.GetParamsRequest();
       default: throw $core.ArgumentError('Unknown method: $method');
     }
   }
@@ -46,15 +70,27 @@ abstract class BlockchainServiceBase extends $pb.GeneratedService {
   $async.Future<$pb.GeneratedMessage> handleCall($pb.ServerContext ctx, $core.String method, $pb.GeneratedMessage request) {
     switch (method) {
       case 'GetBlock': return this.getBlock(ctx, request as $1.GetBlockRequest);
+      case 'GetRawBlock': return this.getRawBlock(ctx, request as $1.GetRawBlockRequest);
+      case 'GetRecentBlocks': return this.getRecentBlocks(ctx, request as $1.GetRecentBlocksRequest);
+      case 'GetBlockTransactions': return this.getBlockTransactions(ctx, request as $1.GetBlockTransactionsRequest);
       case 'GetBlockHash': return this.getBlockHash(ctx, request as $1.GetBlockHashRequest);
+      case 'GetBlockHashByTime': return this.getBlockHashByTime(ctx, request as $1.GetBlockHashByTimeRequest);
       case 'GetBlockHeight': return this.getBlockHeight(ctx, request as $1.GetBlockHeightRequest);
       case 'GetBlockchainInfo': return this.getBlockchainInfo(ctx, request as $1.GetBlockchainInfoRequest);
       case 'GetConsensusInfo': return this.getConsensusInfo(ctx, request as $1.GetConsensusInfoRequest);
       case 'GetAccount': return this.getAccount(ctx, request as $1.GetAccountRequest);
+      case 'GetAccounts': return this.getAccounts(ctx, request as $1.GetAccountsRequest);
       case 'GetValidator': return this.getValidator(ctx, request as $1.GetValidatorRequest);
       case 'GetValidatorByNumber': return this.getValidatorByNumber(ctx, request as $1.GetValidatorByNumberRequest);
+      case 'GetValidatorHistory': return this.getValidatorHistory(ctx, request as $1.GetValidatorHistoryRequest);
       case 'GetValidatorAddresses': return this.getValidatorAddresses(ctx, request as $1.GetValidatorAddressesRequest);
       case 'GetPublicKey': return this.getPublicKey(ctx, request as $1.GetPublicKeyRequest);
+      case 'GetAddressByPublicKey': return this.getAddressByPublicKey(ctx, request as $1.GetAddressByPublicKeyRequest);
+      case 'GetGenesis': return this.getGenesis(ctx, request as $1.GetGenesisRequest);
+      case 'GetCommittee': return this.getCommittee(ctx, request as $1.GetCommitteeRequest);
+      case 'SubscribeBlockHeight': return this.subscribeBlockHeight(ctx, request as $1.SubscribeBlockHeightRequest);
+      case 'SubscribeAccount': return this.subscribeAccount(ctx, request as $1.SubscribeAccountRequest);
+      case 'GetParams': return this.getParams(ctx, request as $1.GetParamsRequest);
       default: throw $core.ArgumentError('Unknown method: $method');
     }
   }
@@ -48,6 +48,7 @@ class GetNetworkInfoResponse extends $pb.GeneratedMessage {
     ..pc<PeerInfo>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'peers', $pb.PbFieldType.PM, subBuilder: PeerInfo.create)
     ..m<$core.int, $fixnum.Int64>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sentBytes', entryClassName: 'GetNetworkInfoResponse.SentBytesEntry', keyFieldType: $pb.PbFieldType.O3, valueFieldType: $pb.PbFieldType.O6, packageName: const $pb.PackageName('pactus'))
     ..m<$core.int, $fixnum.Int64>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'receivedBytes', entryClassName: 'GetNetworkInfoResponse.ReceivedBytesEntry', keyFieldType: $pb.PbFieldType.O3, valueFieldType: $pb.PbFieldType.O6, packageName: const $pb.PackageName('pactus'))
+    ..pc<SessionInfo>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sessions', $pb.PbFieldType.PM, subBuilder: SessionInfo.create)
     ..hasRequiredFields = false
   ;
 
@@ -59,6 +60,7 @@ class GetNetworkInfoResponse extends $pb.GeneratedMessage {
     $core.Iterable<PeerInfo>? peers,
     $core.Map<$core.int, $fixnum.Int64>? sentBytes,
     $core.Map<$core.int, $fixnum.Int64>? receivedBytes,
+    $core.Iterable<SessionInfo>? sessions,
   }) {
     final _result = create();
     if (totalSentBytes != null) {
@@ -79,6 +81,9 @@ class GetNetworkInfoResponse extends $pb.GeneratedMessage {
     if (receivedBytes != null) {
       _result.receivedBytes.addAll(receivedBytes);
     }
+    if (sessions != null) {
+      _result.sessions.addAll(sessions);
+    }
     return _result;
   }
   factory GetNetworkInfoResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -137,6 +142,224 @@ class GetNetworkInfoResponse extends $pb.GeneratedMessage {
 
   @$pb.TagNumber(6)
   $core.Map<$core.int, $fixnum.Int64> get receivedBytes => $_getMap(5);
+
+  @$pb.TagNumber(7)
+  $core.List<SessionInfo> get sessions => $_getList(6);
+}
+
+class SessionInfo extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SessionInfo', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sessionId', $pb.PbFieldType.O3)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'peerId', $pb.PbFieldType.OY)
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'requestedBlocks', $pb.PbFieldType.OU3)
+    ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'receivedBlocks', $pb.PbFieldType.OU3)
+    ..aInt64(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'lastActivityAt')
+    ..aInt64(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'openedAt')
+    ..aInt64(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'age')
+    ..aOS(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'lastResponseCode')
+    ..hasRequiredFields = false
+  ;
+
+  SessionInfo._() : super();
+  factory SessionInfo({
+    $core.int? sessionId,
+    $core.List<$core.int>? peerId,
+    $core.int? requestedBlocks,
+    $core.int? receivedBlocks,
+    $fixnum.Int64? lastActivityAt,
+    $fixnum.Int64? openedAt,
+    $fixnum.Int64? age,
+    $core.String? lastResponseCode,
+  }) {
+    final _result = create();
+    if (sessionId != null) {
+      _result.sessionId = sessionId;
+    }
+    if (peerId != null) {
+      _result.peerId = peerId;
+    }
+    if (requestedBlocks != null) {
+      _result.requestedBlocks = requestedBlocks;
+    }
+    if (receivedBlocks != null) {
+      _result.receivedBlocks = receivedBlocks;
+    }
+    if (lastActivityAt != null) {
+      _result.lastActivityAt = lastActivityAt;
+    }
+    if (openedAt != null) {
+      _result.openedAt = openedAt;
+    }
+    if (age != null) {
+      _result.age = age;
+    }
+    if (lastResponseCode != null) {
+      _result.lastResponseCode = lastResponseCode;
+    }
+    return _result;
+  }
+  factory SessionInfo.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SessionInfo.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  SessionInfo clone() => SessionInfo()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  SessionInfo copyWith(void Function(SessionInfo) updates) => super.copyWith((message) => updates(message as SessionInfo)) as SessionInfo; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static SessionInfo create() => SessionInfo._();
+  SessionInfo createEmptyInstance() => create();
+  static $pb.PbList<SessionInfo> createRepeated() => $pb.PbList<SessionInfo>();
+  @$core.pragma('dart2js:noInline')
+  static SessionInfo getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SessionInfo>(create);
+  static SessionInfo? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get sessionId => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set sessionId($core.int v) { $_setSignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSessionId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSessionId() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get peerId => $_getN(1);
+  @$pb.TagNumber(2)
+  set peerId($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasPeerId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearPeerId() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get requestedBlocks => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set requestedBlocks($core.int v) { $_setUnsignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasRequestedBlocks() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearRequestedBlocks() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get receivedBlocks => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set receivedBlocks($core.int v) { $_setUnsignedInt32(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasReceivedBlocks() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearReceivedBlocks() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get lastActivityAt => $_getI64(4);
+  @$pb.TagNumber(5)
+  set lastActivityAt($fixnum.Int64 v) { $_setInt64(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasLastActivityAt() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearLastActivityAt() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get openedAt => $_getI64(5);
+  @$pb.TagNumber(6)
+  set openedAt($fixnum.Int64 v) { $_setInt64(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasOpenedAt() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearOpenedAt() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $fixnum.Int64 get age => $_getI64(6);
+  @$pb.TagNumber(7)
+  set age($fixnum.Int64 v) { $_setInt64(6, v); }
+  @$pb.TagNumber(7)
+  $core.bool hasAge() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearAge() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.String get lastResponseCode => $_getSZ(7);
+  @$pb.TagNumber(8)
+  set lastResponseCode($core.String v) { $_setString(7, v); }
+  @$pb.TagNumber(8)
+  $core.bool hasLastResponseCode() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearLastResponseCode() => clearField(8);
+}
+
+class GetSessionsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSessionsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  GetSessionsRequest._() : super();
+  factory GetSessionsRequest() => create();
+  factory GetSessionsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSessionsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetSessionsRequest clone() => GetSessionsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetSessionsRequest copyWith(void Function(GetSessionsRequest) updates) => super.copyWith((message) => updates(message as GetSessionsRequest)) as GetSessionsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetSessionsRequest create() => GetSessionsRequest._();
+  GetSessionsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetSessionsRequest> createRepeated() => $pb.PbList<GetSessionsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetSessionsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSessionsRequest>(create);
+  static GetSessionsRequest? _defaultInstance;
+}
+
+class GetSessionsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSessionsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..pc<SessionInfo>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sessions', $pb.PbFieldType.PM, subBuilder: SessionInfo.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetSessionsResponse._() : super();
+  factory GetSessionsResponse({
+    $core.Iterable<SessionInfo>? sessions,
+  }) {
+    final _result = create();
+    if (sessions != null) {
+      _result.sessions.addAll(sessions);
+    }
+    return _result;
+  }
+  factory GetSessionsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSessionsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetSessionsResponse clone() => GetSessionsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetSessionsResponse copyWith(void Function(GetSessionsResponse) updates) => super.copyWith((message) => updates(message as GetSessionsResponse)) as GetSessionsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetSessionsResponse create() => GetSessionsResponse._();
+  GetSessionsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetSessionsResponse> createRepeated() => $pb.PbList<GetSessionsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetSessionsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSessionsResponse>(create);
+  static GetSessionsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<SessionInfo> get sessions => $_getList(0);
 }
 
 class GetNodeInfoRequest extends $pb.GeneratedMessage {
@@ -173,6 +396,7 @@ class GetNodeInfoResponse extends $pb.GeneratedMessage {
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'moniker')
     ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'agent')
     ..a<$core.List<$core.int>>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'peerId', $pb.PbFieldType.OY)
+    ..aOB(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'syncPaused')
     ..hasRequiredFields = false
   ;
 
@@ -181,6 +405,7 @@ class GetNodeInfoResponse extends $pb.GeneratedMessage {
     $core.String? moniker,
     $core.String? agent,
     $core.List<$core.int>? peerId,
+    $core.bool? syncPaused,
   }) {
     final _result = create();
     if (moniker != null) {
@@ -192,6 +417,9 @@ class GetNodeInfoResponse extends $pb.GeneratedMessage {
     if (peerId != null) {
       _result.peerId = peerId;
     }
+    if (syncPaused != null) {
+      _result.syncPaused = syncPaused;
+    }
     return _result;
   }
   factory GetNodeInfoResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -241,6 +469,450 @@ class GetNodeInfoResponse extends $pb.GeneratedMessage {
   $core.bool hasPeerId() => $_has(2);
   @$pb.TagNumber(3)
   void clearPeerId() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.bool get syncPaused => $_getBF(3);
+  @$pb.TagNumber(4)
+  set syncPaused($core.bool v) { $_setBool(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasSyncPaused() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearSyncPaused() => clearField(4);
+}
+
+class BanPeerRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'BanPeerRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'peerId', $pb.PbFieldType.OY)
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'duration')
+    ..hasRequiredFields = false
+  ;
+
+  BanPeerRequest._() : super();
+  factory BanPeerRequest({
+    $core.List<$core.int>? peerId,
+    $fixnum.Int64? duration,
+  }) {
+    final _result = create();
+    if (peerId != null) {
+      _result.peerId = peerId;
+    }
+    if (duration != null) {
+      _result.duration = duration;
+    }
+    return _result;
+  }
+  factory BanPeerRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory BanPeerRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  BanPeerRequest clone() => BanPeerRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  BanPeerRequest copyWith(void Function(BanPeerRequest) updates) => super.copyWith((message) => updates(message as BanPeerRequest)) as BanPeerRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static BanPeerRequest create() => BanPeerRequest._();
+  BanPeerRequest createEmptyInstance() => create();
+  static $pb.PbList<BanPeerRequest> createRepeated() => $pb.PbList<BanPeerRequest>();
+  @$core.pragma('dart2js:noInline')
+  static BanPeerRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<BanPeerRequest>(create);
+  static BanPeerRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get peerId => $_getN(0);
+  @$pb.TagNumber(1)
+  set peerId($core.List<$core.int> v) { $_setBytes(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPeerId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPeerId() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get duration => $_getI64(1);
+  @$pb.TagNumber(2)
+  set duration($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasDuration() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearDuration() => clearField(2);
+}
+
+class BanPeerResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'BanPeerResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  BanPeerResponse._() : super();
+  factory BanPeerResponse() => create();
+  factory BanPeerResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory BanPeerResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  BanPeerResponse clone() => BanPeerResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  BanPeerResponse copyWith(void Function(BanPeerResponse) updates) => super.copyWith((message) => updates(message as BanPeerResponse)) as BanPeerResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static BanPeerResponse create() => BanPeerResponse._();
+  BanPeerResponse createEmptyInstance() => create();
+  static $pb.PbList<BanPeerResponse> createRepeated() => $pb.PbList<BanPeerResponse>();
+  @$core.pragma('dart2js:noInline')
+  static BanPeerResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<BanPeerResponse>(create);
+  static BanPeerResponse? _defaultInstance;
+}
+
+class UnbanPeerRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'UnbanPeerRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'peerId', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false
+  ;
+
+  UnbanPeerRequest._() : super();
+  factory UnbanPeerRequest({
+    $core.List<$core.int>? peerId,
+  }) {
+    final _result = create();
+    if (peerId != null) {
+      _result.peerId = peerId;
+    }
+    return _result;
+  }
+  factory UnbanPeerRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory UnbanPeerRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  UnbanPeerRequest clone() => UnbanPeerRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  UnbanPeerRequest copyWith(void Function(UnbanPeerRequest) updates) => super.copyWith((message) => updates(message as UnbanPeerRequest)) as UnbanPeerRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static UnbanPeerRequest create() => UnbanPeerRequest._();
+  UnbanPeerRequest createEmptyInstance() => create();
+  static $pb.PbList<UnbanPeerRequest> createRepeated() => $pb.PbList<UnbanPeerRequest>();
+  @$core.pragma('dart2js:noInline')
+  static UnbanPeerRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<UnbanPeerRequest>(create);
+  static UnbanPeerRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get peerId => $_getN(0);
+  @$pb.TagNumber(1)
+  set peerId($core.List<$core.int> v) { $_setBytes(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPeerId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPeerId() => clearField(1);
+}
+
+class UnbanPeerResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'UnbanPeerResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  UnbanPeerResponse._() : super();
+  factory UnbanPeerResponse() => create();
+  factory UnbanPeerResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory UnbanPeerResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  UnbanPeerResponse clone() => UnbanPeerResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  UnbanPeerResponse copyWith(void Function(UnbanPeerResponse) updates) => super.copyWith((message) => updates(message as UnbanPeerResponse)) as UnbanPeerResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static UnbanPeerResponse create() => UnbanPeerResponse._();
+  UnbanPeerResponse createEmptyInstance() => create();
+  static $pb.PbList<UnbanPeerResponse> createRepeated() => $pb.PbList<UnbanPeerResponse>();
+  @$core.pragma('dart2js:noInline')
+  static UnbanPeerResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<UnbanPeerResponse>(create);
+  static UnbanPeerResponse? _defaultInstance;
+}
+
+class PauseSyncRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'PauseSyncRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  PauseSyncRequest._() : super();
+  factory PauseSyncRequest() => create();
+  factory PauseSyncRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory PauseSyncRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  PauseSyncRequest clone() => PauseSyncRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  PauseSyncRequest copyWith(void Function(PauseSyncRequest) updates) => super.copyWith((message) => updates(message as PauseSyncRequest)) as PauseSyncRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static PauseSyncRequest create() => PauseSyncRequest._();
+  PauseSyncRequest createEmptyInstance() => create();
+  static $pb.PbList<PauseSyncRequest> createRepeated() => $pb.PbList<PauseSyncRequest>();
+  @$core.pragma('dart2js:noInline')
+  static PauseSyncRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PauseSyncRequest>(create);
+  static PauseSyncRequest? _defaultInstance;
+}
+
+class PauseSyncResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'PauseSyncResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  PauseSyncResponse._() : super();
+  factory PauseSyncResponse() => create();
+  factory PauseSyncResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory PauseSyncResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  PauseSyncResponse clone() => PauseSyncResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  PauseSyncResponse copyWith(void Function(PauseSyncResponse) updates) => super.copyWith((message) => updates(message as PauseSyncResponse)) as PauseSyncResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static PauseSyncResponse create() => PauseSyncResponse._();
+  PauseSyncResponse createEmptyInstance() => create();
+  static $pb.PbList<PauseSyncResponse> createRepeated() => $pb.PbList<PauseSyncResponse>();
+  @$core.pragma('dart2js:noInline')
+  static PauseSyncResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PauseSyncResponse>(create);
+  static PauseSyncResponse? _defaultInstance;
+}
+
+class ResumeSyncRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ResumeSyncRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  ResumeSyncRequest._() : super();
+  factory ResumeSyncRequest() => create();
+  factory ResumeSyncRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ResumeSyncRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ResumeSyncRequest clone() => ResumeSyncRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ResumeSyncRequest copyWith(void Function(ResumeSyncRequest) updates) => super.copyWith((message) => updates(message as ResumeSyncRequest)) as ResumeSyncRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ResumeSyncRequest create() => ResumeSyncRequest._();
+  ResumeSyncRequest createEmptyInstance() => create();
+  static $pb.PbList<ResumeSyncRequest> createRepeated() => $pb.PbList<ResumeSyncRequest>();
+  @$core.pragma('dart2js:noInline')
+  static ResumeSyncRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ResumeSyncRequest>(create);
+  static ResumeSyncRequest? _defaultInstance;
+}
+
+class ResumeSyncResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ResumeSyncResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  ResumeSyncResponse._() : super();
+  factory ResumeSyncResponse() => create();
+  factory ResumeSyncResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ResumeSyncResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ResumeSyncResponse clone() => ResumeSyncResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ResumeSyncResponse copyWith(void Function(ResumeSyncResponse) updates) => super.copyWith((message) => updates(message as ResumeSyncResponse)) as ResumeSyncResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ResumeSyncResponse create() => ResumeSyncResponse._();
+  ResumeSyncResponse createEmptyInstance() => create();
+  static $pb.PbList<ResumeSyncResponse> createRepeated() => $pb.PbList<ResumeSyncResponse>();
+  @$core.pragma('dart2js:noInline')
+  static ResumeSyncResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ResumeSyncResponse>(create);
+  static ResumeSyncResponse? _defaultInstance;
+}
+
+class GetRewardAddressesRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRewardAddressesRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  GetRewardAddressesRequest._() : super();
+  factory GetRewardAddressesRequest() => create();
+  factory GetRewardAddressesRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRewardAddressesRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRewardAddressesRequest clone() => GetRewardAddressesRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRewardAddressesRequest copyWith(void Function(GetRewardAddressesRequest) updates) => super.copyWith((message) => updates(message as GetRewardAddressesRequest)) as GetRewardAddressesRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRewardAddressesRequest create() => GetRewardAddressesRequest._();
+  GetRewardAddressesRequest createEmptyInstance() => create();
+  static $pb.PbList<GetRewardAddressesRequest> createRepeated() => $pb.PbList<GetRewardAddressesRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetRewardAddressesRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRewardAddressesRequest>(create);
+  static GetRewardAddressesRequest? _defaultInstance;
+}
+
+class GetRewardAddressesResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRewardAddressesResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..pc<ValidatorReward>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'validators', $pb.PbFieldType.PM, subBuilder: ValidatorReward.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetRewardAddressesResponse._() : super();
+  factory GetRewardAddressesResponse({
+    $core.Iterable<ValidatorReward>? validators,
+  }) {
+    final _result = create();
+    if (validators != null) {
+      _result.validators.addAll(validators);
+    }
+    return _result;
+  }
+  factory GetRewardAddressesResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRewardAddressesResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRewardAddressesResponse clone() => GetRewardAddressesResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRewardAddressesResponse copyWith(void Function(GetRewardAddressesResponse) updates) => super.copyWith((message) => updates(message as GetRewardAddressesResponse)) as GetRewardAddressesResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRewardAddressesResponse create() => GetRewardAddressesResponse._();
+  GetRewardAddressesResponse createEmptyInstance() => create();
+  static $pb.PbList<GetRewardAddressesResponse> createRepeated() => $pb.PbList<GetRewardAddressesResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetRewardAddressesResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRewardAddressesResponse>(create);
+  static GetRewardAddressesResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<ValidatorReward> get validators => $_getList(0);
+}
+
+class ValidatorReward extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ValidatorReward', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'validatorAddress')
+    ..a<$core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'validatorNumber', $pb.PbFieldType.O3)
+    ..pPS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'rewardAddresses')
+    ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'rotationInterval', $pb.PbFieldType.OU3)
+    ..hasRequiredFields = false
+  ;
+
+  ValidatorReward._() : super();
+  factory ValidatorReward({
+    $core.String? validatorAddress,
+    $core.int? validatorNumber,
+    $core.Iterable<$core.String>? rewardAddresses,
+    $core.int? rotationInterval,
+  }) {
+    final _result = create();
+    if (validatorAddress != null) {
+      _result.validatorAddress = validatorAddress;
+    }
+    if (validatorNumber != null) {
+      _result.validatorNumber = validatorNumber;
+    }
+    if (rewardAddresses != null) {
+      _result.rewardAddresses.addAll(rewardAddresses);
+    }
+    if (rotationInterval != null) {
+      _result.rotationInterval = rotationInterval;
+    }
+    return _result;
+  }
+  factory ValidatorReward.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ValidatorReward.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  ValidatorReward clone() => ValidatorReward()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  ValidatorReward copyWith(void Function(ValidatorReward) updates) => super.copyWith((message) => updates(message as ValidatorReward)) as ValidatorReward; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static ValidatorReward create() => ValidatorReward._();
+  ValidatorReward createEmptyInstance() => create();
+  static $pb.PbList<ValidatorReward> createRepeated() => $pb.PbList<ValidatorReward>();
+  @$core.pragma('dart2js:noInline')
+  static ValidatorReward getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ValidatorReward>(create);
+  static ValidatorReward? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get validatorAddress => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set validatorAddress($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasValidatorAddress() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearValidatorAddress() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get validatorNumber => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set validatorNumber($core.int v) { $_setSignedInt32(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasValidatorNumber() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearValidatorNumber() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.List<$core.String> get rewardAddresses => $_getList(2);
+
+  @$pb.TagNumber(4)
+  $core.int get rotationInterval => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set rotationInterval($core.int v) { $_setUnsignedInt32(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasRotationInterval() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearRotationInterval() => clearField(4);
 }
 
 class PeerInfo extends $pb.GeneratedMessage {
@@ -260,6 +932,9 @@ class PeerInfo extends $pb.GeneratedMessage {
     ..m<$core.int, $fixnum.Int64>(13, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sentBytes', entryClassName: 'PeerInfo.SentBytesEntry', keyFieldType: $pb.PbFieldType.O3, valueFieldType: $pb.PbFieldType.O6, packageName: const $pb.PackageName('pactus'))
     ..m<$core.int, $fixnum.Int64>(14, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'receivedBytes', entryClassName: 'PeerInfo.ReceivedBytesEntry', keyFieldType: $pb.PbFieldType.O3, valueFieldType: $pb.PbFieldType.O6, packageName: const $pb.PackageName('pactus'))
     ..aOS(15, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'address')
+    ..aInt64(16, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'latencyMs')
+    ..aOS(17, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'direction')
+    ..aOB(18, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'relayed')
     ..hasRequiredFields = false
   ;
 
@@ -280,6 +955,9 @@ class PeerInfo extends $pb.GeneratedMessage {
     $core.Map<$core.int, $fixnum.Int64>? sentBytes,
     $core.Map<$core.int, $fixnum.Int64>? receivedBytes,
     $core.String? address,
+    $fixnum.Int64? latencyMs,
+    $core.String? direction,
+    $core.bool? relayed,
   }) {
     final _result = create();
     if (status != null) {
@@ -327,6 +1005,15 @@ class PeerInfo extends $pb.GeneratedMessage {
     if (address != null) {
       _result.address = address;
     }
+    if (latencyMs != null) {
+      _result.latencyMs = latencyMs;
+    }
+    if (direction != null) {
+      _result.direction = direction;
+    }
+    if (relayed != null) {
+      _result.relayed = relayed;
+    }
     return _result;
   }
   factory PeerInfo.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
@@ -466,6 +1153,33 @@ class PeerInfo extends $pb.GeneratedMessage {
   $core.bool hasAddress() => $_has(14);
   @$pb.TagNumber(15)
   void clearAddress() => clearField(15);
+
+  @$pb.TagNumber(16)
+  $fixnum.Int64 get latencyMs => $_getI64(15);
+  @$pb.TagNumber(16)
+  set latencyMs($fixnum.Int64 v) { $_setInt64(15, v); }
+  @$pb.TagNumber(16)
+  $core.bool hasLatencyMs() => $_has(15);
+  @$pb.TagNumber(16)
+  void clearLatencyMs() => clearField(16);
+
+  @$pb.TagNumber(17)
+  $core.String get direction => $_getSZ(16);
+  @$pb.TagNumber(17)
+  set direction($core.String v) { $_setString(16, v); }
+  @$pb.TagNumber(17)
+  $core.bool hasDirection() => $_has(16);
+  @$pb.TagNumber(17)
+  void clearDirection() => clearField(17);
+
+  @$pb.TagNumber(18)
+  $core.bool get relayed => $_getBF(17);
+  @$pb.TagNumber(18)
+  set relayed($core.bool v) { $_setBool(17, v); }
+  @$pb.TagNumber(18)
+  $core.bool hasRelayed() => $_has(17);
+  @$pb.TagNumber(18)
+  void clearRelayed() => clearField(18);
 }
 
 class NetworkApi {
@@ -480,5 +1194,29 @@ class NetworkApi {
     var emptyResponse = GetNodeInfoResponse();
     return _client.invoke<GetNodeInfoResponse>(ctx, 'Network', 'GetNodeInfo', request, emptyResponse);
   }
+  $async.Future<BanPeerResponse> banPeer($pb.ClientContext? ctx, BanPeerRequest request) {
+    var emptyResponse = BanPeerResponse();
+    return _client.invoke<BanPeerResponse>(ctx, 'Network', 'BanPeer', request, emptyResponse);
+  }
+  $async.Future<UnbanPeerResponse> unbanPeer($pb.ClientContext? ctx, UnbanPeerRequest request) {
+    var emptyResponse = UnbanPeerResponse();
+    return _client.invoke<UnbanPeerResponse>(ctx, 'Network', 'UnbanPeer', request, emptyResponse);
+  }
+  $async.Future<GetRewardAddressesResponse> getRewardAddresses($pb.ClientContext? ctx, GetRewardAddressesRequest request) {
+    var emptyResponse = GetRewardAddressesResponse();
+    return _client.invoke<GetRewardAddressesResponse>(ctx, 'Network', 'GetRewardAddresses', request, emptyResponse);
+  }
+  $async.Future<PauseSyncResponse> pauseSync($pb.ClientContext? ctx, PauseSyncRequest request) {
+    var emptyResponse = PauseSyncResponse();
+    return _client.invoke<PauseSyncResponse>(ctx, 'Network', 'PauseSync', request, emptyResponse);
+  }
+  $async.Future<ResumeSyncResponse> resumeSync($pb.ClientContext? ctx, ResumeSyncRequest request) {
+    var emptyResponse = ResumeSyncResponse();
+    return _client.invoke<ResumeSyncResponse>(ctx, 'Network', 'ResumeSync', request, emptyResponse);
+  }
+  $async.Future<GetSessionsResponse> getSessions($pb.ClientContext? ctx, GetSessionsRequest request) {
+    var emptyResponse = GetSessionsResponse();
+    return _client.invoke<GetSessionsResponse>(ctx, 'Network', 'GetSessions', request, emptyResponse);
+  }
 }
 
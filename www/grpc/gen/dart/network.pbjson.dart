@@ -25,6 +25,7 @@ const GetNetworkInfoResponse$json = const {
     const {'1': 'peers', '3': 4, '4': 3, '5': 11, '6': '.pactus.PeerInfo', '10': 'peers'},
     const {'1': 'sent_bytes', '3': 5, '4': 3, '5': 11, '6': '.pactus.GetNetworkInfoResponse.SentBytesEntry', '10': 'sentBytes'},
     const {'1': 'received_bytes', '3': 6, '4': 3, '5': 11, '6': '.pactus.GetNetworkInfoResponse.ReceivedBytesEntry', '10': 'receivedBytes'},
+    const {'1': 'sessions', '3': 7, '4': 3, '5': 11, '6': '.pactus.SessionInfo', '10': 'sessions'},
   ],
   '3': const [GetNetworkInfoResponse_SentBytesEntry$json, GetNetworkInfoResponse_ReceivedBytesEntry$json],
 };
@@ -50,7 +51,41 @@ const GetNetworkInfoResponse_ReceivedBytesEntry$json = const {
 };
 
 /// Descriptor for `GetNetworkInfoResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getNetworkInfoResponseDescriptor = $convert.base64Decode('ChZHZXROZXR3b3JrSW5mb1Jlc3BvbnNlEigKEHRvdGFsX3NlbnRfYnl0ZXMYASABKAVSDnRvdGFsU2VudEJ5dGVzEjAKFHRvdGFsX3JlY2VpdmVkX2J5dGVzGAIgASgFUhJ0b3RhbFJlY2VpdmVkQnl0ZXMSHQoKc3RhcnRlZF9hdBgDIAEoA1IJc3RhcnRlZEF0EiYKBXBlZXJzGAQgAygLMhAucGFjdHVzLlBlZXJJbmZvUgVwZWVycxJMCgpzZW50X2J5dGVzGAUgAygLMi0ucGFjdHVzLkdldE5ldHdvcmtJbmZvUmVzcG9uc2UuU2VudEJ5dGVzRW50cnlSCXNlbnRCeXRlcxJYCg5yZWNlaXZlZF9ieXRlcxgGIAMoCzIxLnBhY3R1cy5HZXROZXR3b3JrSW5mb1Jlc3BvbnNlLlJlY2VpdmVkQnl0ZXNFbnRyeVINcmVjZWl2ZWRCeXRlcxo8Cg5TZW50Qnl0ZXNFbnRyeRIQCgNrZXkYASABKAVSA2tleRIUCgV2YWx1ZRgCIAEoA1IFdmFsdWU6AjgBGkAKElJlY2VpdmVkQnl0ZXNFbnRyeRIQCgNrZXkYASABKAVSA2tleRIUCgV2YWx1ZRgCIAEoA1IFdmFsdWU6AjgB');
+final $typed_data.Uint8List getNetworkInfoResponseDescriptor = $convert.base64Decode('ChZHZXROZXR3b3JrSW5mb1Jlc3BvbnNlEigKEHRvdGFsX3NlbnRfYnl0ZXMYASABKAVSDnRvdGFsU2VudEJ5dGVzEjAKFHRvdGFsX3JlY2VpdmVkX2J5dGVzGAIgASgFUhJ0b3RhbFJlY2VpdmVkQnl0ZXMSHQoKc3RhcnRlZF9hdBgDIAEoA1IJc3RhcnRlZEF0EiYKBXBlZXJzGAQgAygLMhAucGFjdHVzLlBlZXJJbmZvUgVwZWVycxJMCgpzZW50X2J5dGVzGAUgAygLMi0ucGFjdHVzLkdldE5ldHdvcmtJbmZvUmVzcG9uc2UuU2VudEJ5dGVzRW50cnlSCXNlbnRCeXRlcxJYCg5yZWNlaXZlZF9ieXRlcxgGIAMoCzIxLnBhY3R1cy5HZXROZXR3b3JrSW5mb1Jlc3BvbnNlLlJlY2VpdmVkQnl0ZXNFbnRyeVINcmVjZWl2ZWRCeXRlcxIvCghzZXNzaW9ucxgHIAMoCzITLnBhY3R1cy5TZXNzaW9uSW5mb1IIc2Vzc2lvbnMaPAoOU2VudEJ5dGVzRW50cnkSEAoDa2V5GAEgASgFUgNrZXkSFAoFdmFsdWUYAiABKANSBXZhbHVlOgI4ARpAChJSZWNlaXZlZEJ5dGVzRW50cnkSEAoDa2V5GAEgASgFUgNrZXkSFAoFdmFsdWUYAiABKANSBXZhbHVlOgI4AQ==');
+@$core.Deprecated('Use sessionInfoDescriptor instead')
+const SessionInfo$json = const {
+  '1': 'SessionInfo',
+  '2': const [
+    const {'1': 'session_id', '3': 1, '4': 1, '5': 5, '10': 'sessionId'},
+    const {'1': 'peer_id', '3': 2, '4': 1, '5': 12, '10': 'peerId'},
+    const {'1': 'requested_blocks', '3': 3, '4': 1, '5': 13, '10': 'requestedBlocks'},
+    const {'1': 'received_blocks', '3': 4, '4': 1, '5': 13, '10': 'receivedBlocks'},
+    const {'1': 'last_activity_at', '3': 5, '4': 1, '5': 3, '10': 'lastActivityAt'},
+    const {'1': 'opened_at', '3': 6, '4': 1, '5': 3, '10': 'openedAt'},
+    const {'1': 'age', '3': 7, '4': 1, '5': 3, '10': 'age'},
+    const {'1': 'last_response_code', '3': 8, '4': 1, '5': 9, '10': 'lastResponseCode'},
+  ],
+};
+
+/// Descriptor for `SessionInfo`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List sessionInfoDescriptor = $convert.base64Decode('CgtTZXNzaW9uSW5mbxIdCgpzZXNzaW9uX2lkGAEgASgFUglzZXNzaW9uSWQSFwoHcGVlcl9pZBgCIAEoDFIGcGVlcklkEikKEHJlcXVlc3RlZF9ibG9ja3MYAyABKA1SD3JlcXVlc3RlZEJsb2NrcxInCg9yZWNlaXZlZF9ibG9ja3MYBCABKA1SDnJlY2VpdmVkQmxvY2tzEigKEGxhc3RfYWN0aXZpdHlfYXQYBSABKANSDmxhc3RBY3Rpdml0eUF0EhsKCW9wZW5lZF9hdBgGIAEoA1IIb3BlbmVkQXQSEAoDYWdlGAcgASgDUgNhZ2USLAoSbGFzdF9yZXNwb25zZV9jb2RlGAggASgJUhBsYXN0UmVzcG9uc2VDb2Rl');
+@$core.Deprecated('Use getSessionsRequestDescriptor instead')
+const GetSessionsRequest$json = const {
+  '1': 'GetSessionsRequest',
+};
+
+/// Descriptor for `GetSessionsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getSessionsRequestDescriptor = $convert.base64Decode('ChJHZXRTZXNzaW9uc1JlcXVlc3Q=');
+@$core.Deprecated('Use getSessionsResponseDescriptor instead')
+const GetSessionsResponse$json = const {
+  '1': 'GetSessionsResponse',
+  '2': const [
+    const {'1': 'sessions', '3': 1, '4': 3, '5': 11, '6': '.pactus.SessionInfo', '10': 'sessions'},
+  ],
+};
+
+/// Descriptor for `GetSessionsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getSessionsResponseDescriptor = $convert.base64Decode('ChNHZXRTZXNzaW9uc1Jlc3BvbnNlEi8KCHNlc3Npb25zGAEgAygLMhMucGFjdHVzLlNlc3Npb25JbmZvUghzZXNzaW9ucw==');
 @$core.Deprecated('Use getNodeInfoRequestDescriptor instead')
 const GetNodeInfoRequest$json = const {
   '1': 'GetNodeInfoRequest',
@@ -65,11 +100,105 @@ const GetNodeInfoResponse$json = const {
     const {'1': 'moniker', '3': 1, '4': 1, '5': 9, '10': 'moniker'},
     const {'1': 'agent', '3': 2, '4': 1, '5': 9, '10': 'agent'},
     const {'1': 'peer_id', '3': 3, '4': 1, '5': 12, '10': 'peerId'},
+    const {'1': 'sync_paused', '3': 4, '4': 1, '5': 8, '10': 'syncPaused'},
   ],
 };
 
 /// Descriptor for `GetNodeInfoResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getNodeInfoResponseDescriptor = $convert.base64Decode('ChNHZXROb2RlSW5mb1Jlc3BvbnNlEhgKB21vbmlrZXIYASABKAlSB21vbmlrZXISFAoFYWdlbnQYAiABKAlSBWFnZW50EhcKB3BlZXJfaWQYAyABKAxSBnBlZXJJZA==');
+final $typed_data.Uint8List getNodeInfoResponseDescriptor = $convert.base64Decode('ChNHZXROb2RlSW5mb1Jlc3BvbnNlEhgKB21vbmlrZXIYASABKAlSB21vbmlrZXISFAoFYWdlbnQYAiABKAlSBWFnZW50EhcKB3BlZXJfaWQYAyABKAxSBnBlZXJJZBIfCgtzeW5jX3BhdXNlZBgEIAEoCFIKc3luY1BhdXNlZA==');
+@$core.Deprecated('Use banPeerRequestDescriptor instead')
+const BanPeerRequest$json = const {
+  '1': 'BanPeerRequest',
+  '2': const [
+    const {'1': 'peer_id', '3': 1, '4': 1, '5': 12, '10': 'peerId'},
+    const {'1': 'duration', '3': 2, '4': 1, '5': 3, '10': 'duration'},
+  ],
+};
+
+/// Descriptor for `BanPeerRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List banPeerRequestDescriptor = $convert.base64Decode('Cg5CYW5QZWVyUmVxdWVzdBIXCgdwZWVyX2lkGAEgASgMUgZwZWVySWQSGgoIZHVyYXRpb24YAiABKANSCGR1cmF0aW9u');
+@$core.Deprecated('Use banPeerResponseDescriptor instead')
+const BanPeerResponse$json = const {
+  '1': 'BanPeerResponse',
+};
+
+/// Descriptor for `BanPeerResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List banPeerResponseDescriptor = $convert.base64Decode('Cg9CYW5QZWVyUmVzcG9uc2U=');
+@$core.Deprecated('Use unbanPeerRequestDescriptor instead')
+const UnbanPeerRequest$json = const {
+  '1': 'UnbanPeerRequest',
+  '2': const [
+    const {'1': 'peer_id', '3': 1, '4': 1, '5': 12, '10': 'peerId'},
+  ],
+};
+
+/// Descriptor for `UnbanPeerRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List unbanPeerRequestDescriptor = $convert.base64Decode('ChBVbmJhblBlZXJSZXF1ZXN0EhcKB3BlZXJfaWQYASABKAxSBnBlZXJJZA==');
+@$core.Deprecated('Use unbanPeerResponseDescriptor instead')
+const UnbanPeerResponse$json = const {
+  '1': 'UnbanPeerResponse',
+};
+
+/// Descriptor for `UnbanPeerResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List unbanPeerResponseDescriptor = $convert.base64Decode('ChFVbmJhblBlZXJSZXNwb25zZQ==');
+@$core.Deprecated('Use pauseSyncRequestDescriptor instead')
+const PauseSyncRequest$json = const {
+  '1': 'PauseSyncRequest',
+};
+
+/// Descriptor for `PauseSyncRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List pauseSyncRequestDescriptor = $convert.base64Decode('ChBQYXVzZVN5bmNSZXF1ZXN0');
+@$core.Deprecated('Use pauseSyncResponseDescriptor instead')
+const PauseSyncResponse$json = const {
+  '1': 'PauseSyncResponse',
+};
+
+/// Descriptor for `PauseSyncResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List pauseSyncResponseDescriptor = $convert.base64Decode('ChFQYXVzZVN5bmNSZXNwb25zZQ==');
+@$core.Deprecated('Use resumeSyncRequestDescriptor instead')
+const ResumeSyncRequest$json = const {
+  '1': 'ResumeSyncRequest',
+};
+
+/// Descriptor for `ResumeSyncRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List resumeSyncRequestDescriptor = $convert.base64Decode('ChFSZXN1bWVTeW5jUmVxdWVzdA==');
+@$core.Deprecated('Use resumeSyncResponseDescriptor instead')
+const ResumeSyncResponse$json = const {
+  '1': 'ResumeSyncResponse',
+};
+
+/// Descriptor for `ResumeSyncResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List resumeSyncResponseDescriptor = $convert.base64Decode('ChJSZXN1bWVTeW5jUmVzcG9uc2U=');
+@$core.Deprecated('Use getRewardAddressesRequestDescriptor instead')
+const GetRewardAddressesRequest$json = const {
+  '1': 'GetRewardAddressesRequest',
+};
+
+/// Descriptor for `GetRewardAddressesRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRewardAddressesRequestDescriptor = $convert.base64Decode('ChlHZXRSZXdhcmRBZGRyZXNzZXNSZXF1ZXN0');
+@$core.Deprecated('Use getRewardAddressesResponseDescriptor instead')
+const GetRewardAddressesResponse$json = const {
+  '1': 'GetRewardAddressesResponse',
+  '2': const [
+    const {'1': 'validators', '3': 1, '4': 3, '5': 11, '6': '.pactus.ValidatorReward', '10': 'validators'},
+  ],
+};
+
+/// Descriptor for `GetRewardAddressesResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRewardAddressesResponseDescriptor = $convert.base64Decode('ChpHZXRSZXdhcmRBZGRyZXNzZXNSZXNwb25zZRI3Cgp2YWxpZGF0b3JzGAEgAygLMhcucGFjdHVzLlZhbGlkYXRvclJld2FyZFIKdmFsaWRhdG9ycw==');
+@$core.Deprecated('Use validatorRewardDescriptor instead')
+const ValidatorReward$json = const {
+  '1': 'ValidatorReward',
+  '2': const [
+    const {'1': 'validator_address', '3': 1, '4': 1, '5': 9, '10': 'validatorAddress'},
+    const {'1': 'validator_number', '3': 2, '4': 1, '5': 5, '10': 'validatorNumber'},
+    const {'1': 'reward_addresses', '3': 3, '4': 3, '5': 9, '10': 'rewardAddresses'},
+    const {'1': 'rotation_interval', '3': 4, '4': 1, '5': 13, '10': 'rotationInterval'},
+  ],
+};
+
+/// Descriptor for `ValidatorReward`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List validatorRewardDescriptor = $convert.base64Decode('Cg9WYWxpZGF0b3JSZXdhcmQSKwoRdmFsaWRhdG9yX2FkZHJlc3MYASABKAlSEHZhbGlkYXRvckFkZHJlc3MSKQoQdmFsaWRhdG9yX251bWJlchgCIAEoBVIPdmFsaWRhdG9yTnVtYmVyEikKEHJld2FyZF9hZGRyZXNzZXMYAyADKAlSD3Jld2FyZEFkZHJlc3NlcxIrChFyb3RhdGlvbl9pbnRlcnZhbBgEIAEoDVIQcm90YXRpb25JbnRlcnZhbA==');
 @$core.Deprecated('Use peerInfoDescriptor instead')
 const PeerInfo$json = const {
   '1': 'PeerInfo',
@@ -89,6 +218,9 @@ const PeerInfo$json = const {
     const {'1': 'sent_bytes', '3': 13, '4': 3, '5': 11, '6': '.pactus.PeerInfo.SentBytesEntry', '10': 'sentBytes'},
     const {'1': 'received_bytes', '3': 14, '4': 3, '5': 11, '6': '.pactus.PeerInfo.ReceivedBytesEntry', '10': 'receivedBytes'},
     const {'1': 'address', '3': 15, '4': 1, '5': 9, '10': 'address'},
+    const {'1': 'latency_ms', '3': 16, '4': 1, '5': 3, '10': 'latencyMs'},
+    const {'1': 'direction', '3': 17, '4': 1, '5': 9, '10': 'direction'},
+    const {'1': 'relayed', '3': 18, '4': 1, '5': 8, '10': 'relayed'},
   ],
   '3': const [PeerInfo_SentBytesEntry$json, PeerInfo_ReceivedBytesEntry$json],
 };
@@ -114,12 +246,18 @@ const PeerInfo_ReceivedBytesEntry$json = const {
 };
 
 /// Descriptor for `PeerInfo`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List peerInfoDescriptor = $convert.base64Decode('CghQZWVySW5mbxIWCgZzdGF0dXMYASABKAVSBnN0YXR1cxIYCgdtb25pa2VyGAIgASgJUgdtb25pa2VyEhQKBWFnZW50GAMgASgJUgVhZ2VudBIXCgdwZWVyX2lkGAQgASgMUgZwZWVySWQSJQoOY29uc2Vuc3VzX2tleXMYBSADKAlSDWNvbnNlbnN1c0tleXMSGgoIc2VydmljZXMYBiABKA1SCHNlcnZpY2VzEiYKD2xhc3RfYmxvY2tfaGFzaBgHIAEoDFINbGFzdEJsb2NrSGFzaBIWCgZoZWlnaHQYCCABKA1SBmhlaWdodBIrChFyZWNlaXZlZF9tZXNzYWdlcxgJIAEoBVIQcmVjZWl2ZWRNZXNzYWdlcxIpChBpbnZhbGlkX21lc3NhZ2VzGAogASgFUg9pbnZhbGlkTWVzc2FnZXMSGwoJbGFzdF9zZW50GAsgASgDUghsYXN0U2VudBIjCg1sYXN0X3JlY2VpdmVkGAwgASgDUgxsYXN0UmVjZWl2ZWQSPgoKc2VudF9ieXRlcxgNIAMoCzIfLnBhY3R1cy5QZWVySW5mby5TZW50Qnl0ZXNFbnRyeVIJc2VudEJ5dGVzEkoKDnJlY2VpdmVkX2J5dGVzGA4gAygLMiMucGFjdHVzLlBlZXJJbmZvLlJlY2VpdmVkQnl0ZXNFbnRyeVINcmVjZWl2ZWRCeXRlcxIYCgdhZGRyZXNzGA8gASgJUgdhZGRyZXNzGjwKDlNlbnRCeXRlc0VudHJ5EhAKA2tleRgBIAEoBVIDa2V5EhQKBXZhbHVlGAIgASgDUgV2YWx1ZToCOAEaQAoSUmVjZWl2ZWRCeXRlc0VudHJ5EhAKA2tleRgBIAEoBVIDa2V5EhQKBXZhbHVlGAIgASgDUgV2YWx1ZToCOAE=');
+final $typed_data.Uint8List peerInfoDescriptor = $convert.base64Decode('CghQZWVySW5mbxIWCgZzdGF0dXMYASABKAVSBnN0YXR1cxIYCgdtb25pa2VyGAIgASgJUgdtb25pa2VyEhQKBWFnZW50GAMgASgJUgVhZ2VudBIXCgdwZWVyX2lkGAQgASgMUgZwZWVySWQSJQoOY29uc2Vuc3VzX2tleXMYBSADKAlSDWNvbnNlbnN1c0tleXMSGgoIc2VydmljZXMYBiABKA1SCHNlcnZpY2VzEiYKD2xhc3RfYmxvY2tfaGFzaBgHIAEoDFINbGFzdEJsb2NrSGFzaBIWCgZoZWlnaHQYCCABKA1SBmhlaWdodBIrChFyZWNlaXZlZF9tZXNzYWdlcxgJIAEoBVIQcmVjZWl2ZWRNZXNzYWdlcxIpChBpbnZhbGlkX21lc3NhZ2VzGAogASgFUg9pbnZhbGlkTWVzc2FnZXMSGwoJbGFzdF9zZW50GAsgASgDUghsYXN0U2VudBIjCg1sYXN0X3JlY2VpdmVkGAwgASgDUgxsYXN0UmVjZWl2ZWQSPgoKc2VudF9ieXRlcxgNIAMoCzIfLnBhY3R1cy5QZWVySW5mby5TZW50Qnl0ZXNFbnRyeVIJc2VudEJ5dGVzEkoKDnJlY2VpdmVkX2J5dGVzGA4gAygLMiMucGFjdHVzLlBlZXJJbmZvLlJlY2VpdmVkQnl0ZXNFbnRyeVINcmVjZWl2ZWRCeXRlcxIYCgdhZGRyZXNzGA8gASgJUgdhZGRyZXNzEh0KCmxhdGVuY3lfbXMYECABKANSCWxhdGVuY3lNcxIcCglkaXJlY3Rpb24YESABKAlSCWRpcmVjdGlvbhIYCgdyZWxheWVkGBIgASgIUgdyZWxheWVkGjwKDlNlbnRCeXRlc0VudHJ5EhAKA2tleRgBIAEoBVIDa2V5EhQKBXZhbHVlGAIgASgDUgV2YWx1ZToCOAEaQAoSUmVjZWl2ZWRCeXRlc0VudHJ5EhAKA2tleRgBIAEoBVIDa2V5EhQKBXZhbHVlGAIgASgDUgV2YWx1ZToCOAE=');
 const $core.Map<$core.String, $core.dynamic> NetworkServiceBase$json = const {
   '1': 'Network',
   '2': const [
     const {'1': 'GetNetworkInfo', '2': '.pactus.GetNetworkInfoRequest', '3': '.pactus.GetNetworkInfoResponse'},
     const {'1': 'GetNodeInfo', '2': '.pactus.GetNodeInfoRequest', '3': '.pactus.GetNodeInfoResponse'},
+    const {'1': 'BanPeer', '2': '.pactus.BanPeerRequest', '3': '.pactus.BanPeerResponse'},
+    const {'1': 'UnbanPeer', '2': '.pactus.UnbanPeerRequest', '3': '.pactus.UnbanPeerResponse'},
+    const {'1': 'GetRewardAddresses', '2': '.pactus.GetRewardAddressesRequest', '3': '.pactus.GetRewardAddressesResponse'},
+    const {'1': 'PauseSync', '2': '.pactus.PauseSyncRequest', '3': '.pactus.PauseSyncResponse'},
+    const {'1': 'ResumeSync', '2': '.pactus.ResumeSyncRequest', '3': '.pactus.ResumeSyncResponse'},
+    const {'1': 'GetSessions', '2': '.pactus.GetSessionsRequest', '3': '.pactus.GetSessionsResponse'},
   ],
 };
 
@@ -132,9 +270,23 @@ const $core.Map<$core.String, $core.Map<$core.String, $core.dynamic>> NetworkSer
   '.pactus.PeerInfo.ReceivedBytesEntry': PeerInfo_ReceivedBytesEntry$json,
   '.pactus.GetNetworkInfoResponse.SentBytesEntry': GetNetworkInfoResponse_SentBytesEntry$json,
   '.pactus.GetNetworkInfoResponse.ReceivedBytesEntry': GetNetworkInfoResponse_ReceivedBytesEntry$json,
+  '.pactus.SessionInfo': SessionInfo$json,
   '.pactus.GetNodeInfoRequest': GetNodeInfoRequest$json,
   '.pactus.GetNodeInfoResponse': GetNodeInfoResponse$json,
+  '.pactus.BanPeerRequest': BanPeerRequest$json,
+  '.pactus.BanPeerResponse': BanPeerResponse$json,
+  '.pactus.UnbanPeerRequest': UnbanPeerRequest$json,
+  '.pactus.UnbanPeerResponse': UnbanPeerResponse$json,
+  '.pactus.GetRewardAddressesRequest': GetRewardAddressesRequest$json,
+  '.pactus.GetRewardAddressesResponse': GetRewardAddressesResponse$json,
+  '.pactus.ValidatorReward': ValidatorReward$json,
+  '.pactus.PauseSyncRequest': PauseSyncRequest$json,
+  '.pactus.PauseSyncResponse': PauseSyncResponse$json,
+  '.pactus.ResumeSyncRequest': ResumeSyncRequest$json,
+  '.pactus.ResumeSyncResponse': ResumeSyncResponse$json,
+  '.pactus.GetSessionsRequest': GetSessionsRequest$json,
+  '.pactus.GetSessionsResponse': GetSessionsResponse$json,
 };
 
 /// Descriptor for `Network`. Decode as a `google.protobuf.ServiceDescriptorProto`.
-final $typed_data.Uint8List networkServiceDescriptor = $convert.base64Decode('CgdOZXR3b3JrEk8KDkdldE5ldHdvcmtJbmZvEh0ucGFjdHVzLkdldE5ldHdvcmtJbmZvUmVxdWVzdBoeLnBhY3R1cy5HZXROZXR3b3JrSW5mb1Jlc3BvbnNlEkYKC0dldE5vZGVJbmZvEhoucGFjdHVzLkdldE5vZGVJbmZvUmVxdWVzdBobLnBhY3R1cy5HZXROb2RlSW5mb1Jlc3BvbnNl');
+final $typed_data.Uint8List networkServiceDescriptor = $convert.base64Decode('CgdOZXR3b3JrEk8KDkdldE5ldHdvcmtJbmZvEh0ucGFjdHVzLkdldE5ldHdvcmtJbmZvUmVxdWVzdBoeLnBhY3R1cy5HZXROZXR3b3JrSW5mb1Jlc3BvbnNlEkYKC0dldE5vZGVJbmZvEhoucGFjdHVzLkdldE5vZGVJbmZvUmVxdWVzdBobLnBhY3R1cy5HZXROb2RlSW5mb1Jlc3BvbnNlEjoKB0JhblBlZXISFi5wYWN0dXMuQmFuUGVlclJlcXVlc3QaFy5wYWN0dXMuQmFuUGVlclJlc3BvbnNlEkAKCVVuYmFuUGVlchIYLnBhY3R1cy5VbmJhblBlZXJSZXF1ZXN0GhkucGFjdHVzLlVuYmFuUGVlclJlc3BvbnNlElsKEkdldFJld2FyZEFkZHJlc3NlcxIhLnBhY3R1cy5HZXRSZXdhcmRBZGRyZXNzZXNSZXF1ZXN0GiIucGFjdHVzLkdldFJld2FyZEFkZHJlc3Nlc1Jlc3BvbnNlEkAKCVBhdXNlU3luYxIYLnBhY3R1cy5QYXVzZVN5bmNSZXF1ZXN0GhkucGFjdHVzLlBhdXNlU3luY1Jlc3BvbnNlEkMKClJlc3VtZVN5bmMSGS5wYWN0dXMuUmVzdW1lU3luY1JlcXVlc3QaGi5wYWN0dXMuUmVzdW1lU3luY1Jlc3BvbnNlEkYKC0dldFNlc3Npb25zEhoucGFjdHVzLkdldFNlc3Npb25zUmVxdWVzdBobLnBhY3R1cy5HZXRTZXNzaW9uc1Jlc3BvbnNl');
@@ -18,11 +18,23 @@ export 'network.pb.dart';
 abstract class NetworkServiceBase extends $pb.GeneratedService {
   $async.Future<$2.GetNetworkInfoResponse> getNetworkInfo($pb.ServerContext ctx, $2.GetNetworkInfoRequest request);
   $async.Future<$2.GetNodeInfoResponse> getNodeInfo($pb.ServerContext ctx, $2.GetNodeInfoRequest request);
+  $async.Future<$2.BanPeerResponse> banPeer($pb.ServerContext ctx, $2.BanPeerRequest request);
+  $async.Future<$2.UnbanPeerResponse> unbanPeer($pb.ServerContext ctx, $2.UnbanPeerRequest request);
+  $async.Future<$2.GetRewardAddressesResponse> getRewardAddresses($pb.ServerContext ctx, $2.GetRewardAddressesRequest request);
+  $async.Future<$2.PauseSyncResponse> pauseSync($pb.ServerContext ctx, $2.PauseSyncRequest request);
+  $async.Future<$2.ResumeSyncResponse> resumeSync($pb.ServerContext ctx, $2.ResumeSyncRequest request);
+  $async.Future<$2.GetSessionsResponse> getSessions($pb.ServerContext ctx, $2.GetSessionsRequest request);
 
   $pb.GeneratedMessage createRequest($core.String method) {
     switch (method) {
       case 'GetNetworkInfo': return $2.GetNetworkInfoRequest();
       case 'GetNodeInfo': return $2.GetNodeInfoRequest();
+      case 'BanPeer': return $2.BanPeerRequest();
+      case 'UnbanPeer': return $2.UnbanPeerRequest();
+      case 'GetRewardAddresses': return $2.GetRewardAddressesRequest();
+      case 'PauseSync': return $2.PauseSyncRequest();
+      case 'ResumeSync': return $2.ResumeSyncRequest();
+      case 'GetSessions': return $2.GetSessionsRequest();
       default: throw $core.ArgumentError('Unknown method: $method');
     }
   }
@@ -31,6 +43,12 @@ abstract class NetworkServiceBase extends $pb.GeneratedService {
     switch (method) {
       case 'GetNetworkInfo': return this.getNetworkInfo(ctx, request as $2.GetNetworkInfoRequest);
       case 'GetNodeInfo': return this.getNodeInfo(ctx, request as $2.GetNodeInfoRequest);
+      case 'BanPeer': return this.banPeer(ctx, request as $2.BanPeerRequest);
+      case 'UnbanPeer': return this.unbanPeer(ctx, request as $2.UnbanPeerRequest);
+      case 'GetRewardAddresses': return this.getRewardAddresses(ctx, request as $2.GetRewardAddressesRequest);
+      case 'PauseSync': return this.pauseSync(ctx, request as $2.PauseSyncRequest);
+      case 'ResumeSync': return this.resumeSync(ctx, request as $2.ResumeSyncRequest);
+      case 'GetSessions': return this.getSessions(ctx, request as $2.GetSessionsRequest);
       default: throw $core.ArgumentError('Unknown method: $method');
     }
   }
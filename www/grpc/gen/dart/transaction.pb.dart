@@ -153,6 +153,328 @@ class GetTransactionResponse extends $pb.GeneratedMessage {
   void clearBlockTime() => clearField(13);
 }
 
+class GetTransactionInclusionRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetTransactionInclusionRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false
+  ;
+
+  GetTransactionInclusionRequest._() : super();
+  factory GetTransactionInclusionRequest({
+    $core.List<$core.int>? id,
+  }) {
+    final _result = create();
+    if (id != null) {
+      _result.id = id;
+    }
+    return _result;
+  }
+  factory GetTransactionInclusionRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetTransactionInclusionRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetTransactionInclusionRequest clone() => GetTransactionInclusionRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetTransactionInclusionRequest copyWith(void Function(GetTransactionInclusionRequest) updates) => super.copyWith((message) => updates(message as GetTransactionInclusionRequest)) as GetTransactionInclusionRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionInclusionRequest create() => GetTransactionInclusionRequest._();
+  GetTransactionInclusionRequest createEmptyInstance() => create();
+  static $pb.PbList<GetTransactionInclusionRequest> createRepeated() => $pb.PbList<GetTransactionInclusionRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionInclusionRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetTransactionInclusionRequest>(create);
+  static GetTransactionInclusionRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get id => $_getN(0);
+  @$pb.TagNumber(1)
+  set id($core.List<$core.int> v) { $_setBytes(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearId() => clearField(1);
+}
+
+class GetTransactionInclusionResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetTransactionInclusionResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockHeight', $pb.PbFieldType.OU3)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockHash', $pb.PbFieldType.OY)
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockTime', $pb.PbFieldType.OU3)
+    ..a<$core.int>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'index', $pb.PbFieldType.O3)
+    ..hasRequiredFields = false
+  ;
+
+  GetTransactionInclusionResponse._() : super();
+  factory GetTransactionInclusionResponse({
+    $core.int? blockHeight,
+    $core.List<$core.int>? blockHash,
+    $core.int? blockTime,
+    $core.int? index,
+  }) {
+    final _result = create();
+    if (blockHeight != null) {
+      _result.blockHeight = blockHeight;
+    }
+    if (blockHash != null) {
+      _result.blockHash = blockHash;
+    }
+    if (blockTime != null) {
+      _result.blockTime = blockTime;
+    }
+    if (index != null) {
+      _result.index = index;
+    }
+    return _result;
+  }
+  factory GetTransactionInclusionResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetTransactionInclusionResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetTransactionInclusionResponse clone() => GetTransactionInclusionResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetTransactionInclusionResponse copyWith(void Function(GetTransactionInclusionResponse) updates) => super.copyWith((message) => updates(message as GetTransactionInclusionResponse)) as GetTransactionInclusionResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionInclusionResponse create() => GetTransactionInclusionResponse._();
+  GetTransactionInclusionResponse createEmptyInstance() => create();
+  static $pb.PbList<GetTransactionInclusionResponse> createRepeated() => $pb.PbList<GetTransactionInclusionResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionInclusionResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetTransactionInclusionResponse>(create);
+  static GetTransactionInclusionResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get blockHeight => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set blockHeight($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasBlockHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearBlockHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get blockHash => $_getN(1);
+  @$pb.TagNumber(2)
+  set blockHash($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasBlockHash() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearBlockHash() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get blockTime => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set blockTime($core.int v) { $_setUnsignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasBlockTime() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBlockTime() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get index => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set index($core.int v) { $_setSignedInt32(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasIndex() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearIndex() => clearField(4);
+}
+
+class GetTransactionProofRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetTransactionProofRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false
+  ;
+
+  GetTransactionProofRequest._() : super();
+  factory GetTransactionProofRequest({
+    $core.List<$core.int>? id,
+  }) {
+    final _result = create();
+    if (id != null) {
+      _result.id = id;
+    }
+    return _result;
+  }
+  factory GetTransactionProofRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetTransactionProofRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetTransactionProofRequest clone() => GetTransactionProofRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetTransactionProofRequest copyWith(void Function(GetTransactionProofRequest) updates) => super.copyWith((message) => updates(message as GetTransactionProofRequest)) as GetTransactionProofRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionProofRequest create() => GetTransactionProofRequest._();
+  GetTransactionProofRequest createEmptyInstance() => create();
+  static $pb.PbList<GetTransactionProofRequest> createRepeated() => $pb.PbList<GetTransactionProofRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionProofRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetTransactionProofRequest>(create);
+  static GetTransactionProofRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<$core.int> get id => $_getN(0);
+  @$pb.TagNumber(1)
+  set id($core.List<$core.int> v) { $_setBytes(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearId() => clearField(1);
+}
+
+class GetTransactionProofResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetTransactionProofResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockHeight', $pb.PbFieldType.OU3)
+    ..a<$core.List<$core.int>>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockHash', $pb.PbFieldType.OY)
+    ..a<$core.List<$core.int>>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'blockHeader', $pb.PbFieldType.OY)
+    ..a<$core.List<$core.int>>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'prevCertHash', $pb.PbFieldType.OY)
+    ..a<$core.List<$core.int>>(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txsRoot', $pb.PbFieldType.OY)
+    ..a<$core.int>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'txCount', $pb.PbFieldType.O3)
+    ..a<$core.int>(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'index', $pb.PbFieldType.O3)
+    ..p<$core.List<$core.int>>(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'proof', $pb.PbFieldType.PY)
+    ..hasRequiredFields = false
+  ;
+
+  GetTransactionProofResponse._() : super();
+  factory GetTransactionProofResponse({
+    $core.int? blockHeight,
+    $core.List<$core.int>? blockHash,
+    $core.List<$core.int>? blockHeader,
+    $core.List<$core.int>? prevCertHash,
+    $core.List<$core.int>? txsRoot,
+    $core.int? txCount,
+    $core.int? index,
+    $core.Iterable<$core.List<$core.int>>? proof,
+  }) {
+    final _result = create();
+    if (blockHeight != null) {
+      _result.blockHeight = blockHeight;
+    }
+    if (blockHash != null) {
+      _result.blockHash = blockHash;
+    }
+    if (blockHeader != null) {
+      _result.blockHeader = blockHeader;
+    }
+    if (prevCertHash != null) {
+      _result.prevCertHash = prevCertHash;
+    }
+    if (txsRoot != null) {
+      _result.txsRoot = txsRoot;
+    }
+    if (txCount != null) {
+      _result.txCount = txCount;
+    }
+    if (index != null) {
+      _result.index = index;
+    }
+    if (proof != null) {
+      _result.proof.addAll(proof);
+    }
+    return _result;
+  }
+  factory GetTransactionProofResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetTransactionProofResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetTransactionProofResponse clone() => GetTransactionProofResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetTransactionProofResponse copyWith(void Function(GetTransactionProofResponse) updates) => super.copyWith((message) => updates(message as GetTransactionProofResponse)) as GetTransactionProofResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionProofResponse create() => GetTransactionProofResponse._();
+  GetTransactionProofResponse createEmptyInstance() => create();
+  static $pb.PbList<GetTransactionProofResponse> createRepeated() => $pb.PbList<GetTransactionProofResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetTransactionProofResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetTransactionProofResponse>(create);
+  static GetTransactionProofResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get blockHeight => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set blockHeight($core.int v) { $_setUnsignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasBlockHeight() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearBlockHeight() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<$core.int> get blockHash => $_getN(1);
+  @$pb.TagNumber(2)
+  set blockHash($core.List<$core.int> v) { $_setBytes(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasBlockHash() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearBlockHash() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.List<$core.int> get blockHeader => $_getN(2);
+  @$pb.TagNumber(3)
+  set blockHeader($core.List<$core.int> v) { $_setBytes(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasBlockHeader() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBlockHeader() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.List<$core.int> get prevCertHash => $_getN(3);
+  @$pb.TagNumber(4)
+  set prevCertHash($core.List<$core.int> v) { $_setBytes(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasPrevCertHash() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearPrevCertHash() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.List<$core.int> get txsRoot => $_getN(4);
+  @$pb.TagNumber(5)
+  set txsRoot($core.List<$core.int> v) { $_setBytes(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasTxsRoot() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearTxsRoot() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.int get txCount => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set txCount($core.int v) { $_setSignedInt32(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasTxCount() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearTxCount() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.int get index => $_getIZ(6);
+  @$pb.TagNumber(7)
+  set index($core.int v) { $_setSignedInt32(6, v); }
+  @$pb.TagNumber(7)
+  $core.bool hasIndex() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearIndex() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.List<$core.List<$core.int>> get proof => $_getList(7);
+}
+
 class CalculateFeeRequest extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CalculateFeeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..aInt64(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'amount')
@@ -261,6 +583,156 @@ class CalculateFeeResponse extends $pb.GeneratedMessage {
   void clearFee() => clearField(1);
 }
 
+class EstimateFeeRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'EstimateFeeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aInt64(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'amount')
+    ..e<PayloadType>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'payloadType', $pb.PbFieldType.OE, protoName: 'payloadType', defaultOrMaker: PayloadType.UNKNOWN, valueOf: PayloadType.valueOf, enumValues: PayloadType.values)
+    ..hasRequiredFields = false
+  ;
+
+  EstimateFeeRequest._() : super();
+  factory EstimateFeeRequest({
+    $fixnum.Int64? amount,
+    PayloadType? payloadType,
+  }) {
+    final _result = create();
+    if (amount != null) {
+      _result.amount = amount;
+    }
+    if (payloadType != null) {
+      _result.payloadType = payloadType;
+    }
+    return _result;
+  }
+  factory EstimateFeeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory EstimateFeeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  EstimateFeeRequest clone() => EstimateFeeRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  EstimateFeeRequest copyWith(void Function(EstimateFeeRequest) updates) => super.copyWith((message) => updates(message as EstimateFeeRequest)) as EstimateFeeRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static EstimateFeeRequest create() => EstimateFeeRequest._();
+  EstimateFeeRequest createEmptyInstance() => create();
+  static $pb.PbList<EstimateFeeRequest> createRepeated() => $pb.PbList<EstimateFeeRequest>();
+  @$core.pragma('dart2js:noInline')
+  static EstimateFeeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<EstimateFeeRequest>(create);
+  static EstimateFeeRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get amount => $_getI64(0);
+  @$pb.TagNumber(1)
+  set amount($fixnum.Int64 v) { $_setInt64(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasAmount() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearAmount() => clearField(1);
+
+  @$pb.TagNumber(2)
+  PayloadType get payloadType => $_getN(1);
+  @$pb.TagNumber(2)
+  set payloadType(PayloadType v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasPayloadType() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearPayloadType() => clearField(2);
+}
+
+class EstimateFeeResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'EstimateFeeResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..aInt64(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'fee')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'minFee')
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pendingTxs', $pb.PbFieldType.O3)
+    ..a<$core.double>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'poolUtilization', $pb.PbFieldType.OD)
+    ..hasRequiredFields = false
+  ;
+
+  EstimateFeeResponse._() : super();
+  factory EstimateFeeResponse({
+    $fixnum.Int64? fee,
+    $fixnum.Int64? minFee,
+    $core.int? pendingTxs,
+    $core.double? poolUtilization,
+  }) {
+    final _result = create();
+    if (fee != null) {
+      _result.fee = fee;
+    }
+    if (minFee != null) {
+      _result.minFee = minFee;
+    }
+    if (pendingTxs != null) {
+      _result.pendingTxs = pendingTxs;
+    }
+    if (poolUtilization != null) {
+      _result.poolUtilization = poolUtilization;
+    }
+    return _result;
+  }
+  factory EstimateFeeResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory EstimateFeeResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  EstimateFeeResponse clone() => EstimateFeeResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  EstimateFeeResponse copyWith(void Function(EstimateFeeResponse) updates) => super.copyWith((message) => updates(message as EstimateFeeResponse)) as EstimateFeeResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static EstimateFeeResponse create() => EstimateFeeResponse._();
+  EstimateFeeResponse createEmptyInstance() => create();
+  static $pb.PbList<EstimateFeeResponse> createRepeated() => $pb.PbList<EstimateFeeResponse>();
+  @$core.pragma('dart2js:noInline')
+  static EstimateFeeResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<EstimateFeeResponse>(create);
+  static EstimateFeeResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get fee => $_getI64(0);
+  @$pb.TagNumber(1)
+  set fee($fixnum.Int64 v) { $_setInt64(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasFee() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearFee() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get minFee => $_getI64(1);
+  @$pb.TagNumber(2)
+  set minFee($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasMinFee() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMinFee() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get pendingTxs => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set pendingTxs($core.int v) { $_setSignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasPendingTxs() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearPendingTxs() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.double get poolUtilization => $_getN(3);
+  @$pb.TagNumber(4)
+  set poolUtilization($core.double v) { $_setDouble(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasPoolUtilization() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearPoolUtilization() => clearField(4);
+}
+
 class SendRawTransactionRequest extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SendRawTransactionRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..a<$core.List<$core.int>>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'data', $pb.PbFieldType.OY)
@@ -355,6 +827,132 @@ class SendRawTransactionResponse extends $pb.GeneratedMessage {
   void clearId() => clearField(2);
 }
 
+class GetTxPoolStatsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetTxPoolStatsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  GetTxPoolStatsRequest._() : super();
+  factory GetTxPoolStatsRequest() => create();
+  factory GetTxPoolStatsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetTxPoolStatsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetTxPoolStatsRequest clone() => GetTxPoolStatsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetTxPoolStatsRequest copyWith(void Function(GetTxPoolStatsRequest) updates) => super.copyWith((message) => updates(message as GetTxPoolStatsRequest)) as GetTxPoolStatsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetTxPoolStatsRequest create() => GetTxPoolStatsRequest._();
+  GetTxPoolStatsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetTxPoolStatsRequest> createRepeated() => $pb.PbList<GetTxPoolStatsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetTxPoolStatsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetTxPoolStatsRequest>(create);
+  static GetTxPoolStatsRequest? _defaultInstance;
+}
+
+class GetTxPoolStatsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetTxPoolStatsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
+    ..a<$core.int>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'total', $pb.PbFieldType.O3)
+    ..m<$core.int, $core.int>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'perType', entryClassName: 'GetTxPoolStatsResponse.PerTypeEntry', keyFieldType: $pb.PbFieldType.O3, valueFieldType: $pb.PbFieldType.O3, packageName: const $pb.PackageName('pactus'))
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'capacity', $pb.PbFieldType.O3)
+    ..a<$core.double>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'utilization', $pb.PbFieldType.OD)
+    ..aInt64(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'oldestTxAgeMs')
+    ..hasRequiredFields = false
+  ;
+
+  GetTxPoolStatsResponse._() : super();
+  factory GetTxPoolStatsResponse({
+    $core.int? total,
+    $core.Map<$core.int, $core.int>? perType,
+    $core.int? capacity,
+    $core.double? utilization,
+    $fixnum.Int64? oldestTxAgeMs,
+  }) {
+    final _result = create();
+    if (total != null) {
+      _result.total = total;
+    }
+    if (perType != null) {
+      _result.perType.addAll(perType);
+    }
+    if (capacity != null) {
+      _result.capacity = capacity;
+    }
+    if (utilization != null) {
+      _result.utilization = utilization;
+    }
+    if (oldestTxAgeMs != null) {
+      _result.oldestTxAgeMs = oldestTxAgeMs;
+    }
+    return _result;
+  }
+  factory GetTxPoolStatsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetTxPoolStatsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetTxPoolStatsResponse clone() => GetTxPoolStatsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetTxPoolStatsResponse copyWith(void Function(GetTxPoolStatsResponse) updates) => super.copyWith((message) => updates(message as GetTxPoolStatsResponse)) as GetTxPoolStatsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetTxPoolStatsResponse create() => GetTxPoolStatsResponse._();
+  GetTxPoolStatsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetTxPoolStatsResponse> createRepeated() => $pb.PbList<GetTxPoolStatsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetTxPoolStatsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetTxPoolStatsResponse>(create);
+  static GetTxPoolStatsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.int get total => $_getIZ(0);
+  @$pb.TagNumber(1)
+  set total($core.int v) { $_setSignedInt32(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasTotal() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTotal() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.Map<$core.int, $core.int> get perType => $_getMap(1);
+
+  @$pb.TagNumber(3)
+  $core.int get capacity => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set capacity($core.int v) { $_setSignedInt32(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasCapacity() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCapacity() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.double get utilization => $_getN(3);
+  @$pb.TagNumber(4)
+  set utilization($core.double v) { $_setDouble(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasUtilization() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearUtilization() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get oldestTxAgeMs => $_getI64(4);
+  @$pb.TagNumber(5)
+  set oldestTxAgeMs($fixnum.Int64 v) { $_setInt64(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasOldestTxAgeMs() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearOldestTxAgeMs() => clearField(5);
+}
+
 class PayloadTransfer extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'PayloadTransfer', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'pactus'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sender')
@@ -970,13 +1568,29 @@ class TransactionApi {
     var emptyResponse = GetTransactionResponse();
     return _client.invoke<GetTransactionResponse>(ctx, 'Transaction', 'GetTransaction', request, emptyResponse);
   }
+  $async.Future<GetTransactionInclusionResponse> getTransactionInclusion($pb.ClientContext? ctx, GetTransactionInclusionRequest request) {
+    var emptyResponse = GetTransactionInclusionResponse();
+    return _client.invoke<GetTransactionInclusionResponse>(ctx, 'Transaction', 'GetTransactionInclusion', request, emptyResponse);
+  }
+  $async.Future<GetTransactionProofResponse> getTransactionProof($pb.ClientContext? ctx, GetTransactionProofRequest request) {
+    var emptyResponse = GetTransactionProofResponse();
+    return _client.invoke<GetTransactionProofResponse>(ctx, 'Transaction', 'GetTransactionProof', request, emptyResponse);
+  }
   $async.Future<CalculateFeeResponse> calculateFee($pb.ClientContext? ctx, CalculateFeeRequest request) {
     var emptyResponse = CalculateFeeResponse();
     return _client.invoke<CalculateFeeResponse>(ctx, 'Transaction', 'CalculateFee', request, emptyResponse);
   }
+  $async.Future<EstimateFeeResponse> estimateFee($pb.ClientContext? ctx, EstimateFeeRequest request) {
+    var emptyResponse = EstimateFeeResponse();
+    return _client.invoke<EstimateFeeResponse>(ctx, 'Transaction', 'EstimateFee', request, emptyResponse);
+  }
   $async.Future<SendRawTransactionResponse> sendRawTransaction($pb.ClientContext? ctx, SendRawTransactionRequest request) {
     var emptyResponse = SendRawTransactionResponse();
     return _client.invoke<SendRawTransactionResponse>(ctx, 'Transaction', 'SendRawTransaction', request, emptyResponse);
   }
+  $async.Future<GetTxPoolStatsResponse> getTxPoolStats($pb.ClientContext? ctx, GetTxPoolStatsRequest request) {
+    var emptyResponse = GetTxPoolStatsResponse();
+    return _client.invoke<GetTxPoolStatsResponse>(ctx, 'Transaction', 'GetTxPoolStats', request, emptyResponse);
+  }
 }
 
@@ -57,6 +57,56 @@ const GetTransactionResponse$json = const {
 
 /// Descriptor for `GetTransactionResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getTransactionResponseDescriptor = $convert.base64Decode('ChZHZXRUcmFuc2FjdGlvblJlc3BvbnNlEiEKDGJsb2NrX2hlaWdodBgMIAEoDVILYmxvY2tIZWlnaHQSHQoKYmxvY2tfdGltZRgNIAEoDVIJYmxvY2tUaW1lEjkKC3RyYW5zYWN0aW9uGAMgASgLMhcucGFjdHVzLlRyYW5zYWN0aW9uSW5mb1ILdHJhbnNhY3Rpb24=');
+@$core.Deprecated('Use getTransactionInclusionRequestDescriptor instead')
+const GetTransactionInclusionRequest$json = const {
+  '1': 'GetTransactionInclusionRequest',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 12, '10': 'id'},
+  ],
+};
+
+/// Descriptor for `GetTransactionInclusionRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getTransactionInclusionRequestDescriptor = $convert.base64Decode('Ch5HZXRUcmFuc2FjdGlvbkluY2x1c2lvblJlcXVlc3QSDgoCaWQYASABKAxSAmlk');
+@$core.Deprecated('Use getTransactionInclusionResponseDescriptor instead')
+const GetTransactionInclusionResponse$json = const {
+  '1': 'GetTransactionInclusionResponse',
+  '2': const [
+    const {'1': 'block_height', '3': 1, '4': 1, '5': 13, '10': 'blockHeight'},
+    const {'1': 'block_hash', '3': 2, '4': 1, '5': 12, '10': 'blockHash'},
+    const {'1': 'block_time', '3': 3, '4': 1, '5': 13, '10': 'blockTime'},
+    const {'1': 'index', '3': 4, '4': 1, '5': 5, '10': 'index'},
+  ],
+};
+
+/// Descriptor for `GetTransactionInclusionResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getTransactionInclusionResponseDescriptor = $convert.base64Decode('Ch9HZXRUcmFuc2FjdGlvbkluY2x1c2lvblJlc3BvbnNlEiEKDGJsb2NrX2hlaWdodBgBIAEoDVILYmxvY2tIZWlnaHQSHQoKYmxvY2tfaGFzaBgCIAEoDFIJYmxvY2tIYXNoEh0KCmJsb2NrX3RpbWUYAyABKA1SCWJsb2NrVGltZRIUCgVpbmRleBgEIAEoBVIFaW5kZXg=');
+@$core.Deprecated('Use getTransactionProofRequestDescriptor instead')
+const GetTransactionProofRequest$json = const {
+  '1': 'GetTransactionProofRequest',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 12, '10': 'id'},
+  ],
+};
+
+/// Descriptor for `GetTransactionProofRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getTransactionProofRequestDescriptor = $convert.base64Decode('ChpHZXRUcmFuc2FjdGlvblByb29mUmVxdWVzdBIOCgJpZBgBIAEoDFICaWQ=');
+@$core.Deprecated('Use getTransactionProofResponseDescriptor instead')
+const GetTransactionProofResponse$json = const {
+  '1': 'GetTransactionProofResponse',
+  '2': const [
+    const {'1': 'block_height', '3': 1, '4': 1, '5': 13, '10': 'blockHeight'},
+    const {'1': 'block_hash', '3': 2, '4': 1, '5': 12, '10': 'blockHash'},
+    const {'1': 'block_header', '3': 3, '4': 1, '5': 12, '10': 'blockHeader'},
+    const {'1': 'prev_cert_hash', '3': 4, '4': 1, '5': 12, '10': 'prevCertHash'},
+    const {'1': 'txs_root', '3': 5, '4': 1, '5': 12, '10': 'txsRoot'},
+    const {'1': 'tx_count', '3': 6, '4': 1, '5': 5, '10': 'txCount'},
+    const {'1': 'index', '3': 7, '4': 1, '5': 5, '10': 'index'},
+    const {'1': 'proof', '3': 8, '4': 3, '5': 12, '10': 'proof'},
+  ],
+};
+
+/// Descriptor for `GetTransactionProofResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getTransactionProofResponseDescriptor = $convert.base64Decode('ChtHZXRUcmFuc2FjdGlvblByb29mUmVzcG9uc2USIQoMYmxvY2tfaGVpZ2h0GAEgASgNUgtibG9ja0hlaWdodBIdCgpibG9ja19oYXNoGAIgASgMUglibG9ja0hhc2gSIQoMYmxvY2tfaGVhZGVyGAMgASgMUgtibG9ja0hlYWRlchIkCg5wcmV2X2NlcnRfaGFzaBgEIAEoDFIMcHJldkNlcnRIYXNoEhkKCHR4c19yb290GAUgASgMUgd0eHNSb290EhkKCHR4X2NvdW50GAYgASgFUgd0eENvdW50EhQKBWluZGV4GAcgASgFUgVpbmRleBIUCgVwcm9vZhgIIAMoDFIFcHJvb2Y=');
 @$core.Deprecated('Use calculateFeeRequestDescriptor instead')
 const CalculateFeeRequest$json = const {
   '1': 'CalculateFeeRequest',
@@ -78,6 +128,30 @@ const CalculateFeeResponse$json = const {
 
 /// Descriptor for `CalculateFeeResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List calculateFeeResponseDescriptor = $convert.base64Decode('ChRDYWxjdWxhdGVGZWVSZXNwb25zZRIQCgNmZWUYASABKANSA2ZlZQ==');
+@$core.Deprecated('Use estimateFeeRequestDescriptor instead')
+const EstimateFeeRequest$json = const {
+  '1': 'EstimateFeeRequest',
+  '2': const [
+    const {'1': 'amount', '3': 1, '4': 1, '5': 3, '10': 'amount'},
+    const {'1': 'payloadType', '3': 2, '4': 1, '5': 14, '6': '.pactus.PayloadType', '10': 'payloadType'},
+  ],
+};
+
+/// Descriptor for `EstimateFeeRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List estimateFeeRequestDescriptor = $convert.base64Decode('ChJFc3RpbWF0ZUZlZVJlcXVlc3QSFgoGYW1vdW50GAEgASgDUgZhbW91bnQSNQoLcGF5bG9hZFR5cGUYAiABKA4yEy5wYWN0dXMuUGF5bG9hZFR5cGVSC3BheWxvYWRUeXBl');
+@$core.Deprecated('Use estimateFeeResponseDescriptor instead')
+const EstimateFeeResponse$json = const {
+  '1': 'EstimateFeeResponse',
+  '2': const [
+    const {'1': 'fee', '3': 1, '4': 1, '5': 3, '10': 'fee'},
+    const {'1': 'min_fee', '3': 2, '4': 1, '5': 3, '10': 'minFee'},
+    const {'1': 'pending_txs', '3': 3, '4': 1, '5': 5, '10': 'pendingTxs'},
+    const {'1': 'pool_utilization', '3': 4, '4': 1, '5': 1, '10': 'poolUtilization'},
+  ],
+};
+
+/// Descriptor for `EstimateFeeResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List estimateFeeResponseDescriptor = $convert.base64Decode('ChNFc3RpbWF0ZUZlZVJlc3BvbnNlEhAKA2ZlZRgBIAEoA1IDZmVlEhcKB21pbl9mZWUYAiABKANSBm1pbkZlZRIfCgtwZW5kaW5nX3R4cxgDIAEoBVIKcGVuZGluZ1R4cxIpChBwb29sX3V0aWxpemF0aW9uGAQgASgBUg9wb29sVXRpbGl6YXRpb24=');
 @$core.Deprecated('Use sendRawTransactionRequestDescriptor instead')
 const SendRawTransactionRequest$json = const {
   '1': 'SendRawTransactionRequest',
@@ -98,6 +172,38 @@ const SendRawTransactionResponse$json = const {
 
 /// Descriptor for `SendRawTransactionResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List sendRawTransactionResponseDescriptor = $convert.base64Decode('ChpTZW5kUmF3VHJhbnNhY3Rpb25SZXNwb25zZRIOCgJpZBgCIAEoDFICaWQ=');
+@$core.Deprecated('Use getTxPoolStatsRequestDescriptor instead')
+const GetTxPoolStatsRequest$json = const {
+  '1': 'GetTxPoolStatsRequest',
+};
+
+/// Descriptor for `GetTxPoolStatsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getTxPoolStatsRequestDescriptor = $convert.base64Decode('ChVHZXRUeFBvb2xTdGF0c1JlcXVlc3Q=');
+@$core.Deprecated('Use getTxPoolStatsResponseDescriptor instead')
+const GetTxPoolStatsResponse$json = const {
+  '1': 'GetTxPoolStatsResponse',
+  '2': const [
+    const {'1': 'total', '3': 1, '4': 1, '5': 5, '10': 'total'},
+    const {'1': 'per_type', '3': 2, '4': 3, '5': 11, '6': '.pactus.GetTxPoolStatsResponse.PerTypeEntry', '10': 'perType'},
+    const {'1': 'capacity', '3': 3, '4': 1, '5': 5, '10': 'capacity'},
+    const {'1': 'utilization', '3': 4, '4': 1, '5': 1, '10': 'utilization'},
+    const {'1': 'oldest_tx_age_ms', '3': 5, '4': 1, '5': 3, '10': 'oldestTxAgeMs'},
+  ],
+  '3': const [GetTxPoolStatsResponse_PerTypeEntry$json],
+};
+
+@$core.Deprecated('Use getTxPoolStatsResponseDescriptor instead')
+const GetTxPoolStatsResponse_PerTypeEntry$json = const {
+  '1': 'PerTypeEntry',
+  '2': const [
+    const {'1': 'key', '3': 1, '4': 1, '5': 5, '10': 'key'},
+    const {'1': 'value', '3': 2, '4': 1, '5': 5, '10': 'value'},
+  ],
+  '7': const {'7': true},
+};
+
+/// Descriptor for `GetTxPoolStatsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getTxPoolStatsResponseDescriptor = $convert.base64Decode('ChZHZXRUeFBvb2xTdGF0c1Jlc3BvbnNlEhQKBXRvdGFsGAEgASgFUgV0b3RhbBJGCghwZXJfdHlwZRgCIAMoCzIrLnBhY3R1cy5HZXRUeFBvb2xTdGF0c1Jlc3BvbnNlLlBlclR5cGVFbnRyeVIHcGVyVHlwZRIaCghjYXBhY2l0eRgDIAEoBVIIY2FwYWNpdHkSIAoLdXRpbGl6YXRpb24YBCABKAFSC3V0aWxpemF0aW9uEicKEG9sZGVzdF90eF9hZ2VfbXMYBSABKANSDW9sZGVzdFR4QWdlTXMaOgoMUGVyVHlwZUVudHJ5EhAKA2tleRgBIAEoBVIDa2V5EhQKBXZhbHVlGAIgASgFUgV2YWx1ZToCOAE=');
 @$core.Deprecated('Use payloadTransferDescriptor instead')
 const PayloadTransfer$json = const {
   '1': 'PayloadTransfer',
@@ -186,8 +292,12 @@ const $core.Map<$core.String, $core.dynamic> TransactionServiceBase$json = const
   '1': 'Transaction',
   '2': const [
     const {'1': 'GetTransaction', '2': '.pactus.GetTransactionRequest', '3': '.pactus.GetTransactionResponse'},
+    const {'1': 'GetTransactionInclusion', '2': '.pactus.GetTransactionInclusionRequest', '3': '.pactus.GetTransactionInclusionResponse'},
+    const {'1': 'GetTransactionProof', '2': '.pactus.GetTransactionProofRequest', '3': '.pactus.GetTransactionProofResponse'},
     const {'1': 'CalculateFee', '2': '.pactus.CalculateFeeRequest', '3': '.pactus.CalculateFeeResponse'},
+    const {'1': 'EstimateFee', '2': '.pactus.EstimateFeeRequest', '3': '.pactus.EstimateFeeResponse'},
     const {'1': 'SendRawTransaction', '2': '.pactus.SendRawTransactionRequest', '3': '.pactus.SendRawTransactionResponse'},
+    const {'1': 'GetTxPoolStats', '2': '.pactus.GetTxPoolStatsRequest', '3': '.pactus.GetTxPoolStatsResponse'},
   ],
 };
 
@@ -201,11 +311,20 @@ const $core.Map<$core.String, $core.Map<$core.String, $core.dynamic>> Transactio
   '.pactus.PayloadSortition': PayloadSortition$json,
   '.pactus.PayloadUnbond': PayloadUnbond$json,
   '.pactus.PayloadWithdraw': PayloadWithdraw$json,
+  '.pactus.GetTransactionInclusionRequest': GetTransactionInclusionRequest$json,
+  '.pactus.GetTransactionInclusionResponse': GetTransactionInclusionResponse$json,
+  '.pactus.GetTransactionProofRequest': GetTransactionProofRequest$json,
+  '.pactus.GetTransactionProofResponse': GetTransactionProofResponse$json,
   '.pactus.CalculateFeeRequest': CalculateFeeRequest$json,
   '.pactus.CalculateFeeResponse': CalculateFeeResponse$json,
+  '.pactus.EstimateFeeRequest': EstimateFeeRequest$json,
+  '.pactus.EstimateFeeResponse': EstimateFeeResponse$json,
   '.pactus.SendRawTransactionRequest': SendRawTransactionRequest$json,
   '.pactus.SendRawTransactionResponse': SendRawTransactionResponse$json,
+  '.pactus.GetTxPoolStatsRequest': GetTxPoolStatsRequest$json,
+  '.pactus.GetTxPoolStatsResponse': GetTxPoolStatsResponse$json,
+  '.pactus.GetTxPoolStatsResponse.PerTypeEntry': GetTxPoolStatsResponse_PerTypeEntry$json,
 };
 
 /// Descriptor for `Transaction`. Decode as a `google.protobuf.ServiceDescriptorProto`.
-final $typed_data.Uint8List transactionServiceDescriptor = $convert.base64Decode('CgtUcmFuc2FjdGlvbhJPCg5HZXRUcmFuc2FjdGlvbhIdLnBhY3R1cy5HZXRUcmFuc2FjdGlvblJlcXVlc3QaHi5wYWN0dXMuR2V0VHJhbnNhY3Rpb25SZXNwb25zZRJJCgxDYWxjdWxhdGVGZWUSGy5wYWN0dXMuQ2FsY3VsYXRlRmVlUmVxdWVzdBocLnBhY3R1cy5DYWxjdWxhdGVGZWVSZXNwb25zZRJbChJTZW5kUmF3VHJhbnNhY3Rpb24SIS5wYWN0dXMuU2VuZFJhd1RyYW5zYWN0aW9uUmVxdWVzdBoiLnBhY3R1cy5TZW5kUmF3VHJhbnNhY3Rpb25SZXNwb25zZQ==');
+final $typed_data.Uint8List transactionServiceDescriptor = $convert.base64Decode('CgtUcmFuc2FjdGlvbhJPCg5HZXRUcmFuc2FjdGlvbhIdLnBhY3R1cy5HZXRUcmFuc2FjdGlvblJlcXVlc3QaHi5wYWN0dXMuR2V0VHJhbnNhY3Rpb25SZXNwb25zZRJqChdHZXRUcmFuc2FjdGlvbkluY2x1c2lvbhImLnBhY3R1cy5HZXRUcmFuc2FjdGlvbkluY2x1c2lvblJlcXVlc3QaJy5wYWN0dXMuR2V0VHJhbnNhY3Rpb25JbmNsdXNpb25SZXNwb25zZRJeChNHZXRUcmFuc2FjdGlvblByb29mEiIucGFjdHVzLkdldFRyYW5zYWN0aW9uUHJvb2ZSZXF1ZXN0GiMucGFjdHVzLkdldFRyYW5zYWN0aW9uUHJvb2ZSZXNwb25zZRJJCgxDYWxjdWxhdGVGZWUSGy5wYWN0dXMuQ2FsY3VsYXRlRmVlUmVxdWVzdBocLnBhY3R1cy5DYWxjdWxhdGVGZWVSZXNwb25zZRJGCgtFc3RpbWF0ZUZlZRIaLnBhY3R1cy5Fc3RpbWF0ZUZlZVJlcXVlc3QaGy5wYWN0dXMuRXN0aW1hdGVGZWVSZXNwb25zZRJbChJTZW5kUmF3VHJhbnNhY3Rpb24SIS5wYWN0dXMuU2VuZFJhd1RyYW5zYWN0aW9uUmVxdWVzdBoiLnBhY3R1cy5TZW5kUmF3VHJhbnNhY3Rpb25SZXNwb25zZRJPCg5HZXRUeFBvb2xTdGF0cxIdLnBhY3R1cy5HZXRUeFBvb2xTdGF0c1JlcXVlc3QaHi5wYWN0dXMuR2V0VHhQb29sU3RhdHNSZXNwb25zZQ==');
@@ -17,14 +17,22 @@ export 'transaction.pb.dart';
 
 abstract class TransactionServiceBase extends $pb.GeneratedService {
   $async.Future<$0.GetTransactionResponse> getTransaction($pb.ServerContext ctx, $0.GetTransactionRequest request);
+  $async.Future<$0.GetTransactionInclusionResponse> getTransactionInclusion($pb.ServerContext ctx, $0.GetTransactionInclusionRequest request);
+  $async.Future<$0.GetTransactionProofResponse> getTransactionProof($pb.ServerContext ctx, $0.GetTransactionProofRequest request);
   $async.Future<$0.CalculateFeeResponse> calculateFee($pb.ServerContext ctx, $0.CalculateFeeRequest request);
+  $async.Future<$0.EstimateFeeResponse> estimateFee($pb.ServerContext ctx, $0.EstimateFeeRequest request);
   $async.Future<$0.SendRawTransactionResponse> sendRawTransaction($pb.ServerContext ctx, $0.SendRawTransactionRequest request);
+  $async.Future<$0.GetTxPoolStatsResponse> getTxPoolStats($pb.ServerContext ctx, $0.GetTxPoolStatsRequest request);
 
   $pb.GeneratedMessage createRequest($core.String method) {
     switch (method) {
       case 'GetTransaction': return $0.GetTransactionRequest();
+      case 'GetTransactionInclusion': return $0.GetTransactionInclusionRequest();
+      case 'GetTransactionProof': return $0.GetTransactionProofRequest();
       case 'CalculateFee': return $0.CalculateFeeRequest();
+      case 'EstimateFee': return $0.EstimateFeeRequest();
       case 'SendRawTransaction': return $0.SendRawTransactionRequest();
+      case 'GetTxPoolStats': return $0.GetTxPoolStatsRequest();
       default: throw $core.ArgumentError('Unknown method: $method');
     }
   }
@@ -32,8 +40,12 @@ abstract class TransactionServiceBase extends $pb.GeneratedService {
   $async.Future<$pb.GeneratedMessage> handleCall($pb.ServerContext ctx, $core.String method, $pb.GeneratedMessage request) {
     switch (method) {
       case 'GetTransaction': return this.getTransaction(ctx, request as $0.GetTransactionRequest);
+      case 'GetTransactionInclusion': return this.getTransactionInclusion(ctx, request as $0.GetTransactionInclusionRequest);
+      case 'GetTransactionProof': return this.getTransactionProof(ctx, request as $0.GetTransactionProofRequest);
       case 'CalculateFee': return this.calculateFee(ctx, request as $0.CalculateFeeRequest);
+      case 'EstimateFee': return this.estimateFee(ctx, request as $0.EstimateFeeRequest);
       case 'SendRawTransaction': return this.sendRawTransaction(ctx, request as $0.SendRawTransactionRequest);
+      case 'GetTxPoolStats': return this.getTxPoolStats(ctx, request as $0.GetTxPoolStatsRequest);
       default: throw $core.ArgumentError('Unknown method: $method');
     }
   }
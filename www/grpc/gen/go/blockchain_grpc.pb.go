@@ -23,15 +23,27 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BlockchainClient interface {
 	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	GetRawBlock(ctx context.Context, in *GetRawBlockRequest, opts ...grpc.CallOption) (*GetRawBlockResponse, error)
+	GetRecentBlocks(ctx context.Context, in *GetRecentBlocksRequest, opts ...grpc.CallOption) (*GetRecentBlocksResponse, error)
+	GetBlockTransactions(ctx context.Context, in *GetBlockTransactionsRequest, opts ...grpc.CallOption) (*GetBlockTransactionsResponse, error)
 	GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error)
+	GetBlockHashByTime(ctx context.Context, in *GetBlockHashByTimeRequest, opts ...grpc.CallOption) (*GetBlockHashByTimeResponse, error)
 	GetBlockHeight(ctx context.Context, in *GetBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeightResponse, error)
 	GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error)
 	GetConsensusInfo(ctx context.Context, in *GetConsensusInfoRequest, opts ...grpc.CallOption) (*GetConsensusInfoResponse, error)
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
 	GetValidator(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*GetValidatorResponse, error)
 	GetValidatorByNumber(ctx context.Context, in *GetValidatorByNumberRequest, opts ...grpc.CallOption) (*GetValidatorResponse, error)
+	GetValidatorHistory(ctx context.Context, in *GetValidatorHistoryRequest, opts ...grpc.CallOption) (*GetValidatorHistoryResponse, error)
 	GetValidatorAddresses(ctx context.Context, in *GetValidatorAddressesRequest, opts ...grpc.CallOption) (*GetValidatorAddressesResponse, error)
 	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+	GetAddressByPublicKey(ctx context.Context, in *GetAddressByPublicKeyRequest, opts ...grpc.CallOption) (*GetAddressByPublicKeyResponse, error)
+	GetGenesis(ctx context.Context, in *GetGenesisRequest, opts ...grpc.CallOption) (*GetGenesisResponse, error)
+	GetCommittee(ctx context.Context, in *GetCommitteeRequest, opts ...grpc.CallOption) (*GetCommitteeResponse, error)
+	SubscribeBlockHeight(ctx context.Context, in *SubscribeBlockHeightRequest, opts ...grpc.CallOption) (Blockchain_SubscribeBlockHeightClient, error)
+	SubscribeAccount(ctx context.Context, in *SubscribeAccountRequest, opts ...grpc.CallOption) (Blockchain_SubscribeAccountClient, error)
+	GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error)
 }
 
 type blockchainClient struct {
@@ -51,6 +63,33 @@ func (c *blockchainClient) GetBlock(ctx context.Context, in *GetBlockRequest, op
 	return out, nil
 }
 
+func (c *blockchainClient) GetRawBlock(ctx context.Context, in *GetRawBlockRequest, opts ...grpc.CallOption) (*GetRawBlockResponse, error) {
+	out := new(GetRawBlockResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetRawBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetRecentBlocks(ctx context.Context, in *GetRecentBlocksRequest, opts ...grpc.CallOption) (*GetRecentBlocksResponse, error) {
+	out := new(GetRecentBlocksResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetRecentBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetBlockTransactions(ctx context.Context, in *GetBlockTransactionsRequest, opts ...grpc.CallOption) (*GetBlockTransactionsResponse, error) {
+	out := new(GetBlockTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainClient) GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error) {
 	out := new(GetBlockHashResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockHash", in, out, opts...)
@@ -60,6 +99,15 @@ func (c *blockchainClient) GetBlockHash(ctx context.Context, in *GetBlockHashReq
 	return out, nil
 }
 
+func (c *blockchainClient) GetBlockHashByTime(ctx context.Context, in *GetBlockHashByTimeRequest, opts ...grpc.CallOption) (*GetBlockHashByTimeResponse, error) {
+	out := new(GetBlockHashByTimeResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockHashByTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainClient) GetBlockHeight(ctx context.Context, in *GetBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeightResponse, error) {
 	out := new(GetBlockHeightResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetBlockHeight", in, out, opts...)
@@ -96,6 +144,15 @@ func (c *blockchainClient) GetAccount(ctx context.Context, in *GetAccountRequest
 	return out, nil
 }
 
+func (c *blockchainClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainClient) GetValidator(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*GetValidatorResponse, error) {
 	out := new(GetValidatorResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetValidator", in, out, opts...)
@@ -114,6 +171,15 @@ func (c *blockchainClient) GetValidatorByNumber(ctx context.Context, in *GetVali
 	return out, nil
 }
 
+func (c *blockchainClient) GetValidatorHistory(ctx context.Context, in *GetValidatorHistoryRequest, opts ...grpc.CallOption) (*GetValidatorHistoryResponse, error) {
+	out := new(GetValidatorHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetValidatorHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainClient) GetValidatorAddresses(ctx context.Context, in *GetValidatorAddressesRequest, opts ...grpc.CallOption) (*GetValidatorAddressesResponse, error) {
 	out := new(GetValidatorAddressesResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetValidatorAddresses", in, out, opts...)
@@ -132,20 +198,132 @@ func (c *blockchainClient) GetPublicKey(ctx context.Context, in *GetPublicKeyReq
 	return out, nil
 }
 
+func (c *blockchainClient) GetAddressByPublicKey(ctx context.Context, in *GetAddressByPublicKeyRequest, opts ...grpc.CallOption) (*GetAddressByPublicKeyResponse, error) {
+	out := new(GetAddressByPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetAddressByPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetGenesis(ctx context.Context, in *GetGenesisRequest, opts ...grpc.CallOption) (*GetGenesisResponse, error) {
+	out := new(GetGenesisResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetCommittee(ctx context.Context, in *GetCommitteeRequest, opts ...grpc.CallOption) (*GetCommitteeResponse, error) {
+	out := new(GetCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) SubscribeBlockHeight(ctx context.Context, in *SubscribeBlockHeightRequest, opts ...grpc.CallOption) (Blockchain_SubscribeBlockHeightClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Blockchain_ServiceDesc.Streams[0], "/pactus.Blockchain/SubscribeBlockHeight", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockchainSubscribeBlockHeightClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Blockchain_SubscribeBlockHeightClient interface {
+	Recv() (*SubscribeBlockHeightResponse, error)
+	grpc.ClientStream
+}
+
+type blockchainSubscribeBlockHeightClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockchainSubscribeBlockHeightClient) Recv() (*SubscribeBlockHeightResponse, error) {
+	m := new(SubscribeBlockHeightResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blockchainClient) SubscribeAccount(ctx context.Context, in *SubscribeAccountRequest, opts ...grpc.CallOption) (Blockchain_SubscribeAccountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Blockchain_ServiceDesc.Streams[1], "/pactus.Blockchain/SubscribeAccount", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockchainSubscribeAccountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Blockchain_SubscribeAccountClient interface {
+	Recv() (*SubscribeAccountResponse, error)
+	grpc.ClientStream
+}
+
+type blockchainSubscribeAccountClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockchainSubscribeAccountClient) Recv() (*SubscribeAccountResponse, error) {
+	m := new(SubscribeAccountResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blockchainClient) GetParams(ctx context.Context, in *GetParamsRequest, opts ...grpc.CallOption) (*GetParamsResponse, error) {
+	out := new(GetParamsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Blockchain/GetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockchainServer is the server API for Blockchain service.
 // All implementations should embed UnimplementedBlockchainServer
 // for forward compatibility
 type BlockchainServer interface {
 	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
+	GetRawBlock(context.Context, *GetRawBlockRequest) (*GetRawBlockResponse, error)
+	GetRecentBlocks(context.Context, *GetRecentBlocksRequest) (*GetRecentBlocksResponse, error)
+	GetBlockTransactions(context.Context, *GetBlockTransactionsRequest) (*GetBlockTransactionsResponse, error)
 	GetBlockHash(context.Context, *GetBlockHashRequest) (*GetBlockHashResponse, error)
+	GetBlockHashByTime(context.Context, *GetBlockHashByTimeRequest) (*GetBlockHashByTimeResponse, error)
 	GetBlockHeight(context.Context, *GetBlockHeightRequest) (*GetBlockHeightResponse, error)
 	GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error)
 	GetConsensusInfo(context.Context, *GetConsensusInfoRequest) (*GetConsensusInfoResponse, error)
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
 	GetValidator(context.Context, *GetValidatorRequest) (*GetValidatorResponse, error)
 	GetValidatorByNumber(context.Context, *GetValidatorByNumberRequest) (*GetValidatorResponse, error)
+	GetValidatorHistory(context.Context, *GetValidatorHistoryRequest) (*GetValidatorHistoryResponse, error)
 	GetValidatorAddresses(context.Context, *GetValidatorAddressesRequest) (*GetValidatorAddressesResponse, error)
 	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	GetAddressByPublicKey(context.Context, *GetAddressByPublicKeyRequest) (*GetAddressByPublicKeyResponse, error)
+	GetGenesis(context.Context, *GetGenesisRequest) (*GetGenesisResponse, error)
+	GetCommittee(context.Context, *GetCommitteeRequest) (*GetCommitteeResponse, error)
+	SubscribeBlockHeight(*SubscribeBlockHeightRequest, Blockchain_SubscribeBlockHeightServer) error
+	SubscribeAccount(*SubscribeAccountRequest, Blockchain_SubscribeAccountServer) error
+	GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error)
 }
 
 // UnimplementedBlockchainServer should be embedded to have forward compatible implementations.
@@ -155,9 +333,21 @@ type UnimplementedBlockchainServer struct {
 func (UnimplementedBlockchainServer) GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
 }
+func (UnimplementedBlockchainServer) GetRawBlock(context.Context, *GetRawBlockRequest) (*GetRawBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawBlock not implemented")
+}
+func (UnimplementedBlockchainServer) GetRecentBlocks(context.Context, *GetRecentBlocksRequest) (*GetRecentBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentBlocks not implemented")
+}
+func (UnimplementedBlockchainServer) GetBlockTransactions(context.Context, *GetBlockTransactionsRequest) (*GetBlockTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockTransactions not implemented")
+}
 func (UnimplementedBlockchainServer) GetBlockHash(context.Context, *GetBlockHashRequest) (*GetBlockHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHash not implemented")
 }
+func (UnimplementedBlockchainServer) GetBlockHashByTime(context.Context, *GetBlockHashByTimeRequest) (*GetBlockHashByTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHashByTime not implemented")
+}
 func (UnimplementedBlockchainServer) GetBlockHeight(context.Context, *GetBlockHeightRequest) (*GetBlockHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeight not implemented")
 }
@@ -170,18 +360,42 @@ func (UnimplementedBlockchainServer) GetConsensusInfo(context.Context, *GetConse
 func (UnimplementedBlockchainServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
 }
+func (UnimplementedBlockchainServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
 func (UnimplementedBlockchainServer) GetValidator(context.Context, *GetValidatorRequest) (*GetValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidator not implemented")
 }
 func (UnimplementedBlockchainServer) GetValidatorByNumber(context.Context, *GetValidatorByNumberRequest) (*GetValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorByNumber not implemented")
 }
+func (UnimplementedBlockchainServer) GetValidatorHistory(context.Context, *GetValidatorHistoryRequest) (*GetValidatorHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorHistory not implemented")
+}
 func (UnimplementedBlockchainServer) GetValidatorAddresses(context.Context, *GetValidatorAddressesRequest) (*GetValidatorAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorAddresses not implemented")
 }
 func (UnimplementedBlockchainServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
 }
+func (UnimplementedBlockchainServer) GetAddressByPublicKey(context.Context, *GetAddressByPublicKeyRequest) (*GetAddressByPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressByPublicKey not implemented")
+}
+func (UnimplementedBlockchainServer) GetGenesis(context.Context, *GetGenesisRequest) (*GetGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenesis not implemented")
+}
+func (UnimplementedBlockchainServer) GetCommittee(context.Context, *GetCommitteeRequest) (*GetCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommittee not implemented")
+}
+func (UnimplementedBlockchainServer) SubscribeBlockHeight(*SubscribeBlockHeightRequest, Blockchain_SubscribeBlockHeightServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlockHeight not implemented")
+}
+func (UnimplementedBlockchainServer) SubscribeAccount(*SubscribeAccountRequest, Blockchain_SubscribeAccountServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAccount not implemented")
+}
+func (UnimplementedBlockchainServer) GetParams(context.Context, *GetParamsRequest) (*GetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
 
 // UnsafeBlockchainServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BlockchainServer will
@@ -212,6 +426,60 @@ func _Blockchain_GetBlock_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetRawBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetRawBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetRawBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetRawBlock(ctx, req.(*GetRawBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetRecentBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetRecentBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetRecentBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetRecentBlocks(ctx, req.(*GetRecentBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetBlockTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetBlockTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetBlockTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetBlockTransactions(ctx, req.(*GetBlockTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blockchain_GetBlockHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockHashRequest)
 	if err := dec(in); err != nil {
@@ -230,6 +498,24 @@ func _Blockchain_GetBlockHash_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetBlockHashByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHashByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetBlockHashByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetBlockHashByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetBlockHashByTime(ctx, req.(*GetBlockHashByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blockchain_GetBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockHeightRequest)
 	if err := dec(in); err != nil {
@@ -302,6 +588,24 @@ func _Blockchain_GetAccount_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blockchain_GetValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidatorRequest)
 	if err := dec(in); err != nil {
@@ -338,6 +642,24 @@ func _Blockchain_GetValidatorByNumber_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetValidatorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetValidatorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetValidatorHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetValidatorHistory(ctx, req.(*GetValidatorHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blockchain_GetValidatorAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidatorAddressesRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +696,120 @@ func _Blockchain_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetAddressByPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressByPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetAddressByPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetAddressByPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetAddressByPublicKey(ctx, req.(*GetAddressByPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetGenesis(ctx, req.(*GetGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetCommittee(ctx, req.(*GetCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_SubscribeBlockHeight_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlockHeightRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockchainServer).SubscribeBlockHeight(m, &blockchainSubscribeBlockHeightServer{stream})
+}
+
+type Blockchain_SubscribeBlockHeightServer interface {
+	Send(*SubscribeBlockHeightResponse) error
+	grpc.ServerStream
+}
+
+type blockchainSubscribeBlockHeightServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockchainSubscribeBlockHeightServer) Send(m *SubscribeBlockHeightResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Blockchain_SubscribeAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockchainServer).SubscribeAccount(m, &blockchainSubscribeAccountServer{stream})
+}
+
+type Blockchain_SubscribeAccountServer interface {
+	Send(*SubscribeAccountResponse) error
+	grpc.ServerStream
+}
+
+type blockchainSubscribeAccountServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockchainSubscribeAccountServer) Send(m *SubscribeAccountResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Blockchain_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Blockchain/GetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetParams(ctx, req.(*GetParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Blockchain_ServiceDesc is the grpc.ServiceDesc for Blockchain service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -385,10 +821,26 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlock",
 			Handler:    _Blockchain_GetBlock_Handler,
 		},
+		{
+			MethodName: "GetRawBlock",
+			Handler:    _Blockchain_GetRawBlock_Handler,
+		},
+		{
+			MethodName: "GetRecentBlocks",
+			Handler:    _Blockchain_GetRecentBlocks_Handler,
+		},
+		{
+			MethodName: "GetBlockTransactions",
+			Handler:    _Blockchain_GetBlockTransactions_Handler,
+		},
 		{
 			MethodName: "GetBlockHash",
 			Handler:    _Blockchain_GetBlockHash_Handler,
 		},
+		{
+			MethodName: "GetBlockHashByTime",
+			Handler:    _Blockchain_GetBlockHashByTime_Handler,
+		},
 		{
 			MethodName: "GetBlockHeight",
 			Handler:    _Blockchain_GetBlockHeight_Handler,
@@ -405,6 +857,10 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccount",
 			Handler:    _Blockchain_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _Blockchain_GetAccounts_Handler,
+		},
 		{
 			MethodName: "GetValidator",
 			Handler:    _Blockchain_GetValidator_Handler,
@@ -413,6 +869,10 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorByNumber",
 			Handler:    _Blockchain_GetValidatorByNumber_Handler,
 		},
+		{
+			MethodName: "GetValidatorHistory",
+			Handler:    _Blockchain_GetValidatorHistory_Handler,
+		},
 		{
 			MethodName: "GetValidatorAddresses",
 			Handler:    _Blockchain_GetValidatorAddresses_Handler,
@@ -421,7 +881,34 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicKey",
 			Handler:    _Blockchain_GetPublicKey_Handler,
 		},
+		{
+			MethodName: "GetAddressByPublicKey",
+			Handler:    _Blockchain_GetAddressByPublicKey_Handler,
+		},
+		{
+			MethodName: "GetGenesis",
+			Handler:    _Blockchain_GetGenesis_Handler,
+		},
+		{
+			MethodName: "GetCommittee",
+			Handler:    _Blockchain_GetCommittee_Handler,
+		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Blockchain_GetParams_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlockHeight",
+			Handler:       _Blockchain_SubscribeBlockHeight_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeAccount",
+			Handler:       _Blockchain_SubscribeAccount_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "blockchain.proto",
 }
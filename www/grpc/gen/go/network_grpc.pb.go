@@ -24,6 +24,12 @@ const _ = grpc.SupportPackageIsVersion7
 type NetworkClient interface {
 	GetNetworkInfo(ctx context.Context, in *GetNetworkInfoRequest, opts ...grpc.CallOption) (*GetNetworkInfoResponse, error)
 	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BanPeerResponse, error)
+	UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error)
+	GetRewardAddresses(ctx context.Context, in *GetRewardAddressesRequest, opts ...grpc.CallOption) (*GetRewardAddressesResponse, error)
+	PauseSync(ctx context.Context, in *PauseSyncRequest, opts ...grpc.CallOption) (*PauseSyncResponse, error)
+	ResumeSync(ctx context.Context, in *ResumeSyncRequest, opts ...grpc.CallOption) (*ResumeSyncResponse, error)
+	GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error)
 }
 
 type networkClient struct {
@@ -52,12 +58,72 @@ func (c *networkClient) GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest,
 	return out, nil
 }
 
+func (c *networkClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BanPeerResponse, error) {
+	out := new(BanPeerResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error) {
+	out := new(UnbanPeerResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) GetRewardAddresses(ctx context.Context, in *GetRewardAddressesRequest, opts ...grpc.CallOption) (*GetRewardAddressesResponse, error) {
+	out := new(GetRewardAddressesResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/GetRewardAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) PauseSync(ctx context.Context, in *PauseSyncRequest, opts ...grpc.CallOption) (*PauseSyncResponse, error) {
+	out := new(PauseSyncResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/PauseSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) ResumeSync(ctx context.Context, in *ResumeSyncRequest, opts ...grpc.CallOption) (*ResumeSyncResponse, error) {
+	out := new(ResumeSyncResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/ResumeSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkClient) GetSessions(ctx context.Context, in *GetSessionsRequest, opts ...grpc.CallOption) (*GetSessionsResponse, error) {
+	out := new(GetSessionsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Network/GetSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetworkServer is the server API for Network service.
 // All implementations should embed UnimplementedNetworkServer
 // for forward compatibility
 type NetworkServer interface {
 	GetNetworkInfo(context.Context, *GetNetworkInfoRequest) (*GetNetworkInfoResponse, error)
 	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error)
+	BanPeer(context.Context, *BanPeerRequest) (*BanPeerResponse, error)
+	UnbanPeer(context.Context, *UnbanPeerRequest) (*UnbanPeerResponse, error)
+	GetRewardAddresses(context.Context, *GetRewardAddressesRequest) (*GetRewardAddressesResponse, error)
+	PauseSync(context.Context, *PauseSyncRequest) (*PauseSyncResponse, error)
+	ResumeSync(context.Context, *ResumeSyncRequest) (*ResumeSyncResponse, error)
+	GetSessions(context.Context, *GetSessionsRequest) (*GetSessionsResponse, error)
 }
 
 // UnimplementedNetworkServer should be embedded to have forward compatible implementations.
@@ -70,6 +136,24 @@ func (UnimplementedNetworkServer) GetNetworkInfo(context.Context, *GetNetworkInf
 func (UnimplementedNetworkServer) GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
 }
+func (UnimplementedNetworkServer) BanPeer(context.Context, *BanPeerRequest) (*BanPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (UnimplementedNetworkServer) UnbanPeer(context.Context, *UnbanPeerRequest) (*UnbanPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (UnimplementedNetworkServer) GetRewardAddresses(context.Context, *GetRewardAddressesRequest) (*GetRewardAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardAddresses not implemented")
+}
+func (UnimplementedNetworkServer) PauseSync(context.Context, *PauseSyncRequest) (*PauseSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSync not implemented")
+}
+func (UnimplementedNetworkServer) ResumeSync(context.Context, *ResumeSyncRequest) (*ResumeSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSync not implemented")
+}
+func (UnimplementedNetworkServer) GetSessions(context.Context, *GetSessionsRequest) (*GetSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
 
 // UnsafeNetworkServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NetworkServer will
@@ -118,6 +202,114 @@ func _Network_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Network_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).UnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_GetRewardAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRewardAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).GetRewardAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/GetRewardAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).GetRewardAddresses(ctx, req.(*GetRewardAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_PauseSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).PauseSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/PauseSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).PauseSync(ctx, req.(*PauseSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_ResumeSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).ResumeSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/ResumeSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).ResumeSync(ctx, req.(*ResumeSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Network_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Network/GetSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServer).GetSessions(ctx, req.(*GetSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Network_ServiceDesc is the grpc.ServiceDesc for Network service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -133,6 +325,30 @@ var Network_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNodeInfo",
 			Handler:    _Network_GetNodeInfo_Handler,
 		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _Network_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _Network_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "GetRewardAddresses",
+			Handler:    _Network_GetRewardAddresses_Handler,
+		},
+		{
+			MethodName: "PauseSync",
+			Handler:    _Network_PauseSync_Handler,
+		},
+		{
+			MethodName: "ResumeSync",
+			Handler:    _Network_ResumeSync_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _Network_GetSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "network.proto",
@@ -23,8 +23,12 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TransactionClient interface {
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	GetTransactionInclusion(ctx context.Context, in *GetTransactionInclusionRequest, opts ...grpc.CallOption) (*GetTransactionInclusionResponse, error)
+	GetTransactionProof(ctx context.Context, in *GetTransactionProofRequest, opts ...grpc.CallOption) (*GetTransactionProofResponse, error)
 	CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error)
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
 	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
+	GetTxPoolStats(ctx context.Context, in *GetTxPoolStatsRequest, opts ...grpc.CallOption) (*GetTxPoolStatsResponse, error)
 }
 
 type transactionClient struct {
@@ -44,6 +48,24 @@ func (c *transactionClient) GetTransaction(ctx context.Context, in *GetTransacti
 	return out, nil
 }
 
+func (c *transactionClient) GetTransactionInclusion(ctx context.Context, in *GetTransactionInclusionRequest, opts ...grpc.CallOption) (*GetTransactionInclusionResponse, error) {
+	out := new(GetTransactionInclusionResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/GetTransactionInclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetTransactionProof(ctx context.Context, in *GetTransactionProofRequest, opts ...grpc.CallOption) (*GetTransactionProofResponse, error) {
+	out := new(GetTransactionProofResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/GetTransactionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionClient) CalculateFee(ctx context.Context, in *CalculateFeeRequest, opts ...grpc.CallOption) (*CalculateFeeResponse, error) {
 	out := new(CalculateFeeResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Transaction/CalculateFee", in, out, opts...)
@@ -53,6 +75,15 @@ func (c *transactionClient) CalculateFee(ctx context.Context, in *CalculateFeeRe
 	return out, nil
 }
 
+func (c *transactionClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error) {
 	out := new(SendRawTransactionResponse)
 	err := c.cc.Invoke(ctx, "/pactus.Transaction/SendRawTransaction", in, out, opts...)
@@ -62,13 +93,26 @@ func (c *transactionClient) SendRawTransaction(ctx context.Context, in *SendRawT
 	return out, nil
 }
 
+func (c *transactionClient) GetTxPoolStats(ctx context.Context, in *GetTxPoolStatsRequest, opts ...grpc.CallOption) (*GetTxPoolStatsResponse, error) {
+	out := new(GetTxPoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/pactus.Transaction/GetTxPoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServer is the server API for Transaction service.
 // All implementations should embed UnimplementedTransactionServer
 // for forward compatibility
 type TransactionServer interface {
 	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	GetTransactionInclusion(context.Context, *GetTransactionInclusionRequest) (*GetTransactionInclusionResponse, error)
+	GetTransactionProof(context.Context, *GetTransactionProofRequest) (*GetTransactionProofResponse, error)
 	CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error)
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
 	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
+	GetTxPoolStats(context.Context, *GetTxPoolStatsRequest) (*GetTxPoolStatsResponse, error)
 }
 
 // UnimplementedTransactionServer should be embedded to have forward compatible implementations.
@@ -78,12 +122,24 @@ type UnimplementedTransactionServer struct {
 func (UnimplementedTransactionServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
 }
+func (UnimplementedTransactionServer) GetTransactionInclusion(context.Context, *GetTransactionInclusionRequest) (*GetTransactionInclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionInclusion not implemented")
+}
+func (UnimplementedTransactionServer) GetTransactionProof(context.Context, *GetTransactionProofRequest) (*GetTransactionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionProof not implemented")
+}
 func (UnimplementedTransactionServer) CalculateFee(context.Context, *CalculateFeeRequest) (*CalculateFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateFee not implemented")
 }
+func (UnimplementedTransactionServer) EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 func (UnimplementedTransactionServer) SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendRawTransaction not implemented")
 }
+func (UnimplementedTransactionServer) GetTxPoolStats(context.Context, *GetTxPoolStatsRequest) (*GetTxPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolStats not implemented")
+}
 
 // UnsafeTransactionServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TransactionServer will
@@ -114,6 +170,42 @@ func _Transaction_GetTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_GetTransactionInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetTransactionInclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/GetTransactionInclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetTransactionInclusion(ctx, req.(*GetTransactionInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetTransactionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetTransactionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/GetTransactionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetTransactionProof(ctx, req.(*GetTransactionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transaction_CalculateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculateFeeRequest)
 	if err := dec(in); err != nil {
@@ -132,6 +224,24 @@ func _Transaction_CalculateFee_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transaction_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendRawTransactionRequest)
 	if err := dec(in); err != nil {
@@ -150,6 +260,24 @@ func _Transaction_SendRawTransaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_GetTxPoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetTxPoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pactus.Transaction/GetTxPoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetTxPoolStats(ctx, req.(*GetTxPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Transaction_ServiceDesc is the grpc.ServiceDesc for Transaction service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,14 +289,30 @@ var Transaction_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransaction",
 			Handler:    _Transaction_GetTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactionInclusion",
+			Handler:    _Transaction_GetTransactionInclusion_Handler,
+		},
+		{
+			MethodName: "GetTransactionProof",
+			Handler:    _Transaction_GetTransactionProof_Handler,
+		},
 		{
 			MethodName: "CalculateFee",
 			Handler:    _Transaction_CalculateFee_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Transaction_EstimateFee_Handler,
+		},
 		{
 			MethodName: "SendRawTransaction",
 			Handler:    _Transaction_SendRawTransaction_Handler,
 		},
+		{
+			MethodName: "GetTxPoolStats",
+			Handler:    _Transaction_GetTxPoolStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction.proto",
@@ -46,6 +46,99 @@ public final class BlockchainGrpc {
     return getGetBlockMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest,
+      pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse> getGetRawBlockMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetRawBlock",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest,
+      pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse> getGetRawBlockMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest, pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse> getGetRawBlockMethod;
+    if ((getGetRawBlockMethod = BlockchainGrpc.getGetRawBlockMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetRawBlockMethod = BlockchainGrpc.getGetRawBlockMethod) == null) {
+          BlockchainGrpc.getGetRawBlockMethod = getGetRawBlockMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest, pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetRawBlock"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetRawBlock"))
+              .build();
+        }
+      }
+    }
+    return getGetRawBlockMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest,
+      pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse> getGetRecentBlocksMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetRecentBlocks",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest,
+      pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse> getGetRecentBlocksMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest, pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse> getGetRecentBlocksMethod;
+    if ((getGetRecentBlocksMethod = BlockchainGrpc.getGetRecentBlocksMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetRecentBlocksMethod = BlockchainGrpc.getGetRecentBlocksMethod) == null) {
+          BlockchainGrpc.getGetRecentBlocksMethod = getGetRecentBlocksMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest, pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetRecentBlocks"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetRecentBlocks"))
+              .build();
+        }
+      }
+    }
+    return getGetRecentBlocksMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest,
+      pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse> getGetBlockTransactionsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetBlockTransactions",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest,
+      pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse> getGetBlockTransactionsMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest, pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse> getGetBlockTransactionsMethod;
+    if ((getGetBlockTransactionsMethod = BlockchainGrpc.getGetBlockTransactionsMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetBlockTransactionsMethod = BlockchainGrpc.getGetBlockTransactionsMethod) == null) {
+          BlockchainGrpc.getGetBlockTransactionsMethod = getGetBlockTransactionsMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest, pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetBlockTransactions"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetBlockTransactions"))
+              .build();
+        }
+      }
+    }
+    return getGetBlockTransactionsMethod;
+  }
+
   private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockHashRequest,
       pactus.blockchain.BlockchainOuterClass.GetBlockHashResponse> getGetBlockHashMethod;
 
@@ -77,6 +170,37 @@ public final class BlockchainGrpc {
     return getGetBlockHashMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest,
+      pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse> getGetBlockHashByTimeMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetBlockHashByTime",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest,
+      pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse> getGetBlockHashByTimeMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest, pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse> getGetBlockHashByTimeMethod;
+    if ((getGetBlockHashByTimeMethod = BlockchainGrpc.getGetBlockHashByTimeMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetBlockHashByTimeMethod = BlockchainGrpc.getGetBlockHashByTimeMethod) == null) {
+          BlockchainGrpc.getGetBlockHashByTimeMethod = getGetBlockHashByTimeMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest, pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetBlockHashByTime"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetBlockHashByTime"))
+              .build();
+        }
+      }
+    }
+    return getGetBlockHashByTimeMethod;
+  }
+
   private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetBlockHeightRequest,
       pactus.blockchain.BlockchainOuterClass.GetBlockHeightResponse> getGetBlockHeightMethod;
 
@@ -201,6 +325,37 @@ public final class BlockchainGrpc {
     return getGetAccountMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetAccountsRequest,
+      pactus.blockchain.BlockchainOuterClass.GetAccountsResponse> getGetAccountsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetAccounts",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetAccountsRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetAccountsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetAccountsRequest,
+      pactus.blockchain.BlockchainOuterClass.GetAccountsResponse> getGetAccountsMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetAccountsRequest, pactus.blockchain.BlockchainOuterClass.GetAccountsResponse> getGetAccountsMethod;
+    if ((getGetAccountsMethod = BlockchainGrpc.getGetAccountsMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetAccountsMethod = BlockchainGrpc.getGetAccountsMethod) == null) {
+          BlockchainGrpc.getGetAccountsMethod = getGetAccountsMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetAccountsRequest, pactus.blockchain.BlockchainOuterClass.GetAccountsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetAccounts"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetAccountsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetAccountsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetAccounts"))
+              .build();
+        }
+      }
+    }
+    return getGetAccountsMethod;
+  }
+
   private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetValidatorRequest,
       pactus.blockchain.BlockchainOuterClass.GetValidatorResponse> getGetValidatorMethod;
 
@@ -263,6 +418,37 @@ public final class BlockchainGrpc {
     return getGetValidatorByNumberMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest,
+      pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse> getGetValidatorHistoryMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetValidatorHistory",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest,
+      pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse> getGetValidatorHistoryMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest, pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse> getGetValidatorHistoryMethod;
+    if ((getGetValidatorHistoryMethod = BlockchainGrpc.getGetValidatorHistoryMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetValidatorHistoryMethod = BlockchainGrpc.getGetValidatorHistoryMethod) == null) {
+          BlockchainGrpc.getGetValidatorHistoryMethod = getGetValidatorHistoryMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest, pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetValidatorHistory"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetValidatorHistory"))
+              .build();
+        }
+      }
+    }
+    return getGetValidatorHistoryMethod;
+  }
+
   private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesRequest,
       pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesResponse> getGetValidatorAddressesMethod;
 
@@ -325,6 +511,192 @@ public final class BlockchainGrpc {
     return getGetPublicKeyMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest,
+      pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse> getGetAddressByPublicKeyMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetAddressByPublicKey",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest,
+      pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse> getGetAddressByPublicKeyMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest, pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse> getGetAddressByPublicKeyMethod;
+    if ((getGetAddressByPublicKeyMethod = BlockchainGrpc.getGetAddressByPublicKeyMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetAddressByPublicKeyMethod = BlockchainGrpc.getGetAddressByPublicKeyMethod) == null) {
+          BlockchainGrpc.getGetAddressByPublicKeyMethod = getGetAddressByPublicKeyMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest, pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetAddressByPublicKey"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetAddressByPublicKey"))
+              .build();
+        }
+      }
+    }
+    return getGetAddressByPublicKeyMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetGenesisRequest,
+      pactus.blockchain.BlockchainOuterClass.GetGenesisResponse> getGetGenesisMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetGenesis",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetGenesisRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetGenesisResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetGenesisRequest,
+      pactus.blockchain.BlockchainOuterClass.GetGenesisResponse> getGetGenesisMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetGenesisRequest, pactus.blockchain.BlockchainOuterClass.GetGenesisResponse> getGetGenesisMethod;
+    if ((getGetGenesisMethod = BlockchainGrpc.getGetGenesisMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetGenesisMethod = BlockchainGrpc.getGetGenesisMethod) == null) {
+          BlockchainGrpc.getGetGenesisMethod = getGetGenesisMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetGenesisRequest, pactus.blockchain.BlockchainOuterClass.GetGenesisResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetGenesis"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetGenesisRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetGenesisResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetGenesis"))
+              .build();
+        }
+      }
+    }
+    return getGetGenesisMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest,
+      pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse> getGetCommitteeMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetCommittee",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest,
+      pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse> getGetCommitteeMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest, pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse> getGetCommitteeMethod;
+    if ((getGetCommitteeMethod = BlockchainGrpc.getGetCommitteeMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetCommitteeMethod = BlockchainGrpc.getGetCommitteeMethod) == null) {
+          BlockchainGrpc.getGetCommitteeMethod = getGetCommitteeMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest, pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetCommittee"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetCommittee"))
+              .build();
+        }
+      }
+    }
+    return getGetCommitteeMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest,
+      pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse> getSubscribeBlockHeightMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "SubscribeBlockHeight",
+      requestType = pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest,
+      pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse> getSubscribeBlockHeightMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest, pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse> getSubscribeBlockHeightMethod;
+    if ((getSubscribeBlockHeightMethod = BlockchainGrpc.getSubscribeBlockHeightMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getSubscribeBlockHeightMethod = BlockchainGrpc.getSubscribeBlockHeightMethod) == null) {
+          BlockchainGrpc.getSubscribeBlockHeightMethod = getSubscribeBlockHeightMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest, pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "SubscribeBlockHeight"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("SubscribeBlockHeight"))
+              .build();
+        }
+      }
+    }
+    return getSubscribeBlockHeightMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest,
+      pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse> getSubscribeAccountMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "SubscribeAccount",
+      requestType = pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest,
+      pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse> getSubscribeAccountMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest, pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse> getSubscribeAccountMethod;
+    if ((getSubscribeAccountMethod = BlockchainGrpc.getSubscribeAccountMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getSubscribeAccountMethod = BlockchainGrpc.getSubscribeAccountMethod) == null) {
+          BlockchainGrpc.getSubscribeAccountMethod = getSubscribeAccountMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest, pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "SubscribeAccount"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("SubscribeAccount"))
+              .build();
+        }
+      }
+    }
+    return getSubscribeAccountMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetParamsRequest,
+      pactus.blockchain.BlockchainOuterClass.GetParamsResponse> getGetParamsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetParams",
+      requestType = pactus.blockchain.BlockchainOuterClass.GetParamsRequest.class,
+      responseType = pactus.blockchain.BlockchainOuterClass.GetParamsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetParamsRequest,
+      pactus.blockchain.BlockchainOuterClass.GetParamsResponse> getGetParamsMethod() {
+    io.grpc.MethodDescriptor<pactus.blockchain.BlockchainOuterClass.GetParamsRequest, pactus.blockchain.BlockchainOuterClass.GetParamsResponse> getGetParamsMethod;
+    if ((getGetParamsMethod = BlockchainGrpc.getGetParamsMethod) == null) {
+      synchronized (BlockchainGrpc.class) {
+        if ((getGetParamsMethod = BlockchainGrpc.getGetParamsMethod) == null) {
+          BlockchainGrpc.getGetParamsMethod = getGetParamsMethod =
+              io.grpc.MethodDescriptor.<pactus.blockchain.BlockchainOuterClass.GetParamsRequest, pactus.blockchain.BlockchainOuterClass.GetParamsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetParams"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetParamsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.blockchain.BlockchainOuterClass.GetParamsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new BlockchainMethodDescriptorSupplier("GetParams"))
+              .build();
+        }
+      }
+    }
+    return getGetParamsMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -380,6 +752,27 @@ public final class BlockchainGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetBlockMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void getRawBlock(pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetRawBlockMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getRecentBlocks(pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetRecentBlocksMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getBlockTransactions(pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetBlockTransactionsMethod(), responseObserver);
+    }
+
     /**
      */
     public void getBlockHash(pactus.blockchain.BlockchainOuterClass.GetBlockHashRequest request,
@@ -387,6 +780,13 @@ public final class BlockchainGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetBlockHashMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void getBlockHashByTime(pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetBlockHashByTimeMethod(), responseObserver);
+    }
+
     /**
      */
     public void getBlockHeight(pactus.blockchain.BlockchainOuterClass.GetBlockHeightRequest request,
@@ -415,6 +815,13 @@ public final class BlockchainGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetAccountMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void getAccounts(pactus.blockchain.BlockchainOuterClass.GetAccountsRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetAccountsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetAccountsMethod(), responseObserver);
+    }
+
     /**
      */
     public void getValidator(pactus.blockchain.BlockchainOuterClass.GetValidatorRequest request,
@@ -429,6 +836,13 @@ public final class BlockchainGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetValidatorByNumberMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void getValidatorHistory(pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetValidatorHistoryMethod(), responseObserver);
+    }
+
     /**
      */
     public void getValidatorAddresses(pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesRequest request,
@@ -443,6 +857,48 @@ public final class BlockchainGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetPublicKeyMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void getAddressByPublicKey(pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetAddressByPublicKeyMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getGenesis(pactus.blockchain.BlockchainOuterClass.GetGenesisRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetGenesisResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetGenesisMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getCommittee(pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetCommitteeMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void subscribeBlockHeight(pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getSubscribeBlockHeightMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void subscribeAccount(pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getSubscribeAccountMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getParams(pactus.blockchain.BlockchainOuterClass.GetParamsRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetParamsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetParamsMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -452,6 +908,27 @@ public final class BlockchainGrpc {
                 pactus.blockchain.BlockchainOuterClass.GetBlockRequest,
                 pactus.blockchain.BlockchainOuterClass.GetBlockResponse>(
                   this, METHODID_GET_BLOCK)))
+          .addMethod(
+            getGetRawBlockMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest,
+                pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse>(
+                  this, METHODID_GET_RAW_BLOCK)))
+          .addMethod(
+            getGetRecentBlocksMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest,
+                pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse>(
+                  this, METHODID_GET_RECENT_BLOCKS)))
+          .addMethod(
+            getGetBlockTransactionsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest,
+                pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse>(
+                  this, METHODID_GET_BLOCK_TRANSACTIONS)))
           .addMethod(
             getGetBlockHashMethod(),
             io.grpc.stub.ServerCalls.asyncUnaryCall(
@@ -459,6 +936,13 @@ public final class BlockchainGrpc {
                 pactus.blockchain.BlockchainOuterClass.GetBlockHashRequest,
                 pactus.blockchain.BlockchainOuterClass.GetBlockHashResponse>(
                   this, METHODID_GET_BLOCK_HASH)))
+          .addMethod(
+            getGetBlockHashByTimeMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest,
+                pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse>(
+                  this, METHODID_GET_BLOCK_HASH_BY_TIME)))
           .addMethod(
             getGetBlockHeightMethod(),
             io.grpc.stub.ServerCalls.asyncUnaryCall(
@@ -487,6 +971,13 @@ public final class BlockchainGrpc {
                 pactus.blockchain.BlockchainOuterClass.GetAccountRequest,
                 pactus.blockchain.BlockchainOuterClass.GetAccountResponse>(
                   this, METHODID_GET_ACCOUNT)))
+          .addMethod(
+            getGetAccountsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetAccountsRequest,
+                pactus.blockchain.BlockchainOuterClass.GetAccountsResponse>(
+                  this, METHODID_GET_ACCOUNTS)))
           .addMethod(
             getGetValidatorMethod(),
             io.grpc.stub.ServerCalls.asyncUnaryCall(
@@ -501,6 +992,13 @@ public final class BlockchainGrpc {
                 pactus.blockchain.BlockchainOuterClass.GetValidatorByNumberRequest,
                 pactus.blockchain.BlockchainOuterClass.GetValidatorResponse>(
                   this, METHODID_GET_VALIDATOR_BY_NUMBER)))
+          .addMethod(
+            getGetValidatorHistoryMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest,
+                pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse>(
+                  this, METHODID_GET_VALIDATOR_HISTORY)))
           .addMethod(
             getGetValidatorAddressesMethod(),
             io.grpc.stub.ServerCalls.asyncUnaryCall(
@@ -515,6 +1013,48 @@ public final class BlockchainGrpc {
                 pactus.blockchain.BlockchainOuterClass.GetPublicKeyRequest,
                 pactus.blockchain.BlockchainOuterClass.GetPublicKeyResponse>(
                   this, METHODID_GET_PUBLIC_KEY)))
+          .addMethod(
+            getGetAddressByPublicKeyMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest,
+                pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse>(
+                  this, METHODID_GET_ADDRESS_BY_PUBLIC_KEY)))
+          .addMethod(
+            getGetGenesisMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetGenesisRequest,
+                pactus.blockchain.BlockchainOuterClass.GetGenesisResponse>(
+                  this, METHODID_GET_GENESIS)))
+          .addMethod(
+            getGetCommitteeMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest,
+                pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse>(
+                  this, METHODID_GET_COMMITTEE)))
+          .addMethod(
+            getSubscribeBlockHeightMethod(),
+            io.grpc.stub.ServerCalls.asyncServerStreamingCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest,
+                pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse>(
+                  this, METHODID_SUBSCRIBE_BLOCK_HEIGHT)))
+          .addMethod(
+            getSubscribeAccountMethod(),
+            io.grpc.stub.ServerCalls.asyncServerStreamingCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest,
+                pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse>(
+                  this, METHODID_SUBSCRIBE_ACCOUNT)))
+          .addMethod(
+            getGetParamsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.blockchain.BlockchainOuterClass.GetParamsRequest,
+                pactus.blockchain.BlockchainOuterClass.GetParamsResponse>(
+                  this, METHODID_GET_PARAMS)))
           .build();
     }
   }
@@ -541,6 +1081,30 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetBlockMethod(), getCallOptions()), request, responseObserver);
     }
 
+    /**
+     */
+    public void getRawBlock(pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetRawBlockMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getRecentBlocks(pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetRecentBlocksMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getBlockTransactions(pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetBlockTransactionsMethod(), getCallOptions()), request, responseObserver);
+    }
+
     /**
      */
     public void getBlockHash(pactus.blockchain.BlockchainOuterClass.GetBlockHashRequest request,
@@ -549,6 +1113,14 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetBlockHashMethod(), getCallOptions()), request, responseObserver);
     }
 
+    /**
+     */
+    public void getBlockHashByTime(pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetBlockHashByTimeMethod(), getCallOptions()), request, responseObserver);
+    }
+
     /**
      */
     public void getBlockHeight(pactus.blockchain.BlockchainOuterClass.GetBlockHeightRequest request,
@@ -581,6 +1153,14 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetAccountMethod(), getCallOptions()), request, responseObserver);
     }
 
+    /**
+     */
+    public void getAccounts(pactus.blockchain.BlockchainOuterClass.GetAccountsRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetAccountsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetAccountsMethod(), getCallOptions()), request, responseObserver);
+    }
+
     /**
      */
     public void getValidator(pactus.blockchain.BlockchainOuterClass.GetValidatorRequest request,
@@ -597,6 +1177,14 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetValidatorByNumberMethod(), getCallOptions()), request, responseObserver);
     }
 
+    /**
+     */
+    public void getValidatorHistory(pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetValidatorHistoryMethod(), getCallOptions()), request, responseObserver);
+    }
+
     /**
      */
     public void getValidatorAddresses(pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesRequest request,
@@ -612,6 +1200,54 @@ public final class BlockchainGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getGetPublicKeyMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     */
+    public void getAddressByPublicKey(pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetAddressByPublicKeyMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getGenesis(pactus.blockchain.BlockchainOuterClass.GetGenesisRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetGenesisResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetGenesisMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getCommittee(pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetCommitteeMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void subscribeBlockHeight(pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncServerStreamingCall(
+          getChannel().newCall(getSubscribeBlockHeightMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void subscribeAccount(pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncServerStreamingCall(
+          getChannel().newCall(getSubscribeAccountMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getParams(pactus.blockchain.BlockchainOuterClass.GetParamsRequest request,
+        io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetParamsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetParamsMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -635,6 +1271,27 @@ public final class BlockchainGrpc {
           getChannel(), getGetBlockMethod(), getCallOptions(), request);
     }
 
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse getRawBlock(pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetRawBlockMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse getRecentBlocks(pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetRecentBlocksMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse getBlockTransactions(pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetBlockTransactionsMethod(), getCallOptions(), request);
+    }
+
     /**
      */
     public pactus.blockchain.BlockchainOuterClass.GetBlockHashResponse getBlockHash(pactus.blockchain.BlockchainOuterClass.GetBlockHashRequest request) {
@@ -642,6 +1299,13 @@ public final class BlockchainGrpc {
           getChannel(), getGetBlockHashMethod(), getCallOptions(), request);
     }
 
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse getBlockHashByTime(pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetBlockHashByTimeMethod(), getCallOptions(), request);
+    }
+
     /**
      */
     public pactus.blockchain.BlockchainOuterClass.GetBlockHeightResponse getBlockHeight(pactus.blockchain.BlockchainOuterClass.GetBlockHeightRequest request) {
@@ -670,6 +1334,13 @@ public final class BlockchainGrpc {
           getChannel(), getGetAccountMethod(), getCallOptions(), request);
     }
 
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetAccountsResponse getAccounts(pactus.blockchain.BlockchainOuterClass.GetAccountsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetAccountsMethod(), getCallOptions(), request);
+    }
+
     /**
      */
     public pactus.blockchain.BlockchainOuterClass.GetValidatorResponse getValidator(pactus.blockchain.BlockchainOuterClass.GetValidatorRequest request) {
@@ -684,6 +1355,13 @@ public final class BlockchainGrpc {
           getChannel(), getGetValidatorByNumberMethod(), getCallOptions(), request);
     }
 
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse getValidatorHistory(pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetValidatorHistoryMethod(), getCallOptions(), request);
+    }
+
     /**
      */
     public pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesResponse getValidatorAddresses(pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesRequest request) {
@@ -697,6 +1375,50 @@ public final class BlockchainGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getGetPublicKeyMethod(), getCallOptions(), request);
     }
+
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse getAddressByPublicKey(pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetAddressByPublicKeyMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetGenesisResponse getGenesis(pactus.blockchain.BlockchainOuterClass.GetGenesisRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetGenesisMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse getCommittee(pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetCommitteeMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public java.util.Iterator<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse> subscribeBlockHeight(
+        pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest request) {
+      return io.grpc.stub.ClientCalls.blockingServerStreamingCall(
+          getChannel(), getSubscribeBlockHeightMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public java.util.Iterator<pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse> subscribeAccount(
+        pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest request) {
+      return io.grpc.stub.ClientCalls.blockingServerStreamingCall(
+          getChannel(), getSubscribeAccountMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.blockchain.BlockchainOuterClass.GetParamsResponse getParams(pactus.blockchain.BlockchainOuterClass.GetParamsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetParamsMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -721,6 +1443,30 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetBlockMethod(), getCallOptions()), request);
     }
 
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse> getRawBlock(
+        pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetRawBlockMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse> getRecentBlocks(
+        pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetRecentBlocksMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse> getBlockTransactions(
+        pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetBlockTransactionsMethod(), getCallOptions()), request);
+    }
+
     /**
      */
     public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetBlockHashResponse> getBlockHash(
@@ -729,6 +1475,14 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetBlockHashMethod(), getCallOptions()), request);
     }
 
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse> getBlockHashByTime(
+        pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetBlockHashByTimeMethod(), getCallOptions()), request);
+    }
+
     /**
      */
     public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetBlockHeightResponse> getBlockHeight(
@@ -761,6 +1515,14 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetAccountMethod(), getCallOptions()), request);
     }
 
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetAccountsResponse> getAccounts(
+        pactus.blockchain.BlockchainOuterClass.GetAccountsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetAccountsMethod(), getCallOptions()), request);
+    }
+
     /**
      */
     public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetValidatorResponse> getValidator(
@@ -777,6 +1539,14 @@ public final class BlockchainGrpc {
           getChannel().newCall(getGetValidatorByNumberMethod(), getCallOptions()), request);
     }
 
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse> getValidatorHistory(
+        pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetValidatorHistoryMethod(), getCallOptions()), request);
+    }
+
     /**
      */
     public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesResponse> getValidatorAddresses(
@@ -792,18 +1562,62 @@ public final class BlockchainGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getGetPublicKeyMethod(), getCallOptions()), request);
     }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse> getAddressByPublicKey(
+        pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetAddressByPublicKeyMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetGenesisResponse> getGenesis(
+        pactus.blockchain.BlockchainOuterClass.GetGenesisRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetGenesisMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse> getCommittee(
+        pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetCommitteeMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.blockchain.BlockchainOuterClass.GetParamsResponse> getParams(
+        pactus.blockchain.BlockchainOuterClass.GetParamsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetParamsMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_GET_BLOCK = 0;
-  private static final int METHODID_GET_BLOCK_HASH = 1;
-  private static final int METHODID_GET_BLOCK_HEIGHT = 2;
-  private static final int METHODID_GET_BLOCKCHAIN_INFO = 3;
-  private static final int METHODID_GET_CONSENSUS_INFO = 4;
-  private static final int METHODID_GET_ACCOUNT = 5;
-  private static final int METHODID_GET_VALIDATOR = 6;
-  private static final int METHODID_GET_VALIDATOR_BY_NUMBER = 7;
-  private static final int METHODID_GET_VALIDATOR_ADDRESSES = 8;
-  private static final int METHODID_GET_PUBLIC_KEY = 9;
+  private static final int METHODID_GET_RAW_BLOCK = 1;
+  private static final int METHODID_GET_RECENT_BLOCKS = 2;
+  private static final int METHODID_GET_BLOCK_TRANSACTIONS = 3;
+  private static final int METHODID_GET_BLOCK_HASH = 4;
+  private static final int METHODID_GET_BLOCK_HASH_BY_TIME = 5;
+  private static final int METHODID_GET_BLOCK_HEIGHT = 6;
+  private static final int METHODID_GET_BLOCKCHAIN_INFO = 7;
+  private static final int METHODID_GET_CONSENSUS_INFO = 8;
+  private static final int METHODID_GET_ACCOUNT = 9;
+  private static final int METHODID_GET_ACCOUNTS = 10;
+  private static final int METHODID_GET_VALIDATOR = 11;
+  private static final int METHODID_GET_VALIDATOR_BY_NUMBER = 12;
+  private static final int METHODID_GET_VALIDATOR_HISTORY = 13;
+  private static final int METHODID_GET_VALIDATOR_ADDRESSES = 14;
+  private static final int METHODID_GET_PUBLIC_KEY = 15;
+  private static final int METHODID_GET_ADDRESS_BY_PUBLIC_KEY = 16;
+  private static final int METHODID_GET_GENESIS = 17;
+  private static final int METHODID_GET_COMMITTEE = 18;
+  private static final int METHODID_SUBSCRIBE_BLOCK_HEIGHT = 19;
+  private static final int METHODID_SUBSCRIBE_ACCOUNT = 20;
+  private static final int METHODID_GET_PARAMS = 21;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -826,10 +1640,26 @@ public final class BlockchainGrpc {
           serviceImpl.getBlock((pactus.blockchain.BlockchainOuterClass.GetBlockRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockResponse>) responseObserver);
           break;
+        case METHODID_GET_RAW_BLOCK:
+          serviceImpl.getRawBlock((pactus.blockchain.BlockchainOuterClass.GetRawBlockRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetRawBlockResponse>) responseObserver);
+          break;
+        case METHODID_GET_RECENT_BLOCKS:
+          serviceImpl.getRecentBlocks((pactus.blockchain.BlockchainOuterClass.GetRecentBlocksRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetRecentBlocksResponse>) responseObserver);
+          break;
+        case METHODID_GET_BLOCK_TRANSACTIONS:
+          serviceImpl.getBlockTransactions((pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockTransactionsResponse>) responseObserver);
+          break;
         case METHODID_GET_BLOCK_HASH:
           serviceImpl.getBlockHash((pactus.blockchain.BlockchainOuterClass.GetBlockHashRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockHashResponse>) responseObserver);
           break;
+        case METHODID_GET_BLOCK_HASH_BY_TIME:
+          serviceImpl.getBlockHashByTime((pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockHashByTimeResponse>) responseObserver);
+          break;
         case METHODID_GET_BLOCK_HEIGHT:
           serviceImpl.getBlockHeight((pactus.blockchain.BlockchainOuterClass.GetBlockHeightRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetBlockHeightResponse>) responseObserver);
@@ -846,6 +1676,10 @@ public final class BlockchainGrpc {
           serviceImpl.getAccount((pactus.blockchain.BlockchainOuterClass.GetAccountRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetAccountResponse>) responseObserver);
           break;
+        case METHODID_GET_ACCOUNTS:
+          serviceImpl.getAccounts((pactus.blockchain.BlockchainOuterClass.GetAccountsRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetAccountsResponse>) responseObserver);
+          break;
         case METHODID_GET_VALIDATOR:
           serviceImpl.getValidator((pactus.blockchain.BlockchainOuterClass.GetValidatorRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetValidatorResponse>) responseObserver);
@@ -854,6 +1688,10 @@ public final class BlockchainGrpc {
           serviceImpl.getValidatorByNumber((pactus.blockchain.BlockchainOuterClass.GetValidatorByNumberRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetValidatorResponse>) responseObserver);
           break;
+        case METHODID_GET_VALIDATOR_HISTORY:
+          serviceImpl.getValidatorHistory((pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetValidatorHistoryResponse>) responseObserver);
+          break;
         case METHODID_GET_VALIDATOR_ADDRESSES:
           serviceImpl.getValidatorAddresses((pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetValidatorAddressesResponse>) responseObserver);
@@ -862,6 +1700,30 @@ public final class BlockchainGrpc {
           serviceImpl.getPublicKey((pactus.blockchain.BlockchainOuterClass.GetPublicKeyRequest) request,
               (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetPublicKeyResponse>) responseObserver);
           break;
+        case METHODID_GET_ADDRESS_BY_PUBLIC_KEY:
+          serviceImpl.getAddressByPublicKey((pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetAddressByPublicKeyResponse>) responseObserver);
+          break;
+        case METHODID_GET_GENESIS:
+          serviceImpl.getGenesis((pactus.blockchain.BlockchainOuterClass.GetGenesisRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetGenesisResponse>) responseObserver);
+          break;
+        case METHODID_GET_COMMITTEE:
+          serviceImpl.getCommittee((pactus.blockchain.BlockchainOuterClass.GetCommitteeRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetCommitteeResponse>) responseObserver);
+          break;
+        case METHODID_SUBSCRIBE_BLOCK_HEIGHT:
+          serviceImpl.subscribeBlockHeight((pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.SubscribeBlockHeightResponse>) responseObserver);
+          break;
+        case METHODID_SUBSCRIBE_ACCOUNT:
+          serviceImpl.subscribeAccount((pactus.blockchain.BlockchainOuterClass.SubscribeAccountRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.SubscribeAccountResponse>) responseObserver);
+          break;
+        case METHODID_GET_PARAMS:
+          serviceImpl.getParams((pactus.blockchain.BlockchainOuterClass.GetParamsRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.blockchain.BlockchainOuterClass.GetParamsResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -924,15 +1786,27 @@ public final class BlockchainGrpc {
           serviceDescriptor = result = io.grpc.ServiceDescriptor.newBuilder(SERVICE_NAME)
               .setSchemaDescriptor(new BlockchainFileDescriptorSupplier())
               .addMethod(getGetBlockMethod())
+              .addMethod(getGetRawBlockMethod())
+              .addMethod(getGetRecentBlocksMethod())
+              .addMethod(getGetBlockTransactionsMethod())
               .addMethod(getGetBlockHashMethod())
+              .addMethod(getGetBlockHashByTimeMethod())
               .addMethod(getGetBlockHeightMethod())
               .addMethod(getGetBlockchainInfoMethod())
               .addMethod(getGetConsensusInfoMethod())
               .addMethod(getGetAccountMethod())
+              .addMethod(getGetAccountsMethod())
               .addMethod(getGetValidatorMethod())
               .addMethod(getGetValidatorByNumberMethod())
+              .addMethod(getGetValidatorHistoryMethod())
               .addMethod(getGetValidatorAddressesMethod())
               .addMethod(getGetPublicKeyMethod())
+              .addMethod(getGetAddressByPublicKeyMethod())
+              .addMethod(getGetGenesisMethod())
+              .addMethod(getGetCommitteeMethod())
+              .addMethod(getSubscribeBlockHeightMethod())
+              .addMethod(getSubscribeAccountMethod())
+              .addMethod(getGetParamsMethod())
               .build();
         }
       }
@@ -77,6 +77,192 @@ public final class NetworkGrpc {
     return getGetNodeInfoMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.BanPeerRequest,
+      pactus.network.NetworkOuterClass.BanPeerResponse> getBanPeerMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "BanPeer",
+      requestType = pactus.network.NetworkOuterClass.BanPeerRequest.class,
+      responseType = pactus.network.NetworkOuterClass.BanPeerResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.BanPeerRequest,
+      pactus.network.NetworkOuterClass.BanPeerResponse> getBanPeerMethod() {
+    io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.BanPeerRequest, pactus.network.NetworkOuterClass.BanPeerResponse> getBanPeerMethod;
+    if ((getBanPeerMethod = NetworkGrpc.getBanPeerMethod) == null) {
+      synchronized (NetworkGrpc.class) {
+        if ((getBanPeerMethod = NetworkGrpc.getBanPeerMethod) == null) {
+          NetworkGrpc.getBanPeerMethod = getBanPeerMethod =
+              io.grpc.MethodDescriptor.<pactus.network.NetworkOuterClass.BanPeerRequest, pactus.network.NetworkOuterClass.BanPeerResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "BanPeer"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.BanPeerRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.BanPeerResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new NetworkMethodDescriptorSupplier("BanPeer"))
+              .build();
+        }
+      }
+    }
+    return getBanPeerMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.UnbanPeerRequest,
+      pactus.network.NetworkOuterClass.UnbanPeerResponse> getUnbanPeerMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "UnbanPeer",
+      requestType = pactus.network.NetworkOuterClass.UnbanPeerRequest.class,
+      responseType = pactus.network.NetworkOuterClass.UnbanPeerResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.UnbanPeerRequest,
+      pactus.network.NetworkOuterClass.UnbanPeerResponse> getUnbanPeerMethod() {
+    io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.UnbanPeerRequest, pactus.network.NetworkOuterClass.UnbanPeerResponse> getUnbanPeerMethod;
+    if ((getUnbanPeerMethod = NetworkGrpc.getUnbanPeerMethod) == null) {
+      synchronized (NetworkGrpc.class) {
+        if ((getUnbanPeerMethod = NetworkGrpc.getUnbanPeerMethod) == null) {
+          NetworkGrpc.getUnbanPeerMethod = getUnbanPeerMethod =
+              io.grpc.MethodDescriptor.<pactus.network.NetworkOuterClass.UnbanPeerRequest, pactus.network.NetworkOuterClass.UnbanPeerResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "UnbanPeer"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.UnbanPeerRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.UnbanPeerResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new NetworkMethodDescriptorSupplier("UnbanPeer"))
+              .build();
+        }
+      }
+    }
+    return getUnbanPeerMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.GetRewardAddressesRequest,
+      pactus.network.NetworkOuterClass.GetRewardAddressesResponse> getGetRewardAddressesMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetRewardAddresses",
+      requestType = pactus.network.NetworkOuterClass.GetRewardAddressesRequest.class,
+      responseType = pactus.network.NetworkOuterClass.GetRewardAddressesResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.GetRewardAddressesRequest,
+      pactus.network.NetworkOuterClass.GetRewardAddressesResponse> getGetRewardAddressesMethod() {
+    io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.GetRewardAddressesRequest, pactus.network.NetworkOuterClass.GetRewardAddressesResponse> getGetRewardAddressesMethod;
+    if ((getGetRewardAddressesMethod = NetworkGrpc.getGetRewardAddressesMethod) == null) {
+      synchronized (NetworkGrpc.class) {
+        if ((getGetRewardAddressesMethod = NetworkGrpc.getGetRewardAddressesMethod) == null) {
+          NetworkGrpc.getGetRewardAddressesMethod = getGetRewardAddressesMethod =
+              io.grpc.MethodDescriptor.<pactus.network.NetworkOuterClass.GetRewardAddressesRequest, pactus.network.NetworkOuterClass.GetRewardAddressesResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetRewardAddresses"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.GetRewardAddressesRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.GetRewardAddressesResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new NetworkMethodDescriptorSupplier("GetRewardAddresses"))
+              .build();
+        }
+      }
+    }
+    return getGetRewardAddressesMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.PauseSyncRequest,
+      pactus.network.NetworkOuterClass.PauseSyncResponse> getPauseSyncMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "PauseSync",
+      requestType = pactus.network.NetworkOuterClass.PauseSyncRequest.class,
+      responseType = pactus.network.NetworkOuterClass.PauseSyncResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.PauseSyncRequest,
+      pactus.network.NetworkOuterClass.PauseSyncResponse> getPauseSyncMethod() {
+    io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.PauseSyncRequest, pactus.network.NetworkOuterClass.PauseSyncResponse> getPauseSyncMethod;
+    if ((getPauseSyncMethod = NetworkGrpc.getPauseSyncMethod) == null) {
+      synchronized (NetworkGrpc.class) {
+        if ((getPauseSyncMethod = NetworkGrpc.getPauseSyncMethod) == null) {
+          NetworkGrpc.getPauseSyncMethod = getPauseSyncMethod =
+              io.grpc.MethodDescriptor.<pactus.network.NetworkOuterClass.PauseSyncRequest, pactus.network.NetworkOuterClass.PauseSyncResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "PauseSync"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.PauseSyncRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.PauseSyncResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new NetworkMethodDescriptorSupplier("PauseSync"))
+              .build();
+        }
+      }
+    }
+    return getPauseSyncMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.ResumeSyncRequest,
+      pactus.network.NetworkOuterClass.ResumeSyncResponse> getResumeSyncMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ResumeSync",
+      requestType = pactus.network.NetworkOuterClass.ResumeSyncRequest.class,
+      responseType = pactus.network.NetworkOuterClass.ResumeSyncResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.ResumeSyncRequest,
+      pactus.network.NetworkOuterClass.ResumeSyncResponse> getResumeSyncMethod() {
+    io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.ResumeSyncRequest, pactus.network.NetworkOuterClass.ResumeSyncResponse> getResumeSyncMethod;
+    if ((getResumeSyncMethod = NetworkGrpc.getResumeSyncMethod) == null) {
+      synchronized (NetworkGrpc.class) {
+        if ((getResumeSyncMethod = NetworkGrpc.getResumeSyncMethod) == null) {
+          NetworkGrpc.getResumeSyncMethod = getResumeSyncMethod =
+              io.grpc.MethodDescriptor.<pactus.network.NetworkOuterClass.ResumeSyncRequest, pactus.network.NetworkOuterClass.ResumeSyncResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ResumeSync"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.ResumeSyncRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.ResumeSyncResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new NetworkMethodDescriptorSupplier("ResumeSync"))
+              .build();
+        }
+      }
+    }
+    return getResumeSyncMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.GetSessionsRequest,
+      pactus.network.NetworkOuterClass.GetSessionsResponse> getGetSessionsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetSessions",
+      requestType = pactus.network.NetworkOuterClass.GetSessionsRequest.class,
+      responseType = pactus.network.NetworkOuterClass.GetSessionsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.GetSessionsRequest,
+      pactus.network.NetworkOuterClass.GetSessionsResponse> getGetSessionsMethod() {
+    io.grpc.MethodDescriptor<pactus.network.NetworkOuterClass.GetSessionsRequest, pactus.network.NetworkOuterClass.GetSessionsResponse> getGetSessionsMethod;
+    if ((getGetSessionsMethod = NetworkGrpc.getGetSessionsMethod) == null) {
+      synchronized (NetworkGrpc.class) {
+        if ((getGetSessionsMethod = NetworkGrpc.getGetSessionsMethod) == null) {
+          NetworkGrpc.getGetSessionsMethod = getGetSessionsMethod =
+              io.grpc.MethodDescriptor.<pactus.network.NetworkOuterClass.GetSessionsRequest, pactus.network.NetworkOuterClass.GetSessionsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetSessions"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.GetSessionsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.network.NetworkOuterClass.GetSessionsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new NetworkMethodDescriptorSupplier("GetSessions"))
+              .build();
+        }
+      }
+    }
+    return getGetSessionsMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -139,6 +325,48 @@ public final class NetworkGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetNodeInfoMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void banPeer(pactus.network.NetworkOuterClass.BanPeerRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.BanPeerResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getBanPeerMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void unbanPeer(pactus.network.NetworkOuterClass.UnbanPeerRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.UnbanPeerResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getUnbanPeerMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getRewardAddresses(pactus.network.NetworkOuterClass.GetRewardAddressesRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.GetRewardAddressesResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetRewardAddressesMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void pauseSync(pactus.network.NetworkOuterClass.PauseSyncRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.PauseSyncResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getPauseSyncMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void resumeSync(pactus.network.NetworkOuterClass.ResumeSyncRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.ResumeSyncResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getResumeSyncMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getSessions(pactus.network.NetworkOuterClass.GetSessionsRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.GetSessionsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetSessionsMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -155,6 +383,48 @@ public final class NetworkGrpc {
                 pactus.network.NetworkOuterClass.GetNodeInfoRequest,
                 pactus.network.NetworkOuterClass.GetNodeInfoResponse>(
                   this, METHODID_GET_NODE_INFO)))
+          .addMethod(
+            getBanPeerMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.network.NetworkOuterClass.BanPeerRequest,
+                pactus.network.NetworkOuterClass.BanPeerResponse>(
+                  this, METHODID_BAN_PEER)))
+          .addMethod(
+            getUnbanPeerMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.network.NetworkOuterClass.UnbanPeerRequest,
+                pactus.network.NetworkOuterClass.UnbanPeerResponse>(
+                  this, METHODID_UNBAN_PEER)))
+          .addMethod(
+            getGetRewardAddressesMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.network.NetworkOuterClass.GetRewardAddressesRequest,
+                pactus.network.NetworkOuterClass.GetRewardAddressesResponse>(
+                  this, METHODID_GET_REWARD_ADDRESSES)))
+          .addMethod(
+            getPauseSyncMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.network.NetworkOuterClass.PauseSyncRequest,
+                pactus.network.NetworkOuterClass.PauseSyncResponse>(
+                  this, METHODID_PAUSE_SYNC)))
+          .addMethod(
+            getResumeSyncMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.network.NetworkOuterClass.ResumeSyncRequest,
+                pactus.network.NetworkOuterClass.ResumeSyncResponse>(
+                  this, METHODID_RESUME_SYNC)))
+          .addMethod(
+            getGetSessionsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.network.NetworkOuterClass.GetSessionsRequest,
+                pactus.network.NetworkOuterClass.GetSessionsResponse>(
+                  this, METHODID_GET_SESSIONS)))
           .build();
     }
   }
@@ -188,6 +458,54 @@ public final class NetworkGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getGetNodeInfoMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     */
+    public void banPeer(pactus.network.NetworkOuterClass.BanPeerRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.BanPeerResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getBanPeerMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void unbanPeer(pactus.network.NetworkOuterClass.UnbanPeerRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.UnbanPeerResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getUnbanPeerMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getRewardAddresses(pactus.network.NetworkOuterClass.GetRewardAddressesRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.GetRewardAddressesResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetRewardAddressesMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void pauseSync(pactus.network.NetworkOuterClass.PauseSyncRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.PauseSyncResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getPauseSyncMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void resumeSync(pactus.network.NetworkOuterClass.ResumeSyncRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.ResumeSyncResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getResumeSyncMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getSessions(pactus.network.NetworkOuterClass.GetSessionsRequest request,
+        io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.GetSessionsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetSessionsMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -217,6 +535,48 @@ public final class NetworkGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getGetNodeInfoMethod(), getCallOptions(), request);
     }
+
+    /**
+     */
+    public pactus.network.NetworkOuterClass.BanPeerResponse banPeer(pactus.network.NetworkOuterClass.BanPeerRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getBanPeerMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.network.NetworkOuterClass.UnbanPeerResponse unbanPeer(pactus.network.NetworkOuterClass.UnbanPeerRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getUnbanPeerMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.network.NetworkOuterClass.GetRewardAddressesResponse getRewardAddresses(pactus.network.NetworkOuterClass.GetRewardAddressesRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetRewardAddressesMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.network.NetworkOuterClass.PauseSyncResponse pauseSync(pactus.network.NetworkOuterClass.PauseSyncRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getPauseSyncMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.network.NetworkOuterClass.ResumeSyncResponse resumeSync(pactus.network.NetworkOuterClass.ResumeSyncRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getResumeSyncMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.network.NetworkOuterClass.GetSessionsResponse getSessions(pactus.network.NetworkOuterClass.GetSessionsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetSessionsMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -248,10 +608,64 @@ public final class NetworkGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getGetNodeInfoMethod(), getCallOptions()), request);
     }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.network.NetworkOuterClass.BanPeerResponse> banPeer(
+        pactus.network.NetworkOuterClass.BanPeerRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getBanPeerMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.network.NetworkOuterClass.UnbanPeerResponse> unbanPeer(
+        pactus.network.NetworkOuterClass.UnbanPeerRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getUnbanPeerMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.network.NetworkOuterClass.GetRewardAddressesResponse> getRewardAddresses(
+        pactus.network.NetworkOuterClass.GetRewardAddressesRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetRewardAddressesMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.network.NetworkOuterClass.PauseSyncResponse> pauseSync(
+        pactus.network.NetworkOuterClass.PauseSyncRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getPauseSyncMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.network.NetworkOuterClass.ResumeSyncResponse> resumeSync(
+        pactus.network.NetworkOuterClass.ResumeSyncRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getResumeSyncMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.network.NetworkOuterClass.GetSessionsResponse> getSessions(
+        pactus.network.NetworkOuterClass.GetSessionsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetSessionsMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_GET_NETWORK_INFO = 0;
   private static final int METHODID_GET_NODE_INFO = 1;
+  private static final int METHODID_BAN_PEER = 2;
+  private static final int METHODID_UNBAN_PEER = 3;
+  private static final int METHODID_GET_REWARD_ADDRESSES = 4;
+  private static final int METHODID_PAUSE_SYNC = 5;
+  private static final int METHODID_RESUME_SYNC = 6;
+  private static final int METHODID_GET_SESSIONS = 7;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -278,6 +692,30 @@ public final class NetworkGrpc {
           serviceImpl.getNodeInfo((pactus.network.NetworkOuterClass.GetNodeInfoRequest) request,
               (io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.GetNodeInfoResponse>) responseObserver);
           break;
+        case METHODID_BAN_PEER:
+          serviceImpl.banPeer((pactus.network.NetworkOuterClass.BanPeerRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.BanPeerResponse>) responseObserver);
+          break;
+        case METHODID_UNBAN_PEER:
+          serviceImpl.unbanPeer((pactus.network.NetworkOuterClass.UnbanPeerRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.UnbanPeerResponse>) responseObserver);
+          break;
+        case METHODID_GET_REWARD_ADDRESSES:
+          serviceImpl.getRewardAddresses((pactus.network.NetworkOuterClass.GetRewardAddressesRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.GetRewardAddressesResponse>) responseObserver);
+          break;
+        case METHODID_PAUSE_SYNC:
+          serviceImpl.pauseSync((pactus.network.NetworkOuterClass.PauseSyncRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.PauseSyncResponse>) responseObserver);
+          break;
+        case METHODID_RESUME_SYNC:
+          serviceImpl.resumeSync((pactus.network.NetworkOuterClass.ResumeSyncRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.ResumeSyncResponse>) responseObserver);
+          break;
+        case METHODID_GET_SESSIONS:
+          serviceImpl.getSessions((pactus.network.NetworkOuterClass.GetSessionsRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.network.NetworkOuterClass.GetSessionsResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -341,6 +779,12 @@ public final class NetworkGrpc {
               .setSchemaDescriptor(new NetworkFileDescriptorSupplier())
               .addMethod(getGetNetworkInfoMethod())
               .addMethod(getGetNodeInfoMethod())
+              .addMethod(getBanPeerMethod())
+              .addMethod(getUnbanPeerMethod())
+              .addMethod(getGetRewardAddressesMethod())
+              .addMethod(getPauseSyncMethod())
+              .addMethod(getResumeSyncMethod())
+              .addMethod(getGetSessionsMethod())
               .build();
         }
       }
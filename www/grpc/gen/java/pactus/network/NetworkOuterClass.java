@@ -527,6 +527,30 @@ public final class NetworkOuterClass {
 
     long getReceivedBytesOrThrow(
         int key);
+
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    java.util.List<pactus.network.NetworkOuterClass.SessionInfo> 
+        getSessionsList();
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    pactus.network.NetworkOuterClass.SessionInfo getSessions(int index);
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    int getSessionsCount();
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    java.util.List<? extends pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+        getSessionsOrBuilderList();
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    pactus.network.NetworkOuterClass.SessionInfoOrBuilder getSessionsOrBuilder(
+        int index);
   }
   /**
    * Protobuf type {@code pactus.GetNetworkInfoResponse}
@@ -542,6 +566,7 @@ public final class NetworkOuterClass {
     }
     private GetNetworkInfoResponse() {
       peers_ = java.util.Collections.emptyList();
+      sessions_ = java.util.Collections.emptyList();
     }
 
     @java.lang.Override
@@ -818,6 +843,46 @@ public final class NetworkOuterClass {
       return map.get(key);
     }
 
+    public static final int SESSIONS_FIELD_NUMBER = 7;
+    private java.util.List<pactus.network.NetworkOuterClass.SessionInfo> sessions_;
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public java.util.List<pactus.network.NetworkOuterClass.SessionInfo> getSessionsList() {
+      return sessions_;
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+        getSessionsOrBuilderList() {
+      return sessions_;
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public int getSessionsCount() {
+      return sessions_.size();
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.SessionInfo getSessions(int index) {
+      return sessions_.get(index);
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.SessionInfoOrBuilder getSessionsOrBuilder(
+        int index) {
+      return sessions_.get(index);
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -856,6 +921,9 @@ public final class NetworkOuterClass {
           internalGetReceivedBytes(),
           ReceivedBytesDefaultEntryHolder.defaultEntry,
           6);
+      for (int i = 0; i < sessions_.size(); i++) {
+        output.writeMessage(7, sessions_.get(i));
+      }
       getUnknownFields().writeTo(output);
     }
 
@@ -901,6 +969,10 @@ public final class NetworkOuterClass {
         size += com.google.protobuf.CodedOutputStream
             .computeMessageSize(6, receivedBytes__);
       }
+      for (int i = 0; i < sessions_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(7, sessions_.get(i));
+      }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
       return size;
@@ -928,6 +1000,8 @@ public final class NetworkOuterClass {
           other.internalGetSentBytes())) return false;
       if (!internalGetReceivedBytes().equals(
           other.internalGetReceivedBytes())) return false;
+      if (!getSessionsList()
+          .equals(other.getSessionsList())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -958,6 +1032,10 @@ public final class NetworkOuterClass {
         hash = (37 * hash) + RECEIVED_BYTES_FIELD_NUMBER;
         hash = (53 * hash) + internalGetReceivedBytes().hashCode();
       }
+      if (getSessionsCount() > 0) {
+        hash = (37 * hash) + SESSIONS_FIELD_NUMBER;
+        hash = (53 * hash) + getSessionsList().hashCode();
+      }
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -1127,6 +1205,13 @@ public final class NetworkOuterClass {
         bitField0_ = (bitField0_ & ~0x00000001);
         internalGetMutableSentBytes().clear();
         internalGetMutableReceivedBytes().clear();
+        if (sessionsBuilder_ == null) {
+          sessions_ = java.util.Collections.emptyList();
+        } else {
+          sessions_ = null;
+          sessionsBuilder_.clear();
+        }
+        bitField0_ = (bitField0_ & ~0x00000002);
         return this;
       }
 
@@ -1170,6 +1255,15 @@ public final class NetworkOuterClass {
         result.sentBytes_.makeImmutable();
         result.receivedBytes_ = internalGetReceivedBytes();
         result.receivedBytes_.makeImmutable();
+        if (sessionsBuilder_ == null) {
+          if (((bitField0_ & 0x00000002) != 0)) {
+            sessions_ = java.util.Collections.unmodifiableList(sessions_);
+            bitField0_ = (bitField0_ & ~0x00000002);
+          }
+          result.sessions_ = sessions_;
+        } else {
+          result.sessions_ = sessionsBuilder_.build();
+        }
         onBuilt();
         return result;
       }
@@ -1257,6 +1351,32 @@ public final class NetworkOuterClass {
             other.internalGetSentBytes());
         internalGetMutableReceivedBytes().mergeFrom(
             other.internalGetReceivedBytes());
+        if (sessionsBuilder_ == null) {
+          if (!other.sessions_.isEmpty()) {
+            if (sessions_.isEmpty()) {
+              sessions_ = other.sessions_;
+              bitField0_ = (bitField0_ & ~0x00000002);
+            } else {
+              ensureSessionsIsMutable();
+              sessions_.addAll(other.sessions_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.sessions_.isEmpty()) {
+            if (sessionsBuilder_.isEmpty()) {
+              sessionsBuilder_.dispose();
+              sessionsBuilder_ = null;
+              sessions_ = other.sessions_;
+              bitField0_ = (bitField0_ & ~0x00000002);
+              sessionsBuilder_ = 
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getSessionsFieldBuilder() : null;
+            } else {
+              sessionsBuilder_.addAllMessages(other.sessions_);
+            }
+          }
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -1327,6 +1447,19 @@ public final class NetworkOuterClass {
                     receivedBytes__.getKey(), receivedBytes__.getValue());
                 break;
               } // case 50
+              case 58: {
+                pactus.network.NetworkOuterClass.SessionInfo m =
+                    input.readMessage(
+                        pactus.network.NetworkOuterClass.SessionInfo.parser(),
+                        extensionRegistry);
+                if (sessionsBuilder_ == null) {
+                  ensureSessionsIsMutable();
+                  sessions_.add(m);
+                } else {
+                  sessionsBuilder_.addMessage(m);
+                }
+                break;
+              } // case 58
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -1932,6 +2065,246 @@ public final class NetworkOuterClass {
             .putAll(values);
         return this;
       }
+
+      private java.util.List<pactus.network.NetworkOuterClass.SessionInfo> sessions_ =
+        java.util.Collections.emptyList();
+      private void ensureSessionsIsMutable() {
+        if (!((bitField0_ & 0x00000002) != 0)) {
+          sessions_ = new java.util.ArrayList<pactus.network.NetworkOuterClass.SessionInfo>(sessions_);
+          bitField0_ |= 0x00000002;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          pactus.network.NetworkOuterClass.SessionInfo, pactus.network.NetworkOuterClass.SessionInfo.Builder, pactus.network.NetworkOuterClass.SessionInfoOrBuilder> sessionsBuilder_;
+
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public java.util.List<pactus.network.NetworkOuterClass.SessionInfo> getSessionsList() {
+        if (sessionsBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(sessions_);
+        } else {
+          return sessionsBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public int getSessionsCount() {
+        if (sessionsBuilder_ == null) {
+          return sessions_.size();
+        } else {
+          return sessionsBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo getSessions(int index) {
+        if (sessionsBuilder_ == null) {
+          return sessions_.get(index);
+        } else {
+          return sessionsBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder setSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo value) {
+        if (sessionsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSessionsIsMutable();
+          sessions_.set(index, value);
+          onChanged();
+        } else {
+          sessionsBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder setSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo.Builder builderForValue) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          sessionsBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(pactus.network.NetworkOuterClass.SessionInfo value) {
+        if (sessionsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSessionsIsMutable();
+          sessions_.add(value);
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo value) {
+        if (sessionsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSessionsIsMutable();
+          sessions_.add(index, value);
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(
+          pactus.network.NetworkOuterClass.SessionInfo.Builder builderForValue) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.add(builderForValue.build());
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo.Builder builderForValue) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder addAllSessions(
+          java.lang.Iterable<? extends pactus.network.NetworkOuterClass.SessionInfo> values) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, sessions_);
+          onChanged();
+        } else {
+          sessionsBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder clearSessions() {
+        if (sessionsBuilder_ == null) {
+          sessions_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000002);
+          onChanged();
+        } else {
+          sessionsBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public Builder removeSessions(int index) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.remove(index);
+          onChanged();
+        } else {
+          sessionsBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo.Builder getSessionsBuilder(
+          int index) {
+        return getSessionsFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfoOrBuilder getSessionsOrBuilder(
+          int index) {
+        if (sessionsBuilder_ == null) {
+          return sessions_.get(index);  } else {
+          return sessionsBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public java.util.List<? extends pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+           getSessionsOrBuilderList() {
+        if (sessionsBuilder_ != null) {
+          return sessionsBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(sessions_);
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo.Builder addSessionsBuilder() {
+        return getSessionsFieldBuilder().addBuilder(
+            pactus.network.NetworkOuterClass.SessionInfo.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo.Builder addSessionsBuilder(
+          int index) {
+        return getSessionsFieldBuilder().addBuilder(
+            index, pactus.network.NetworkOuterClass.SessionInfo.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 7 [json_name = "sessions"];</code>
+       */
+      public java.util.List<pactus.network.NetworkOuterClass.SessionInfo.Builder> 
+           getSessionsBuilderList() {
+        return getSessionsFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          pactus.network.NetworkOuterClass.SessionInfo, pactus.network.NetworkOuterClass.SessionInfo.Builder, pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+          getSessionsFieldBuilder() {
+        if (sessionsBuilder_ == null) {
+          sessionsBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              pactus.network.NetworkOuterClass.SessionInfo, pactus.network.NetworkOuterClass.SessionInfo.Builder, pactus.network.NetworkOuterClass.SessionInfoOrBuilder>(
+                  sessions_,
+                  ((bitField0_ & 0x00000002) != 0),
+                  getParentForChildren(),
+                  isClean());
+          sessions_ = null;
+        }
+        return sessionsBuilder_;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -1996,30 +2369,98 @@ public final class NetworkOuterClass {
 
   }
 
-  public interface GetNodeInfoRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:pactus.GetNodeInfoRequest)
+  public interface SessionInfoOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.SessionInfo)
       com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>int32 session_id = 1 [json_name = "sessionId"];</code>
+     * @return The sessionId.
+     */
+    int getSessionId();
+
+    /**
+     * <code>bytes peer_id = 2 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
+    com.google.protobuf.ByteString getPeerId();
+
+    /**
+     * <code>uint32 requested_blocks = 3 [json_name = "requestedBlocks"];</code>
+     * @return The requestedBlocks.
+     */
+    int getRequestedBlocks();
+
+    /**
+     * <code>uint32 received_blocks = 4 [json_name = "receivedBlocks"];</code>
+     * @return The receivedBlocks.
+     */
+    int getReceivedBlocks();
+
+    /**
+     * <code>int64 last_activity_at = 5 [json_name = "lastActivityAt"];</code>
+     * @return The lastActivityAt.
+     */
+    long getLastActivityAt();
+
+    /**
+     * <code>int64 opened_at = 6 [json_name = "openedAt"];</code>
+     * @return The openedAt.
+     */
+    long getOpenedAt();
+
+    /**
+     * <pre>
+     * Age of the session in seconds.
+     * </pre>
+     *
+     * <code>int64 age = 7 [json_name = "age"];</code>
+     * @return The age.
+     */
+    long getAge();
+
+    /**
+     * <pre>
+     * Last response code received in the session, like "more-blocks".
+     * </pre>
+     *
+     * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+     * @return The lastResponseCode.
+     */
+    java.lang.String getLastResponseCode();
+    /**
+     * <pre>
+     * Last response code received in the session, like "more-blocks".
+     * </pre>
+     *
+     * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+     * @return The bytes for lastResponseCode.
+     */
+    com.google.protobuf.ByteString
+        getLastResponseCodeBytes();
   }
   /**
-   * Protobuf type {@code pactus.GetNodeInfoRequest}
+   * Protobuf type {@code pactus.SessionInfo}
    */
-  public static final class GetNodeInfoRequest extends
+  public static final class SessionInfo extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:pactus.GetNodeInfoRequest)
-      GetNodeInfoRequestOrBuilder {
+      // @@protoc_insertion_point(message_implements:pactus.SessionInfo)
+      SessionInfoOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use GetNodeInfoRequest.newBuilder() to construct.
-    private GetNodeInfoRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use SessionInfo.newBuilder() to construct.
+    private SessionInfo(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private GetNodeInfoRequest() {
+    private SessionInfo() {
+      peerId_ = com.google.protobuf.ByteString.EMPTY;
+      lastResponseCode_ = "";
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new GetNodeInfoRequest();
+      return new SessionInfo();
     }
 
     @java.lang.Override
@@ -2029,43 +2470,225 @@ public final class NetworkOuterClass {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_descriptor;
+      return pactus.network.NetworkOuterClass.internal_static_pactus_SessionInfo_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_fieldAccessorTable
+      return pactus.network.NetworkOuterClass.internal_static_pactus_SessionInfo_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              pactus.network.NetworkOuterClass.GetNodeInfoRequest.class, pactus.network.NetworkOuterClass.GetNodeInfoRequest.Builder.class);
+              pactus.network.NetworkOuterClass.SessionInfo.class, pactus.network.NetworkOuterClass.SessionInfo.Builder.class);
     }
 
-    private byte memoizedIsInitialized = -1;
+    public static final int SESSION_ID_FIELD_NUMBER = 1;
+    private int sessionId_;
+    /**
+     * <code>int32 session_id = 1 [json_name = "sessionId"];</code>
+     * @return The sessionId.
+     */
     @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
+    public int getSessionId() {
+      return sessionId_;
     }
 
+    public static final int PEER_ID_FIELD_NUMBER = 2;
+    private com.google.protobuf.ByteString peerId_;
+    /**
+     * <code>bytes peer_id = 2 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
     @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      getUnknownFields().writeTo(output);
+    public com.google.protobuf.ByteString getPeerId() {
+      return peerId_;
     }
 
+    public static final int REQUESTED_BLOCKS_FIELD_NUMBER = 3;
+    private int requestedBlocks_;
+    /**
+     * <code>uint32 requested_blocks = 3 [json_name = "requestedBlocks"];</code>
+     * @return The requestedBlocks.
+     */
     @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      size += getUnknownFields().getSerializedSize();
-      memoizedSize = size;
-      return size;
+    public int getRequestedBlocks() {
+      return requestedBlocks_;
+    }
+
+    public static final int RECEIVED_BLOCKS_FIELD_NUMBER = 4;
+    private int receivedBlocks_;
+    /**
+     * <code>uint32 received_blocks = 4 [json_name = "receivedBlocks"];</code>
+     * @return The receivedBlocks.
+     */
+    @java.lang.Override
+    public int getReceivedBlocks() {
+      return receivedBlocks_;
+    }
+
+    public static final int LAST_ACTIVITY_AT_FIELD_NUMBER = 5;
+    private long lastActivityAt_;
+    /**
+     * <code>int64 last_activity_at = 5 [json_name = "lastActivityAt"];</code>
+     * @return The lastActivityAt.
+     */
+    @java.lang.Override
+    public long getLastActivityAt() {
+      return lastActivityAt_;
+    }
+
+    public static final int OPENED_AT_FIELD_NUMBER = 6;
+    private long openedAt_;
+    /**
+     * <code>int64 opened_at = 6 [json_name = "openedAt"];</code>
+     * @return The openedAt.
+     */
+    @java.lang.Override
+    public long getOpenedAt() {
+      return openedAt_;
+    }
+
+    public static final int AGE_FIELD_NUMBER = 7;
+    private long age_;
+    /**
+     * <pre>
+     * Age of the session in seconds.
+     * </pre>
+     *
+     * <code>int64 age = 7 [json_name = "age"];</code>
+     * @return The age.
+     */
+    @java.lang.Override
+    public long getAge() {
+      return age_;
+    }
+
+    public static final int LAST_RESPONSE_CODE_FIELD_NUMBER = 8;
+    private volatile java.lang.Object lastResponseCode_;
+    /**
+     * <pre>
+     * Last response code received in the session, like "more-blocks".
+     * </pre>
+     *
+     * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+     * @return The lastResponseCode.
+     */
+    @java.lang.Override
+    public java.lang.String getLastResponseCode() {
+      java.lang.Object ref = lastResponseCode_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        lastResponseCode_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * Last response code received in the session, like "more-blocks".
+     * </pre>
+     *
+     * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+     * @return The bytes for lastResponseCode.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getLastResponseCodeBytes() {
+      java.lang.Object ref = lastResponseCode_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        lastResponseCode_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (sessionId_ != 0) {
+        output.writeInt32(1, sessionId_);
+      }
+      if (!peerId_.isEmpty()) {
+        output.writeBytes(2, peerId_);
+      }
+      if (requestedBlocks_ != 0) {
+        output.writeUInt32(3, requestedBlocks_);
+      }
+      if (receivedBlocks_ != 0) {
+        output.writeUInt32(4, receivedBlocks_);
+      }
+      if (lastActivityAt_ != 0L) {
+        output.writeInt64(5, lastActivityAt_);
+      }
+      if (openedAt_ != 0L) {
+        output.writeInt64(6, openedAt_);
+      }
+      if (age_ != 0L) {
+        output.writeInt64(7, age_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(lastResponseCode_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 8, lastResponseCode_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (sessionId_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(1, sessionId_);
+      }
+      if (!peerId_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(2, peerId_);
+      }
+      if (requestedBlocks_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(3, requestedBlocks_);
+      }
+      if (receivedBlocks_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(4, receivedBlocks_);
+      }
+      if (lastActivityAt_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(5, lastActivityAt_);
+      }
+      if (openedAt_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(6, openedAt_);
+      }
+      if (age_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(7, age_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(lastResponseCode_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(8, lastResponseCode_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
     }
 
     @java.lang.Override
@@ -2073,11 +2696,27 @@ public final class NetworkOuterClass {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof pactus.network.NetworkOuterClass.GetNodeInfoRequest)) {
+      if (!(obj instanceof pactus.network.NetworkOuterClass.SessionInfo)) {
         return super.equals(obj);
       }
-      pactus.network.NetworkOuterClass.GetNodeInfoRequest other = (pactus.network.NetworkOuterClass.GetNodeInfoRequest) obj;
+      pactus.network.NetworkOuterClass.SessionInfo other = (pactus.network.NetworkOuterClass.SessionInfo) obj;
 
+      if (getSessionId()
+          != other.getSessionId()) return false;
+      if (!getPeerId()
+          .equals(other.getPeerId())) return false;
+      if (getRequestedBlocks()
+          != other.getRequestedBlocks()) return false;
+      if (getReceivedBlocks()
+          != other.getReceivedBlocks()) return false;
+      if (getLastActivityAt()
+          != other.getLastActivityAt()) return false;
+      if (getOpenedAt()
+          != other.getOpenedAt()) return false;
+      if (getAge()
+          != other.getAge()) return false;
+      if (!getLastResponseCode()
+          .equals(other.getLastResponseCode())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -2089,74 +2728,93 @@ public final class NetworkOuterClass {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + SESSION_ID_FIELD_NUMBER;
+      hash = (53 * hash) + getSessionId();
+      hash = (37 * hash) + PEER_ID_FIELD_NUMBER;
+      hash = (53 * hash) + getPeerId().hashCode();
+      hash = (37 * hash) + REQUESTED_BLOCKS_FIELD_NUMBER;
+      hash = (53 * hash) + getRequestedBlocks();
+      hash = (37 * hash) + RECEIVED_BLOCKS_FIELD_NUMBER;
+      hash = (53 * hash) + getReceivedBlocks();
+      hash = (37 * hash) + LAST_ACTIVITY_AT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getLastActivityAt());
+      hash = (37 * hash) + OPENED_AT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getOpenedAt());
+      hash = (37 * hash) + AGE_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getAge());
+      hash = (37 * hash) + LAST_RESPONSE_CODE_FIELD_NUMBER;
+      hash = (53 * hash) + getLastResponseCode().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(byte[] data)
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(java.io.InputStream input)
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseDelimitedFrom(java.io.InputStream input)
+    public static pactus.network.NetworkOuterClass.SessionInfo parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseDelimitedFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+    public static pactus.network.NetworkOuterClass.SessionInfo parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -2169,7 +2827,7 @@ public final class NetworkOuterClass {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetNodeInfoRequest prototype) {
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.SessionInfo prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -2185,26 +2843,26 @@ public final class NetworkOuterClass {
       return builder;
     }
     /**
-     * Protobuf type {@code pactus.GetNodeInfoRequest}
+     * Protobuf type {@code pactus.SessionInfo}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:pactus.GetNodeInfoRequest)
-        pactus.network.NetworkOuterClass.GetNodeInfoRequestOrBuilder {
+        // @@protoc_insertion_point(builder_implements:pactus.SessionInfo)
+        pactus.network.NetworkOuterClass.SessionInfoOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_descriptor;
+        return pactus.network.NetworkOuterClass.internal_static_pactus_SessionInfo_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_fieldAccessorTable
+        return pactus.network.NetworkOuterClass.internal_static_pactus_SessionInfo_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                pactus.network.NetworkOuterClass.GetNodeInfoRequest.class, pactus.network.NetworkOuterClass.GetNodeInfoRequest.Builder.class);
+                pactus.network.NetworkOuterClass.SessionInfo.class, pactus.network.NetworkOuterClass.SessionInfo.Builder.class);
       }
 
-      // Construct using pactus.network.NetworkOuterClass.GetNodeInfoRequest.newBuilder()
+      // Construct using pactus.network.NetworkOuterClass.SessionInfo.newBuilder()
       private Builder() {
 
       }
@@ -2217,23 +2875,39 @@ public final class NetworkOuterClass {
       @java.lang.Override
       public Builder clear() {
         super.clear();
+        sessionId_ = 0;
+
+        peerId_ = com.google.protobuf.ByteString.EMPTY;
+
+        requestedBlocks_ = 0;
+
+        receivedBlocks_ = 0;
+
+        lastActivityAt_ = 0L;
+
+        openedAt_ = 0L;
+
+        age_ = 0L;
+
+        lastResponseCode_ = "";
+
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_descriptor;
+        return pactus.network.NetworkOuterClass.internal_static_pactus_SessionInfo_descriptor;
       }
 
       @java.lang.Override
-      public pactus.network.NetworkOuterClass.GetNodeInfoRequest getDefaultInstanceForType() {
-        return pactus.network.NetworkOuterClass.GetNodeInfoRequest.getDefaultInstance();
+      public pactus.network.NetworkOuterClass.SessionInfo getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.SessionInfo.getDefaultInstance();
       }
 
       @java.lang.Override
-      public pactus.network.NetworkOuterClass.GetNodeInfoRequest build() {
-        pactus.network.NetworkOuterClass.GetNodeInfoRequest result = buildPartial();
+      public pactus.network.NetworkOuterClass.SessionInfo build() {
+        pactus.network.NetworkOuterClass.SessionInfo result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -2241,8 +2915,16 @@ public final class NetworkOuterClass {
       }
 
       @java.lang.Override
-      public pactus.network.NetworkOuterClass.GetNodeInfoRequest buildPartial() {
-        pactus.network.NetworkOuterClass.GetNodeInfoRequest result = new pactus.network.NetworkOuterClass.GetNodeInfoRequest(this);
+      public pactus.network.NetworkOuterClass.SessionInfo buildPartial() {
+        pactus.network.NetworkOuterClass.SessionInfo result = new pactus.network.NetworkOuterClass.SessionInfo(this);
+        result.sessionId_ = sessionId_;
+        result.peerId_ = peerId_;
+        result.requestedBlocks_ = requestedBlocks_;
+        result.receivedBlocks_ = receivedBlocks_;
+        result.lastActivityAt_ = lastActivityAt_;
+        result.openedAt_ = openedAt_;
+        result.age_ = age_;
+        result.lastResponseCode_ = lastResponseCode_;
         onBuilt();
         return result;
       }
@@ -2281,16 +2963,41 @@ public final class NetworkOuterClass {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pactus.network.NetworkOuterClass.GetNodeInfoRequest) {
-          return mergeFrom((pactus.network.NetworkOuterClass.GetNodeInfoRequest)other);
+        if (other instanceof pactus.network.NetworkOuterClass.SessionInfo) {
+          return mergeFrom((pactus.network.NetworkOuterClass.SessionInfo)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetNodeInfoRequest other) {
-        if (other == pactus.network.NetworkOuterClass.GetNodeInfoRequest.getDefaultInstance()) return this;
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.SessionInfo other) {
+        if (other == pactus.network.NetworkOuterClass.SessionInfo.getDefaultInstance()) return this;
+        if (other.getSessionId() != 0) {
+          setSessionId(other.getSessionId());
+        }
+        if (other.getPeerId() != com.google.protobuf.ByteString.EMPTY) {
+          setPeerId(other.getPeerId());
+        }
+        if (other.getRequestedBlocks() != 0) {
+          setRequestedBlocks(other.getRequestedBlocks());
+        }
+        if (other.getReceivedBlocks() != 0) {
+          setReceivedBlocks(other.getReceivedBlocks());
+        }
+        if (other.getLastActivityAt() != 0L) {
+          setLastActivityAt(other.getLastActivityAt());
+        }
+        if (other.getOpenedAt() != 0L) {
+          setOpenedAt(other.getOpenedAt());
+        }
+        if (other.getAge() != 0L) {
+          setAge(other.getAge());
+        }
+        if (!other.getLastResponseCode().isEmpty()) {
+          lastResponseCode_ = other.lastResponseCode_;
+          onChanged();
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -2317,6 +3024,46 @@ public final class NetworkOuterClass {
               case 0:
                 done = true;
                 break;
+              case 8: {
+                sessionId_ = input.readInt32();
+
+                break;
+              } // case 8
+              case 18: {
+                peerId_ = input.readBytes();
+
+                break;
+              } // case 18
+              case 24: {
+                requestedBlocks_ = input.readUInt32();
+
+                break;
+              } // case 24
+              case 32: {
+                receivedBlocks_ = input.readUInt32();
+
+                break;
+              } // case 32
+              case 40: {
+                lastActivityAt_ = input.readInt64();
+
+                break;
+              } // case 40
+              case 48: {
+                openedAt_ = input.readInt64();
+
+                break;
+              } // case 48
+              case 56: {
+                age_ = input.readInt64();
+
+                break;
+              } // case 56
+              case 66: {
+                lastResponseCode_ = input.readStringRequireUtf8();
+
+                break;
+              } // case 66
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -2332,37 +3079,7417 @@ public final class NetworkOuterClass {
         } // finally
         return this;
       }
+
+      private int sessionId_ ;
+      /**
+       * <code>int32 session_id = 1 [json_name = "sessionId"];</code>
+       * @return The sessionId.
+       */
       @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
+      public int getSessionId() {
+        return sessionId_;
+      }
+      /**
+       * <code>int32 session_id = 1 [json_name = "sessionId"];</code>
+       * @param value The sessionId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSessionId(int value) {
+        
+        sessionId_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 session_id = 1 [json_name = "sessionId"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSessionId() {
+        
+        sessionId_ = 0;
+        onChanged();
+        return this;
       }
 
+      private com.google.protobuf.ByteString peerId_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes peer_id = 2 [json_name = "peerId"];</code>
+       * @return The peerId.
+       */
       @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
+      public com.google.protobuf.ByteString getPeerId() {
+        return peerId_;
+      }
+      /**
+       * <code>bytes peer_id = 2 [json_name = "peerId"];</code>
+       * @param value The peerId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPeerId(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        peerId_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes peer_id = 2 [json_name = "peerId"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPeerId() {
+        
+        peerId_ = getDefaultInstance().getPeerId();
+        onChanged();
+        return this;
       }
 
-
-      // @@protoc_insertion_point(builder_scope:pactus.GetNodeInfoRequest)
-    }
-
-    // @@protoc_insertion_point(class_scope:pactus.GetNodeInfoRequest)
-    private static final pactus.network.NetworkOuterClass.GetNodeInfoRequest DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetNodeInfoRequest();
-    }
-
-    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<GetNodeInfoRequest>
-        PARSER = new com.google.protobuf.AbstractParser<GetNodeInfoRequest>() {
+      private int requestedBlocks_ ;
+      /**
+       * <code>uint32 requested_blocks = 3 [json_name = "requestedBlocks"];</code>
+       * @return The requestedBlocks.
+       */
       @java.lang.Override
-      public GetNodeInfoRequest parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
+      public int getRequestedBlocks() {
+        return requestedBlocks_;
+      }
+      /**
+       * <code>uint32 requested_blocks = 3 [json_name = "requestedBlocks"];</code>
+       * @param value The requestedBlocks to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRequestedBlocks(int value) {
+        
+        requestedBlocks_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 requested_blocks = 3 [json_name = "requestedBlocks"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRequestedBlocks() {
+        
+        requestedBlocks_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int receivedBlocks_ ;
+      /**
+       * <code>uint32 received_blocks = 4 [json_name = "receivedBlocks"];</code>
+       * @return The receivedBlocks.
+       */
+      @java.lang.Override
+      public int getReceivedBlocks() {
+        return receivedBlocks_;
+      }
+      /**
+       * <code>uint32 received_blocks = 4 [json_name = "receivedBlocks"];</code>
+       * @param value The receivedBlocks to set.
+       * @return This builder for chaining.
+       */
+      public Builder setReceivedBlocks(int value) {
+        
+        receivedBlocks_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 received_blocks = 4 [json_name = "receivedBlocks"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearReceivedBlocks() {
+        
+        receivedBlocks_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private long lastActivityAt_ ;
+      /**
+       * <code>int64 last_activity_at = 5 [json_name = "lastActivityAt"];</code>
+       * @return The lastActivityAt.
+       */
+      @java.lang.Override
+      public long getLastActivityAt() {
+        return lastActivityAt_;
+      }
+      /**
+       * <code>int64 last_activity_at = 5 [json_name = "lastActivityAt"];</code>
+       * @param value The lastActivityAt to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLastActivityAt(long value) {
+        
+        lastActivityAt_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 last_activity_at = 5 [json_name = "lastActivityAt"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLastActivityAt() {
+        
+        lastActivityAt_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private long openedAt_ ;
+      /**
+       * <code>int64 opened_at = 6 [json_name = "openedAt"];</code>
+       * @return The openedAt.
+       */
+      @java.lang.Override
+      public long getOpenedAt() {
+        return openedAt_;
+      }
+      /**
+       * <code>int64 opened_at = 6 [json_name = "openedAt"];</code>
+       * @param value The openedAt to set.
+       * @return This builder for chaining.
+       */
+      public Builder setOpenedAt(long value) {
+        
+        openedAt_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 opened_at = 6 [json_name = "openedAt"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearOpenedAt() {
+        
+        openedAt_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private long age_ ;
+      /**
+       * <pre>
+       * Age of the session in seconds.
+       * </pre>
+       *
+       * <code>int64 age = 7 [json_name = "age"];</code>
+       * @return The age.
+       */
+      @java.lang.Override
+      public long getAge() {
+        return age_;
+      }
+      /**
+       * <pre>
+       * Age of the session in seconds.
+       * </pre>
+       *
+       * <code>int64 age = 7 [json_name = "age"];</code>
+       * @param value The age to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAge(long value) {
+        
+        age_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Age of the session in seconds.
+       * </pre>
+       *
+       * <code>int64 age = 7 [json_name = "age"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAge() {
+        
+        age_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object lastResponseCode_ = "";
+      /**
+       * <pre>
+       * Last response code received in the session, like "more-blocks".
+       * </pre>
+       *
+       * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+       * @return The lastResponseCode.
+       */
+      public java.lang.String getLastResponseCode() {
+        java.lang.Object ref = lastResponseCode_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          lastResponseCode_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * Last response code received in the session, like "more-blocks".
+       * </pre>
+       *
+       * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+       * @return The bytes for lastResponseCode.
+       */
+      public com.google.protobuf.ByteString
+          getLastResponseCodeBytes() {
+        java.lang.Object ref = lastResponseCode_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          lastResponseCode_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * Last response code received in the session, like "more-blocks".
+       * </pre>
+       *
+       * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+       * @param value The lastResponseCode to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLastResponseCode(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        lastResponseCode_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Last response code received in the session, like "more-blocks".
+       * </pre>
+       *
+       * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLastResponseCode() {
+        
+        lastResponseCode_ = getDefaultInstance().getLastResponseCode();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Last response code received in the session, like "more-blocks".
+       * </pre>
+       *
+       * <code>string last_response_code = 8 [json_name = "lastResponseCode"];</code>
+       * @param value The bytes for lastResponseCode to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLastResponseCodeBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        lastResponseCode_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.SessionInfo)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.SessionInfo)
+    private static final pactus.network.NetworkOuterClass.SessionInfo DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.SessionInfo();
+    }
+
+    public static pactus.network.NetworkOuterClass.SessionInfo getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SessionInfo>
+        PARSER = new com.google.protobuf.AbstractParser<SessionInfo>() {
+      @java.lang.Override
+      public SessionInfo parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<SessionInfo> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SessionInfo> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.SessionInfo getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetSessionsRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetSessionsRequest)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * <pre>
+   * Lists the open download sessions, to find out the stale or stuck sessions
+   * when the node isn't syncing.
+   * </pre>
+   *
+   * Protobuf type {@code pactus.GetSessionsRequest}
+   */
+  public static final class GetSessionsRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetSessionsRequest)
+      GetSessionsRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetSessionsRequest.newBuilder() to construct.
+    private GetSessionsRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetSessionsRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetSessionsRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.GetSessionsRequest.class, pactus.network.NetworkOuterClass.GetSessionsRequest.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.GetSessionsRequest)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.GetSessionsRequest other = (pactus.network.NetworkOuterClass.GetSessionsRequest) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetSessionsRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * <pre>
+     * Lists the open download sessions, to find out the stale or stuck sessions
+     * when the node isn't syncing.
+     * </pre>
+     *
+     * Protobuf type {@code pactus.GetSessionsRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetSessionsRequest)
+        pactus.network.NetworkOuterClass.GetSessionsRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.GetSessionsRequest.class, pactus.network.NetworkOuterClass.GetSessionsRequest.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.GetSessionsRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetSessionsRequest getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.GetSessionsRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetSessionsRequest build() {
+        pactus.network.NetworkOuterClass.GetSessionsRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetSessionsRequest buildPartial() {
+        pactus.network.NetworkOuterClass.GetSessionsRequest result = new pactus.network.NetworkOuterClass.GetSessionsRequest(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.GetSessionsRequest) {
+          return mergeFrom((pactus.network.NetworkOuterClass.GetSessionsRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetSessionsRequest other) {
+        if (other == pactus.network.NetworkOuterClass.GetSessionsRequest.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetSessionsRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetSessionsRequest)
+    private static final pactus.network.NetworkOuterClass.GetSessionsRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetSessionsRequest();
+    }
+
+    public static pactus.network.NetworkOuterClass.GetSessionsRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetSessionsRequest>
+        PARSER = new com.google.protobuf.AbstractParser<GetSessionsRequest>() {
+      @java.lang.Override
+      public GetSessionsRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetSessionsRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetSessionsRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.GetSessionsRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetSessionsResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetSessionsResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    java.util.List<pactus.network.NetworkOuterClass.SessionInfo> 
+        getSessionsList();
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    pactus.network.NetworkOuterClass.SessionInfo getSessions(int index);
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    int getSessionsCount();
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    java.util.List<? extends pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+        getSessionsOrBuilderList();
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    pactus.network.NetworkOuterClass.SessionInfoOrBuilder getSessionsOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code pactus.GetSessionsResponse}
+   */
+  public static final class GetSessionsResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetSessionsResponse)
+      GetSessionsResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetSessionsResponse.newBuilder() to construct.
+    private GetSessionsResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetSessionsResponse() {
+      sessions_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetSessionsResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.GetSessionsResponse.class, pactus.network.NetworkOuterClass.GetSessionsResponse.Builder.class);
+    }
+
+    public static final int SESSIONS_FIELD_NUMBER = 1;
+    private java.util.List<pactus.network.NetworkOuterClass.SessionInfo> sessions_;
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public java.util.List<pactus.network.NetworkOuterClass.SessionInfo> getSessionsList() {
+      return sessions_;
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+        getSessionsOrBuilderList() {
+      return sessions_;
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public int getSessionsCount() {
+      return sessions_.size();
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.SessionInfo getSessions(int index) {
+      return sessions_.get(index);
+    }
+    /**
+     * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+     */
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.SessionInfoOrBuilder getSessionsOrBuilder(
+        int index) {
+      return sessions_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      for (int i = 0; i < sessions_.size(); i++) {
+        output.writeMessage(1, sessions_.get(i));
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      for (int i = 0; i < sessions_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, sessions_.get(i));
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.GetSessionsResponse)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.GetSessionsResponse other = (pactus.network.NetworkOuterClass.GetSessionsResponse) obj;
+
+      if (!getSessionsList()
+          .equals(other.getSessionsList())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getSessionsCount() > 0) {
+        hash = (37 * hash) + SESSIONS_FIELD_NUMBER;
+        hash = (53 * hash) + getSessionsList().hashCode();
+      }
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetSessionsResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.GetSessionsResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetSessionsResponse)
+        pactus.network.NetworkOuterClass.GetSessionsResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.GetSessionsResponse.class, pactus.network.NetworkOuterClass.GetSessionsResponse.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.GetSessionsResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (sessionsBuilder_ == null) {
+          sessions_ = java.util.Collections.emptyList();
+        } else {
+          sessions_ = null;
+          sessionsBuilder_.clear();
+        }
+        bitField0_ = (bitField0_ & ~0x00000001);
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetSessionsResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetSessionsResponse getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.GetSessionsResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetSessionsResponse build() {
+        pactus.network.NetworkOuterClass.GetSessionsResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetSessionsResponse buildPartial() {
+        pactus.network.NetworkOuterClass.GetSessionsResponse result = new pactus.network.NetworkOuterClass.GetSessionsResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (sessionsBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            sessions_ = java.util.Collections.unmodifiableList(sessions_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.sessions_ = sessions_;
+        } else {
+          result.sessions_ = sessionsBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.GetSessionsResponse) {
+          return mergeFrom((pactus.network.NetworkOuterClass.GetSessionsResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetSessionsResponse other) {
+        if (other == pactus.network.NetworkOuterClass.GetSessionsResponse.getDefaultInstance()) return this;
+        if (sessionsBuilder_ == null) {
+          if (!other.sessions_.isEmpty()) {
+            if (sessions_.isEmpty()) {
+              sessions_ = other.sessions_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureSessionsIsMutable();
+              sessions_.addAll(other.sessions_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.sessions_.isEmpty()) {
+            if (sessionsBuilder_.isEmpty()) {
+              sessionsBuilder_.dispose();
+              sessionsBuilder_ = null;
+              sessions_ = other.sessions_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              sessionsBuilder_ = 
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getSessionsFieldBuilder() : null;
+            } else {
+              sessionsBuilder_.addAllMessages(other.sessions_);
+            }
+          }
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                pactus.network.NetworkOuterClass.SessionInfo m =
+                    input.readMessage(
+                        pactus.network.NetworkOuterClass.SessionInfo.parser(),
+                        extensionRegistry);
+                if (sessionsBuilder_ == null) {
+                  ensureSessionsIsMutable();
+                  sessions_.add(m);
+                } else {
+                  sessionsBuilder_.addMessage(m);
+                }
+                break;
+              } // case 10
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<pactus.network.NetworkOuterClass.SessionInfo> sessions_ =
+        java.util.Collections.emptyList();
+      private void ensureSessionsIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          sessions_ = new java.util.ArrayList<pactus.network.NetworkOuterClass.SessionInfo>(sessions_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          pactus.network.NetworkOuterClass.SessionInfo, pactus.network.NetworkOuterClass.SessionInfo.Builder, pactus.network.NetworkOuterClass.SessionInfoOrBuilder> sessionsBuilder_;
+
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public java.util.List<pactus.network.NetworkOuterClass.SessionInfo> getSessionsList() {
+        if (sessionsBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(sessions_);
+        } else {
+          return sessionsBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public int getSessionsCount() {
+        if (sessionsBuilder_ == null) {
+          return sessions_.size();
+        } else {
+          return sessionsBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo getSessions(int index) {
+        if (sessionsBuilder_ == null) {
+          return sessions_.get(index);
+        } else {
+          return sessionsBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder setSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo value) {
+        if (sessionsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSessionsIsMutable();
+          sessions_.set(index, value);
+          onChanged();
+        } else {
+          sessionsBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder setSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo.Builder builderForValue) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          sessionsBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(pactus.network.NetworkOuterClass.SessionInfo value) {
+        if (sessionsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSessionsIsMutable();
+          sessions_.add(value);
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo value) {
+        if (sessionsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSessionsIsMutable();
+          sessions_.add(index, value);
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(
+          pactus.network.NetworkOuterClass.SessionInfo.Builder builderForValue) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.add(builderForValue.build());
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder addSessions(
+          int index, pactus.network.NetworkOuterClass.SessionInfo.Builder builderForValue) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          sessionsBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder addAllSessions(
+          java.lang.Iterable<? extends pactus.network.NetworkOuterClass.SessionInfo> values) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, sessions_);
+          onChanged();
+        } else {
+          sessionsBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder clearSessions() {
+        if (sessionsBuilder_ == null) {
+          sessions_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          sessionsBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public Builder removeSessions(int index) {
+        if (sessionsBuilder_ == null) {
+          ensureSessionsIsMutable();
+          sessions_.remove(index);
+          onChanged();
+        } else {
+          sessionsBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo.Builder getSessionsBuilder(
+          int index) {
+        return getSessionsFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfoOrBuilder getSessionsOrBuilder(
+          int index) {
+        if (sessionsBuilder_ == null) {
+          return sessions_.get(index);  } else {
+          return sessionsBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public java.util.List<? extends pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+           getSessionsOrBuilderList() {
+        if (sessionsBuilder_ != null) {
+          return sessionsBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(sessions_);
+        }
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo.Builder addSessionsBuilder() {
+        return getSessionsFieldBuilder().addBuilder(
+            pactus.network.NetworkOuterClass.SessionInfo.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public pactus.network.NetworkOuterClass.SessionInfo.Builder addSessionsBuilder(
+          int index) {
+        return getSessionsFieldBuilder().addBuilder(
+            index, pactus.network.NetworkOuterClass.SessionInfo.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .pactus.SessionInfo sessions = 1 [json_name = "sessions"];</code>
+       */
+      public java.util.List<pactus.network.NetworkOuterClass.SessionInfo.Builder> 
+           getSessionsBuilderList() {
+        return getSessionsFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          pactus.network.NetworkOuterClass.SessionInfo, pactus.network.NetworkOuterClass.SessionInfo.Builder, pactus.network.NetworkOuterClass.SessionInfoOrBuilder> 
+          getSessionsFieldBuilder() {
+        if (sessionsBuilder_ == null) {
+          sessionsBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              pactus.network.NetworkOuterClass.SessionInfo, pactus.network.NetworkOuterClass.SessionInfo.Builder, pactus.network.NetworkOuterClass.SessionInfoOrBuilder>(
+                  sessions_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          sessions_ = null;
+        }
+        return sessionsBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetSessionsResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetSessionsResponse)
+    private static final pactus.network.NetworkOuterClass.GetSessionsResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetSessionsResponse();
+    }
+
+    public static pactus.network.NetworkOuterClass.GetSessionsResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetSessionsResponse>
+        PARSER = new com.google.protobuf.AbstractParser<GetSessionsResponse>() {
+      @java.lang.Override
+      public GetSessionsResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetSessionsResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetSessionsResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.GetSessionsResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetNodeInfoRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetNodeInfoRequest)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code pactus.GetNodeInfoRequest}
+   */
+  public static final class GetNodeInfoRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetNodeInfoRequest)
+      GetNodeInfoRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetNodeInfoRequest.newBuilder() to construct.
+    private GetNodeInfoRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetNodeInfoRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetNodeInfoRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.GetNodeInfoRequest.class, pactus.network.NetworkOuterClass.GetNodeInfoRequest.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.GetNodeInfoRequest)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.GetNodeInfoRequest other = (pactus.network.NetworkOuterClass.GetNodeInfoRequest) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetNodeInfoRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.GetNodeInfoRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetNodeInfoRequest)
+        pactus.network.NetworkOuterClass.GetNodeInfoRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.GetNodeInfoRequest.class, pactus.network.NetworkOuterClass.GetNodeInfoRequest.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.GetNodeInfoRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetNodeInfoRequest getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.GetNodeInfoRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetNodeInfoRequest build() {
+        pactus.network.NetworkOuterClass.GetNodeInfoRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetNodeInfoRequest buildPartial() {
+        pactus.network.NetworkOuterClass.GetNodeInfoRequest result = new pactus.network.NetworkOuterClass.GetNodeInfoRequest(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.GetNodeInfoRequest) {
+          return mergeFrom((pactus.network.NetworkOuterClass.GetNodeInfoRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetNodeInfoRequest other) {
+        if (other == pactus.network.NetworkOuterClass.GetNodeInfoRequest.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetNodeInfoRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetNodeInfoRequest)
+    private static final pactus.network.NetworkOuterClass.GetNodeInfoRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetNodeInfoRequest();
+    }
+
+    public static pactus.network.NetworkOuterClass.GetNodeInfoRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetNodeInfoRequest>
+        PARSER = new com.google.protobuf.AbstractParser<GetNodeInfoRequest>() {
+      @java.lang.Override
+      public GetNodeInfoRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetNodeInfoRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetNodeInfoRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.GetNodeInfoRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetNodeInfoResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetNodeInfoResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string moniker = 1 [json_name = "moniker"];</code>
+     * @return The moniker.
+     */
+    java.lang.String getMoniker();
+    /**
+     * <code>string moniker = 1 [json_name = "moniker"];</code>
+     * @return The bytes for moniker.
+     */
+    com.google.protobuf.ByteString
+        getMonikerBytes();
+
+    /**
+     * <code>string agent = 2 [json_name = "agent"];</code>
+     * @return The agent.
+     */
+    java.lang.String getAgent();
+    /**
+     * <code>string agent = 2 [json_name = "agent"];</code>
+     * @return The bytes for agent.
+     */
+    com.google.protobuf.ByteString
+        getAgentBytes();
+
+    /**
+     * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
+    com.google.protobuf.ByteString getPeerId();
+
+    /**
+     * <code>bool sync_paused = 4 [json_name = "syncPaused"];</code>
+     * @return The syncPaused.
+     */
+    boolean getSyncPaused();
+  }
+  /**
+   * Protobuf type {@code pactus.GetNodeInfoResponse}
+   */
+  public static final class GetNodeInfoResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetNodeInfoResponse)
+      GetNodeInfoResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetNodeInfoResponse.newBuilder() to construct.
+    private GetNodeInfoResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetNodeInfoResponse() {
+      moniker_ = "";
+      agent_ = "";
+      peerId_ = com.google.protobuf.ByteString.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetNodeInfoResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.GetNodeInfoResponse.class, pactus.network.NetworkOuterClass.GetNodeInfoResponse.Builder.class);
+    }
+
+    public static final int MONIKER_FIELD_NUMBER = 1;
+    private volatile java.lang.Object moniker_;
+    /**
+     * <code>string moniker = 1 [json_name = "moniker"];</code>
+     * @return The moniker.
+     */
+    @java.lang.Override
+    public java.lang.String getMoniker() {
+      java.lang.Object ref = moniker_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        moniker_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string moniker = 1 [json_name = "moniker"];</code>
+     * @return The bytes for moniker.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getMonikerBytes() {
+      java.lang.Object ref = moniker_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        moniker_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int AGENT_FIELD_NUMBER = 2;
+    private volatile java.lang.Object agent_;
+    /**
+     * <code>string agent = 2 [json_name = "agent"];</code>
+     * @return The agent.
+     */
+    @java.lang.Override
+    public java.lang.String getAgent() {
+      java.lang.Object ref = agent_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        agent_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string agent = 2 [json_name = "agent"];</code>
+     * @return The bytes for agent.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getAgentBytes() {
+      java.lang.Object ref = agent_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        agent_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int PEER_ID_FIELD_NUMBER = 3;
+    private com.google.protobuf.ByteString peerId_;
+    /**
+     * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getPeerId() {
+      return peerId_;
+    }
+
+    public static final int SYNC_PAUSED_FIELD_NUMBER = 4;
+    private boolean syncPaused_;
+    /**
+     * <code>bool sync_paused = 4 [json_name = "syncPaused"];</code>
+     * @return The syncPaused.
+     */
+    @java.lang.Override
+    public boolean getSyncPaused() {
+      return syncPaused_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(moniker_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, moniker_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(agent_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, agent_);
+      }
+      if (!peerId_.isEmpty()) {
+        output.writeBytes(3, peerId_);
+      }
+      if (syncPaused_ != false) {
+        output.writeBool(4, syncPaused_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(moniker_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, moniker_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(agent_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, agent_);
+      }
+      if (!peerId_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(3, peerId_);
+      }
+      if (syncPaused_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(4, syncPaused_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.GetNodeInfoResponse)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.GetNodeInfoResponse other = (pactus.network.NetworkOuterClass.GetNodeInfoResponse) obj;
+
+      if (!getMoniker()
+          .equals(other.getMoniker())) return false;
+      if (!getAgent()
+          .equals(other.getAgent())) return false;
+      if (!getPeerId()
+          .equals(other.getPeerId())) return false;
+      if (getSyncPaused()
+          != other.getSyncPaused()) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + MONIKER_FIELD_NUMBER;
+      hash = (53 * hash) + getMoniker().hashCode();
+      hash = (37 * hash) + AGENT_FIELD_NUMBER;
+      hash = (53 * hash) + getAgent().hashCode();
+      hash = (37 * hash) + PEER_ID_FIELD_NUMBER;
+      hash = (53 * hash) + getPeerId().hashCode();
+      hash = (37 * hash) + SYNC_PAUSED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getSyncPaused());
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetNodeInfoResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.GetNodeInfoResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetNodeInfoResponse)
+        pactus.network.NetworkOuterClass.GetNodeInfoResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.GetNodeInfoResponse.class, pactus.network.NetworkOuterClass.GetNodeInfoResponse.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.GetNodeInfoResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        moniker_ = "";
+
+        agent_ = "";
+
+        peerId_ = com.google.protobuf.ByteString.EMPTY;
+
+        syncPaused_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetNodeInfoResponse getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.GetNodeInfoResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetNodeInfoResponse build() {
+        pactus.network.NetworkOuterClass.GetNodeInfoResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetNodeInfoResponse buildPartial() {
+        pactus.network.NetworkOuterClass.GetNodeInfoResponse result = new pactus.network.NetworkOuterClass.GetNodeInfoResponse(this);
+        result.moniker_ = moniker_;
+        result.agent_ = agent_;
+        result.peerId_ = peerId_;
+        result.syncPaused_ = syncPaused_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.GetNodeInfoResponse) {
+          return mergeFrom((pactus.network.NetworkOuterClass.GetNodeInfoResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetNodeInfoResponse other) {
+        if (other == pactus.network.NetworkOuterClass.GetNodeInfoResponse.getDefaultInstance()) return this;
+        if (!other.getMoniker().isEmpty()) {
+          moniker_ = other.moniker_;
+          onChanged();
+        }
+        if (!other.getAgent().isEmpty()) {
+          agent_ = other.agent_;
+          onChanged();
+        }
+        if (other.getPeerId() != com.google.protobuf.ByteString.EMPTY) {
+          setPeerId(other.getPeerId());
+        }
+        if (other.getSyncPaused() != false) {
+          setSyncPaused(other.getSyncPaused());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                moniker_ = input.readStringRequireUtf8();
+
+                break;
+              } // case 10
+              case 18: {
+                agent_ = input.readStringRequireUtf8();
+
+                break;
+              } // case 18
+              case 26: {
+                peerId_ = input.readBytes();
+
+                break;
+              } // case 26
+              case 32: {
+                syncPaused_ = input.readBool();
+
+                break;
+              } // case 32
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private java.lang.Object moniker_ = "";
+      /**
+       * <code>string moniker = 1 [json_name = "moniker"];</code>
+       * @return The moniker.
+       */
+      public java.lang.String getMoniker() {
+        java.lang.Object ref = moniker_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          moniker_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string moniker = 1 [json_name = "moniker"];</code>
+       * @return The bytes for moniker.
+       */
+      public com.google.protobuf.ByteString
+          getMonikerBytes() {
+        java.lang.Object ref = moniker_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          moniker_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string moniker = 1 [json_name = "moniker"];</code>
+       * @param value The moniker to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMoniker(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        moniker_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string moniker = 1 [json_name = "moniker"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMoniker() {
+        
+        moniker_ = getDefaultInstance().getMoniker();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string moniker = 1 [json_name = "moniker"];</code>
+       * @param value The bytes for moniker to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMonikerBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        moniker_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object agent_ = "";
+      /**
+       * <code>string agent = 2 [json_name = "agent"];</code>
+       * @return The agent.
+       */
+      public java.lang.String getAgent() {
+        java.lang.Object ref = agent_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          agent_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string agent = 2 [json_name = "agent"];</code>
+       * @return The bytes for agent.
+       */
+      public com.google.protobuf.ByteString
+          getAgentBytes() {
+        java.lang.Object ref = agent_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          agent_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string agent = 2 [json_name = "agent"];</code>
+       * @param value The agent to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAgent(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        agent_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string agent = 2 [json_name = "agent"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAgent() {
+        
+        agent_ = getDefaultInstance().getAgent();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string agent = 2 [json_name = "agent"];</code>
+       * @param value The bytes for agent to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAgentBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        agent_ = value;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.ByteString peerId_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
+       * @return The peerId.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getPeerId() {
+        return peerId_;
+      }
+      /**
+       * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
+       * @param value The peerId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPeerId(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        peerId_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPeerId() {
+        
+        peerId_ = getDefaultInstance().getPeerId();
+        onChanged();
+        return this;
+      }
+
+      private boolean syncPaused_ ;
+      /**
+       * <code>bool sync_paused = 4 [json_name = "syncPaused"];</code>
+       * @return The syncPaused.
+       */
+      @java.lang.Override
+      public boolean getSyncPaused() {
+        return syncPaused_;
+      }
+      /**
+       * <code>bool sync_paused = 4 [json_name = "syncPaused"];</code>
+       * @param value The syncPaused to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSyncPaused(boolean value) {
+        
+        syncPaused_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bool sync_paused = 4 [json_name = "syncPaused"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSyncPaused() {
+        
+        syncPaused_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetNodeInfoResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetNodeInfoResponse)
+    private static final pactus.network.NetworkOuterClass.GetNodeInfoResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetNodeInfoResponse();
+    }
+
+    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetNodeInfoResponse>
+        PARSER = new com.google.protobuf.AbstractParser<GetNodeInfoResponse>() {
+      @java.lang.Override
+      public GetNodeInfoResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetNodeInfoResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetNodeInfoResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.GetNodeInfoResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface BanPeerRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.BanPeerRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
+    com.google.protobuf.ByteString getPeerId();
+
+    /**
+     * <pre>
+     * Ban duration in seconds. Zero means the peer is banned permanently.
+     * </pre>
+     *
+     * <code>int64 duration = 2 [json_name = "duration"];</code>
+     * @return The duration.
+     */
+    long getDuration();
+  }
+  /**
+   * Protobuf type {@code pactus.BanPeerRequest}
+   */
+  public static final class BanPeerRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.BanPeerRequest)
+      BanPeerRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use BanPeerRequest.newBuilder() to construct.
+    private BanPeerRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private BanPeerRequest() {
+      peerId_ = com.google.protobuf.ByteString.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new BanPeerRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.BanPeerRequest.class, pactus.network.NetworkOuterClass.BanPeerRequest.Builder.class);
+    }
+
+    public static final int PEER_ID_FIELD_NUMBER = 1;
+    private com.google.protobuf.ByteString peerId_;
+    /**
+     * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getPeerId() {
+      return peerId_;
+    }
+
+    public static final int DURATION_FIELD_NUMBER = 2;
+    private long duration_;
+    /**
+     * <pre>
+     * Ban duration in seconds. Zero means the peer is banned permanently.
+     * </pre>
+     *
+     * <code>int64 duration = 2 [json_name = "duration"];</code>
+     * @return The duration.
+     */
+    @java.lang.Override
+    public long getDuration() {
+      return duration_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!peerId_.isEmpty()) {
+        output.writeBytes(1, peerId_);
+      }
+      if (duration_ != 0L) {
+        output.writeInt64(2, duration_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!peerId_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(1, peerId_);
+      }
+      if (duration_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(2, duration_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.BanPeerRequest)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.BanPeerRequest other = (pactus.network.NetworkOuterClass.BanPeerRequest) obj;
+
+      if (!getPeerId()
+          .equals(other.getPeerId())) return false;
+      if (getDuration()
+          != other.getDuration()) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PEER_ID_FIELD_NUMBER;
+      hash = (53 * hash) + getPeerId().hashCode();
+      hash = (37 * hash) + DURATION_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getDuration());
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.BanPeerRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.BanPeerRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.BanPeerRequest)
+        pactus.network.NetworkOuterClass.BanPeerRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.BanPeerRequest.class, pactus.network.NetworkOuterClass.BanPeerRequest.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.BanPeerRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        peerId_ = com.google.protobuf.ByteString.EMPTY;
+
+        duration_ = 0L;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.BanPeerRequest getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.BanPeerRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.BanPeerRequest build() {
+        pactus.network.NetworkOuterClass.BanPeerRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.BanPeerRequest buildPartial() {
+        pactus.network.NetworkOuterClass.BanPeerRequest result = new pactus.network.NetworkOuterClass.BanPeerRequest(this);
+        result.peerId_ = peerId_;
+        result.duration_ = duration_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.BanPeerRequest) {
+          return mergeFrom((pactus.network.NetworkOuterClass.BanPeerRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.BanPeerRequest other) {
+        if (other == pactus.network.NetworkOuterClass.BanPeerRequest.getDefaultInstance()) return this;
+        if (other.getPeerId() != com.google.protobuf.ByteString.EMPTY) {
+          setPeerId(other.getPeerId());
+        }
+        if (other.getDuration() != 0L) {
+          setDuration(other.getDuration());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                peerId_ = input.readBytes();
+
+                break;
+              } // case 10
+              case 16: {
+                duration_ = input.readInt64();
+
+                break;
+              } // case 16
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private com.google.protobuf.ByteString peerId_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+       * @return The peerId.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getPeerId() {
+        return peerId_;
+      }
+      /**
+       * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+       * @param value The peerId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPeerId(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        peerId_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPeerId() {
+        
+        peerId_ = getDefaultInstance().getPeerId();
+        onChanged();
+        return this;
+      }
+
+      private long duration_ ;
+      /**
+       * <pre>
+       * Ban duration in seconds. Zero means the peer is banned permanently.
+       * </pre>
+       *
+       * <code>int64 duration = 2 [json_name = "duration"];</code>
+       * @return The duration.
+       */
+      @java.lang.Override
+      public long getDuration() {
+        return duration_;
+      }
+      /**
+       * <pre>
+       * Ban duration in seconds. Zero means the peer is banned permanently.
+       * </pre>
+       *
+       * <code>int64 duration = 2 [json_name = "duration"];</code>
+       * @param value The duration to set.
+       * @return This builder for chaining.
+       */
+      public Builder setDuration(long value) {
+        
+        duration_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Ban duration in seconds. Zero means the peer is banned permanently.
+       * </pre>
+       *
+       * <code>int64 duration = 2 [json_name = "duration"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearDuration() {
+        
+        duration_ = 0L;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.BanPeerRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.BanPeerRequest)
+    private static final pactus.network.NetworkOuterClass.BanPeerRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.BanPeerRequest();
+    }
+
+    public static pactus.network.NetworkOuterClass.BanPeerRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<BanPeerRequest>
+        PARSER = new com.google.protobuf.AbstractParser<BanPeerRequest>() {
+      @java.lang.Override
+      public BanPeerRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<BanPeerRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<BanPeerRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.BanPeerRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface BanPeerResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.BanPeerResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code pactus.BanPeerResponse}
+   */
+  public static final class BanPeerResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.BanPeerResponse)
+      BanPeerResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use BanPeerResponse.newBuilder() to construct.
+    private BanPeerResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private BanPeerResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new BanPeerResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.BanPeerResponse.class, pactus.network.NetworkOuterClass.BanPeerResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.BanPeerResponse)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.BanPeerResponse other = (pactus.network.NetworkOuterClass.BanPeerResponse) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.BanPeerResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.BanPeerResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.BanPeerResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.BanPeerResponse)
+        pactus.network.NetworkOuterClass.BanPeerResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.BanPeerResponse.class, pactus.network.NetworkOuterClass.BanPeerResponse.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.BanPeerResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_BanPeerResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.BanPeerResponse getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.BanPeerResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.BanPeerResponse build() {
+        pactus.network.NetworkOuterClass.BanPeerResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.BanPeerResponse buildPartial() {
+        pactus.network.NetworkOuterClass.BanPeerResponse result = new pactus.network.NetworkOuterClass.BanPeerResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.BanPeerResponse) {
+          return mergeFrom((pactus.network.NetworkOuterClass.BanPeerResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.BanPeerResponse other) {
+        if (other == pactus.network.NetworkOuterClass.BanPeerResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.BanPeerResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.BanPeerResponse)
+    private static final pactus.network.NetworkOuterClass.BanPeerResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.BanPeerResponse();
+    }
+
+    public static pactus.network.NetworkOuterClass.BanPeerResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<BanPeerResponse>
+        PARSER = new com.google.protobuf.AbstractParser<BanPeerResponse>() {
+      @java.lang.Override
+      public BanPeerResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<BanPeerResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<BanPeerResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.BanPeerResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface UnbanPeerRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.UnbanPeerRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
+    com.google.protobuf.ByteString getPeerId();
+  }
+  /**
+   * Protobuf type {@code pactus.UnbanPeerRequest}
+   */
+  public static final class UnbanPeerRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.UnbanPeerRequest)
+      UnbanPeerRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use UnbanPeerRequest.newBuilder() to construct.
+    private UnbanPeerRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private UnbanPeerRequest() {
+      peerId_ = com.google.protobuf.ByteString.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new UnbanPeerRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.UnbanPeerRequest.class, pactus.network.NetworkOuterClass.UnbanPeerRequest.Builder.class);
+    }
+
+    public static final int PEER_ID_FIELD_NUMBER = 1;
+    private com.google.protobuf.ByteString peerId_;
+    /**
+     * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+     * @return The peerId.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getPeerId() {
+      return peerId_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!peerId_.isEmpty()) {
+        output.writeBytes(1, peerId_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!peerId_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(1, peerId_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.UnbanPeerRequest)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.UnbanPeerRequest other = (pactus.network.NetworkOuterClass.UnbanPeerRequest) obj;
+
+      if (!getPeerId()
+          .equals(other.getPeerId())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PEER_ID_FIELD_NUMBER;
+      hash = (53 * hash) + getPeerId().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.UnbanPeerRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.UnbanPeerRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.UnbanPeerRequest)
+        pactus.network.NetworkOuterClass.UnbanPeerRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.UnbanPeerRequest.class, pactus.network.NetworkOuterClass.UnbanPeerRequest.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.UnbanPeerRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        peerId_ = com.google.protobuf.ByteString.EMPTY;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.UnbanPeerRequest getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.UnbanPeerRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.UnbanPeerRequest build() {
+        pactus.network.NetworkOuterClass.UnbanPeerRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.UnbanPeerRequest buildPartial() {
+        pactus.network.NetworkOuterClass.UnbanPeerRequest result = new pactus.network.NetworkOuterClass.UnbanPeerRequest(this);
+        result.peerId_ = peerId_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.UnbanPeerRequest) {
+          return mergeFrom((pactus.network.NetworkOuterClass.UnbanPeerRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.UnbanPeerRequest other) {
+        if (other == pactus.network.NetworkOuterClass.UnbanPeerRequest.getDefaultInstance()) return this;
+        if (other.getPeerId() != com.google.protobuf.ByteString.EMPTY) {
+          setPeerId(other.getPeerId());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                peerId_ = input.readBytes();
+
+                break;
+              } // case 10
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private com.google.protobuf.ByteString peerId_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+       * @return The peerId.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getPeerId() {
+        return peerId_;
+      }
+      /**
+       * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+       * @param value The peerId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPeerId(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        peerId_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes peer_id = 1 [json_name = "peerId"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPeerId() {
+        
+        peerId_ = getDefaultInstance().getPeerId();
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.UnbanPeerRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.UnbanPeerRequest)
+    private static final pactus.network.NetworkOuterClass.UnbanPeerRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.UnbanPeerRequest();
+    }
+
+    public static pactus.network.NetworkOuterClass.UnbanPeerRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<UnbanPeerRequest>
+        PARSER = new com.google.protobuf.AbstractParser<UnbanPeerRequest>() {
+      @java.lang.Override
+      public UnbanPeerRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<UnbanPeerRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<UnbanPeerRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.UnbanPeerRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface UnbanPeerResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.UnbanPeerResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code pactus.UnbanPeerResponse}
+   */
+  public static final class UnbanPeerResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.UnbanPeerResponse)
+      UnbanPeerResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use UnbanPeerResponse.newBuilder() to construct.
+    private UnbanPeerResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private UnbanPeerResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new UnbanPeerResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.UnbanPeerResponse.class, pactus.network.NetworkOuterClass.UnbanPeerResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.UnbanPeerResponse)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.UnbanPeerResponse other = (pactus.network.NetworkOuterClass.UnbanPeerResponse) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.UnbanPeerResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.UnbanPeerResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.UnbanPeerResponse)
+        pactus.network.NetworkOuterClass.UnbanPeerResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.UnbanPeerResponse.class, pactus.network.NetworkOuterClass.UnbanPeerResponse.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.UnbanPeerResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_UnbanPeerResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.UnbanPeerResponse getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.UnbanPeerResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.UnbanPeerResponse build() {
+        pactus.network.NetworkOuterClass.UnbanPeerResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.UnbanPeerResponse buildPartial() {
+        pactus.network.NetworkOuterClass.UnbanPeerResponse result = new pactus.network.NetworkOuterClass.UnbanPeerResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.UnbanPeerResponse) {
+          return mergeFrom((pactus.network.NetworkOuterClass.UnbanPeerResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.UnbanPeerResponse other) {
+        if (other == pactus.network.NetworkOuterClass.UnbanPeerResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.UnbanPeerResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.UnbanPeerResponse)
+    private static final pactus.network.NetworkOuterClass.UnbanPeerResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.UnbanPeerResponse();
+    }
+
+    public static pactus.network.NetworkOuterClass.UnbanPeerResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<UnbanPeerResponse>
+        PARSER = new com.google.protobuf.AbstractParser<UnbanPeerResponse>() {
+      @java.lang.Override
+      public UnbanPeerResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<UnbanPeerResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<UnbanPeerResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.UnbanPeerResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PauseSyncRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.PauseSyncRequest)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * <pre>
+   * While the sync is paused, the node stays connected, but it doesn't download
+   * or process the blocks. The open sessions are closed.
+   * </pre>
+   *
+   * Protobuf type {@code pactus.PauseSyncRequest}
+   */
+  public static final class PauseSyncRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.PauseSyncRequest)
+      PauseSyncRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use PauseSyncRequest.newBuilder() to construct.
+    private PauseSyncRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private PauseSyncRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new PauseSyncRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.PauseSyncRequest.class, pactus.network.NetworkOuterClass.PauseSyncRequest.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.PauseSyncRequest)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.PauseSyncRequest other = (pactus.network.NetworkOuterClass.PauseSyncRequest) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.PauseSyncRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * <pre>
+     * While the sync is paused, the node stays connected, but it doesn't download
+     * or process the blocks. The open sessions are closed.
+     * </pre>
+     *
+     * Protobuf type {@code pactus.PauseSyncRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.PauseSyncRequest)
+        pactus.network.NetworkOuterClass.PauseSyncRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.PauseSyncRequest.class, pactus.network.NetworkOuterClass.PauseSyncRequest.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.PauseSyncRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.PauseSyncRequest getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.PauseSyncRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.PauseSyncRequest build() {
+        pactus.network.NetworkOuterClass.PauseSyncRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.PauseSyncRequest buildPartial() {
+        pactus.network.NetworkOuterClass.PauseSyncRequest result = new pactus.network.NetworkOuterClass.PauseSyncRequest(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.PauseSyncRequest) {
+          return mergeFrom((pactus.network.NetworkOuterClass.PauseSyncRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.PauseSyncRequest other) {
+        if (other == pactus.network.NetworkOuterClass.PauseSyncRequest.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.PauseSyncRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.PauseSyncRequest)
+    private static final pactus.network.NetworkOuterClass.PauseSyncRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.PauseSyncRequest();
+    }
+
+    public static pactus.network.NetworkOuterClass.PauseSyncRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PauseSyncRequest>
+        PARSER = new com.google.protobuf.AbstractParser<PauseSyncRequest>() {
+      @java.lang.Override
+      public PauseSyncRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PauseSyncRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PauseSyncRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.PauseSyncRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PauseSyncResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.PauseSyncResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code pactus.PauseSyncResponse}
+   */
+  public static final class PauseSyncResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.PauseSyncResponse)
+      PauseSyncResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use PauseSyncResponse.newBuilder() to construct.
+    private PauseSyncResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private PauseSyncResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new PauseSyncResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.PauseSyncResponse.class, pactus.network.NetworkOuterClass.PauseSyncResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.PauseSyncResponse)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.PauseSyncResponse other = (pactus.network.NetworkOuterClass.PauseSyncResponse) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.PauseSyncResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.PauseSyncResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.PauseSyncResponse)
+        pactus.network.NetworkOuterClass.PauseSyncResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.PauseSyncResponse.class, pactus.network.NetworkOuterClass.PauseSyncResponse.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.PauseSyncResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_PauseSyncResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.PauseSyncResponse getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.PauseSyncResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.PauseSyncResponse build() {
+        pactus.network.NetworkOuterClass.PauseSyncResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.PauseSyncResponse buildPartial() {
+        pactus.network.NetworkOuterClass.PauseSyncResponse result = new pactus.network.NetworkOuterClass.PauseSyncResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.PauseSyncResponse) {
+          return mergeFrom((pactus.network.NetworkOuterClass.PauseSyncResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.PauseSyncResponse other) {
+        if (other == pactus.network.NetworkOuterClass.PauseSyncResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.PauseSyncResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.PauseSyncResponse)
+    private static final pactus.network.NetworkOuterClass.PauseSyncResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.PauseSyncResponse();
+    }
+
+    public static pactus.network.NetworkOuterClass.PauseSyncResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PauseSyncResponse>
+        PARSER = new com.google.protobuf.AbstractParser<PauseSyncResponse>() {
+      @java.lang.Override
+      public PauseSyncResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<PauseSyncResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PauseSyncResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.PauseSyncResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ResumeSyncRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.ResumeSyncRequest)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code pactus.ResumeSyncRequest}
+   */
+  public static final class ResumeSyncRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.ResumeSyncRequest)
+      ResumeSyncRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ResumeSyncRequest.newBuilder() to construct.
+    private ResumeSyncRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ResumeSyncRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ResumeSyncRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.ResumeSyncRequest.class, pactus.network.NetworkOuterClass.ResumeSyncRequest.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.ResumeSyncRequest)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.ResumeSyncRequest other = (pactus.network.NetworkOuterClass.ResumeSyncRequest) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.ResumeSyncRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.ResumeSyncRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.ResumeSyncRequest)
+        pactus.network.NetworkOuterClass.ResumeSyncRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.ResumeSyncRequest.class, pactus.network.NetworkOuterClass.ResumeSyncRequest.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.ResumeSyncRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.ResumeSyncRequest getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.ResumeSyncRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.ResumeSyncRequest build() {
+        pactus.network.NetworkOuterClass.ResumeSyncRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.ResumeSyncRequest buildPartial() {
+        pactus.network.NetworkOuterClass.ResumeSyncRequest result = new pactus.network.NetworkOuterClass.ResumeSyncRequest(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.ResumeSyncRequest) {
+          return mergeFrom((pactus.network.NetworkOuterClass.ResumeSyncRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.ResumeSyncRequest other) {
+        if (other == pactus.network.NetworkOuterClass.ResumeSyncRequest.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.ResumeSyncRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.ResumeSyncRequest)
+    private static final pactus.network.NetworkOuterClass.ResumeSyncRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.ResumeSyncRequest();
+    }
+
+    public static pactus.network.NetworkOuterClass.ResumeSyncRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ResumeSyncRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ResumeSyncRequest>() {
+      @java.lang.Override
+      public ResumeSyncRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<ResumeSyncRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ResumeSyncRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.ResumeSyncRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ResumeSyncResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.ResumeSyncResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code pactus.ResumeSyncResponse}
+   */
+  public static final class ResumeSyncResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.ResumeSyncResponse)
+      ResumeSyncResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ResumeSyncResponse.newBuilder() to construct.
+    private ResumeSyncResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ResumeSyncResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ResumeSyncResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.ResumeSyncResponse.class, pactus.network.NetworkOuterClass.ResumeSyncResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.ResumeSyncResponse)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.ResumeSyncResponse other = (pactus.network.NetworkOuterClass.ResumeSyncResponse) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.ResumeSyncResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.ResumeSyncResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.ResumeSyncResponse)
+        pactus.network.NetworkOuterClass.ResumeSyncResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.ResumeSyncResponse.class, pactus.network.NetworkOuterClass.ResumeSyncResponse.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.ResumeSyncResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ResumeSyncResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.ResumeSyncResponse getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.ResumeSyncResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.ResumeSyncResponse build() {
+        pactus.network.NetworkOuterClass.ResumeSyncResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.ResumeSyncResponse buildPartial() {
+        pactus.network.NetworkOuterClass.ResumeSyncResponse result = new pactus.network.NetworkOuterClass.ResumeSyncResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.ResumeSyncResponse) {
+          return mergeFrom((pactus.network.NetworkOuterClass.ResumeSyncResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.ResumeSyncResponse other) {
+        if (other == pactus.network.NetworkOuterClass.ResumeSyncResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.ResumeSyncResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.ResumeSyncResponse)
+    private static final pactus.network.NetworkOuterClass.ResumeSyncResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.ResumeSyncResponse();
+    }
+
+    public static pactus.network.NetworkOuterClass.ResumeSyncResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ResumeSyncResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ResumeSyncResponse>() {
+      @java.lang.Override
+      public ResumeSyncResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<ResumeSyncResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ResumeSyncResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.ResumeSyncResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetRewardAddressesRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetRewardAddressesRequest)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code pactus.GetRewardAddressesRequest}
+   */
+  public static final class GetRewardAddressesRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetRewardAddressesRequest)
+      GetRewardAddressesRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetRewardAddressesRequest.newBuilder() to construct.
+    private GetRewardAddressesRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetRewardAddressesRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetRewardAddressesRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.GetRewardAddressesRequest.class, pactus.network.NetworkOuterClass.GetRewardAddressesRequest.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.GetRewardAddressesRequest)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.GetRewardAddressesRequest other = (pactus.network.NetworkOuterClass.GetRewardAddressesRequest) obj;
+
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetRewardAddressesRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.GetRewardAddressesRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetRewardAddressesRequest)
+        pactus.network.NetworkOuterClass.GetRewardAddressesRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.GetRewardAddressesRequest.class, pactus.network.NetworkOuterClass.GetRewardAddressesRequest.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.GetRewardAddressesRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetRewardAddressesRequest getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.GetRewardAddressesRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetRewardAddressesRequest build() {
+        pactus.network.NetworkOuterClass.GetRewardAddressesRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetRewardAddressesRequest buildPartial() {
+        pactus.network.NetworkOuterClass.GetRewardAddressesRequest result = new pactus.network.NetworkOuterClass.GetRewardAddressesRequest(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.GetRewardAddressesRequest) {
+          return mergeFrom((pactus.network.NetworkOuterClass.GetRewardAddressesRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetRewardAddressesRequest other) {
+        if (other == pactus.network.NetworkOuterClass.GetRewardAddressesRequest.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetRewardAddressesRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetRewardAddressesRequest)
+    private static final pactus.network.NetworkOuterClass.GetRewardAddressesRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetRewardAddressesRequest();
+    }
+
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetRewardAddressesRequest>
+        PARSER = new com.google.protobuf.AbstractParser<GetRewardAddressesRequest>() {
+      @java.lang.Override
+      public GetRewardAddressesRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetRewardAddressesRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetRewardAddressesRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.GetRewardAddressesRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetRewardAddressesResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetRewardAddressesResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    java.util.List<pactus.network.NetworkOuterClass.ValidatorReward> 
+        getValidatorsList();
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    pactus.network.NetworkOuterClass.ValidatorReward getValidators(int index);
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    int getValidatorsCount();
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    java.util.List<? extends pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder> 
+        getValidatorsOrBuilderList();
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder getValidatorsOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code pactus.GetRewardAddressesResponse}
+   */
+  public static final class GetRewardAddressesResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetRewardAddressesResponse)
+      GetRewardAddressesResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetRewardAddressesResponse.newBuilder() to construct.
+    private GetRewardAddressesResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetRewardAddressesResponse() {
+      validators_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetRewardAddressesResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.network.NetworkOuterClass.GetRewardAddressesResponse.class, pactus.network.NetworkOuterClass.GetRewardAddressesResponse.Builder.class);
+    }
+
+    public static final int VALIDATORS_FIELD_NUMBER = 1;
+    private java.util.List<pactus.network.NetworkOuterClass.ValidatorReward> validators_;
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    @java.lang.Override
+    public java.util.List<pactus.network.NetworkOuterClass.ValidatorReward> getValidatorsList() {
+      return validators_;
+    }
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder> 
+        getValidatorsOrBuilderList() {
+      return validators_;
+    }
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    @java.lang.Override
+    public int getValidatorsCount() {
+      return validators_.size();
+    }
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.ValidatorReward getValidators(int index) {
+      return validators_.get(index);
+    }
+    /**
+     * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+     */
+    @java.lang.Override
+    public pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder getValidatorsOrBuilder(
+        int index) {
+      return validators_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      for (int i = 0; i < validators_.size(); i++) {
+        output.writeMessage(1, validators_.get(i));
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      for (int i = 0; i < validators_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, validators_.get(i));
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.network.NetworkOuterClass.GetRewardAddressesResponse)) {
+        return super.equals(obj);
+      }
+      pactus.network.NetworkOuterClass.GetRewardAddressesResponse other = (pactus.network.NetworkOuterClass.GetRewardAddressesResponse) obj;
+
+      if (!getValidatorsList()
+          .equals(other.getValidatorsList())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getValidatorsCount() > 0) {
+        hash = (37 * hash) + VALIDATORS_FIELD_NUMBER;
+        hash = (53 * hash) + getValidatorsList().hashCode();
+      }
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetRewardAddressesResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.GetRewardAddressesResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetRewardAddressesResponse)
+        pactus.network.NetworkOuterClass.GetRewardAddressesResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.network.NetworkOuterClass.GetRewardAddressesResponse.class, pactus.network.NetworkOuterClass.GetRewardAddressesResponse.Builder.class);
+      }
+
+      // Construct using pactus.network.NetworkOuterClass.GetRewardAddressesResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (validatorsBuilder_ == null) {
+          validators_ = java.util.Collections.emptyList();
+        } else {
+          validators_ = null;
+          validatorsBuilder_.clear();
+        }
+        bitField0_ = (bitField0_ & ~0x00000001);
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.network.NetworkOuterClass.internal_static_pactus_GetRewardAddressesResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetRewardAddressesResponse getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.GetRewardAddressesResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetRewardAddressesResponse build() {
+        pactus.network.NetworkOuterClass.GetRewardAddressesResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.network.NetworkOuterClass.GetRewardAddressesResponse buildPartial() {
+        pactus.network.NetworkOuterClass.GetRewardAddressesResponse result = new pactus.network.NetworkOuterClass.GetRewardAddressesResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (validatorsBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            validators_ = java.util.Collections.unmodifiableList(validators_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.validators_ = validators_;
+        } else {
+          result.validators_ = validatorsBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.network.NetworkOuterClass.GetRewardAddressesResponse) {
+          return mergeFrom((pactus.network.NetworkOuterClass.GetRewardAddressesResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetRewardAddressesResponse other) {
+        if (other == pactus.network.NetworkOuterClass.GetRewardAddressesResponse.getDefaultInstance()) return this;
+        if (validatorsBuilder_ == null) {
+          if (!other.validators_.isEmpty()) {
+            if (validators_.isEmpty()) {
+              validators_ = other.validators_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureValidatorsIsMutable();
+              validators_.addAll(other.validators_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.validators_.isEmpty()) {
+            if (validatorsBuilder_.isEmpty()) {
+              validatorsBuilder_.dispose();
+              validatorsBuilder_ = null;
+              validators_ = other.validators_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              validatorsBuilder_ = 
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getValidatorsFieldBuilder() : null;
+            } else {
+              validatorsBuilder_.addAllMessages(other.validators_);
+            }
+          }
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                pactus.network.NetworkOuterClass.ValidatorReward m =
+                    input.readMessage(
+                        pactus.network.NetworkOuterClass.ValidatorReward.parser(),
+                        extensionRegistry);
+                if (validatorsBuilder_ == null) {
+                  ensureValidatorsIsMutable();
+                  validators_.add(m);
+                } else {
+                  validatorsBuilder_.addMessage(m);
+                }
+                break;
+              } // case 10
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<pactus.network.NetworkOuterClass.ValidatorReward> validators_ =
+        java.util.Collections.emptyList();
+      private void ensureValidatorsIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          validators_ = new java.util.ArrayList<pactus.network.NetworkOuterClass.ValidatorReward>(validators_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          pactus.network.NetworkOuterClass.ValidatorReward, pactus.network.NetworkOuterClass.ValidatorReward.Builder, pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder> validatorsBuilder_;
+
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public java.util.List<pactus.network.NetworkOuterClass.ValidatorReward> getValidatorsList() {
+        if (validatorsBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(validators_);
+        } else {
+          return validatorsBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public int getValidatorsCount() {
+        if (validatorsBuilder_ == null) {
+          return validators_.size();
+        } else {
+          return validatorsBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public pactus.network.NetworkOuterClass.ValidatorReward getValidators(int index) {
+        if (validatorsBuilder_ == null) {
+          return validators_.get(index);
+        } else {
+          return validatorsBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder setValidators(
+          int index, pactus.network.NetworkOuterClass.ValidatorReward value) {
+        if (validatorsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureValidatorsIsMutable();
+          validators_.set(index, value);
+          onChanged();
+        } else {
+          validatorsBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder setValidators(
+          int index, pactus.network.NetworkOuterClass.ValidatorReward.Builder builderForValue) {
+        if (validatorsBuilder_ == null) {
+          ensureValidatorsIsMutable();
+          validators_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          validatorsBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder addValidators(pactus.network.NetworkOuterClass.ValidatorReward value) {
+        if (validatorsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureValidatorsIsMutable();
+          validators_.add(value);
+          onChanged();
+        } else {
+          validatorsBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder addValidators(
+          int index, pactus.network.NetworkOuterClass.ValidatorReward value) {
+        if (validatorsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureValidatorsIsMutable();
+          validators_.add(index, value);
+          onChanged();
+        } else {
+          validatorsBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder addValidators(
+          pactus.network.NetworkOuterClass.ValidatorReward.Builder builderForValue) {
+        if (validatorsBuilder_ == null) {
+          ensureValidatorsIsMutable();
+          validators_.add(builderForValue.build());
+          onChanged();
+        } else {
+          validatorsBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder addValidators(
+          int index, pactus.network.NetworkOuterClass.ValidatorReward.Builder builderForValue) {
+        if (validatorsBuilder_ == null) {
+          ensureValidatorsIsMutable();
+          validators_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          validatorsBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder addAllValidators(
+          java.lang.Iterable<? extends pactus.network.NetworkOuterClass.ValidatorReward> values) {
+        if (validatorsBuilder_ == null) {
+          ensureValidatorsIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, validators_);
+          onChanged();
+        } else {
+          validatorsBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder clearValidators() {
+        if (validatorsBuilder_ == null) {
+          validators_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          validatorsBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public Builder removeValidators(int index) {
+        if (validatorsBuilder_ == null) {
+          ensureValidatorsIsMutable();
+          validators_.remove(index);
+          onChanged();
+        } else {
+          validatorsBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public pactus.network.NetworkOuterClass.ValidatorReward.Builder getValidatorsBuilder(
+          int index) {
+        return getValidatorsFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder getValidatorsOrBuilder(
+          int index) {
+        if (validatorsBuilder_ == null) {
+          return validators_.get(index);  } else {
+          return validatorsBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public java.util.List<? extends pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder> 
+           getValidatorsOrBuilderList() {
+        if (validatorsBuilder_ != null) {
+          return validatorsBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(validators_);
+        }
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public pactus.network.NetworkOuterClass.ValidatorReward.Builder addValidatorsBuilder() {
+        return getValidatorsFieldBuilder().addBuilder(
+            pactus.network.NetworkOuterClass.ValidatorReward.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public pactus.network.NetworkOuterClass.ValidatorReward.Builder addValidatorsBuilder(
+          int index) {
+        return getValidatorsFieldBuilder().addBuilder(
+            index, pactus.network.NetworkOuterClass.ValidatorReward.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .pactus.ValidatorReward validators = 1 [json_name = "validators"];</code>
+       */
+      public java.util.List<pactus.network.NetworkOuterClass.ValidatorReward.Builder> 
+           getValidatorsBuilderList() {
+        return getValidatorsFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          pactus.network.NetworkOuterClass.ValidatorReward, pactus.network.NetworkOuterClass.ValidatorReward.Builder, pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder> 
+          getValidatorsFieldBuilder() {
+        if (validatorsBuilder_ == null) {
+          validatorsBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              pactus.network.NetworkOuterClass.ValidatorReward, pactus.network.NetworkOuterClass.ValidatorReward.Builder, pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder>(
+                  validators_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          validators_ = null;
+        }
+        return validatorsBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetRewardAddressesResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetRewardAddressesResponse)
+    private static final pactus.network.NetworkOuterClass.GetRewardAddressesResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetRewardAddressesResponse();
+    }
+
+    public static pactus.network.NetworkOuterClass.GetRewardAddressesResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetRewardAddressesResponse>
+        PARSER = new com.google.protobuf.AbstractParser<GetRewardAddressesResponse>() {
+      @java.lang.Override
+      public GetRewardAddressesResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
         Builder builder = newBuilder();
@@ -2380,79 +10507,121 @@ public final class NetworkOuterClass {
       }
     };
 
-    public static com.google.protobuf.Parser<GetNodeInfoRequest> parser() {
+    public static com.google.protobuf.Parser<GetRewardAddressesResponse> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<GetNodeInfoRequest> getParserForType() {
+    public com.google.protobuf.Parser<GetRewardAddressesResponse> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public pactus.network.NetworkOuterClass.GetNodeInfoRequest getDefaultInstanceForType() {
+    public pactus.network.NetworkOuterClass.GetRewardAddressesResponse getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface GetNodeInfoResponseOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:pactus.GetNodeInfoResponse)
+  public interface ValidatorRewardOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.ValidatorReward)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>string moniker = 1 [json_name = "moniker"];</code>
-     * @return The moniker.
+     * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+     * @return The validatorAddress.
      */
-    java.lang.String getMoniker();
+    java.lang.String getValidatorAddress();
     /**
-     * <code>string moniker = 1 [json_name = "moniker"];</code>
-     * @return The bytes for moniker.
+     * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+     * @return The bytes for validatorAddress.
      */
     com.google.protobuf.ByteString
-        getMonikerBytes();
+        getValidatorAddressBytes();
 
     /**
-     * <code>string agent = 2 [json_name = "agent"];</code>
-     * @return The agent.
+     * <pre>
+     * The validator number, or -1 if the validator is not registered yet.
+     * </pre>
+     *
+     * <code>int32 validator_number = 2 [json_name = "validatorNumber"];</code>
+     * @return The validatorNumber.
      */
-    java.lang.String getAgent();
+    int getValidatorNumber();
+
     /**
-     * <code>string agent = 2 [json_name = "agent"];</code>
-     * @return The bytes for agent.
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @return A list containing the rewardAddresses.
+     */
+    java.util.List<java.lang.String>
+        getRewardAddressesList();
+    /**
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @return The count of rewardAddresses.
+     */
+    int getRewardAddressesCount();
+    /**
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @param index The index of the element to return.
+     * @return The rewardAddresses at the given index.
+     */
+    java.lang.String getRewardAddresses(int index);
+    /**
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the rewardAddresses at the given index.
      */
     com.google.protobuf.ByteString
-        getAgentBytes();
+        getRewardAddressesBytes(int index);
 
     /**
-     * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
-     * @return The peerId.
+     * <pre>
+     * The number of blocks that each reward address stays active.
+     * </pre>
+     *
+     * <code>uint32 rotation_interval = 4 [json_name = "rotationInterval"];</code>
+     * @return The rotationInterval.
      */
-    com.google.protobuf.ByteString getPeerId();
+    int getRotationInterval();
   }
   /**
-   * Protobuf type {@code pactus.GetNodeInfoResponse}
+   * Protobuf type {@code pactus.ValidatorReward}
    */
-  public static final class GetNodeInfoResponse extends
+  public static final class ValidatorReward extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:pactus.GetNodeInfoResponse)
-      GetNodeInfoResponseOrBuilder {
+      // @@protoc_insertion_point(message_implements:pactus.ValidatorReward)
+      ValidatorRewardOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use GetNodeInfoResponse.newBuilder() to construct.
-    private GetNodeInfoResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use ValidatorReward.newBuilder() to construct.
+    private ValidatorReward(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private GetNodeInfoResponse() {
-      moniker_ = "";
-      agent_ = "";
-      peerId_ = com.google.protobuf.ByteString.EMPTY;
+    private ValidatorReward() {
+      validatorAddress_ = "";
+      rewardAddresses_ = com.google.protobuf.LazyStringArrayList.EMPTY;
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new GetNodeInfoResponse();
+      return new ValidatorReward();
     }
 
     @java.lang.Override
@@ -2462,102 +10631,134 @@ public final class NetworkOuterClass {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_descriptor;
+      return pactus.network.NetworkOuterClass.internal_static_pactus_ValidatorReward_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_fieldAccessorTable
+      return pactus.network.NetworkOuterClass.internal_static_pactus_ValidatorReward_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              pactus.network.NetworkOuterClass.GetNodeInfoResponse.class, pactus.network.NetworkOuterClass.GetNodeInfoResponse.Builder.class);
-    }
-
-    public static final int MONIKER_FIELD_NUMBER = 1;
-    private volatile java.lang.Object moniker_;
-    /**
-     * <code>string moniker = 1 [json_name = "moniker"];</code>
-     * @return The moniker.
-     */
-    @java.lang.Override
-    public java.lang.String getMoniker() {
-      java.lang.Object ref = moniker_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        moniker_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string moniker = 1 [json_name = "moniker"];</code>
-     * @return The bytes for moniker.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getMonikerBytes() {
-      java.lang.Object ref = moniker_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        moniker_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
+              pactus.network.NetworkOuterClass.ValidatorReward.class, pactus.network.NetworkOuterClass.ValidatorReward.Builder.class);
     }
 
-    public static final int AGENT_FIELD_NUMBER = 2;
-    private volatile java.lang.Object agent_;
+    public static final int VALIDATOR_ADDRESS_FIELD_NUMBER = 1;
+    private volatile java.lang.Object validatorAddress_;
     /**
-     * <code>string agent = 2 [json_name = "agent"];</code>
-     * @return The agent.
+     * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+     * @return The validatorAddress.
      */
     @java.lang.Override
-    public java.lang.String getAgent() {
-      java.lang.Object ref = agent_;
+    public java.lang.String getValidatorAddress() {
+      java.lang.Object ref = validatorAddress_;
       if (ref instanceof java.lang.String) {
         return (java.lang.String) ref;
       } else {
         com.google.protobuf.ByteString bs = 
             (com.google.protobuf.ByteString) ref;
         java.lang.String s = bs.toStringUtf8();
-        agent_ = s;
+        validatorAddress_ = s;
         return s;
       }
     }
     /**
-     * <code>string agent = 2 [json_name = "agent"];</code>
-     * @return The bytes for agent.
+     * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+     * @return The bytes for validatorAddress.
      */
     @java.lang.Override
     public com.google.protobuf.ByteString
-        getAgentBytes() {
-      java.lang.Object ref = agent_;
+        getValidatorAddressBytes() {
+      java.lang.Object ref = validatorAddress_;
       if (ref instanceof java.lang.String) {
         com.google.protobuf.ByteString b = 
             com.google.protobuf.ByteString.copyFromUtf8(
                 (java.lang.String) ref);
-        agent_ = b;
+        validatorAddress_ = b;
         return b;
       } else {
         return (com.google.protobuf.ByteString) ref;
       }
     }
 
-    public static final int PEER_ID_FIELD_NUMBER = 3;
-    private com.google.protobuf.ByteString peerId_;
+    public static final int VALIDATOR_NUMBER_FIELD_NUMBER = 2;
+    private int validatorNumber_;
     /**
-     * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
-     * @return The peerId.
+     * <pre>
+     * The validator number, or -1 if the validator is not registered yet.
+     * </pre>
+     *
+     * <code>int32 validator_number = 2 [json_name = "validatorNumber"];</code>
+     * @return The validatorNumber.
      */
     @java.lang.Override
-    public com.google.protobuf.ByteString getPeerId() {
-      return peerId_;
+    public int getValidatorNumber() {
+      return validatorNumber_;
+    }
+
+    public static final int REWARD_ADDRESSES_FIELD_NUMBER = 3;
+    private com.google.protobuf.LazyStringList rewardAddresses_;
+    /**
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @return A list containing the rewardAddresses.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getRewardAddressesList() {
+      return rewardAddresses_;
+    }
+    /**
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @return The count of rewardAddresses.
+     */
+    public int getRewardAddressesCount() {
+      return rewardAddresses_.size();
+    }
+    /**
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @param index The index of the element to return.
+     * @return The rewardAddresses at the given index.
+     */
+    public java.lang.String getRewardAddresses(int index) {
+      return rewardAddresses_.get(index);
+    }
+    /**
+     * <pre>
+     * The reward addresses are used in turn, if more than one is defined.
+     * </pre>
+     *
+     * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the rewardAddresses at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getRewardAddressesBytes(int index) {
+      return rewardAddresses_.getByteString(index);
+    }
+
+    public static final int ROTATION_INTERVAL_FIELD_NUMBER = 4;
+    private int rotationInterval_;
+    /**
+     * <pre>
+     * The number of blocks that each reward address stays active.
+     * </pre>
+     *
+     * <code>uint32 rotation_interval = 4 [json_name = "rotationInterval"];</code>
+     * @return The rotationInterval.
+     */
+    @java.lang.Override
+    public int getRotationInterval() {
+      return rotationInterval_;
     }
 
     private byte memoizedIsInitialized = -1;
@@ -2574,14 +10775,17 @@ public final class NetworkOuterClass {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(moniker_)) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, moniker_);
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(validatorAddress_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, validatorAddress_);
       }
-      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(agent_)) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, agent_);
+      if (validatorNumber_ != 0) {
+        output.writeInt32(2, validatorNumber_);
       }
-      if (!peerId_.isEmpty()) {
-        output.writeBytes(3, peerId_);
+      for (int i = 0; i < rewardAddresses_.size(); i++) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, rewardAddresses_.getRaw(i));
+      }
+      if (rotationInterval_ != 0) {
+        output.writeUInt32(4, rotationInterval_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -2592,15 +10796,24 @@ public final class NetworkOuterClass {
       if (size != -1) return size;
 
       size = 0;
-      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(moniker_)) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, moniker_);
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(validatorAddress_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, validatorAddress_);
       }
-      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(agent_)) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, agent_);
+      if (validatorNumber_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(2, validatorNumber_);
       }
-      if (!peerId_.isEmpty()) {
+      {
+        int dataSize = 0;
+        for (int i = 0; i < rewardAddresses_.size(); i++) {
+          dataSize += computeStringSizeNoTag(rewardAddresses_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getRewardAddressesList().size();
+      }
+      if (rotationInterval_ != 0) {
         size += com.google.protobuf.CodedOutputStream
-          .computeBytesSize(3, peerId_);
+          .computeUInt32Size(4, rotationInterval_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -2612,17 +10825,19 @@ public final class NetworkOuterClass {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof pactus.network.NetworkOuterClass.GetNodeInfoResponse)) {
+      if (!(obj instanceof pactus.network.NetworkOuterClass.ValidatorReward)) {
         return super.equals(obj);
       }
-      pactus.network.NetworkOuterClass.GetNodeInfoResponse other = (pactus.network.NetworkOuterClass.GetNodeInfoResponse) obj;
+      pactus.network.NetworkOuterClass.ValidatorReward other = (pactus.network.NetworkOuterClass.ValidatorReward) obj;
 
-      if (!getMoniker()
-          .equals(other.getMoniker())) return false;
-      if (!getAgent()
-          .equals(other.getAgent())) return false;
-      if (!getPeerId()
-          .equals(other.getPeerId())) return false;
+      if (!getValidatorAddress()
+          .equals(other.getValidatorAddress())) return false;
+      if (getValidatorNumber()
+          != other.getValidatorNumber()) return false;
+      if (!getRewardAddressesList()
+          .equals(other.getRewardAddressesList())) return false;
+      if (getRotationInterval()
+          != other.getRotationInterval()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -2634,80 +10849,84 @@ public final class NetworkOuterClass {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + MONIKER_FIELD_NUMBER;
-      hash = (53 * hash) + getMoniker().hashCode();
-      hash = (37 * hash) + AGENT_FIELD_NUMBER;
-      hash = (53 * hash) + getAgent().hashCode();
-      hash = (37 * hash) + PEER_ID_FIELD_NUMBER;
-      hash = (53 * hash) + getPeerId().hashCode();
+      hash = (37 * hash) + VALIDATOR_ADDRESS_FIELD_NUMBER;
+      hash = (53 * hash) + getValidatorAddress().hashCode();
+      hash = (37 * hash) + VALIDATOR_NUMBER_FIELD_NUMBER;
+      hash = (53 * hash) + getValidatorNumber();
+      if (getRewardAddressesCount() > 0) {
+        hash = (37 * hash) + REWARD_ADDRESSES_FIELD_NUMBER;
+        hash = (53 * hash) + getRewardAddressesList().hashCode();
+      }
+      hash = (37 * hash) + ROTATION_INTERVAL_FIELD_NUMBER;
+      hash = (53 * hash) + getRotationInterval();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(byte[] data)
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(java.io.InputStream input)
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseDelimitedFrom(java.io.InputStream input)
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseDelimitedFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse parseFrom(
+    public static pactus.network.NetworkOuterClass.ValidatorReward parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -2720,7 +10939,7 @@ public final class NetworkOuterClass {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(pactus.network.NetworkOuterClass.GetNodeInfoResponse prototype) {
+    public static Builder newBuilder(pactus.network.NetworkOuterClass.ValidatorReward prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -2736,26 +10955,26 @@ public final class NetworkOuterClass {
       return builder;
     }
     /**
-     * Protobuf type {@code pactus.GetNodeInfoResponse}
+     * Protobuf type {@code pactus.ValidatorReward}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:pactus.GetNodeInfoResponse)
-        pactus.network.NetworkOuterClass.GetNodeInfoResponseOrBuilder {
+        // @@protoc_insertion_point(builder_implements:pactus.ValidatorReward)
+        pactus.network.NetworkOuterClass.ValidatorRewardOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_descriptor;
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ValidatorReward_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_fieldAccessorTable
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ValidatorReward_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                pactus.network.NetworkOuterClass.GetNodeInfoResponse.class, pactus.network.NetworkOuterClass.GetNodeInfoResponse.Builder.class);
+                pactus.network.NetworkOuterClass.ValidatorReward.class, pactus.network.NetworkOuterClass.ValidatorReward.Builder.class);
       }
 
-      // Construct using pactus.network.NetworkOuterClass.GetNodeInfoResponse.newBuilder()
+      // Construct using pactus.network.NetworkOuterClass.ValidatorReward.newBuilder()
       private Builder() {
 
       }
@@ -2768,11 +10987,13 @@ public final class NetworkOuterClass {
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        moniker_ = "";
+        validatorAddress_ = "";
 
-        agent_ = "";
+        validatorNumber_ = 0;
 
-        peerId_ = com.google.protobuf.ByteString.EMPTY;
+        rewardAddresses_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+        bitField0_ = (bitField0_ & ~0x00000001);
+        rotationInterval_ = 0;
 
         return this;
       }
@@ -2780,17 +11001,17 @@ public final class NetworkOuterClass {
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return pactus.network.NetworkOuterClass.internal_static_pactus_GetNodeInfoResponse_descriptor;
+        return pactus.network.NetworkOuterClass.internal_static_pactus_ValidatorReward_descriptor;
       }
 
       @java.lang.Override
-      public pactus.network.NetworkOuterClass.GetNodeInfoResponse getDefaultInstanceForType() {
-        return pactus.network.NetworkOuterClass.GetNodeInfoResponse.getDefaultInstance();
+      public pactus.network.NetworkOuterClass.ValidatorReward getDefaultInstanceForType() {
+        return pactus.network.NetworkOuterClass.ValidatorReward.getDefaultInstance();
       }
 
       @java.lang.Override
-      public pactus.network.NetworkOuterClass.GetNodeInfoResponse build() {
-        pactus.network.NetworkOuterClass.GetNodeInfoResponse result = buildPartial();
+      public pactus.network.NetworkOuterClass.ValidatorReward build() {
+        pactus.network.NetworkOuterClass.ValidatorReward result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -2798,11 +11019,17 @@ public final class NetworkOuterClass {
       }
 
       @java.lang.Override
-      public pactus.network.NetworkOuterClass.GetNodeInfoResponse buildPartial() {
-        pactus.network.NetworkOuterClass.GetNodeInfoResponse result = new pactus.network.NetworkOuterClass.GetNodeInfoResponse(this);
-        result.moniker_ = moniker_;
-        result.agent_ = agent_;
-        result.peerId_ = peerId_;
+      public pactus.network.NetworkOuterClass.ValidatorReward buildPartial() {
+        pactus.network.NetworkOuterClass.ValidatorReward result = new pactus.network.NetworkOuterClass.ValidatorReward(this);
+        int from_bitField0_ = bitField0_;
+        result.validatorAddress_ = validatorAddress_;
+        result.validatorNumber_ = validatorNumber_;
+        if (((bitField0_ & 0x00000001) != 0)) {
+          rewardAddresses_ = rewardAddresses_.getUnmodifiableView();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        }
+        result.rewardAddresses_ = rewardAddresses_;
+        result.rotationInterval_ = rotationInterval_;
         onBuilt();
         return result;
       }
@@ -2841,26 +11068,35 @@ public final class NetworkOuterClass {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pactus.network.NetworkOuterClass.GetNodeInfoResponse) {
-          return mergeFrom((pactus.network.NetworkOuterClass.GetNodeInfoResponse)other);
+        if (other instanceof pactus.network.NetworkOuterClass.ValidatorReward) {
+          return mergeFrom((pactus.network.NetworkOuterClass.ValidatorReward)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(pactus.network.NetworkOuterClass.GetNodeInfoResponse other) {
-        if (other == pactus.network.NetworkOuterClass.GetNodeInfoResponse.getDefaultInstance()) return this;
-        if (!other.getMoniker().isEmpty()) {
-          moniker_ = other.moniker_;
+      public Builder mergeFrom(pactus.network.NetworkOuterClass.ValidatorReward other) {
+        if (other == pactus.network.NetworkOuterClass.ValidatorReward.getDefaultInstance()) return this;
+        if (!other.getValidatorAddress().isEmpty()) {
+          validatorAddress_ = other.validatorAddress_;
           onChanged();
         }
-        if (!other.getAgent().isEmpty()) {
-          agent_ = other.agent_;
+        if (other.getValidatorNumber() != 0) {
+          setValidatorNumber(other.getValidatorNumber());
+        }
+        if (!other.rewardAddresses_.isEmpty()) {
+          if (rewardAddresses_.isEmpty()) {
+            rewardAddresses_ = other.rewardAddresses_;
+            bitField0_ = (bitField0_ & ~0x00000001);
+          } else {
+            ensureRewardAddressesIsMutable();
+            rewardAddresses_.addAll(other.rewardAddresses_);
+          }
           onChanged();
         }
-        if (other.getPeerId() != com.google.protobuf.ByteString.EMPTY) {
-          setPeerId(other.getPeerId());
+        if (other.getRotationInterval() != 0) {
+          setRotationInterval(other.getRotationInterval());
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -2889,20 +11125,26 @@ public final class NetworkOuterClass {
                 done = true;
                 break;
               case 10: {
-                moniker_ = input.readStringRequireUtf8();
+                validatorAddress_ = input.readStringRequireUtf8();
 
                 break;
               } // case 10
-              case 18: {
-                agent_ = input.readStringRequireUtf8();
+              case 16: {
+                validatorNumber_ = input.readInt32();
 
                 break;
-              } // case 18
+              } // case 16
               case 26: {
-                peerId_ = input.readBytes();
-
+                java.lang.String s = input.readStringRequireUtf8();
+                ensureRewardAddressesIsMutable();
+                rewardAddresses_.add(s);
                 break;
               } // case 26
+              case 32: {
+                rotationInterval_ = input.readUInt32();
+
+                break;
+              } // case 32
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -2918,189 +11160,312 @@ public final class NetworkOuterClass {
         } // finally
         return this;
       }
+      private int bitField0_;
 
-      private java.lang.Object moniker_ = "";
+      private java.lang.Object validatorAddress_ = "";
       /**
-       * <code>string moniker = 1 [json_name = "moniker"];</code>
-       * @return The moniker.
+       * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+       * @return The validatorAddress.
        */
-      public java.lang.String getMoniker() {
-        java.lang.Object ref = moniker_;
+      public java.lang.String getValidatorAddress() {
+        java.lang.Object ref = validatorAddress_;
         if (!(ref instanceof java.lang.String)) {
           com.google.protobuf.ByteString bs =
               (com.google.protobuf.ByteString) ref;
           java.lang.String s = bs.toStringUtf8();
-          moniker_ = s;
+          validatorAddress_ = s;
           return s;
         } else {
           return (java.lang.String) ref;
         }
       }
       /**
-       * <code>string moniker = 1 [json_name = "moniker"];</code>
-       * @return The bytes for moniker.
+       * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+       * @return The bytes for validatorAddress.
        */
       public com.google.protobuf.ByteString
-          getMonikerBytes() {
-        java.lang.Object ref = moniker_;
+          getValidatorAddressBytes() {
+        java.lang.Object ref = validatorAddress_;
         if (ref instanceof String) {
           com.google.protobuf.ByteString b = 
               com.google.protobuf.ByteString.copyFromUtf8(
                   (java.lang.String) ref);
-          moniker_ = b;
+          validatorAddress_ = b;
           return b;
         } else {
           return (com.google.protobuf.ByteString) ref;
         }
       }
       /**
-       * <code>string moniker = 1 [json_name = "moniker"];</code>
-       * @param value The moniker to set.
+       * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+       * @param value The validatorAddress to set.
        * @return This builder for chaining.
        */
-      public Builder setMoniker(
+      public Builder setValidatorAddress(
           java.lang.String value) {
         if (value == null) {
     throw new NullPointerException();
   }
   
-        moniker_ = value;
+        validatorAddress_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>string moniker = 1 [json_name = "moniker"];</code>
+       * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
        * @return This builder for chaining.
        */
-      public Builder clearMoniker() {
+      public Builder clearValidatorAddress() {
         
-        moniker_ = getDefaultInstance().getMoniker();
+        validatorAddress_ = getDefaultInstance().getValidatorAddress();
         onChanged();
         return this;
       }
       /**
-       * <code>string moniker = 1 [json_name = "moniker"];</code>
-       * @param value The bytes for moniker to set.
+       * <code>string validator_address = 1 [json_name = "validatorAddress"];</code>
+       * @param value The bytes for validatorAddress to set.
        * @return This builder for chaining.
        */
-      public Builder setMonikerBytes(
+      public Builder setValidatorAddressBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) {
     throw new NullPointerException();
   }
   checkByteStringIsUtf8(value);
         
-        moniker_ = value;
+        validatorAddress_ = value;
         onChanged();
         return this;
       }
 
-      private java.lang.Object agent_ = "";
+      private int validatorNumber_ ;
       /**
-       * <code>string agent = 2 [json_name = "agent"];</code>
-       * @return The agent.
+       * <pre>
+       * The validator number, or -1 if the validator is not registered yet.
+       * </pre>
+       *
+       * <code>int32 validator_number = 2 [json_name = "validatorNumber"];</code>
+       * @return The validatorNumber.
        */
-      public java.lang.String getAgent() {
-        java.lang.Object ref = agent_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          agent_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
+      @java.lang.Override
+      public int getValidatorNumber() {
+        return validatorNumber_;
       }
       /**
-       * <code>string agent = 2 [json_name = "agent"];</code>
-       * @return The bytes for agent.
+       * <pre>
+       * The validator number, or -1 if the validator is not registered yet.
+       * </pre>
+       *
+       * <code>int32 validator_number = 2 [json_name = "validatorNumber"];</code>
+       * @param value The validatorNumber to set.
+       * @return This builder for chaining.
+       */
+      public Builder setValidatorNumber(int value) {
+        
+        validatorNumber_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The validator number, or -1 if the validator is not registered yet.
+       * </pre>
+       *
+       * <code>int32 validator_number = 2 [json_name = "validatorNumber"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearValidatorNumber() {
+        
+        validatorNumber_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.LazyStringList rewardAddresses_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+      private void ensureRewardAddressesIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          rewardAddresses_ = new com.google.protobuf.LazyStringArrayList(rewardAddresses_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+      /**
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @return A list containing the rewardAddresses.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getRewardAddressesList() {
+        return rewardAddresses_.getUnmodifiableView();
+      }
+      /**
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @return The count of rewardAddresses.
+       */
+      public int getRewardAddressesCount() {
+        return rewardAddresses_.size();
+      }
+      /**
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @param index The index of the element to return.
+       * @return The rewardAddresses at the given index.
+       */
+      public java.lang.String getRewardAddresses(int index) {
+        return rewardAddresses_.get(index);
+      }
+      /**
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the rewardAddresses at the given index.
        */
       public com.google.protobuf.ByteString
-          getAgentBytes() {
-        java.lang.Object ref = agent_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          agent_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
+          getRewardAddressesBytes(int index) {
+        return rewardAddresses_.getByteString(index);
       }
       /**
-       * <code>string agent = 2 [json_name = "agent"];</code>
-       * @param value The agent to set.
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @param index The index to set the value at.
+       * @param value The rewardAddresses to set.
        * @return This builder for chaining.
        */
-      public Builder setAgent(
+      public Builder setRewardAddresses(
+          int index, java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  ensureRewardAddressesIsMutable();
+        rewardAddresses_.set(index, value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @param value The rewardAddresses to add.
+       * @return This builder for chaining.
+       */
+      public Builder addRewardAddresses(
           java.lang.String value) {
         if (value == null) {
     throw new NullPointerException();
   }
-  
-        agent_ = value;
+  ensureRewardAddressesIsMutable();
+        rewardAddresses_.add(value);
         onChanged();
         return this;
       }
       /**
-       * <code>string agent = 2 [json_name = "agent"];</code>
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @param values The rewardAddresses to add.
        * @return This builder for chaining.
        */
-      public Builder clearAgent() {
-        
-        agent_ = getDefaultInstance().getAgent();
+      public Builder addAllRewardAddresses(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureRewardAddressesIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, rewardAddresses_);
         onChanged();
         return this;
       }
       /**
-       * <code>string agent = 2 [json_name = "agent"];</code>
-       * @param value The bytes for agent to set.
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
        * @return This builder for chaining.
        */
-      public Builder setAgentBytes(
+      public Builder clearRewardAddresses() {
+        rewardAddresses_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The reward addresses are used in turn, if more than one is defined.
+       * </pre>
+       *
+       * <code>repeated string reward_addresses = 3 [json_name = "rewardAddresses"];</code>
+       * @param value The bytes of the rewardAddresses to add.
+       * @return This builder for chaining.
+       */
+      public Builder addRewardAddressesBytes(
           com.google.protobuf.ByteString value) {
         if (value == null) {
     throw new NullPointerException();
   }
   checkByteStringIsUtf8(value);
-        
-        agent_ = value;
+        ensureRewardAddressesIsMutable();
+        rewardAddresses_.add(value);
         onChanged();
         return this;
       }
 
-      private com.google.protobuf.ByteString peerId_ = com.google.protobuf.ByteString.EMPTY;
+      private int rotationInterval_ ;
       /**
-       * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
-       * @return The peerId.
+       * <pre>
+       * The number of blocks that each reward address stays active.
+       * </pre>
+       *
+       * <code>uint32 rotation_interval = 4 [json_name = "rotationInterval"];</code>
+       * @return The rotationInterval.
        */
-      @java.lang.Override
-      public com.google.protobuf.ByteString getPeerId() {
-        return peerId_;
+      @java.lang.Override
+      public int getRotationInterval() {
+        return rotationInterval_;
       }
       /**
-       * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
-       * @param value The peerId to set.
+       * <pre>
+       * The number of blocks that each reward address stays active.
+       * </pre>
+       *
+       * <code>uint32 rotation_interval = 4 [json_name = "rotationInterval"];</code>
+       * @param value The rotationInterval to set.
        * @return This builder for chaining.
        */
-      public Builder setPeerId(com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        peerId_ = value;
+      public Builder setRotationInterval(int value) {
+        
+        rotationInterval_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>bytes peer_id = 3 [json_name = "peerId"];</code>
+       * <pre>
+       * The number of blocks that each reward address stays active.
+       * </pre>
+       *
+       * <code>uint32 rotation_interval = 4 [json_name = "rotationInterval"];</code>
        * @return This builder for chaining.
        */
-      public Builder clearPeerId() {
+      public Builder clearRotationInterval() {
         
-        peerId_ = getDefaultInstance().getPeerId();
+        rotationInterval_ = 0;
         onChanged();
         return this;
       }
@@ -3117,23 +11482,23 @@ public final class NetworkOuterClass {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:pactus.GetNodeInfoResponse)
+      // @@protoc_insertion_point(builder_scope:pactus.ValidatorReward)
     }
 
-    // @@protoc_insertion_point(class_scope:pactus.GetNodeInfoResponse)
-    private static final pactus.network.NetworkOuterClass.GetNodeInfoResponse DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:pactus.ValidatorReward)
+    private static final pactus.network.NetworkOuterClass.ValidatorReward DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.GetNodeInfoResponse();
+      DEFAULT_INSTANCE = new pactus.network.NetworkOuterClass.ValidatorReward();
     }
 
-    public static pactus.network.NetworkOuterClass.GetNodeInfoResponse getDefaultInstance() {
+    public static pactus.network.NetworkOuterClass.ValidatorReward getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<GetNodeInfoResponse>
-        PARSER = new com.google.protobuf.AbstractParser<GetNodeInfoResponse>() {
+    private static final com.google.protobuf.Parser<ValidatorReward>
+        PARSER = new com.google.protobuf.AbstractParser<ValidatorReward>() {
       @java.lang.Override
-      public GetNodeInfoResponse parsePartialFrom(
+      public ValidatorReward parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -3152,17 +11517,17 @@ public final class NetworkOuterClass {
       }
     };
 
-    public static com.google.protobuf.Parser<GetNodeInfoResponse> parser() {
+    public static com.google.protobuf.Parser<ValidatorReward> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<GetNodeInfoResponse> getParserForType() {
+    public com.google.protobuf.Parser<ValidatorReward> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public pactus.network.NetworkOuterClass.GetNodeInfoResponse getDefaultInstanceForType() {
+    public pactus.network.NetworkOuterClass.ValidatorReward getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
@@ -3354,6 +11719,30 @@ public final class NetworkOuterClass {
      */
     com.google.protobuf.ByteString
         getAddressBytes();
+
+    /**
+     * <code>int64 latency_ms = 16 [json_name = "latencyMs"];</code>
+     * @return The latencyMs.
+     */
+    long getLatencyMs();
+
+    /**
+     * <code>string direction = 17 [json_name = "direction"];</code>
+     * @return The direction.
+     */
+    java.lang.String getDirection();
+    /**
+     * <code>string direction = 17 [json_name = "direction"];</code>
+     * @return The bytes for direction.
+     */
+    com.google.protobuf.ByteString
+        getDirectionBytes();
+
+    /**
+     * <code>bool relayed = 18 [json_name = "relayed"];</code>
+     * @return The relayed.
+     */
+    boolean getRelayed();
   }
   /**
    * Protobuf type {@code pactus.PeerInfo}
@@ -3374,6 +11763,7 @@ public final class NetworkOuterClass {
       consensusKeys_ = com.google.protobuf.LazyStringArrayList.EMPTY;
       lastBlockHash_ = com.google.protobuf.ByteString.EMPTY;
       address_ = "";
+      direction_ = "";
     }
 
     @java.lang.Override
@@ -3825,6 +12215,66 @@ public final class NetworkOuterClass {
       }
     }
 
+    public static final int LATENCY_MS_FIELD_NUMBER = 16;
+    private long latencyMs_;
+    /**
+     * <code>int64 latency_ms = 16 [json_name = "latencyMs"];</code>
+     * @return The latencyMs.
+     */
+    @java.lang.Override
+    public long getLatencyMs() {
+      return latencyMs_;
+    }
+
+    public static final int DIRECTION_FIELD_NUMBER = 17;
+    private volatile java.lang.Object direction_;
+    /**
+     * <code>string direction = 17 [json_name = "direction"];</code>
+     * @return The direction.
+     */
+    @java.lang.Override
+    public java.lang.String getDirection() {
+      java.lang.Object ref = direction_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs = 
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        direction_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string direction = 17 [json_name = "direction"];</code>
+     * @return The bytes for direction.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getDirectionBytes() {
+      java.lang.Object ref = direction_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b = 
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        direction_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int RELAYED_FIELD_NUMBER = 18;
+    private boolean relayed_;
+    /**
+     * <code>bool relayed = 18 [json_name = "relayed"];</code>
+     * @return The relayed.
+     */
+    @java.lang.Override
+    public boolean getRelayed() {
+      return relayed_;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -3890,6 +12340,15 @@ public final class NetworkOuterClass {
       if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(address_)) {
         com.google.protobuf.GeneratedMessageV3.writeString(output, 15, address_);
       }
+      if (latencyMs_ != 0L) {
+        output.writeInt64(16, latencyMs_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(direction_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 17, direction_);
+      }
+      if (relayed_ != false) {
+        output.writeBool(18, relayed_);
+      }
       getUnknownFields().writeTo(output);
     }
 
@@ -3972,6 +12431,17 @@ public final class NetworkOuterClass {
       if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(address_)) {
         size += com.google.protobuf.GeneratedMessageV3.computeStringSize(15, address_);
       }
+      if (latencyMs_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(16, latencyMs_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(direction_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(17, direction_);
+      }
+      if (relayed_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(18, relayed_);
+      }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
       return size;
@@ -4017,6 +12487,12 @@ public final class NetworkOuterClass {
           other.internalGetReceivedBytes())) return false;
       if (!getAddress()
           .equals(other.getAddress())) return false;
+      if (getLatencyMs()
+          != other.getLatencyMs()) return false;
+      if (!getDirection()
+          .equals(other.getDirection())) return false;
+      if (getRelayed()
+          != other.getRelayed()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -4066,6 +12542,14 @@ public final class NetworkOuterClass {
       }
       hash = (37 * hash) + ADDRESS_FIELD_NUMBER;
       hash = (53 * hash) + getAddress().hashCode();
+      hash = (37 * hash) + LATENCY_MS_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getLatencyMs());
+      hash = (37 * hash) + DIRECTION_FIELD_NUMBER;
+      hash = (53 * hash) + getDirection().hashCode();
+      hash = (37 * hash) + RELAYED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getRelayed());
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -4248,6 +12732,12 @@ public final class NetworkOuterClass {
         internalGetMutableReceivedBytes().clear();
         address_ = "";
 
+        latencyMs_ = 0L;
+
+        direction_ = "";
+
+        relayed_ = false;
+
         return this;
       }
 
@@ -4296,6 +12786,9 @@ public final class NetworkOuterClass {
         result.receivedBytes_ = internalGetReceivedBytes();
         result.receivedBytes_.makeImmutable();
         result.address_ = address_;
+        result.latencyMs_ = latencyMs_;
+        result.direction_ = direction_;
+        result.relayed_ = relayed_;
         onBuilt();
         return result;
       }
@@ -4397,6 +12890,16 @@ public final class NetworkOuterClass {
           address_ = other.address_;
           onChanged();
         }
+        if (other.getLatencyMs() != 0L) {
+          setLatencyMs(other.getLatencyMs());
+        }
+        if (!other.getDirection().isEmpty()) {
+          direction_ = other.direction_;
+          onChanged();
+        }
+        if (other.getRelayed() != false) {
+          setRelayed(other.getRelayed());
+        }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -4505,6 +13008,21 @@ public final class NetworkOuterClass {
 
                 break;
               } // case 122
+              case 128: {
+                latencyMs_ = input.readInt64();
+
+                break;
+              } // case 128
+              case 138: {
+                direction_ = input.readStringRequireUtf8();
+
+                break;
+              } // case 138
+              case 144: {
+                relayed_ = input.readBool();
+
+                break;
+              } // case 144
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -5400,6 +13918,144 @@ public final class NetworkOuterClass {
         onChanged();
         return this;
       }
+
+      private long latencyMs_ ;
+      /**
+       * <code>int64 latency_ms = 16 [json_name = "latencyMs"];</code>
+       * @return The latencyMs.
+       */
+      @java.lang.Override
+      public long getLatencyMs() {
+        return latencyMs_;
+      }
+      /**
+       * <code>int64 latency_ms = 16 [json_name = "latencyMs"];</code>
+       * @param value The latencyMs to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLatencyMs(long value) {
+        
+        latencyMs_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 latency_ms = 16 [json_name = "latencyMs"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLatencyMs() {
+        
+        latencyMs_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object direction_ = "";
+      /**
+       * <code>string direction = 17 [json_name = "direction"];</code>
+       * @return The direction.
+       */
+      public java.lang.String getDirection() {
+        java.lang.Object ref = direction_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          direction_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string direction = 17 [json_name = "direction"];</code>
+       * @return The bytes for direction.
+       */
+      public com.google.protobuf.ByteString
+          getDirectionBytes() {
+        java.lang.Object ref = direction_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b = 
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          direction_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string direction = 17 [json_name = "direction"];</code>
+       * @param value The direction to set.
+       * @return This builder for chaining.
+       */
+      public Builder setDirection(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        direction_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string direction = 17 [json_name = "direction"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearDirection() {
+        
+        direction_ = getDefaultInstance().getDirection();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string direction = 17 [json_name = "direction"];</code>
+       * @param value The bytes for direction to set.
+       * @return This builder for chaining.
+       */
+      public Builder setDirectionBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        
+        direction_ = value;
+        onChanged();
+        return this;
+      }
+
+      private boolean relayed_ ;
+      /**
+       * <code>bool relayed = 18 [json_name = "relayed"];</code>
+       * @return The relayed.
+       */
+      @java.lang.Override
+      public boolean getRelayed() {
+        return relayed_;
+      }
+      /**
+       * <code>bool relayed = 18 [json_name = "relayed"];</code>
+       * @param value The relayed to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRelayed(boolean value) {
+        
+        relayed_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bool relayed = 18 [json_name = "relayed"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRelayed() {
+        
+        relayed_ = false;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -5484,6 +14140,21 @@ public final class NetworkOuterClass {
   private static final 
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_pactus_GetNetworkInfoResponse_ReceivedBytesEntry_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_SessionInfo_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_SessionInfo_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetSessionsRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetSessionsRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetSessionsResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetSessionsResponse_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_pactus_GetNodeInfoRequest_descriptor;
   private static final 
@@ -5494,6 +14165,61 @@ public final class NetworkOuterClass {
   private static final 
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_pactus_GetNodeInfoResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_BanPeerRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_BanPeerRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_BanPeerResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_BanPeerResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_UnbanPeerRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_UnbanPeerRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_UnbanPeerResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_UnbanPeerResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_PauseSyncRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_PauseSyncRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_PauseSyncResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_PauseSyncResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_ResumeSyncRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_ResumeSyncRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_ResumeSyncResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_ResumeSyncResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetRewardAddressesRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetRewardAddressesRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetRewardAddressesResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetRewardAddressesResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_ValidatorReward_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_ValidatorReward_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_pactus_PeerInfo_descriptor;
   private static final 
@@ -5519,7 +14245,7 @@ public final class NetworkOuterClass {
   static {
     java.lang.String[] descriptorData = {
       "\n\rnetwork.proto\022\006pactus\"\027\n\025GetNetworkInf" +
-      "oRequest\"\343\003\n\026GetNetworkInfoResponse\022(\n\020t" +
+      "oRequest\"\224\004\n\026GetNetworkInfoResponse\022(\n\020t" +
       "otal_sent_bytes\030\001 \001(\005R\016totalSentBytes\0220\n" +
       "\024total_received_bytes\030\002 \001(\005R\022totalReceiv" +
       "edBytes\022\035\n\nstarted_at\030\003 \001(\003R\tstartedAt\022&" +
@@ -5528,36 +14254,74 @@ public final class NetworkOuterClass {
       "foResponse.SentBytesEntryR\tsentBytes\022X\n\016" +
       "received_bytes\030\006 \003(\01321.pactus.GetNetwork" +
       "InfoResponse.ReceivedBytesEntryR\rreceive" +
-      "dBytes\032<\n\016SentBytesEntry\022\020\n\003key\030\001 \001(\005R\003k" +
-      "ey\022\024\n\005value\030\002 \001(\003R\005value:\0028\001\032@\n\022Received" +
-      "BytesEntry\022\020\n\003key\030\001 \001(\005R\003key\022\024\n\005value\030\002 " +
-      "\001(\003R\005value:\0028\001\"\024\n\022GetNodeInfoRequest\"^\n\023" +
-      "GetNodeInfoResponse\022\030\n\007moniker\030\001 \001(\tR\007mo" +
-      "niker\022\024\n\005agent\030\002 \001(\tR\005agent\022\027\n\007peer_id\030\003" +
-      " \001(\014R\006peerId\"\256\005\n\010PeerInfo\022\026\n\006status\030\001 \001(" +
-      "\005R\006status\022\030\n\007moniker\030\002 \001(\tR\007moniker\022\024\n\005a" +
-      "gent\030\003 \001(\tR\005agent\022\027\n\007peer_id\030\004 \001(\014R\006peer" +
-      "Id\022%\n\016consensus_keys\030\005 \003(\tR\rconsensusKey" +
-      "s\022\032\n\010services\030\006 \001(\rR\010services\022&\n\017last_bl" +
-      "ock_hash\030\007 \001(\014R\rlastBlockHash\022\026\n\006height\030" +
-      "\010 \001(\rR\006height\022+\n\021received_messages\030\t \001(\005" +
-      "R\020receivedMessages\022)\n\020invalid_messages\030\n" +
-      " \001(\005R\017invalidMessages\022\033\n\tlast_sent\030\013 \001(\003" +
-      "R\010lastSent\022#\n\rlast_received\030\014 \001(\003R\014lastR" +
-      "eceived\022>\n\nsent_bytes\030\r \003(\0132\037.pactus.Pee" +
-      "rInfo.SentBytesEntryR\tsentBytes\022J\n\016recei" +
-      "ved_bytes\030\016 \003(\0132#.pactus.PeerInfo.Receiv" +
-      "edBytesEntryR\rreceivedBytes\022\030\n\007address\030\017" +
-      " \001(\tR\007address\032<\n\016SentBytesEntry\022\020\n\003key\030\001" +
-      " \001(\005R\003key\022\024\n\005value\030\002 \001(\003R\005value:\0028\001\032@\n\022R" +
-      "eceivedBytesEntry\022\020\n\003key\030\001 \001(\005R\003key\022\024\n\005v" +
-      "alue\030\002 \001(\003R\005value:\0028\0012\242\001\n\007Network\022O\n\016Get" +
-      "NetworkInfo\022\035.pactus.GetNetworkInfoReque" +
-      "st\032\036.pactus.GetNetworkInfoResponse\022F\n\013Ge" +
-      "tNodeInfo\022\032.pactus.GetNodeInfoRequest\032\033." +
-      "pactus.GetNodeInfoResponseBB\n\016pactus.net" +
-      "workZ0github.com/pactus-project/pactus/w" +
-      "ww/grpc/pactusb\006proto3"
+      "dBytes\022/\n\010sessions\030\007 \003(\0132\023.pactus.Sessio" +
+      "nInfoR\010sessions\032<\n\016SentBytesEntry\022\020\n\003key" +
+      "\030\001 \001(\005R\003key\022\024\n\005value\030\002 \001(\003R\005value:\0028\001\032@\n" +
+      "\022ReceivedBytesEntry\022\020\n\003key\030\001 \001(\005R\003key\022\024\n" +
+      "\005value\030\002 \001(\003R\005value:\0028\001\"\240\002\n\013SessionInfo\022" +
+      "\035\n\nsession_id\030\001 \001(\005R\tsessionId\022\027\n\007peer_i" +
+      "d\030\002 \001(\014R\006peerId\022)\n\020requested_blocks\030\003 \001(" +
+      "\rR\017requestedBlocks\022\'\n\017received_blocks\030\004 " +
+      "\001(\rR\016receivedBlocks\022(\n\020last_activity_at\030" +
+      "\005 \001(\003R\016lastActivityAt\022\033\n\topened_at\030\006 \001(\003" +
+      "R\010openedAt\022\020\n\003age\030\007 \001(\003R\003age\022,\n\022last_res" +
+      "ponse_code\030\010 \001(\tR\020lastResponseCode\"\024\n\022Ge" +
+      "tSessionsRequest\"F\n\023GetSessionsResponse\022" +
+      "/\n\010sessions\030\001 \003(\0132\023.pactus.SessionInfoR\010" +
+      "sessions\"\024\n\022GetNodeInfoRequest\"\177\n\023GetNod" +
+      "eInfoResponse\022\030\n\007moniker\030\001 \001(\tR\007moniker\022" +
+      "\024\n\005agent\030\002 \001(\tR\005agent\022\027\n\007peer_id\030\003 \001(\014R\006" +
+      "peerId\022\037\n\013sync_paused\030\004 \001(\010R\nsyncPaused\"" +
+      "E\n\016BanPeerRequest\022\027\n\007peer_id\030\001 \001(\014R\006peer" +
+      "Id\022\032\n\010duration\030\002 \001(\003R\010duration\"\021\n\017BanPee" +
+      "rResponse\"+\n\020UnbanPeerRequest\022\027\n\007peer_id" +
+      "\030\001 \001(\014R\006peerId\"\023\n\021UnbanPeerResponse\"\022\n\020P" +
+      "auseSyncRequest\"\023\n\021PauseSyncResponse\"\023\n\021" +
+      "ResumeSyncRequest\"\024\n\022ResumeSyncResponse\"" +
+      "\033\n\031GetRewardAddressesRequest\"U\n\032GetRewar" +
+      "dAddressesResponse\0227\n\nvalidators\030\001 \003(\0132\027" +
+      ".pactus.ValidatorRewardR\nvalidators\"\301\001\n\017" +
+      "ValidatorReward\022+\n\021validator_address\030\001 \001" +
+      "(\tR\020validatorAddress\022)\n\020validator_number" +
+      "\030\002 \001(\005R\017validatorNumber\022)\n\020reward_addres" +
+      "ses\030\003 \003(\tR\017rewardAddresses\022+\n\021rotation_i" +
+      "nterval\030\004 \001(\rR\020rotationInterval\"\205\006\n\010Peer" +
+      "Info\022\026\n\006status\030\001 \001(\005R\006status\022\030\n\007moniker\030" +
+      "\002 \001(\tR\007moniker\022\024\n\005agent\030\003 \001(\tR\005agent\022\027\n\007" +
+      "peer_id\030\004 \001(\014R\006peerId\022%\n\016consensus_keys\030" +
+      "\005 \003(\tR\rconsensusKeys\022\032\n\010services\030\006 \001(\rR\010" +
+      "services\022&\n\017last_block_hash\030\007 \001(\014R\rlastB" +
+      "lockHash\022\026\n\006height\030\010 \001(\rR\006height\022+\n\021rece" +
+      "ived_messages\030\t \001(\005R\020receivedMessages\022)\n" +
+      "\020invalid_messages\030\n \001(\005R\017invalidMessages" +
+      "\022\033\n\tlast_sent\030\013 \001(\003R\010lastSent\022#\n\rlast_re" +
+      "ceived\030\014 \001(\003R\014lastReceived\022>\n\nsent_bytes" +
+      "\030\r \003(\0132\037.pactus.PeerInfo.SentBytesEntryR" +
+      "\tsentBytes\022J\n\016received_bytes\030\016 \003(\0132#.pac" +
+      "tus.PeerInfo.ReceivedBytesEntryR\rreceive" +
+      "dBytes\022\030\n\007address\030\017 \001(\tR\007address\022\035\n\nlate" +
+      "ncy_ms\030\020 \001(\003R\tlatencyMs\022\034\n\tdirection\030\021 \001" +
+      "(\tR\tdirection\022\030\n\007relayed\030\022 \001(\010R\007relayed\032" +
+      "<\n\016SentBytesEntry\022\020\n\003key\030\001 \001(\005R\003key\022\024\n\005v" +
+      "alue\030\002 \001(\003R\005value:\0028\001\032@\n\022ReceivedBytesEn" +
+      "try\022\020\n\003key\030\001 \001(\005R\003key\022\024\n\005value\030\002 \001(\003R\005va" +
+      "lue:\0028\0012\314\004\n\007Network\022O\n\016GetNetworkInfo\022\035." +
+      "pactus.GetNetworkInfoRequest\032\036.pactus.Ge" +
+      "tNetworkInfoResponse\022F\n\013GetNodeInfo\022\032.pa" +
+      "ctus.GetNodeInfoRequest\032\033.pactus.GetNode" +
+      "InfoResponse\022:\n\007BanPeer\022\026.pactus.BanPeer" +
+      "Request\032\027.pactus.BanPeerResponse\022@\n\tUnba" +
+      "nPeer\022\030.pactus.UnbanPeerRequest\032\031.pactus" +
+      ".UnbanPeerResponse\022[\n\022GetRewardAddresses" +
+      "\022!.pactus.GetRewardAddressesRequest\032\".pa" +
+      "ctus.GetRewardAddressesResponse\022@\n\tPause" +
+      "Sync\022\030.pactus.PauseSyncRequest\032\031.pactus." +
+      "PauseSyncResponse\022C\n\nResumeSync\022\031.pactus" +
+      ".ResumeSyncRequest\032\032.pactus.ResumeSyncRe" +
+      "sponse\022F\n\013GetSessions\022\032.pactus.GetSessio" +
+      "nsRequest\032\033.pactus.GetSessionsResponseBB" +
+      "\n\016pactus.networkZ0github.com/pactus-proj" +
+      "ect/pactus/www/grpc/pactusb\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -5574,7 +14338,7 @@ public final class NetworkOuterClass {
     internal_static_pactus_GetNetworkInfoResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_GetNetworkInfoResponse_descriptor,
-        new java.lang.String[] { "TotalSentBytes", "TotalReceivedBytes", "StartedAt", "Peers", "SentBytes", "ReceivedBytes", });
+        new java.lang.String[] { "TotalSentBytes", "TotalReceivedBytes", "StartedAt", "Peers", "SentBytes", "ReceivedBytes", "Sessions", });
     internal_static_pactus_GetNetworkInfoResponse_SentBytesEntry_descriptor =
       internal_static_pactus_GetNetworkInfoResponse_descriptor.getNestedTypes().get(0);
     internal_static_pactus_GetNetworkInfoResponse_SentBytesEntry_fieldAccessorTable = new
@@ -5587,24 +14351,108 @@ public final class NetworkOuterClass {
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_GetNetworkInfoResponse_ReceivedBytesEntry_descriptor,
         new java.lang.String[] { "Key", "Value", });
-    internal_static_pactus_GetNodeInfoRequest_descriptor =
+    internal_static_pactus_SessionInfo_descriptor =
       getDescriptor().getMessageTypes().get(2);
+    internal_static_pactus_SessionInfo_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_SessionInfo_descriptor,
+        new java.lang.String[] { "SessionId", "PeerId", "RequestedBlocks", "ReceivedBlocks", "LastActivityAt", "OpenedAt", "Age", "LastResponseCode", });
+    internal_static_pactus_GetSessionsRequest_descriptor =
+      getDescriptor().getMessageTypes().get(3);
+    internal_static_pactus_GetSessionsRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetSessionsRequest_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_GetSessionsResponse_descriptor =
+      getDescriptor().getMessageTypes().get(4);
+    internal_static_pactus_GetSessionsResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetSessionsResponse_descriptor,
+        new java.lang.String[] { "Sessions", });
+    internal_static_pactus_GetNodeInfoRequest_descriptor =
+      getDescriptor().getMessageTypes().get(5);
     internal_static_pactus_GetNodeInfoRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_GetNodeInfoRequest_descriptor,
         new java.lang.String[] { });
     internal_static_pactus_GetNodeInfoResponse_descriptor =
-      getDescriptor().getMessageTypes().get(3);
+      getDescriptor().getMessageTypes().get(6);
     internal_static_pactus_GetNodeInfoResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_GetNodeInfoResponse_descriptor,
-        new java.lang.String[] { "Moniker", "Agent", "PeerId", });
+        new java.lang.String[] { "Moniker", "Agent", "PeerId", "SyncPaused", });
+    internal_static_pactus_BanPeerRequest_descriptor =
+      getDescriptor().getMessageTypes().get(7);
+    internal_static_pactus_BanPeerRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_BanPeerRequest_descriptor,
+        new java.lang.String[] { "PeerId", "Duration", });
+    internal_static_pactus_BanPeerResponse_descriptor =
+      getDescriptor().getMessageTypes().get(8);
+    internal_static_pactus_BanPeerResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_BanPeerResponse_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_UnbanPeerRequest_descriptor =
+      getDescriptor().getMessageTypes().get(9);
+    internal_static_pactus_UnbanPeerRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_UnbanPeerRequest_descriptor,
+        new java.lang.String[] { "PeerId", });
+    internal_static_pactus_UnbanPeerResponse_descriptor =
+      getDescriptor().getMessageTypes().get(10);
+    internal_static_pactus_UnbanPeerResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_UnbanPeerResponse_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_PauseSyncRequest_descriptor =
+      getDescriptor().getMessageTypes().get(11);
+    internal_static_pactus_PauseSyncRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_PauseSyncRequest_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_PauseSyncResponse_descriptor =
+      getDescriptor().getMessageTypes().get(12);
+    internal_static_pactus_PauseSyncResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_PauseSyncResponse_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_ResumeSyncRequest_descriptor =
+      getDescriptor().getMessageTypes().get(13);
+    internal_static_pactus_ResumeSyncRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_ResumeSyncRequest_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_ResumeSyncResponse_descriptor =
+      getDescriptor().getMessageTypes().get(14);
+    internal_static_pactus_ResumeSyncResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_ResumeSyncResponse_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_GetRewardAddressesRequest_descriptor =
+      getDescriptor().getMessageTypes().get(15);
+    internal_static_pactus_GetRewardAddressesRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetRewardAddressesRequest_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_GetRewardAddressesResponse_descriptor =
+      getDescriptor().getMessageTypes().get(16);
+    internal_static_pactus_GetRewardAddressesResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetRewardAddressesResponse_descriptor,
+        new java.lang.String[] { "Validators", });
+    internal_static_pactus_ValidatorReward_descriptor =
+      getDescriptor().getMessageTypes().get(17);
+    internal_static_pactus_ValidatorReward_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_ValidatorReward_descriptor,
+        new java.lang.String[] { "ValidatorAddress", "ValidatorNumber", "RewardAddresses", "RotationInterval", });
     internal_static_pactus_PeerInfo_descriptor =
-      getDescriptor().getMessageTypes().get(4);
+      getDescriptor().getMessageTypes().get(18);
     internal_static_pactus_PeerInfo_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_PeerInfo_descriptor,
-        new java.lang.String[] { "Status", "Moniker", "Agent", "PeerId", "ConsensusKeys", "Services", "LastBlockHash", "Height", "ReceivedMessages", "InvalidMessages", "LastSent", "LastReceived", "SentBytes", "ReceivedBytes", "Address", });
+        new java.lang.String[] { "Status", "Moniker", "Agent", "PeerId", "ConsensusKeys", "Services", "LastBlockHash", "Height", "ReceivedMessages", "InvalidMessages", "LastSent", "LastReceived", "SentBytes", "ReceivedBytes", "Address", "LatencyMs", "Direction", "Relayed", });
     internal_static_pactus_PeerInfo_SentBytesEntry_descriptor =
       internal_static_pactus_PeerInfo_descriptor.getNestedTypes().get(0);
     internal_static_pactus_PeerInfo_SentBytesEntry_fieldAccessorTable = new
@@ -46,6 +46,68 @@ public final class TransactionGrpc {
     return getGetTransactionMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest,
+      pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse> getGetTransactionInclusionMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetTransactionInclusion",
+      requestType = pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.class,
+      responseType = pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest,
+      pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse> getGetTransactionInclusionMethod() {
+    io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest, pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse> getGetTransactionInclusionMethod;
+    if ((getGetTransactionInclusionMethod = TransactionGrpc.getGetTransactionInclusionMethod) == null) {
+      synchronized (TransactionGrpc.class) {
+        if ((getGetTransactionInclusionMethod = TransactionGrpc.getGetTransactionInclusionMethod) == null) {
+          TransactionGrpc.getGetTransactionInclusionMethod = getGetTransactionInclusionMethod =
+              io.grpc.MethodDescriptor.<pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest, pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetTransactionInclusion"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TransactionMethodDescriptorSupplier("GetTransactionInclusion"))
+              .build();
+        }
+      }
+    }
+    return getGetTransactionInclusionMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTransactionProofRequest,
+      pactus.transaction.TransactionOuterClass.GetTransactionProofResponse> getGetTransactionProofMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetTransactionProof",
+      requestType = pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.class,
+      responseType = pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTransactionProofRequest,
+      pactus.transaction.TransactionOuterClass.GetTransactionProofResponse> getGetTransactionProofMethod() {
+    io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTransactionProofRequest, pactus.transaction.TransactionOuterClass.GetTransactionProofResponse> getGetTransactionProofMethod;
+    if ((getGetTransactionProofMethod = TransactionGrpc.getGetTransactionProofMethod) == null) {
+      synchronized (TransactionGrpc.class) {
+        if ((getGetTransactionProofMethod = TransactionGrpc.getGetTransactionProofMethod) == null) {
+          TransactionGrpc.getGetTransactionProofMethod = getGetTransactionProofMethod =
+              io.grpc.MethodDescriptor.<pactus.transaction.TransactionOuterClass.GetTransactionProofRequest, pactus.transaction.TransactionOuterClass.GetTransactionProofResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetTransactionProof"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TransactionMethodDescriptorSupplier("GetTransactionProof"))
+              .build();
+        }
+      }
+    }
+    return getGetTransactionProofMethod;
+  }
+
   private static volatile io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.CalculateFeeRequest,
       pactus.transaction.TransactionOuterClass.CalculateFeeResponse> getCalculateFeeMethod;
 
@@ -77,6 +139,37 @@ public final class TransactionGrpc {
     return getCalculateFeeMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.EstimateFeeRequest,
+      pactus.transaction.TransactionOuterClass.EstimateFeeResponse> getEstimateFeeMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "EstimateFee",
+      requestType = pactus.transaction.TransactionOuterClass.EstimateFeeRequest.class,
+      responseType = pactus.transaction.TransactionOuterClass.EstimateFeeResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.EstimateFeeRequest,
+      pactus.transaction.TransactionOuterClass.EstimateFeeResponse> getEstimateFeeMethod() {
+    io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.EstimateFeeRequest, pactus.transaction.TransactionOuterClass.EstimateFeeResponse> getEstimateFeeMethod;
+    if ((getEstimateFeeMethod = TransactionGrpc.getEstimateFeeMethod) == null) {
+      synchronized (TransactionGrpc.class) {
+        if ((getEstimateFeeMethod = TransactionGrpc.getEstimateFeeMethod) == null) {
+          TransactionGrpc.getEstimateFeeMethod = getEstimateFeeMethod =
+              io.grpc.MethodDescriptor.<pactus.transaction.TransactionOuterClass.EstimateFeeRequest, pactus.transaction.TransactionOuterClass.EstimateFeeResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "EstimateFee"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.EstimateFeeRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.EstimateFeeResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TransactionMethodDescriptorSupplier("EstimateFee"))
+              .build();
+        }
+      }
+    }
+    return getEstimateFeeMethod;
+  }
+
   private static volatile io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.SendRawTransactionRequest,
       pactus.transaction.TransactionOuterClass.SendRawTransactionResponse> getSendRawTransactionMethod;
 
@@ -108,6 +201,37 @@ public final class TransactionGrpc {
     return getSendRawTransactionMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest,
+      pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse> getGetTxPoolStatsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetTxPoolStats",
+      requestType = pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.class,
+      responseType = pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest,
+      pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse> getGetTxPoolStatsMethod() {
+    io.grpc.MethodDescriptor<pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest, pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse> getGetTxPoolStatsMethod;
+    if ((getGetTxPoolStatsMethod = TransactionGrpc.getGetTxPoolStatsMethod) == null) {
+      synchronized (TransactionGrpc.class) {
+        if ((getGetTxPoolStatsMethod = TransactionGrpc.getGetTxPoolStatsMethod) == null) {
+          TransactionGrpc.getGetTxPoolStatsMethod = getGetTxPoolStatsMethod =
+              io.grpc.MethodDescriptor.<pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest, pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetTxPoolStats"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TransactionMethodDescriptorSupplier("GetTxPoolStats"))
+              .build();
+        }
+      }
+    }
+    return getGetTxPoolStatsMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -163,6 +287,20 @@ public final class TransactionGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetTransactionMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void getTransactionInclusion(pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetTransactionInclusionMethod(), responseObserver);
+    }
+
+    /**
+     */
+    public void getTransactionProof(pactus.transaction.TransactionOuterClass.GetTransactionProofRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTransactionProofResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetTransactionProofMethod(), responseObserver);
+    }
+
     /**
      */
     public void calculateFee(pactus.transaction.TransactionOuterClass.CalculateFeeRequest request,
@@ -170,6 +308,13 @@ public final class TransactionGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getCalculateFeeMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void estimateFee(pactus.transaction.TransactionOuterClass.EstimateFeeRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.EstimateFeeResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getEstimateFeeMethod(), responseObserver);
+    }
+
     /**
      */
     public void sendRawTransaction(pactus.transaction.TransactionOuterClass.SendRawTransactionRequest request,
@@ -177,6 +322,13 @@ public final class TransactionGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getSendRawTransactionMethod(), responseObserver);
     }
 
+    /**
+     */
+    public void getTxPoolStats(pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetTxPoolStatsMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -186,6 +338,20 @@ public final class TransactionGrpc {
                 pactus.transaction.TransactionOuterClass.GetTransactionRequest,
                 pactus.transaction.TransactionOuterClass.GetTransactionResponse>(
                   this, METHODID_GET_TRANSACTION)))
+          .addMethod(
+            getGetTransactionInclusionMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest,
+                pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse>(
+                  this, METHODID_GET_TRANSACTION_INCLUSION)))
+          .addMethod(
+            getGetTransactionProofMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.transaction.TransactionOuterClass.GetTransactionProofRequest,
+                pactus.transaction.TransactionOuterClass.GetTransactionProofResponse>(
+                  this, METHODID_GET_TRANSACTION_PROOF)))
           .addMethod(
             getCalculateFeeMethod(),
             io.grpc.stub.ServerCalls.asyncUnaryCall(
@@ -193,6 +359,13 @@ public final class TransactionGrpc {
                 pactus.transaction.TransactionOuterClass.CalculateFeeRequest,
                 pactus.transaction.TransactionOuterClass.CalculateFeeResponse>(
                   this, METHODID_CALCULATE_FEE)))
+          .addMethod(
+            getEstimateFeeMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.transaction.TransactionOuterClass.EstimateFeeRequest,
+                pactus.transaction.TransactionOuterClass.EstimateFeeResponse>(
+                  this, METHODID_ESTIMATE_FEE)))
           .addMethod(
             getSendRawTransactionMethod(),
             io.grpc.stub.ServerCalls.asyncUnaryCall(
@@ -200,6 +373,13 @@ public final class TransactionGrpc {
                 pactus.transaction.TransactionOuterClass.SendRawTransactionRequest,
                 pactus.transaction.TransactionOuterClass.SendRawTransactionResponse>(
                   this, METHODID_SEND_RAW_TRANSACTION)))
+          .addMethod(
+            getGetTxPoolStatsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest,
+                pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse>(
+                  this, METHODID_GET_TX_POOL_STATS)))
           .build();
     }
   }
@@ -226,6 +406,22 @@ public final class TransactionGrpc {
           getChannel().newCall(getGetTransactionMethod(), getCallOptions()), request, responseObserver);
     }
 
+    /**
+     */
+    public void getTransactionInclusion(pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetTransactionInclusionMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     */
+    public void getTransactionProof(pactus.transaction.TransactionOuterClass.GetTransactionProofRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTransactionProofResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetTransactionProofMethod(), getCallOptions()), request, responseObserver);
+    }
+
     /**
      */
     public void calculateFee(pactus.transaction.TransactionOuterClass.CalculateFeeRequest request,
@@ -234,6 +430,14 @@ public final class TransactionGrpc {
           getChannel().newCall(getCalculateFeeMethod(), getCallOptions()), request, responseObserver);
     }
 
+    /**
+     */
+    public void estimateFee(pactus.transaction.TransactionOuterClass.EstimateFeeRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.EstimateFeeResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getEstimateFeeMethod(), getCallOptions()), request, responseObserver);
+    }
+
     /**
      */
     public void sendRawTransaction(pactus.transaction.TransactionOuterClass.SendRawTransactionRequest request,
@@ -241,6 +445,14 @@ public final class TransactionGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getSendRawTransactionMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     */
+    public void getTxPoolStats(pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest request,
+        io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetTxPoolStatsMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -264,6 +476,20 @@ public final class TransactionGrpc {
           getChannel(), getGetTransactionMethod(), getCallOptions(), request);
     }
 
+    /**
+     */
+    public pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse getTransactionInclusion(pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetTransactionInclusionMethod(), getCallOptions(), request);
+    }
+
+    /**
+     */
+    public pactus.transaction.TransactionOuterClass.GetTransactionProofResponse getTransactionProof(pactus.transaction.TransactionOuterClass.GetTransactionProofRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetTransactionProofMethod(), getCallOptions(), request);
+    }
+
     /**
      */
     public pactus.transaction.TransactionOuterClass.CalculateFeeResponse calculateFee(pactus.transaction.TransactionOuterClass.CalculateFeeRequest request) {
@@ -271,12 +497,26 @@ public final class TransactionGrpc {
           getChannel(), getCalculateFeeMethod(), getCallOptions(), request);
     }
 
+    /**
+     */
+    public pactus.transaction.TransactionOuterClass.EstimateFeeResponse estimateFee(pactus.transaction.TransactionOuterClass.EstimateFeeRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getEstimateFeeMethod(), getCallOptions(), request);
+    }
+
     /**
      */
     public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse sendRawTransaction(pactus.transaction.TransactionOuterClass.SendRawTransactionRequest request) {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getSendRawTransactionMethod(), getCallOptions(), request);
     }
+
+    /**
+     */
+    public pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse getTxPoolStats(pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetTxPoolStatsMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -301,6 +541,22 @@ public final class TransactionGrpc {
           getChannel().newCall(getGetTransactionMethod(), getCallOptions()), request);
     }
 
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse> getTransactionInclusion(
+        pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetTransactionInclusionMethod(), getCallOptions()), request);
+    }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.transaction.TransactionOuterClass.GetTransactionProofResponse> getTransactionProof(
+        pactus.transaction.TransactionOuterClass.GetTransactionProofRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetTransactionProofMethod(), getCallOptions()), request);
+    }
+
     /**
      */
     public com.google.common.util.concurrent.ListenableFuture<pactus.transaction.TransactionOuterClass.CalculateFeeResponse> calculateFee(
@@ -309,6 +565,14 @@ public final class TransactionGrpc {
           getChannel().newCall(getCalculateFeeMethod(), getCallOptions()), request);
     }
 
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.transaction.TransactionOuterClass.EstimateFeeResponse> estimateFee(
+        pactus.transaction.TransactionOuterClass.EstimateFeeRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getEstimateFeeMethod(), getCallOptions()), request);
+    }
+
     /**
      */
     public com.google.common.util.concurrent.ListenableFuture<pactus.transaction.TransactionOuterClass.SendRawTransactionResponse> sendRawTransaction(
@@ -316,11 +580,23 @@ public final class TransactionGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getSendRawTransactionMethod(), getCallOptions()), request);
     }
+
+    /**
+     */
+    public com.google.common.util.concurrent.ListenableFuture<pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse> getTxPoolStats(
+        pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetTxPoolStatsMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_GET_TRANSACTION = 0;
-  private static final int METHODID_CALCULATE_FEE = 1;
-  private static final int METHODID_SEND_RAW_TRANSACTION = 2;
+  private static final int METHODID_GET_TRANSACTION_INCLUSION = 1;
+  private static final int METHODID_GET_TRANSACTION_PROOF = 2;
+  private static final int METHODID_CALCULATE_FEE = 3;
+  private static final int METHODID_ESTIMATE_FEE = 4;
+  private static final int METHODID_SEND_RAW_TRANSACTION = 5;
+  private static final int METHODID_GET_TX_POOL_STATS = 6;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -343,14 +619,30 @@ public final class TransactionGrpc {
           serviceImpl.getTransaction((pactus.transaction.TransactionOuterClass.GetTransactionRequest) request,
               (io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTransactionResponse>) responseObserver);
           break;
+        case METHODID_GET_TRANSACTION_INCLUSION:
+          serviceImpl.getTransactionInclusion((pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse>) responseObserver);
+          break;
+        case METHODID_GET_TRANSACTION_PROOF:
+          serviceImpl.getTransactionProof((pactus.transaction.TransactionOuterClass.GetTransactionProofRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTransactionProofResponse>) responseObserver);
+          break;
         case METHODID_CALCULATE_FEE:
           serviceImpl.calculateFee((pactus.transaction.TransactionOuterClass.CalculateFeeRequest) request,
               (io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.CalculateFeeResponse>) responseObserver);
           break;
+        case METHODID_ESTIMATE_FEE:
+          serviceImpl.estimateFee((pactus.transaction.TransactionOuterClass.EstimateFeeRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.EstimateFeeResponse>) responseObserver);
+          break;
         case METHODID_SEND_RAW_TRANSACTION:
           serviceImpl.sendRawTransaction((pactus.transaction.TransactionOuterClass.SendRawTransactionRequest) request,
               (io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.SendRawTransactionResponse>) responseObserver);
           break;
+        case METHODID_GET_TX_POOL_STATS:
+          serviceImpl.getTxPoolStats((pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest) request,
+              (io.grpc.stub.StreamObserver<pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -413,8 +705,12 @@ public final class TransactionGrpc {
           serviceDescriptor = result = io.grpc.ServiceDescriptor.newBuilder(SERVICE_NAME)
               .setSchemaDescriptor(new TransactionFileDescriptorSupplier())
               .addMethod(getGetTransactionMethod())
+              .addMethod(getGetTransactionInclusionMethod())
+              .addMethod(getGetTransactionProofMethod())
               .addMethod(getCalculateFeeMethod())
+              .addMethod(getEstimateFeeMethod())
               .addMethod(getSendRawTransactionMethod())
+              .addMethod(getGetTxPoolStatsMethod())
               .build();
         }
       }
@@ -1583,48 +1583,37 @@ public final class TransactionOuterClass {
 
   }
 
-  public interface CalculateFeeRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:pactus.CalculateFeeRequest)
+  public interface GetTransactionInclusionRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetTransactionInclusionRequest)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>int64 amount = 1 [json_name = "amount"];</code>
-     * @return The amount.
-     */
-    long getAmount();
-
-    /**
-     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-     * @return The enum numeric value on the wire for payloadType.
-     */
-    int getPayloadTypeValue();
-    /**
-     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-     * @return The payloadType.
+     * <code>bytes id = 1 [json_name = "id"];</code>
+     * @return The id.
      */
-    pactus.transaction.TransactionOuterClass.PayloadType getPayloadType();
+    com.google.protobuf.ByteString getId();
   }
   /**
-   * Protobuf type {@code pactus.CalculateFeeRequest}
+   * Protobuf type {@code pactus.GetTransactionInclusionRequest}
    */
-  public static final class CalculateFeeRequest extends
+  public static final class GetTransactionInclusionRequest extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:pactus.CalculateFeeRequest)
-      CalculateFeeRequestOrBuilder {
+      // @@protoc_insertion_point(message_implements:pactus.GetTransactionInclusionRequest)
+      GetTransactionInclusionRequestOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use CalculateFeeRequest.newBuilder() to construct.
-    private CalculateFeeRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use GetTransactionInclusionRequest.newBuilder() to construct.
+    private GetTransactionInclusionRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private CalculateFeeRequest() {
-      payloadType_ = 0;
+    private GetTransactionInclusionRequest() {
+      id_ = com.google.protobuf.ByteString.EMPTY;
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new CalculateFeeRequest();
+      return new GetTransactionInclusionRequest();
     }
 
     @java.lang.Override
@@ -1634,45 +1623,26 @@ public final class TransactionOuterClass {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_descriptor;
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionRequest_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_fieldAccessorTable
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionRequest_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              pactus.transaction.TransactionOuterClass.CalculateFeeRequest.class, pactus.transaction.TransactionOuterClass.CalculateFeeRequest.Builder.class);
+              pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.class, pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.Builder.class);
     }
 
-    public static final int AMOUNT_FIELD_NUMBER = 1;
-    private long amount_;
+    public static final int ID_FIELD_NUMBER = 1;
+    private com.google.protobuf.ByteString id_;
     /**
-     * <code>int64 amount = 1 [json_name = "amount"];</code>
-     * @return The amount.
+     * <code>bytes id = 1 [json_name = "id"];</code>
+     * @return The id.
      */
     @java.lang.Override
-    public long getAmount() {
-      return amount_;
-    }
-
-    public static final int PAYLOADTYPE_FIELD_NUMBER = 2;
-    private int payloadType_;
-    /**
-     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-     * @return The enum numeric value on the wire for payloadType.
-     */
-    @java.lang.Override public int getPayloadTypeValue() {
-      return payloadType_;
-    }
-    /**
-     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-     * @return The payloadType.
-     */
-    @java.lang.Override public pactus.transaction.TransactionOuterClass.PayloadType getPayloadType() {
-      @SuppressWarnings("deprecation")
-      pactus.transaction.TransactionOuterClass.PayloadType result = pactus.transaction.TransactionOuterClass.PayloadType.valueOf(payloadType_);
-      return result == null ? pactus.transaction.TransactionOuterClass.PayloadType.UNRECOGNIZED : result;
+    public com.google.protobuf.ByteString getId() {
+      return id_;
     }
 
     private byte memoizedIsInitialized = -1;
@@ -1689,11 +1659,8 @@ public final class TransactionOuterClass {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (amount_ != 0L) {
-        output.writeInt64(1, amount_);
-      }
-      if (payloadType_ != pactus.transaction.TransactionOuterClass.PayloadType.UNKNOWN.getNumber()) {
-        output.writeEnum(2, payloadType_);
+      if (!id_.isEmpty()) {
+        output.writeBytes(1, id_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -1704,13 +1671,9 @@ public final class TransactionOuterClass {
       if (size != -1) return size;
 
       size = 0;
-      if (amount_ != 0L) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeInt64Size(1, amount_);
-      }
-      if (payloadType_ != pactus.transaction.TransactionOuterClass.PayloadType.UNKNOWN.getNumber()) {
+      if (!id_.isEmpty()) {
         size += com.google.protobuf.CodedOutputStream
-          .computeEnumSize(2, payloadType_);
+          .computeBytesSize(1, id_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -1722,14 +1685,13 @@ public final class TransactionOuterClass {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof pactus.transaction.TransactionOuterClass.CalculateFeeRequest)) {
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest)) {
         return super.equals(obj);
       }
-      pactus.transaction.TransactionOuterClass.CalculateFeeRequest other = (pactus.transaction.TransactionOuterClass.CalculateFeeRequest) obj;
+      pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest other = (pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest) obj;
 
-      if (getAmount()
-          != other.getAmount()) return false;
-      if (payloadType_ != other.payloadType_) return false;
+      if (!getId()
+          .equals(other.getId())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -1741,79 +1703,76 @@ public final class TransactionOuterClass {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + AMOUNT_FIELD_NUMBER;
-      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-          getAmount());
-      hash = (37 * hash) + PAYLOADTYPE_FIELD_NUMBER;
-      hash = (53 * hash) + payloadType_;
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(byte[] data)
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseDelimitedFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseDelimitedFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -1826,7 +1785,7 @@ public final class TransactionOuterClass {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.CalculateFeeRequest prototype) {
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -1842,26 +1801,26 @@ public final class TransactionOuterClass {
       return builder;
     }
     /**
-     * Protobuf type {@code pactus.CalculateFeeRequest}
+     * Protobuf type {@code pactus.GetTransactionInclusionRequest}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:pactus.CalculateFeeRequest)
-        pactus.transaction.TransactionOuterClass.CalculateFeeRequestOrBuilder {
+        // @@protoc_insertion_point(builder_implements:pactus.GetTransactionInclusionRequest)
+        pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequestOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionRequest_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_fieldAccessorTable
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionRequest_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                pactus.transaction.TransactionOuterClass.CalculateFeeRequest.class, pactus.transaction.TransactionOuterClass.CalculateFeeRequest.Builder.class);
+                pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.class, pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.Builder.class);
       }
 
-      // Construct using pactus.transaction.TransactionOuterClass.CalculateFeeRequest.newBuilder()
+      // Construct using pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.newBuilder()
       private Builder() {
 
       }
@@ -1874,9 +1833,7 @@ public final class TransactionOuterClass {
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        amount_ = 0L;
-
-        payloadType_ = 0;
+        id_ = com.google.protobuf.ByteString.EMPTY;
 
         return this;
       }
@@ -1884,17 +1841,17 @@ public final class TransactionOuterClass {
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionRequest_descriptor;
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.CalculateFeeRequest getDefaultInstanceForType() {
-        return pactus.transaction.TransactionOuterClass.CalculateFeeRequest.getDefaultInstance();
+      public pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.getDefaultInstance();
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.CalculateFeeRequest build() {
-        pactus.transaction.TransactionOuterClass.CalculateFeeRequest result = buildPartial();
+      public pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest build() {
+        pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -1902,10 +1859,9 @@ public final class TransactionOuterClass {
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.CalculateFeeRequest buildPartial() {
-        pactus.transaction.TransactionOuterClass.CalculateFeeRequest result = new pactus.transaction.TransactionOuterClass.CalculateFeeRequest(this);
-        result.amount_ = amount_;
-        result.payloadType_ = payloadType_;
+      public pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest buildPartial() {
+        pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest result = new pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest(this);
+        result.id_ = id_;
         onBuilt();
         return result;
       }
@@ -1944,21 +1900,18 @@ public final class TransactionOuterClass {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pactus.transaction.TransactionOuterClass.CalculateFeeRequest) {
-          return mergeFrom((pactus.transaction.TransactionOuterClass.CalculateFeeRequest)other);
+        if (other instanceof pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.CalculateFeeRequest other) {
-        if (other == pactus.transaction.TransactionOuterClass.CalculateFeeRequest.getDefaultInstance()) return this;
-        if (other.getAmount() != 0L) {
-          setAmount(other.getAmount());
-        }
-        if (other.payloadType_ != 0) {
-          setPayloadTypeValue(other.getPayloadTypeValue());
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest other) {
+        if (other == pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest.getDefaultInstance()) return this;
+        if (other.getId() != com.google.protobuf.ByteString.EMPTY) {
+          setId(other.getId());
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -1986,16 +1939,11 @@ public final class TransactionOuterClass {
               case 0:
                 done = true;
                 break;
-              case 8: {
-                amount_ = input.readInt64();
-
-                break;
-              } // case 8
-              case 16: {
-                payloadType_ = input.readEnum();
+              case 10: {
+                id_ = input.readBytes();
 
                 break;
-              } // case 16
+              } // case 10
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -2012,87 +1960,5243 @@ public final class TransactionOuterClass {
         return this;
       }
 
-      private long amount_ ;
+      private com.google.protobuf.ByteString id_ = com.google.protobuf.ByteString.EMPTY;
       /**
-       * <code>int64 amount = 1 [json_name = "amount"];</code>
-       * @return The amount.
+       * <code>bytes id = 1 [json_name = "id"];</code>
+       * @return The id.
        */
       @java.lang.Override
-      public long getAmount() {
-        return amount_;
+      public com.google.protobuf.ByteString getId() {
+        return id_;
       }
       /**
-       * <code>int64 amount = 1 [json_name = "amount"];</code>
-       * @param value The amount to set.
+       * <code>bytes id = 1 [json_name = "id"];</code>
+       * @param value The id to set.
        * @return This builder for chaining.
        */
-      public Builder setAmount(long value) {
-        
-        amount_ = value;
+      public Builder setId(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        id_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>int64 amount = 1 [json_name = "amount"];</code>
+       * <code>bytes id = 1 [json_name = "id"];</code>
        * @return This builder for chaining.
        */
-      public Builder clearAmount() {
+      public Builder clearId() {
         
-        amount_ = 0L;
+        id_ = getDefaultInstance().getId();
         onChanged();
         return this;
       }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
 
-      private int payloadType_ = 0;
-      /**
-       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-       * @return The enum numeric value on the wire for payloadType.
-       */
-      @java.lang.Override public int getPayloadTypeValue() {
-        return payloadType_;
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
       }
-      /**
-       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-       * @param value The enum numeric value on the wire for payloadType to set.
-       * @return This builder for chaining.
-       */
-      public Builder setPayloadTypeValue(int value) {
-        
-        payloadType_ = value;
-        onChanged();
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetTransactionInclusionRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetTransactionInclusionRequest)
+    private static final pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetTransactionInclusionRequest>
+        PARSER = new com.google.protobuf.AbstractParser<GetTransactionInclusionRequest>() {
+      @java.lang.Override
+      public GetTransactionInclusionRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetTransactionInclusionRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetTransactionInclusionRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.GetTransactionInclusionRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetTransactionInclusionResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetTransactionInclusionResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+     * @return The blockHeight.
+     */
+    int getBlockHeight();
+
+    /**
+     * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+     * @return The blockHash.
+     */
+    com.google.protobuf.ByteString getBlockHash();
+
+    /**
+     * <code>uint32 block_time = 3 [json_name = "blockTime"];</code>
+     * @return The blockTime.
+     */
+    int getBlockTime();
+
+    /**
+     * <code>int32 index = 4 [json_name = "index"];</code>
+     * @return The index.
+     */
+    int getIndex();
+  }
+  /**
+   * Protobuf type {@code pactus.GetTransactionInclusionResponse}
+   */
+  public static final class GetTransactionInclusionResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetTransactionInclusionResponse)
+      GetTransactionInclusionResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetTransactionInclusionResponse.newBuilder() to construct.
+    private GetTransactionInclusionResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetTransactionInclusionResponse() {
+      blockHash_ = com.google.protobuf.ByteString.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetTransactionInclusionResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.class, pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.Builder.class);
+    }
+
+    public static final int BLOCK_HEIGHT_FIELD_NUMBER = 1;
+    private int blockHeight_;
+    /**
+     * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+     * @return The blockHeight.
+     */
+    @java.lang.Override
+    public int getBlockHeight() {
+      return blockHeight_;
+    }
+
+    public static final int BLOCK_HASH_FIELD_NUMBER = 2;
+    private com.google.protobuf.ByteString blockHash_;
+    /**
+     * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+     * @return The blockHash.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getBlockHash() {
+      return blockHash_;
+    }
+
+    public static final int BLOCK_TIME_FIELD_NUMBER = 3;
+    private int blockTime_;
+    /**
+     * <code>uint32 block_time = 3 [json_name = "blockTime"];</code>
+     * @return The blockTime.
+     */
+    @java.lang.Override
+    public int getBlockTime() {
+      return blockTime_;
+    }
+
+    public static final int INDEX_FIELD_NUMBER = 4;
+    private int index_;
+    /**
+     * <code>int32 index = 4 [json_name = "index"];</code>
+     * @return The index.
+     */
+    @java.lang.Override
+    public int getIndex() {
+      return index_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (blockHeight_ != 0) {
+        output.writeUInt32(1, blockHeight_);
+      }
+      if (!blockHash_.isEmpty()) {
+        output.writeBytes(2, blockHash_);
+      }
+      if (blockTime_ != 0) {
+        output.writeUInt32(3, blockTime_);
+      }
+      if (index_ != 0) {
+        output.writeInt32(4, index_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (blockHeight_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(1, blockHeight_);
+      }
+      if (!blockHash_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(2, blockHash_);
+      }
+      if (blockTime_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(3, blockTime_);
+      }
+      if (index_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(4, index_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse other = (pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse) obj;
+
+      if (getBlockHeight()
+          != other.getBlockHeight()) return false;
+      if (!getBlockHash()
+          .equals(other.getBlockHash())) return false;
+      if (getBlockTime()
+          != other.getBlockTime()) return false;
+      if (getIndex()
+          != other.getIndex()) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + BLOCK_HEIGHT_FIELD_NUMBER;
+      hash = (53 * hash) + getBlockHeight();
+      hash = (37 * hash) + BLOCK_HASH_FIELD_NUMBER;
+      hash = (53 * hash) + getBlockHash().hashCode();
+      hash = (37 * hash) + BLOCK_TIME_FIELD_NUMBER;
+      hash = (53 * hash) + getBlockTime();
+      hash = (37 * hash) + INDEX_FIELD_NUMBER;
+      hash = (53 * hash) + getIndex();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.GetTransactionInclusionResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetTransactionInclusionResponse)
+        pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.class, pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        blockHeight_ = 0;
+
+        blockHash_ = com.google.protobuf.ByteString.EMPTY;
+
+        blockTime_ = 0;
+
+        index_ = 0;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionInclusionResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse build() {
+        pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse buildPartial() {
+        pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse result = new pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse(this);
+        result.blockHeight_ = blockHeight_;
+        result.blockHash_ = blockHash_;
+        result.blockTime_ = blockTime_;
+        result.index_ = index_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse other) {
+        if (other == pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse.getDefaultInstance()) return this;
+        if (other.getBlockHeight() != 0) {
+          setBlockHeight(other.getBlockHeight());
+        }
+        if (other.getBlockHash() != com.google.protobuf.ByteString.EMPTY) {
+          setBlockHash(other.getBlockHash());
+        }
+        if (other.getBlockTime() != 0) {
+          setBlockTime(other.getBlockTime());
+        }
+        if (other.getIndex() != 0) {
+          setIndex(other.getIndex());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                blockHeight_ = input.readUInt32();
+
+                break;
+              } // case 8
+              case 18: {
+                blockHash_ = input.readBytes();
+
+                break;
+              } // case 18
+              case 24: {
+                blockTime_ = input.readUInt32();
+
+                break;
+              } // case 24
+              case 32: {
+                index_ = input.readInt32();
+
+                break;
+              } // case 32
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private int blockHeight_ ;
+      /**
+       * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+       * @return The blockHeight.
+       */
+      @java.lang.Override
+      public int getBlockHeight() {
+        return blockHeight_;
+      }
+      /**
+       * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+       * @param value The blockHeight to set.
+       * @return This builder for chaining.
+       */
+      public Builder setBlockHeight(int value) {
+        
+        blockHeight_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearBlockHeight() {
+        
+        blockHeight_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.ByteString blockHash_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+       * @return The blockHash.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getBlockHash() {
+        return blockHash_;
+      }
+      /**
+       * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+       * @param value The blockHash to set.
+       * @return This builder for chaining.
+       */
+      public Builder setBlockHash(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        blockHash_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearBlockHash() {
+        
+        blockHash_ = getDefaultInstance().getBlockHash();
+        onChanged();
+        return this;
+      }
+
+      private int blockTime_ ;
+      /**
+       * <code>uint32 block_time = 3 [json_name = "blockTime"];</code>
+       * @return The blockTime.
+       */
+      @java.lang.Override
+      public int getBlockTime() {
+        return blockTime_;
+      }
+      /**
+       * <code>uint32 block_time = 3 [json_name = "blockTime"];</code>
+       * @param value The blockTime to set.
+       * @return This builder for chaining.
+       */
+      public Builder setBlockTime(int value) {
+        
+        blockTime_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 block_time = 3 [json_name = "blockTime"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearBlockTime() {
+        
+        blockTime_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int index_ ;
+      /**
+       * <code>int32 index = 4 [json_name = "index"];</code>
+       * @return The index.
+       */
+      @java.lang.Override
+      public int getIndex() {
+        return index_;
+      }
+      /**
+       * <code>int32 index = 4 [json_name = "index"];</code>
+       * @param value The index to set.
+       * @return This builder for chaining.
+       */
+      public Builder setIndex(int value) {
+        
+        index_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 index = 4 [json_name = "index"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearIndex() {
+        
+        index_ = 0;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetTransactionInclusionResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetTransactionInclusionResponse)
+    private static final pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetTransactionInclusionResponse>
+        PARSER = new com.google.protobuf.AbstractParser<GetTransactionInclusionResponse>() {
+      @java.lang.Override
+      public GetTransactionInclusionResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetTransactionInclusionResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetTransactionInclusionResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.GetTransactionInclusionResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetTransactionProofRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetTransactionProofRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>bytes id = 1 [json_name = "id"];</code>
+     * @return The id.
+     */
+    com.google.protobuf.ByteString getId();
+  }
+  /**
+   * Protobuf type {@code pactus.GetTransactionProofRequest}
+   */
+  public static final class GetTransactionProofRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetTransactionProofRequest)
+      GetTransactionProofRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetTransactionProofRequest.newBuilder() to construct.
+    private GetTransactionProofRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetTransactionProofRequest() {
+      id_ = com.google.protobuf.ByteString.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetTransactionProofRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.class, pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.Builder.class);
+    }
+
+    public static final int ID_FIELD_NUMBER = 1;
+    private com.google.protobuf.ByteString id_;
+    /**
+     * <code>bytes id = 1 [json_name = "id"];</code>
+     * @return The id.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getId() {
+      return id_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!id_.isEmpty()) {
+        output.writeBytes(1, id_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!id_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(1, id_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.GetTransactionProofRequest)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.GetTransactionProofRequest other = (pactus.transaction.TransactionOuterClass.GetTransactionProofRequest) obj;
+
+      if (!getId()
+          .equals(other.getId())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.GetTransactionProofRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.GetTransactionProofRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetTransactionProofRequest)
+        pactus.transaction.TransactionOuterClass.GetTransactionProofRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.class, pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        id_ = com.google.protobuf.ByteString.EMPTY;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionProofRequest getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionProofRequest build() {
+        pactus.transaction.TransactionOuterClass.GetTransactionProofRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionProofRequest buildPartial() {
+        pactus.transaction.TransactionOuterClass.GetTransactionProofRequest result = new pactus.transaction.TransactionOuterClass.GetTransactionProofRequest(this);
+        result.id_ = id_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.GetTransactionProofRequest) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.GetTransactionProofRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.GetTransactionProofRequest other) {
+        if (other == pactus.transaction.TransactionOuterClass.GetTransactionProofRequest.getDefaultInstance()) return this;
+        if (other.getId() != com.google.protobuf.ByteString.EMPTY) {
+          setId(other.getId());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                id_ = input.readBytes();
+
+                break;
+              } // case 10
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private com.google.protobuf.ByteString id_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes id = 1 [json_name = "id"];</code>
+       * @return The id.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getId() {
+        return id_;
+      }
+      /**
+       * <code>bytes id = 1 [json_name = "id"];</code>
+       * @param value The id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setId(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes id = 1 [json_name = "id"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearId() {
+        
+        id_ = getDefaultInstance().getId();
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetTransactionProofRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetTransactionProofRequest)
+    private static final pactus.transaction.TransactionOuterClass.GetTransactionProofRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.GetTransactionProofRequest();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetTransactionProofRequest>
+        PARSER = new com.google.protobuf.AbstractParser<GetTransactionProofRequest>() {
+      @java.lang.Override
+      public GetTransactionProofRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetTransactionProofRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetTransactionProofRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.GetTransactionProofRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface GetTransactionProofResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetTransactionProofResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+     * @return The blockHeight.
+     */
+    int getBlockHeight();
+
+    /**
+     * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+     * @return The blockHash.
+     */
+    com.google.protobuf.ByteString getBlockHash();
+
+    /**
+     * <code>bytes block_header = 3 [json_name = "blockHeader"];</code>
+     * @return The blockHeader.
+     */
+    com.google.protobuf.ByteString getBlockHeader();
+
+    /**
+     * <pre>
+     * It is empty for the genesis block.
+     * </pre>
+     *
+     * <code>bytes prev_cert_hash = 4 [json_name = "prevCertHash"];</code>
+     * @return The prevCertHash.
+     */
+    com.google.protobuf.ByteString getPrevCertHash();
+
+    /**
+     * <code>bytes txs_root = 5 [json_name = "txsRoot"];</code>
+     * @return The txsRoot.
+     */
+    com.google.protobuf.ByteString getTxsRoot();
+
+    /**
+     * <code>int32 tx_count = 6 [json_name = "txCount"];</code>
+     * @return The txCount.
+     */
+    int getTxCount();
+
+    /**
+     * <code>int32 index = 7 [json_name = "index"];</code>
+     * @return The index.
+     */
+    int getIndex();
+
+    /**
+     * <pre>
+     * The sibling hashes on the path from the transaction ID to the transactions root.
+     * </pre>
+     *
+     * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+     * @return A list containing the proof.
+     */
+    java.util.List<com.google.protobuf.ByteString> getProofList();
+    /**
+     * <pre>
+     * The sibling hashes on the path from the transaction ID to the transactions root.
+     * </pre>
+     *
+     * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+     * @return The count of proof.
+     */
+    int getProofCount();
+    /**
+     * <pre>
+     * The sibling hashes on the path from the transaction ID to the transactions root.
+     * </pre>
+     *
+     * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+     * @param index The index of the element to return.
+     * @return The proof at the given index.
+     */
+    com.google.protobuf.ByteString getProof(int index);
+  }
+  /**
+   * <pre>
+   * The proof links the transaction ID to the transactions root, and the transactions root
+   * to the block hash. The block hash is the hash of the block header, the previous
+   * certificate hash, the transactions root and the number of transactions,
+   * encoded as a 4-byte little-endian integer.
+   * </pre>
+   *
+   * Protobuf type {@code pactus.GetTransactionProofResponse}
+   */
+  public static final class GetTransactionProofResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.GetTransactionProofResponse)
+      GetTransactionProofResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use GetTransactionProofResponse.newBuilder() to construct.
+    private GetTransactionProofResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private GetTransactionProofResponse() {
+      blockHash_ = com.google.protobuf.ByteString.EMPTY;
+      blockHeader_ = com.google.protobuf.ByteString.EMPTY;
+      prevCertHash_ = com.google.protobuf.ByteString.EMPTY;
+      txsRoot_ = com.google.protobuf.ByteString.EMPTY;
+      proof_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new GetTransactionProofResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.class, pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.Builder.class);
+    }
+
+    public static final int BLOCK_HEIGHT_FIELD_NUMBER = 1;
+    private int blockHeight_;
+    /**
+     * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+     * @return The blockHeight.
+     */
+    @java.lang.Override
+    public int getBlockHeight() {
+      return blockHeight_;
+    }
+
+    public static final int BLOCK_HASH_FIELD_NUMBER = 2;
+    private com.google.protobuf.ByteString blockHash_;
+    /**
+     * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+     * @return The blockHash.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getBlockHash() {
+      return blockHash_;
+    }
+
+    public static final int BLOCK_HEADER_FIELD_NUMBER = 3;
+    private com.google.protobuf.ByteString blockHeader_;
+    /**
+     * <code>bytes block_header = 3 [json_name = "blockHeader"];</code>
+     * @return The blockHeader.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getBlockHeader() {
+      return blockHeader_;
+    }
+
+    public static final int PREV_CERT_HASH_FIELD_NUMBER = 4;
+    private com.google.protobuf.ByteString prevCertHash_;
+    /**
+     * <pre>
+     * It is empty for the genesis block.
+     * </pre>
+     *
+     * <code>bytes prev_cert_hash = 4 [json_name = "prevCertHash"];</code>
+     * @return The prevCertHash.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getPrevCertHash() {
+      return prevCertHash_;
+    }
+
+    public static final int TXS_ROOT_FIELD_NUMBER = 5;
+    private com.google.protobuf.ByteString txsRoot_;
+    /**
+     * <code>bytes txs_root = 5 [json_name = "txsRoot"];</code>
+     * @return The txsRoot.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getTxsRoot() {
+      return txsRoot_;
+    }
+
+    public static final int TX_COUNT_FIELD_NUMBER = 6;
+    private int txCount_;
+    /**
+     * <code>int32 tx_count = 6 [json_name = "txCount"];</code>
+     * @return The txCount.
+     */
+    @java.lang.Override
+    public int getTxCount() {
+      return txCount_;
+    }
+
+    public static final int INDEX_FIELD_NUMBER = 7;
+    private int index_;
+    /**
+     * <code>int32 index = 7 [json_name = "index"];</code>
+     * @return The index.
+     */
+    @java.lang.Override
+    public int getIndex() {
+      return index_;
+    }
+
+    public static final int PROOF_FIELD_NUMBER = 8;
+    private java.util.List<com.google.protobuf.ByteString> proof_;
+    /**
+     * <pre>
+     * The sibling hashes on the path from the transaction ID to the transactions root.
+     * </pre>
+     *
+     * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+     * @return A list containing the proof.
+     */
+    @java.lang.Override
+    public java.util.List<com.google.protobuf.ByteString>
+        getProofList() {
+      return proof_;
+    }
+    /**
+     * <pre>
+     * The sibling hashes on the path from the transaction ID to the transactions root.
+     * </pre>
+     *
+     * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+     * @return The count of proof.
+     */
+    public int getProofCount() {
+      return proof_.size();
+    }
+    /**
+     * <pre>
+     * The sibling hashes on the path from the transaction ID to the transactions root.
+     * </pre>
+     *
+     * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+     * @param index The index of the element to return.
+     * @return The proof at the given index.
+     */
+    public com.google.protobuf.ByteString getProof(int index) {
+      return proof_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (blockHeight_ != 0) {
+        output.writeUInt32(1, blockHeight_);
+      }
+      if (!blockHash_.isEmpty()) {
+        output.writeBytes(2, blockHash_);
+      }
+      if (!blockHeader_.isEmpty()) {
+        output.writeBytes(3, blockHeader_);
+      }
+      if (!prevCertHash_.isEmpty()) {
+        output.writeBytes(4, prevCertHash_);
+      }
+      if (!txsRoot_.isEmpty()) {
+        output.writeBytes(5, txsRoot_);
+      }
+      if (txCount_ != 0) {
+        output.writeInt32(6, txCount_);
+      }
+      if (index_ != 0) {
+        output.writeInt32(7, index_);
+      }
+      for (int i = 0; i < proof_.size(); i++) {
+        output.writeBytes(8, proof_.get(i));
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (blockHeight_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(1, blockHeight_);
+      }
+      if (!blockHash_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(2, blockHash_);
+      }
+      if (!blockHeader_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(3, blockHeader_);
+      }
+      if (!prevCertHash_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(4, prevCertHash_);
+      }
+      if (!txsRoot_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(5, txsRoot_);
+      }
+      if (txCount_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(6, txCount_);
+      }
+      if (index_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(7, index_);
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < proof_.size(); i++) {
+          dataSize += com.google.protobuf.CodedOutputStream
+            .computeBytesSizeNoTag(proof_.get(i));
+        }
+        size += dataSize;
+        size += 1 * getProofList().size();
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.GetTransactionProofResponse)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.GetTransactionProofResponse other = (pactus.transaction.TransactionOuterClass.GetTransactionProofResponse) obj;
+
+      if (getBlockHeight()
+          != other.getBlockHeight()) return false;
+      if (!getBlockHash()
+          .equals(other.getBlockHash())) return false;
+      if (!getBlockHeader()
+          .equals(other.getBlockHeader())) return false;
+      if (!getPrevCertHash()
+          .equals(other.getPrevCertHash())) return false;
+      if (!getTxsRoot()
+          .equals(other.getTxsRoot())) return false;
+      if (getTxCount()
+          != other.getTxCount()) return false;
+      if (getIndex()
+          != other.getIndex()) return false;
+      if (!getProofList()
+          .equals(other.getProofList())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + BLOCK_HEIGHT_FIELD_NUMBER;
+      hash = (53 * hash) + getBlockHeight();
+      hash = (37 * hash) + BLOCK_HASH_FIELD_NUMBER;
+      hash = (53 * hash) + getBlockHash().hashCode();
+      hash = (37 * hash) + BLOCK_HEADER_FIELD_NUMBER;
+      hash = (53 * hash) + getBlockHeader().hashCode();
+      hash = (37 * hash) + PREV_CERT_HASH_FIELD_NUMBER;
+      hash = (53 * hash) + getPrevCertHash().hashCode();
+      hash = (37 * hash) + TXS_ROOT_FIELD_NUMBER;
+      hash = (53 * hash) + getTxsRoot().hashCode();
+      hash = (37 * hash) + TX_COUNT_FIELD_NUMBER;
+      hash = (53 * hash) + getTxCount();
+      hash = (37 * hash) + INDEX_FIELD_NUMBER;
+      hash = (53 * hash) + getIndex();
+      if (getProofCount() > 0) {
+        hash = (37 * hash) + PROOF_FIELD_NUMBER;
+        hash = (53 * hash) + getProofList().hashCode();
+      }
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.GetTransactionProofResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * <pre>
+     * The proof links the transaction ID to the transactions root, and the transactions root
+     * to the block hash. The block hash is the hash of the block header, the previous
+     * certificate hash, the transactions root and the number of transactions,
+     * encoded as a 4-byte little-endian integer.
+     * </pre>
+     *
+     * Protobuf type {@code pactus.GetTransactionProofResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.GetTransactionProofResponse)
+        pactus.transaction.TransactionOuterClass.GetTransactionProofResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.class, pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        blockHeight_ = 0;
+
+        blockHash_ = com.google.protobuf.ByteString.EMPTY;
+
+        blockHeader_ = com.google.protobuf.ByteString.EMPTY;
+
+        prevCertHash_ = com.google.protobuf.ByteString.EMPTY;
+
+        txsRoot_ = com.google.protobuf.ByteString.EMPTY;
+
+        txCount_ = 0;
+
+        index_ = 0;
+
+        proof_ = java.util.Collections.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTransactionProofResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionProofResponse getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionProofResponse build() {
+        pactus.transaction.TransactionOuterClass.GetTransactionProofResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.GetTransactionProofResponse buildPartial() {
+        pactus.transaction.TransactionOuterClass.GetTransactionProofResponse result = new pactus.transaction.TransactionOuterClass.GetTransactionProofResponse(this);
+        int from_bitField0_ = bitField0_;
+        result.blockHeight_ = blockHeight_;
+        result.blockHash_ = blockHash_;
+        result.blockHeader_ = blockHeader_;
+        result.prevCertHash_ = prevCertHash_;
+        result.txsRoot_ = txsRoot_;
+        result.txCount_ = txCount_;
+        result.index_ = index_;
+        if (((bitField0_ & 0x00000001) != 0)) {
+          proof_ = java.util.Collections.unmodifiableList(proof_);
+          bitField0_ = (bitField0_ & ~0x00000001);
+        }
+        result.proof_ = proof_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.GetTransactionProofResponse) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.GetTransactionProofResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.GetTransactionProofResponse other) {
+        if (other == pactus.transaction.TransactionOuterClass.GetTransactionProofResponse.getDefaultInstance()) return this;
+        if (other.getBlockHeight() != 0) {
+          setBlockHeight(other.getBlockHeight());
+        }
+        if (other.getBlockHash() != com.google.protobuf.ByteString.EMPTY) {
+          setBlockHash(other.getBlockHash());
+        }
+        if (other.getBlockHeader() != com.google.protobuf.ByteString.EMPTY) {
+          setBlockHeader(other.getBlockHeader());
+        }
+        if (other.getPrevCertHash() != com.google.protobuf.ByteString.EMPTY) {
+          setPrevCertHash(other.getPrevCertHash());
+        }
+        if (other.getTxsRoot() != com.google.protobuf.ByteString.EMPTY) {
+          setTxsRoot(other.getTxsRoot());
+        }
+        if (other.getTxCount() != 0) {
+          setTxCount(other.getTxCount());
+        }
+        if (other.getIndex() != 0) {
+          setIndex(other.getIndex());
+        }
+        if (!other.proof_.isEmpty()) {
+          if (proof_.isEmpty()) {
+            proof_ = other.proof_;
+            bitField0_ = (bitField0_ & ~0x00000001);
+          } else {
+            ensureProofIsMutable();
+            proof_.addAll(other.proof_);
+          }
+          onChanged();
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                blockHeight_ = input.readUInt32();
+
+                break;
+              } // case 8
+              case 18: {
+                blockHash_ = input.readBytes();
+
+                break;
+              } // case 18
+              case 26: {
+                blockHeader_ = input.readBytes();
+
+                break;
+              } // case 26
+              case 34: {
+                prevCertHash_ = input.readBytes();
+
+                break;
+              } // case 34
+              case 42: {
+                txsRoot_ = input.readBytes();
+
+                break;
+              } // case 42
+              case 48: {
+                txCount_ = input.readInt32();
+
+                break;
+              } // case 48
+              case 56: {
+                index_ = input.readInt32();
+
+                break;
+              } // case 56
+              case 66: {
+                com.google.protobuf.ByteString v = input.readBytes();
+                ensureProofIsMutable();
+                proof_.add(v);
+                break;
+              } // case 66
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+      private int bitField0_;
+
+      private int blockHeight_ ;
+      /**
+       * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+       * @return The blockHeight.
+       */
+      @java.lang.Override
+      public int getBlockHeight() {
+        return blockHeight_;
+      }
+      /**
+       * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+       * @param value The blockHeight to set.
+       * @return This builder for chaining.
+       */
+      public Builder setBlockHeight(int value) {
+        
+        blockHeight_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>uint32 block_height = 1 [json_name = "blockHeight"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearBlockHeight() {
+        
+        blockHeight_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.ByteString blockHash_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+       * @return The blockHash.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getBlockHash() {
+        return blockHash_;
+      }
+      /**
+       * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+       * @param value The blockHash to set.
+       * @return This builder for chaining.
+       */
+      public Builder setBlockHash(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        blockHash_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes block_hash = 2 [json_name = "blockHash"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearBlockHash() {
+        
+        blockHash_ = getDefaultInstance().getBlockHash();
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.ByteString blockHeader_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes block_header = 3 [json_name = "blockHeader"];</code>
+       * @return The blockHeader.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getBlockHeader() {
+        return blockHeader_;
+      }
+      /**
+       * <code>bytes block_header = 3 [json_name = "blockHeader"];</code>
+       * @param value The blockHeader to set.
+       * @return This builder for chaining.
+       */
+      public Builder setBlockHeader(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        blockHeader_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes block_header = 3 [json_name = "blockHeader"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearBlockHeader() {
+        
+        blockHeader_ = getDefaultInstance().getBlockHeader();
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.ByteString prevCertHash_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <pre>
+       * It is empty for the genesis block.
+       * </pre>
+       *
+       * <code>bytes prev_cert_hash = 4 [json_name = "prevCertHash"];</code>
+       * @return The prevCertHash.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getPrevCertHash() {
+        return prevCertHash_;
+      }
+      /**
+       * <pre>
+       * It is empty for the genesis block.
+       * </pre>
+       *
+       * <code>bytes prev_cert_hash = 4 [json_name = "prevCertHash"];</code>
+       * @param value The prevCertHash to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPrevCertHash(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        prevCertHash_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * It is empty for the genesis block.
+       * </pre>
+       *
+       * <code>bytes prev_cert_hash = 4 [json_name = "prevCertHash"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPrevCertHash() {
+        
+        prevCertHash_ = getDefaultInstance().getPrevCertHash();
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.ByteString txsRoot_ = com.google.protobuf.ByteString.EMPTY;
+      /**
+       * <code>bytes txs_root = 5 [json_name = "txsRoot"];</code>
+       * @return The txsRoot.
+       */
+      @java.lang.Override
+      public com.google.protobuf.ByteString getTxsRoot() {
+        return txsRoot_;
+      }
+      /**
+       * <code>bytes txs_root = 5 [json_name = "txsRoot"];</code>
+       * @param value The txsRoot to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTxsRoot(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        txsRoot_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>bytes txs_root = 5 [json_name = "txsRoot"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTxsRoot() {
+        
+        txsRoot_ = getDefaultInstance().getTxsRoot();
+        onChanged();
+        return this;
+      }
+
+      private int txCount_ ;
+      /**
+       * <code>int32 tx_count = 6 [json_name = "txCount"];</code>
+       * @return The txCount.
+       */
+      @java.lang.Override
+      public int getTxCount() {
+        return txCount_;
+      }
+      /**
+       * <code>int32 tx_count = 6 [json_name = "txCount"];</code>
+       * @param value The txCount to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTxCount(int value) {
+        
+        txCount_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 tx_count = 6 [json_name = "txCount"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTxCount() {
+        
+        txCount_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int index_ ;
+      /**
+       * <code>int32 index = 7 [json_name = "index"];</code>
+       * @return The index.
+       */
+      @java.lang.Override
+      public int getIndex() {
+        return index_;
+      }
+      /**
+       * <code>int32 index = 7 [json_name = "index"];</code>
+       * @param value The index to set.
+       * @return This builder for chaining.
+       */
+      public Builder setIndex(int value) {
+        
+        index_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 index = 7 [json_name = "index"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearIndex() {
+        
+        index_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.util.List<com.google.protobuf.ByteString> proof_ = java.util.Collections.emptyList();
+      private void ensureProofIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          proof_ = new java.util.ArrayList<com.google.protobuf.ByteString>(proof_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+      /**
+       * <pre>
+       * The sibling hashes on the path from the transaction ID to the transactions root.
+       * </pre>
+       *
+       * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+       * @return A list containing the proof.
+       */
+      public java.util.List<com.google.protobuf.ByteString>
+          getProofList() {
+        return ((bitField0_ & 0x00000001) != 0) ?
+                 java.util.Collections.unmodifiableList(proof_) : proof_;
+      }
+      /**
+       * <pre>
+       * The sibling hashes on the path from the transaction ID to the transactions root.
+       * </pre>
+       *
+       * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+       * @return The count of proof.
+       */
+      public int getProofCount() {
+        return proof_.size();
+      }
+      /**
+       * <pre>
+       * The sibling hashes on the path from the transaction ID to the transactions root.
+       * </pre>
+       *
+       * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+       * @param index The index of the element to return.
+       * @return The proof at the given index.
+       */
+      public com.google.protobuf.ByteString getProof(int index) {
+        return proof_.get(index);
+      }
+      /**
+       * <pre>
+       * The sibling hashes on the path from the transaction ID to the transactions root.
+       * </pre>
+       *
+       * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+       * @param index The index to set the value at.
+       * @param value The proof to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProof(
+          int index, com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  ensureProofIsMutable();
+        proof_.set(index, value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The sibling hashes on the path from the transaction ID to the transactions root.
+       * </pre>
+       *
+       * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+       * @param value The proof to add.
+       * @return This builder for chaining.
+       */
+      public Builder addProof(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  ensureProofIsMutable();
+        proof_.add(value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The sibling hashes on the path from the transaction ID to the transactions root.
+       * </pre>
+       *
+       * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+       * @param values The proof to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllProof(
+          java.lang.Iterable<? extends com.google.protobuf.ByteString> values) {
+        ensureProofIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, proof_);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The sibling hashes on the path from the transaction ID to the transactions root.
+       * </pre>
+       *
+       * <code>repeated bytes proof = 8 [json_name = "proof"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearProof() {
+        proof_ = java.util.Collections.emptyList();
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.GetTransactionProofResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.GetTransactionProofResponse)
+    private static final pactus.transaction.TransactionOuterClass.GetTransactionProofResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.GetTransactionProofResponse();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.GetTransactionProofResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<GetTransactionProofResponse>
+        PARSER = new com.google.protobuf.AbstractParser<GetTransactionProofResponse>() {
+      @java.lang.Override
+      public GetTransactionProofResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<GetTransactionProofResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<GetTransactionProofResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.GetTransactionProofResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface CalculateFeeRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.CalculateFeeRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>int64 amount = 1 [json_name = "amount"];</code>
+     * @return The amount.
+     */
+    long getAmount();
+
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The enum numeric value on the wire for payloadType.
+     */
+    int getPayloadTypeValue();
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The payloadType.
+     */
+    pactus.transaction.TransactionOuterClass.PayloadType getPayloadType();
+  }
+  /**
+   * Protobuf type {@code pactus.CalculateFeeRequest}
+   */
+  public static final class CalculateFeeRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.CalculateFeeRequest)
+      CalculateFeeRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use CalculateFeeRequest.newBuilder() to construct.
+    private CalculateFeeRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private CalculateFeeRequest() {
+      payloadType_ = 0;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new CalculateFeeRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.CalculateFeeRequest.class, pactus.transaction.TransactionOuterClass.CalculateFeeRequest.Builder.class);
+    }
+
+    public static final int AMOUNT_FIELD_NUMBER = 1;
+    private long amount_;
+    /**
+     * <code>int64 amount = 1 [json_name = "amount"];</code>
+     * @return The amount.
+     */
+    @java.lang.Override
+    public long getAmount() {
+      return amount_;
+    }
+
+    public static final int PAYLOADTYPE_FIELD_NUMBER = 2;
+    private int payloadType_;
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The enum numeric value on the wire for payloadType.
+     */
+    @java.lang.Override public int getPayloadTypeValue() {
+      return payloadType_;
+    }
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The payloadType.
+     */
+    @java.lang.Override public pactus.transaction.TransactionOuterClass.PayloadType getPayloadType() {
+      @SuppressWarnings("deprecation")
+      pactus.transaction.TransactionOuterClass.PayloadType result = pactus.transaction.TransactionOuterClass.PayloadType.valueOf(payloadType_);
+      return result == null ? pactus.transaction.TransactionOuterClass.PayloadType.UNRECOGNIZED : result;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (amount_ != 0L) {
+        output.writeInt64(1, amount_);
+      }
+      if (payloadType_ != pactus.transaction.TransactionOuterClass.PayloadType.UNKNOWN.getNumber()) {
+        output.writeEnum(2, payloadType_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (amount_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(1, amount_);
+      }
+      if (payloadType_ != pactus.transaction.TransactionOuterClass.PayloadType.UNKNOWN.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(2, payloadType_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.CalculateFeeRequest)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.CalculateFeeRequest other = (pactus.transaction.TransactionOuterClass.CalculateFeeRequest) obj;
+
+      if (getAmount()
+          != other.getAmount()) return false;
+      if (payloadType_ != other.payloadType_) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + AMOUNT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getAmount());
+      hash = (37 * hash) + PAYLOADTYPE_FIELD_NUMBER;
+      hash = (53 * hash) + payloadType_;
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.CalculateFeeRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.CalculateFeeRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.CalculateFeeRequest)
+        pactus.transaction.TransactionOuterClass.CalculateFeeRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.CalculateFeeRequest.class, pactus.transaction.TransactionOuterClass.CalculateFeeRequest.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.CalculateFeeRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        amount_ = 0L;
+
+        payloadType_ = 0;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.CalculateFeeRequest getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.CalculateFeeRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.CalculateFeeRequest build() {
+        pactus.transaction.TransactionOuterClass.CalculateFeeRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.CalculateFeeRequest buildPartial() {
+        pactus.transaction.TransactionOuterClass.CalculateFeeRequest result = new pactus.transaction.TransactionOuterClass.CalculateFeeRequest(this);
+        result.amount_ = amount_;
+        result.payloadType_ = payloadType_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.CalculateFeeRequest) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.CalculateFeeRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.CalculateFeeRequest other) {
+        if (other == pactus.transaction.TransactionOuterClass.CalculateFeeRequest.getDefaultInstance()) return this;
+        if (other.getAmount() != 0L) {
+          setAmount(other.getAmount());
+        }
+        if (other.payloadType_ != 0) {
+          setPayloadTypeValue(other.getPayloadTypeValue());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                amount_ = input.readInt64();
+
+                break;
+              } // case 8
+              case 16: {
+                payloadType_ = input.readEnum();
+
+                break;
+              } // case 16
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private long amount_ ;
+      /**
+       * <code>int64 amount = 1 [json_name = "amount"];</code>
+       * @return The amount.
+       */
+      @java.lang.Override
+      public long getAmount() {
+        return amount_;
+      }
+      /**
+       * <code>int64 amount = 1 [json_name = "amount"];</code>
+       * @param value The amount to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAmount(long value) {
+        
+        amount_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 amount = 1 [json_name = "amount"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAmount() {
+        
+        amount_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private int payloadType_ = 0;
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @return The enum numeric value on the wire for payloadType.
+       */
+      @java.lang.Override public int getPayloadTypeValue() {
+        return payloadType_;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @param value The enum numeric value on the wire for payloadType to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayloadTypeValue(int value) {
+        
+        payloadType_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @return The payloadType.
+       */
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.PayloadType getPayloadType() {
+        @SuppressWarnings("deprecation")
+        pactus.transaction.TransactionOuterClass.PayloadType result = pactus.transaction.TransactionOuterClass.PayloadType.valueOf(payloadType_);
+        return result == null ? pactus.transaction.TransactionOuterClass.PayloadType.UNRECOGNIZED : result;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @param value The payloadType to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayloadType(pactus.transaction.TransactionOuterClass.PayloadType value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        
+        payloadType_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPayloadType() {
+        
+        payloadType_ = 0;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.CalculateFeeRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.CalculateFeeRequest)
+    private static final pactus.transaction.TransactionOuterClass.CalculateFeeRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.CalculateFeeRequest();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<CalculateFeeRequest>
+        PARSER = new com.google.protobuf.AbstractParser<CalculateFeeRequest>() {
+      @java.lang.Override
+      public CalculateFeeRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<CalculateFeeRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<CalculateFeeRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.CalculateFeeRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface CalculateFeeResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.CalculateFeeResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>int64 fee = 1 [json_name = "fee"];</code>
+     * @return The fee.
+     */
+    long getFee();
+  }
+  /**
+   * Protobuf type {@code pactus.CalculateFeeResponse}
+   */
+  public static final class CalculateFeeResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.CalculateFeeResponse)
+      CalculateFeeResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use CalculateFeeResponse.newBuilder() to construct.
+    private CalculateFeeResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private CalculateFeeResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new CalculateFeeResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.CalculateFeeResponse.class, pactus.transaction.TransactionOuterClass.CalculateFeeResponse.Builder.class);
+    }
+
+    public static final int FEE_FIELD_NUMBER = 1;
+    private long fee_;
+    /**
+     * <code>int64 fee = 1 [json_name = "fee"];</code>
+     * @return The fee.
+     */
+    @java.lang.Override
+    public long getFee() {
+      return fee_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (fee_ != 0L) {
+        output.writeInt64(1, fee_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (fee_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(1, fee_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.CalculateFeeResponse)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.CalculateFeeResponse other = (pactus.transaction.TransactionOuterClass.CalculateFeeResponse) obj;
+
+      if (getFee()
+          != other.getFee()) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + FEE_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getFee());
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.CalculateFeeResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.CalculateFeeResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.CalculateFeeResponse)
+        pactus.transaction.TransactionOuterClass.CalculateFeeResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.CalculateFeeResponse.class, pactus.transaction.TransactionOuterClass.CalculateFeeResponse.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.CalculateFeeResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        fee_ = 0L;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.CalculateFeeResponse getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.CalculateFeeResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.CalculateFeeResponse build() {
+        pactus.transaction.TransactionOuterClass.CalculateFeeResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.CalculateFeeResponse buildPartial() {
+        pactus.transaction.TransactionOuterClass.CalculateFeeResponse result = new pactus.transaction.TransactionOuterClass.CalculateFeeResponse(this);
+        result.fee_ = fee_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.CalculateFeeResponse) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.CalculateFeeResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.CalculateFeeResponse other) {
+        if (other == pactus.transaction.TransactionOuterClass.CalculateFeeResponse.getDefaultInstance()) return this;
+        if (other.getFee() != 0L) {
+          setFee(other.getFee());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                fee_ = input.readInt64();
+
+                break;
+              } // case 8
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private long fee_ ;
+      /**
+       * <code>int64 fee = 1 [json_name = "fee"];</code>
+       * @return The fee.
+       */
+      @java.lang.Override
+      public long getFee() {
+        return fee_;
+      }
+      /**
+       * <code>int64 fee = 1 [json_name = "fee"];</code>
+       * @param value The fee to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFee(long value) {
+        
+        fee_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 fee = 1 [json_name = "fee"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFee() {
+        
+        fee_ = 0L;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.CalculateFeeResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.CalculateFeeResponse)
+    private static final pactus.transaction.TransactionOuterClass.CalculateFeeResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.CalculateFeeResponse();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<CalculateFeeResponse>
+        PARSER = new com.google.protobuf.AbstractParser<CalculateFeeResponse>() {
+      @java.lang.Override
+      public CalculateFeeResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<CalculateFeeResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<CalculateFeeResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.CalculateFeeResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface EstimateFeeRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.EstimateFeeRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>int64 amount = 1 [json_name = "amount"];</code>
+     * @return The amount.
+     */
+    long getAmount();
+
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The enum numeric value on the wire for payloadType.
+     */
+    int getPayloadTypeValue();
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The payloadType.
+     */
+    pactus.transaction.TransactionOuterClass.PayloadType getPayloadType();
+  }
+  /**
+   * Protobuf type {@code pactus.EstimateFeeRequest}
+   */
+  public static final class EstimateFeeRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.EstimateFeeRequest)
+      EstimateFeeRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use EstimateFeeRequest.newBuilder() to construct.
+    private EstimateFeeRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private EstimateFeeRequest() {
+      payloadType_ = 0;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new EstimateFeeRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.EstimateFeeRequest.class, pactus.transaction.TransactionOuterClass.EstimateFeeRequest.Builder.class);
+    }
+
+    public static final int AMOUNT_FIELD_NUMBER = 1;
+    private long amount_;
+    /**
+     * <code>int64 amount = 1 [json_name = "amount"];</code>
+     * @return The amount.
+     */
+    @java.lang.Override
+    public long getAmount() {
+      return amount_;
+    }
+
+    public static final int PAYLOADTYPE_FIELD_NUMBER = 2;
+    private int payloadType_;
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The enum numeric value on the wire for payloadType.
+     */
+    @java.lang.Override public int getPayloadTypeValue() {
+      return payloadType_;
+    }
+    /**
+     * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+     * @return The payloadType.
+     */
+    @java.lang.Override public pactus.transaction.TransactionOuterClass.PayloadType getPayloadType() {
+      @SuppressWarnings("deprecation")
+      pactus.transaction.TransactionOuterClass.PayloadType result = pactus.transaction.TransactionOuterClass.PayloadType.valueOf(payloadType_);
+      return result == null ? pactus.transaction.TransactionOuterClass.PayloadType.UNRECOGNIZED : result;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (amount_ != 0L) {
+        output.writeInt64(1, amount_);
+      }
+      if (payloadType_ != pactus.transaction.TransactionOuterClass.PayloadType.UNKNOWN.getNumber()) {
+        output.writeEnum(2, payloadType_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (amount_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(1, amount_);
+      }
+      if (payloadType_ != pactus.transaction.TransactionOuterClass.PayloadType.UNKNOWN.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(2, payloadType_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.EstimateFeeRequest)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.EstimateFeeRequest other = (pactus.transaction.TransactionOuterClass.EstimateFeeRequest) obj;
+
+      if (getAmount()
+          != other.getAmount()) return false;
+      if (payloadType_ != other.payloadType_) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + AMOUNT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getAmount());
+      hash = (37 * hash) + PAYLOADTYPE_FIELD_NUMBER;
+      hash = (53 * hash) + payloadType_;
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.EstimateFeeRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.EstimateFeeRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.EstimateFeeRequest)
+        pactus.transaction.TransactionOuterClass.EstimateFeeRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.EstimateFeeRequest.class, pactus.transaction.TransactionOuterClass.EstimateFeeRequest.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.EstimateFeeRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        amount_ = 0L;
+
+        payloadType_ = 0;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.EstimateFeeRequest getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.EstimateFeeRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.EstimateFeeRequest build() {
+        pactus.transaction.TransactionOuterClass.EstimateFeeRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.EstimateFeeRequest buildPartial() {
+        pactus.transaction.TransactionOuterClass.EstimateFeeRequest result = new pactus.transaction.TransactionOuterClass.EstimateFeeRequest(this);
+        result.amount_ = amount_;
+        result.payloadType_ = payloadType_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.EstimateFeeRequest) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.EstimateFeeRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.EstimateFeeRequest other) {
+        if (other == pactus.transaction.TransactionOuterClass.EstimateFeeRequest.getDefaultInstance()) return this;
+        if (other.getAmount() != 0L) {
+          setAmount(other.getAmount());
+        }
+        if (other.payloadType_ != 0) {
+          setPayloadTypeValue(other.getPayloadTypeValue());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                amount_ = input.readInt64();
+
+                break;
+              } // case 8
+              case 16: {
+                payloadType_ = input.readEnum();
+
+                break;
+              } // case 16
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private long amount_ ;
+      /**
+       * <code>int64 amount = 1 [json_name = "amount"];</code>
+       * @return The amount.
+       */
+      @java.lang.Override
+      public long getAmount() {
+        return amount_;
+      }
+      /**
+       * <code>int64 amount = 1 [json_name = "amount"];</code>
+       * @param value The amount to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAmount(long value) {
+        
+        amount_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 amount = 1 [json_name = "amount"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAmount() {
+        
+        amount_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private int payloadType_ = 0;
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @return The enum numeric value on the wire for payloadType.
+       */
+      @java.lang.Override public int getPayloadTypeValue() {
+        return payloadType_;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @param value The enum numeric value on the wire for payloadType to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayloadTypeValue(int value) {
+        
+        payloadType_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @return The payloadType.
+       */
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.PayloadType getPayloadType() {
+        @SuppressWarnings("deprecation")
+        pactus.transaction.TransactionOuterClass.PayloadType result = pactus.transaction.TransactionOuterClass.PayloadType.valueOf(payloadType_);
+        return result == null ? pactus.transaction.TransactionOuterClass.PayloadType.UNRECOGNIZED : result;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @param value The payloadType to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayloadType(pactus.transaction.TransactionOuterClass.PayloadType value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+        
+        payloadType_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPayloadType() {
+        
+        payloadType_ = 0;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.EstimateFeeRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.EstimateFeeRequest)
+    private static final pactus.transaction.TransactionOuterClass.EstimateFeeRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.EstimateFeeRequest();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<EstimateFeeRequest>
+        PARSER = new com.google.protobuf.AbstractParser<EstimateFeeRequest>() {
+      @java.lang.Override
+      public EstimateFeeRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<EstimateFeeRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<EstimateFeeRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.EstimateFeeRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface EstimateFeeResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.EstimateFeeResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * The fee that the transaction should pay.
+     * </pre>
+     *
+     * <code>int64 fee = 1 [json_name = "fee"];</code>
+     * @return The fee.
+     */
+    long getFee();
+
+    /**
+     * <pre>
+     * The minimum fee that this node accepts. The transactions with a lower fee
+     * are not accepted by this node, although they are valid.
+     * </pre>
+     *
+     * <code>int64 min_fee = 2 [json_name = "minFee"];</code>
+     * @return The minFee.
+     */
+    long getMinFee();
+
+    /**
+     * <pre>
+     * The number of the pending transactions in the pool.
+     * </pre>
+     *
+     * <code>int32 pending_txs = 3 [json_name = "pendingTxs"];</code>
+     * @return The pendingTxs.
+     */
+    int getPendingTxs();
+
+    /**
+     * <pre>
+     * The ratio of the pending transactions to the pool capacity.
+     * </pre>
+     *
+     * <code>double pool_utilization = 4 [json_name = "poolUtilization"];</code>
+     * @return The poolUtilization.
+     */
+    double getPoolUtilization();
+  }
+  /**
+   * <pre>
+   * The fee is not a bid. The consensus rules fix it as the amount multiplied by
+   * the fee fraction, bounded by the minimum and maximum fees, and the
+   * transactions with any other fee are rejected. So the estimated fee doesn't
+   * grow with the congestion. Instead, the state of the transaction pool is
+   * returned, so the wallets can warn the users about the possible delays.
+   * </pre>
+   *
+   * Protobuf type {@code pactus.EstimateFeeResponse}
+   */
+  public static final class EstimateFeeResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.EstimateFeeResponse)
+      EstimateFeeResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use EstimateFeeResponse.newBuilder() to construct.
+    private EstimateFeeResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private EstimateFeeResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new EstimateFeeResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.EstimateFeeResponse.class, pactus.transaction.TransactionOuterClass.EstimateFeeResponse.Builder.class);
+    }
+
+    public static final int FEE_FIELD_NUMBER = 1;
+    private long fee_;
+    /**
+     * <pre>
+     * The fee that the transaction should pay.
+     * </pre>
+     *
+     * <code>int64 fee = 1 [json_name = "fee"];</code>
+     * @return The fee.
+     */
+    @java.lang.Override
+    public long getFee() {
+      return fee_;
+    }
+
+    public static final int MIN_FEE_FIELD_NUMBER = 2;
+    private long minFee_;
+    /**
+     * <pre>
+     * The minimum fee that this node accepts. The transactions with a lower fee
+     * are not accepted by this node, although they are valid.
+     * </pre>
+     *
+     * <code>int64 min_fee = 2 [json_name = "minFee"];</code>
+     * @return The minFee.
+     */
+    @java.lang.Override
+    public long getMinFee() {
+      return minFee_;
+    }
+
+    public static final int PENDING_TXS_FIELD_NUMBER = 3;
+    private int pendingTxs_;
+    /**
+     * <pre>
+     * The number of the pending transactions in the pool.
+     * </pre>
+     *
+     * <code>int32 pending_txs = 3 [json_name = "pendingTxs"];</code>
+     * @return The pendingTxs.
+     */
+    @java.lang.Override
+    public int getPendingTxs() {
+      return pendingTxs_;
+    }
+
+    public static final int POOL_UTILIZATION_FIELD_NUMBER = 4;
+    private double poolUtilization_;
+    /**
+     * <pre>
+     * The ratio of the pending transactions to the pool capacity.
+     * </pre>
+     *
+     * <code>double pool_utilization = 4 [json_name = "poolUtilization"];</code>
+     * @return The poolUtilization.
+     */
+    @java.lang.Override
+    public double getPoolUtilization() {
+      return poolUtilization_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (fee_ != 0L) {
+        output.writeInt64(1, fee_);
+      }
+      if (minFee_ != 0L) {
+        output.writeInt64(2, minFee_);
+      }
+      if (pendingTxs_ != 0) {
+        output.writeInt32(3, pendingTxs_);
+      }
+      if (java.lang.Double.doubleToRawLongBits(poolUtilization_) != 0) {
+        output.writeDouble(4, poolUtilization_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (fee_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(1, fee_);
+      }
+      if (minFee_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(2, minFee_);
+      }
+      if (pendingTxs_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(3, pendingTxs_);
+      }
+      if (java.lang.Double.doubleToRawLongBits(poolUtilization_) != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(4, poolUtilization_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.EstimateFeeResponse)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.EstimateFeeResponse other = (pactus.transaction.TransactionOuterClass.EstimateFeeResponse) obj;
+
+      if (getFee()
+          != other.getFee()) return false;
+      if (getMinFee()
+          != other.getMinFee()) return false;
+      if (getPendingTxs()
+          != other.getPendingTxs()) return false;
+      if (java.lang.Double.doubleToLongBits(getPoolUtilization())
+          != java.lang.Double.doubleToLongBits(
+              other.getPoolUtilization())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + FEE_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getFee());
+      hash = (37 * hash) + MIN_FEE_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getMinFee());
+      hash = (37 * hash) + PENDING_TXS_FIELD_NUMBER;
+      hash = (53 * hash) + getPendingTxs();
+      hash = (37 * hash) + POOL_UTILIZATION_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getPoolUtilization()));
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.EstimateFeeResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * <pre>
+     * The fee is not a bid. The consensus rules fix it as the amount multiplied by
+     * the fee fraction, bounded by the minimum and maximum fees, and the
+     * transactions with any other fee are rejected. So the estimated fee doesn't
+     * grow with the congestion. Instead, the state of the transaction pool is
+     * returned, so the wallets can warn the users about the possible delays.
+     * </pre>
+     *
+     * Protobuf type {@code pactus.EstimateFeeResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.EstimateFeeResponse)
+        pactus.transaction.TransactionOuterClass.EstimateFeeResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.EstimateFeeResponse.class, pactus.transaction.TransactionOuterClass.EstimateFeeResponse.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.EstimateFeeResponse.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        fee_ = 0L;
+
+        minFee_ = 0L;
+
+        pendingTxs_ = 0;
+
+        poolUtilization_ = 0D;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_EstimateFeeResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.EstimateFeeResponse getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.EstimateFeeResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.EstimateFeeResponse build() {
+        pactus.transaction.TransactionOuterClass.EstimateFeeResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.EstimateFeeResponse buildPartial() {
+        pactus.transaction.TransactionOuterClass.EstimateFeeResponse result = new pactus.transaction.TransactionOuterClass.EstimateFeeResponse(this);
+        result.fee_ = fee_;
+        result.minFee_ = minFee_;
+        result.pendingTxs_ = pendingTxs_;
+        result.poolUtilization_ = poolUtilization_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.EstimateFeeResponse) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.EstimateFeeResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.EstimateFeeResponse other) {
+        if (other == pactus.transaction.TransactionOuterClass.EstimateFeeResponse.getDefaultInstance()) return this;
+        if (other.getFee() != 0L) {
+          setFee(other.getFee());
+        }
+        if (other.getMinFee() != 0L) {
+          setMinFee(other.getMinFee());
+        }
+        if (other.getPendingTxs() != 0) {
+          setPendingTxs(other.getPendingTxs());
+        }
+        if (other.getPoolUtilization() != 0D) {
+          setPoolUtilization(other.getPoolUtilization());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 8: {
+                fee_ = input.readInt64();
+
+                break;
+              } // case 8
+              case 16: {
+                minFee_ = input.readInt64();
+
+                break;
+              } // case 16
+              case 24: {
+                pendingTxs_ = input.readInt32();
+
+                break;
+              } // case 24
+              case 33: {
+                poolUtilization_ = input.readDouble();
+
+                break;
+              } // case 33
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
+        return this;
+      }
+
+      private long fee_ ;
+      /**
+       * <pre>
+       * The fee that the transaction should pay.
+       * </pre>
+       *
+       * <code>int64 fee = 1 [json_name = "fee"];</code>
+       * @return The fee.
+       */
+      @java.lang.Override
+      public long getFee() {
+        return fee_;
+      }
+      /**
+       * <pre>
+       * The fee that the transaction should pay.
+       * </pre>
+       *
+       * <code>int64 fee = 1 [json_name = "fee"];</code>
+       * @param value The fee to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFee(long value) {
+        
+        fee_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The fee that the transaction should pay.
+       * </pre>
+       *
+       * <code>int64 fee = 1 [json_name = "fee"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFee() {
+        
+        fee_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private long minFee_ ;
+      /**
+       * <pre>
+       * The minimum fee that this node accepts. The transactions with a lower fee
+       * are not accepted by this node, although they are valid.
+       * </pre>
+       *
+       * <code>int64 min_fee = 2 [json_name = "minFee"];</code>
+       * @return The minFee.
+       */
+      @java.lang.Override
+      public long getMinFee() {
+        return minFee_;
+      }
+      /**
+       * <pre>
+       * The minimum fee that this node accepts. The transactions with a lower fee
+       * are not accepted by this node, although they are valid.
+       * </pre>
+       *
+       * <code>int64 min_fee = 2 [json_name = "minFee"];</code>
+       * @param value The minFee to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMinFee(long value) {
+        
+        minFee_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The minimum fee that this node accepts. The transactions with a lower fee
+       * are not accepted by this node, although they are valid.
+       * </pre>
+       *
+       * <code>int64 min_fee = 2 [json_name = "minFee"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMinFee() {
+        
+        minFee_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private int pendingTxs_ ;
+      /**
+       * <pre>
+       * The number of the pending transactions in the pool.
+       * </pre>
+       *
+       * <code>int32 pending_txs = 3 [json_name = "pendingTxs"];</code>
+       * @return The pendingTxs.
+       */
+      @java.lang.Override
+      public int getPendingTxs() {
+        return pendingTxs_;
+      }
+      /**
+       * <pre>
+       * The number of the pending transactions in the pool.
+       * </pre>
+       *
+       * <code>int32 pending_txs = 3 [json_name = "pendingTxs"];</code>
+       * @param value The pendingTxs to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPendingTxs(int value) {
+        
+        pendingTxs_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The number of the pending transactions in the pool.
+       * </pre>
+       *
+       * <code>int32 pending_txs = 3 [json_name = "pendingTxs"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPendingTxs() {
+        
+        pendingTxs_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private double poolUtilization_ ;
+      /**
+       * <pre>
+       * The ratio of the pending transactions to the pool capacity.
+       * </pre>
+       *
+       * <code>double pool_utilization = 4 [json_name = "poolUtilization"];</code>
+       * @return The poolUtilization.
+       */
+      @java.lang.Override
+      public double getPoolUtilization() {
+        return poolUtilization_;
+      }
+      /**
+       * <pre>
+       * The ratio of the pending transactions to the pool capacity.
+       * </pre>
+       *
+       * <code>double pool_utilization = 4 [json_name = "poolUtilization"];</code>
+       * @param value The poolUtilization to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPoolUtilization(double value) {
+        
+        poolUtilization_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * The ratio of the pending transactions to the pool capacity.
+       * </pre>
+       *
+       * <code>double pool_utilization = 4 [json_name = "poolUtilization"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPoolUtilization() {
+        
+        poolUtilization_ = 0D;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:pactus.EstimateFeeResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:pactus.EstimateFeeResponse)
+    private static final pactus.transaction.TransactionOuterClass.EstimateFeeResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.EstimateFeeResponse();
+    }
+
+    public static pactus.transaction.TransactionOuterClass.EstimateFeeResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<EstimateFeeResponse>
+        PARSER = new com.google.protobuf.AbstractParser<EstimateFeeResponse>() {
+      @java.lang.Override
+      public EstimateFeeResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        Builder builder = newBuilder();
+        try {
+          builder.mergeFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.setUnfinishedMessage(builder.buildPartial());
+        } catch (com.google.protobuf.UninitializedMessageException e) {
+          throw e.asInvalidProtocolBufferException().setUnfinishedMessage(builder.buildPartial());
+        } catch (java.io.IOException e) {
+          throw new com.google.protobuf.InvalidProtocolBufferException(e)
+              .setUnfinishedMessage(builder.buildPartial());
+        }
+        return builder.buildPartial();
+      }
+    };
+
+    public static com.google.protobuf.Parser<EstimateFeeResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<EstimateFeeResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public pactus.transaction.TransactionOuterClass.EstimateFeeResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SendRawTransactionRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.SendRawTransactionRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>bytes data = 1 [json_name = "data"];</code>
+     * @return The data.
+     */
+    com.google.protobuf.ByteString getData();
+  }
+  /**
+   * Protobuf type {@code pactus.SendRawTransactionRequest}
+   */
+  public static final class SendRawTransactionRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:pactus.SendRawTransactionRequest)
+      SendRawTransactionRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SendRawTransactionRequest.newBuilder() to construct.
+    private SendRawTransactionRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SendRawTransactionRequest() {
+      data_ = com.google.protobuf.ByteString.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SendRawTransactionRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.class, pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.Builder.class);
+    }
+
+    public static final int DATA_FIELD_NUMBER = 1;
+    private com.google.protobuf.ByteString data_;
+    /**
+     * <code>bytes data = 1 [json_name = "data"];</code>
+     * @return The data.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString getData() {
+      return data_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!data_.isEmpty()) {
+        output.writeBytes(1, data_);
+      }
+      getUnknownFields().writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!data_.isEmpty()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBytesSize(1, data_);
+      }
+      size += getUnknownFields().getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionRequest)) {
+        return super.equals(obj);
+      }
+      pactus.transaction.TransactionOuterClass.SendRawTransactionRequest other = (pactus.transaction.TransactionOuterClass.SendRawTransactionRequest) obj;
+
+      if (!getData()
+          .equals(other.getData())) return false;
+      if (!getUnknownFields().equals(other.getUnknownFields())) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + DATA_FIELD_NUMBER;
+      hash = (53 * hash) + getData().hashCode();
+      hash = (29 * hash) + getUnknownFields().hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.SendRawTransactionRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code pactus.SendRawTransactionRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:pactus.SendRawTransactionRequest)
+        pactus.transaction.TransactionOuterClass.SendRawTransactionRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.class, pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.Builder.class);
+      }
+
+      // Construct using pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.newBuilder()
+      private Builder() {
+
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        data_ = com.google.protobuf.ByteString.EMPTY;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest build() {
+        pactus.transaction.TransactionOuterClass.SendRawTransactionRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest buildPartial() {
+        pactus.transaction.TransactionOuterClass.SendRawTransactionRequest result = new pactus.transaction.TransactionOuterClass.SendRawTransactionRequest(this);
+        result.data_ = data_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionRequest) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.SendRawTransactionRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.SendRawTransactionRequest other) {
+        if (other == pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.getDefaultInstance()) return this;
+        if (other.getData() != com.google.protobuf.ByteString.EMPTY) {
+          setData(other.getData());
+        }
+        this.mergeUnknownFields(other.getUnknownFields());
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        if (extensionRegistry == null) {
+          throw new java.lang.NullPointerException();
+        }
+        try {
+          boolean done = false;
+          while (!done) {
+            int tag = input.readTag();
+            switch (tag) {
+              case 0:
+                done = true;
+                break;
+              case 10: {
+                data_ = input.readBytes();
+
+                break;
+              } // case 10
+              default: {
+                if (!super.parseUnknownField(input, extensionRegistry, tag)) {
+                  done = true; // was an endgroup tag
+                }
+                break;
+              } // default:
+            } // switch (tag)
+          } // while (!done)
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          throw e.unwrapIOException();
+        } finally {
+          onChanged();
+        } // finally
         return this;
       }
+
+      private com.google.protobuf.ByteString data_ = com.google.protobuf.ByteString.EMPTY;
       /**
-       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-       * @return The payloadType.
+       * <code>bytes data = 1 [json_name = "data"];</code>
+       * @return The data.
        */
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.PayloadType getPayloadType() {
-        @SuppressWarnings("deprecation")
-        pactus.transaction.TransactionOuterClass.PayloadType result = pactus.transaction.TransactionOuterClass.PayloadType.valueOf(payloadType_);
-        return result == null ? pactus.transaction.TransactionOuterClass.PayloadType.UNRECOGNIZED : result;
+      public com.google.protobuf.ByteString getData() {
+        return data_;
       }
       /**
-       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
-       * @param value The payloadType to set.
+       * <code>bytes data = 1 [json_name = "data"];</code>
+       * @param value The data to set.
        * @return This builder for chaining.
        */
-      public Builder setPayloadType(pactus.transaction.TransactionOuterClass.PayloadType value) {
+      public Builder setData(com.google.protobuf.ByteString value) {
         if (value == null) {
-          throw new NullPointerException();
-        }
-        
-        payloadType_ = value.getNumber();
+    throw new NullPointerException();
+  }
+  
+        data_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>.pactus.PayloadType payloadType = 2 [json_name = "payloadType"];</code>
+       * <code>bytes data = 1 [json_name = "data"];</code>
        * @return This builder for chaining.
        */
-      public Builder clearPayloadType() {
+      public Builder clearData() {
         
-        payloadType_ = 0;
+        data_ = getDefaultInstance().getData();
         onChanged();
         return this;
       }
@@ -2109,23 +7213,23 @@ public final class TransactionOuterClass {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:pactus.CalculateFeeRequest)
+      // @@protoc_insertion_point(builder_scope:pactus.SendRawTransactionRequest)
     }
 
-    // @@protoc_insertion_point(class_scope:pactus.CalculateFeeRequest)
-    private static final pactus.transaction.TransactionOuterClass.CalculateFeeRequest DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:pactus.SendRawTransactionRequest)
+    private static final pactus.transaction.TransactionOuterClass.SendRawTransactionRequest DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.CalculateFeeRequest();
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.SendRawTransactionRequest();
     }
 
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeRequest getDefaultInstance() {
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<CalculateFeeRequest>
-        PARSER = new com.google.protobuf.AbstractParser<CalculateFeeRequest>() {
+    private static final com.google.protobuf.Parser<SendRawTransactionRequest>
+        PARSER = new com.google.protobuf.AbstractParser<SendRawTransactionRequest>() {
       @java.lang.Override
-      public CalculateFeeRequest parsePartialFrom(
+      public SendRawTransactionRequest parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -2144,52 +7248,53 @@ public final class TransactionOuterClass {
       }
     };
 
-    public static com.google.protobuf.Parser<CalculateFeeRequest> parser() {
+    public static com.google.protobuf.Parser<SendRawTransactionRequest> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<CalculateFeeRequest> getParserForType() {
+    public com.google.protobuf.Parser<SendRawTransactionRequest> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public pactus.transaction.TransactionOuterClass.CalculateFeeRequest getDefaultInstanceForType() {
+    public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface CalculateFeeResponseOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:pactus.CalculateFeeResponse)
+  public interface SendRawTransactionResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.SendRawTransactionResponse)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>int64 fee = 1 [json_name = "fee"];</code>
-     * @return The fee.
+     * <code>bytes id = 2 [json_name = "id"];</code>
+     * @return The id.
      */
-    long getFee();
+    com.google.protobuf.ByteString getId();
   }
   /**
-   * Protobuf type {@code pactus.CalculateFeeResponse}
+   * Protobuf type {@code pactus.SendRawTransactionResponse}
    */
-  public static final class CalculateFeeResponse extends
+  public static final class SendRawTransactionResponse extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:pactus.CalculateFeeResponse)
-      CalculateFeeResponseOrBuilder {
+      // @@protoc_insertion_point(message_implements:pactus.SendRawTransactionResponse)
+      SendRawTransactionResponseOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use CalculateFeeResponse.newBuilder() to construct.
-    private CalculateFeeResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use SendRawTransactionResponse.newBuilder() to construct.
+    private SendRawTransactionResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private CalculateFeeResponse() {
+    private SendRawTransactionResponse() {
+      id_ = com.google.protobuf.ByteString.EMPTY;
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new CalculateFeeResponse();
+      return new SendRawTransactionResponse();
     }
 
     @java.lang.Override
@@ -2199,26 +7304,26 @@ public final class TransactionOuterClass {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_descriptor;
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_fieldAccessorTable
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              pactus.transaction.TransactionOuterClass.CalculateFeeResponse.class, pactus.transaction.TransactionOuterClass.CalculateFeeResponse.Builder.class);
+              pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.class, pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.Builder.class);
     }
 
-    public static final int FEE_FIELD_NUMBER = 1;
-    private long fee_;
+    public static final int ID_FIELD_NUMBER = 2;
+    private com.google.protobuf.ByteString id_;
     /**
-     * <code>int64 fee = 1 [json_name = "fee"];</code>
-     * @return The fee.
+     * <code>bytes id = 2 [json_name = "id"];</code>
+     * @return The id.
      */
     @java.lang.Override
-    public long getFee() {
-      return fee_;
+    public com.google.protobuf.ByteString getId() {
+      return id_;
     }
 
     private byte memoizedIsInitialized = -1;
@@ -2235,8 +7340,8 @@ public final class TransactionOuterClass {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (fee_ != 0L) {
-        output.writeInt64(1, fee_);
+      if (!id_.isEmpty()) {
+        output.writeBytes(2, id_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -2247,9 +7352,9 @@ public final class TransactionOuterClass {
       if (size != -1) return size;
 
       size = 0;
-      if (fee_ != 0L) {
+      if (!id_.isEmpty()) {
         size += com.google.protobuf.CodedOutputStream
-          .computeInt64Size(1, fee_);
+          .computeBytesSize(2, id_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -2261,13 +7366,13 @@ public final class TransactionOuterClass {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof pactus.transaction.TransactionOuterClass.CalculateFeeResponse)) {
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionResponse)) {
         return super.equals(obj);
       }
-      pactus.transaction.TransactionOuterClass.CalculateFeeResponse other = (pactus.transaction.TransactionOuterClass.CalculateFeeResponse) obj;
+      pactus.transaction.TransactionOuterClass.SendRawTransactionResponse other = (pactus.transaction.TransactionOuterClass.SendRawTransactionResponse) obj;
 
-      if (getFee()
-          != other.getFee()) return false;
+      if (!getId()
+          .equals(other.getId())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -2279,77 +7384,76 @@ public final class TransactionOuterClass {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + FEE_FIELD_NUMBER;
-      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-          getFee());
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(byte[] data)
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseDelimitedFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseDelimitedFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -2362,7 +7466,7 @@ public final class TransactionOuterClass {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.CalculateFeeResponse prototype) {
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.SendRawTransactionResponse prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -2378,26 +7482,26 @@ public final class TransactionOuterClass {
       return builder;
     }
     /**
-     * Protobuf type {@code pactus.CalculateFeeResponse}
+     * Protobuf type {@code pactus.SendRawTransactionResponse}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:pactus.CalculateFeeResponse)
-        pactus.transaction.TransactionOuterClass.CalculateFeeResponseOrBuilder {
+        // @@protoc_insertion_point(builder_implements:pactus.SendRawTransactionResponse)
+        pactus.transaction.TransactionOuterClass.SendRawTransactionResponseOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_fieldAccessorTable
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                pactus.transaction.TransactionOuterClass.CalculateFeeResponse.class, pactus.transaction.TransactionOuterClass.CalculateFeeResponse.Builder.class);
+                pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.class, pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.Builder.class);
       }
 
-      // Construct using pactus.transaction.TransactionOuterClass.CalculateFeeResponse.newBuilder()
+      // Construct using pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.newBuilder()
       private Builder() {
 
       }
@@ -2410,7 +7514,7 @@ public final class TransactionOuterClass {
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        fee_ = 0L;
+        id_ = com.google.protobuf.ByteString.EMPTY;
 
         return this;
       }
@@ -2418,17 +7522,17 @@ public final class TransactionOuterClass {
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_CalculateFeeResponse_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_descriptor;
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.CalculateFeeResponse getDefaultInstanceForType() {
-        return pactus.transaction.TransactionOuterClass.CalculateFeeResponse.getDefaultInstance();
+      public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.getDefaultInstance();
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.CalculateFeeResponse build() {
-        pactus.transaction.TransactionOuterClass.CalculateFeeResponse result = buildPartial();
+      public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse build() {
+        pactus.transaction.TransactionOuterClass.SendRawTransactionResponse result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -2436,9 +7540,9 @@ public final class TransactionOuterClass {
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.CalculateFeeResponse buildPartial() {
-        pactus.transaction.TransactionOuterClass.CalculateFeeResponse result = new pactus.transaction.TransactionOuterClass.CalculateFeeResponse(this);
-        result.fee_ = fee_;
+      public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse buildPartial() {
+        pactus.transaction.TransactionOuterClass.SendRawTransactionResponse result = new pactus.transaction.TransactionOuterClass.SendRawTransactionResponse(this);
+        result.id_ = id_;
         onBuilt();
         return result;
       }
@@ -2477,18 +7581,18 @@ public final class TransactionOuterClass {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pactus.transaction.TransactionOuterClass.CalculateFeeResponse) {
-          return mergeFrom((pactus.transaction.TransactionOuterClass.CalculateFeeResponse)other);
+        if (other instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionResponse) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.SendRawTransactionResponse)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.CalculateFeeResponse other) {
-        if (other == pactus.transaction.TransactionOuterClass.CalculateFeeResponse.getDefaultInstance()) return this;
-        if (other.getFee() != 0L) {
-          setFee(other.getFee());
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.SendRawTransactionResponse other) {
+        if (other == pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.getDefaultInstance()) return this;
+        if (other.getId() != com.google.protobuf.ByteString.EMPTY) {
+          setId(other.getId());
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -2516,11 +7620,11 @@ public final class TransactionOuterClass {
               case 0:
                 done = true;
                 break;
-              case 8: {
-                fee_ = input.readInt64();
+              case 18: {
+                id_ = input.readBytes();
 
                 break;
-              } // case 8
+              } // case 18
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -2537,33 +7641,36 @@ public final class TransactionOuterClass {
         return this;
       }
 
-      private long fee_ ;
+      private com.google.protobuf.ByteString id_ = com.google.protobuf.ByteString.EMPTY;
       /**
-       * <code>int64 fee = 1 [json_name = "fee"];</code>
-       * @return The fee.
+       * <code>bytes id = 2 [json_name = "id"];</code>
+       * @return The id.
        */
       @java.lang.Override
-      public long getFee() {
-        return fee_;
+      public com.google.protobuf.ByteString getId() {
+        return id_;
       }
       /**
-       * <code>int64 fee = 1 [json_name = "fee"];</code>
-       * @param value The fee to set.
+       * <code>bytes id = 2 [json_name = "id"];</code>
+       * @param value The id to set.
        * @return This builder for chaining.
        */
-      public Builder setFee(long value) {
-        
-        fee_ = value;
+      public Builder setId(com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  
+        id_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>int64 fee = 1 [json_name = "fee"];</code>
+       * <code>bytes id = 2 [json_name = "id"];</code>
        * @return This builder for chaining.
        */
-      public Builder clearFee() {
+      public Builder clearId() {
         
-        fee_ = 0L;
+        id_ = getDefaultInstance().getId();
         onChanged();
         return this;
       }
@@ -2580,23 +7687,23 @@ public final class TransactionOuterClass {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:pactus.CalculateFeeResponse)
+      // @@protoc_insertion_point(builder_scope:pactus.SendRawTransactionResponse)
     }
 
-    // @@protoc_insertion_point(class_scope:pactus.CalculateFeeResponse)
-    private static final pactus.transaction.TransactionOuterClass.CalculateFeeResponse DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:pactus.SendRawTransactionResponse)
+    private static final pactus.transaction.TransactionOuterClass.SendRawTransactionResponse DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.CalculateFeeResponse();
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.SendRawTransactionResponse();
     }
 
-    public static pactus.transaction.TransactionOuterClass.CalculateFeeResponse getDefaultInstance() {
+    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<CalculateFeeResponse>
-        PARSER = new com.google.protobuf.AbstractParser<CalculateFeeResponse>() {
+    private static final com.google.protobuf.Parser<SendRawTransactionResponse>
+        PARSER = new com.google.protobuf.AbstractParser<SendRawTransactionResponse>() {
       @java.lang.Override
-      public CalculateFeeResponse parsePartialFrom(
+      public SendRawTransactionResponse parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -2615,53 +7722,46 @@ public final class TransactionOuterClass {
       }
     };
 
-    public static com.google.protobuf.Parser<CalculateFeeResponse> parser() {
+    public static com.google.protobuf.Parser<SendRawTransactionResponse> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<CalculateFeeResponse> getParserForType() {
+    public com.google.protobuf.Parser<SendRawTransactionResponse> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public pactus.transaction.TransactionOuterClass.CalculateFeeResponse getDefaultInstanceForType() {
+    public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface SendRawTransactionRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:pactus.SendRawTransactionRequest)
+  public interface GetTxPoolStatsRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetTxPoolStatsRequest)
       com.google.protobuf.MessageOrBuilder {
-
-    /**
-     * <code>bytes data = 1 [json_name = "data"];</code>
-     * @return The data.
-     */
-    com.google.protobuf.ByteString getData();
   }
   /**
-   * Protobuf type {@code pactus.SendRawTransactionRequest}
+   * Protobuf type {@code pactus.GetTxPoolStatsRequest}
    */
-  public static final class SendRawTransactionRequest extends
+  public static final class GetTxPoolStatsRequest extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:pactus.SendRawTransactionRequest)
-      SendRawTransactionRequestOrBuilder {
+      // @@protoc_insertion_point(message_implements:pactus.GetTxPoolStatsRequest)
+      GetTxPoolStatsRequestOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use SendRawTransactionRequest.newBuilder() to construct.
-    private SendRawTransactionRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use GetTxPoolStatsRequest.newBuilder() to construct.
+    private GetTxPoolStatsRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private SendRawTransactionRequest() {
-      data_ = com.google.protobuf.ByteString.EMPTY;
+    private GetTxPoolStatsRequest() {
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new SendRawTransactionRequest();
+      return new GetTxPoolStatsRequest();
     }
 
     @java.lang.Override
@@ -2671,26 +7771,15 @@ public final class TransactionOuterClass {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_descriptor;
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsRequest_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_fieldAccessorTable
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsRequest_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.class, pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.Builder.class);
-    }
-
-    public static final int DATA_FIELD_NUMBER = 1;
-    private com.google.protobuf.ByteString data_;
-    /**
-     * <code>bytes data = 1 [json_name = "data"];</code>
-     * @return The data.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString getData() {
-      return data_;
+              pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.class, pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.Builder.class);
     }
 
     private byte memoizedIsInitialized = -1;
@@ -2707,9 +7796,6 @@ public final class TransactionOuterClass {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!data_.isEmpty()) {
-        output.writeBytes(1, data_);
-      }
       getUnknownFields().writeTo(output);
     }
 
@@ -2719,10 +7805,6 @@ public final class TransactionOuterClass {
       if (size != -1) return size;
 
       size = 0;
-      if (!data_.isEmpty()) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeBytesSize(1, data_);
-      }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
       return size;
@@ -2733,13 +7815,11 @@ public final class TransactionOuterClass {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionRequest)) {
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest)) {
         return super.equals(obj);
       }
-      pactus.transaction.TransactionOuterClass.SendRawTransactionRequest other = (pactus.transaction.TransactionOuterClass.SendRawTransactionRequest) obj;
+      pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest other = (pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest) obj;
 
-      if (!getData()
-          .equals(other.getData())) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -2751,76 +7831,74 @@ public final class TransactionOuterClass {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + DATA_FIELD_NUMBER;
-      hash = (53 * hash) + getData().hashCode();
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(byte[] data)
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseDelimitedFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseDelimitedFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -2833,7 +7911,7 @@ public final class TransactionOuterClass {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.SendRawTransactionRequest prototype) {
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -2849,26 +7927,26 @@ public final class TransactionOuterClass {
       return builder;
     }
     /**
-     * Protobuf type {@code pactus.SendRawTransactionRequest}
+     * Protobuf type {@code pactus.GetTxPoolStatsRequest}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:pactus.SendRawTransactionRequest)
-        pactus.transaction.TransactionOuterClass.SendRawTransactionRequestOrBuilder {
+        // @@protoc_insertion_point(builder_implements:pactus.GetTxPoolStatsRequest)
+        pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequestOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsRequest_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_fieldAccessorTable
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsRequest_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.class, pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.Builder.class);
+                pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.class, pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.Builder.class);
       }
 
-      // Construct using pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.newBuilder()
+      // Construct using pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.newBuilder()
       private Builder() {
 
       }
@@ -2881,25 +7959,23 @@ public final class TransactionOuterClass {
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        data_ = com.google.protobuf.ByteString.EMPTY;
-
         return this;
       }
 
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionRequest_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsRequest_descriptor;
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest getDefaultInstanceForType() {
-        return pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.getDefaultInstance();
+      public pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.getDefaultInstance();
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest build() {
-        pactus.transaction.TransactionOuterClass.SendRawTransactionRequest result = buildPartial();
+      public pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest build() {
+        pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -2907,9 +7983,8 @@ public final class TransactionOuterClass {
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest buildPartial() {
-        pactus.transaction.TransactionOuterClass.SendRawTransactionRequest result = new pactus.transaction.TransactionOuterClass.SendRawTransactionRequest(this);
-        result.data_ = data_;
+      public pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest buildPartial() {
+        pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest result = new pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest(this);
         onBuilt();
         return result;
       }
@@ -2948,19 +8023,16 @@ public final class TransactionOuterClass {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionRequest) {
-          return mergeFrom((pactus.transaction.TransactionOuterClass.SendRawTransactionRequest)other);
+        if (other instanceof pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.SendRawTransactionRequest other) {
-        if (other == pactus.transaction.TransactionOuterClass.SendRawTransactionRequest.getDefaultInstance()) return this;
-        if (other.getData() != com.google.protobuf.ByteString.EMPTY) {
-          setData(other.getData());
-        }
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest other) {
+        if (other == pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest.getDefaultInstance()) return this;
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
         return this;
@@ -2987,11 +8059,6 @@ public final class TransactionOuterClass {
               case 0:
                 done = true;
                 break;
-              case 10: {
-                data_ = input.readBytes();
-
-                break;
-              } // case 10
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -3007,40 +8074,6 @@ public final class TransactionOuterClass {
         } // finally
         return this;
       }
-
-      private com.google.protobuf.ByteString data_ = com.google.protobuf.ByteString.EMPTY;
-      /**
-       * <code>bytes data = 1 [json_name = "data"];</code>
-       * @return The data.
-       */
-      @java.lang.Override
-      public com.google.protobuf.ByteString getData() {
-        return data_;
-      }
-      /**
-       * <code>bytes data = 1 [json_name = "data"];</code>
-       * @param value The data to set.
-       * @return This builder for chaining.
-       */
-      public Builder setData(com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        data_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>bytes data = 1 [json_name = "data"];</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearData() {
-        
-        data_ = getDefaultInstance().getData();
-        onChanged();
-        return this;
-      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -3054,23 +8087,23 @@ public final class TransactionOuterClass {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:pactus.SendRawTransactionRequest)
+      // @@protoc_insertion_point(builder_scope:pactus.GetTxPoolStatsRequest)
     }
 
-    // @@protoc_insertion_point(class_scope:pactus.SendRawTransactionRequest)
-    private static final pactus.transaction.TransactionOuterClass.SendRawTransactionRequest DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:pactus.GetTxPoolStatsRequest)
+    private static final pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.SendRawTransactionRequest();
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest();
     }
 
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionRequest getDefaultInstance() {
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<SendRawTransactionRequest>
-        PARSER = new com.google.protobuf.AbstractParser<SendRawTransactionRequest>() {
+    private static final com.google.protobuf.Parser<GetTxPoolStatsRequest>
+        PARSER = new com.google.protobuf.AbstractParser<GetTxPoolStatsRequest>() {
       @java.lang.Override
-      public SendRawTransactionRequest parsePartialFrom(
+      public GetTxPoolStatsRequest parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -3089,53 +8122,104 @@ public final class TransactionOuterClass {
       }
     };
 
-    public static com.google.protobuf.Parser<SendRawTransactionRequest> parser() {
+    public static com.google.protobuf.Parser<GetTxPoolStatsRequest> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<SendRawTransactionRequest> getParserForType() {
+    public com.google.protobuf.Parser<GetTxPoolStatsRequest> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public pactus.transaction.TransactionOuterClass.SendRawTransactionRequest getDefaultInstanceForType() {
+    public pactus.transaction.TransactionOuterClass.GetTxPoolStatsRequest getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
   }
 
-  public interface SendRawTransactionResponseOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:pactus.SendRawTransactionResponse)
+  public interface GetTxPoolStatsResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:pactus.GetTxPoolStatsResponse)
       com.google.protobuf.MessageOrBuilder {
 
     /**
-     * <code>bytes id = 2 [json_name = "id"];</code>
-     * @return The id.
+     * <code>int32 total = 1 [json_name = "total"];</code>
+     * @return The total.
      */
-    com.google.protobuf.ByteString getId();
+    int getTotal();
+
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+    int getPerTypeCount();
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+    boolean containsPerType(
+        int key);
+    /**
+     * Use {@link #getPerTypeMap()} instead.
+     */
+    @java.lang.Deprecated
+    java.util.Map<java.lang.Integer, java.lang.Integer>
+    getPerType();
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+    java.util.Map<java.lang.Integer, java.lang.Integer>
+    getPerTypeMap();
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+
+    int getPerTypeOrDefault(
+        int key,
+        int defaultValue);
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+
+    int getPerTypeOrThrow(
+        int key);
+
+    /**
+     * <code>int32 capacity = 3 [json_name = "capacity"];</code>
+     * @return The capacity.
+     */
+    int getCapacity();
+
+    /**
+     * <code>double utilization = 4 [json_name = "utilization"];</code>
+     * @return The utilization.
+     */
+    double getUtilization();
+
+    /**
+     * <code>int64 oldest_tx_age_ms = 5 [json_name = "oldestTxAgeMs"];</code>
+     * @return The oldestTxAgeMs.
+     */
+    long getOldestTxAgeMs();
   }
   /**
-   * Protobuf type {@code pactus.SendRawTransactionResponse}
+   * Protobuf type {@code pactus.GetTxPoolStatsResponse}
    */
-  public static final class SendRawTransactionResponse extends
+  public static final class GetTxPoolStatsResponse extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:pactus.SendRawTransactionResponse)
-      SendRawTransactionResponseOrBuilder {
+      // @@protoc_insertion_point(message_implements:pactus.GetTxPoolStatsResponse)
+      GetTxPoolStatsResponseOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use SendRawTransactionResponse.newBuilder() to construct.
-    private SendRawTransactionResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use GetTxPoolStatsResponse.newBuilder() to construct.
+    private GetTxPoolStatsResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private SendRawTransactionResponse() {
-      id_ = com.google.protobuf.ByteString.EMPTY;
+    private GetTxPoolStatsResponse() {
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new SendRawTransactionResponse();
+      return new GetTxPoolStatsResponse();
     }
 
     @java.lang.Override
@@ -3145,26 +8229,152 @@ public final class TransactionOuterClass {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_descriptor;
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsResponse_descriptor;
     }
 
+    @SuppressWarnings({"rawtypes"})
+    @java.lang.Override
+    protected com.google.protobuf.MapField internalGetMapField(
+        int number) {
+      switch (number) {
+        case 2:
+          return internalGetPerType();
+        default:
+          throw new RuntimeException(
+              "Invalid map field number: " + number);
+      }
+    }
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_fieldAccessorTable
+      return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsResponse_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.class, pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.Builder.class);
+              pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.class, pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.Builder.class);
     }
 
-    public static final int ID_FIELD_NUMBER = 2;
-    private com.google.protobuf.ByteString id_;
+    public static final int TOTAL_FIELD_NUMBER = 1;
+    private int total_;
     /**
-     * <code>bytes id = 2 [json_name = "id"];</code>
-     * @return The id.
+     * <code>int32 total = 1 [json_name = "total"];</code>
+     * @return The total.
      */
     @java.lang.Override
-    public com.google.protobuf.ByteString getId() {
-      return id_;
+    public int getTotal() {
+      return total_;
+    }
+
+    public static final int PER_TYPE_FIELD_NUMBER = 2;
+    private static final class PerTypeDefaultEntryHolder {
+      static final com.google.protobuf.MapEntry<
+          java.lang.Integer, java.lang.Integer> defaultEntry =
+              com.google.protobuf.MapEntry
+              .<java.lang.Integer, java.lang.Integer>newDefaultInstance(
+                  pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsResponse_PerTypeEntry_descriptor, 
+                  com.google.protobuf.WireFormat.FieldType.INT32,
+                  0,
+                  com.google.protobuf.WireFormat.FieldType.INT32,
+                  0);
+    }
+    private com.google.protobuf.MapField<
+        java.lang.Integer, java.lang.Integer> perType_;
+    private com.google.protobuf.MapField<java.lang.Integer, java.lang.Integer>
+    internalGetPerType() {
+      if (perType_ == null) {
+        return com.google.protobuf.MapField.emptyMapField(
+            PerTypeDefaultEntryHolder.defaultEntry);
+      }
+      return perType_;
+    }
+
+    public int getPerTypeCount() {
+      return internalGetPerType().getMap().size();
+    }
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+
+    @java.lang.Override
+    public boolean containsPerType(
+        int key) {
+      
+      return internalGetPerType().getMap().containsKey(key);
+    }
+    /**
+     * Use {@link #getPerTypeMap()} instead.
+     */
+    @java.lang.Override
+    @java.lang.Deprecated
+    public java.util.Map<java.lang.Integer, java.lang.Integer> getPerType() {
+      return getPerTypeMap();
+    }
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+    @java.lang.Override
+
+    public java.util.Map<java.lang.Integer, java.lang.Integer> getPerTypeMap() {
+      return internalGetPerType().getMap();
+    }
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+    @java.lang.Override
+
+    public int getPerTypeOrDefault(
+        int key,
+        int defaultValue) {
+      
+      java.util.Map<java.lang.Integer, java.lang.Integer> map =
+          internalGetPerType().getMap();
+      return map.containsKey(key) ? map.get(key) : defaultValue;
+    }
+    /**
+     * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+     */
+    @java.lang.Override
+
+    public int getPerTypeOrThrow(
+        int key) {
+      
+      java.util.Map<java.lang.Integer, java.lang.Integer> map =
+          internalGetPerType().getMap();
+      if (!map.containsKey(key)) {
+        throw new java.lang.IllegalArgumentException();
+      }
+      return map.get(key);
+    }
+
+    public static final int CAPACITY_FIELD_NUMBER = 3;
+    private int capacity_;
+    /**
+     * <code>int32 capacity = 3 [json_name = "capacity"];</code>
+     * @return The capacity.
+     */
+    @java.lang.Override
+    public int getCapacity() {
+      return capacity_;
+    }
+
+    public static final int UTILIZATION_FIELD_NUMBER = 4;
+    private double utilization_;
+    /**
+     * <code>double utilization = 4 [json_name = "utilization"];</code>
+     * @return The utilization.
+     */
+    @java.lang.Override
+    public double getUtilization() {
+      return utilization_;
+    }
+
+    public static final int OLDEST_TX_AGE_MS_FIELD_NUMBER = 5;
+    private long oldestTxAgeMs_;
+    /**
+     * <code>int64 oldest_tx_age_ms = 5 [json_name = "oldestTxAgeMs"];</code>
+     * @return The oldestTxAgeMs.
+     */
+    @java.lang.Override
+    public long getOldestTxAgeMs() {
+      return oldestTxAgeMs_;
     }
 
     private byte memoizedIsInitialized = -1;
@@ -3181,8 +8391,23 @@ public final class TransactionOuterClass {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (!id_.isEmpty()) {
-        output.writeBytes(2, id_);
+      if (total_ != 0) {
+        output.writeInt32(1, total_);
+      }
+      com.google.protobuf.GeneratedMessageV3
+        .serializeIntegerMapTo(
+          output,
+          internalGetPerType(),
+          PerTypeDefaultEntryHolder.defaultEntry,
+          2);
+      if (capacity_ != 0) {
+        output.writeInt32(3, capacity_);
+      }
+      if (java.lang.Double.doubleToRawLongBits(utilization_) != 0) {
+        output.writeDouble(4, utilization_);
+      }
+      if (oldestTxAgeMs_ != 0L) {
+        output.writeInt64(5, oldestTxAgeMs_);
       }
       getUnknownFields().writeTo(output);
     }
@@ -3193,9 +8418,31 @@ public final class TransactionOuterClass {
       if (size != -1) return size;
 
       size = 0;
-      if (!id_.isEmpty()) {
+      if (total_ != 0) {
         size += com.google.protobuf.CodedOutputStream
-          .computeBytesSize(2, id_);
+          .computeInt32Size(1, total_);
+      }
+      for (java.util.Map.Entry<java.lang.Integer, java.lang.Integer> entry
+           : internalGetPerType().getMap().entrySet()) {
+        com.google.protobuf.MapEntry<java.lang.Integer, java.lang.Integer>
+        perType__ = PerTypeDefaultEntryHolder.defaultEntry.newBuilderForType()
+            .setKey(entry.getKey())
+            .setValue(entry.getValue())
+            .build();
+        size += com.google.protobuf.CodedOutputStream
+            .computeMessageSize(2, perType__);
+      }
+      if (capacity_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(3, capacity_);
+      }
+      if (java.lang.Double.doubleToRawLongBits(utilization_) != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(4, utilization_);
+      }
+      if (oldestTxAgeMs_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(5, oldestTxAgeMs_);
       }
       size += getUnknownFields().getSerializedSize();
       memoizedSize = size;
@@ -3207,13 +8454,22 @@ public final class TransactionOuterClass {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionResponse)) {
+      if (!(obj instanceof pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse)) {
         return super.equals(obj);
       }
-      pactus.transaction.TransactionOuterClass.SendRawTransactionResponse other = (pactus.transaction.TransactionOuterClass.SendRawTransactionResponse) obj;
-
-      if (!getId()
-          .equals(other.getId())) return false;
+      pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse other = (pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse) obj;
+
+      if (getTotal()
+          != other.getTotal()) return false;
+      if (!internalGetPerType().equals(
+          other.internalGetPerType())) return false;
+      if (getCapacity()
+          != other.getCapacity()) return false;
+      if (java.lang.Double.doubleToLongBits(getUtilization())
+          != java.lang.Double.doubleToLongBits(
+              other.getUtilization())) return false;
+      if (getOldestTxAgeMs()
+          != other.getOldestTxAgeMs()) return false;
       if (!getUnknownFields().equals(other.getUnknownFields())) return false;
       return true;
     }
@@ -3225,76 +8481,88 @@ public final class TransactionOuterClass {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + ID_FIELD_NUMBER;
-      hash = (53 * hash) + getId().hashCode();
+      hash = (37 * hash) + TOTAL_FIELD_NUMBER;
+      hash = (53 * hash) + getTotal();
+      if (!internalGetPerType().getMap().isEmpty()) {
+        hash = (37 * hash) + PER_TYPE_FIELD_NUMBER;
+        hash = (53 * hash) + internalGetPerType().hashCode();
+      }
+      hash = (37 * hash) + CAPACITY_FIELD_NUMBER;
+      hash = (53 * hash) + getCapacity();
+      hash = (37 * hash) + UTILIZATION_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getUtilization()));
+      hash = (37 * hash) + OLDEST_TX_AGE_MS_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getOldestTxAgeMs());
       hash = (29 * hash) + getUnknownFields().hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(byte[] data)
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseDelimitedFrom(java.io.InputStream input)
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseDelimitedFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse parseFrom(
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -3307,7 +8575,7 @@ public final class TransactionOuterClass {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.SendRawTransactionResponse prototype) {
+    public static Builder newBuilder(pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -3323,26 +8591,48 @@ public final class TransactionOuterClass {
       return builder;
     }
     /**
-     * Protobuf type {@code pactus.SendRawTransactionResponse}
+     * Protobuf type {@code pactus.GetTxPoolStatsResponse}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:pactus.SendRawTransactionResponse)
-        pactus.transaction.TransactionOuterClass.SendRawTransactionResponseOrBuilder {
+        // @@protoc_insertion_point(builder_implements:pactus.GetTxPoolStatsResponse)
+        pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponseOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsResponse_descriptor;
       }
 
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapField internalGetMapField(
+          int number) {
+        switch (number) {
+          case 2:
+            return internalGetPerType();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
+      @SuppressWarnings({"rawtypes"})
+      protected com.google.protobuf.MapField internalGetMutableMapField(
+          int number) {
+        switch (number) {
+          case 2:
+            return internalGetMutablePerType();
+          default:
+            throw new RuntimeException(
+                "Invalid map field number: " + number);
+        }
+      }
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_fieldAccessorTable
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsResponse_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.class, pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.Builder.class);
+                pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.class, pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.Builder.class);
       }
 
-      // Construct using pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.newBuilder()
+      // Construct using pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.newBuilder()
       private Builder() {
 
       }
@@ -3355,7 +8645,14 @@ public final class TransactionOuterClass {
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        id_ = com.google.protobuf.ByteString.EMPTY;
+        total_ = 0;
+
+        internalGetMutablePerType().clear();
+        capacity_ = 0;
+
+        utilization_ = 0D;
+
+        oldestTxAgeMs_ = 0L;
 
         return this;
       }
@@ -3363,17 +8660,17 @@ public final class TransactionOuterClass {
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return pactus.transaction.TransactionOuterClass.internal_static_pactus_SendRawTransactionResponse_descriptor;
+        return pactus.transaction.TransactionOuterClass.internal_static_pactus_GetTxPoolStatsResponse_descriptor;
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse getDefaultInstanceForType() {
-        return pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.getDefaultInstance();
+      public pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse getDefaultInstanceForType() {
+        return pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.getDefaultInstance();
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse build() {
-        pactus.transaction.TransactionOuterClass.SendRawTransactionResponse result = buildPartial();
+      public pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse build() {
+        pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -3381,9 +8678,14 @@ public final class TransactionOuterClass {
       }
 
       @java.lang.Override
-      public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse buildPartial() {
-        pactus.transaction.TransactionOuterClass.SendRawTransactionResponse result = new pactus.transaction.TransactionOuterClass.SendRawTransactionResponse(this);
-        result.id_ = id_;
+      public pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse buildPartial() {
+        pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse result = new pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse(this);
+        result.total_ = total_;
+        result.perType_ = internalGetPerType();
+        result.perType_.makeImmutable();
+        result.capacity_ = capacity_;
+        result.utilization_ = utilization_;
+        result.oldestTxAgeMs_ = oldestTxAgeMs_;
         onBuilt();
         return result;
       }
@@ -3422,18 +8724,29 @@ public final class TransactionOuterClass {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pactus.transaction.TransactionOuterClass.SendRawTransactionResponse) {
-          return mergeFrom((pactus.transaction.TransactionOuterClass.SendRawTransactionResponse)other);
+        if (other instanceof pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse) {
+          return mergeFrom((pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.SendRawTransactionResponse other) {
-        if (other == pactus.transaction.TransactionOuterClass.SendRawTransactionResponse.getDefaultInstance()) return this;
-        if (other.getId() != com.google.protobuf.ByteString.EMPTY) {
-          setId(other.getId());
+      public Builder mergeFrom(pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse other) {
+        if (other == pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse.getDefaultInstance()) return this;
+        if (other.getTotal() != 0) {
+          setTotal(other.getTotal());
+        }
+        internalGetMutablePerType().mergeFrom(
+            other.internalGetPerType());
+        if (other.getCapacity() != 0) {
+          setCapacity(other.getCapacity());
+        }
+        if (other.getUtilization() != 0D) {
+          setUtilization(other.getUtilization());
+        }
+        if (other.getOldestTxAgeMs() != 0L) {
+          setOldestTxAgeMs(other.getOldestTxAgeMs());
         }
         this.mergeUnknownFields(other.getUnknownFields());
         onChanged();
@@ -3461,11 +8774,34 @@ public final class TransactionOuterClass {
               case 0:
                 done = true;
                 break;
-              case 18: {
-                id_ = input.readBytes();
+              case 8: {
+                total_ = input.readInt32();
 
                 break;
+              } // case 8
+              case 18: {
+                com.google.protobuf.MapEntry<java.lang.Integer, java.lang.Integer>
+                perType__ = input.readMessage(
+                    PerTypeDefaultEntryHolder.defaultEntry.getParserForType(), extensionRegistry);
+                internalGetMutablePerType().getMutableMap().put(
+                    perType__.getKey(), perType__.getValue());
+                break;
               } // case 18
+              case 24: {
+                capacity_ = input.readInt32();
+
+                break;
+              } // case 24
+              case 33: {
+                utilization_ = input.readDouble();
+
+                break;
+              } // case 33
+              case 40: {
+                oldestTxAgeMs_ = input.readInt64();
+
+                break;
+              } // case 40
               default: {
                 if (!super.parseUnknownField(input, extensionRegistry, tag)) {
                   done = true; // was an endgroup tag
@@ -3482,36 +8818,254 @@ public final class TransactionOuterClass {
         return this;
       }
 
-      private com.google.protobuf.ByteString id_ = com.google.protobuf.ByteString.EMPTY;
+      private int total_ ;
       /**
-       * <code>bytes id = 2 [json_name = "id"];</code>
-       * @return The id.
+       * <code>int32 total = 1 [json_name = "total"];</code>
+       * @return The total.
        */
       @java.lang.Override
-      public com.google.protobuf.ByteString getId() {
-        return id_;
+      public int getTotal() {
+        return total_;
       }
       /**
-       * <code>bytes id = 2 [json_name = "id"];</code>
-       * @param value The id to set.
+       * <code>int32 total = 1 [json_name = "total"];</code>
+       * @param value The total to set.
        * @return This builder for chaining.
        */
-      public Builder setId(com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        id_ = value;
+      public Builder setTotal(int value) {
+        
+        total_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>bytes id = 2 [json_name = "id"];</code>
+       * <code>int32 total = 1 [json_name = "total"];</code>
        * @return This builder for chaining.
        */
-      public Builder clearId() {
+      public Builder clearTotal() {
         
-        id_ = getDefaultInstance().getId();
+        total_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.MapField<
+          java.lang.Integer, java.lang.Integer> perType_;
+      private com.google.protobuf.MapField<java.lang.Integer, java.lang.Integer>
+      internalGetPerType() {
+        if (perType_ == null) {
+          return com.google.protobuf.MapField.emptyMapField(
+              PerTypeDefaultEntryHolder.defaultEntry);
+        }
+        return perType_;
+      }
+      private com.google.protobuf.MapField<java.lang.Integer, java.lang.Integer>
+      internalGetMutablePerType() {
+        onChanged();;
+        if (perType_ == null) {
+          perType_ = com.google.protobuf.MapField.newMapField(
+              PerTypeDefaultEntryHolder.defaultEntry);
+        }
+        if (!perType_.isMutable()) {
+          perType_ = perType_.copy();
+        }
+        return perType_;
+      }
+
+      public int getPerTypeCount() {
+        return internalGetPerType().getMap().size();
+      }
+      /**
+       * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+       */
+
+      @java.lang.Override
+      public boolean containsPerType(
+          int key) {
+        
+        return internalGetPerType().getMap().containsKey(key);
+      }
+      /**
+       * Use {@link #getPerTypeMap()} instead.
+       */
+      @java.lang.Override
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.Integer, java.lang.Integer> getPerType() {
+        return getPerTypeMap();
+      }
+      /**
+       * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+       */
+      @java.lang.Override
+
+      public java.util.Map<java.lang.Integer, java.lang.Integer> getPerTypeMap() {
+        return internalGetPerType().getMap();
+      }
+      /**
+       * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+       */
+      @java.lang.Override
+
+      public int getPerTypeOrDefault(
+          int key,
+          int defaultValue) {
+        
+        java.util.Map<java.lang.Integer, java.lang.Integer> map =
+            internalGetPerType().getMap();
+        return map.containsKey(key) ? map.get(key) : defaultValue;
+      }
+      /**
+       * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+       */
+      @java.lang.Override
+
+      public int getPerTypeOrThrow(
+          int key) {
+        
+        java.util.Map<java.lang.Integer, java.lang.Integer> map =
+            internalGetPerType().getMap();
+        if (!map.containsKey(key)) {
+          throw new java.lang.IllegalArgumentException();
+        }
+        return map.get(key);
+      }
+
+      public Builder clearPerType() {
+        internalGetMutablePerType().getMutableMap()
+            .clear();
+        return this;
+      }
+      /**
+       * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+       */
+
+      public Builder removePerType(
+          int key) {
+        
+        internalGetMutablePerType().getMutableMap()
+            .remove(key);
+        return this;
+      }
+      /**
+       * Use alternate mutation accessors instead.
+       */
+      @java.lang.Deprecated
+      public java.util.Map<java.lang.Integer, java.lang.Integer>
+      getMutablePerType() {
+        return internalGetMutablePerType().getMutableMap();
+      }
+      /**
+       * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+       */
+      public Builder putPerType(
+          int key,
+          int value) {
+        
+        
+        internalGetMutablePerType().getMutableMap()
+            .put(key, value);
+        return this;
+      }
+      /**
+       * <code>map&lt;int32, int32&gt; per_type = 2 [json_name = "perType"];</code>
+       */
+
+      public Builder putAllPerType(
+          java.util.Map<java.lang.Integer, java.lang.Integer> values) {
+        internalGetMutablePerType().getMutableMap()
+            .putAll(values);
+        return this;
+      }
+
+      private int capacity_ ;
+      /**
+       * <code>int32 capacity = 3 [json_name = "capacity"];</code>
+       * @return The capacity.
+       */
+      @java.lang.Override
+      public int getCapacity() {
+        return capacity_;
+      }
+      /**
+       * <code>int32 capacity = 3 [json_name = "capacity"];</code>
+       * @param value The capacity to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCapacity(int value) {
+        
+        capacity_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int32 capacity = 3 [json_name = "capacity"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCapacity() {
+        
+        capacity_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private double utilization_ ;
+      /**
+       * <code>double utilization = 4 [json_name = "utilization"];</code>
+       * @return The utilization.
+       */
+      @java.lang.Override
+      public double getUtilization() {
+        return utilization_;
+      }
+      /**
+       * <code>double utilization = 4 [json_name = "utilization"];</code>
+       * @param value The utilization to set.
+       * @return This builder for chaining.
+       */
+      public Builder setUtilization(double value) {
+        
+        utilization_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>double utilization = 4 [json_name = "utilization"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearUtilization() {
+        
+        utilization_ = 0D;
+        onChanged();
+        return this;
+      }
+
+      private long oldestTxAgeMs_ ;
+      /**
+       * <code>int64 oldest_tx_age_ms = 5 [json_name = "oldestTxAgeMs"];</code>
+       * @return The oldestTxAgeMs.
+       */
+      @java.lang.Override
+      public long getOldestTxAgeMs() {
+        return oldestTxAgeMs_;
+      }
+      /**
+       * <code>int64 oldest_tx_age_ms = 5 [json_name = "oldestTxAgeMs"];</code>
+       * @param value The oldestTxAgeMs to set.
+       * @return This builder for chaining.
+       */
+      public Builder setOldestTxAgeMs(long value) {
+        
+        oldestTxAgeMs_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 oldest_tx_age_ms = 5 [json_name = "oldestTxAgeMs"];</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearOldestTxAgeMs() {
+        
+        oldestTxAgeMs_ = 0L;
         onChanged();
         return this;
       }
@@ -3528,23 +9082,23 @@ public final class TransactionOuterClass {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:pactus.SendRawTransactionResponse)
+      // @@protoc_insertion_point(builder_scope:pactus.GetTxPoolStatsResponse)
     }
 
-    // @@protoc_insertion_point(class_scope:pactus.SendRawTransactionResponse)
-    private static final pactus.transaction.TransactionOuterClass.SendRawTransactionResponse DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:pactus.GetTxPoolStatsResponse)
+    private static final pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.SendRawTransactionResponse();
+      DEFAULT_INSTANCE = new pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse();
     }
 
-    public static pactus.transaction.TransactionOuterClass.SendRawTransactionResponse getDefaultInstance() {
+    public static pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<SendRawTransactionResponse>
-        PARSER = new com.google.protobuf.AbstractParser<SendRawTransactionResponse>() {
+    private static final com.google.protobuf.Parser<GetTxPoolStatsResponse>
+        PARSER = new com.google.protobuf.AbstractParser<GetTxPoolStatsResponse>() {
       @java.lang.Override
-      public SendRawTransactionResponse parsePartialFrom(
+      public GetTxPoolStatsResponse parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
@@ -3563,17 +9117,17 @@ public final class TransactionOuterClass {
       }
     };
 
-    public static com.google.protobuf.Parser<SendRawTransactionResponse> parser() {
+    public static com.google.protobuf.Parser<GetTxPoolStatsResponse> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<SendRawTransactionResponse> getParserForType() {
+    public com.google.protobuf.Parser<GetTxPoolStatsResponse> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public pactus.transaction.TransactionOuterClass.SendRawTransactionResponse getDefaultInstanceForType() {
+    public pactus.transaction.TransactionOuterClass.GetTxPoolStatsResponse getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
@@ -9577,6 +15131,26 @@ public final class TransactionOuterClass {
   private static final 
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_pactus_GetTransactionResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetTransactionInclusionRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetTransactionInclusionRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetTransactionInclusionResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetTransactionInclusionResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetTransactionProofRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetTransactionProofRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetTransactionProofResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetTransactionProofResponse_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_pactus_CalculateFeeRequest_descriptor;
   private static final 
@@ -9587,6 +15161,16 @@ public final class TransactionOuterClass {
   private static final 
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_pactus_CalculateFeeResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_EstimateFeeRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_EstimateFeeRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_EstimateFeeResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_EstimateFeeResponse_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_pactus_SendRawTransactionRequest_descriptor;
   private static final 
@@ -9597,6 +15181,21 @@ public final class TransactionOuterClass {
   private static final 
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_pactus_SendRawTransactionResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetTxPoolStatsRequest_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetTxPoolStatsRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetTxPoolStatsResponse_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetTxPoolStatsResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_pactus_GetTxPoolStatsResponse_PerTypeEntry_descriptor;
+  private static final 
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_pactus_GetTxPoolStatsResponse_PerTypeEntry_fieldAccessorTable;
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_pactus_PayloadTransfer_descriptor;
   private static final 
@@ -9643,50 +15242,85 @@ public final class TransactionOuterClass {
       "lock_height\030\014 \001(\rR\013blockHeight\022\035\n\nblock_" +
       "time\030\r \001(\rR\tblockTime\0229\n\013transaction\030\003 \001" +
       "(\0132\027.pactus.TransactionInfoR\013transaction" +
+      "\"0\n\036GetTransactionInclusionRequest\022\016\n\002id" +
+      "\030\001 \001(\014R\002id\"\230\001\n\037GetTransactionInclusionRe" +
+      "sponse\022!\n\014block_height\030\001 \001(\rR\013blockHeigh" +
+      "t\022\035\n\nblock_hash\030\002 \001(\014R\tblockHash\022\035\n\nbloc" +
+      "k_time\030\003 \001(\rR\tblockTime\022\024\n\005index\030\004 \001(\005R\005" +
+      "index\",\n\032GetTransactionProofRequest\022\016\n\002i" +
+      "d\030\001 \001(\014R\002id\"\212\002\n\033GetTransactionProofRespo" +
+      "nse\022!\n\014block_height\030\001 \001(\rR\013blockHeight\022\035" +
+      "\n\nblock_hash\030\002 \001(\014R\tblockHash\022!\n\014block_h" +
+      "eader\030\003 \001(\014R\013blockHeader\022$\n\016prev_cert_ha" +
+      "sh\030\004 \001(\014R\014prevCertHash\022\031\n\010txs_root\030\005 \001(\014" +
+      "R\007txsRoot\022\031\n\010tx_count\030\006 \001(\005R\007txCount\022\024\n\005" +
+      "index\030\007 \001(\005R\005index\022\024\n\005proof\030\010 \003(\014R\005proof" +
       "\"d\n\023CalculateFeeRequest\022\026\n\006amount\030\001 \001(\003R" +
       "\006amount\0225\n\013payloadType\030\002 \001(\0162\023.pactus.Pa" +
       "yloadTypeR\013payloadType\"(\n\024CalculateFeeRe" +
-      "sponse\022\020\n\003fee\030\001 \001(\003R\003fee\"/\n\031SendRawTrans" +
-      "actionRequest\022\022\n\004data\030\001 \001(\014R\004data\",\n\032Sen" +
-      "dRawTransactionResponse\022\016\n\002id\030\002 \001(\014R\002id\"" +
-      "]\n\017PayloadTransfer\022\026\n\006sender\030\001 \001(\tR\006send" +
-      "er\022\032\n\010receiver\030\002 \001(\tR\010receiver\022\026\n\006amount" +
-      "\030\003 \001(\003R\006amount\"W\n\013PayloadBond\022\026\n\006sender\030" +
-      "\001 \001(\tR\006sender\022\032\n\010receiver\030\002 \001(\tR\010receive" +
-      "r\022\024\n\005stake\030\003 \001(\003R\005stake\"B\n\020PayloadSortit" +
-      "ion\022\030\n\007address\030\001 \001(\tR\007address\022\024\n\005proof\030\002" +
-      " \001(\014R\005proof\"-\n\rPayloadUnbond\022\034\n\tvalidato" +
-      "r\030\001 \001(\tR\tvalidator\"M\n\017PayloadWithdraw\022\022\n" +
-      "\004from\030\001 \001(\tR\004from\022\016\n\002to\030\002 \001(\tR\002to\022\026\n\006amo" +
-      "unt\030\003 \001(\003R\006amount\"\253\004\n\017TransactionInfo\022\016\n" +
-      "\002id\030\001 \001(\014R\002id\022\022\n\004data\030\002 \001(\014R\004data\022\030\n\007ver" +
-      "sion\030\003 \001(\005R\007version\022\033\n\tlock_time\030\004 \001(\rR\010" +
-      "lockTime\022\024\n\005value\030\005 \001(\003R\005value\022\020\n\003fee\030\006 " +
-      "\001(\003R\003fee\0225\n\013payloadType\030\007 \001(\0162\023.pactus.P" +
-      "ayloadTypeR\013payloadType\0225\n\010transfer\030\036 \001(" +
-      "\0132\027.pactus.PayloadTransferH\000R\010transfer\022)" +
-      "\n\004bond\030\037 \001(\0132\023.pactus.PayloadBondH\000R\004bon" +
-      "d\0228\n\tsortition\030  \001(\0132\030.pactus.PayloadSor" +
-      "titionH\000R\tsortition\022/\n\006unbond\030! \001(\0132\025.pa" +
-      "ctus.PayloadUnbondH\000R\006unbond\0225\n\010withdraw" +
-      "\030\" \001(\0132\027.pactus.PayloadWithdrawH\000R\010withd" +
-      "raw\022\022\n\004memo\030\010 \001(\tR\004memo\022\035\n\npublic_key\030\t " +
-      "\001(\tR\tpublicKey\022\034\n\tsignature\030\n \001(\014R\tsigna" +
-      "tureB\t\n\007payload*\203\001\n\013PayloadType\022\013\n\007UNKNO" +
-      "WN\020\000\022\024\n\020TRANSFER_PAYLOAD\020\001\022\020\n\014BOND_PAYLO" +
-      "AD\020\002\022\025\n\021SORTITION_PAYLOAD\020\003\022\022\n\016UNBOND_PA" +
-      "YLOAD\020\004\022\024\n\020WITHDRAW_PAYLOAD\020\005*B\n\024Transac" +
-      "tionVerbosity\022\024\n\020TRANSACTION_DATA\020\000\022\024\n\020T" +
-      "RANSACTION_INFO\020\0012\206\002\n\013Transaction\022O\n\016Get" +
-      "Transaction\022\035.pactus.GetTransactionReque" +
-      "st\032\036.pactus.GetTransactionResponse\022I\n\014Ca" +
-      "lculateFee\022\033.pactus.CalculateFeeRequest\032" +
-      "\034.pactus.CalculateFeeResponse\022[\n\022SendRaw" +
-      "Transaction\022!.pactus.SendRawTransactionR" +
-      "equest\032\".pactus.SendRawTransactionRespon" +
-      "seBF\n\022pactus.transactionZ0github.com/pac" +
-      "tus-project/pactus/www/grpc/pactusb\006prot" +
-      "o3"
+      "sponse\022\020\n\003fee\030\001 \001(\003R\003fee\"c\n\022EstimateFeeR" +
+      "equest\022\026\n\006amount\030\001 \001(\003R\006amount\0225\n\013payloa" +
+      "dType\030\002 \001(\0162\023.pactus.PayloadTypeR\013payloa" +
+      "dType\"\214\001\n\023EstimateFeeResponse\022\020\n\003fee\030\001 \001" +
+      "(\003R\003fee\022\027\n\007min_fee\030\002 \001(\003R\006minFee\022\037\n\013pend" +
+      "ing_txs\030\003 \001(\005R\npendingTxs\022)\n\020pool_utiliz" +
+      "ation\030\004 \001(\001R\017poolUtilization\"/\n\031SendRawT" +
+      "ransactionRequest\022\022\n\004data\030\001 \001(\014R\004data\",\n" +
+      "\032SendRawTransactionResponse\022\016\n\002id\030\002 \001(\014R" +
+      "\002id\"\027\n\025GetTxPoolStatsRequest\"\231\002\n\026GetTxPo" +
+      "olStatsResponse\022\024\n\005total\030\001 \001(\005R\005total\022F\n" +
+      "\010per_type\030\002 \003(\0132+.pactus.GetTxPoolStatsR" +
+      "esponse.PerTypeEntryR\007perType\022\032\n\010capacit" +
+      "y\030\003 \001(\005R\010capacity\022 \n\013utilization\030\004 \001(\001R\013" +
+      "utilization\022\'\n\020oldest_tx_age_ms\030\005 \001(\003R\ro" +
+      "ldestTxAgeMs\032:\n\014PerTypeEntry\022\020\n\003key\030\001 \001(" +
+      "\005R\003key\022\024\n\005value\030\002 \001(\005R\005value:\0028\001\"]\n\017Payl" +
+      "oadTransfer\022\026\n\006sender\030\001 \001(\tR\006sender\022\032\n\010r" +
+      "eceiver\030\002 \001(\tR\010receiver\022\026\n\006amount\030\003 \001(\003R" +
+      "\006amount\"W\n\013PayloadBond\022\026\n\006sender\030\001 \001(\tR\006" +
+      "sender\022\032\n\010receiver\030\002 \001(\tR\010receiver\022\024\n\005st" +
+      "ake\030\003 \001(\003R\005stake\"B\n\020PayloadSortition\022\030\n\007" +
+      "address\030\001 \001(\tR\007address\022\024\n\005proof\030\002 \001(\014R\005p" +
+      "roof\"-\n\rPayloadUnbond\022\034\n\tvalidator\030\001 \001(\t" +
+      "R\tvalidator\"M\n\017PayloadWithdraw\022\022\n\004from\030\001" +
+      " \001(\tR\004from\022\016\n\002to\030\002 \001(\tR\002to\022\026\n\006amount\030\003 \001" +
+      "(\003R\006amount\"\253\004\n\017TransactionInfo\022\016\n\002id\030\001 \001" +
+      "(\014R\002id\022\022\n\004data\030\002 \001(\014R\004data\022\030\n\007version\030\003 " +
+      "\001(\005R\007version\022\033\n\tlock_time\030\004 \001(\rR\010lockTim" +
+      "e\022\024\n\005value\030\005 \001(\003R\005value\022\020\n\003fee\030\006 \001(\003R\003fe" +
+      "e\0225\n\013payloadType\030\007 \001(\0162\023.pactus.PayloadT" +
+      "ypeR\013payloadType\0225\n\010transfer\030\036 \001(\0132\027.pac" +
+      "tus.PayloadTransferH\000R\010transfer\022)\n\004bond\030" +
+      "\037 \001(\0132\023.pactus.PayloadBondH\000R\004bond\0228\n\tso" +
+      "rtition\030  \001(\0132\030.pactus.PayloadSortitionH" +
+      "\000R\tsortition\022/\n\006unbond\030! \001(\0132\025.pactus.Pa" +
+      "yloadUnbondH\000R\006unbond\0225\n\010withdraw\030\" \001(\0132" +
+      "\027.pactus.PayloadWithdrawH\000R\010withdraw\022\022\n\004" +
+      "memo\030\010 \001(\tR\004memo\022\035\n\npublic_key\030\t \001(\tR\tpu" +
+      "blicKey\022\034\n\tsignature\030\n \001(\014R\tsignatureB\t\n" +
+      "\007payload*\203\001\n\013PayloadType\022\013\n\007UNKNOWN\020\000\022\024\n" +
+      "\020TRANSFER_PAYLOAD\020\001\022\020\n\014BOND_PAYLOAD\020\002\022\025\n" +
+      "\021SORTITION_PAYLOAD\020\003\022\022\n\016UNBOND_PAYLOAD\020\004" +
+      "\022\024\n\020WITHDRAW_PAYLOAD\020\005*B\n\024TransactionVer" +
+      "bosity\022\024\n\020TRANSACTION_DATA\020\000\022\024\n\020TRANSACT" +
+      "ION_INFO\020\0012\353\004\n\013Transaction\022O\n\016GetTransac" +
+      "tion\022\035.pactus.GetTransactionRequest\032\036.pa" +
+      "ctus.GetTransactionResponse\022j\n\027GetTransa" +
+      "ctionInclusion\022&.pactus.GetTransactionIn" +
+      "clusionRequest\032\'.pactus.GetTransactionIn" +
+      "clusionResponse\022^\n\023GetTransactionProof\022\"" +
+      ".pactus.GetTransactionProofRequest\032#.pac" +
+      "tus.GetTransactionProofResponse\022I\n\014Calcu" +
+      "lateFee\022\033.pactus.CalculateFeeRequest\032\034.p" +
+      "actus.CalculateFeeResponse\022F\n\013EstimateFe" +
+      "e\022\032.pactus.EstimateFeeRequest\032\033.pactus.E" +
+      "stimateFeeResponse\022[\n\022SendRawTransaction" +
+      "\022!.pactus.SendRawTransactionRequest\032\".pa" +
+      "ctus.SendRawTransactionResponse\022O\n\016GetTx" +
+      "PoolStats\022\035.pactus.GetTxPoolStatsRequest" +
+      "\032\036.pactus.GetTxPoolStatsResponseBF\n\022pact" +
+      "us.transactionZ0github.com/pactus-projec" +
+      "t/pactus/www/grpc/pactusb\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
@@ -9704,62 +15338,116 @@ public final class TransactionOuterClass {
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_GetTransactionResponse_descriptor,
         new java.lang.String[] { "BlockHeight", "BlockTime", "Transaction", });
-    internal_static_pactus_CalculateFeeRequest_descriptor =
+    internal_static_pactus_GetTransactionInclusionRequest_descriptor =
       getDescriptor().getMessageTypes().get(2);
+    internal_static_pactus_GetTransactionInclusionRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetTransactionInclusionRequest_descriptor,
+        new java.lang.String[] { "Id", });
+    internal_static_pactus_GetTransactionInclusionResponse_descriptor =
+      getDescriptor().getMessageTypes().get(3);
+    internal_static_pactus_GetTransactionInclusionResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetTransactionInclusionResponse_descriptor,
+        new java.lang.String[] { "BlockHeight", "BlockHash", "BlockTime", "Index", });
+    internal_static_pactus_GetTransactionProofRequest_descriptor =
+      getDescriptor().getMessageTypes().get(4);
+    internal_static_pactus_GetTransactionProofRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetTransactionProofRequest_descriptor,
+        new java.lang.String[] { "Id", });
+    internal_static_pactus_GetTransactionProofResponse_descriptor =
+      getDescriptor().getMessageTypes().get(5);
+    internal_static_pactus_GetTransactionProofResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetTransactionProofResponse_descriptor,
+        new java.lang.String[] { "BlockHeight", "BlockHash", "BlockHeader", "PrevCertHash", "TxsRoot", "TxCount", "Index", "Proof", });
+    internal_static_pactus_CalculateFeeRequest_descriptor =
+      getDescriptor().getMessageTypes().get(6);
     internal_static_pactus_CalculateFeeRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_CalculateFeeRequest_descriptor,
         new java.lang.String[] { "Amount", "PayloadType", });
     internal_static_pactus_CalculateFeeResponse_descriptor =
-      getDescriptor().getMessageTypes().get(3);
+      getDescriptor().getMessageTypes().get(7);
     internal_static_pactus_CalculateFeeResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_CalculateFeeResponse_descriptor,
         new java.lang.String[] { "Fee", });
+    internal_static_pactus_EstimateFeeRequest_descriptor =
+      getDescriptor().getMessageTypes().get(8);
+    internal_static_pactus_EstimateFeeRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_EstimateFeeRequest_descriptor,
+        new java.lang.String[] { "Amount", "PayloadType", });
+    internal_static_pactus_EstimateFeeResponse_descriptor =
+      getDescriptor().getMessageTypes().get(9);
+    internal_static_pactus_EstimateFeeResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_EstimateFeeResponse_descriptor,
+        new java.lang.String[] { "Fee", "MinFee", "PendingTxs", "PoolUtilization", });
     internal_static_pactus_SendRawTransactionRequest_descriptor =
-      getDescriptor().getMessageTypes().get(4);
+      getDescriptor().getMessageTypes().get(10);
     internal_static_pactus_SendRawTransactionRequest_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_SendRawTransactionRequest_descriptor,
         new java.lang.String[] { "Data", });
     internal_static_pactus_SendRawTransactionResponse_descriptor =
-      getDescriptor().getMessageTypes().get(5);
+      getDescriptor().getMessageTypes().get(11);
     internal_static_pactus_SendRawTransactionResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_SendRawTransactionResponse_descriptor,
         new java.lang.String[] { "Id", });
+    internal_static_pactus_GetTxPoolStatsRequest_descriptor =
+      getDescriptor().getMessageTypes().get(12);
+    internal_static_pactus_GetTxPoolStatsRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetTxPoolStatsRequest_descriptor,
+        new java.lang.String[] { });
+    internal_static_pactus_GetTxPoolStatsResponse_descriptor =
+      getDescriptor().getMessageTypes().get(13);
+    internal_static_pactus_GetTxPoolStatsResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetTxPoolStatsResponse_descriptor,
+        new java.lang.String[] { "Total", "PerType", "Capacity", "Utilization", "OldestTxAgeMs", });
+    internal_static_pactus_GetTxPoolStatsResponse_PerTypeEntry_descriptor =
+      internal_static_pactus_GetTxPoolStatsResponse_descriptor.getNestedTypes().get(0);
+    internal_static_pactus_GetTxPoolStatsResponse_PerTypeEntry_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_pactus_GetTxPoolStatsResponse_PerTypeEntry_descriptor,
+        new java.lang.String[] { "Key", "Value", });
     internal_static_pactus_PayloadTransfer_descriptor =
-      getDescriptor().getMessageTypes().get(6);
+      getDescriptor().getMessageTypes().get(14);
     internal_static_pactus_PayloadTransfer_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_PayloadTransfer_descriptor,
         new java.lang.String[] { "Sender", "Receiver", "Amount", });
     internal_static_pactus_PayloadBond_descriptor =
-      getDescriptor().getMessageTypes().get(7);
+      getDescriptor().getMessageTypes().get(15);
     internal_static_pactus_PayloadBond_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_PayloadBond_descriptor,
         new java.lang.String[] { "Sender", "Receiver", "Stake", });
     internal_static_pactus_PayloadSortition_descriptor =
-      getDescriptor().getMessageTypes().get(8);
+      getDescriptor().getMessageTypes().get(16);
     internal_static_pactus_PayloadSortition_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_PayloadSortition_descriptor,
         new java.lang.String[] { "Address", "Proof", });
     internal_static_pactus_PayloadUnbond_descriptor =
-      getDescriptor().getMessageTypes().get(9);
+      getDescriptor().getMessageTypes().get(17);
     internal_static_pactus_PayloadUnbond_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_PayloadUnbond_descriptor,
         new java.lang.String[] { "Validator", });
     internal_static_pactus_PayloadWithdraw_descriptor =
-      getDescriptor().getMessageTypes().get(10);
+      getDescriptor().getMessageTypes().get(18);
     internal_static_pactus_PayloadWithdraw_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_PayloadWithdraw_descriptor,
         new java.lang.String[] { "From", "To", "Amount", });
     internal_static_pactus_TransactionInfo_descriptor =
-      getDescriptor().getMessageTypes().get(11);
+      getDescriptor().getMessageTypes().get(19);
     internal_static_pactus_TransactionInfo_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_pactus_TransactionInfo_descriptor,
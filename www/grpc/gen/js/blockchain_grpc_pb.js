@@ -27,6 +27,72 @@ function deserialize_pactus_GetAccountResponse(buffer_arg) {
   return blockchain_pb.GetAccountResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetAccountsRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetAccountsRequest)) {
+    throw new Error('Expected argument of type pactus.GetAccountsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetAccountsRequest(buffer_arg) {
+  return blockchain_pb.GetAccountsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetAccountsResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetAccountsResponse)) {
+    throw new Error('Expected argument of type pactus.GetAccountsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetAccountsResponse(buffer_arg) {
+  return blockchain_pb.GetAccountsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetAddressByPublicKeyRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetAddressByPublicKeyRequest)) {
+    throw new Error('Expected argument of type pactus.GetAddressByPublicKeyRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetAddressByPublicKeyRequest(buffer_arg) {
+  return blockchain_pb.GetAddressByPublicKeyRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetAddressByPublicKeyResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetAddressByPublicKeyResponse)) {
+    throw new Error('Expected argument of type pactus.GetAddressByPublicKeyResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetAddressByPublicKeyResponse(buffer_arg) {
+  return blockchain_pb.GetAddressByPublicKeyResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetBlockHashByTimeRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetBlockHashByTimeRequest)) {
+    throw new Error('Expected argument of type pactus.GetBlockHashByTimeRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetBlockHashByTimeRequest(buffer_arg) {
+  return blockchain_pb.GetBlockHashByTimeRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetBlockHashByTimeResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetBlockHashByTimeResponse)) {
+    throw new Error('Expected argument of type pactus.GetBlockHashByTimeResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetBlockHashByTimeResponse(buffer_arg) {
+  return blockchain_pb.GetBlockHashByTimeResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetBlockHashRequest(arg) {
   if (!(arg instanceof blockchain_pb.GetBlockHashRequest)) {
     throw new Error('Expected argument of type pactus.GetBlockHashRequest');
@@ -93,6 +159,28 @@ function deserialize_pactus_GetBlockResponse(buffer_arg) {
   return blockchain_pb.GetBlockResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetBlockTransactionsRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetBlockTransactionsRequest)) {
+    throw new Error('Expected argument of type pactus.GetBlockTransactionsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetBlockTransactionsRequest(buffer_arg) {
+  return blockchain_pb.GetBlockTransactionsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetBlockTransactionsResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetBlockTransactionsResponse)) {
+    throw new Error('Expected argument of type pactus.GetBlockTransactionsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetBlockTransactionsResponse(buffer_arg) {
+  return blockchain_pb.GetBlockTransactionsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetBlockchainInfoRequest(arg) {
   if (!(arg instanceof blockchain_pb.GetBlockchainInfoRequest)) {
     throw new Error('Expected argument of type pactus.GetBlockchainInfoRequest');
@@ -115,6 +203,28 @@ function deserialize_pactus_GetBlockchainInfoResponse(buffer_arg) {
   return blockchain_pb.GetBlockchainInfoResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetCommitteeRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetCommitteeRequest)) {
+    throw new Error('Expected argument of type pactus.GetCommitteeRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetCommitteeRequest(buffer_arg) {
+  return blockchain_pb.GetCommitteeRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetCommitteeResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetCommitteeResponse)) {
+    throw new Error('Expected argument of type pactus.GetCommitteeResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetCommitteeResponse(buffer_arg) {
+  return blockchain_pb.GetCommitteeResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetConsensusInfoRequest(arg) {
   if (!(arg instanceof blockchain_pb.GetConsensusInfoRequest)) {
     throw new Error('Expected argument of type pactus.GetConsensusInfoRequest');
@@ -137,6 +247,50 @@ function deserialize_pactus_GetConsensusInfoResponse(buffer_arg) {
   return blockchain_pb.GetConsensusInfoResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetGenesisRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetGenesisRequest)) {
+    throw new Error('Expected argument of type pactus.GetGenesisRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetGenesisRequest(buffer_arg) {
+  return blockchain_pb.GetGenesisRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetGenesisResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetGenesisResponse)) {
+    throw new Error('Expected argument of type pactus.GetGenesisResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetGenesisResponse(buffer_arg) {
+  return blockchain_pb.GetGenesisResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetParamsRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetParamsRequest)) {
+    throw new Error('Expected argument of type pactus.GetParamsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetParamsRequest(buffer_arg) {
+  return blockchain_pb.GetParamsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetParamsResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetParamsResponse)) {
+    throw new Error('Expected argument of type pactus.GetParamsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetParamsResponse(buffer_arg) {
+  return blockchain_pb.GetParamsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetPublicKeyRequest(arg) {
   if (!(arg instanceof blockchain_pb.GetPublicKeyRequest)) {
     throw new Error('Expected argument of type pactus.GetPublicKeyRequest');
@@ -159,6 +313,50 @@ function deserialize_pactus_GetPublicKeyResponse(buffer_arg) {
   return blockchain_pb.GetPublicKeyResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetRawBlockRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetRawBlockRequest)) {
+    throw new Error('Expected argument of type pactus.GetRawBlockRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetRawBlockRequest(buffer_arg) {
+  return blockchain_pb.GetRawBlockRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetRawBlockResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetRawBlockResponse)) {
+    throw new Error('Expected argument of type pactus.GetRawBlockResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetRawBlockResponse(buffer_arg) {
+  return blockchain_pb.GetRawBlockResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetRecentBlocksRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetRecentBlocksRequest)) {
+    throw new Error('Expected argument of type pactus.GetRecentBlocksRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetRecentBlocksRequest(buffer_arg) {
+  return blockchain_pb.GetRecentBlocksRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetRecentBlocksResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetRecentBlocksResponse)) {
+    throw new Error('Expected argument of type pactus.GetRecentBlocksResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetRecentBlocksResponse(buffer_arg) {
+  return blockchain_pb.GetRecentBlocksResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetValidatorAddressesRequest(arg) {
   if (!(arg instanceof blockchain_pb.GetValidatorAddressesRequest)) {
     throw new Error('Expected argument of type pactus.GetValidatorAddressesRequest');
@@ -192,6 +390,28 @@ function deserialize_pactus_GetValidatorByNumberRequest(buffer_arg) {
   return blockchain_pb.GetValidatorByNumberRequest.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetValidatorHistoryRequest(arg) {
+  if (!(arg instanceof blockchain_pb.GetValidatorHistoryRequest)) {
+    throw new Error('Expected argument of type pactus.GetValidatorHistoryRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetValidatorHistoryRequest(buffer_arg) {
+  return blockchain_pb.GetValidatorHistoryRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetValidatorHistoryResponse(arg) {
+  if (!(arg instanceof blockchain_pb.GetValidatorHistoryResponse)) {
+    throw new Error('Expected argument of type pactus.GetValidatorHistoryResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetValidatorHistoryResponse(buffer_arg) {
+  return blockchain_pb.GetValidatorHistoryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetValidatorRequest(arg) {
   if (!(arg instanceof blockchain_pb.GetValidatorRequest)) {
     throw new Error('Expected argument of type pactus.GetValidatorRequest');
@@ -214,6 +434,50 @@ function deserialize_pactus_GetValidatorResponse(buffer_arg) {
   return blockchain_pb.GetValidatorResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_SubscribeAccountRequest(arg) {
+  if (!(arg instanceof blockchain_pb.SubscribeAccountRequest)) {
+    throw new Error('Expected argument of type pactus.SubscribeAccountRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_SubscribeAccountRequest(buffer_arg) {
+  return blockchain_pb.SubscribeAccountRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_SubscribeAccountResponse(arg) {
+  if (!(arg instanceof blockchain_pb.SubscribeAccountResponse)) {
+    throw new Error('Expected argument of type pactus.SubscribeAccountResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_SubscribeAccountResponse(buffer_arg) {
+  return blockchain_pb.SubscribeAccountResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_SubscribeBlockHeightRequest(arg) {
+  if (!(arg instanceof blockchain_pb.SubscribeBlockHeightRequest)) {
+    throw new Error('Expected argument of type pactus.SubscribeBlockHeightRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_SubscribeBlockHeightRequest(buffer_arg) {
+  return blockchain_pb.SubscribeBlockHeightRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_SubscribeBlockHeightResponse(arg) {
+  if (!(arg instanceof blockchain_pb.SubscribeBlockHeightResponse)) {
+    throw new Error('Expected argument of type pactus.SubscribeBlockHeightResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_SubscribeBlockHeightResponse(buffer_arg) {
+  return blockchain_pb.SubscribeBlockHeightResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 var BlockchainService = exports.BlockchainService = {
   getBlock: {
@@ -227,6 +491,39 @@ var BlockchainService = exports.BlockchainService = {
     responseSerialize: serialize_pactus_GetBlockResponse,
     responseDeserialize: deserialize_pactus_GetBlockResponse,
   },
+  getRawBlock: {
+    path: '/pactus.Blockchain/GetRawBlock',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetRawBlockRequest,
+    responseType: blockchain_pb.GetRawBlockResponse,
+    requestSerialize: serialize_pactus_GetRawBlockRequest,
+    requestDeserialize: deserialize_pactus_GetRawBlockRequest,
+    responseSerialize: serialize_pactus_GetRawBlockResponse,
+    responseDeserialize: deserialize_pactus_GetRawBlockResponse,
+  },
+  getRecentBlocks: {
+    path: '/pactus.Blockchain/GetRecentBlocks',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetRecentBlocksRequest,
+    responseType: blockchain_pb.GetRecentBlocksResponse,
+    requestSerialize: serialize_pactus_GetRecentBlocksRequest,
+    requestDeserialize: deserialize_pactus_GetRecentBlocksRequest,
+    responseSerialize: serialize_pactus_GetRecentBlocksResponse,
+    responseDeserialize: deserialize_pactus_GetRecentBlocksResponse,
+  },
+  getBlockTransactions: {
+    path: '/pactus.Blockchain/GetBlockTransactions',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetBlockTransactionsRequest,
+    responseType: blockchain_pb.GetBlockTransactionsResponse,
+    requestSerialize: serialize_pactus_GetBlockTransactionsRequest,
+    requestDeserialize: deserialize_pactus_GetBlockTransactionsRequest,
+    responseSerialize: serialize_pactus_GetBlockTransactionsResponse,
+    responseDeserialize: deserialize_pactus_GetBlockTransactionsResponse,
+  },
   getBlockHash: {
     path: '/pactus.Blockchain/GetBlockHash',
     requestStream: false,
@@ -238,6 +535,17 @@ var BlockchainService = exports.BlockchainService = {
     responseSerialize: serialize_pactus_GetBlockHashResponse,
     responseDeserialize: deserialize_pactus_GetBlockHashResponse,
   },
+  getBlockHashByTime: {
+    path: '/pactus.Blockchain/GetBlockHashByTime',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetBlockHashByTimeRequest,
+    responseType: blockchain_pb.GetBlockHashByTimeResponse,
+    requestSerialize: serialize_pactus_GetBlockHashByTimeRequest,
+    requestDeserialize: deserialize_pactus_GetBlockHashByTimeRequest,
+    responseSerialize: serialize_pactus_GetBlockHashByTimeResponse,
+    responseDeserialize: deserialize_pactus_GetBlockHashByTimeResponse,
+  },
   getBlockHeight: {
     path: '/pactus.Blockchain/GetBlockHeight',
     requestStream: false,
@@ -282,6 +590,17 @@ var BlockchainService = exports.BlockchainService = {
     responseSerialize: serialize_pactus_GetAccountResponse,
     responseDeserialize: deserialize_pactus_GetAccountResponse,
   },
+  getAccounts: {
+    path: '/pactus.Blockchain/GetAccounts',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetAccountsRequest,
+    responseType: blockchain_pb.GetAccountsResponse,
+    requestSerialize: serialize_pactus_GetAccountsRequest,
+    requestDeserialize: deserialize_pactus_GetAccountsRequest,
+    responseSerialize: serialize_pactus_GetAccountsResponse,
+    responseDeserialize: deserialize_pactus_GetAccountsResponse,
+  },
   getValidator: {
     path: '/pactus.Blockchain/GetValidator',
     requestStream: false,
@@ -304,6 +623,17 @@ var BlockchainService = exports.BlockchainService = {
     responseSerialize: serialize_pactus_GetValidatorResponse,
     responseDeserialize: deserialize_pactus_GetValidatorResponse,
   },
+  getValidatorHistory: {
+    path: '/pactus.Blockchain/GetValidatorHistory',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetValidatorHistoryRequest,
+    responseType: blockchain_pb.GetValidatorHistoryResponse,
+    requestSerialize: serialize_pactus_GetValidatorHistoryRequest,
+    requestDeserialize: deserialize_pactus_GetValidatorHistoryRequest,
+    responseSerialize: serialize_pactus_GetValidatorHistoryResponse,
+    responseDeserialize: deserialize_pactus_GetValidatorHistoryResponse,
+  },
   getValidatorAddresses: {
     path: '/pactus.Blockchain/GetValidatorAddresses',
     requestStream: false,
@@ -326,6 +656,72 @@ var BlockchainService = exports.BlockchainService = {
     responseSerialize: serialize_pactus_GetPublicKeyResponse,
     responseDeserialize: deserialize_pactus_GetPublicKeyResponse,
   },
+  getAddressByPublicKey: {
+    path: '/pactus.Blockchain/GetAddressByPublicKey',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetAddressByPublicKeyRequest,
+    responseType: blockchain_pb.GetAddressByPublicKeyResponse,
+    requestSerialize: serialize_pactus_GetAddressByPublicKeyRequest,
+    requestDeserialize: deserialize_pactus_GetAddressByPublicKeyRequest,
+    responseSerialize: serialize_pactus_GetAddressByPublicKeyResponse,
+    responseDeserialize: deserialize_pactus_GetAddressByPublicKeyResponse,
+  },
+  getGenesis: {
+    path: '/pactus.Blockchain/GetGenesis',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetGenesisRequest,
+    responseType: blockchain_pb.GetGenesisResponse,
+    requestSerialize: serialize_pactus_GetGenesisRequest,
+    requestDeserialize: deserialize_pactus_GetGenesisRequest,
+    responseSerialize: serialize_pactus_GetGenesisResponse,
+    responseDeserialize: deserialize_pactus_GetGenesisResponse,
+  },
+  getCommittee: {
+    path: '/pactus.Blockchain/GetCommittee',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetCommitteeRequest,
+    responseType: blockchain_pb.GetCommitteeResponse,
+    requestSerialize: serialize_pactus_GetCommitteeRequest,
+    requestDeserialize: deserialize_pactus_GetCommitteeRequest,
+    responseSerialize: serialize_pactus_GetCommitteeResponse,
+    responseDeserialize: deserialize_pactus_GetCommitteeResponse,
+  },
+  subscribeBlockHeight: {
+    path: '/pactus.Blockchain/SubscribeBlockHeight',
+    requestStream: false,
+    responseStream: true,
+    requestType: blockchain_pb.SubscribeBlockHeightRequest,
+    responseType: blockchain_pb.SubscribeBlockHeightResponse,
+    requestSerialize: serialize_pactus_SubscribeBlockHeightRequest,
+    requestDeserialize: deserialize_pactus_SubscribeBlockHeightRequest,
+    responseSerialize: serialize_pactus_SubscribeBlockHeightResponse,
+    responseDeserialize: deserialize_pactus_SubscribeBlockHeightResponse,
+  },
+  subscribeAccount: {
+    path: '/pactus.Blockchain/SubscribeAccount',
+    requestStream: false,
+    responseStream: true,
+    requestType: blockchain_pb.SubscribeAccountRequest,
+    responseType: blockchain_pb.SubscribeAccountResponse,
+    requestSerialize: serialize_pactus_SubscribeAccountRequest,
+    requestDeserialize: deserialize_pactus_SubscribeAccountRequest,
+    responseSerialize: serialize_pactus_SubscribeAccountResponse,
+    responseDeserialize: deserialize_pactus_SubscribeAccountResponse,
+  },
+  getParams: {
+    path: '/pactus.Blockchain/GetParams',
+    requestStream: false,
+    responseStream: false,
+    requestType: blockchain_pb.GetParamsRequest,
+    responseType: blockchain_pb.GetParamsResponse,
+    requestSerialize: serialize_pactus_GetParamsRequest,
+    requestDeserialize: deserialize_pactus_GetParamsRequest,
+    responseSerialize: serialize_pactus_GetParamsResponse,
+    responseDeserialize: deserialize_pactus_GetParamsResponse,
+  },
 };
 
 exports.BlockchainClient = grpc.makeGenericClientConstructor(BlockchainService);
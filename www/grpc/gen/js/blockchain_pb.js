@@ -25,28 +25,58 @@ var transaction_pb = require('./transaction_pb.js');
 goog.object.extend(proto, transaction_pb);
 goog.exportSymbol('proto.pactus.AccountInfo', null, global);
 goog.exportSymbol('proto.pactus.BlockHeaderInfo', null, global);
+goog.exportSymbol('proto.pactus.BlockSummary', null, global);
 goog.exportSymbol('proto.pactus.BlockVerbosity', null, global);
 goog.exportSymbol('proto.pactus.CertificateInfo', null, global);
+goog.exportSymbol('proto.pactus.CommitteeMember', null, global);
 goog.exportSymbol('proto.pactus.ConsensusInfo', null, global);
 goog.exportSymbol('proto.pactus.GetAccountRequest', null, global);
 goog.exportSymbol('proto.pactus.GetAccountResponse', null, global);
+goog.exportSymbol('proto.pactus.GetAccountsRequest', null, global);
+goog.exportSymbol('proto.pactus.GetAccountsResponse', null, global);
+goog.exportSymbol('proto.pactus.GetAddressByPublicKeyRequest', null, global);
+goog.exportSymbol('proto.pactus.GetAddressByPublicKeyResponse', null, global);
+goog.exportSymbol('proto.pactus.GetBlockHashByTimeRequest', null, global);
+goog.exportSymbol('proto.pactus.GetBlockHashByTimeResponse', null, global);
 goog.exportSymbol('proto.pactus.GetBlockHashRequest', null, global);
 goog.exportSymbol('proto.pactus.GetBlockHashResponse', null, global);
 goog.exportSymbol('proto.pactus.GetBlockHeightRequest', null, global);
 goog.exportSymbol('proto.pactus.GetBlockHeightResponse', null, global);
 goog.exportSymbol('proto.pactus.GetBlockRequest', null, global);
 goog.exportSymbol('proto.pactus.GetBlockResponse', null, global);
+goog.exportSymbol('proto.pactus.GetBlockTransactionsRequest', null, global);
+goog.exportSymbol('proto.pactus.GetBlockTransactionsResponse', null, global);
 goog.exportSymbol('proto.pactus.GetBlockchainInfoRequest', null, global);
 goog.exportSymbol('proto.pactus.GetBlockchainInfoResponse', null, global);
+goog.exportSymbol('proto.pactus.GetCommitteeRequest', null, global);
+goog.exportSymbol('proto.pactus.GetCommitteeResponse', null, global);
 goog.exportSymbol('proto.pactus.GetConsensusInfoRequest', null, global);
 goog.exportSymbol('proto.pactus.GetConsensusInfoResponse', null, global);
+goog.exportSymbol('proto.pactus.GetGenesisRequest', null, global);
+goog.exportSymbol('proto.pactus.GetGenesisResponse', null, global);
+goog.exportSymbol('proto.pactus.GetParamsRequest', null, global);
+goog.exportSymbol('proto.pactus.GetParamsResponse', null, global);
 goog.exportSymbol('proto.pactus.GetPublicKeyRequest', null, global);
 goog.exportSymbol('proto.pactus.GetPublicKeyResponse', null, global);
+goog.exportSymbol('proto.pactus.GetRawBlockRequest', null, global);
+goog.exportSymbol('proto.pactus.GetRawBlockResponse', null, global);
+goog.exportSymbol('proto.pactus.GetRecentBlocksRequest', null, global);
+goog.exportSymbol('proto.pactus.GetRecentBlocksResponse', null, global);
 goog.exportSymbol('proto.pactus.GetValidatorAddressesRequest', null, global);
 goog.exportSymbol('proto.pactus.GetValidatorAddressesResponse', null, global);
 goog.exportSymbol('proto.pactus.GetValidatorByNumberRequest', null, global);
+goog.exportSymbol('proto.pactus.GetValidatorHistoryRequest', null, global);
+goog.exportSymbol('proto.pactus.GetValidatorHistoryResponse', null, global);
 goog.exportSymbol('proto.pactus.GetValidatorRequest', null, global);
 goog.exportSymbol('proto.pactus.GetValidatorResponse', null, global);
+goog.exportSymbol('proto.pactus.ProposalInfo', null, global);
+goog.exportSymbol('proto.pactus.RoundTiming', null, global);
+goog.exportSymbol('proto.pactus.SubscribeAccountRequest', null, global);
+goog.exportSymbol('proto.pactus.SubscribeAccountResponse', null, global);
+goog.exportSymbol('proto.pactus.SubscribeBlockHeightRequest', null, global);
+goog.exportSymbol('proto.pactus.SubscribeBlockHeightResponse', null, global);
+goog.exportSymbol('proto.pactus.ValidatorAddressInfo', null, global);
+goog.exportSymbol('proto.pactus.ValidatorHistoryEvent', null, global);
 goog.exportSymbol('proto.pactus.ValidatorInfo', null, global);
 goog.exportSymbol('proto.pactus.VoteInfo', null, global);
 goog.exportSymbol('proto.pactus.VoteType', null, global);
@@ -92,6 +122,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.GetAccountResponse.displayName = 'proto.pactus.GetAccountResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetAccountsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetAccountsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetAccountsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetAccountsRequest.displayName = 'proto.pactus.GetAccountsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetAccountsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetAccountsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetAccountsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetAccountsResponse.displayName = 'proto.pactus.GetAccountsResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -134,6 +206,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.GetValidatorAddressesResponse.displayName = 'proto.pactus.GetValidatorAddressesResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ValidatorAddressInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.ValidatorAddressInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ValidatorAddressInfo.displayName = 'proto.pactus.ValidatorAddressInfo';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -197,6 +290,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.GetValidatorResponse.displayName = 'proto.pactus.GetValidatorResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetValidatorHistoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetValidatorHistoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetValidatorHistoryRequest.displayName = 'proto.pactus.GetValidatorHistoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetValidatorHistoryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetValidatorHistoryResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetValidatorHistoryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetValidatorHistoryResponse.displayName = 'proto.pactus.GetValidatorHistoryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ValidatorHistoryEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.ValidatorHistoryEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ValidatorHistoryEvent.displayName = 'proto.pactus.ValidatorHistoryEvent';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -249,16 +405,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockRequest = function(opt_data) {
+proto.pactus.GetAddressByPublicKeyRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetBlockRequest, jspb.Message);
+goog.inherits(proto.pactus.GetAddressByPublicKeyRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockRequest.displayName = 'proto.pactus.GetBlockRequest';
+  proto.pactus.GetAddressByPublicKeyRequest.displayName = 'proto.pactus.GetAddressByPublicKeyRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -270,16 +426,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetBlockResponse.repeatedFields_, null);
+proto.pactus.GetAddressByPublicKeyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetBlockResponse, jspb.Message);
+goog.inherits(proto.pactus.GetAddressByPublicKeyResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockResponse.displayName = 'proto.pactus.GetBlockResponse';
+  proto.pactus.GetAddressByPublicKeyResponse.displayName = 'proto.pactus.GetAddressByPublicKeyResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -291,16 +447,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockHashRequest = function(opt_data) {
+proto.pactus.GetGenesisRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetBlockHashRequest, jspb.Message);
+goog.inherits(proto.pactus.GetGenesisRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockHashRequest.displayName = 'proto.pactus.GetBlockHashRequest';
+  proto.pactus.GetGenesisRequest.displayName = 'proto.pactus.GetGenesisRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -312,16 +468,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockHashResponse = function(opt_data) {
+proto.pactus.GetGenesisResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetBlockHashResponse, jspb.Message);
+goog.inherits(proto.pactus.GetGenesisResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockHashResponse.displayName = 'proto.pactus.GetBlockHashResponse';
+  proto.pactus.GetGenesisResponse.displayName = 'proto.pactus.GetGenesisResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -333,16 +489,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockHeightRequest = function(opt_data) {
+proto.pactus.GetParamsRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetBlockHeightRequest, jspb.Message);
+goog.inherits(proto.pactus.GetParamsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockHeightRequest.displayName = 'proto.pactus.GetBlockHeightRequest';
+  proto.pactus.GetParamsRequest.displayName = 'proto.pactus.GetParamsRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -354,16 +510,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockHeightResponse = function(opt_data) {
+proto.pactus.GetParamsResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetBlockHeightResponse, jspb.Message);
+goog.inherits(proto.pactus.GetParamsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockHeightResponse.displayName = 'proto.pactus.GetBlockHeightResponse';
+  proto.pactus.GetParamsResponse.displayName = 'proto.pactus.GetParamsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -375,16 +531,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockchainInfoRequest = function(opt_data) {
+proto.pactus.GetBlockRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetBlockchainInfoRequest, jspb.Message);
+goog.inherits(proto.pactus.GetBlockRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockchainInfoRequest.displayName = 'proto.pactus.GetBlockchainInfoRequest';
+  proto.pactus.GetBlockRequest.displayName = 'proto.pactus.GetBlockRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -396,16 +552,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetBlockchainInfoResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetBlockchainInfoResponse.repeatedFields_, null);
+proto.pactus.GetBlockResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetBlockResponse.repeatedFields_, null);
 };
-goog.inherits(proto.pactus.GetBlockchainInfoResponse, jspb.Message);
+goog.inherits(proto.pactus.GetBlockResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetBlockchainInfoResponse.displayName = 'proto.pactus.GetBlockchainInfoResponse';
+  proto.pactus.GetBlockResponse.displayName = 'proto.pactus.GetBlockResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -417,16 +573,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetConsensusInfoRequest = function(opt_data) {
+proto.pactus.GetRawBlockRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetConsensusInfoRequest, jspb.Message);
+goog.inherits(proto.pactus.GetRawBlockRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetConsensusInfoRequest.displayName = 'proto.pactus.GetConsensusInfoRequest';
+  proto.pactus.GetRawBlockRequest.displayName = 'proto.pactus.GetRawBlockRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -438,16 +594,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.GetConsensusInfoResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetConsensusInfoResponse.repeatedFields_, null);
+proto.pactus.GetRawBlockResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.GetConsensusInfoResponse, jspb.Message);
+goog.inherits(proto.pactus.GetRawBlockResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.GetConsensusInfoResponse.displayName = 'proto.pactus.GetConsensusInfoResponse';
+  proto.pactus.GetRawBlockResponse.displayName = 'proto.pactus.GetRawBlockResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -459,16 +615,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.ValidatorInfo = function(opt_data) {
+proto.pactus.GetRecentBlocksRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.ValidatorInfo, jspb.Message);
+goog.inherits(proto.pactus.GetRecentBlocksRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.ValidatorInfo.displayName = 'proto.pactus.ValidatorInfo';
+  proto.pactus.GetRecentBlocksRequest.displayName = 'proto.pactus.GetRecentBlocksRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -480,16 +636,37 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.AccountInfo = function(opt_data) {
+proto.pactus.GetRecentBlocksResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetRecentBlocksResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetRecentBlocksResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetRecentBlocksResponse.displayName = 'proto.pactus.GetRecentBlocksResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.BlockSummary = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.AccountInfo, jspb.Message);
+goog.inherits(proto.pactus.BlockSummary, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.AccountInfo.displayName = 'proto.pactus.AccountInfo';
+  proto.pactus.BlockSummary.displayName = 'proto.pactus.BlockSummary';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -501,16 +678,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.BlockHeaderInfo = function(opt_data) {
+proto.pactus.GetBlockTransactionsRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.BlockHeaderInfo, jspb.Message);
+goog.inherits(proto.pactus.GetBlockTransactionsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.BlockHeaderInfo.displayName = 'proto.pactus.BlockHeaderInfo';
+  proto.pactus.GetBlockTransactionsRequest.displayName = 'proto.pactus.GetBlockTransactionsRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -522,16 +699,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.CertificateInfo = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.CertificateInfo.repeatedFields_, null);
+proto.pactus.GetBlockTransactionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetBlockTransactionsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.pactus.CertificateInfo, jspb.Message);
+goog.inherits(proto.pactus.GetBlockTransactionsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.CertificateInfo.displayName = 'proto.pactus.CertificateInfo';
+  proto.pactus.GetBlockTransactionsResponse.displayName = 'proto.pactus.GetBlockTransactionsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -543,16 +720,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.VoteInfo = function(opt_data) {
+proto.pactus.GetBlockHashRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.VoteInfo, jspb.Message);
+goog.inherits(proto.pactus.GetBlockHashRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.VoteInfo.displayName = 'proto.pactus.VoteInfo';
+  proto.pactus.GetBlockHashRequest.displayName = 'proto.pactus.GetBlockHashRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -564,80 +741,6343 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pactus.ConsensusInfo = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.ConsensusInfo.repeatedFields_, null);
+proto.pactus.GetBlockHashResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pactus.ConsensusInfo, jspb.Message);
+goog.inherits(proto.pactus.GetBlockHashResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pactus.ConsensusInfo.displayName = 'proto.pactus.ConsensusInfo';
+  proto.pactus.GetBlockHashResponse.displayName = 'proto.pactus.GetBlockHashResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pactus.GetAccountRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetAccountRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetBlockHashByTimeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.pactus.GetBlockHashByTimeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetBlockHashByTimeRequest.displayName = 'proto.pactus.GetBlockHashByTimeRequest';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetAccountRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pactus.GetAccountRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    address: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pactus.GetBlockHashByTimeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.pactus.GetBlockHashByTimeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetBlockHashByTimeResponse.displayName = 'proto.pactus.GetBlockHashByTimeResponse';
 }
-
-
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetBlockHeightRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetBlockHeightRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetBlockHeightRequest.displayName = 'proto.pactus.GetBlockHeightRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetBlockHeightResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetBlockHeightResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetBlockHeightResponse.displayName = 'proto.pactus.GetBlockHeightResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.SubscribeBlockHeightRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.SubscribeBlockHeightRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.SubscribeBlockHeightRequest.displayName = 'proto.pactus.SubscribeBlockHeightRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.SubscribeBlockHeightResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.SubscribeBlockHeightResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.SubscribeBlockHeightResponse.displayName = 'proto.pactus.SubscribeBlockHeightResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.SubscribeAccountRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.SubscribeAccountRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.SubscribeAccountRequest.displayName = 'proto.pactus.SubscribeAccountRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.SubscribeAccountResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.SubscribeAccountResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.SubscribeAccountResponse.displayName = 'proto.pactus.SubscribeAccountResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetBlockchainInfoRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetBlockchainInfoRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetBlockchainInfoRequest.displayName = 'proto.pactus.GetBlockchainInfoRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetBlockchainInfoResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetBlockchainInfoResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetBlockchainInfoResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetBlockchainInfoResponse.displayName = 'proto.pactus.GetBlockchainInfoResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetCommitteeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetCommitteeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetCommitteeRequest.displayName = 'proto.pactus.GetCommitteeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetCommitteeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetCommitteeResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetCommitteeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetCommitteeResponse.displayName = 'proto.pactus.GetCommitteeResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.CommitteeMember = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.CommitteeMember, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.CommitteeMember.displayName = 'proto.pactus.CommitteeMember';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetConsensusInfoRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetConsensusInfoRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetConsensusInfoRequest.displayName = 'proto.pactus.GetConsensusInfoRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetConsensusInfoResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetConsensusInfoResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetConsensusInfoResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetConsensusInfoResponse.displayName = 'proto.pactus.GetConsensusInfoResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ValidatorInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.ValidatorInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ValidatorInfo.displayName = 'proto.pactus.ValidatorInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.AccountInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.AccountInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.AccountInfo.displayName = 'proto.pactus.AccountInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.BlockHeaderInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.BlockHeaderInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.BlockHeaderInfo.displayName = 'proto.pactus.BlockHeaderInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.CertificateInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.CertificateInfo.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.CertificateInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.CertificateInfo.displayName = 'proto.pactus.CertificateInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.VoteInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.VoteInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.VoteInfo.displayName = 'proto.pactus.VoteInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ConsensusInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.ConsensusInfo.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.ConsensusInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ConsensusInfo.displayName = 'proto.pactus.ConsensusInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ProposalInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.ProposalInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ProposalInfo.displayName = 'proto.pactus.ProposalInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.RoundTiming = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.RoundTiming, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.RoundTiming.displayName = 'proto.pactus.RoundTiming';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetAccountRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetAccountRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetAccountRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    address: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    height: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetAccountRequest}
+ */
+proto.pactus.GetAccountRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetAccountRequest;
+  return proto.pactus.GetAccountRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetAccountRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetAccountRequest}
+ */
+proto.pactus.GetAccountRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetAccountRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetAccountRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetAccountRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string address = 1;
+ * @return {string}
+ */
+proto.pactus.GetAccountRequest.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetAccountRequest} returns this
+ */
+proto.pactus.GetAccountRequest.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 height = 2;
+ * @return {number}
+ */
+proto.pactus.GetAccountRequest.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetAccountRequest} returns this
+ */
+proto.pactus.GetAccountRequest.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetAccountResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetAccountResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetAccountResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    account: (f = msg.getAccount()) && proto.pactus.AccountInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetAccountResponse}
+ */
+proto.pactus.GetAccountResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetAccountResponse;
+  return proto.pactus.GetAccountResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetAccountResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetAccountResponse}
+ */
+proto.pactus.GetAccountResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pactus.AccountInfo;
+      reader.readMessage(value,proto.pactus.AccountInfo.deserializeBinaryFromReader);
+      msg.setAccount(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetAccountResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetAccountResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetAccountResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccount();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.pactus.AccountInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional AccountInfo account = 1;
+ * @return {?proto.pactus.AccountInfo}
+ */
+proto.pactus.GetAccountResponse.prototype.getAccount = function() {
+  return /** @type{?proto.pactus.AccountInfo} */ (
+    jspb.Message.getWrapperField(this, proto.pactus.AccountInfo, 1));
+};
+
+
+/**
+ * @param {?proto.pactus.AccountInfo|undefined} value
+ * @return {!proto.pactus.GetAccountResponse} returns this
+*/
+proto.pactus.GetAccountResponse.prototype.setAccount = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pactus.GetAccountResponse} returns this
+ */
+proto.pactus.GetAccountResponse.prototype.clearAccount = function() {
+  return this.setAccount(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pactus.GetAccountResponse.prototype.hasAccount = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetAccountsRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetAccountsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetAccountsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetAccountsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    addressesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetAccountsRequest}
+ */
+proto.pactus.GetAccountsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetAccountsRequest;
+  return proto.pactus.GetAccountsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetAccountsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetAccountsRequest}
+ */
+proto.pactus.GetAccountsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAddresses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetAccountsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetAccountsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetAccountsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddressesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string addresses = 1;
+ * @return {!Array<string>}
+ */
+proto.pactus.GetAccountsRequest.prototype.getAddressesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pactus.GetAccountsRequest} returns this
+ */
+proto.pactus.GetAccountsRequest.prototype.setAddressesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.GetAccountsRequest} returns this
+ */
+proto.pactus.GetAccountsRequest.prototype.addAddresses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetAccountsRequest} returns this
+ */
+proto.pactus.GetAccountsRequest.prototype.clearAddressesList = function() {
+  return this.setAddressesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetAccountsResponse.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetAccountsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetAccountsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetAccountsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountsList: jspb.Message.toObjectList(msg.getAccountsList(),
+    proto.pactus.AccountInfo.toObject, includeInstance),
+    missingAddressesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetAccountsResponse}
+ */
+proto.pactus.GetAccountsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetAccountsResponse;
+  return proto.pactus.GetAccountsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetAccountsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetAccountsResponse}
+ */
+proto.pactus.GetAccountsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pactus.AccountInfo;
+      reader.readMessage(value,proto.pactus.AccountInfo.deserializeBinaryFromReader);
+      msg.addAccounts(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMissingAddresses(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetAccountsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetAccountsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetAccountsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAccountsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pactus.AccountInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getMissingAddressesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated AccountInfo accounts = 1;
+ * @return {!Array<!proto.pactus.AccountInfo>}
+ */
+proto.pactus.GetAccountsResponse.prototype.getAccountsList = function() {
+  return /** @type{!Array<!proto.pactus.AccountInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.AccountInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.AccountInfo>} value
+ * @return {!proto.pactus.GetAccountsResponse} returns this
+*/
+proto.pactus.GetAccountsResponse.prototype.setAccountsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pactus.AccountInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.AccountInfo}
+ */
+proto.pactus.GetAccountsResponse.prototype.addAccounts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.AccountInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetAccountsResponse} returns this
+ */
+proto.pactus.GetAccountsResponse.prototype.clearAccountsList = function() {
+  return this.setAccountsList([]);
+};
+
+
+/**
+ * repeated string missing_addresses = 2;
+ * @return {!Array<string>}
+ */
+proto.pactus.GetAccountsResponse.prototype.getMissingAddressesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pactus.GetAccountsResponse} returns this
+ */
+proto.pactus.GetAccountsResponse.prototype.setMissingAddressesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.GetAccountsResponse} returns this
+ */
+proto.pactus.GetAccountsResponse.prototype.addMissingAddresses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetAccountsResponse} returns this
+ */
+proto.pactus.GetAccountsResponse.prototype.clearMissingAddressesList = function() {
+  return this.setMissingAddressesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetValidatorAddressesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetValidatorAddressesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetValidatorAddressesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorAddressesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetValidatorAddressesRequest}
+ */
+proto.pactus.GetValidatorAddressesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetValidatorAddressesRequest;
+  return proto.pactus.GetValidatorAddressesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetValidatorAddressesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetValidatorAddressesRequest}
+ */
+proto.pactus.GetValidatorAddressesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetValidatorAddressesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetValidatorAddressesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetValidatorAddressesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorAddressesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetValidatorAddressesResponse.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetValidatorAddressesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetValidatorAddressesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorAddressesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    addressesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    validatorsList: jspb.Message.toObjectList(msg.getValidatorsList(),
+    proto.pactus.ValidatorAddressInfo.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetValidatorAddressesResponse}
+ */
+proto.pactus.GetValidatorAddressesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetValidatorAddressesResponse;
+  return proto.pactus.GetValidatorAddressesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetValidatorAddressesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetValidatorAddressesResponse}
+ */
+proto.pactus.GetValidatorAddressesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAddresses(value);
+      break;
+    case 2:
+      var value = new proto.pactus.ValidatorAddressInfo;
+      reader.readMessage(value,proto.pactus.ValidatorAddressInfo.deserializeBinaryFromReader);
+      msg.addValidators(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetValidatorAddressesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetValidatorAddressesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorAddressesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddressesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getValidatorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.pactus.ValidatorAddressInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated string addresses = 1;
+ * @return {!Array<string>}
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.getAddressesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.setAddressesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.addAddresses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.clearAddressesList = function() {
+  return this.setAddressesList([]);
+};
+
+
+/**
+ * repeated ValidatorAddressInfo validators = 2;
+ * @return {!Array<!proto.pactus.ValidatorAddressInfo>}
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.getValidatorsList = function() {
+  return /** @type{!Array<!proto.pactus.ValidatorAddressInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.ValidatorAddressInfo, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.ValidatorAddressInfo>} value
+ * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+*/
+proto.pactus.GetValidatorAddressesResponse.prototype.setValidatorsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.pactus.ValidatorAddressInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.ValidatorAddressInfo}
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.addValidators = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pactus.ValidatorAddressInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+ */
+proto.pactus.GetValidatorAddressesResponse.prototype.clearValidatorsList = function() {
+  return this.setValidatorsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.ValidatorAddressInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ValidatorAddressInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.ValidatorAddressInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ValidatorAddressInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    address: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    number: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    publicKey: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.ValidatorAddressInfo}
+ */
+proto.pactus.ValidatorAddressInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.ValidatorAddressInfo;
+  return proto.pactus.ValidatorAddressInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.ValidatorAddressInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.ValidatorAddressInfo}
+ */
+proto.pactus.ValidatorAddressInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNumber(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPublicKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.ValidatorAddressInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.ValidatorAddressInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.ValidatorAddressInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ValidatorAddressInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getPublicKey();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string address = 1;
+ * @return {string}
+ */
+proto.pactus.ValidatorAddressInfo.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.ValidatorAddressInfo} returns this
+ */
+proto.pactus.ValidatorAddressInfo.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 number = 2;
+ * @return {number}
+ */
+proto.pactus.ValidatorAddressInfo.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorAddressInfo} returns this
+ */
+proto.pactus.ValidatorAddressInfo.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string public_key = 3;
+ * @return {string}
+ */
+proto.pactus.ValidatorAddressInfo.prototype.getPublicKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.ValidatorAddressInfo} returns this
+ */
+proto.pactus.ValidatorAddressInfo.prototype.setPublicKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetValidatorRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetValidatorRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetValidatorRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    address: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetValidatorRequest}
+ */
+proto.pactus.GetValidatorRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetValidatorRequest;
+  return proto.pactus.GetValidatorRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetValidatorRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetValidatorRequest}
+ */
+proto.pactus.GetValidatorRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetValidatorRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetValidatorRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetValidatorRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string address = 1;
+ * @return {string}
+ */
+proto.pactus.GetValidatorRequest.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetValidatorRequest} returns this
+ */
+proto.pactus.GetValidatorRequest.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetValidatorByNumberRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetValidatorByNumberRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetValidatorByNumberRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorByNumberRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetValidatorByNumberRequest}
+ */
+proto.pactus.GetValidatorByNumberRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetValidatorByNumberRequest;
+  return proto.pactus.GetValidatorByNumberRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetValidatorByNumberRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetValidatorByNumberRequest}
+ */
+proto.pactus.GetValidatorByNumberRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetValidatorByNumberRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetValidatorByNumberRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetValidatorByNumberRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorByNumberRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 number = 1;
+ * @return {number}
+ */
+proto.pactus.GetValidatorByNumberRequest.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetValidatorByNumberRequest} returns this
+ */
+proto.pactus.GetValidatorByNumberRequest.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetValidatorResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetValidatorResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetValidatorResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    validator: (f = msg.getValidator()) && proto.pactus.ValidatorInfo.toObject(includeInstance, f),
+    withdrawableHeight: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    rewardKnown: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    rewardBalance: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetValidatorResponse}
+ */
+proto.pactus.GetValidatorResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetValidatorResponse;
+  return proto.pactus.GetValidatorResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetValidatorResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetValidatorResponse}
+ */
+proto.pactus.GetValidatorResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pactus.ValidatorInfo;
+      reader.readMessage(value,proto.pactus.ValidatorInfo.deserializeBinaryFromReader);
+      msg.setValidator(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setWithdrawableHeight(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRewardKnown(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRewardBalance(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetValidatorResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetValidatorResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetValidatorResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getValidator();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.pactus.ValidatorInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getWithdrawableHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getRewardKnown();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getRewardBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional ValidatorInfo validator = 1;
+ * @return {?proto.pactus.ValidatorInfo}
+ */
+proto.pactus.GetValidatorResponse.prototype.getValidator = function() {
+  return /** @type{?proto.pactus.ValidatorInfo} */ (
+    jspb.Message.getWrapperField(this, proto.pactus.ValidatorInfo, 1));
+};
+
+
+/**
+ * @param {?proto.pactus.ValidatorInfo|undefined} value
+ * @return {!proto.pactus.GetValidatorResponse} returns this
+*/
+proto.pactus.GetValidatorResponse.prototype.setValidator = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pactus.GetValidatorResponse} returns this
+ */
+proto.pactus.GetValidatorResponse.prototype.clearValidator = function() {
+  return this.setValidator(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pactus.GetValidatorResponse.prototype.hasValidator = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional uint32 withdrawable_height = 2;
+ * @return {number}
+ */
+proto.pactus.GetValidatorResponse.prototype.getWithdrawableHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetValidatorResponse} returns this
+ */
+proto.pactus.GetValidatorResponse.prototype.setWithdrawableHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional bool reward_known = 3;
+ * @return {boolean}
+ */
+proto.pactus.GetValidatorResponse.prototype.getRewardKnown = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pactus.GetValidatorResponse} returns this
+ */
+proto.pactus.GetValidatorResponse.prototype.setRewardKnown = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional int64 reward_balance = 4;
+ * @return {number}
+ */
+proto.pactus.GetValidatorResponse.prototype.getRewardBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetValidatorResponse} returns this
+ */
+proto.pactus.GetValidatorResponse.prototype.setRewardBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetValidatorHistoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetValidatorHistoryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetValidatorHistoryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorHistoryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    address: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetValidatorHistoryRequest}
+ */
+proto.pactus.GetValidatorHistoryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetValidatorHistoryRequest;
+  return proto.pactus.GetValidatorHistoryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetValidatorHistoryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetValidatorHistoryRequest}
+ */
+proto.pactus.GetValidatorHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetValidatorHistoryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetValidatorHistoryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetValidatorHistoryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorHistoryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string address = 1;
+ * @return {string}
+ */
+proto.pactus.GetValidatorHistoryRequest.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetValidatorHistoryRequest} returns this
+ */
+proto.pactus.GetValidatorHistoryRequest.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetValidatorHistoryResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetValidatorHistoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetValidatorHistoryResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetValidatorHistoryResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorHistoryResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.pactus.ValidatorHistoryEvent.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetValidatorHistoryResponse}
+ */
+proto.pactus.GetValidatorHistoryResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetValidatorHistoryResponse;
+  return proto.pactus.GetValidatorHistoryResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetValidatorHistoryResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetValidatorHistoryResponse}
+ */
+proto.pactus.GetValidatorHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pactus.ValidatorHistoryEvent;
+      reader.readMessage(value,proto.pactus.ValidatorHistoryEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetValidatorHistoryResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetValidatorHistoryResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetValidatorHistoryResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetValidatorHistoryResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pactus.ValidatorHistoryEvent.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ValidatorHistoryEvent events = 1;
+ * @return {!Array<!proto.pactus.ValidatorHistoryEvent>}
+ */
+proto.pactus.GetValidatorHistoryResponse.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.pactus.ValidatorHistoryEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.ValidatorHistoryEvent, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.ValidatorHistoryEvent>} value
+ * @return {!proto.pactus.GetValidatorHistoryResponse} returns this
+*/
+proto.pactus.GetValidatorHistoryResponse.prototype.setEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pactus.ValidatorHistoryEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.ValidatorHistoryEvent}
+ */
+proto.pactus.GetValidatorHistoryResponse.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.ValidatorHistoryEvent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetValidatorHistoryResponse} returns this
+ */
+proto.pactus.GetValidatorHistoryResponse.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ValidatorHistoryEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.ValidatorHistoryEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ValidatorHistoryEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    transaction: (f = msg.getTransaction()) && transaction_pb.TransactionInfo.toObject(includeInstance, f),
+    amount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    stake: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.ValidatorHistoryEvent}
+ */
+proto.pactus.ValidatorHistoryEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.ValidatorHistoryEvent;
+  return proto.pactus.ValidatorHistoryEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.ValidatorHistoryEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.ValidatorHistoryEvent}
+ */
+proto.pactus.ValidatorHistoryEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = new transaction_pb.TransactionInfo;
+      reader.readMessage(value,transaction_pb.TransactionInfo.deserializeBinaryFromReader);
+      msg.setTransaction(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAmount(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStake(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.ValidatorHistoryEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.ValidatorHistoryEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ValidatorHistoryEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getTransaction();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      transaction_pb.TransactionInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getAmount();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getStake();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorHistoryEvent} returns this
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional TransactionInfo transaction = 2;
+ * @return {?proto.pactus.TransactionInfo}
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.getTransaction = function() {
+  return /** @type{?proto.pactus.TransactionInfo} */ (
+    jspb.Message.getWrapperField(this, transaction_pb.TransactionInfo, 2));
+};
+
+
+/**
+ * @param {?proto.pactus.TransactionInfo|undefined} value
+ * @return {!proto.pactus.ValidatorHistoryEvent} returns this
+*/
+proto.pactus.ValidatorHistoryEvent.prototype.setTransaction = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pactus.ValidatorHistoryEvent} returns this
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.clearTransaction = function() {
+  return this.setTransaction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.hasTransaction = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional int64 amount = 3;
+ * @return {number}
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.getAmount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorHistoryEvent} returns this
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.setAmount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 stake = 4;
+ * @return {number}
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.getStake = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorHistoryEvent} returns this
+ */
+proto.pactus.ValidatorHistoryEvent.prototype.setStake = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetPublicKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetPublicKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetPublicKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetPublicKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    address: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetPublicKeyRequest}
+ */
+proto.pactus.GetPublicKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetPublicKeyRequest;
+  return proto.pactus.GetPublicKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetPublicKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetPublicKeyRequest}
+ */
+proto.pactus.GetPublicKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetPublicKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetPublicKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetPublicKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetPublicKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string address = 1;
+ * @return {string}
+ */
+proto.pactus.GetPublicKeyRequest.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetPublicKeyRequest} returns this
+ */
+proto.pactus.GetPublicKeyRequest.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetPublicKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetPublicKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetPublicKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetPublicKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    publicKey: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetPublicKeyResponse}
+ */
+proto.pactus.GetPublicKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetPublicKeyResponse;
+  return proto.pactus.GetPublicKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetPublicKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetPublicKeyResponse}
+ */
+proto.pactus.GetPublicKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPublicKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetPublicKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetPublicKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetPublicKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetPublicKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPublicKey();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string public_key = 1;
+ * @return {string}
+ */
+proto.pactus.GetPublicKeyResponse.prototype.getPublicKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetPublicKeyResponse} returns this
+ */
+proto.pactus.GetPublicKeyResponse.prototype.setPublicKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetAddressByPublicKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetAddressByPublicKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetAddressByPublicKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAddressByPublicKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    publicKey: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetAddressByPublicKeyRequest}
+ */
+proto.pactus.GetAddressByPublicKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetAddressByPublicKeyRequest;
+  return proto.pactus.GetAddressByPublicKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetAddressByPublicKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetAddressByPublicKeyRequest}
+ */
+proto.pactus.GetAddressByPublicKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPublicKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetAddressByPublicKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetAddressByPublicKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetAddressByPublicKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAddressByPublicKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPublicKey();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string public_key = 1;
+ * @return {string}
+ */
+proto.pactus.GetAddressByPublicKeyRequest.prototype.getPublicKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetAddressByPublicKeyRequest} returns this
+ */
+proto.pactus.GetAddressByPublicKeyRequest.prototype.setPublicKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetAddressByPublicKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetAddressByPublicKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetAddressByPublicKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAddressByPublicKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountAddress: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    validatorAddress: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetAddressByPublicKeyResponse}
+ */
+proto.pactus.GetAddressByPublicKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetAddressByPublicKeyResponse;
+  return proto.pactus.GetAddressByPublicKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetAddressByPublicKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetAddressByPublicKeyResponse}
+ */
+proto.pactus.GetAddressByPublicKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountAddress(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValidatorAddress(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetAddressByPublicKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetAddressByPublicKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetAddressByPublicKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetAddressByPublicKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getValidatorAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_address = 1;
+ * @return {string}
+ */
+proto.pactus.GetAddressByPublicKeyResponse.prototype.getAccountAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetAddressByPublicKeyResponse} returns this
+ */
+proto.pactus.GetAddressByPublicKeyResponse.prototype.setAccountAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string validator_address = 2;
+ * @return {string}
+ */
+proto.pactus.GetAddressByPublicKeyResponse.prototype.getValidatorAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetAddressByPublicKeyResponse} returns this
+ */
+proto.pactus.GetAddressByPublicKeyResponse.prototype.setValidatorAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetGenesisRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetGenesisRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetGenesisRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetGenesisRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetGenesisRequest}
+ */
+proto.pactus.GetGenesisRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetGenesisRequest;
+  return proto.pactus.GetGenesisRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetGenesisRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetGenesisRequest}
+ */
+proto.pactus.GetGenesisRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetGenesisRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetGenesisRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetGenesisRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetGenesisRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetGenesisResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetGenesisResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetGenesisResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetGenesisResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    hash: msg.getHash_asB64(),
+    data: msg.getData_asB64(),
+    chainType: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetGenesisResponse}
+ */
+proto.pactus.GetGenesisResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetGenesisResponse;
+  return proto.pactus.GetGenesisResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetGenesisResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetGenesisResponse}
+ */
+proto.pactus.GetGenesisResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChainType(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetGenesisResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetGenesisResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetGenesisResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetGenesisResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getChainType();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes hash = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetGenesisResponse.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes hash = 1;
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {string}
+ */
+proto.pactus.GetGenesisResponse.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
+};
+
+
+/**
+ * optional bytes hash = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetGenesisResponse.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetGenesisResponse} returns this
+ */
+proto.pactus.GetGenesisResponse.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional bytes data = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetGenesisResponse.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.pactus.GetGenesisResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetGenesisResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetGenesisResponse} returns this
+ */
+proto.pactus.GetGenesisResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional string chain_type = 3;
+ * @return {string}
+ */
+proto.pactus.GetGenesisResponse.prototype.getChainType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetGenesisResponse} returns this
+ */
+proto.pactus.GetGenesisResponse.prototype.setChainType = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetParamsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetParamsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetParamsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetParamsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetParamsRequest}
+ */
+proto.pactus.GetParamsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetParamsRequest;
+  return proto.pactus.GetParamsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetParamsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetParamsRequest}
+ */
+proto.pactus.GetParamsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetParamsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetParamsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetParamsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetParamsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetParamsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetParamsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetParamsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetParamsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    blockVersion: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    blockIntervalInSecond: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    committeeSize: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    blockReward: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    transactionToLiveInterval: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    bondInterval: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    unbondInterval: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    sortitionInterval: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    feeFraction: jspb.Message.getFloatingPointFieldWithDefault(msg, 9, 0.0),
+    minimumFee: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    maximumFee: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    minimumStake: jspb.Message.getFieldWithDefault(msg, 12, 0),
+    maximumStake: jspb.Message.getFieldWithDefault(msg, 13, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetParamsResponse}
+ */
+proto.pactus.GetParamsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetParamsResponse;
+  return proto.pactus.GetParamsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetParamsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetParamsResponse}
+ */
+proto.pactus.GetParamsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBlockVersion(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setBlockIntervalInSecond(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCommitteeSize(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBlockReward(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTransactionToLiveInterval(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBondInterval(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setUnbondInterval(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSortitionInterval(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setFeeFraction(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMinimumFee(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMaximumFee(value);
+      break;
+    case 12:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMinimumStake(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMaximumStake(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetParamsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetParamsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetParamsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetParamsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBlockVersion();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getBlockIntervalInSecond();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getCommitteeSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getBlockReward();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getTransactionToLiveInterval();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getBondInterval();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getUnbondInterval();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getSortitionInterval();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+  f = message.getFeeFraction();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      9,
+      f
+    );
+  }
+  f = message.getMinimumFee();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+  f = message.getMaximumFee();
+  if (f !== 0) {
+    writer.writeInt64(
+      11,
+      f
+    );
+  }
+  f = message.getMinimumStake();
+  if (f !== 0) {
+    writer.writeInt64(
+      12,
+      f
+    );
+  }
+  f = message.getMaximumStake();
+  if (f !== 0) {
+    writer.writeInt64(
+      13,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 block_version = 1;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getBlockVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setBlockVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 block_interval_in_second = 2;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getBlockIntervalInSecond = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setBlockIntervalInSecond = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 committee_size = 3;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getCommitteeSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setCommitteeSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 block_reward = 4;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getBlockReward = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setBlockReward = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 transaction_to_live_interval = 5;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getTransactionToLiveInterval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setTransactionToLiveInterval = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 bond_interval = 6;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getBondInterval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setBondInterval = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 unbond_interval = 7;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getUnbondInterval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setUnbondInterval = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional uint32 sortition_interval = 8;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getSortitionInterval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setSortitionInterval = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional double fee_fraction = 9;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getFeeFraction = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 9, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setFeeFraction = function(value) {
+  return jspb.Message.setProto3FloatField(this, 9, value);
+};
+
+
+/**
+ * optional int64 minimum_fee = 10;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getMinimumFee = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setMinimumFee = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional int64 maximum_fee = 11;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getMaximumFee = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setMaximumFee = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional int64 minimum_stake = 12;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getMinimumStake = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setMinimumStake = function(value) {
+  return jspb.Message.setProto3IntField(this, 12, value);
+};
+
+
+/**
+ * optional int64 maximum_stake = 13;
+ * @return {number}
+ */
+proto.pactus.GetParamsResponse.prototype.getMaximumStake = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetParamsResponse} returns this
+ */
+proto.pactus.GetParamsResponse.prototype.setMaximumStake = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetBlockRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetBlockRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    verbosity: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetBlockRequest}
+ */
+proto.pactus.GetBlockRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetBlockRequest;
+  return proto.pactus.GetBlockRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetBlockRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetBlockRequest}
+ */
+proto.pactus.GetBlockRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.pactus.BlockVerbosity} */ (reader.readEnum());
+      msg.setVerbosity(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetBlockRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetBlockRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getVerbosity();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.GetBlockRequest.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockRequest} returns this
+ */
+proto.pactus.GetBlockRequest.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional BlockVerbosity verbosity = 2;
+ * @return {!proto.pactus.BlockVerbosity}
+ */
+proto.pactus.GetBlockRequest.prototype.getVerbosity = function() {
+  return /** @type {!proto.pactus.BlockVerbosity} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.pactus.BlockVerbosity} value
+ * @return {!proto.pactus.GetBlockRequest} returns this
+ */
+proto.pactus.GetBlockRequest.prototype.setVerbosity = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetBlockResponse.repeatedFields_ = [7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetBlockResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetBlockResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    hash: msg.getHash_asB64(),
+    data: msg.getData_asB64(),
+    blockTime: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    header: (f = msg.getHeader()) && proto.pactus.BlockHeaderInfo.toObject(includeInstance, f),
+    prevCert: (f = msg.getPrevCert()) && proto.pactus.CertificateInfo.toObject(includeInstance, f),
+    txsList: jspb.Message.toObjectList(msg.getTxsList(),
+    transaction_pb.TransactionInfo.toObject, includeInstance),
+    size: jspb.Message.getFieldWithDefault(msg, 8, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetBlockResponse}
+ */
+proto.pactus.GetBlockResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetBlockResponse;
+  return proto.pactus.GetBlockResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetBlockResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetBlockResponse}
+ */
+proto.pactus.GetBlockResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBlockTime(value);
+      break;
+    case 5:
+      var value = new proto.pactus.BlockHeaderInfo;
+      reader.readMessage(value,proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader);
+      msg.setHeader(value);
+      break;
+    case 6:
+      var value = new proto.pactus.CertificateInfo;
+      reader.readMessage(value,proto.pactus.CertificateInfo.deserializeBinaryFromReader);
+      msg.setPrevCert(value);
+      break;
+    case 7:
+      var value = new transaction_pb.TransactionInfo;
+      reader.readMessage(value,transaction_pb.TransactionInfo.deserializeBinaryFromReader);
+      msg.addTxs(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetBlockResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetBlockResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = message.getBlockTime();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getHeader();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.pactus.BlockHeaderInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getPrevCert();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.pactus.CertificateInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getTxsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      transaction_pb.TransactionInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.GetBlockResponse.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetBlockResponse.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {string}
+ */
+proto.pactus.GetBlockResponse.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockResponse.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional bytes data = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetBlockResponse.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes data = 3;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.pactus.GetBlockResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 block_time = 4;
+ * @return {number}
+ */
+proto.pactus.GetBlockResponse.prototype.getBlockTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.setBlockTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional BlockHeaderInfo header = 5;
+ * @return {?proto.pactus.BlockHeaderInfo}
+ */
+proto.pactus.GetBlockResponse.prototype.getHeader = function() {
+  return /** @type{?proto.pactus.BlockHeaderInfo} */ (
+    jspb.Message.getWrapperField(this, proto.pactus.BlockHeaderInfo, 5));
+};
+
+
+/**
+ * @param {?proto.pactus.BlockHeaderInfo|undefined} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+*/
+proto.pactus.GetBlockResponse.prototype.setHeader = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.clearHeader = function() {
+  return this.setHeader(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pactus.GetBlockResponse.prototype.hasHeader = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional CertificateInfo prev_cert = 6;
+ * @return {?proto.pactus.CertificateInfo}
+ */
+proto.pactus.GetBlockResponse.prototype.getPrevCert = function() {
+  return /** @type{?proto.pactus.CertificateInfo} */ (
+    jspb.Message.getWrapperField(this, proto.pactus.CertificateInfo, 6));
+};
+
+
+/**
+ * @param {?proto.pactus.CertificateInfo|undefined} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+*/
+proto.pactus.GetBlockResponse.prototype.setPrevCert = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.clearPrevCert = function() {
+  return this.setPrevCert(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pactus.GetBlockResponse.prototype.hasPrevCert = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * repeated TransactionInfo txs = 7;
+ * @return {!Array<!proto.pactus.TransactionInfo>}
+ */
+proto.pactus.GetBlockResponse.prototype.getTxsList = function() {
+  return /** @type{!Array<!proto.pactus.TransactionInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, transaction_pb.TransactionInfo, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.TransactionInfo>} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+*/
+proto.pactus.GetBlockResponse.prototype.setTxsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.pactus.TransactionInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.TransactionInfo}
+ */
+proto.pactus.GetBlockResponse.prototype.addTxs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.pactus.TransactionInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.clearTxsList = function() {
+  return this.setTxsList([]);
+};
+
+
+/**
+ * optional uint32 size = 8;
+ * @return {number}
+ */
+proto.pactus.GetBlockResponse.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockResponse} returns this
+ */
+proto.pactus.GetBlockResponse.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetRawBlockRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetRawBlockRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetRawBlockRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRawBlockRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetRawBlockRequest}
+ */
+proto.pactus.GetRawBlockRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetRawBlockRequest;
+  return proto.pactus.GetRawBlockRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetRawBlockRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetRawBlockRequest}
+ */
+proto.pactus.GetRawBlockRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetRawBlockRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetRawBlockRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetRawBlockRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRawBlockRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.GetRawBlockRequest.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetRawBlockRequest} returns this
+ */
+proto.pactus.GetRawBlockRequest.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetRawBlockResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetRawBlockResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetRawBlockResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRawBlockResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    blockData: msg.getBlockData_asB64(),
+    certificateData: msg.getCertificateData_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetRawBlockResponse}
+ */
+proto.pactus.GetRawBlockResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetRawBlockResponse;
+  return proto.pactus.GetRawBlockResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetRawBlockResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetRawBlockResponse}
+ */
+proto.pactus.GetRawBlockResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBlockData(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setCertificateData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetRawBlockResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetRawBlockResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetRawBlockResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRawBlockResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getBlockData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getCertificateData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.GetRawBlockResponse.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetRawBlockResponse} returns this
+ */
+proto.pactus.GetRawBlockResponse.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes block_data = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetRawBlockResponse.prototype.getBlockData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes block_data = 2;
+ * This is a type-conversion wrapper around `getBlockData()`
+ * @return {string}
+ */
+proto.pactus.GetRawBlockResponse.prototype.getBlockData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBlockData()));
+};
+
+
+/**
+ * optional bytes block_data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBlockData()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetRawBlockResponse.prototype.getBlockData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBlockData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetRawBlockResponse} returns this
+ */
+proto.pactus.GetRawBlockResponse.prototype.setBlockData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional bytes certificate_data = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetRawBlockResponse.prototype.getCertificateData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes certificate_data = 3;
+ * This is a type-conversion wrapper around `getCertificateData()`
+ * @return {string}
+ */
+proto.pactus.GetRawBlockResponse.prototype.getCertificateData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getCertificateData()));
+};
+
+
+/**
+ * optional bytes certificate_data = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getCertificateData()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetRawBlockResponse.prototype.getCertificateData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getCertificateData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetRawBlockResponse} returns this
+ */
+proto.pactus.GetRawBlockResponse.prototype.setCertificateData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetRecentBlocksRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetRecentBlocksRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetRecentBlocksRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRecentBlocksRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    count: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetRecentBlocksRequest}
+ */
+proto.pactus.GetRecentBlocksRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetRecentBlocksRequest;
+  return proto.pactus.GetRecentBlocksRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetRecentBlocksRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetRecentBlocksRequest}
+ */
+proto.pactus.GetRecentBlocksRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCount(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetRecentBlocksRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetRecentBlocksRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetRecentBlocksRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRecentBlocksRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 count = 1;
+ * @return {number}
+ */
+proto.pactus.GetRecentBlocksRequest.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetRecentBlocksRequest} returns this
+ */
+proto.pactus.GetRecentBlocksRequest.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetRecentBlocksResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetRecentBlocksResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetRecentBlocksResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetRecentBlocksResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRecentBlocksResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    blocksList: jspb.Message.toObjectList(msg.getBlocksList(),
+    proto.pactus.BlockSummary.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetRecentBlocksResponse}
+ */
+proto.pactus.GetRecentBlocksResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetRecentBlocksResponse;
+  return proto.pactus.GetRecentBlocksResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetRecentBlocksResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetRecentBlocksResponse}
+ */
+proto.pactus.GetRecentBlocksResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pactus.BlockSummary;
+      reader.readMessage(value,proto.pactus.BlockSummary.deserializeBinaryFromReader);
+      msg.addBlocks(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetRecentBlocksResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetRecentBlocksResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetRecentBlocksResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRecentBlocksResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBlocksList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pactus.BlockSummary.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated BlockSummary blocks = 1;
+ * @return {!Array<!proto.pactus.BlockSummary>}
+ */
+proto.pactus.GetRecentBlocksResponse.prototype.getBlocksList = function() {
+  return /** @type{!Array<!proto.pactus.BlockSummary>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.BlockSummary, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.BlockSummary>} value
+ * @return {!proto.pactus.GetRecentBlocksResponse} returns this
+*/
+proto.pactus.GetRecentBlocksResponse.prototype.setBlocksList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pactus.BlockSummary=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.BlockSummary}
+ */
+proto.pactus.GetRecentBlocksResponse.prototype.addBlocks = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.BlockSummary, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetRecentBlocksResponse} returns this
+ */
+proto.pactus.GetRecentBlocksResponse.prototype.clearBlocksList = function() {
+  return this.setBlocksList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.BlockSummary.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.BlockSummary.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.BlockSummary} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.BlockSummary.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    hash: msg.getHash_asB64(),
+    blockTime: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    txCount: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    proposerAddress: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.BlockSummary}
+ */
+proto.pactus.BlockSummary.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.BlockSummary;
+  return proto.pactus.BlockSummary.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.BlockSummary} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.BlockSummary}
+ */
+proto.pactus.BlockSummary.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBlockTime(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTxCount(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProposerAddress(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSize(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.BlockSummary.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.BlockSummary.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.BlockSummary} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.BlockSummary.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getBlockTime();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getTxCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getProposerAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.BlockSummary.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.BlockSummary} returns this
+ */
+proto.pactus.BlockSummary.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.BlockSummary.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {string}
+ */
+proto.pactus.BlockSummary.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.BlockSummary.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.BlockSummary} returns this
+ */
+proto.pactus.BlockSummary.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 block_time = 3;
+ * @return {number}
+ */
+proto.pactus.BlockSummary.prototype.getBlockTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.BlockSummary} returns this
+ */
+proto.pactus.BlockSummary.prototype.setBlockTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 tx_count = 4;
+ * @return {number}
+ */
+proto.pactus.BlockSummary.prototype.getTxCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.BlockSummary} returns this
+ */
+proto.pactus.BlockSummary.prototype.setTxCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string proposer_address = 5;
+ * @return {string}
+ */
+proto.pactus.BlockSummary.prototype.getProposerAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.BlockSummary} returns this
+ */
+proto.pactus.BlockSummary.prototype.setProposerAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 size = 6;
+ * @return {number}
+ */
+proto.pactus.BlockSummary.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.BlockSummary} returns this
+ */
+proto.pactus.BlockSummary.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockTransactionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetBlockTransactionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockTransactionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetBlockTransactionsRequest}
+ */
+proto.pactus.GetBlockTransactionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetBlockTransactionsRequest;
+  return proto.pactus.GetBlockTransactionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetBlockTransactionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetBlockTransactionsRequest}
+ */
+proto.pactus.GetBlockTransactionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPageSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetBlockTransactionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetBlockTransactionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockTransactionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockTransactionsRequest} returns this
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 page_size = 2;
+ * @return {number}
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockTransactionsRequest} returns this
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string page_token = 3;
+ * @return {string}
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetBlockTransactionsRequest} returns this
+ */
+proto.pactus.GetBlockTransactionsRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetBlockTransactionsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetBlockTransactionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockTransactionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetBlockTransactionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockTransactionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    txsList: jspb.Message.toObjectList(msg.getTxsList(),
+    transaction_pb.TransactionInfo.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetBlockTransactionsResponse}
+ */
+proto.pactus.GetBlockTransactionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetBlockTransactionsResponse;
+  return proto.pactus.GetBlockTransactionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetBlockTransactionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetBlockTransactionsResponse}
+ */
+proto.pactus.GetBlockTransactionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new transaction_pb.TransactionInfo;
+      reader.readMessage(value,transaction_pb.TransactionInfo.deserializeBinaryFromReader);
+      msg.addTxs(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockTransactionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetBlockTransactionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetBlockTransactionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockTransactionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTxsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      transaction_pb.TransactionInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated TransactionInfo txs = 1;
+ * @return {!Array<!proto.pactus.TransactionInfo>}
+ */
+proto.pactus.GetBlockTransactionsResponse.prototype.getTxsList = function() {
+  return /** @type{!Array<!proto.pactus.TransactionInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, transaction_pb.TransactionInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.TransactionInfo>} value
+ * @return {!proto.pactus.GetBlockTransactionsResponse} returns this
+*/
+proto.pactus.GetBlockTransactionsResponse.prototype.setTxsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pactus.TransactionInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.TransactionInfo}
+ */
+proto.pactus.GetBlockTransactionsResponse.prototype.addTxs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.TransactionInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetBlockTransactionsResponse} returns this
+ */
+proto.pactus.GetBlockTransactionsResponse.prototype.clearTxsList = function() {
+  return this.setTxsList([]);
+};
+
+
+/**
+ * optional string next_page_token = 2;
+ * @return {string}
+ */
+proto.pactus.GetBlockTransactionsResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetBlockTransactionsResponse} returns this
+ */
+proto.pactus.GetBlockTransactionsResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetBlockHashRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockHashRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetBlockHashRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockHashRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetBlockHashRequest}
+ */
+proto.pactus.GetBlockHashRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetBlockHashRequest;
+  return proto.pactus.GetBlockHashRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetBlockHashRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetBlockHashRequest}
+ */
+proto.pactus.GetBlockHashRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockHashRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetBlockHashRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetBlockHashRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockHashRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.GetBlockHashRequest.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockHashRequest} returns this
+ */
+proto.pactus.GetBlockHashRequest.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetBlockHashResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockHashResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetBlockHashResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetBlockHashResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    hash: msg.getHash_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetAccountRequest}
+ * @return {!proto.pactus.GetBlockHashResponse}
  */
-proto.pactus.GetAccountRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetBlockHashResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetAccountRequest;
-  return proto.pactus.GetAccountRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetBlockHashResponse;
+  return proto.pactus.GetBlockHashResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetAccountRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetBlockHashResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetAccountRequest}
+ * @return {!proto.pactus.GetBlockHashResponse}
  */
-proto.pactus.GetAccountRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetBlockHashResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -645,8 +7085,8 @@ proto.pactus.GetAccountRequest.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAddress(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
       break;
     default:
       reader.skipField();
@@ -661,9 +7101,9 @@ proto.pactus.GetAccountRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetAccountRequest.prototype.serializeBinary = function() {
+proto.pactus.GetBlockHashResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetAccountRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetBlockHashResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -671,15 +7111,15 @@ proto.pactus.GetAccountRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetAccountRequest} message
+ * @param {!proto.pactus.GetBlockHashResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetAccountRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetBlockHashResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAddress();
+  f = message.getHash_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
@@ -688,20 +7128,44 @@ proto.pactus.GetAccountRequest.serializeBinaryToWriter = function(message, write
 
 
 /**
- * optional string address = 1;
+ * optional bytes hash = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetBlockHashResponse.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes hash = 1;
+ * This is a type-conversion wrapper around `getHash()`
  * @return {string}
  */
-proto.pactus.GetAccountRequest.prototype.getAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pactus.GetBlockHashResponse.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pactus.GetAccountRequest} returns this
+ * optional bytes hash = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
  */
-proto.pactus.GetAccountRequest.prototype.setAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pactus.GetBlockHashResponse.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetBlockHashResponse} returns this
+ */
+proto.pactus.GetBlockHashResponse.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
@@ -721,8 +7185,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetAccountResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetAccountResponse.toObject(opt_includeInstance, this);
+proto.pactus.GetBlockHashByTimeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockHashByTimeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -731,13 +7195,13 @@ proto.pactus.GetAccountResponse.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetAccountResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.GetBlockHashByTimeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetAccountResponse.toObject = function(includeInstance, msg) {
+proto.pactus.GetBlockHashByTimeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    account: (f = msg.getAccount()) && proto.pactus.AccountInfo.toObject(includeInstance, f)
+    time: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -751,23 +7215,23 @@ proto.pactus.GetAccountResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetAccountResponse}
+ * @return {!proto.pactus.GetBlockHashByTimeRequest}
  */
-proto.pactus.GetAccountResponse.deserializeBinary = function(bytes) {
+proto.pactus.GetBlockHashByTimeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetAccountResponse;
-  return proto.pactus.GetAccountResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetBlockHashByTimeRequest;
+  return proto.pactus.GetBlockHashByTimeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetAccountResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetBlockHashByTimeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetAccountResponse}
+ * @return {!proto.pactus.GetBlockHashByTimeRequest}
  */
-proto.pactus.GetAccountResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetBlockHashByTimeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -775,9 +7239,8 @@ proto.pactus.GetAccountResponse.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.pactus.AccountInfo;
-      reader.readMessage(value,proto.pactus.AccountInfo.deserializeBinaryFromReader);
-      msg.setAccount(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTime(value);
       break;
     default:
       reader.skipField();
@@ -792,9 +7255,9 @@ proto.pactus.GetAccountResponse.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetAccountResponse.prototype.serializeBinary = function() {
+proto.pactus.GetBlockHashByTimeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetAccountResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetBlockHashByTimeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -802,57 +7265,37 @@ proto.pactus.GetAccountResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetAccountResponse} message
+ * @param {!proto.pactus.GetBlockHashByTimeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetAccountResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetBlockHashByTimeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAccount();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getTime();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
-      f,
-      proto.pactus.AccountInfo.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional AccountInfo account = 1;
- * @return {?proto.pactus.AccountInfo}
- */
-proto.pactus.GetAccountResponse.prototype.getAccount = function() {
-  return /** @type{?proto.pactus.AccountInfo} */ (
-    jspb.Message.getWrapperField(this, proto.pactus.AccountInfo, 1));
-};
-
-
-/**
- * @param {?proto.pactus.AccountInfo|undefined} value
- * @return {!proto.pactus.GetAccountResponse} returns this
-*/
-proto.pactus.GetAccountResponse.prototype.setAccount = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pactus.GetAccountResponse} returns this
+ * optional uint32 time = 1;
+ * @return {number}
  */
-proto.pactus.GetAccountResponse.prototype.clearAccount = function() {
-  return this.setAccount(undefined);
+proto.pactus.GetBlockHashByTimeRequest.prototype.getTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockHashByTimeRequest} returns this
  */
-proto.pactus.GetAccountResponse.prototype.hasAccount = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.pactus.GetBlockHashByTimeRequest.prototype.setTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -872,8 +7315,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetValidatorAddressesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetValidatorAddressesRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetBlockHashByTimeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockHashByTimeResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -882,13 +7325,15 @@ proto.pactus.GetValidatorAddressesRequest.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetValidatorAddressesRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetBlockHashByTimeResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorAddressesRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetBlockHashByTimeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    hash: msg.getHash_asB64(),
+    blockTime: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -902,29 +7347,41 @@ proto.pactus.GetValidatorAddressesRequest.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetValidatorAddressesRequest}
+ * @return {!proto.pactus.GetBlockHashByTimeResponse}
  */
-proto.pactus.GetValidatorAddressesRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetBlockHashByTimeResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetValidatorAddressesRequest;
-  return proto.pactus.GetValidatorAddressesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetBlockHashByTimeResponse;
+  return proto.pactus.GetBlockHashByTimeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetValidatorAddressesRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetBlockHashByTimeResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetValidatorAddressesRequest}
+ * @return {!proto.pactus.GetBlockHashByTimeResponse}
  */
-proto.pactus.GetValidatorAddressesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetBlockHashByTimeResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBlockTime(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -938,9 +7395,9 @@ proto.pactus.GetValidatorAddressesRequest.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetValidatorAddressesRequest.prototype.serializeBinary = function() {
+proto.pactus.GetBlockHashByTimeResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetValidatorAddressesRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetBlockHashByTimeResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -948,22 +7405,114 @@ proto.pactus.GetValidatorAddressesRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetValidatorAddressesRequest} message
+ * @param {!proto.pactus.GetBlockHashByTimeResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorAddressesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetBlockHashByTimeResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getBlockTime();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
 };
 
 
+/**
+ * optional uint32 height = 1;
+ * @return {number}
+ */
+proto.pactus.GetBlockHashByTimeResponse.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockHashByTimeResponse} returns this
+ */
+proto.pactus.GetBlockHashByTimeResponse.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetBlockHashByTimeResponse.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {string}
+ */
+proto.pactus.GetBlockHashByTimeResponse.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
+};
+
+
+/**
+ * optional bytes hash = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetBlockHashByTimeResponse.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetBlockHashByTimeResponse} returns this
+ */
+proto.pactus.GetBlockHashByTimeResponse.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 block_time = 3;
+ * @return {number}
+ */
+proto.pactus.GetBlockHashByTimeResponse.prototype.getBlockTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockHashByTimeResponse} returns this
  */
-proto.pactus.GetValidatorAddressesResponse.repeatedFields_ = [1];
+proto.pactus.GetBlockHashByTimeResponse.prototype.setBlockTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 
 
@@ -980,8 +7529,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetValidatorAddressesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetValidatorAddressesResponse.toObject(opt_includeInstance, this);
+proto.pactus.GetBlockHeightRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockHeightRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -990,13 +7539,13 @@ proto.pactus.GetValidatorAddressesResponse.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetValidatorAddressesResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.GetBlockHeightRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorAddressesResponse.toObject = function(includeInstance, msg) {
+proto.pactus.GetBlockHeightRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    addressesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    hash: msg.getHash_asB64()
   };
 
   if (includeInstance) {
@@ -1010,23 +7559,23 @@ proto.pactus.GetValidatorAddressesResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetValidatorAddressesResponse}
+ * @return {!proto.pactus.GetBlockHeightRequest}
  */
-proto.pactus.GetValidatorAddressesResponse.deserializeBinary = function(bytes) {
+proto.pactus.GetBlockHeightRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetValidatorAddressesResponse;
-  return proto.pactus.GetValidatorAddressesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetBlockHeightRequest;
+  return proto.pactus.GetBlockHeightRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetValidatorAddressesResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetBlockHeightRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetValidatorAddressesResponse}
+ * @return {!proto.pactus.GetBlockHeightRequest}
  */
-proto.pactus.GetValidatorAddressesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetBlockHeightRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1034,8 +7583,8 @@ proto.pactus.GetValidatorAddressesResponse.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addAddresses(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
       break;
     default:
       reader.skipField();
@@ -1050,9 +7599,9 @@ proto.pactus.GetValidatorAddressesResponse.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetValidatorAddressesResponse.prototype.serializeBinary = function() {
+proto.pactus.GetBlockHeightRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetValidatorAddressesResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetBlockHeightRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1060,15 +7609,15 @@ proto.pactus.GetValidatorAddressesResponse.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetValidatorAddressesResponse} message
+ * @param {!proto.pactus.GetBlockHeightRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorAddressesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetBlockHeightRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAddressesList();
+  f = message.getHash_asU8();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeBytes(
       1,
       f
     );
@@ -1077,39 +7626,44 @@ proto.pactus.GetValidatorAddressesResponse.serializeBinaryToWriter = function(me
 
 
 /**
- * repeated string addresses = 1;
- * @return {!Array<string>}
+ * optional bytes hash = 1;
+ * @return {!(string|Uint8Array)}
  */
-proto.pactus.GetValidatorAddressesResponse.prototype.getAddressesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.pactus.GetBlockHeightRequest.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+ * optional bytes hash = 1;
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {string}
  */
-proto.pactus.GetValidatorAddressesResponse.prototype.setAddressesList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.pactus.GetBlockHeightRequest.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+ * optional bytes hash = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
  */
-proto.pactus.GetValidatorAddressesResponse.prototype.addAddresses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.pactus.GetBlockHeightRequest.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pactus.GetValidatorAddressesResponse} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetBlockHeightRequest} returns this
  */
-proto.pactus.GetValidatorAddressesResponse.prototype.clearAddressesList = function() {
-  return this.setAddressesList([]);
+proto.pactus.GetBlockHeightRequest.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
@@ -1129,8 +7683,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetValidatorRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetValidatorRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetBlockHeightResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockHeightResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1139,13 +7693,13 @@ proto.pactus.GetValidatorRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetValidatorRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetBlockHeightResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetBlockHeightResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    address: jspb.Message.getFieldWithDefault(msg, 1, "")
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -1159,23 +7713,23 @@ proto.pactus.GetValidatorRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetValidatorRequest}
+ * @return {!proto.pactus.GetBlockHeightResponse}
  */
-proto.pactus.GetValidatorRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetBlockHeightResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetValidatorRequest;
-  return proto.pactus.GetValidatorRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetBlockHeightResponse;
+  return proto.pactus.GetBlockHeightResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetValidatorRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetBlockHeightResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetValidatorRequest}
+ * @return {!proto.pactus.GetBlockHeightResponse}
  */
-proto.pactus.GetValidatorRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetBlockHeightResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1183,8 +7737,8 @@ proto.pactus.GetValidatorRequest.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAddress(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
       break;
     default:
       reader.skipField();
@@ -1199,9 +7753,9 @@ proto.pactus.GetValidatorRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetValidatorRequest.prototype.serializeBinary = function() {
+proto.pactus.GetBlockHeightResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetValidatorRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetBlockHeightResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1209,15 +7763,15 @@ proto.pactus.GetValidatorRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetValidatorRequest} message
+ * @param {!proto.pactus.GetBlockHeightResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetBlockHeightResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAddress();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
@@ -1226,20 +7780,20 @@ proto.pactus.GetValidatorRequest.serializeBinaryToWriter = function(message, wri
 
 
 /**
- * optional string address = 1;
- * @return {string}
+ * optional uint32 height = 1;
+ * @return {number}
  */
-proto.pactus.GetValidatorRequest.prototype.getAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pactus.GetBlockHeightResponse.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pactus.GetValidatorRequest} returns this
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockHeightResponse} returns this
  */
-proto.pactus.GetValidatorRequest.prototype.setAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pactus.GetBlockHeightResponse.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -1259,8 +7813,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetValidatorByNumberRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetValidatorByNumberRequest.toObject(opt_includeInstance, this);
+proto.pactus.SubscribeBlockHeightRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.SubscribeBlockHeightRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1269,13 +7823,13 @@ proto.pactus.GetValidatorByNumberRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetValidatorByNumberRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.SubscribeBlockHeightRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorByNumberRequest.toObject = function(includeInstance, msg) {
+proto.pactus.SubscribeBlockHeightRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    number: jspb.Message.getFieldWithDefault(msg, 1, 0)
+
   };
 
   if (includeInstance) {
@@ -1289,33 +7843,29 @@ proto.pactus.GetValidatorByNumberRequest.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetValidatorByNumberRequest}
+ * @return {!proto.pactus.SubscribeBlockHeightRequest}
  */
-proto.pactus.GetValidatorByNumberRequest.deserializeBinary = function(bytes) {
+proto.pactus.SubscribeBlockHeightRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetValidatorByNumberRequest;
-  return proto.pactus.GetValidatorByNumberRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.SubscribeBlockHeightRequest;
+  return proto.pactus.SubscribeBlockHeightRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetValidatorByNumberRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.SubscribeBlockHeightRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetValidatorByNumberRequest}
+ * @return {!proto.pactus.SubscribeBlockHeightRequest}
  */
-proto.pactus.GetValidatorByNumberRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.SubscribeBlockHeightRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setNumber(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -1329,9 +7879,9 @@ proto.pactus.GetValidatorByNumberRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetValidatorByNumberRequest.prototype.serializeBinary = function() {
+proto.pactus.SubscribeBlockHeightRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetValidatorByNumberRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.SubscribeBlockHeightRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1339,37 +7889,12 @@ proto.pactus.GetValidatorByNumberRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetValidatorByNumberRequest} message
+ * @param {!proto.pactus.SubscribeBlockHeightRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorByNumberRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.SubscribeBlockHeightRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNumber();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional int32 number = 1;
- * @return {number}
- */
-proto.pactus.GetValidatorByNumberRequest.prototype.getNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.pactus.GetValidatorByNumberRequest} returns this
- */
-proto.pactus.GetValidatorByNumberRequest.prototype.setNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -1389,8 +7914,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetValidatorResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetValidatorResponse.toObject(opt_includeInstance, this);
+proto.pactus.SubscribeBlockHeightResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.SubscribeBlockHeightResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1399,13 +7924,13 @@ proto.pactus.GetValidatorResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetValidatorResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.SubscribeBlockHeightResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorResponse.toObject = function(includeInstance, msg) {
+proto.pactus.SubscribeBlockHeightResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    validator: (f = msg.getValidator()) && proto.pactus.ValidatorInfo.toObject(includeInstance, f)
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -1419,23 +7944,23 @@ proto.pactus.GetValidatorResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetValidatorResponse}
+ * @return {!proto.pactus.SubscribeBlockHeightResponse}
  */
-proto.pactus.GetValidatorResponse.deserializeBinary = function(bytes) {
+proto.pactus.SubscribeBlockHeightResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetValidatorResponse;
-  return proto.pactus.GetValidatorResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.SubscribeBlockHeightResponse;
+  return proto.pactus.SubscribeBlockHeightResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetValidatorResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.SubscribeBlockHeightResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetValidatorResponse}
+ * @return {!proto.pactus.SubscribeBlockHeightResponse}
  */
-proto.pactus.GetValidatorResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.SubscribeBlockHeightResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1443,9 +7968,8 @@ proto.pactus.GetValidatorResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.pactus.ValidatorInfo;
-      reader.readMessage(value,proto.pactus.ValidatorInfo.deserializeBinaryFromReader);
-      msg.setValidator(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
       break;
     default:
       reader.skipField();
@@ -1460,9 +7984,9 @@ proto.pactus.GetValidatorResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetValidatorResponse.prototype.serializeBinary = function() {
+proto.pactus.SubscribeBlockHeightResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetValidatorResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.SubscribeBlockHeightResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1470,57 +7994,37 @@ proto.pactus.GetValidatorResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetValidatorResponse} message
+ * @param {!proto.pactus.SubscribeBlockHeightResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetValidatorResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.SubscribeBlockHeightResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getValidator();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
-      f,
-      proto.pactus.ValidatorInfo.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional ValidatorInfo validator = 1;
- * @return {?proto.pactus.ValidatorInfo}
- */
-proto.pactus.GetValidatorResponse.prototype.getValidator = function() {
-  return /** @type{?proto.pactus.ValidatorInfo} */ (
-    jspb.Message.getWrapperField(this, proto.pactus.ValidatorInfo, 1));
-};
-
-
-/**
- * @param {?proto.pactus.ValidatorInfo|undefined} value
- * @return {!proto.pactus.GetValidatorResponse} returns this
-*/
-proto.pactus.GetValidatorResponse.prototype.setValidator = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pactus.GetValidatorResponse} returns this
+ * optional uint32 height = 1;
+ * @return {number}
  */
-proto.pactus.GetValidatorResponse.prototype.clearValidator = function() {
-  return this.setValidator(undefined);
+proto.pactus.SubscribeBlockHeightResponse.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.pactus.SubscribeBlockHeightResponse} returns this
  */
-proto.pactus.GetValidatorResponse.prototype.hasValidator = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.pactus.SubscribeBlockHeightResponse.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
@@ -1540,8 +8044,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetPublicKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetPublicKeyRequest.toObject(opt_includeInstance, this);
+proto.pactus.SubscribeAccountRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.SubscribeAccountRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1550,11 +8054,11 @@ proto.pactus.GetPublicKeyRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetPublicKeyRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.SubscribeAccountRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetPublicKeyRequest.toObject = function(includeInstance, msg) {
+proto.pactus.SubscribeAccountRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     address: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
@@ -1570,23 +8074,23 @@ proto.pactus.GetPublicKeyRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetPublicKeyRequest}
+ * @return {!proto.pactus.SubscribeAccountRequest}
  */
-proto.pactus.GetPublicKeyRequest.deserializeBinary = function(bytes) {
+proto.pactus.SubscribeAccountRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetPublicKeyRequest;
-  return proto.pactus.GetPublicKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.SubscribeAccountRequest;
+  return proto.pactus.SubscribeAccountRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetPublicKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.SubscribeAccountRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetPublicKeyRequest}
+ * @return {!proto.pactus.SubscribeAccountRequest}
  */
-proto.pactus.GetPublicKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.SubscribeAccountRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1610,9 +8114,9 @@ proto.pactus.GetPublicKeyRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetPublicKeyRequest.prototype.serializeBinary = function() {
+proto.pactus.SubscribeAccountRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetPublicKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.SubscribeAccountRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1620,11 +8124,11 @@ proto.pactus.GetPublicKeyRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetPublicKeyRequest} message
+ * @param {!proto.pactus.SubscribeAccountRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetPublicKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.SubscribeAccountRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getAddress();
   if (f.length > 0) {
@@ -1640,16 +8144,16 @@ proto.pactus.GetPublicKeyRequest.serializeBinaryToWriter = function(message, wri
  * optional string address = 1;
  * @return {string}
  */
-proto.pactus.GetPublicKeyRequest.prototype.getAddress = function() {
+proto.pactus.SubscribeAccountRequest.prototype.getAddress = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pactus.GetPublicKeyRequest} returns this
+ * @return {!proto.pactus.SubscribeAccountRequest} returns this
  */
-proto.pactus.GetPublicKeyRequest.prototype.setAddress = function(value) {
+proto.pactus.SubscribeAccountRequest.prototype.setAddress = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -1670,8 +8174,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetPublicKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetPublicKeyResponse.toObject(opt_includeInstance, this);
+proto.pactus.SubscribeAccountResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.SubscribeAccountResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1680,13 +8184,14 @@ proto.pactus.GetPublicKeyResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetPublicKeyResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.SubscribeAccountResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetPublicKeyResponse.toObject = function(includeInstance, msg) {
+proto.pactus.SubscribeAccountResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    publicKey: jspb.Message.getFieldWithDefault(msg, 1, "")
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    transaction: (f = msg.getTransaction()) && transaction_pb.TransactionInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1700,23 +8205,23 @@ proto.pactus.GetPublicKeyResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetPublicKeyResponse}
+ * @return {!proto.pactus.SubscribeAccountResponse}
  */
-proto.pactus.GetPublicKeyResponse.deserializeBinary = function(bytes) {
+proto.pactus.SubscribeAccountResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetPublicKeyResponse;
-  return proto.pactus.GetPublicKeyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.SubscribeAccountResponse;
+  return proto.pactus.SubscribeAccountResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetPublicKeyResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.SubscribeAccountResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetPublicKeyResponse}
+ * @return {!proto.pactus.SubscribeAccountResponse}
  */
-proto.pactus.GetPublicKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.SubscribeAccountResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1724,8 +8229,13 @@ proto.pactus.GetPublicKeyResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPublicKey(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = new transaction_pb.TransactionInfo;
+      reader.readMessage(value,transaction_pb.TransactionInfo.deserializeBinaryFromReader);
+      msg.setTransaction(value);
       break;
     default:
       reader.skipField();
@@ -1740,9 +8250,9 @@ proto.pactus.GetPublicKeyResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetPublicKeyResponse.prototype.serializeBinary = function() {
+proto.pactus.SubscribeAccountResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetPublicKeyResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.SubscribeAccountResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1750,37 +8260,82 @@ proto.pactus.GetPublicKeyResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetPublicKeyResponse} message
+ * @param {!proto.pactus.SubscribeAccountResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetPublicKeyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.SubscribeAccountResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPublicKey();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
+  f = message.getTransaction();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      transaction_pb.TransactionInfo.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string public_key = 1;
- * @return {string}
+ * optional uint32 height = 1;
+ * @return {number}
  */
-proto.pactus.GetPublicKeyResponse.prototype.getPublicKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pactus.SubscribeAccountResponse.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pactus.GetPublicKeyResponse} returns this
+ * @param {number} value
+ * @return {!proto.pactus.SubscribeAccountResponse} returns this
  */
-proto.pactus.GetPublicKeyResponse.prototype.setPublicKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pactus.SubscribeAccountResponse.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional TransactionInfo transaction = 2;
+ * @return {?proto.pactus.TransactionInfo}
+ */
+proto.pactus.SubscribeAccountResponse.prototype.getTransaction = function() {
+  return /** @type{?proto.pactus.TransactionInfo} */ (
+    jspb.Message.getWrapperField(this, transaction_pb.TransactionInfo, 2));
+};
+
+
+/**
+ * @param {?proto.pactus.TransactionInfo|undefined} value
+ * @return {!proto.pactus.SubscribeAccountResponse} returns this
+*/
+proto.pactus.SubscribeAccountResponse.prototype.setTransaction = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pactus.SubscribeAccountResponse} returns this
+ */
+proto.pactus.SubscribeAccountResponse.prototype.clearTransaction = function() {
+  return this.setTransaction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pactus.SubscribeAccountResponse.prototype.hasTransaction = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -1800,8 +8355,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetBlockchainInfoRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockchainInfoRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1810,14 +8365,13 @@ proto.pactus.GetBlockRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetBlockchainInfoRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetBlockchainInfoRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    verbosity: jspb.Message.getFieldWithDefault(msg, 2, 0)
+
   };
 
   if (includeInstance) {
@@ -1831,37 +8385,29 @@ proto.pactus.GetBlockRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockRequest}
+ * @return {!proto.pactus.GetBlockchainInfoRequest}
  */
-proto.pactus.GetBlockRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetBlockchainInfoRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockRequest;
-  return proto.pactus.GetBlockRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetBlockchainInfoRequest;
+  return proto.pactus.GetBlockchainInfoRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetBlockchainInfoRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockRequest}
+ * @return {!proto.pactus.GetBlockchainInfoRequest}
  */
-proto.pactus.GetBlockRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetBlockchainInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setHeight(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.pactus.BlockVerbosity} */ (reader.readEnum());
-      msg.setVerbosity(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -1875,9 +8421,9 @@ proto.pactus.GetBlockRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockRequest.prototype.serializeBinary = function() {
+proto.pactus.GetBlockchainInfoRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetBlockchainInfoRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1885,62 +8431,12 @@ proto.pactus.GetBlockRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockRequest} message
+ * @param {!proto.pactus.GetBlockchainInfoRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetBlockchainInfoRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHeight();
-  if (f !== 0) {
-    writer.writeUint32(
-      1,
-      f
-    );
-  }
-  f = message.getVerbosity();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * optional uint32 height = 1;
- * @return {number}
- */
-proto.pactus.GetBlockRequest.prototype.getHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.pactus.GetBlockRequest} returns this
- */
-proto.pactus.GetBlockRequest.prototype.setHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional BlockVerbosity verbosity = 2;
- * @return {!proto.pactus.BlockVerbosity}
- */
-proto.pactus.GetBlockRequest.prototype.getVerbosity = function() {
-  return /** @type {!proto.pactus.BlockVerbosity} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {!proto.pactus.BlockVerbosity} value
- * @return {!proto.pactus.GetBlockRequest} returns this
- */
-proto.pactus.GetBlockRequest.prototype.setVerbosity = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
@@ -1950,7 +8446,7 @@ proto.pactus.GetBlockRequest.prototype.setVerbosity = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.pactus.GetBlockResponse.repeatedFields_ = [7];
+proto.pactus.GetBlockchainInfoResponse.repeatedFields_ = [7];
 
 
 
@@ -1967,8 +8463,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockResponse.toObject(opt_includeInstance, this);
+proto.pactus.GetBlockchainInfoResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetBlockchainInfoResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1977,20 +8473,26 @@ proto.pactus.GetBlockResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.GetBlockchainInfoResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockResponse.toObject = function(includeInstance, msg) {
+proto.pactus.GetBlockchainInfoResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    hash: msg.getHash_asB64(),
-    data: msg.getData_asB64(),
-    blockTime: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    header: (f = msg.getHeader()) && proto.pactus.BlockHeaderInfo.toObject(includeInstance, f),
-    prevCert: (f = msg.getPrevCert()) && proto.pactus.CertificateInfo.toObject(includeInstance, f),
-    txsList: jspb.Message.toObjectList(msg.getTxsList(),
-    transaction_pb.TransactionInfo.toObject, includeInstance)
+    lastBlockHeight: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    lastBlockHash: msg.getLastBlockHash_asB64(),
+    totalAccounts: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    totalValidators: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    totalPower: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    committeePower: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    committeeValidatorsList: jspb.Message.toObjectList(msg.getCommitteeValidatorsList(),
+    proto.pactus.ValidatorInfo.toObject, includeInstance),
+    estimatedSyncTime: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    totalSupply: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    treasuryBalance: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    circulatingSupply: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    txCountPerTypeMap: (f = msg.getTxCountPerTypeMap()) ? f.toObject(includeInstance, undefined) : [],
+    txStatsBlocks: jspb.Message.getFieldWithDefault(msg, 13, 0)
   };
 
   if (includeInstance) {
@@ -2004,23 +8506,23 @@ proto.pactus.GetBlockResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockResponse}
+ * @return {!proto.pactus.GetBlockchainInfoResponse}
  */
-proto.pactus.GetBlockResponse.deserializeBinary = function(bytes) {
+proto.pactus.GetBlockchainInfoResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockResponse;
-  return proto.pactus.GetBlockResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetBlockchainInfoResponse;
+  return proto.pactus.GetBlockchainInfoResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetBlockchainInfoResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockResponse}
+ * @return {!proto.pactus.GetBlockchainInfoResponse}
  */
-proto.pactus.GetBlockResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetBlockchainInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2029,34 +8531,58 @@ proto.pactus.GetBlockResponse.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {number} */ (reader.readUint32());
-      msg.setHeight(value);
+      msg.setLastBlockHeight(value);
       break;
     case 2:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setHash(value);
+      msg.setLastBlockHash(value);
       break;
     case 3:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotalAccounts(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setBlockTime(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotalValidators(value);
       break;
     case 5:
-      var value = new proto.pactus.BlockHeaderInfo;
-      reader.readMessage(value,proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader);
-      msg.setHeader(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalPower(value);
       break;
     case 6:
-      var value = new proto.pactus.CertificateInfo;
-      reader.readMessage(value,proto.pactus.CertificateInfo.deserializeBinaryFromReader);
-      msg.setPrevCert(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCommitteePower(value);
       break;
     case 7:
-      var value = new transaction_pb.TransactionInfo;
-      reader.readMessage(value,transaction_pb.TransactionInfo.deserializeBinaryFromReader);
-      msg.addTxs(value);
+      var value = new proto.pactus.ValidatorInfo;
+      reader.readMessage(value,proto.pactus.ValidatorInfo.deserializeBinaryFromReader);
+      msg.addCommitteeValidators(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEstimatedSyncTime(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalSupply(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTreasuryBalance(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCirculatingSupply(value);
+      break;
+    case 12:
+      var value = msg.getTxCountPerTypeMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readInt32, null, 0, 0);
+         });
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTxStatsBlocks(value);
       break;
     default:
       reader.skipField();
@@ -2071,9 +8597,9 @@ proto.pactus.GetBlockResponse.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockResponse.prototype.serializeBinary = function() {
+proto.pactus.GetBlockchainInfoResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetBlockchainInfoResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2081,296 +8607,384 @@ proto.pactus.GetBlockResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockResponse} message
+ * @param {!proto.pactus.GetBlockchainInfoResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetBlockchainInfoResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHeight();
+  f = message.getLastBlockHeight();
   if (f !== 0) {
     writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getHash_asU8();
+  f = message.getLastBlockHash_asU8();
   if (f.length > 0) {
     writer.writeBytes(
       2,
       f
     );
-  }
-  f = message.getData_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
+  }
+  f = message.getTotalAccounts();
+  if (f !== 0) {
+    writer.writeInt32(
       3,
       f
     );
   }
-  f = message.getBlockTime();
+  f = message.getTotalValidators();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getHeader();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getTotalPower();
+  if (f !== 0) {
+    writer.writeInt64(
       5,
-      f,
-      proto.pactus.BlockHeaderInfo.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getPrevCert();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCommitteePower();
+  if (f !== 0) {
+    writer.writeInt64(
       6,
-      f,
-      proto.pactus.CertificateInfo.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getTxsList();
+  f = message.getCommitteeValidatorsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       7,
       f,
-      transaction_pb.TransactionInfo.serializeBinaryToWriter
+      proto.pactus.ValidatorInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getEstimatedSyncTime();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getTotalSupply();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getTreasuryBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+  f = message.getCirculatingSupply();
+  if (f !== 0) {
+    writer.writeInt64(
+      11,
+      f
+    );
+  }
+  f = message.getTxCountPerTypeMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(12, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeInt32);
+  }
+  f = message.getTxStatsBlocks();
+  if (f !== 0) {
+    writer.writeUint32(
+      13,
+      f
     );
   }
 };
 
 
 /**
- * optional uint32 height = 1;
+ * optional uint32 last_block_height = 1;
  * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.getHeight = function() {
+proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHeight = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.setHeight = function(value) {
+proto.pactus.GetBlockchainInfoResponse.prototype.setLastBlockHeight = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bytes hash = 2;
+ * optional bytes last_block_hash = 2;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.GetBlockResponse.prototype.getHash = function() {
+proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHash = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bytes hash = 2;
- * This is a type-conversion wrapper around `getHash()`
+ * optional bytes last_block_hash = 2;
+ * This is a type-conversion wrapper around `getLastBlockHash()`
  * @return {string}
  */
-proto.pactus.GetBlockResponse.prototype.getHash_asB64 = function() {
+proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHash_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getHash()));
+      this.getLastBlockHash()));
 };
 
 
 /**
- * optional bytes hash = 2;
+ * optional bytes last_block_hash = 2;
  * Note that Uint8Array is not supported on all browsers.
  * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getHash()`
+ * This is a type-conversion wrapper around `getLastBlockHash()`
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockResponse.prototype.getHash_asU8 = function() {
+proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHash_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getHash()));
+      this.getLastBlockHash()));
 };
 
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.setHash = function(value) {
+proto.pactus.GetBlockchainInfoResponse.prototype.setLastBlockHash = function(value) {
   return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
 /**
- * optional bytes data = 3;
- * @return {!(string|Uint8Array)}
+ * optional int32 total_accounts = 3;
+ * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.getData = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.pactus.GetBlockchainInfoResponse.prototype.getTotalAccounts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * optional bytes data = 3;
- * This is a type-conversion wrapper around `getData()`
- * @return {string}
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
+proto.pactus.GetBlockchainInfoResponse.prototype.setTotalAccounts = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional bytes data = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
+ * optional int32 total_validators = 4;
+ * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+proto.pactus.GetBlockchainInfoResponse.prototype.getTotalValidators = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 3, value);
+proto.pactus.GetBlockchainInfoResponse.prototype.setTotalValidators = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional uint32 block_time = 4;
+ * optional int64 total_power = 5;
  * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.getBlockTime = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.pactus.GetBlockchainInfoResponse.prototype.getTotalPower = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.setBlockTime = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.pactus.GetBlockchainInfoResponse.prototype.setTotalPower = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional BlockHeaderInfo header = 5;
- * @return {?proto.pactus.BlockHeaderInfo}
+ * optional int64 committee_power = 6;
+ * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.getHeader = function() {
-  return /** @type{?proto.pactus.BlockHeaderInfo} */ (
-    jspb.Message.getWrapperField(this, proto.pactus.BlockHeaderInfo, 5));
+proto.pactus.GetBlockchainInfoResponse.prototype.getCommitteePower = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {?proto.pactus.BlockHeaderInfo|undefined} value
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.setCommitteePower = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * repeated ValidatorInfo committee_validators = 7;
+ * @return {!Array<!proto.pactus.ValidatorInfo>}
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.getCommitteeValidatorsList = function() {
+  return /** @type{!Array<!proto.pactus.ValidatorInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.ValidatorInfo, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.ValidatorInfo>} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
 */
-proto.pactus.GetBlockResponse.prototype.setHeader = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+proto.pactus.GetBlockchainInfoResponse.prototype.setCommitteeValidatorsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * @param {!proto.pactus.ValidatorInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.ValidatorInfo}
  */
-proto.pactus.GetBlockResponse.prototype.clearHeader = function() {
-  return this.setHeader(undefined);
+proto.pactus.GetBlockchainInfoResponse.prototype.addCommitteeValidators = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.pactus.ValidatorInfo, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.hasHeader = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.pactus.GetBlockchainInfoResponse.prototype.clearCommitteeValidatorsList = function() {
+  return this.setCommitteeValidatorsList([]);
 };
 
 
 /**
- * optional CertificateInfo prev_cert = 6;
- * @return {?proto.pactus.CertificateInfo}
+ * optional int64 estimated_sync_time = 8;
+ * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.getPrevCert = function() {
-  return /** @type{?proto.pactus.CertificateInfo} */ (
-    jspb.Message.getWrapperField(this, proto.pactus.CertificateInfo, 6));
+proto.pactus.GetBlockchainInfoResponse.prototype.getEstimatedSyncTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * @param {?proto.pactus.CertificateInfo|undefined} value
- * @return {!proto.pactus.GetBlockResponse} returns this
-*/
-proto.pactus.GetBlockResponse.prototype.setPrevCert = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.setEstimatedSyncTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * optional int64 total_supply = 9;
+ * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.clearPrevCert = function() {
-  return this.setPrevCert(undefined);
+proto.pactus.GetBlockchainInfoResponse.prototype.getTotalSupply = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.hasPrevCert = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.pactus.GetBlockchainInfoResponse.prototype.setTotalSupply = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
 };
 
 
 /**
- * repeated TransactionInfo txs = 7;
- * @return {!Array<!proto.pactus.TransactionInfo>}
+ * optional int64 treasury_balance = 10;
+ * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.getTxsList = function() {
-  return /** @type{!Array<!proto.pactus.TransactionInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, transaction_pb.TransactionInfo, 7));
+proto.pactus.GetBlockchainInfoResponse.prototype.getTreasuryBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
 };
 
 
 /**
- * @param {!Array<!proto.pactus.TransactionInfo>} value
- * @return {!proto.pactus.GetBlockResponse} returns this
-*/
-proto.pactus.GetBlockResponse.prototype.setTxsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.setTreasuryBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional int64 circulating_supply = 11;
+ * @return {number}
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.getCirculatingSupply = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.setCirculatingSupply = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * map<int32, int32> tx_count_per_type = 12;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,number>}
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.getTxCountPerTypeMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,number>} */ (
+      jspb.Message.getMapField(this, 12, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ */
+proto.pactus.GetBlockchainInfoResponse.prototype.clearTxCountPerTypeMap = function() {
+  this.getTxCountPerTypeMap().clear();
+  return this;
 };
 
 
 /**
- * @param {!proto.pactus.TransactionInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pactus.TransactionInfo}
+ * optional uint32 tx_stats_blocks = 13;
+ * @return {number}
  */
-proto.pactus.GetBlockResponse.prototype.addTxs = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.pactus.TransactionInfo, opt_index);
+proto.pactus.GetBlockchainInfoResponse.prototype.getTxStatsBlocks = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pactus.GetBlockResponse} returns this
+ * @param {number} value
+ * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
  */
-proto.pactus.GetBlockResponse.prototype.clearTxsList = function() {
-  return this.setTxsList([]);
+proto.pactus.GetBlockchainInfoResponse.prototype.setTxStatsBlocks = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
 };
 
 
@@ -2390,8 +9004,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockHashRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockHashRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetCommitteeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetCommitteeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2400,13 +9014,13 @@ proto.pactus.GetBlockHashRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockHashRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetCommitteeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHashRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetCommitteeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    height: jspb.Message.getFieldWithDefault(msg, 1, 0)
+
   };
 
   if (includeInstance) {
@@ -2420,33 +9034,29 @@ proto.pactus.GetBlockHashRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockHashRequest}
+ * @return {!proto.pactus.GetCommitteeRequest}
  */
-proto.pactus.GetBlockHashRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetCommitteeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockHashRequest;
-  return proto.pactus.GetBlockHashRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetCommitteeRequest;
+  return proto.pactus.GetCommitteeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockHashRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetCommitteeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockHashRequest}
+ * @return {!proto.pactus.GetCommitteeRequest}
  */
-proto.pactus.GetBlockHashRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetCommitteeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setHeight(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -2460,9 +9070,9 @@ proto.pactus.GetBlockHashRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockHashRequest.prototype.serializeBinary = function() {
+proto.pactus.GetCommitteeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockHashRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetCommitteeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2470,40 +9080,22 @@ proto.pactus.GetBlockHashRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockHashRequest} message
+ * @param {!proto.pactus.GetCommitteeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHashRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetCommitteeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHeight();
-  if (f !== 0) {
-    writer.writeUint32(
-      1,
-      f
-    );
-  }
 };
 
 
-/**
- * optional uint32 height = 1;
- * @return {number}
- */
-proto.pactus.GetBlockHashRequest.prototype.getHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
 
 /**
- * @param {number} value
- * @return {!proto.pactus.GetBlockHashRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pactus.GetBlockHashRequest.prototype.setHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
+proto.pactus.GetCommitteeResponse.repeatedFields_ = [3];
 
 
 
@@ -2520,8 +9112,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockHashResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockHashResponse.toObject(opt_includeInstance, this);
+proto.pactus.GetCommitteeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetCommitteeResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2530,13 +9122,16 @@ proto.pactus.GetBlockHashResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockHashResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.GetCommitteeResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHashResponse.toObject = function(includeInstance, msg) {
+proto.pactus.GetCommitteeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    hash: msg.getHash_asB64()
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    membersList: jspb.Message.toObjectList(msg.getMembersList(),
+    proto.pactus.CommitteeMember.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2550,23 +9145,23 @@ proto.pactus.GetBlockHashResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockHashResponse}
+ * @return {!proto.pactus.GetCommitteeResponse}
  */
-proto.pactus.GetBlockHashResponse.deserializeBinary = function(bytes) {
+proto.pactus.GetCommitteeResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockHashResponse;
-  return proto.pactus.GetBlockHashResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetCommitteeResponse;
+  return proto.pactus.GetCommitteeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockHashResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetCommitteeResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockHashResponse}
+ * @return {!proto.pactus.GetCommitteeResponse}
  */
-proto.pactus.GetBlockHashResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetCommitteeResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2574,8 +9169,17 @@ proto.pactus.GetBlockHashResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setHash(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
+      break;
+    case 3:
+      var value = new proto.pactus.CommitteeMember;
+      reader.readMessage(value,proto.pactus.CommitteeMember.deserializeBinaryFromReader);
+      msg.addMembers(value);
       break;
     default:
       reader.skipField();
@@ -2590,9 +9194,9 @@ proto.pactus.GetBlockHashResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockHashResponse.prototype.serializeBinary = function() {
+proto.pactus.GetCommitteeResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockHashResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetCommitteeResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2600,61 +9204,108 @@ proto.pactus.GetBlockHashResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockHashResponse} message
+ * @param {!proto.pactus.GetCommitteeResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHashResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetCommitteeResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHash_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getMembersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.pactus.CommitteeMember.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional bytes hash = 1;
- * @return {!(string|Uint8Array)}
+ * optional uint32 height = 1;
+ * @return {number}
  */
-proto.pactus.GetBlockHashResponse.prototype.getHash = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pactus.GetCommitteeResponse.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional bytes hash = 1;
- * This is a type-conversion wrapper around `getHash()`
- * @return {string}
+ * @param {number} value
+ * @return {!proto.pactus.GetCommitteeResponse} returns this
  */
-proto.pactus.GetBlockHashResponse.prototype.getHash_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getHash()));
+proto.pactus.GetCommitteeResponse.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bytes hash = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getHash()`
- * @return {!Uint8Array}
+ * optional int32 round = 2;
+ * @return {number}
  */
-proto.pactus.GetBlockHashResponse.prototype.getHash_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getHash()));
+proto.pactus.GetCommitteeResponse.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.GetBlockHashResponse} returns this
+ * @param {number} value
+ * @return {!proto.pactus.GetCommitteeResponse} returns this
  */
-proto.pactus.GetBlockHashResponse.prototype.setHash = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.pactus.GetCommitteeResponse.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * repeated CommitteeMember members = 3;
+ * @return {!Array<!proto.pactus.CommitteeMember>}
+ */
+proto.pactus.GetCommitteeResponse.prototype.getMembersList = function() {
+  return /** @type{!Array<!proto.pactus.CommitteeMember>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.CommitteeMember, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.CommitteeMember>} value
+ * @return {!proto.pactus.GetCommitteeResponse} returns this
+*/
+proto.pactus.GetCommitteeResponse.prototype.setMembersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.pactus.CommitteeMember=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.CommitteeMember}
+ */
+proto.pactus.GetCommitteeResponse.prototype.addMembers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.pactus.CommitteeMember, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetCommitteeResponse} returns this
+ */
+proto.pactus.GetCommitteeResponse.prototype.clearMembersList = function() {
+  return this.setMembersList([]);
 };
 
 
@@ -2674,8 +9325,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockHeightRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockHeightRequest.toObject(opt_includeInstance, this);
+proto.pactus.CommitteeMember.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.CommitteeMember.toObject(opt_includeInstance, this);
 };
 
 
@@ -2684,13 +9335,16 @@ proto.pactus.GetBlockHeightRequest.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockHeightRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.CommitteeMember} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHeightRequest.toObject = function(includeInstance, msg) {
+proto.pactus.CommitteeMember.toObject = function(includeInstance, msg) {
   var f, obj = {
-    hash: msg.getHash_asB64()
+    address: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    number: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    publicKey: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    isProposer: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -2704,23 +9358,23 @@ proto.pactus.GetBlockHeightRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockHeightRequest}
+ * @return {!proto.pactus.CommitteeMember}
  */
-proto.pactus.GetBlockHeightRequest.deserializeBinary = function(bytes) {
+proto.pactus.CommitteeMember.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockHeightRequest;
-  return proto.pactus.GetBlockHeightRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.CommitteeMember;
+  return proto.pactus.CommitteeMember.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockHeightRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.CommitteeMember} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockHeightRequest}
+ * @return {!proto.pactus.CommitteeMember}
  */
-proto.pactus.GetBlockHeightRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.CommitteeMember.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2728,8 +9382,20 @@ proto.pactus.GetBlockHeightRequest.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setHash(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNumber(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPublicKey(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsProposer(value);
       break;
     default:
       reader.skipField();
@@ -2744,9 +9410,9 @@ proto.pactus.GetBlockHeightRequest.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockHeightRequest.prototype.serializeBinary = function() {
+proto.pactus.CommitteeMember.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockHeightRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.CommitteeMember.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2754,61 +9420,112 @@ proto.pactus.GetBlockHeightRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockHeightRequest} message
+ * @param {!proto.pactus.CommitteeMember} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHeightRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.CommitteeMember.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHash_asU8();
+  f = message.getAddress();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getPublicKey();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getIsProposer();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bytes hash = 1;
- * @return {!(string|Uint8Array)}
+ * optional string address = 1;
+ * @return {string}
  */
-proto.pactus.GetBlockHeightRequest.prototype.getHash = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pactus.CommitteeMember.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bytes hash = 1;
- * This is a type-conversion wrapper around `getHash()`
+ * @param {string} value
+ * @return {!proto.pactus.CommitteeMember} returns this
+ */
+proto.pactus.CommitteeMember.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 number = 2;
+ * @return {number}
+ */
+proto.pactus.CommitteeMember.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.CommitteeMember} returns this
+ */
+proto.pactus.CommitteeMember.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string public_key = 3;
  * @return {string}
  */
-proto.pactus.GetBlockHeightRequest.prototype.getHash_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getHash()));
+proto.pactus.CommitteeMember.prototype.getPublicKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.CommitteeMember} returns this
+ */
+proto.pactus.CommitteeMember.prototype.setPublicKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional bytes hash = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getHash()`
- * @return {!Uint8Array}
+ * optional bool is_proposer = 4;
+ * @return {boolean}
  */
-proto.pactus.GetBlockHeightRequest.prototype.getHash_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getHash()));
+proto.pactus.CommitteeMember.prototype.getIsProposer = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.GetBlockHeightRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.pactus.CommitteeMember} returns this
  */
-proto.pactus.GetBlockHeightRequest.prototype.setHash = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.pactus.CommitteeMember.prototype.setIsProposer = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
@@ -2828,8 +9545,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockHeightResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockHeightResponse.toObject(opt_includeInstance, this);
+proto.pactus.GetConsensusInfoRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetConsensusInfoRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2838,11 +9555,11 @@ proto.pactus.GetBlockHeightResponse.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockHeightResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.GetConsensusInfoRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHeightResponse.toObject = function(includeInstance, msg) {
+proto.pactus.GetConsensusInfoRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     height: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
@@ -2858,23 +9575,23 @@ proto.pactus.GetBlockHeightResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockHeightResponse}
+ * @return {!proto.pactus.GetConsensusInfoRequest}
  */
-proto.pactus.GetBlockHeightResponse.deserializeBinary = function(bytes) {
+proto.pactus.GetConsensusInfoRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockHeightResponse;
-  return proto.pactus.GetBlockHeightResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetConsensusInfoRequest;
+  return proto.pactus.GetConsensusInfoRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockHeightResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetConsensusInfoRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockHeightResponse}
+ * @return {!proto.pactus.GetConsensusInfoRequest}
  */
-proto.pactus.GetBlockHeightResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetConsensusInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2898,9 +9615,9 @@ proto.pactus.GetBlockHeightResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockHeightResponse.prototype.serializeBinary = function() {
+proto.pactus.GetConsensusInfoRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockHeightResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetConsensusInfoRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2908,11 +9625,11 @@ proto.pactus.GetBlockHeightResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockHeightResponse} message
+ * @param {!proto.pactus.GetConsensusInfoRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockHeightResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetConsensusInfoRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getHeight();
   if (f !== 0) {
@@ -2928,21 +9645,28 @@ proto.pactus.GetBlockHeightResponse.serializeBinaryToWriter = function(message,
  * optional uint32 height = 1;
  * @return {number}
  */
-proto.pactus.GetBlockHeightResponse.prototype.getHeight = function() {
+proto.pactus.GetConsensusInfoRequest.prototype.getHeight = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.GetBlockHeightResponse} returns this
+ * @return {!proto.pactus.GetConsensusInfoRequest} returns this
  */
-proto.pactus.GetBlockHeightResponse.prototype.setHeight = function(value) {
+proto.pactus.GetConsensusInfoRequest.prototype.setHeight = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetConsensusInfoResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2958,8 +9682,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockchainInfoRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockchainInfoRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetConsensusInfoResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetConsensusInfoResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2968,13 +9692,14 @@ proto.pactus.GetBlockchainInfoRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockchainInfoRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetConsensusInfoResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockchainInfoRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetConsensusInfoResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    instancesList: jspb.Message.toObjectList(msg.getInstancesList(),
+    proto.pactus.ConsensusInfo.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2988,29 +9713,34 @@ proto.pactus.GetBlockchainInfoRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockchainInfoRequest}
+ * @return {!proto.pactus.GetConsensusInfoResponse}
  */
-proto.pactus.GetBlockchainInfoRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetConsensusInfoResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockchainInfoRequest;
-  return proto.pactus.GetBlockchainInfoRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetConsensusInfoResponse;
+  return proto.pactus.GetConsensusInfoResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockchainInfoRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetConsensusInfoResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockchainInfoRequest}
+ * @return {!proto.pactus.GetConsensusInfoResponse}
  */
-proto.pactus.GetBlockchainInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetConsensusInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new proto.pactus.ConsensusInfo;
+      reader.readMessage(value,proto.pactus.ConsensusInfo.deserializeBinaryFromReader);
+      msg.addInstances(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3024,9 +9754,9 @@ proto.pactus.GetBlockchainInfoRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockchainInfoRequest.prototype.serializeBinary = function() {
+proto.pactus.GetConsensusInfoResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockchainInfoRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetConsensusInfoResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3034,22 +9764,61 @@ proto.pactus.GetBlockchainInfoRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockchainInfoRequest} message
+ * @param {!proto.pactus.GetConsensusInfoResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockchainInfoRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetConsensusInfoResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getInstancesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pactus.ConsensusInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ConsensusInfo instances = 1;
+ * @return {!Array<!proto.pactus.ConsensusInfo>}
+ */
+proto.pactus.GetConsensusInfoResponse.prototype.getInstancesList = function() {
+  return /** @type{!Array<!proto.pactus.ConsensusInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.ConsensusInfo, 1));
 };
 
 
+/**
+ * @param {!Array<!proto.pactus.ConsensusInfo>} value
+ * @return {!proto.pactus.GetConsensusInfoResponse} returns this
+*/
+proto.pactus.GetConsensusInfoResponse.prototype.setInstancesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!proto.pactus.ConsensusInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.ConsensusInfo}
  */
-proto.pactus.GetBlockchainInfoResponse.repeatedFields_ = [7];
+proto.pactus.GetConsensusInfoResponse.prototype.addInstances = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.ConsensusInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetConsensusInfoResponse} returns this
+ */
+proto.pactus.GetConsensusInfoResponse.prototype.clearInstancesList = function() {
+  return this.setInstancesList([]);
+};
+
+
 
 
 
@@ -3066,8 +9835,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetBlockchainInfoResponse.toObject(opt_includeInstance, this);
+proto.pactus.ValidatorInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ValidatorInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -3076,20 +9845,21 @@ proto.pactus.GetBlockchainInfoResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetBlockchainInfoResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.ValidatorInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockchainInfoResponse.toObject = function(includeInstance, msg) {
+proto.pactus.ValidatorInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lastBlockHeight: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    lastBlockHash: msg.getLastBlockHash_asB64(),
-    totalAccounts: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    totalValidators: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    totalPower: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    committeePower: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    committeeValidatorsList: jspb.Message.toObjectList(msg.getCommitteeValidatorsList(),
-    proto.pactus.ValidatorInfo.toObject, includeInstance)
+    hash: msg.getHash_asB64(),
+    data: msg.getData_asB64(),
+    publicKey: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    number: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    stake: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    lastBondingHeight: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    lastSortitionHeight: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    unbondingHeight: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    address: jspb.Message.getFieldWithDefault(msg, 9, "")
   };
 
   if (includeInstance) {
@@ -3103,23 +9873,23 @@ proto.pactus.GetBlockchainInfoResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetBlockchainInfoResponse}
+ * @return {!proto.pactus.ValidatorInfo}
  */
-proto.pactus.GetBlockchainInfoResponse.deserializeBinary = function(bytes) {
+proto.pactus.ValidatorInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetBlockchainInfoResponse;
-  return proto.pactus.GetBlockchainInfoResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.ValidatorInfo;
+  return proto.pactus.ValidatorInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetBlockchainInfoResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.ValidatorInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetBlockchainInfoResponse}
+ * @return {!proto.pactus.ValidatorInfo}
  */
-proto.pactus.GetBlockchainInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.ValidatorInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3127,33 +9897,40 @@ proto.pactus.GetBlockchainInfoResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setLastBlockHeight(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
       break;
     case 2:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setLastBlockHash(value);
+      msg.setData(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setTotalAccounts(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPublicKey(value);
       break;
     case 4:
       var value = /** @type {number} */ (reader.readInt32());
-      msg.setTotalValidators(value);
+      msg.setNumber(value);
       break;
     case 5:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setTotalPower(value);
+      msg.setStake(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setCommitteePower(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLastBondingHeight(value);
       break;
     case 7:
-      var value = new proto.pactus.ValidatorInfo;
-      reader.readMessage(value,proto.pactus.ValidatorInfo.deserializeBinaryFromReader);
-      msg.addCommitteeValidators(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLastSortitionHeight(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setUnbondingHeight(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
       break;
     default:
       reader.skipField();
@@ -3168,9 +9945,9 @@ proto.pactus.GetBlockchainInfoResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.serializeBinary = function() {
+proto.pactus.ValidatorInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetBlockchainInfoResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.ValidatorInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3178,344 +9955,289 @@ proto.pactus.GetBlockchainInfoResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetBlockchainInfoResponse} message
+ * @param {!proto.pactus.ValidatorInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetBlockchainInfoResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.ValidatorInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLastBlockHeight();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getLastBlockHash_asU8();
+  f = message.getData_asU8();
   if (f.length > 0) {
     writer.writeBytes(
       2,
       f
     );
   }
-  f = message.getTotalAccounts();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getPublicKey();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getTotalValidators();
+  f = message.getNumber();
   if (f !== 0) {
     writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getTotalPower();
+  f = message.getStake();
   if (f !== 0) {
     writer.writeInt64(
       5,
       f
     );
   }
-  f = message.getCommitteePower();
+  f = message.getLastBondingHeight();
   if (f !== 0) {
-    writer.writeInt64(
+    writer.writeUint32(
       6,
       f
     );
   }
-  f = message.getCommitteeValidatorsList();
+  f = message.getLastSortitionHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getUnbondingHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+  f = message.getAddress();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      7,
-      f,
-      proto.pactus.ValidatorInfo.serializeBinaryToWriter
+    writer.writeString(
+      9,
+      f
     );
   }
 };
 
 
 /**
- * optional uint32 last_block_height = 1;
- * @return {number}
+ * optional bytes hash = 1;
+ * @return {!(string|Uint8Array)}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.pactus.ValidatorInfo.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ * optional bytes hash = 1;
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {string}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.setLastBlockHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.pactus.ValidatorInfo.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
 };
 
 
 /**
- * optional bytes last_block_hash = 2;
+ * optional bytes hash = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.ValidatorInfo.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.ValidatorInfo} returns this
+ */
+proto.pactus.ValidatorInfo.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional bytes data = 2;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHash = function() {
+proto.pactus.ValidatorInfo.prototype.getData = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bytes last_block_hash = 2;
- * This is a type-conversion wrapper around `getLastBlockHash()`
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
  * @return {string}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHash_asB64 = function() {
+proto.pactus.ValidatorInfo.prototype.getData_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getLastBlockHash()));
+      this.getData()));
 };
 
 
 /**
- * optional bytes last_block_hash = 2;
+ * optional bytes data = 2;
  * Note that Uint8Array is not supported on all browsers.
  * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getLastBlockHash()`
+ * This is a type-conversion wrapper around `getData()`
  * @return {!Uint8Array}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getLastBlockHash_asU8 = function() {
+proto.pactus.ValidatorInfo.prototype.getData_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getLastBlockHash()));
+      this.getData()));
 };
 
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.setLastBlockHash = function(value) {
+proto.pactus.ValidatorInfo.prototype.setData = function(value) {
   return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
 /**
- * optional int32 total_accounts = 3;
- * @return {number}
+ * optional string public_key = 3;
+ * @return {string}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getTotalAccounts = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.pactus.ValidatorInfo.prototype.getPublicKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ * @param {string} value
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.setTotalAccounts = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.pactus.ValidatorInfo.prototype.setPublicKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional int32 total_validators = 4;
+ * optional int32 number = 4;
  * @return {number}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getTotalValidators = function() {
+proto.pactus.ValidatorInfo.prototype.getNumber = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.setTotalValidators = function(value) {
+proto.pactus.ValidatorInfo.prototype.setNumber = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional int64 total_power = 5;
+ * optional int64 stake = 5;
  * @return {number}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getTotalPower = function() {
+proto.pactus.ValidatorInfo.prototype.getStake = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.setTotalPower = function(value) {
+proto.pactus.ValidatorInfo.prototype.setStake = function(value) {
   return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional int64 committee_power = 6;
+ * optional uint32 last_bonding_height = 6;
  * @return {number}
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.getCommitteePower = function() {
+proto.pactus.ValidatorInfo.prototype.getLastBondingHeight = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetBlockchainInfoResponse.prototype.setCommitteePower = function(value) {
+proto.pactus.ValidatorInfo.prototype.setLastBondingHeight = function(value) {
   return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * repeated ValidatorInfo committee_validators = 7;
- * @return {!Array<!proto.pactus.ValidatorInfo>}
- */
-proto.pactus.GetBlockchainInfoResponse.prototype.getCommitteeValidatorsList = function() {
-  return /** @type{!Array<!proto.pactus.ValidatorInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pactus.ValidatorInfo, 7));
-};
-
-
-/**
- * @param {!Array<!proto.pactus.ValidatorInfo>} value
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
-*/
-proto.pactus.GetBlockchainInfoResponse.prototype.setCommitteeValidatorsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 7, value);
-};
-
-
-/**
- * @param {!proto.pactus.ValidatorInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pactus.ValidatorInfo}
- */
-proto.pactus.GetBlockchainInfoResponse.prototype.addCommitteeValidators = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.pactus.ValidatorInfo, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pactus.GetBlockchainInfoResponse} returns this
- */
-proto.pactus.GetBlockchainInfoResponse.prototype.clearCommitteeValidatorsList = function() {
-  return this.setCommitteeValidatorsList([]);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional uint32 last_sortition_height = 7;
+ * @return {number}
  */
-proto.pactus.GetConsensusInfoRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetConsensusInfoRequest.toObject(opt_includeInstance, this);
+proto.pactus.ValidatorInfo.prototype.getLastSortitionHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetConsensusInfoRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetConsensusInfoRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pactus.ValidatorInfo.prototype.setLastSortitionHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetConsensusInfoRequest}
+ * optional uint32 unbonding_height = 8;
+ * @return {number}
  */
-proto.pactus.GetConsensusInfoRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetConsensusInfoRequest;
-  return proto.pactus.GetConsensusInfoRequest.deserializeBinaryFromReader(msg, reader);
+proto.pactus.ValidatorInfo.prototype.getUnbondingHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pactus.GetConsensusInfoRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetConsensusInfoRequest}
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetConsensusInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pactus.ValidatorInfo.prototype.setUnbondingHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string address = 9;
+ * @return {string}
  */
-proto.pactus.GetConsensusInfoRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pactus.GetConsensusInfoRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.pactus.ValidatorInfo.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetConsensusInfoRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.pactus.ValidatorInfo} returns this
  */
-proto.pactus.GetConsensusInfoRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.pactus.ValidatorInfo.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pactus.GetConsensusInfoResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3531,8 +10253,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetConsensusInfoResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetConsensusInfoResponse.toObject(opt_includeInstance, this);
+proto.pactus.AccountInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.AccountInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -3541,14 +10263,17 @@ proto.pactus.GetConsensusInfoResponse.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetConsensusInfoResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.AccountInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetConsensusInfoResponse.toObject = function(includeInstance, msg) {
+proto.pactus.AccountInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    instancesList: jspb.Message.toObjectList(msg.getInstancesList(),
-    proto.pactus.ConsensusInfo.toObject, includeInstance)
+    hash: msg.getHash_asB64(),
+    data: msg.getData_asB64(),
+    number: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    balance: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    address: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -3562,23 +10287,23 @@ proto.pactus.GetConsensusInfoResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetConsensusInfoResponse}
+ * @return {!proto.pactus.AccountInfo}
  */
-proto.pactus.GetConsensusInfoResponse.deserializeBinary = function(bytes) {
+proto.pactus.AccountInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetConsensusInfoResponse;
-  return proto.pactus.GetConsensusInfoResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.AccountInfo;
+  return proto.pactus.AccountInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetConsensusInfoResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.AccountInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetConsensusInfoResponse}
+ * @return {!proto.pactus.AccountInfo}
  */
-proto.pactus.GetConsensusInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.AccountInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3586,9 +10311,24 @@ proto.pactus.GetConsensusInfoResponse.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.pactus.ConsensusInfo;
-      reader.readMessage(value,proto.pactus.ConsensusInfo.deserializeBinaryFromReader);
-      msg.addInstances(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setHash(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNumber(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBalance(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
       break;
     default:
       reader.skipField();
@@ -3603,9 +10343,9 @@ proto.pactus.GetConsensusInfoResponse.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetConsensusInfoResponse.prototype.serializeBinary = function() {
+proto.pactus.AccountInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetConsensusInfoResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.AccountInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3613,58 +10353,185 @@ proto.pactus.GetConsensusInfoResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetConsensusInfoResponse} message
+ * @param {!proto.pactus.AccountInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetConsensusInfoResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.AccountInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInstancesList();
+  f = message.getHash_asU8();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeBytes(
       1,
-      f,
-      proto.pactus.ConsensusInfo.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * repeated ConsensusInfo instances = 1;
- * @return {!Array<!proto.pactus.ConsensusInfo>}
+ * optional bytes hash = 1;
+ * @return {!(string|Uint8Array)}
  */
-proto.pactus.GetConsensusInfoResponse.prototype.getInstancesList = function() {
-  return /** @type{!Array<!proto.pactus.ConsensusInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pactus.ConsensusInfo, 1));
+proto.pactus.AccountInfo.prototype.getHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.pactus.ConsensusInfo>} value
- * @return {!proto.pactus.GetConsensusInfoResponse} returns this
-*/
-proto.pactus.GetConsensusInfoResponse.prototype.setInstancesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * optional bytes hash = 1;
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {string}
+ */
+proto.pactus.AccountInfo.prototype.getHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getHash()));
 };
 
 
 /**
- * @param {!proto.pactus.ConsensusInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pactus.ConsensusInfo}
+ * optional bytes hash = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getHash()`
+ * @return {!Uint8Array}
  */
-proto.pactus.GetConsensusInfoResponse.prototype.addInstances = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.ConsensusInfo, opt_index);
+proto.pactus.AccountInfo.prototype.getHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getHash()));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pactus.GetConsensusInfoResponse} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.AccountInfo} returns this
  */
-proto.pactus.GetConsensusInfoResponse.prototype.clearInstancesList = function() {
-  return this.setInstancesList([]);
+proto.pactus.AccountInfo.prototype.setHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional bytes data = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.AccountInfo.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.pactus.AccountInfo.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.AccountInfo.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.AccountInfo} returns this
+ */
+proto.pactus.AccountInfo.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional int32 number = 3;
+ * @return {number}
+ */
+proto.pactus.AccountInfo.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.AccountInfo} returns this
+ */
+proto.pactus.AccountInfo.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 balance = 4;
+ * @return {number}
+ */
+proto.pactus.AccountInfo.prototype.getBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.AccountInfo} returns this
+ */
+proto.pactus.AccountInfo.prototype.setBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string address = 5;
+ * @return {string}
+ */
+proto.pactus.AccountInfo.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.AccountInfo} returns this
+ */
+proto.pactus.AccountInfo.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
@@ -3684,8 +10551,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.ValidatorInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.ValidatorInfo.toObject(opt_includeInstance, this);
+proto.pactus.BlockHeaderInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.BlockHeaderInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -3694,21 +10561,17 @@ proto.pactus.ValidatorInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.ValidatorInfo} msg The msg instance to transform.
+ * @param {!proto.pactus.BlockHeaderInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.ValidatorInfo.toObject = function(includeInstance, msg) {
+proto.pactus.BlockHeaderInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    hash: msg.getHash_asB64(),
-    data: msg.getData_asB64(),
-    publicKey: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    number: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    stake: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    lastBondingHeight: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    lastSortitionHeight: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    unbondingHeight: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    address: jspb.Message.getFieldWithDefault(msg, 9, "")
+    version: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    prevBlockHash: msg.getPrevBlockHash_asB64(),
+    stateRoot: msg.getStateRoot_asB64(),
+    sortitionSeed: msg.getSortitionSeed_asB64(),
+    proposerAddress: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -3722,23 +10585,23 @@ proto.pactus.ValidatorInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.ValidatorInfo}
+ * @return {!proto.pactus.BlockHeaderInfo}
  */
-proto.pactus.ValidatorInfo.deserializeBinary = function(bytes) {
+proto.pactus.BlockHeaderInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.ValidatorInfo;
-  return proto.pactus.ValidatorInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.BlockHeaderInfo;
+  return proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.ValidatorInfo} msg The message object to deserialize into.
+ * @param {!proto.pactus.BlockHeaderInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.ValidatorInfo}
+ * @return {!proto.pactus.BlockHeaderInfo}
  */
-proto.pactus.ValidatorInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3746,40 +10609,24 @@ proto.pactus.ValidatorInfo.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setHash(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setVersion(value);
       break;
     case 2:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      msg.setPrevBlockHash(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPublicKey(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setStateRoot(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setNumber(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setSortitionSeed(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setStake(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setLastBondingHeight(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setLastSortitionHeight(value);
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setUnbondingHeight(value);
-      break;
-    case 9:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAddress(value);
+      msg.setProposerAddress(value);
       break;
     default:
       reader.skipField();
@@ -3794,9 +10641,9 @@ proto.pactus.ValidatorInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.ValidatorInfo.prototype.serializeBinary = function() {
+proto.pactus.BlockHeaderInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.ValidatorInfo.serializeBinaryToWriter(this, writer);
+  proto.pactus.BlockHeaderInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3804,72 +10651,44 @@ proto.pactus.ValidatorInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.ValidatorInfo} message
+ * @param {!proto.pactus.BlockHeaderInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.ValidatorInfo.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.BlockHeaderInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHash_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getData_asU8();
+  f = message.getPrevBlockHash_asU8();
   if (f.length > 0) {
     writer.writeBytes(
       2,
       f
     );
   }
-  f = message.getPublicKey();
+  f = message.getStateRoot_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       3,
       f
     );
   }
-  f = message.getNumber();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getSortitionSeed_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       4,
       f
     );
   }
-  f = message.getStake();
-  if (f !== 0) {
-    writer.writeInt64(
-      5,
-      f
-    );
-  }
-  f = message.getLastBondingHeight();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getLastSortitionHeight();
-  if (f !== 0) {
-    writer.writeUint32(
-      7,
-      f
-    );
-  }
-  f = message.getUnbondingHeight();
-  if (f !== 0) {
-    writer.writeUint32(
-      8,
-      f
-    );
-  }
-  f = message.getAddress();
+  f = message.getProposerAddress();
   if (f.length > 0) {
     writer.writeString(
-      9,
+      5,
       f
     );
   }
@@ -3877,216 +10696,175 @@ proto.pactus.ValidatorInfo.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional bytes hash = 1;
- * @return {!(string|Uint8Array)}
- */
-proto.pactus.ValidatorInfo.prototype.getHash = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * optional bytes hash = 1;
- * This is a type-conversion wrapper around `getHash()`
- * @return {string}
- */
-proto.pactus.ValidatorInfo.prototype.getHash_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getHash()));
-};
-
-
-/**
- * optional bytes hash = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getHash()`
- * @return {!Uint8Array}
+ * optional int32 version = 1;
+ * @return {number}
  */
-proto.pactus.ValidatorInfo.prototype.getHash_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getHash()));
+proto.pactus.BlockHeaderInfo.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.ValidatorInfo} returns this
+ * @param {number} value
+ * @return {!proto.pactus.BlockHeaderInfo} returns this
  */
-proto.pactus.ValidatorInfo.prototype.setHash = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.pactus.BlockHeaderInfo.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bytes data = 2;
+ * optional bytes prev_block_hash = 2;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.ValidatorInfo.prototype.getData = function() {
+proto.pactus.BlockHeaderInfo.prototype.getPrevBlockHash = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bytes data = 2;
- * This is a type-conversion wrapper around `getData()`
+ * optional bytes prev_block_hash = 2;
+ * This is a type-conversion wrapper around `getPrevBlockHash()`
  * @return {string}
  */
-proto.pactus.ValidatorInfo.prototype.getData_asB64 = function() {
+proto.pactus.BlockHeaderInfo.prototype.getPrevBlockHash_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
+      this.getPrevBlockHash()));
 };
 
 
 /**
- * optional bytes data = 2;
+ * optional bytes prev_block_hash = 2;
  * Note that Uint8Array is not supported on all browsers.
  * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
+ * This is a type-conversion wrapper around `getPrevBlockHash()`
  * @return {!Uint8Array}
  */
-proto.pactus.ValidatorInfo.prototype.getData_asU8 = function() {
+proto.pactus.BlockHeaderInfo.prototype.getPrevBlockHash_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+      this.getPrevBlockHash()));
 };
 
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.ValidatorInfo} returns this
+ * @return {!proto.pactus.BlockHeaderInfo} returns this
  */
-proto.pactus.ValidatorInfo.prototype.setData = function(value) {
+proto.pactus.BlockHeaderInfo.prototype.setPrevBlockHash = function(value) {
   return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
 /**
- * optional string public_key = 3;
- * @return {string}
- */
-proto.pactus.ValidatorInfo.prototype.getPublicKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pactus.ValidatorInfo} returns this
- */
-proto.pactus.ValidatorInfo.prototype.setPublicKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional int32 number = 4;
- * @return {number}
- */
-proto.pactus.ValidatorInfo.prototype.getNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.pactus.ValidatorInfo} returns this
- */
-proto.pactus.ValidatorInfo.prototype.setNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * optional int64 stake = 5;
- * @return {number}
+ * optional bytes state_root = 3;
+ * @return {!(string|Uint8Array)}
  */
-proto.pactus.ValidatorInfo.prototype.getStake = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.pactus.BlockHeaderInfo.prototype.getStateRoot = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.ValidatorInfo} returns this
+ * optional bytes state_root = 3;
+ * This is a type-conversion wrapper around `getStateRoot()`
+ * @return {string}
  */
-proto.pactus.ValidatorInfo.prototype.setStake = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.pactus.BlockHeaderInfo.prototype.getStateRoot_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getStateRoot()));
 };
 
 
 /**
- * optional uint32 last_bonding_height = 6;
- * @return {number}
+ * optional bytes state_root = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getStateRoot()`
+ * @return {!Uint8Array}
  */
-proto.pactus.ValidatorInfo.prototype.getLastBondingHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.pactus.BlockHeaderInfo.prototype.getStateRoot_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getStateRoot()));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.ValidatorInfo} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.BlockHeaderInfo} returns this
  */
-proto.pactus.ValidatorInfo.prototype.setLastBondingHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.pactus.BlockHeaderInfo.prototype.setStateRoot = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
 };
 
 
 /**
- * optional uint32 last_sortition_height = 7;
- * @return {number}
+ * optional bytes sortition_seed = 4;
+ * @return {!(string|Uint8Array)}
  */
-proto.pactus.ValidatorInfo.prototype.getLastSortitionHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.pactus.BlockHeaderInfo.prototype.getSortitionSeed = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.ValidatorInfo} returns this
+ * optional bytes sortition_seed = 4;
+ * This is a type-conversion wrapper around `getSortitionSeed()`
+ * @return {string}
  */
-proto.pactus.ValidatorInfo.prototype.setLastSortitionHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.pactus.BlockHeaderInfo.prototype.getSortitionSeed_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getSortitionSeed()));
 };
 
 
 /**
- * optional uint32 unbonding_height = 8;
- * @return {number}
+ * optional bytes sortition_seed = 4;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getSortitionSeed()`
+ * @return {!Uint8Array}
  */
-proto.pactus.ValidatorInfo.prototype.getUnbondingHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.pactus.BlockHeaderInfo.prototype.getSortitionSeed_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getSortitionSeed()));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.ValidatorInfo} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.BlockHeaderInfo} returns this
  */
-proto.pactus.ValidatorInfo.prototype.setUnbondingHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.pactus.BlockHeaderInfo.prototype.setSortitionSeed = function(value) {
+  return jspb.Message.setProto3BytesField(this, 4, value);
 };
 
 
 /**
- * optional string address = 9;
+ * optional string proposer_address = 5;
  * @return {string}
  */
-proto.pactus.ValidatorInfo.prototype.getAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.pactus.BlockHeaderInfo.prototype.getProposerAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pactus.ValidatorInfo} returns this
+ * @return {!proto.pactus.BlockHeaderInfo} returns this
  */
-proto.pactus.ValidatorInfo.prototype.setAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.pactus.BlockHeaderInfo.prototype.setProposerAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.CertificateInfo.repeatedFields_ = [3,4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4102,8 +10880,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.AccountInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.AccountInfo.toObject(opt_includeInstance, this);
+proto.pactus.CertificateInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.CertificateInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -4112,17 +10890,17 @@ proto.pactus.AccountInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.AccountInfo} msg The msg instance to transform.
+ * @param {!proto.pactus.CertificateInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.AccountInfo.toObject = function(includeInstance, msg) {
+proto.pactus.CertificateInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
     hash: msg.getHash_asB64(),
-    data: msg.getData_asB64(),
-    number: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    balance: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    address: jspb.Message.getFieldWithDefault(msg, 5, "")
+    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    committersList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    absenteesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    signature: msg.getSignature_asB64()
   };
 
   if (includeInstance) {
@@ -4136,23 +10914,23 @@ proto.pactus.AccountInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.AccountInfo}
+ * @return {!proto.pactus.CertificateInfo}
  */
-proto.pactus.AccountInfo.deserializeBinary = function(bytes) {
+proto.pactus.CertificateInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.AccountInfo;
-  return proto.pactus.AccountInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.CertificateInfo;
+  return proto.pactus.CertificateInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.AccountInfo} msg The message object to deserialize into.
+ * @param {!proto.pactus.CertificateInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.AccountInfo}
+ * @return {!proto.pactus.CertificateInfo}
  */
-proto.pactus.AccountInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.CertificateInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4164,20 +10942,24 @@ proto.pactus.AccountInfo.deserializeBinaryFromReader = function(msg, reader) {
       msg.setHash(value);
       break;
     case 2:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setNumber(value);
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addCommitters(values[i]);
+      }
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setBalance(value);
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addAbsentees(values[i]);
+      }
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAddress(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setSignature(value);
       break;
     default:
       reader.skipField();
@@ -4192,9 +10974,9 @@ proto.pactus.AccountInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.AccountInfo.prototype.serializeBinary = function() {
+proto.pactus.CertificateInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.AccountInfo.serializeBinaryToWriter(this, writer);
+  proto.pactus.CertificateInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4202,11 +10984,11 @@ proto.pactus.AccountInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.AccountInfo} message
+ * @param {!proto.pactus.CertificateInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.AccountInfo.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.CertificateInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getHash_asU8();
   if (f.length > 0) {
@@ -4215,30 +10997,30 @@ proto.pactus.AccountInfo.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
-  f = message.getData_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getNumber();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getCommittersList();
+  if (f.length > 0) {
+    writer.writePackedInt32(
       3,
       f
     );
   }
-  f = message.getBalance();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getAbsenteesList();
+  if (f.length > 0) {
+    writer.writePackedInt32(
       4,
       f
     );
   }
-  f = message.getAddress();
+  f = message.getSignature_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       5,
       f
     );
@@ -4250,7 +11032,7 @@ proto.pactus.AccountInfo.serializeBinaryToWriter = function(message, writer) {
  * optional bytes hash = 1;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.AccountInfo.prototype.getHash = function() {
+proto.pactus.CertificateInfo.prototype.getHash = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
@@ -4260,7 +11042,7 @@ proto.pactus.AccountInfo.prototype.getHash = function() {
  * This is a type-conversion wrapper around `getHash()`
  * @return {string}
  */
-proto.pactus.AccountInfo.prototype.getHash_asB64 = function() {
+proto.pactus.CertificateInfo.prototype.getHash_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
       this.getHash()));
 };
@@ -4273,7 +11055,7 @@ proto.pactus.AccountInfo.prototype.getHash_asB64 = function() {
  * This is a type-conversion wrapper around `getHash()`
  * @return {!Uint8Array}
  */
-proto.pactus.AccountInfo.prototype.getHash_asU8 = function() {
+proto.pactus.CertificateInfo.prototype.getHash_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
       this.getHash()));
 };
@@ -4281,106 +11063,144 @@ proto.pactus.AccountInfo.prototype.getHash_asU8 = function() {
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.AccountInfo} returns this
+ * @return {!proto.pactus.CertificateInfo} returns this
  */
-proto.pactus.AccountInfo.prototype.setHash = function(value) {
+proto.pactus.CertificateInfo.prototype.setHash = function(value) {
   return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 /**
- * optional bytes data = 2;
- * @return {!(string|Uint8Array)}
+ * optional int32 round = 2;
+ * @return {number}
  */
-proto.pactus.AccountInfo.prototype.getData = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pactus.CertificateInfo.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional bytes data = 2;
- * This is a type-conversion wrapper around `getData()`
- * @return {string}
+ * @param {number} value
+ * @return {!proto.pactus.CertificateInfo} returns this
  */
-proto.pactus.AccountInfo.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
+proto.pactus.CertificateInfo.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional bytes data = 2;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
+ * repeated int32 committers = 3;
+ * @return {!Array<number>}
  */
-proto.pactus.AccountInfo.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+proto.pactus.CertificateInfo.prototype.getCommittersList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.AccountInfo} returns this
+ * @param {!Array<number>} value
+ * @return {!proto.pactus.CertificateInfo} returns this
  */
-proto.pactus.AccountInfo.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 2, value);
+proto.pactus.CertificateInfo.prototype.setCommittersList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * optional int32 number = 3;
- * @return {number}
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.CertificateInfo} returns this
  */
-proto.pactus.AccountInfo.prototype.getNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.pactus.CertificateInfo.prototype.addCommitters = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.AccountInfo} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.CertificateInfo} returns this
  */
-proto.pactus.AccountInfo.prototype.setNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.pactus.CertificateInfo.prototype.clearCommittersList = function() {
+  return this.setCommittersList([]);
 };
 
 
 /**
- * optional int64 balance = 4;
- * @return {number}
+ * repeated int32 absentees = 4;
+ * @return {!Array<number>}
  */
-proto.pactus.AccountInfo.prototype.getBalance = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.pactus.CertificateInfo.prototype.getAbsenteesList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.pactus.CertificateInfo} returns this
+ */
+proto.pactus.CertificateInfo.prototype.setAbsenteesList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.AccountInfo} returns this
+ * @param {number=} opt_index
+ * @return {!proto.pactus.CertificateInfo} returns this
  */
-proto.pactus.AccountInfo.prototype.setBalance = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.pactus.CertificateInfo.prototype.addAbsentees = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
 };
 
 
 /**
- * optional string address = 5;
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.CertificateInfo} returns this
+ */
+proto.pactus.CertificateInfo.prototype.clearAbsenteesList = function() {
+  return this.setAbsenteesList([]);
+};
+
+
+/**
+ * optional bytes signature = 5;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.CertificateInfo.prototype.getSignature = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes signature = 5;
+ * This is a type-conversion wrapper around `getSignature()`
  * @return {string}
  */
-proto.pactus.AccountInfo.prototype.getAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.pactus.CertificateInfo.prototype.getSignature_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getSignature()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pactus.AccountInfo} returns this
+ * optional bytes signature = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getSignature()`
+ * @return {!Uint8Array}
  */
-proto.pactus.AccountInfo.prototype.setAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.pactus.CertificateInfo.prototype.getSignature_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getSignature()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.CertificateInfo} returns this
+ */
+proto.pactus.CertificateInfo.prototype.setSignature = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
 };
 
 
@@ -4400,8 +11220,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.BlockHeaderInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.BlockHeaderInfo.toObject(opt_includeInstance, this);
+proto.pactus.VoteInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.VoteInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -4410,17 +11230,16 @@ proto.pactus.BlockHeaderInfo.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.BlockHeaderInfo} msg The msg instance to transform.
+ * @param {!proto.pactus.VoteInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.BlockHeaderInfo.toObject = function(includeInstance, msg) {
+proto.pactus.VoteInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    version: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    prevBlockHash: msg.getPrevBlockHash_asB64(),
-    stateRoot: msg.getStateRoot_asB64(),
-    sortitionSeed: msg.getSortitionSeed_asB64(),
-    proposerAddress: jspb.Message.getFieldWithDefault(msg, 5, "")
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    voter: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    blockHash: msg.getBlockHash_asB64(),
+    round: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -4434,23 +11253,23 @@ proto.pactus.BlockHeaderInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.BlockHeaderInfo}
+ * @return {!proto.pactus.VoteInfo}
  */
-proto.pactus.BlockHeaderInfo.deserializeBinary = function(bytes) {
+proto.pactus.VoteInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.BlockHeaderInfo;
-  return proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.VoteInfo;
+  return proto.pactus.VoteInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.BlockHeaderInfo} msg The message object to deserialize into.
+ * @param {!proto.pactus.VoteInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.BlockHeaderInfo}
+ * @return {!proto.pactus.VoteInfo}
  */
-proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.VoteInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4458,24 +11277,20 @@ proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setVersion(value);
+      var value = /** @type {!proto.pactus.VoteType} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 2:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setPrevBlockHash(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVoter(value);
       break;
     case 3:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setStateRoot(value);
+      msg.setBlockHash(value);
       break;
     case 4:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setSortitionSeed(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProposerAddress(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
       break;
     default:
       reader.skipField();
@@ -4490,9 +11305,9 @@ proto.pactus.BlockHeaderInfo.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.BlockHeaderInfo.prototype.serializeBinary = function() {
+proto.pactus.VoteInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.BlockHeaderInfo.serializeBinaryToWriter(this, writer);
+  proto.pactus.VoteInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4500,209 +11315,136 @@ proto.pactus.BlockHeaderInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.BlockHeaderInfo} message
+ * @param {!proto.pactus.VoteInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.BlockHeaderInfo.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.VoteInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getVersion();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getPrevBlockHash_asU8();
+  f = message.getVoter();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getStateRoot_asU8();
+  f = message.getBlockHash_asU8();
   if (f.length > 0) {
     writer.writeBytes(
       3,
       f
     );
   }
-  f = message.getSortitionSeed_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getProposerAddress();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-};
-
-
-/**
- * optional int32 version = 1;
- * @return {number}
- */
-proto.pactus.BlockHeaderInfo.prototype.getVersion = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.BlockHeaderInfo} returns this
+ * optional VoteType type = 1;
+ * @return {!proto.pactus.VoteType}
  */
-proto.pactus.BlockHeaderInfo.prototype.setVersion = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.pactus.VoteInfo.prototype.getType = function() {
+  return /** @type {!proto.pactus.VoteType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional bytes prev_block_hash = 2;
- * @return {!(string|Uint8Array)}
+ * @param {!proto.pactus.VoteType} value
+ * @return {!proto.pactus.VoteInfo} returns this
  */
-proto.pactus.BlockHeaderInfo.prototype.getPrevBlockHash = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pactus.VoteInfo.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * optional bytes prev_block_hash = 2;
- * This is a type-conversion wrapper around `getPrevBlockHash()`
+ * optional string voter = 2;
  * @return {string}
  */
-proto.pactus.BlockHeaderInfo.prototype.getPrevBlockHash_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getPrevBlockHash()));
-};
-
-
-/**
- * optional bytes prev_block_hash = 2;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getPrevBlockHash()`
- * @return {!Uint8Array}
- */
-proto.pactus.BlockHeaderInfo.prototype.getPrevBlockHash_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getPrevBlockHash()));
+proto.pactus.VoteInfo.prototype.getVoter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.BlockHeaderInfo} returns this
+ * @param {string} value
+ * @return {!proto.pactus.VoteInfo} returns this
  */
-proto.pactus.BlockHeaderInfo.prototype.setPrevBlockHash = function(value) {
-  return jspb.Message.setProto3BytesField(this, 2, value);
+proto.pactus.VoteInfo.prototype.setVoter = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional bytes state_root = 3;
+ * optional bytes block_hash = 3;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.BlockHeaderInfo.prototype.getStateRoot = function() {
+proto.pactus.VoteInfo.prototype.getBlockHash = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * optional bytes state_root = 3;
- * This is a type-conversion wrapper around `getStateRoot()`
+ * optional bytes block_hash = 3;
+ * This is a type-conversion wrapper around `getBlockHash()`
  * @return {string}
  */
-proto.pactus.BlockHeaderInfo.prototype.getStateRoot_asB64 = function() {
+proto.pactus.VoteInfo.prototype.getBlockHash_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getStateRoot()));
+      this.getBlockHash()));
 };
 
 
 /**
- * optional bytes state_root = 3;
+ * optional bytes block_hash = 3;
  * Note that Uint8Array is not supported on all browsers.
  * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getStateRoot()`
+ * This is a type-conversion wrapper around `getBlockHash()`
  * @return {!Uint8Array}
  */
-proto.pactus.BlockHeaderInfo.prototype.getStateRoot_asU8 = function() {
+proto.pactus.VoteInfo.prototype.getBlockHash_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getStateRoot()));
+      this.getBlockHash()));
 };
 
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.BlockHeaderInfo} returns this
+ * @return {!proto.pactus.VoteInfo} returns this
  */
-proto.pactus.BlockHeaderInfo.prototype.setStateRoot = function(value) {
+proto.pactus.VoteInfo.prototype.setBlockHash = function(value) {
   return jspb.Message.setProto3BytesField(this, 3, value);
 };
 
 
 /**
- * optional bytes sortition_seed = 4;
- * @return {!(string|Uint8Array)}
- */
-proto.pactus.BlockHeaderInfo.prototype.getSortitionSeed = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * optional bytes sortition_seed = 4;
- * This is a type-conversion wrapper around `getSortitionSeed()`
- * @return {string}
- */
-proto.pactus.BlockHeaderInfo.prototype.getSortitionSeed_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getSortitionSeed()));
-};
-
-
-/**
- * optional bytes sortition_seed = 4;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getSortitionSeed()`
- * @return {!Uint8Array}
- */
-proto.pactus.BlockHeaderInfo.prototype.getSortitionSeed_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getSortitionSeed()));
-};
-
-
-/**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.BlockHeaderInfo} returns this
- */
-proto.pactus.BlockHeaderInfo.prototype.setSortitionSeed = function(value) {
-  return jspb.Message.setProto3BytesField(this, 4, value);
-};
-
-
-/**
- * optional string proposer_address = 5;
- * @return {string}
+ * optional int32 round = 4;
+ * @return {number}
  */
-proto.pactus.BlockHeaderInfo.prototype.getProposerAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.pactus.VoteInfo.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pactus.BlockHeaderInfo} returns this
+ * @param {number} value
+ * @return {!proto.pactus.VoteInfo} returns this
  */
-proto.pactus.BlockHeaderInfo.prototype.setProposerAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.pactus.VoteInfo.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -4712,7 +11454,7 @@ proto.pactus.BlockHeaderInfo.prototype.setProposerAddress = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.pactus.CertificateInfo.repeatedFields_ = [3,4];
+proto.pactus.ConsensusInfo.repeatedFields_ = [5,6];
 
 
 
@@ -4729,8 +11471,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.CertificateInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.CertificateInfo.toObject(opt_includeInstance, this);
+proto.pactus.ConsensusInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ConsensusInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -4739,17 +11481,23 @@ proto.pactus.CertificateInfo.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.CertificateInfo} msg The msg instance to transform.
+ * @param {!proto.pactus.ConsensusInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.CertificateInfo.toObject = function(includeInstance, msg) {
+proto.pactus.ConsensusInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    hash: msg.getHash_asB64(),
-    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    committersList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    absenteesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    signature: msg.getSignature_asB64()
+    address: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    active: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    height: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    round: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    votesList: jspb.Message.toObjectList(msg.getVotesList(),
+    proto.pactus.VoteInfo.toObject, includeInstance),
+    roundTimingsList: jspb.Message.toObjectList(msg.getRoundTimingsList(),
+    proto.pactus.RoundTiming.toObject, includeInstance),
+    proposal: (f = msg.getProposal()) && proto.pactus.ProposalInfo.toObject(includeInstance, f),
+    missedProposals: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    missedVotes: jspb.Message.getFieldWithDefault(msg, 9, 0)
   };
 
   if (includeInstance) {
@@ -4763,23 +11511,23 @@ proto.pactus.CertificateInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.CertificateInfo}
+ * @return {!proto.pactus.ConsensusInfo}
  */
-proto.pactus.CertificateInfo.deserializeBinary = function(bytes) {
+proto.pactus.ConsensusInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.CertificateInfo;
-  return proto.pactus.CertificateInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.ConsensusInfo;
+  return proto.pactus.ConsensusInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.CertificateInfo} msg The message object to deserialize into.
+ * @param {!proto.pactus.ConsensusInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.CertificateInfo}
+ * @return {!proto.pactus.ConsensusInfo}
  */
-proto.pactus.CertificateInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.ConsensusInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4787,28 +11535,43 @@ proto.pactus.CertificateInfo.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setHash(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setActive(value);
       break;
     case 3:
-      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addCommitters(values[i]);
-      }
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
       break;
     case 4:
-      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addAbsentees(values[i]);
-      }
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
       break;
     case 5:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setSignature(value);
+      var value = new proto.pactus.VoteInfo;
+      reader.readMessage(value,proto.pactus.VoteInfo.deserializeBinaryFromReader);
+      msg.addVotes(value);
+      break;
+    case 6:
+      var value = new proto.pactus.RoundTiming;
+      reader.readMessage(value,proto.pactus.RoundTiming.deserializeBinaryFromReader);
+      msg.addRoundTimings(value);
+      break;
+    case 7:
+      var value = new proto.pactus.ProposalInfo;
+      reader.readMessage(value,proto.pactus.ProposalInfo.deserializeBinaryFromReader);
+      msg.setProposal(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMissedProposals(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMissedVotes(value);
       break;
     default:
       reader.skipField();
@@ -4823,9 +11586,9 @@ proto.pactus.CertificateInfo.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.CertificateInfo.prototype.serializeBinary = function() {
+proto.pactus.ConsensusInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.CertificateInfo.serializeBinaryToWriter(this, writer);
+  proto.pactus.ConsensusInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4833,44 +11596,75 @@ proto.pactus.CertificateInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.CertificateInfo} message
+ * @param {!proto.pactus.ConsensusInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.CertificateInfo.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.ConsensusInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getHash_asU8();
+  f = message.getAddress();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getActive();
+  if (f) {
+    writer.writeBool(
       2,
       f
     );
   }
-  f = message.getCommittersList();
-  if (f.length > 0) {
-    writer.writePackedInt32(
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getAbsenteesList();
-  if (f.length > 0) {
-    writer.writePackedInt32(
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
       4,
       f
     );
   }
-  f = message.getSignature_asU8();
+  f = message.getVotesList();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeRepeatedMessage(
       5,
+      f,
+      proto.pactus.VoteInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getRoundTimingsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.pactus.RoundTiming.serializeBinaryToWriter
+    );
+  }
+  f = message.getProposal();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.pactus.ProposalInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getMissedProposals();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getMissedVotes();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
       f
     );
   }
@@ -4878,178 +11672,223 @@ proto.pactus.CertificateInfo.serializeBinaryToWriter = function(message, writer)
 
 
 /**
- * optional bytes hash = 1;
- * @return {!(string|Uint8Array)}
+ * optional string address = 1;
+ * @return {string}
  */
-proto.pactus.CertificateInfo.prototype.getHash = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pactus.ConsensusInfo.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bytes hash = 1;
- * This is a type-conversion wrapper around `getHash()`
- * @return {string}
+ * @param {string} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.getHash_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getHash()));
+proto.pactus.ConsensusInfo.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bytes hash = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getHash()`
- * @return {!Uint8Array}
+ * optional bool Active = 2;
+ * @return {boolean}
  */
-proto.pactus.CertificateInfo.prototype.getHash_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getHash()));
+proto.pactus.ConsensusInfo.prototype.getActive = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.CertificateInfo} returns this
+ * @param {boolean} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.setHash = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.pactus.ConsensusInfo.prototype.setActive = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * optional int32 round = 2;
+ * optional uint32 height = 3;
  * @return {number}
  */
-proto.pactus.CertificateInfo.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.pactus.ConsensusInfo.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.CertificateInfo} returns this
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.pactus.ConsensusInfo.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * repeated int32 committers = 3;
- * @return {!Array<number>}
+ * optional int32 round = 4;
+ * @return {number}
  */
-proto.pactus.CertificateInfo.prototype.getCommittersList = function() {
-  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.pactus.ConsensusInfo.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {!Array<number>} value
- * @return {!proto.pactus.CertificateInfo} returns this
+ * @param {number} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.setCommittersList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.pactus.ConsensusInfo.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * @param {number} value
+ * repeated VoteInfo votes = 5;
+ * @return {!Array<!proto.pactus.VoteInfo>}
+ */
+proto.pactus.ConsensusInfo.prototype.getVotesList = function() {
+  return /** @type{!Array<!proto.pactus.VoteInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.VoteInfo, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.VoteInfo>} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
+*/
+proto.pactus.ConsensusInfo.prototype.setVotesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.pactus.VoteInfo=} opt_value
  * @param {number=} opt_index
- * @return {!proto.pactus.CertificateInfo} returns this
+ * @return {!proto.pactus.VoteInfo}
  */
-proto.pactus.CertificateInfo.prototype.addCommitters = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.pactus.ConsensusInfo.prototype.addVotes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.pactus.VoteInfo, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pactus.CertificateInfo} returns this
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.clearCommittersList = function() {
-  return this.setCommittersList([]);
+proto.pactus.ConsensusInfo.prototype.clearVotesList = function() {
+  return this.setVotesList([]);
 };
 
 
 /**
- * repeated int32 absentees = 4;
- * @return {!Array<number>}
+ * repeated RoundTiming round_timings = 6;
+ * @return {!Array<!proto.pactus.RoundTiming>}
  */
-proto.pactus.CertificateInfo.prototype.getAbsenteesList = function() {
-  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.pactus.ConsensusInfo.prototype.getRoundTimingsList = function() {
+  return /** @type{!Array<!proto.pactus.RoundTiming>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.RoundTiming, 6));
 };
 
 
 /**
- * @param {!Array<number>} value
- * @return {!proto.pactus.CertificateInfo} returns this
- */
-proto.pactus.CertificateInfo.prototype.setAbsenteesList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+ * @param {!Array<!proto.pactus.RoundTiming>} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
+*/
+proto.pactus.ConsensusInfo.prototype.setRoundTimingsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
 };
 
 
 /**
- * @param {number} value
+ * @param {!proto.pactus.RoundTiming=} opt_value
  * @param {number=} opt_index
- * @return {!proto.pactus.CertificateInfo} returns this
+ * @return {!proto.pactus.RoundTiming}
  */
-proto.pactus.CertificateInfo.prototype.addAbsentees = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.pactus.ConsensusInfo.prototype.addRoundTimings = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.pactus.RoundTiming, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pactus.CertificateInfo} returns this
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.clearAbsenteesList = function() {
-  return this.setAbsenteesList([]);
+proto.pactus.ConsensusInfo.prototype.clearRoundTimingsList = function() {
+  return this.setRoundTimingsList([]);
 };
 
 
 /**
- * optional bytes signature = 5;
- * @return {!(string|Uint8Array)}
+ * optional ProposalInfo proposal = 7;
+ * @return {?proto.pactus.ProposalInfo}
  */
-proto.pactus.CertificateInfo.prototype.getSignature = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.pactus.ConsensusInfo.prototype.getProposal = function() {
+  return /** @type{?proto.pactus.ProposalInfo} */ (
+    jspb.Message.getWrapperField(this, proto.pactus.ProposalInfo, 7));
 };
 
 
 /**
- * optional bytes signature = 5;
- * This is a type-conversion wrapper around `getSignature()`
- * @return {string}
+ * @param {?proto.pactus.ProposalInfo|undefined} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
+*/
+proto.pactus.ConsensusInfo.prototype.setProposal = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.getSignature_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getSignature()));
+proto.pactus.ConsensusInfo.prototype.clearProposal = function() {
+  return this.setProposal(undefined);
 };
 
 
 /**
- * optional bytes signature = 5;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getSignature()`
- * @return {!Uint8Array}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pactus.CertificateInfo.prototype.getSignature_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getSignature()));
+proto.pactus.ConsensusInfo.prototype.hasProposal = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.CertificateInfo} returns this
+ * optional int64 missed_proposals = 8;
+ * @return {number}
+ */
+proto.pactus.ConsensusInfo.prototype.getMissedProposals = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
+ */
+proto.pactus.ConsensusInfo.prototype.setMissedProposals = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int64 missed_votes = 9;
+ * @return {number}
+ */
+proto.pactus.ConsensusInfo.prototype.getMissedVotes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.ConsensusInfo} returns this
  */
-proto.pactus.CertificateInfo.prototype.setSignature = function(value) {
-  return jspb.Message.setProto3BytesField(this, 5, value);
+proto.pactus.ConsensusInfo.prototype.setMissedVotes = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
 };
 
 
@@ -5069,8 +11908,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.VoteInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.VoteInfo.toObject(opt_includeInstance, this);
+proto.pactus.ProposalInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ProposalInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -5079,16 +11918,16 @@ proto.pactus.VoteInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.VoteInfo} msg The msg instance to transform.
+ * @param {!proto.pactus.ProposalInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.VoteInfo.toObject = function(includeInstance, msg) {
+proto.pactus.ProposalInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    voter: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    blockHash: msg.getBlockHash_asB64(),
-    round: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    blockData: msg.getBlockData_asB64(),
+    signature: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -5102,23 +11941,23 @@ proto.pactus.VoteInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.VoteInfo}
+ * @return {!proto.pactus.ProposalInfo}
  */
-proto.pactus.VoteInfo.deserializeBinary = function(bytes) {
+proto.pactus.ProposalInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.VoteInfo;
-  return proto.pactus.VoteInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.ProposalInfo;
+  return proto.pactus.ProposalInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.VoteInfo} msg The message object to deserialize into.
+ * @param {!proto.pactus.ProposalInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.VoteInfo}
+ * @return {!proto.pactus.ProposalInfo}
  */
-proto.pactus.VoteInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.ProposalInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5126,20 +11965,20 @@ proto.pactus.VoteInfo.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.pactus.VoteType} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setHeight(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setVoter(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRound(value);
       break;
     case 3:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setBlockHash(value);
+      msg.setBlockData(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setRound(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSignature(value);
       break;
     default:
       reader.skipField();
@@ -5154,9 +11993,9 @@ proto.pactus.VoteInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.VoteInfo.prototype.serializeBinary = function() {
+proto.pactus.ProposalInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.VoteInfo.serializeBinaryToWriter(this, writer);
+  proto.pactus.ProposalInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5164,36 +12003,36 @@ proto.pactus.VoteInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.VoteInfo} message
+ * @param {!proto.pactus.ProposalInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.VoteInfo.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.ProposalInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getVoter();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getBlockHash_asU8();
+  f = message.getBlockData_asU8();
   if (f.length > 0) {
     writer.writeBytes(
       3,
       f
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getSignature();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
@@ -5202,109 +12041,102 @@ proto.pactus.VoteInfo.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional VoteType type = 1;
- * @return {!proto.pactus.VoteType}
+ * optional uint32 height = 1;
+ * @return {number}
  */
-proto.pactus.VoteInfo.prototype.getType = function() {
-  return /** @type {!proto.pactus.VoteType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.pactus.ProposalInfo.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!proto.pactus.VoteType} value
- * @return {!proto.pactus.VoteInfo} returns this
+ * @param {number} value
+ * @return {!proto.pactus.ProposalInfo} returns this
  */
-proto.pactus.VoteInfo.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.pactus.ProposalInfo.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string voter = 2;
- * @return {string}
+ * optional int32 round = 2;
+ * @return {number}
  */
-proto.pactus.VoteInfo.prototype.getVoter = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pactus.ProposalInfo.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pactus.VoteInfo} returns this
+ * @param {number} value
+ * @return {!proto.pactus.ProposalInfo} returns this
  */
-proto.pactus.VoteInfo.prototype.setVoter = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pactus.ProposalInfo.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional bytes block_hash = 3;
+ * optional bytes block_data = 3;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.VoteInfo.prototype.getBlockHash = function() {
+proto.pactus.ProposalInfo.prototype.getBlockData = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * optional bytes block_hash = 3;
- * This is a type-conversion wrapper around `getBlockHash()`
+ * optional bytes block_data = 3;
+ * This is a type-conversion wrapper around `getBlockData()`
  * @return {string}
  */
-proto.pactus.VoteInfo.prototype.getBlockHash_asB64 = function() {
+proto.pactus.ProposalInfo.prototype.getBlockData_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getBlockHash()));
+      this.getBlockData()));
 };
 
 
 /**
- * optional bytes block_hash = 3;
+ * optional bytes block_data = 3;
  * Note that Uint8Array is not supported on all browsers.
  * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getBlockHash()`
+ * This is a type-conversion wrapper around `getBlockData()`
  * @return {!Uint8Array}
  */
-proto.pactus.VoteInfo.prototype.getBlockHash_asU8 = function() {
+proto.pactus.ProposalInfo.prototype.getBlockData_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getBlockHash()));
+      this.getBlockData()));
 };
 
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.VoteInfo} returns this
+ * @return {!proto.pactus.ProposalInfo} returns this
  */
-proto.pactus.VoteInfo.prototype.setBlockHash = function(value) {
+proto.pactus.ProposalInfo.prototype.setBlockData = function(value) {
   return jspb.Message.setProto3BytesField(this, 3, value);
 };
 
 
 /**
- * optional int32 round = 4;
- * @return {number}
+ * optional string signature = 4;
+ * @return {string}
  */
-proto.pactus.VoteInfo.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.pactus.ProposalInfo.prototype.getSignature = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.VoteInfo} returns this
+ * @param {string} value
+ * @return {!proto.pactus.ProposalInfo} returns this
  */
-proto.pactus.VoteInfo.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.pactus.ProposalInfo.prototype.setSignature = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pactus.ConsensusInfo.repeatedFields_ = [5];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5320,8 +12152,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.ConsensusInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.ConsensusInfo.toObject(opt_includeInstance, this);
+proto.pactus.RoundTiming.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.RoundTiming.toObject(opt_includeInstance, this);
 };
 
 
@@ -5330,18 +12162,16 @@ proto.pactus.ConsensusInfo.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.ConsensusInfo} msg The msg instance to transform.
+ * @param {!proto.pactus.RoundTiming} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.ConsensusInfo.toObject = function(includeInstance, msg) {
+proto.pactus.RoundTiming.toObject = function(includeInstance, msg) {
   var f, obj = {
-    address: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    active: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    height: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    round: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    votesList: jspb.Message.toObjectList(msg.getVotesList(),
-    proto.pactus.VoteInfo.toObject, includeInstance)
+    height: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    round: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    durationMs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    committed: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -5355,23 +12185,23 @@ proto.pactus.ConsensusInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.ConsensusInfo}
+ * @return {!proto.pactus.RoundTiming}
  */
-proto.pactus.ConsensusInfo.deserializeBinary = function(bytes) {
+proto.pactus.RoundTiming.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.ConsensusInfo;
-  return proto.pactus.ConsensusInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.RoundTiming;
+  return proto.pactus.RoundTiming.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.ConsensusInfo} msg The message object to deserialize into.
+ * @param {!proto.pactus.RoundTiming} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.ConsensusInfo}
+ * @return {!proto.pactus.RoundTiming}
  */
-proto.pactus.ConsensusInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.RoundTiming.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5379,25 +12209,20 @@ proto.pactus.ConsensusInfo.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAddress(value);
-      break;
-    case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setActive(value);
-      break;
-    case 3:
       var value = /** @type {number} */ (reader.readUint32());
       msg.setHeight(value);
       break;
-    case 4:
+    case 2:
       var value = /** @type {number} */ (reader.readInt32());
       msg.setRound(value);
       break;
-    case 5:
-      var value = new proto.pactus.VoteInfo;
-      reader.readMessage(value,proto.pactus.VoteInfo.deserializeBinaryFromReader);
-      msg.addVotes(value);
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDurationMs(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCommitted(value);
       break;
     default:
       reader.skipField();
@@ -5412,9 +12237,9 @@ proto.pactus.ConsensusInfo.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.ConsensusInfo.prototype.serializeBinary = function() {
+proto.pactus.RoundTiming.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.ConsensusInfo.serializeBinaryToWriter(this, writer);
+  proto.pactus.RoundTiming.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5422,158 +12247,112 @@ proto.pactus.ConsensusInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.ConsensusInfo} message
+ * @param {!proto.pactus.RoundTiming} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.ConsensusInfo.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.RoundTiming.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAddress();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getActive();
-  if (f) {
-    writer.writeBool(
+  f = message.getRound();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getHeight();
+  f = message.getDurationMs();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeInt64(
       3,
       f
     );
   }
-  f = message.getRound();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getCommitted();
+  if (f) {
+    writer.writeBool(
       4,
       f
     );
   }
-  f = message.getVotesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      5,
-      f,
-      proto.pactus.VoteInfo.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional string address = 1;
- * @return {string}
- */
-proto.pactus.ConsensusInfo.prototype.getAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pactus.ConsensusInfo} returns this
- */
-proto.pactus.ConsensusInfo.prototype.setAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool Active = 2;
- * @return {boolean}
+ * optional uint32 height = 1;
+ * @return {number}
  */
-proto.pactus.ConsensusInfo.prototype.getActive = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.pactus.RoundTiming.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pactus.ConsensusInfo} returns this
+ * @param {number} value
+ * @return {!proto.pactus.RoundTiming} returns this
  */
-proto.pactus.ConsensusInfo.prototype.setActive = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.pactus.RoundTiming.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional uint32 height = 3;
+ * optional int32 round = 2;
  * @return {number}
  */
-proto.pactus.ConsensusInfo.prototype.getHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.pactus.RoundTiming.prototype.getRound = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.ConsensusInfo} returns this
+ * @return {!proto.pactus.RoundTiming} returns this
  */
-proto.pactus.ConsensusInfo.prototype.setHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.pactus.RoundTiming.prototype.setRound = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 round = 4;
+ * optional int64 duration_ms = 3;
  * @return {number}
  */
-proto.pactus.ConsensusInfo.prototype.getRound = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.pactus.RoundTiming.prototype.getDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.ConsensusInfo} returns this
- */
-proto.pactus.ConsensusInfo.prototype.setRound = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-/**
- * repeated VoteInfo votes = 5;
- * @return {!Array<!proto.pactus.VoteInfo>}
+ * @return {!proto.pactus.RoundTiming} returns this
  */
-proto.pactus.ConsensusInfo.prototype.getVotesList = function() {
-  return /** @type{!Array<!proto.pactus.VoteInfo>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pactus.VoteInfo, 5));
-};
-
-
-/**
- * @param {!Array<!proto.pactus.VoteInfo>} value
- * @return {!proto.pactus.ConsensusInfo} returns this
-*/
-proto.pactus.ConsensusInfo.prototype.setVotesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+proto.pactus.RoundTiming.prototype.setDurationMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.pactus.VoteInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pactus.VoteInfo}
+ * optional bool committed = 4;
+ * @return {boolean}
  */
-proto.pactus.ConsensusInfo.prototype.addVotes = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.pactus.VoteInfo, opt_index);
+proto.pactus.RoundTiming.prototype.getCommitted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pactus.ConsensusInfo} returns this
+ * @param {boolean} value
+ * @return {!proto.pactus.RoundTiming} returns this
  */
-proto.pactus.ConsensusInfo.prototype.clearVotesList = function() {
-  return this.setVotesList([]);
+proto.pactus.RoundTiming.prototype.setCommitted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
@@ -5583,7 +12362,8 @@ proto.pactus.ConsensusInfo.prototype.clearVotesList = function() {
 proto.pactus.BlockVerbosity = {
   BLOCK_DATA: 0,
   BLOCK_INFO: 1,
-  BLOCK_TRANSACTIONS: 2
+  BLOCK_TRANSACTIONS: 2,
+  BLOCK_HEADER: 3
 };
 
 /**
@@ -4,6 +4,28 @@
 var grpc = require('grpc');
 var network_pb = require('./network_pb.js');
 
+function serialize_pactus_BanPeerRequest(arg) {
+  if (!(arg instanceof network_pb.BanPeerRequest)) {
+    throw new Error('Expected argument of type pactus.BanPeerRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_BanPeerRequest(buffer_arg) {
+  return network_pb.BanPeerRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_BanPeerResponse(arg) {
+  if (!(arg instanceof network_pb.BanPeerResponse)) {
+    throw new Error('Expected argument of type pactus.BanPeerResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_BanPeerResponse(buffer_arg) {
+  return network_pb.BanPeerResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetNetworkInfoRequest(arg) {
   if (!(arg instanceof network_pb.GetNetworkInfoRequest)) {
     throw new Error('Expected argument of type pactus.GetNetworkInfoRequest');
@@ -48,6 +70,116 @@ function deserialize_pactus_GetNodeInfoResponse(buffer_arg) {
   return network_pb.GetNodeInfoResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetRewardAddressesRequest(arg) {
+  if (!(arg instanceof network_pb.GetRewardAddressesRequest)) {
+    throw new Error('Expected argument of type pactus.GetRewardAddressesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetRewardAddressesRequest(buffer_arg) {
+  return network_pb.GetRewardAddressesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetRewardAddressesResponse(arg) {
+  if (!(arg instanceof network_pb.GetRewardAddressesResponse)) {
+    throw new Error('Expected argument of type pactus.GetRewardAddressesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetRewardAddressesResponse(buffer_arg) {
+  return network_pb.GetRewardAddressesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetSessionsRequest(arg) {
+  if (!(arg instanceof network_pb.GetSessionsRequest)) {
+    throw new Error('Expected argument of type pactus.GetSessionsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetSessionsRequest(buffer_arg) {
+  return network_pb.GetSessionsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetSessionsResponse(arg) {
+  if (!(arg instanceof network_pb.GetSessionsResponse)) {
+    throw new Error('Expected argument of type pactus.GetSessionsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetSessionsResponse(buffer_arg) {
+  return network_pb.GetSessionsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_PauseSyncRequest(arg) {
+  if (!(arg instanceof network_pb.PauseSyncRequest)) {
+    throw new Error('Expected argument of type pactus.PauseSyncRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_PauseSyncRequest(buffer_arg) {
+  return network_pb.PauseSyncRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_PauseSyncResponse(arg) {
+  if (!(arg instanceof network_pb.PauseSyncResponse)) {
+    throw new Error('Expected argument of type pactus.PauseSyncResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_PauseSyncResponse(buffer_arg) {
+  return network_pb.PauseSyncResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_ResumeSyncRequest(arg) {
+  if (!(arg instanceof network_pb.ResumeSyncRequest)) {
+    throw new Error('Expected argument of type pactus.ResumeSyncRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_ResumeSyncRequest(buffer_arg) {
+  return network_pb.ResumeSyncRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_ResumeSyncResponse(arg) {
+  if (!(arg instanceof network_pb.ResumeSyncResponse)) {
+    throw new Error('Expected argument of type pactus.ResumeSyncResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_ResumeSyncResponse(buffer_arg) {
+  return network_pb.ResumeSyncResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_UnbanPeerRequest(arg) {
+  if (!(arg instanceof network_pb.UnbanPeerRequest)) {
+    throw new Error('Expected argument of type pactus.UnbanPeerRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_UnbanPeerRequest(buffer_arg) {
+  return network_pb.UnbanPeerRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_UnbanPeerResponse(arg) {
+  if (!(arg instanceof network_pb.UnbanPeerResponse)) {
+    throw new Error('Expected argument of type pactus.UnbanPeerResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_UnbanPeerResponse(buffer_arg) {
+  return network_pb.UnbanPeerResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 var NetworkService = exports.NetworkService = {
   getNetworkInfo: {
@@ -72,6 +204,72 @@ var NetworkService = exports.NetworkService = {
     responseSerialize: serialize_pactus_GetNodeInfoResponse,
     responseDeserialize: deserialize_pactus_GetNodeInfoResponse,
   },
+  banPeer: {
+    path: '/pactus.Network/BanPeer',
+    requestStream: false,
+    responseStream: false,
+    requestType: network_pb.BanPeerRequest,
+    responseType: network_pb.BanPeerResponse,
+    requestSerialize: serialize_pactus_BanPeerRequest,
+    requestDeserialize: deserialize_pactus_BanPeerRequest,
+    responseSerialize: serialize_pactus_BanPeerResponse,
+    responseDeserialize: deserialize_pactus_BanPeerResponse,
+  },
+  unbanPeer: {
+    path: '/pactus.Network/UnbanPeer',
+    requestStream: false,
+    responseStream: false,
+    requestType: network_pb.UnbanPeerRequest,
+    responseType: network_pb.UnbanPeerResponse,
+    requestSerialize: serialize_pactus_UnbanPeerRequest,
+    requestDeserialize: deserialize_pactus_UnbanPeerRequest,
+    responseSerialize: serialize_pactus_UnbanPeerResponse,
+    responseDeserialize: deserialize_pactus_UnbanPeerResponse,
+  },
+  getRewardAddresses: {
+    path: '/pactus.Network/GetRewardAddresses',
+    requestStream: false,
+    responseStream: false,
+    requestType: network_pb.GetRewardAddressesRequest,
+    responseType: network_pb.GetRewardAddressesResponse,
+    requestSerialize: serialize_pactus_GetRewardAddressesRequest,
+    requestDeserialize: deserialize_pactus_GetRewardAddressesRequest,
+    responseSerialize: serialize_pactus_GetRewardAddressesResponse,
+    responseDeserialize: deserialize_pactus_GetRewardAddressesResponse,
+  },
+  pauseSync: {
+    path: '/pactus.Network/PauseSync',
+    requestStream: false,
+    responseStream: false,
+    requestType: network_pb.PauseSyncRequest,
+    responseType: network_pb.PauseSyncResponse,
+    requestSerialize: serialize_pactus_PauseSyncRequest,
+    requestDeserialize: deserialize_pactus_PauseSyncRequest,
+    responseSerialize: serialize_pactus_PauseSyncResponse,
+    responseDeserialize: deserialize_pactus_PauseSyncResponse,
+  },
+  resumeSync: {
+    path: '/pactus.Network/ResumeSync',
+    requestStream: false,
+    responseStream: false,
+    requestType: network_pb.ResumeSyncRequest,
+    responseType: network_pb.ResumeSyncResponse,
+    requestSerialize: serialize_pactus_ResumeSyncRequest,
+    requestDeserialize: deserialize_pactus_ResumeSyncRequest,
+    responseSerialize: serialize_pactus_ResumeSyncResponse,
+    responseDeserialize: deserialize_pactus_ResumeSyncResponse,
+  },
+  getSessions: {
+    path: '/pactus.Network/GetSessions',
+    requestStream: false,
+    responseStream: false,
+    requestType: network_pb.GetSessionsRequest,
+    responseType: network_pb.GetSessionsResponse,
+    requestSerialize: serialize_pactus_GetSessionsRequest,
+    requestDeserialize: deserialize_pactus_GetSessionsRequest,
+    responseSerialize: serialize_pactus_GetSessionsResponse,
+    responseDeserialize: deserialize_pactus_GetSessionsResponse,
+  },
 };
 
 exports.NetworkClient = grpc.makeGenericClientConstructor(NetworkService);
@@ -21,11 +21,25 @@ var global =
     (function () { return this; }).call(null) ||
     Function('return this')();
 
+goog.exportSymbol('proto.pactus.BanPeerRequest', null, global);
+goog.exportSymbol('proto.pactus.BanPeerResponse', null, global);
 goog.exportSymbol('proto.pactus.GetNetworkInfoRequest', null, global);
 goog.exportSymbol('proto.pactus.GetNetworkInfoResponse', null, global);
 goog.exportSymbol('proto.pactus.GetNodeInfoRequest', null, global);
 goog.exportSymbol('proto.pactus.GetNodeInfoResponse', null, global);
+goog.exportSymbol('proto.pactus.GetRewardAddressesRequest', null, global);
+goog.exportSymbol('proto.pactus.GetRewardAddressesResponse', null, global);
+goog.exportSymbol('proto.pactus.GetSessionsRequest', null, global);
+goog.exportSymbol('proto.pactus.GetSessionsResponse', null, global);
+goog.exportSymbol('proto.pactus.PauseSyncRequest', null, global);
+goog.exportSymbol('proto.pactus.PauseSyncResponse', null, global);
 goog.exportSymbol('proto.pactus.PeerInfo', null, global);
+goog.exportSymbol('proto.pactus.ResumeSyncRequest', null, global);
+goog.exportSymbol('proto.pactus.ResumeSyncResponse', null, global);
+goog.exportSymbol('proto.pactus.SessionInfo', null, global);
+goog.exportSymbol('proto.pactus.UnbanPeerRequest', null, global);
+goog.exportSymbol('proto.pactus.UnbanPeerResponse', null, global);
+goog.exportSymbol('proto.pactus.ValidatorReward', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -68,6 +82,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.GetNetworkInfoResponse.displayName = 'proto.pactus.GetNetworkInfoResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.SessionInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.SessionInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.SessionInfo.displayName = 'proto.pactus.SessionInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetSessionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetSessionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetSessionsRequest.displayName = 'proto.pactus.GetSessionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetSessionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetSessionsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetSessionsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetSessionsResponse.displayName = 'proto.pactus.GetSessionsResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -110,6 +187,237 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.GetNodeInfoResponse.displayName = 'proto.pactus.GetNodeInfoResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.BanPeerRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.BanPeerRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.BanPeerRequest.displayName = 'proto.pactus.BanPeerRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.BanPeerResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.BanPeerResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.BanPeerResponse.displayName = 'proto.pactus.BanPeerResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.UnbanPeerRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.UnbanPeerRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.UnbanPeerRequest.displayName = 'proto.pactus.UnbanPeerRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.UnbanPeerResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.UnbanPeerResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.UnbanPeerResponse.displayName = 'proto.pactus.UnbanPeerResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.PauseSyncRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.PauseSyncRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.PauseSyncRequest.displayName = 'proto.pactus.PauseSyncRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.PauseSyncResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.PauseSyncResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.PauseSyncResponse.displayName = 'proto.pactus.PauseSyncResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ResumeSyncRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.ResumeSyncRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ResumeSyncRequest.displayName = 'proto.pactus.ResumeSyncRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ResumeSyncResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.ResumeSyncResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ResumeSyncResponse.displayName = 'proto.pactus.ResumeSyncResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetRewardAddressesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetRewardAddressesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetRewardAddressesRequest.displayName = 'proto.pactus.GetRewardAddressesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetRewardAddressesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetRewardAddressesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetRewardAddressesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetRewardAddressesResponse.displayName = 'proto.pactus.GetRewardAddressesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.ValidatorReward = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.ValidatorReward.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.ValidatorReward, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.ValidatorReward.displayName = 'proto.pactus.ValidatorReward';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -238,7 +546,7 @@ proto.pactus.GetNetworkInfoRequest.serializeBinaryToWriter = function(message, w
  * @private {!Array<number>}
  * @const
  */
-proto.pactus.GetNetworkInfoResponse.repeatedFields_ = [4];
+proto.pactus.GetNetworkInfoResponse.repeatedFields_ = [4,7];
 
 
 
@@ -277,7 +585,9 @@ proto.pactus.GetNetworkInfoResponse.toObject = function(includeInstance, msg) {
     peersList: jspb.Message.toObjectList(msg.getPeersList(),
     proto.pactus.PeerInfo.toObject, includeInstance),
     sentBytesMap: (f = msg.getSentBytesMap()) ? f.toObject(includeInstance, undefined) : [],
-    receivedBytesMap: (f = msg.getReceivedBytesMap()) ? f.toObject(includeInstance, undefined) : []
+    receivedBytesMap: (f = msg.getReceivedBytesMap()) ? f.toObject(includeInstance, undefined) : [],
+    sessionsList: jspb.Message.toObjectList(msg.getSessionsList(),
+    proto.pactus.SessionInfo.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -343,6 +653,11 @@ proto.pactus.GetNetworkInfoResponse.deserializeBinaryFromReader = function(msg,
         jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readInt64, null, 0, 0);
          });
       break;
+    case 7:
+      var value = new proto.pactus.SessionInfo;
+      reader.readMessage(value,proto.pactus.SessionInfo.deserializeBinaryFromReader);
+      msg.addSessions(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -409,10 +724,18 @@ proto.pactus.GetNetworkInfoResponse.serializeBinaryToWriter = function(message,
   if (f && f.getLength() > 0) {
     f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeInt64);
   }
-};
-
-
-/**
+  f = message.getSessionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.pactus.SessionInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
  * optional int32 total_sent_bytes = 1;
  * @return {number}
  */
@@ -518,39 +841,2099 @@ proto.pactus.GetNetworkInfoResponse.prototype.getSentBytesMap = function(opt_noL
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pactus.GetNetworkInfoResponse} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pactus.GetNetworkInfoResponse} returns this
+ */
+proto.pactus.GetNetworkInfoResponse.prototype.clearSentBytesMap = function() {
+  this.getSentBytesMap().clear();
+  return this;
+};
+
+
+/**
+ * map<int32, int64> received_bytes = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,number>}
+ */
+proto.pactus.GetNetworkInfoResponse.prototype.getReceivedBytesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,number>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pactus.GetNetworkInfoResponse} returns this
+ */
+proto.pactus.GetNetworkInfoResponse.prototype.clearReceivedBytesMap = function() {
+  this.getReceivedBytesMap().clear();
+  return this;
+};
+
+
+/**
+ * repeated SessionInfo sessions = 7;
+ * @return {!Array<!proto.pactus.SessionInfo>}
+ */
+proto.pactus.GetNetworkInfoResponse.prototype.getSessionsList = function() {
+  return /** @type{!Array<!proto.pactus.SessionInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.SessionInfo, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.SessionInfo>} value
+ * @return {!proto.pactus.GetNetworkInfoResponse} returns this
+*/
+proto.pactus.GetNetworkInfoResponse.prototype.setSessionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.pactus.SessionInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.SessionInfo}
+ */
+proto.pactus.GetNetworkInfoResponse.prototype.addSessions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.pactus.SessionInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetNetworkInfoResponse} returns this
+ */
+proto.pactus.GetNetworkInfoResponse.prototype.clearSessionsList = function() {
+  return this.setSessionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.SessionInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.SessionInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.SessionInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.SessionInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    sessionId: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    peerId: msg.getPeerId_asB64(),
+    requestedBlocks: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    receivedBlocks: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    lastActivityAt: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    openedAt: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    age: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    lastResponseCode: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.SessionInfo}
+ */
+proto.pactus.SessionInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.SessionInfo;
+  return proto.pactus.SessionInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.SessionInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.SessionInfo}
+ */
+proto.pactus.SessionInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSessionId(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPeerId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRequestedBlocks(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReceivedBlocks(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastActivityAt(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setOpenedAt(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAge(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastResponseCode(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.SessionInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.SessionInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.SessionInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.SessionInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSessionId();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getPeerId_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getRequestedBlocks();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getReceivedBlocks();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getLastActivityAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getOpenedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getAge();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getLastResponseCode();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 session_id = 1;
+ * @return {number}
+ */
+proto.pactus.SessionInfo.prototype.getSessionId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setSessionId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes peer_id = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.SessionInfo.prototype.getPeerId = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes peer_id = 2;
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {string}
+ */
+proto.pactus.SessionInfo.prototype.getPeerId_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPeerId()));
+};
+
+
+/**
+ * optional bytes peer_id = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.SessionInfo.prototype.getPeerId_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPeerId()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setPeerId = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 requested_blocks = 3;
+ * @return {number}
+ */
+proto.pactus.SessionInfo.prototype.getRequestedBlocks = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setRequestedBlocks = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 received_blocks = 4;
+ * @return {number}
+ */
+proto.pactus.SessionInfo.prototype.getReceivedBlocks = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setReceivedBlocks = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 last_activity_at = 5;
+ * @return {number}
+ */
+proto.pactus.SessionInfo.prototype.getLastActivityAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setLastActivityAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 opened_at = 6;
+ * @return {number}
+ */
+proto.pactus.SessionInfo.prototype.getOpenedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setOpenedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int64 age = 7;
+ * @return {number}
+ */
+proto.pactus.SessionInfo.prototype.getAge = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setAge = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional string last_response_code = 8;
+ * @return {string}
+ */
+proto.pactus.SessionInfo.prototype.getLastResponseCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.SessionInfo} returns this
+ */
+proto.pactus.SessionInfo.prototype.setLastResponseCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetSessionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetSessionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetSessionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetSessionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetSessionsRequest}
+ */
+proto.pactus.GetSessionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetSessionsRequest;
+  return proto.pactus.GetSessionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetSessionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetSessionsRequest}
+ */
+proto.pactus.GetSessionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetSessionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetSessionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetSessionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetSessionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetSessionsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetSessionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetSessionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetSessionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetSessionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    sessionsList: jspb.Message.toObjectList(msg.getSessionsList(),
+    proto.pactus.SessionInfo.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetSessionsResponse}
+ */
+proto.pactus.GetSessionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetSessionsResponse;
+  return proto.pactus.GetSessionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetSessionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetSessionsResponse}
+ */
+proto.pactus.GetSessionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pactus.SessionInfo;
+      reader.readMessage(value,proto.pactus.SessionInfo.deserializeBinaryFromReader);
+      msg.addSessions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetSessionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetSessionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetSessionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetSessionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSessionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pactus.SessionInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated SessionInfo sessions = 1;
+ * @return {!Array<!proto.pactus.SessionInfo>}
+ */
+proto.pactus.GetSessionsResponse.prototype.getSessionsList = function() {
+  return /** @type{!Array<!proto.pactus.SessionInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.SessionInfo, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.SessionInfo>} value
+ * @return {!proto.pactus.GetSessionsResponse} returns this
+*/
+proto.pactus.GetSessionsResponse.prototype.setSessionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pactus.SessionInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.SessionInfo}
+ */
+proto.pactus.GetSessionsResponse.prototype.addSessions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.SessionInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetSessionsResponse} returns this
+ */
+proto.pactus.GetSessionsResponse.prototype.clearSessionsList = function() {
+  return this.setSessionsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetNodeInfoRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetNodeInfoRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetNodeInfoRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetNodeInfoRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetNodeInfoRequest}
+ */
+proto.pactus.GetNodeInfoRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetNodeInfoRequest;
+  return proto.pactus.GetNodeInfoRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetNodeInfoRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetNodeInfoRequest}
+ */
+proto.pactus.GetNodeInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetNodeInfoRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetNodeInfoRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetNodeInfoRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetNodeInfoRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetNodeInfoResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetNodeInfoResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetNodeInfoResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    moniker: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    agent: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    peerId: msg.getPeerId_asB64(),
+    syncPaused: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetNodeInfoResponse}
+ */
+proto.pactus.GetNodeInfoResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetNodeInfoResponse;
+  return proto.pactus.GetNodeInfoResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetNodeInfoResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetNodeInfoResponse}
+ */
+proto.pactus.GetNodeInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMoniker(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAgent(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPeerId(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSyncPaused(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetNodeInfoResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetNodeInfoResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetNodeInfoResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMoniker();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAgent();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPeerId_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = message.getSyncPaused();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string moniker = 1;
+ * @return {string}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.getMoniker = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetNodeInfoResponse} returns this
+ */
+proto.pactus.GetNodeInfoResponse.prototype.setMoniker = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string agent = 2;
+ * @return {string}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.getAgent = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.GetNodeInfoResponse} returns this
+ */
+proto.pactus.GetNodeInfoResponse.prototype.setAgent = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bytes peer_id = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.getPeerId = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes peer_id = 3;
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {string}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.getPeerId_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPeerId()));
+};
+
+
+/**
+ * optional bytes peer_id = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.getPeerId_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPeerId()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetNodeInfoResponse} returns this
+ */
+proto.pactus.GetNodeInfoResponse.prototype.setPeerId = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+/**
+ * optional bool sync_paused = 4;
+ * @return {boolean}
+ */
+proto.pactus.GetNodeInfoResponse.prototype.getSyncPaused = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pactus.GetNodeInfoResponse} returns this
+ */
+proto.pactus.GetNodeInfoResponse.prototype.setSyncPaused = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.BanPeerRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.BanPeerRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.BanPeerRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.BanPeerRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    peerId: msg.getPeerId_asB64(),
+    duration: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.BanPeerRequest}
+ */
+proto.pactus.BanPeerRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.BanPeerRequest;
+  return proto.pactus.BanPeerRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.BanPeerRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.BanPeerRequest}
+ */
+proto.pactus.BanPeerRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPeerId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDuration(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.BanPeerRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.BanPeerRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.BanPeerRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.BanPeerRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPeerId_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes peer_id = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.BanPeerRequest.prototype.getPeerId = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes peer_id = 1;
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {string}
+ */
+proto.pactus.BanPeerRequest.prototype.getPeerId_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPeerId()));
+};
+
+
+/**
+ * optional bytes peer_id = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.BanPeerRequest.prototype.getPeerId_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPeerId()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.BanPeerRequest} returns this
+ */
+proto.pactus.BanPeerRequest.prototype.setPeerId = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional int64 duration = 2;
+ * @return {number}
+ */
+proto.pactus.BanPeerRequest.prototype.getDuration = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.BanPeerRequest} returns this
+ */
+proto.pactus.BanPeerRequest.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.BanPeerResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.BanPeerResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.BanPeerResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.BanPeerResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.BanPeerResponse}
+ */
+proto.pactus.BanPeerResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.BanPeerResponse;
+  return proto.pactus.BanPeerResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.BanPeerResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.BanPeerResponse}
+ */
+proto.pactus.BanPeerResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.BanPeerResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.BanPeerResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.BanPeerResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.BanPeerResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.UnbanPeerRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.UnbanPeerRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.UnbanPeerRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.UnbanPeerRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    peerId: msg.getPeerId_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.UnbanPeerRequest}
+ */
+proto.pactus.UnbanPeerRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.UnbanPeerRequest;
+  return proto.pactus.UnbanPeerRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.UnbanPeerRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.UnbanPeerRequest}
+ */
+proto.pactus.UnbanPeerRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPeerId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.UnbanPeerRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.UnbanPeerRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.UnbanPeerRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.UnbanPeerRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPeerId_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes peer_id = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.UnbanPeerRequest.prototype.getPeerId = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes peer_id = 1;
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {string}
+ */
+proto.pactus.UnbanPeerRequest.prototype.getPeerId_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPeerId()));
+};
+
+
+/**
+ * optional bytes peer_id = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPeerId()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.UnbanPeerRequest.prototype.getPeerId_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPeerId()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.UnbanPeerRequest} returns this
+ */
+proto.pactus.UnbanPeerRequest.prototype.setPeerId = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.UnbanPeerResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.UnbanPeerResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.UnbanPeerResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.UnbanPeerResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.UnbanPeerResponse}
+ */
+proto.pactus.UnbanPeerResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.UnbanPeerResponse;
+  return proto.pactus.UnbanPeerResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.UnbanPeerResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.UnbanPeerResponse}
+ */
+proto.pactus.UnbanPeerResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.UnbanPeerResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.UnbanPeerResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.UnbanPeerResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.UnbanPeerResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.PauseSyncRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.PauseSyncRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.PauseSyncRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.PauseSyncRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.PauseSyncRequest}
+ */
+proto.pactus.PauseSyncRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.PauseSyncRequest;
+  return proto.pactus.PauseSyncRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.PauseSyncRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.PauseSyncRequest}
+ */
+proto.pactus.PauseSyncRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.PauseSyncRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.PauseSyncRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.PauseSyncRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.PauseSyncRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.PauseSyncResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.PauseSyncResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.PauseSyncResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.PauseSyncResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.PauseSyncResponse}
+ */
+proto.pactus.PauseSyncResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.PauseSyncResponse;
+  return proto.pactus.PauseSyncResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.PauseSyncResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.PauseSyncResponse}
+ */
+proto.pactus.PauseSyncResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.PauseSyncResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.PauseSyncResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.PauseSyncResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.PauseSyncResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.ResumeSyncRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ResumeSyncRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.ResumeSyncRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ResumeSyncRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.ResumeSyncRequest}
+ */
+proto.pactus.ResumeSyncRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.ResumeSyncRequest;
+  return proto.pactus.ResumeSyncRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.ResumeSyncRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.ResumeSyncRequest}
+ */
+proto.pactus.ResumeSyncRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.ResumeSyncRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.ResumeSyncRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.ResumeSyncRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ResumeSyncRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.ResumeSyncResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ResumeSyncResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.ResumeSyncResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ResumeSyncResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.ResumeSyncResponse}
+ */
+proto.pactus.ResumeSyncResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.ResumeSyncResponse;
+  return proto.pactus.ResumeSyncResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.ResumeSyncResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.ResumeSyncResponse}
+ */
+proto.pactus.ResumeSyncResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.ResumeSyncResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.ResumeSyncResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.ResumeSyncResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.ResumeSyncResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetRewardAddressesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetRewardAddressesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetRewardAddressesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetRewardAddressesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetRewardAddressesRequest}
+ */
+proto.pactus.GetRewardAddressesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetRewardAddressesRequest;
+  return proto.pactus.GetRewardAddressesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetRewardAddressesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetRewardAddressesRequest}
  */
-proto.pactus.GetNetworkInfoResponse.prototype.clearSentBytesMap = function() {
-  this.getSentBytesMap().clear();
-  return this;
+proto.pactus.GetRewardAddressesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * map<int32, int64> received_bytes = 6;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<number,number>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pactus.GetNetworkInfoResponse.prototype.getReceivedBytesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<number,number>} */ (
-      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
-      null));
+proto.pactus.GetRewardAddressesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetRewardAddressesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pactus.GetNetworkInfoResponse} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetRewardAddressesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetNetworkInfoResponse.prototype.clearReceivedBytesMap = function() {
-  this.getReceivedBytesMap().clear();
-  return this;
+proto.pactus.GetRewardAddressesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetRewardAddressesResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -566,8 +2949,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetNodeInfoRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetNodeInfoRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetRewardAddressesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetRewardAddressesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -576,13 +2959,14 @@ proto.pactus.GetNodeInfoRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetNodeInfoRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetRewardAddressesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetNodeInfoRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetRewardAddressesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    validatorsList: jspb.Message.toObjectList(msg.getValidatorsList(),
+    proto.pactus.ValidatorReward.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -596,29 +2980,34 @@ proto.pactus.GetNodeInfoRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetNodeInfoRequest}
+ * @return {!proto.pactus.GetRewardAddressesResponse}
  */
-proto.pactus.GetNodeInfoRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetRewardAddressesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetNodeInfoRequest;
-  return proto.pactus.GetNodeInfoRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetRewardAddressesResponse;
+  return proto.pactus.GetRewardAddressesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetNodeInfoRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetRewardAddressesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetNodeInfoRequest}
+ * @return {!proto.pactus.GetRewardAddressesResponse}
  */
-proto.pactus.GetNodeInfoRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetRewardAddressesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new proto.pactus.ValidatorReward;
+      reader.readMessage(value,proto.pactus.ValidatorReward.deserializeBinaryFromReader);
+      msg.addValidators(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -632,9 +3021,9 @@ proto.pactus.GetNodeInfoRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetNodeInfoRequest.prototype.serializeBinary = function() {
+proto.pactus.GetRewardAddressesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetNodeInfoRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetRewardAddressesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -642,16 +3031,69 @@ proto.pactus.GetNodeInfoRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetNodeInfoRequest} message
+ * @param {!proto.pactus.GetRewardAddressesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetNodeInfoRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetRewardAddressesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getValidatorsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pactus.ValidatorReward.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ValidatorReward validators = 1;
+ * @return {!Array<!proto.pactus.ValidatorReward>}
+ */
+proto.pactus.GetRewardAddressesResponse.prototype.getValidatorsList = function() {
+  return /** @type{!Array<!proto.pactus.ValidatorReward>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pactus.ValidatorReward, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pactus.ValidatorReward>} value
+ * @return {!proto.pactus.GetRewardAddressesResponse} returns this
+*/
+proto.pactus.GetRewardAddressesResponse.prototype.setValidatorsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pactus.ValidatorReward=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.ValidatorReward}
+ */
+proto.pactus.GetRewardAddressesResponse.prototype.addValidators = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pactus.ValidatorReward, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetRewardAddressesResponse} returns this
+ */
+proto.pactus.GetRewardAddressesResponse.prototype.clearValidatorsList = function() {
+  return this.setValidatorsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.ValidatorReward.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -667,8 +3109,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.GetNodeInfoResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.GetNodeInfoResponse.toObject(opt_includeInstance, this);
+proto.pactus.ValidatorReward.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.ValidatorReward.toObject(opt_includeInstance, this);
 };
 
 
@@ -677,15 +3119,16 @@ proto.pactus.GetNodeInfoResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.GetNodeInfoResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.ValidatorReward} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetNodeInfoResponse.toObject = function(includeInstance, msg) {
+proto.pactus.ValidatorReward.toObject = function(includeInstance, msg) {
   var f, obj = {
-    moniker: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    agent: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    peerId: msg.getPeerId_asB64()
+    validatorAddress: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    validatorNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    rewardAddressesList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    rotationInterval: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -699,23 +3142,23 @@ proto.pactus.GetNodeInfoResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.GetNodeInfoResponse}
+ * @return {!proto.pactus.ValidatorReward}
  */
-proto.pactus.GetNodeInfoResponse.deserializeBinary = function(bytes) {
+proto.pactus.ValidatorReward.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.GetNodeInfoResponse;
-  return proto.pactus.GetNodeInfoResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.ValidatorReward;
+  return proto.pactus.ValidatorReward.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.GetNodeInfoResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.ValidatorReward} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.GetNodeInfoResponse}
+ * @return {!proto.pactus.ValidatorReward}
  */
-proto.pactus.GetNodeInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.ValidatorReward.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -724,15 +3167,19 @@ proto.pactus.GetNodeInfoResponse.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMoniker(value);
+      msg.setValidatorAddress(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setAgent(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setValidatorNumber(value);
       break;
     case 3:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setPeerId(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addRewardAddresses(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRotationInterval(value);
       break;
     default:
       reader.skipField();
@@ -747,9 +3194,9 @@ proto.pactus.GetNodeInfoResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.GetNodeInfoResponse.prototype.serializeBinary = function() {
+proto.pactus.ValidatorReward.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.GetNodeInfoResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.ValidatorReward.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -757,111 +3204,131 @@ proto.pactus.GetNodeInfoResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.GetNodeInfoResponse} message
+ * @param {!proto.pactus.ValidatorReward} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.GetNodeInfoResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.ValidatorReward.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMoniker();
+  f = message.getValidatorAddress();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getAgent();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getValidatorNumber();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getPeerId_asU8();
+  f = message.getRewardAddressesList();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeRepeatedString(
       3,
       f
     );
   }
+  f = message.getRotationInterval();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string moniker = 1;
+ * optional string validator_address = 1;
  * @return {string}
  */
-proto.pactus.GetNodeInfoResponse.prototype.getMoniker = function() {
+proto.pactus.ValidatorReward.prototype.getValidatorAddress = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pactus.GetNodeInfoResponse} returns this
+ * @return {!proto.pactus.ValidatorReward} returns this
  */
-proto.pactus.GetNodeInfoResponse.prototype.setMoniker = function(value) {
+proto.pactus.ValidatorReward.prototype.setValidatorAddress = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string agent = 2;
- * @return {string}
+ * optional int32 validator_number = 2;
+ * @return {number}
  */
-proto.pactus.GetNodeInfoResponse.prototype.getAgent = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pactus.ValidatorReward.prototype.getValidatorNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pactus.GetNodeInfoResponse} returns this
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorReward} returns this
  */
-proto.pactus.GetNodeInfoResponse.prototype.setAgent = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pactus.ValidatorReward.prototype.setValidatorNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional bytes peer_id = 3;
- * @return {!(string|Uint8Array)}
+ * repeated string reward_addresses = 3;
+ * @return {!Array<string>}
  */
-proto.pactus.GetNodeInfoResponse.prototype.getPeerId = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.pactus.ValidatorReward.prototype.getRewardAddressesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * optional bytes peer_id = 3;
- * This is a type-conversion wrapper around `getPeerId()`
- * @return {string}
+ * @param {!Array<string>} value
+ * @return {!proto.pactus.ValidatorReward} returns this
  */
-proto.pactus.GetNodeInfoResponse.prototype.getPeerId_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getPeerId()));
+proto.pactus.ValidatorReward.prototype.setRewardAddressesList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * optional bytes peer_id = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getPeerId()`
- * @return {!Uint8Array}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.ValidatorReward} returns this
  */
-proto.pactus.GetNodeInfoResponse.prototype.getPeerId_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getPeerId()));
+proto.pactus.ValidatorReward.prototype.addRewardAddresses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.GetNodeInfoResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.ValidatorReward} returns this
  */
-proto.pactus.GetNodeInfoResponse.prototype.setPeerId = function(value) {
-  return jspb.Message.setProto3BytesField(this, 3, value);
+proto.pactus.ValidatorReward.prototype.clearRewardAddressesList = function() {
+  return this.setRewardAddressesList([]);
+};
+
+
+/**
+ * optional uint32 rotation_interval = 4;
+ * @return {number}
+ */
+proto.pactus.ValidatorReward.prototype.getRotationInterval = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.ValidatorReward} returns this
+ */
+proto.pactus.ValidatorReward.prototype.setRotationInterval = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -918,7 +3385,10 @@ proto.pactus.PeerInfo.toObject = function(includeInstance, msg) {
     lastReceived: jspb.Message.getFieldWithDefault(msg, 12, 0),
     sentBytesMap: (f = msg.getSentBytesMap()) ? f.toObject(includeInstance, undefined) : [],
     receivedBytesMap: (f = msg.getReceivedBytesMap()) ? f.toObject(includeInstance, undefined) : [],
-    address: jspb.Message.getFieldWithDefault(msg, 15, "")
+    address: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    latencyMs: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    direction: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    relayed: jspb.Message.getBooleanFieldWithDefault(msg, 18, false)
   };
 
   if (includeInstance) {
@@ -1019,6 +3489,18 @@ proto.pactus.PeerInfo.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setAddress(value);
       break;
+    case 16:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLatencyMs(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDirection(value);
+      break;
+    case 18:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRelayed(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1147,6 +3629,27 @@ proto.pactus.PeerInfo.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getLatencyMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      16,
+      f
+    );
+  }
+  f = message.getDirection();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = message.getRelayed();
+  if (f) {
+    writer.writeBool(
+      18,
+      f
+    );
+  }
 };
 
 
@@ -1497,4 +4000,58 @@ proto.pactus.PeerInfo.prototype.setAddress = function(value) {
 };
 
 
+/**
+ * optional int64 latency_ms = 16;
+ * @return {number}
+ */
+proto.pactus.PeerInfo.prototype.getLatencyMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.PeerInfo} returns this
+ */
+proto.pactus.PeerInfo.prototype.setLatencyMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 16, value);
+};
+
+
+/**
+ * optional string direction = 17;
+ * @return {string}
+ */
+proto.pactus.PeerInfo.prototype.getDirection = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pactus.PeerInfo} returns this
+ */
+proto.pactus.PeerInfo.prototype.setDirection = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
+};
+
+
+/**
+ * optional bool relayed = 18;
+ * @return {boolean}
+ */
+proto.pactus.PeerInfo.prototype.getRelayed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 18, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pactus.PeerInfo} returns this
+ */
+proto.pactus.PeerInfo.prototype.setRelayed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 18, value);
+};
+
+
 goog.object.extend(exports, proto.pactus);
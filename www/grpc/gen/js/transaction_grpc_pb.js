@@ -26,6 +26,72 @@ function deserialize_pactus_CalculateFeeResponse(buffer_arg) {
   return transaction_pb.CalculateFeeResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_EstimateFeeRequest(arg) {
+  if (!(arg instanceof transaction_pb.EstimateFeeRequest)) {
+    throw new Error('Expected argument of type pactus.EstimateFeeRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_EstimateFeeRequest(buffer_arg) {
+  return transaction_pb.EstimateFeeRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_EstimateFeeResponse(arg) {
+  if (!(arg instanceof transaction_pb.EstimateFeeResponse)) {
+    throw new Error('Expected argument of type pactus.EstimateFeeResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_EstimateFeeResponse(buffer_arg) {
+  return transaction_pb.EstimateFeeResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetTransactionInclusionRequest(arg) {
+  if (!(arg instanceof transaction_pb.GetTransactionInclusionRequest)) {
+    throw new Error('Expected argument of type pactus.GetTransactionInclusionRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetTransactionInclusionRequest(buffer_arg) {
+  return transaction_pb.GetTransactionInclusionRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetTransactionInclusionResponse(arg) {
+  if (!(arg instanceof transaction_pb.GetTransactionInclusionResponse)) {
+    throw new Error('Expected argument of type pactus.GetTransactionInclusionResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetTransactionInclusionResponse(buffer_arg) {
+  return transaction_pb.GetTransactionInclusionResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetTransactionProofRequest(arg) {
+  if (!(arg instanceof transaction_pb.GetTransactionProofRequest)) {
+    throw new Error('Expected argument of type pactus.GetTransactionProofRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetTransactionProofRequest(buffer_arg) {
+  return transaction_pb.GetTransactionProofRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetTransactionProofResponse(arg) {
+  if (!(arg instanceof transaction_pb.GetTransactionProofResponse)) {
+    throw new Error('Expected argument of type pactus.GetTransactionProofResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetTransactionProofResponse(buffer_arg) {
+  return transaction_pb.GetTransactionProofResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_GetTransactionRequest(arg) {
   if (!(arg instanceof transaction_pb.GetTransactionRequest)) {
     throw new Error('Expected argument of type pactus.GetTransactionRequest');
@@ -48,6 +114,28 @@ function deserialize_pactus_GetTransactionResponse(buffer_arg) {
   return transaction_pb.GetTransactionResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pactus_GetTxPoolStatsRequest(arg) {
+  if (!(arg instanceof transaction_pb.GetTxPoolStatsRequest)) {
+    throw new Error('Expected argument of type pactus.GetTxPoolStatsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetTxPoolStatsRequest(buffer_arg) {
+  return transaction_pb.GetTxPoolStatsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pactus_GetTxPoolStatsResponse(arg) {
+  if (!(arg instanceof transaction_pb.GetTxPoolStatsResponse)) {
+    throw new Error('Expected argument of type pactus.GetTxPoolStatsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pactus_GetTxPoolStatsResponse(buffer_arg) {
+  return transaction_pb.GetTxPoolStatsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pactus_SendRawTransactionRequest(arg) {
   if (!(arg instanceof transaction_pb.SendRawTransactionRequest)) {
     throw new Error('Expected argument of type pactus.SendRawTransactionRequest');
@@ -83,6 +171,28 @@ var TransactionService = exports.TransactionService = {
     responseSerialize: serialize_pactus_GetTransactionResponse,
     responseDeserialize: deserialize_pactus_GetTransactionResponse,
   },
+  getTransactionInclusion: {
+    path: '/pactus.Transaction/GetTransactionInclusion',
+    requestStream: false,
+    responseStream: false,
+    requestType: transaction_pb.GetTransactionInclusionRequest,
+    responseType: transaction_pb.GetTransactionInclusionResponse,
+    requestSerialize: serialize_pactus_GetTransactionInclusionRequest,
+    requestDeserialize: deserialize_pactus_GetTransactionInclusionRequest,
+    responseSerialize: serialize_pactus_GetTransactionInclusionResponse,
+    responseDeserialize: deserialize_pactus_GetTransactionInclusionResponse,
+  },
+  getTransactionProof: {
+    path: '/pactus.Transaction/GetTransactionProof',
+    requestStream: false,
+    responseStream: false,
+    requestType: transaction_pb.GetTransactionProofRequest,
+    responseType: transaction_pb.GetTransactionProofResponse,
+    requestSerialize: serialize_pactus_GetTransactionProofRequest,
+    requestDeserialize: deserialize_pactus_GetTransactionProofRequest,
+    responseSerialize: serialize_pactus_GetTransactionProofResponse,
+    responseDeserialize: deserialize_pactus_GetTransactionProofResponse,
+  },
   calculateFee: {
     path: '/pactus.Transaction/CalculateFee',
     requestStream: false,
@@ -94,6 +204,17 @@ var TransactionService = exports.TransactionService = {
     responseSerialize: serialize_pactus_CalculateFeeResponse,
     responseDeserialize: deserialize_pactus_CalculateFeeResponse,
   },
+  estimateFee: {
+    path: '/pactus.Transaction/EstimateFee',
+    requestStream: false,
+    responseStream: false,
+    requestType: transaction_pb.EstimateFeeRequest,
+    responseType: transaction_pb.EstimateFeeResponse,
+    requestSerialize: serialize_pactus_EstimateFeeRequest,
+    requestDeserialize: deserialize_pactus_EstimateFeeRequest,
+    responseSerialize: serialize_pactus_EstimateFeeResponse,
+    responseDeserialize: deserialize_pactus_EstimateFeeResponse,
+  },
   sendRawTransaction: {
     path: '/pactus.Transaction/SendRawTransaction',
     requestStream: false,
@@ -105,6 +226,17 @@ var TransactionService = exports.TransactionService = {
     responseSerialize: serialize_pactus_SendRawTransactionResponse,
     responseDeserialize: deserialize_pactus_SendRawTransactionResponse,
   },
+  getTxPoolStats: {
+    path: '/pactus.Transaction/GetTxPoolStats',
+    requestStream: false,
+    responseStream: false,
+    requestType: transaction_pb.GetTxPoolStatsRequest,
+    responseType: transaction_pb.GetTxPoolStatsResponse,
+    requestSerialize: serialize_pactus_GetTxPoolStatsRequest,
+    requestDeserialize: deserialize_pactus_GetTxPoolStatsRequest,
+    responseSerialize: serialize_pactus_GetTxPoolStatsResponse,
+    responseDeserialize: deserialize_pactus_GetTxPoolStatsResponse,
+  },
 };
 
 exports.TransactionClient = grpc.makeGenericClientConstructor(TransactionService);
@@ -23,8 +23,16 @@ var global =
 
 goog.exportSymbol('proto.pactus.CalculateFeeRequest', null, global);
 goog.exportSymbol('proto.pactus.CalculateFeeResponse', null, global);
+goog.exportSymbol('proto.pactus.EstimateFeeRequest', null, global);
+goog.exportSymbol('proto.pactus.EstimateFeeResponse', null, global);
+goog.exportSymbol('proto.pactus.GetTransactionInclusionRequest', null, global);
+goog.exportSymbol('proto.pactus.GetTransactionInclusionResponse', null, global);
+goog.exportSymbol('proto.pactus.GetTransactionProofRequest', null, global);
+goog.exportSymbol('proto.pactus.GetTransactionProofResponse', null, global);
 goog.exportSymbol('proto.pactus.GetTransactionRequest', null, global);
 goog.exportSymbol('proto.pactus.GetTransactionResponse', null, global);
+goog.exportSymbol('proto.pactus.GetTxPoolStatsRequest', null, global);
+goog.exportSymbol('proto.pactus.GetTxPoolStatsResponse', null, global);
 goog.exportSymbol('proto.pactus.PayloadBond', null, global);
 goog.exportSymbol('proto.pactus.PayloadSortition', null, global);
 goog.exportSymbol('proto.pactus.PayloadTransfer', null, global);
@@ -78,6 +86,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.GetTransactionResponse.displayName = 'proto.pactus.GetTransactionResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetTransactionInclusionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetTransactionInclusionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetTransactionInclusionRequest.displayName = 'proto.pactus.GetTransactionInclusionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetTransactionInclusionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetTransactionInclusionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetTransactionInclusionResponse.displayName = 'proto.pactus.GetTransactionInclusionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetTransactionProofRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetTransactionProofRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetTransactionProofRequest.displayName = 'proto.pactus.GetTransactionProofRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetTransactionProofResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pactus.GetTransactionProofResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pactus.GetTransactionProofResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetTransactionProofResponse.displayName = 'proto.pactus.GetTransactionProofResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -120,6 +212,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.CalculateFeeResponse.displayName = 'proto.pactus.CalculateFeeResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.EstimateFeeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.EstimateFeeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.EstimateFeeRequest.displayName = 'proto.pactus.EstimateFeeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.EstimateFeeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.EstimateFeeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.EstimateFeeResponse.displayName = 'proto.pactus.EstimateFeeResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -162,6 +296,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pactus.SendRawTransactionResponse.displayName = 'proto.pactus.SendRawTransactionResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetTxPoolStatsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetTxPoolStatsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetTxPoolStatsRequest.displayName = 'proto.pactus.GetTxPoolStatsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pactus.GetTxPoolStatsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pactus.GetTxPoolStatsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pactus.GetTxPoolStatsResponse.displayName = 'proto.pactus.GetTxPoolStatsResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -699,8 +875,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.CalculateFeeRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.CalculateFeeRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetTransactionInclusionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetTransactionInclusionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -709,14 +885,13 @@ proto.pactus.CalculateFeeRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.CalculateFeeRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetTransactionInclusionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.CalculateFeeRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetTransactionInclusionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    amount: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    payloadtype: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    id: msg.getId_asB64()
   };
 
   if (includeInstance) {
@@ -730,23 +905,23 @@ proto.pactus.CalculateFeeRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.CalculateFeeRequest}
+ * @return {!proto.pactus.GetTransactionInclusionRequest}
  */
-proto.pactus.CalculateFeeRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetTransactionInclusionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.CalculateFeeRequest;
-  return proto.pactus.CalculateFeeRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetTransactionInclusionRequest;
+  return proto.pactus.GetTransactionInclusionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.CalculateFeeRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetTransactionInclusionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.CalculateFeeRequest}
+ * @return {!proto.pactus.GetTransactionInclusionRequest}
  */
-proto.pactus.CalculateFeeRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetTransactionInclusionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -754,12 +929,8 @@ proto.pactus.CalculateFeeRequest.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setAmount(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.pactus.PayloadType} */ (reader.readEnum());
-      msg.setPayloadtype(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -774,9 +945,9 @@ proto.pactus.CalculateFeeRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.CalculateFeeRequest.prototype.serializeBinary = function() {
+proto.pactus.GetTransactionInclusionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.CalculateFeeRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetTransactionInclusionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -784,62 +955,61 @@ proto.pactus.CalculateFeeRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.CalculateFeeRequest} message
+ * @param {!proto.pactus.GetTransactionInclusionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.CalculateFeeRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetTransactionInclusionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAmount();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getId_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getPayloadtype();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional int64 amount = 1;
- * @return {number}
+ * optional bytes id = 1;
+ * @return {!(string|Uint8Array)}
  */
-proto.pactus.CalculateFeeRequest.prototype.getAmount = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.pactus.GetTransactionInclusionRequest.prototype.getId = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pactus.CalculateFeeRequest} returns this
+ * optional bytes id = 1;
+ * This is a type-conversion wrapper around `getId()`
+ * @return {string}
  */
-proto.pactus.CalculateFeeRequest.prototype.setAmount = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.pactus.GetTransactionInclusionRequest.prototype.getId_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getId()));
 };
 
 
 /**
- * optional PayloadType payloadType = 2;
- * @return {!proto.pactus.PayloadType}
+ * optional bytes id = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getId()`
+ * @return {!Uint8Array}
  */
-proto.pactus.CalculateFeeRequest.prototype.getPayloadtype = function() {
-  return /** @type {!proto.pactus.PayloadType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.pactus.GetTransactionInclusionRequest.prototype.getId_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getId()));
 };
 
 
 /**
- * @param {!proto.pactus.PayloadType} value
- * @return {!proto.pactus.CalculateFeeRequest} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetTransactionInclusionRequest} returns this
  */
-proto.pactus.CalculateFeeRequest.prototype.setPayloadtype = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.pactus.GetTransactionInclusionRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
@@ -859,8 +1029,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.CalculateFeeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.CalculateFeeResponse.toObject(opt_includeInstance, this);
+proto.pactus.GetTransactionInclusionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetTransactionInclusionResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -869,13 +1039,16 @@ proto.pactus.CalculateFeeResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.CalculateFeeResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.GetTransactionInclusionResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.CalculateFeeResponse.toObject = function(includeInstance, msg) {
+proto.pactus.GetTransactionInclusionResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fee: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    blockHeight: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    blockHash: msg.getBlockHash_asB64(),
+    blockTime: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    index: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -889,23 +1062,23 @@ proto.pactus.CalculateFeeResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.CalculateFeeResponse}
+ * @return {!proto.pactus.GetTransactionInclusionResponse}
  */
-proto.pactus.CalculateFeeResponse.deserializeBinary = function(bytes) {
+proto.pactus.GetTransactionInclusionResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.CalculateFeeResponse;
-  return proto.pactus.CalculateFeeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetTransactionInclusionResponse;
+  return proto.pactus.GetTransactionInclusionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.CalculateFeeResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetTransactionInclusionResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.CalculateFeeResponse}
+ * @return {!proto.pactus.GetTransactionInclusionResponse}
  */
-proto.pactus.CalculateFeeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetTransactionInclusionResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -913,8 +1086,20 @@ proto.pactus.CalculateFeeResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setFee(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBlockHeight(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBlockHash(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBlockTime(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setIndex(value);
       break;
     default:
       reader.skipField();
@@ -929,9 +1114,9 @@ proto.pactus.CalculateFeeResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.CalculateFeeResponse.prototype.serializeBinary = function() {
+proto.pactus.GetTransactionInclusionResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.CalculateFeeResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetTransactionInclusionResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -939,40 +1124,139 @@ proto.pactus.CalculateFeeResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.CalculateFeeResponse} message
+ * @param {!proto.pactus.GetTransactionInclusionResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.CalculateFeeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetTransactionInclusionResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFee();
+  f = message.getBlockHeight();
   if (f !== 0) {
-    writer.writeInt64(
+    writer.writeUint32(
       1,
       f
     );
   }
-};
-
-
-/**
- * optional int64 fee = 1;
- * @return {number}
- */
-proto.pactus.CalculateFeeResponse.prototype.getFee = function() {
+  f = message.getBlockHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+  f = message.getBlockTime();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getIndex();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 block_height = 1;
+ * @return {number}
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.getBlockHeight = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pactus.CalculateFeeResponse} returns this
+ * @return {!proto.pactus.GetTransactionInclusionResponse} returns this
  */
-proto.pactus.CalculateFeeResponse.prototype.setFee = function(value) {
+proto.pactus.GetTransactionInclusionResponse.prototype.setBlockHeight = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional bytes block_hash = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.getBlockHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes block_hash = 2;
+ * This is a type-conversion wrapper around `getBlockHash()`
+ * @return {string}
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.getBlockHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBlockHash()));
+};
+
+
+/**
+ * optional bytes block_hash = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBlockHash()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.getBlockHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBlockHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetTransactionInclusionResponse} returns this
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.setBlockHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 block_time = 3;
+ * @return {number}
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.getBlockTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTransactionInclusionResponse} returns this
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.setBlockTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 index = 4;
+ * @return {number}
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.getIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTransactionInclusionResponse} returns this
+ */
+proto.pactus.GetTransactionInclusionResponse.prototype.setIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
 
 
 
@@ -989,8 +1273,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.SendRawTransactionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.SendRawTransactionRequest.toObject(opt_includeInstance, this);
+proto.pactus.GetTransactionProofRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetTransactionProofRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -999,13 +1283,13 @@ proto.pactus.SendRawTransactionRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.SendRawTransactionRequest} msg The msg instance to transform.
+ * @param {!proto.pactus.GetTransactionProofRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.SendRawTransactionRequest.toObject = function(includeInstance, msg) {
+proto.pactus.GetTransactionProofRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64()
+    id: msg.getId_asB64()
   };
 
   if (includeInstance) {
@@ -1019,23 +1303,23 @@ proto.pactus.SendRawTransactionRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.SendRawTransactionRequest}
+ * @return {!proto.pactus.GetTransactionProofRequest}
  */
-proto.pactus.SendRawTransactionRequest.deserializeBinary = function(bytes) {
+proto.pactus.GetTransactionProofRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.SendRawTransactionRequest;
-  return proto.pactus.SendRawTransactionRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetTransactionProofRequest;
+  return proto.pactus.GetTransactionProofRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.SendRawTransactionRequest} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetTransactionProofRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.SendRawTransactionRequest}
+ * @return {!proto.pactus.GetTransactionProofRequest}
  */
-proto.pactus.SendRawTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetTransactionProofRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1044,7 +1328,7 @@ proto.pactus.SendRawTransactionRequest.deserializeBinaryFromReader = function(ms
     switch (field) {
     case 1:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -1059,9 +1343,9 @@ proto.pactus.SendRawTransactionRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.SendRawTransactionRequest.prototype.serializeBinary = function() {
+proto.pactus.GetTransactionProofRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.SendRawTransactionRequest.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetTransactionProofRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1069,13 +1353,13 @@ proto.pactus.SendRawTransactionRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.SendRawTransactionRequest} message
+ * @param {!proto.pactus.GetTransactionProofRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.SendRawTransactionRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetTransactionProofRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData_asU8();
+  f = message.getId_asU8();
   if (f.length > 0) {
     writer.writeBytes(
       1,
@@ -1086,48 +1370,55 @@ proto.pactus.SendRawTransactionRequest.serializeBinaryToWriter = function(messag
 
 
 /**
- * optional bytes data = 1;
+ * optional bytes id = 1;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.SendRawTransactionRequest.prototype.getData = function() {
+proto.pactus.GetTransactionProofRequest.prototype.getId = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
+ * optional bytes id = 1;
+ * This is a type-conversion wrapper around `getId()`
  * @return {string}
  */
-proto.pactus.SendRawTransactionRequest.prototype.getData_asB64 = function() {
+proto.pactus.GetTransactionProofRequest.prototype.getId_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
+      this.getId()));
 };
 
 
 /**
- * optional bytes data = 1;
+ * optional bytes id = 1;
  * Note that Uint8Array is not supported on all browsers.
  * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
+ * This is a type-conversion wrapper around `getId()`
  * @return {!Uint8Array}
  */
-proto.pactus.SendRawTransactionRequest.prototype.getData_asU8 = function() {
+proto.pactus.GetTransactionProofRequest.prototype.getId_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+      this.getId()));
 };
 
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.SendRawTransactionRequest} returns this
+ * @return {!proto.pactus.GetTransactionProofRequest} returns this
  */
-proto.pactus.SendRawTransactionRequest.prototype.setData = function(value) {
+proto.pactus.GetTransactionProofRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pactus.GetTransactionProofResponse.repeatedFields_ = [8];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1143,8 +1434,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pactus.SendRawTransactionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pactus.SendRawTransactionResponse.toObject(opt_includeInstance, this);
+proto.pactus.GetTransactionProofResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetTransactionProofResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1153,13 +1444,20 @@ proto.pactus.SendRawTransactionResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pactus.SendRawTransactionResponse} msg The msg instance to transform.
+ * @param {!proto.pactus.GetTransactionProofResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.SendRawTransactionResponse.toObject = function(includeInstance, msg) {
+proto.pactus.GetTransactionProofResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: msg.getId_asB64()
+    blockHeight: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    blockHash: msg.getBlockHash_asB64(),
+    blockHeader: msg.getBlockHeader_asB64(),
+    prevCertHash: msg.getPrevCertHash_asB64(),
+    txsRoot: msg.getTxsRoot_asB64(),
+    txCount: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    index: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    proofList: msg.getProofList_asB64()
   };
 
   if (includeInstance) {
@@ -1173,32 +1471,60 @@ proto.pactus.SendRawTransactionResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pactus.SendRawTransactionResponse}
+ * @return {!proto.pactus.GetTransactionProofResponse}
  */
-proto.pactus.SendRawTransactionResponse.deserializeBinary = function(bytes) {
+proto.pactus.GetTransactionProofResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pactus.SendRawTransactionResponse;
-  return proto.pactus.SendRawTransactionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pactus.GetTransactionProofResponse;
+  return proto.pactus.GetTransactionProofResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pactus.SendRawTransactionResponse} msg The message object to deserialize into.
+ * @param {!proto.pactus.GetTransactionProofResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pactus.SendRawTransactionResponse}
+ * @return {!proto.pactus.GetTransactionProofResponse}
  */
-proto.pactus.SendRawTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pactus.GetTransactionProofResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBlockHeight(value);
+      break;
     case 2:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setId(value);
+      msg.setBlockHash(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBlockHeader(value);
+      break;
+    case 4:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPrevCertHash(value);
+      break;
+    case 5:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setTxsRoot(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTxCount(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setIndex(value);
+      break;
+    case 8:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.addProof(value);
       break;
     default:
       reader.skipField();
@@ -1213,9 +1539,9 @@ proto.pactus.SendRawTransactionResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pactus.SendRawTransactionResponse.prototype.serializeBinary = function() {
+proto.pactus.GetTransactionProofResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pactus.SendRawTransactionResponse.serializeBinaryToWriter(this, writer);
+  proto.pactus.GetTransactionProofResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1223,64 +1549,1687 @@ proto.pactus.SendRawTransactionResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pactus.SendRawTransactionResponse} message
+ * @param {!proto.pactus.GetTransactionProofResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pactus.SendRawTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pactus.GetTransactionProofResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId_asU8();
+  f = message.getBlockHeight();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getBlockHash_asU8();
   if (f.length > 0) {
     writer.writeBytes(
       2,
       f
     );
   }
+  f = message.getBlockHeader_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = message.getPrevCertHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      4,
+      f
+    );
+  }
+  f = message.getTxsRoot_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
+      f
+    );
+  }
+  f = message.getTxCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getIndex();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getProofList_asU8();
+  if (f.length > 0) {
+    writer.writeRepeatedBytes(
+      8,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bytes id = 2;
+ * optional uint32 block_height = 1;
+ * @return {number}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getBlockHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.setBlockHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional bytes block_hash = 2;
  * @return {!(string|Uint8Array)}
  */
-proto.pactus.SendRawTransactionResponse.prototype.getId = function() {
+proto.pactus.GetTransactionProofResponse.prototype.getBlockHash = function() {
   return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bytes id = 2;
- * This is a type-conversion wrapper around `getId()`
+ * optional bytes block_hash = 2;
+ * This is a type-conversion wrapper around `getBlockHash()`
  * @return {string}
  */
-proto.pactus.SendRawTransactionResponse.prototype.getId_asB64 = function() {
+proto.pactus.GetTransactionProofResponse.prototype.getBlockHash_asB64 = function() {
   return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getId()));
+      this.getBlockHash()));
 };
 
 
 /**
- * optional bytes id = 2;
+ * optional bytes block_hash = 2;
  * Note that Uint8Array is not supported on all browsers.
  * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getId()`
+ * This is a type-conversion wrapper around `getBlockHash()`
  * @return {!Uint8Array}
  */
-proto.pactus.SendRawTransactionResponse.prototype.getId_asU8 = function() {
+proto.pactus.GetTransactionProofResponse.prototype.getBlockHash_asU8 = function() {
   return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getId()));
+      this.getBlockHash()));
 };
 
 
 /**
  * @param {!(string|Uint8Array)} value
- * @return {!proto.pactus.SendRawTransactionResponse} returns this
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
  */
-proto.pactus.SendRawTransactionResponse.prototype.setId = function(value) {
+proto.pactus.GetTransactionProofResponse.prototype.setBlockHash = function(value) {
   return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
+/**
+ * optional bytes block_header = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getBlockHeader = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes block_header = 3;
+ * This is a type-conversion wrapper around `getBlockHeader()`
+ * @return {string}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getBlockHeader_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBlockHeader()));
+};
+
+
+/**
+ * optional bytes block_header = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBlockHeader()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getBlockHeader_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBlockHeader()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.setBlockHeader = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+/**
+ * optional bytes prev_cert_hash = 4;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getPrevCertHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * optional bytes prev_cert_hash = 4;
+ * This is a type-conversion wrapper around `getPrevCertHash()`
+ * @return {string}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getPrevCertHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPrevCertHash()));
+};
+
+
+/**
+ * optional bytes prev_cert_hash = 4;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPrevCertHash()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getPrevCertHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPrevCertHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.setPrevCertHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 4, value);
+};
+
+
+/**
+ * optional bytes txs_root = 5;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getTxsRoot = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes txs_root = 5;
+ * This is a type-conversion wrapper around `getTxsRoot()`
+ * @return {string}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getTxsRoot_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getTxsRoot()));
+};
+
+
+/**
+ * optional bytes txs_root = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getTxsRoot()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getTxsRoot_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getTxsRoot()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.setTxsRoot = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
+};
+
+
+/**
+ * optional int32 tx_count = 6;
+ * @return {number}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getTxCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.setTxCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int32 index = 7;
+ * @return {number}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.setIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * repeated bytes proof = 8;
+ * @return {!(Array<!Uint8Array>|Array<string>)}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getProofList = function() {
+  return /** @type {!(Array<!Uint8Array>|Array<string>)} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * repeated bytes proof = 8;
+ * This is a type-conversion wrapper around `getProofList()`
+ * @return {!Array<string>}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getProofList_asB64 = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.bytesListAsB64(
+      this.getProofList()));
+};
+
+
+/**
+ * repeated bytes proof = 8;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getProofList()`
+ * @return {!Array<!Uint8Array>}
+ */
+proto.pactus.GetTransactionProofResponse.prototype.getProofList_asU8 = function() {
+  return /** @type {!Array<!Uint8Array>} */ (jspb.Message.bytesListAsU8(
+      this.getProofList()));
+};
+
+
+/**
+ * @param {!(Array<!Uint8Array>|Array<string>)} value
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.setProofList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @param {number=} opt_index
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.addProof = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pactus.GetTransactionProofResponse} returns this
+ */
+proto.pactus.GetTransactionProofResponse.prototype.clearProofList = function() {
+  return this.setProofList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.CalculateFeeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.CalculateFeeRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.CalculateFeeRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.CalculateFeeRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    amount: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    payloadtype: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.CalculateFeeRequest}
+ */
+proto.pactus.CalculateFeeRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.CalculateFeeRequest;
+  return proto.pactus.CalculateFeeRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.CalculateFeeRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.CalculateFeeRequest}
+ */
+proto.pactus.CalculateFeeRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAmount(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.pactus.PayloadType} */ (reader.readEnum());
+      msg.setPayloadtype(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.CalculateFeeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.CalculateFeeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.CalculateFeeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.CalculateFeeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAmount();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getPayloadtype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 amount = 1;
+ * @return {number}
+ */
+proto.pactus.CalculateFeeRequest.prototype.getAmount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.CalculateFeeRequest} returns this
+ */
+proto.pactus.CalculateFeeRequest.prototype.setAmount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional PayloadType payloadType = 2;
+ * @return {!proto.pactus.PayloadType}
+ */
+proto.pactus.CalculateFeeRequest.prototype.getPayloadtype = function() {
+  return /** @type {!proto.pactus.PayloadType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.pactus.PayloadType} value
+ * @return {!proto.pactus.CalculateFeeRequest} returns this
+ */
+proto.pactus.CalculateFeeRequest.prototype.setPayloadtype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.CalculateFeeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.CalculateFeeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.CalculateFeeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.CalculateFeeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fee: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.CalculateFeeResponse}
+ */
+proto.pactus.CalculateFeeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.CalculateFeeResponse;
+  return proto.pactus.CalculateFeeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.CalculateFeeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.CalculateFeeResponse}
+ */
+proto.pactus.CalculateFeeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFee(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.CalculateFeeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.CalculateFeeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.CalculateFeeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.CalculateFeeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFee();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 fee = 1;
+ * @return {number}
+ */
+proto.pactus.CalculateFeeResponse.prototype.getFee = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.CalculateFeeResponse} returns this
+ */
+proto.pactus.CalculateFeeResponse.prototype.setFee = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.EstimateFeeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.EstimateFeeRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.EstimateFeeRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.EstimateFeeRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    amount: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    payloadtype: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.EstimateFeeRequest}
+ */
+proto.pactus.EstimateFeeRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.EstimateFeeRequest;
+  return proto.pactus.EstimateFeeRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.EstimateFeeRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.EstimateFeeRequest}
+ */
+proto.pactus.EstimateFeeRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAmount(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.pactus.PayloadType} */ (reader.readEnum());
+      msg.setPayloadtype(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.EstimateFeeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.EstimateFeeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.EstimateFeeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.EstimateFeeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAmount();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getPayloadtype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 amount = 1;
+ * @return {number}
+ */
+proto.pactus.EstimateFeeRequest.prototype.getAmount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.EstimateFeeRequest} returns this
+ */
+proto.pactus.EstimateFeeRequest.prototype.setAmount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional PayloadType payloadType = 2;
+ * @return {!proto.pactus.PayloadType}
+ */
+proto.pactus.EstimateFeeRequest.prototype.getPayloadtype = function() {
+  return /** @type {!proto.pactus.PayloadType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.pactus.PayloadType} value
+ * @return {!proto.pactus.EstimateFeeRequest} returns this
+ */
+proto.pactus.EstimateFeeRequest.prototype.setPayloadtype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.EstimateFeeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.EstimateFeeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.EstimateFeeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.EstimateFeeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fee: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    minFee: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    pendingTxs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    poolUtilization: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.EstimateFeeResponse}
+ */
+proto.pactus.EstimateFeeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.EstimateFeeResponse;
+  return proto.pactus.EstimateFeeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.EstimateFeeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.EstimateFeeResponse}
+ */
+proto.pactus.EstimateFeeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFee(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMinFee(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPendingTxs(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setPoolUtilization(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.EstimateFeeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.EstimateFeeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.EstimateFeeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.EstimateFeeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFee();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getMinFee();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getPendingTxs();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPoolUtilization();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 fee = 1;
+ * @return {number}
+ */
+proto.pactus.EstimateFeeResponse.prototype.getFee = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.EstimateFeeResponse} returns this
+ */
+proto.pactus.EstimateFeeResponse.prototype.setFee = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 min_fee = 2;
+ * @return {number}
+ */
+proto.pactus.EstimateFeeResponse.prototype.getMinFee = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.EstimateFeeResponse} returns this
+ */
+proto.pactus.EstimateFeeResponse.prototype.setMinFee = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 pending_txs = 3;
+ * @return {number}
+ */
+proto.pactus.EstimateFeeResponse.prototype.getPendingTxs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.EstimateFeeResponse} returns this
+ */
+proto.pactus.EstimateFeeResponse.prototype.setPendingTxs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional double pool_utilization = 4;
+ * @return {number}
+ */
+proto.pactus.EstimateFeeResponse.prototype.getPoolUtilization = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.EstimateFeeResponse} returns this
+ */
+proto.pactus.EstimateFeeResponse.prototype.setPoolUtilization = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.SendRawTransactionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.SendRawTransactionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.SendRawTransactionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.SendRawTransactionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    data: msg.getData_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.SendRawTransactionRequest}
+ */
+proto.pactus.SendRawTransactionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.SendRawTransactionRequest;
+  return proto.pactus.SendRawTransactionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.SendRawTransactionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.SendRawTransactionRequest}
+ */
+proto.pactus.SendRawTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.SendRawTransactionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.SendRawTransactionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.SendRawTransactionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.SendRawTransactionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes data = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.SendRawTransactionRequest.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
+ */
+proto.pactus.SendRawTransactionRequest.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.SendRawTransactionRequest.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.SendRawTransactionRequest} returns this
+ */
+proto.pactus.SendRawTransactionRequest.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.SendRawTransactionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.SendRawTransactionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.SendRawTransactionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.SendRawTransactionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: msg.getId_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.SendRawTransactionResponse}
+ */
+proto.pactus.SendRawTransactionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.SendRawTransactionResponse;
+  return proto.pactus.SendRawTransactionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.SendRawTransactionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.SendRawTransactionResponse}
+ */
+proto.pactus.SendRawTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.SendRawTransactionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.SendRawTransactionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.SendRawTransactionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.SendRawTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes id = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pactus.SendRawTransactionResponse.prototype.getId = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes id = 2;
+ * This is a type-conversion wrapper around `getId()`
+ * @return {string}
+ */
+proto.pactus.SendRawTransactionResponse.prototype.getId_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getId()));
+};
+
+
+/**
+ * optional bytes id = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getId()`
+ * @return {!Uint8Array}
+ */
+proto.pactus.SendRawTransactionResponse.prototype.getId_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getId()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pactus.SendRawTransactionResponse} returns this
+ */
+proto.pactus.SendRawTransactionResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetTxPoolStatsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetTxPoolStatsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetTxPoolStatsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetTxPoolStatsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetTxPoolStatsRequest}
+ */
+proto.pactus.GetTxPoolStatsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetTxPoolStatsRequest;
+  return proto.pactus.GetTxPoolStatsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetTxPoolStatsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetTxPoolStatsRequest}
+ */
+proto.pactus.GetTxPoolStatsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetTxPoolStatsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetTxPoolStatsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetTxPoolStatsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetTxPoolStatsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pactus.GetTxPoolStatsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pactus.GetTxPoolStatsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetTxPoolStatsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    total: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    perTypeMap: (f = msg.getPerTypeMap()) ? f.toObject(includeInstance, undefined) : [],
+    capacity: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    utilization: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    oldestTxAgeMs: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pactus.GetTxPoolStatsResponse}
+ */
+proto.pactus.GetTxPoolStatsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pactus.GetTxPoolStatsResponse;
+  return proto.pactus.GetTxPoolStatsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pactus.GetTxPoolStatsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pactus.GetTxPoolStatsResponse}
+ */
+proto.pactus.GetTxPoolStatsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotal(value);
+      break;
+    case 2:
+      var value = msg.getPerTypeMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readInt32, jspb.BinaryReader.prototype.readInt32, null, 0, 0);
+         });
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCapacity(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setUtilization(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setOldestTxAgeMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pactus.GetTxPoolStatsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pactus.GetTxPoolStatsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pactus.GetTxPoolStatsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getPerTypeMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeInt32, jspb.BinaryWriter.prototype.writeInt32);
+  }
+  f = message.getCapacity();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getUtilization();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = message.getOldestTxAgeMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 total = 1;
+ * @return {number}
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTxPoolStatsResponse} returns this
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * map<int32, int32> per_type = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<number,number>}
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.getPerTypeMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<number,number>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pactus.GetTxPoolStatsResponse} returns this
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.clearPerTypeMap = function() {
+  this.getPerTypeMap().clear();
+  return this;
+};
+
+
+/**
+ * optional int32 capacity = 3;
+ * @return {number}
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.getCapacity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTxPoolStatsResponse} returns this
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.setCapacity = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional double utilization = 4;
+ * @return {number}
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.getUtilization = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTxPoolStatsResponse} returns this
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.setUtilization = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * optional int64 oldest_tx_age_ms = 5;
+ * @return {number}
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.getOldestTxAgeMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pactus.GetTxPoolStatsResponse} returns this
+ */
+proto.pactus.GetTxPoolStatsResponse.prototype.setOldestTxAgeMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
 
 
 
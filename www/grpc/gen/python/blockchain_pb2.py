@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 import transaction_pb2 as transaction__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10\x62lockchain.proto\x12\x06pactus\x1a\x11transaction.proto\"-\n\x11GetAccountRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\"C\n\x12GetAccountResponse\x12-\n\x07\x61\x63\x63ount\x18\x01 \x01(\x0b\x32\x13.pactus.AccountInfoR\x07\x61\x63\x63ount\"\x1e\n\x1cGetValidatorAddressesRequest\"=\n\x1dGetValidatorAddressesResponse\x12\x1c\n\taddresses\x18\x01 \x03(\tR\taddresses\"/\n\x13GetValidatorRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\"5\n\x1bGetValidatorByNumberRequest\x12\x16\n\x06number\x18\x01 \x01(\x05R\x06number\"K\n\x14GetValidatorResponse\x12\x33\n\tvalidator\x18\x01 \x01(\x0b\x32\x15.pactus.ValidatorInfoR\tvalidator\"/\n\x13GetPublicKeyRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\"5\n\x14GetPublicKeyResponse\x12\x1d\n\npublic_key\x18\x01 \x01(\tR\tpublicKey\"_\n\x0fGetBlockRequest\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x34\n\tverbosity\x18\x02 \x01(\x0e\x32\x16.pactus.BlockVerbosityR\tverbosity\"\x83\x02\n\x10GetBlockResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x12\n\x04hash\x18\x02 \x01(\x0cR\x04hash\x12\x12\n\x04\x64\x61ta\x18\x03 \x01(\x0cR\x04\x64\x61ta\x12\x1d\n\nblock_time\x18\x04 \x01(\rR\tblockTime\x12/\n\x06header\x18\x05 \x01(\x0b\x32\x17.pactus.BlockHeaderInfoR\x06header\x12\x34\n\tprev_cert\x18\x06 \x01(\x0b\x32\x17.pactus.CertificateInfoR\x08prevCert\x12)\n\x03txs\x18\x07 \x03(\x0b\x32\x17.pactus.TransactionInfoR\x03txs\"-\n\x13GetBlockHashRequest\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\"*\n\x14GetBlockHashResponse\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\"+\n\x15GetBlockHeightRequest\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\"0\n\x16GetBlockHeightResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\"\x1a\n\x18GetBlockchainInfoRequest\"\xd5\x02\n\x19GetBlockchainInfoResponse\x12*\n\x11last_block_height\x18\x01 \x01(\rR\x0flastBlockHeight\x12&\n\x0flast_block_hash\x18\x02 \x01(\x0cR\rlastBlockHash\x12%\n\x0etotal_accounts\x18\x03 \x01(\x05R\rtotalAccounts\x12)\n\x10total_validators\x18\x04 \x01(\x05R\x0ftotalValidators\x12\x1f\n\x0btotal_power\x18\x05 \x01(\x03R\ntotalPower\x12\'\n\x0f\x63ommittee_power\x18\x06 \x01(\x03R\x0e\x63ommitteePower\x12H\n\x14\x63ommittee_validators\x18\x07 \x03(\x0b\x32\x15.pactus.ValidatorInfoR\x13\x63ommitteeValidators\"\x19\n\x17GetConsensusInfoRequest\"O\n\x18GetConsensusInfoResponse\x12\x33\n\tinstances\x18\x01 \x03(\x0b\x32\x15.pactus.ConsensusInfoR\tinstances\"\xad\x02\n\rValidatorInfo\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\x12\x12\n\x04\x64\x61ta\x18\x02 \x01(\x0cR\x04\x64\x61ta\x12\x1d\n\npublic_key\x18\x03 \x01(\tR\tpublicKey\x12\x16\n\x06number\x18\x04 \x01(\x05R\x06number\x12\x14\n\x05stake\x18\x05 \x01(\x03R\x05stake\x12.\n\x13last_bonding_height\x18\x06 \x01(\rR\x11lastBondingHeight\x12\x32\n\x15last_sortition_height\x18\x07 \x01(\rR\x13lastSortitionHeight\x12)\n\x10unbonding_height\x18\x08 \x01(\rR\x0funbondingHeight\x12\x18\n\x07\x61\x64\x64ress\x18\t \x01(\tR\x07\x61\x64\x64ress\"\x81\x01\n\x0b\x41\x63\x63ountInfo\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\x12\x12\n\x04\x64\x61ta\x18\x02 \x01(\x0cR\x04\x64\x61ta\x12\x16\n\x06number\x18\x03 \x01(\x05R\x06number\x12\x18\n\x07\x62\x61lance\x18\x04 \x01(\x03R\x07\x62\x61lance\x12\x18\n\x07\x61\x64\x64ress\x18\x05 \x01(\tR\x07\x61\x64\x64ress\"\xc4\x01\n\x0f\x42lockHeaderInfo\x12\x18\n\x07version\x18\x01 \x01(\x05R\x07version\x12&\n\x0fprev_block_hash\x18\x02 \x01(\x0cR\rprevBlockHash\x12\x1d\n\nstate_root\x18\x03 \x01(\x0cR\tstateRoot\x12%\n\x0esortition_seed\x18\x04 \x01(\x0cR\rsortitionSeed\x12)\n\x10proposer_address\x18\x05 \x01(\tR\x0fproposerAddress\"\x97\x01\n\x0f\x43\x65rtificateInfo\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\x12\x14\n\x05round\x18\x02 \x01(\x05R\x05round\x12\x1e\n\ncommitters\x18\x03 \x03(\x05R\ncommitters\x12\x1c\n\tabsentees\x18\x04 \x03(\x05R\tabsentees\x12\x1c\n\tsignature\x18\x05 \x01(\x0cR\tsignature\"{\n\x08VoteInfo\x12$\n\x04type\x18\x01 \x01(\x0e\x32\x10.pactus.VoteTypeR\x04type\x12\x14\n\x05voter\x18\x02 \x01(\tR\x05voter\x12\x1d\n\nblock_hash\x18\x03 \x01(\x0cR\tblockHash\x12\x14\n\x05round\x18\x04 \x01(\x05R\x05round\"\x97\x01\n\rConsensusInfo\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\x12\x16\n\x06\x41\x63tive\x18\x02 \x01(\x08R\x06\x41\x63tive\x12\x16\n\x06height\x18\x03 \x01(\rR\x06height\x12\x14\n\x05round\x18\x04 \x01(\x05R\x05round\x12&\n\x05votes\x18\x05 \x03(\x0b\x32\x10.pactus.VoteInfoR\x05votes*H\n\x0e\x42lockVerbosity\x12\x0e\n\nBLOCK_DATA\x10\x00\x12\x0e\n\nBLOCK_INFO\x10\x01\x12\x16\n\x12\x42LOCK_TRANSACTIONS\x10\x02*\\\n\x08VoteType\x12\x10\n\x0cVOTE_UNKNOWN\x10\x00\x12\x10\n\x0cVOTE_PREPARE\x10\x01\x12\x12\n\x0eVOTE_PRECOMMIT\x10\x02\x12\x18\n\x14VOTE_CHANGE_PROPOSER\x10\x03\x32\xb4\x06\n\nBlockchain\x12=\n\x08GetBlock\x12\x17.pactus.GetBlockRequest\x1a\x18.pactus.GetBlockResponse\x12I\n\x0cGetBlockHash\x12\x1b.pactus.GetBlockHashRequest\x1a\x1c.pactus.GetBlockHashResponse\x12O\n\x0eGetBlockHeight\x12\x1d.pactus.GetBlockHeightRequest\x1a\x1e.pactus.GetBlockHeightResponse\x12X\n\x11GetBlockchainInfo\x12 .pactus.GetBlockchainInfoRequest\x1a!.pactus.GetBlockchainInfoResponse\x12U\n\x10GetConsensusInfo\x12\x1f.pactus.GetConsensusInfoRequest\x1a .pactus.GetConsensusInfoResponse\x12\x43\n\nGetAccount\x12\x19.pactus.GetAccountRequest\x1a\x1a.pactus.GetAccountResponse\x12I\n\x0cGetValidator\x12\x1b.pactus.GetValidatorRequest\x1a\x1c.pactus.GetValidatorResponse\x12Y\n\x14GetValidatorByNumber\x12#.pactus.GetValidatorByNumberRequest\x1a\x1c.pactus.GetValidatorResponse\x12\x64\n\x15GetValidatorAddresses\x12$.pactus.GetValidatorAddressesRequest\x1a%.pactus.GetValidatorAddressesResponse\x12I\n\x0cGetPublicKey\x12\x1b.pactus.GetPublicKeyRequest\x1a\x1c.pactus.GetPublicKeyResponseBE\n\x11pactus.blockchainZ0github.com/pactus-project/pactus/www/grpc/pactusb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10\x62lockchain.proto\x12\x06pactus\x1a\x11transaction.proto\"E\n\x11GetAccountRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\x12\x16\n\x06height\x18\x02 \x01(\rR\x06height\"C\n\x12GetAccountResponse\x12-\n\x07\x61\x63\x63ount\x18\x01 \x01(\x0b\x32\x13.pactus.AccountInfoR\x07\x61\x63\x63ount\"2\n\x12GetAccountsRequest\x12\x1c\n\taddresses\x18\x01 \x03(\tR\taddresses\"s\n\x13GetAccountsResponse\x12/\n\x08\x61\x63\x63ounts\x18\x01 \x03(\x0b\x32\x13.pactus.AccountInfoR\x08\x61\x63\x63ounts\x12+\n\x11missing_addresses\x18\x02 \x03(\tR\x10missingAddresses\"\x1e\n\x1cGetValidatorAddressesRequest\"{\n\x1dGetValidatorAddressesResponse\x12\x1c\n\taddresses\x18\x01 \x03(\tR\taddresses\x12<\n\nvalidators\x18\x02 \x03(\x0b\x32\x1c.pactus.ValidatorAddressInfoR\nvalidators\"g\n\x14ValidatorAddressInfo\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\x12\x16\n\x06number\x18\x02 \x01(\x05R\x06number\x12\x1d\n\npublic_key\x18\x03 \x01(\tR\tpublicKey\"/\n\x13GetValidatorRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\"5\n\x1bGetValidatorByNumberRequest\x12\x16\n\x06number\x18\x01 \x01(\x05R\x06number\"\xc6\x01\n\x14GetValidatorResponse\x12\x33\n\tvalidator\x18\x01 \x01(\x0b\x32\x15.pactus.ValidatorInfoR\tvalidator\x12/\n\x13withdrawable_height\x18\x02 \x01(\rR\x12withdrawableHeight\x12!\n\x0creward_known\x18\x03 \x01(\x08R\x0brewardKnown\x12%\n\x0ereward_balance\x18\x04 \x01(\x03R\rrewardBalance\"6\n\x1aGetValidatorHistoryRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\"T\n\x1bGetValidatorHistoryResponse\x12\x35\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x1d.pactus.ValidatorHistoryEventR\x06\x65vents\"\x98\x01\n\x15ValidatorHistoryEvent\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x39\n\x0btransaction\x18\x02 \x01(\x0b\x32\x17.pactus.TransactionInfoR\x0btransaction\x12\x16\n\x06\x61mount\x18\x03 \x01(\x03R\x06\x61mount\x12\x14\n\x05stake\x18\x04 \x01(\x03R\x05stake\"/\n\x13GetPublicKeyRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\"5\n\x14GetPublicKeyResponse\x12\x1d\n\npublic_key\x18\x01 \x01(\tR\tpublicKey\"=\n\x1cGetAddressByPublicKeyRequest\x12\x1d\n\npublic_key\x18\x01 \x01(\tR\tpublicKey\"u\n\x1dGetAddressByPublicKeyResponse\x12\'\n\x0f\x61\x63\x63ount_address\x18\x01 \x01(\tR\x0e\x61\x63\x63ountAddress\x12+\n\x11validator_address\x18\x02 \x01(\tR\x10validatorAddress\"\x13\n\x11GetGenesisRequest\"[\n\x12GetGenesisResponse\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\x12\x12\n\x04\x64\x61ta\x18\x02 \x01(\x0cR\x04\x64\x61ta\x12\x1d\n\nchain_type\x18\x03 \x01(\tR\tchainType\"\x12\n\x10GetParamsRequest\"\xa8\x04\n\x11GetParamsResponse\x12#\n\rblock_version\x18\x01 \x01(\rR\x0c\x62lockVersion\x12\x37\n\x18\x62lock_interval_in_second\x18\x02 \x01(\x05R\x15\x62lockIntervalInSecond\x12%\n\x0e\x63ommittee_size\x18\x03 \x01(\x05R\rcommitteeSize\x12!\n\x0c\x62lock_reward\x18\x04 \x01(\x03R\x0b\x62lockReward\x12?\n\x1ctransaction_to_live_interval\x18\x05 \x01(\rR\x19transactionToLiveInterval\x12#\n\rbond_interval\x18\x06 \x01(\rR\x0c\x62ondInterval\x12\'\n\x0funbond_interval\x18\x07 \x01(\rR\x0eunbondInterval\x12-\n\x12sortition_interval\x18\x08 \x01(\rR\x11sortitionInterval\x12!\n\x0c\x66\x65\x65_fraction\x18\t \x01(\x01R\x0b\x66\x65\x65\x46raction\x12\x1f\n\x0bminimum_fee\x18\n \x01(\x03R\nminimumFee\x12\x1f\n\x0bmaximum_fee\x18\x0b \x01(\x03R\nmaximumFee\x12#\n\rminimum_stake\x18\x0c \x01(\x03R\x0cminimumStake\x12#\n\rmaximum_stake\x18\r \x01(\x03R\x0cmaximumStake\"_\n\x0fGetBlockRequest\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x34\n\tverbosity\x18\x02 \x01(\x0e\x32\x16.pactus.BlockVerbosityR\tverbosity\"\x97\x02\n\x10GetBlockResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x12\n\x04hash\x18\x02 \x01(\x0cR\x04hash\x12\x12\n\x04\x64\x61ta\x18\x03 \x01(\x0cR\x04\x64\x61ta\x12\x1d\n\nblock_time\x18\x04 \x01(\rR\tblockTime\x12/\n\x06header\x18\x05 \x01(\x0b\x32\x17.pactus.BlockHeaderInfoR\x06header\x12\x34\n\tprev_cert\x18\x06 \x01(\x0b\x32\x17.pactus.CertificateInfoR\x08prevCert\x12)\n\x03txs\x18\x07 \x03(\x0b\x32\x17.pactus.TransactionInfoR\x03txs\x12\x12\n\x04size\x18\x08 \x01(\rR\x04size\",\n\x12GetRawBlockRequest\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\"w\n\x13GetRawBlockResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x1d\n\nblock_data\x18\x02 \x01(\x0cR\tblockData\x12)\n\x10\x63\x65rtificate_data\x18\x03 \x01(\x0cR\x0f\x63\x65rtificateData\".\n\x16GetRecentBlocksRequest\x12\x14\n\x05\x63ount\x18\x01 \x01(\rR\x05\x63ount\"G\n\x17GetRecentBlocksResponse\x12,\n\x06\x62locks\x18\x01 \x03(\x0b\x32\x14.pactus.BlockSummaryR\x06\x62locks\"\xb3\x01\n\x0c\x42lockSummary\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x12\n\x04hash\x18\x02 \x01(\x0cR\x04hash\x12\x1d\n\nblock_time\x18\x03 \x01(\rR\tblockTime\x12\x19\n\x08tx_count\x18\x04 \x01(\x05R\x07txCount\x12)\n\x10proposer_address\x18\x05 \x01(\tR\x0fproposerAddress\x12\x12\n\x04size\x18\x06 \x01(\rR\x04size\"q\n\x1bGetBlockTransactionsRequest\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x1b\n\tpage_size\x18\x02 \x01(\rR\x08pageSize\x12\x1d\n\npage_token\x18\x03 \x01(\tR\tpageToken\"q\n\x1cGetBlockTransactionsResponse\x12)\n\x03txs\x18\x01 \x03(\x0b\x32\x17.pactus.TransactionInfoR\x03txs\x12&\n\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n\x13GetBlockHashRequest\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\"*\n\x14GetBlockHashResponse\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\"/\n\x19GetBlockHashByTimeRequest\x12\x12\n\x04time\x18\x01 \x01(\rR\x04time\"g\n\x1aGetBlockHashByTimeResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x12\n\x04hash\x18\x02 \x01(\x0cR\x04hash\x12\x1d\n\nblock_time\x18\x03 \x01(\rR\tblockTime\"+\n\x15GetBlockHeightRequest\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\"0\n\x16GetBlockHeightResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\"\x1d\n\x1bSubscribeBlockHeightRequest\"6\n\x1cSubscribeBlockHeightResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\"3\n\x17SubscribeAccountRequest\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\"m\n\x18SubscribeAccountResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x39\n\x0btransaction\x18\x02 \x01(\x0b\x32\x17.pactus.TransactionInfoR\x0btransaction\"\x1a\n\x18GetBlockchainInfoRequest\"\xcf\x05\n\x19GetBlockchainInfoResponse\x12*\n\x11last_block_height\x18\x01 \x01(\rR\x0flastBlockHeight\x12&\n\x0flast_block_hash\x18\x02 \x01(\x0cR\rlastBlockHash\x12%\n\x0etotal_accounts\x18\x03 \x01(\x05R\rtotalAccounts\x12)\n\x10total_validators\x18\x04 \x01(\x05R\x0ftotalValidators\x12\x1f\n\x0btotal_power\x18\x05 \x01(\x03R\ntotalPower\x12\'\n\x0f\x63ommittee_power\x18\x06 \x01(\x03R\x0e\x63ommitteePower\x12H\n\x14\x63ommittee_validators\x18\x07 \x03(\x0b\x32\x15.pactus.ValidatorInfoR\x13\x63ommitteeValidators\x12.\n\x13\x65stimated_sync_time\x18\x08 \x01(\x03R\x11\x65stimatedSyncTime\x12!\n\x0ctotal_supply\x18\t \x01(\x03R\x0btotalSupply\x12)\n\x10treasury_balance\x18\n \x01(\x03R\x0ftreasuryBalance\x12-\n\x12\x63irculating_supply\x18\x0b \x01(\x03R\x11\x63irculatingSupply\x12`\n\x11tx_count_per_type\x18\x0c \x03(\x0b\x32\x35.pactus.GetBlockchainInfoResponse.TxCountPerTypeEntryR\x0etxCountPerType\x12&\n\x0ftx_stats_blocks\x18\r \x01(\rR\rtxStatsBlocks\x1a\x41\n\x13TxCountPerTypeEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05value:\x02\x38\x01\"\x15\n\x13GetCommitteeRequest\"w\n\x14GetCommitteeResponse\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x14\n\x05round\x18\x02 \x01(\x05R\x05round\x12\x31\n\x07members\x18\x03 \x03(\x0b\x32\x17.pactus.CommitteeMemberR\x07members\"\x83\x01\n\x0f\x43ommitteeMember\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\x12\x16\n\x06number\x18\x02 \x01(\x05R\x06number\x12\x1d\n\npublic_key\x18\x03 \x01(\tR\tpublicKey\x12\x1f\n\x0bis_proposer\x18\x04 \x01(\x08R\nisProposer\"1\n\x17GetConsensusInfoRequest\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\"O\n\x18GetConsensusInfoResponse\x12\x33\n\tinstances\x18\x01 \x03(\x0b\x32\x15.pactus.ConsensusInfoR\tinstances\"\xad\x02\n\rValidatorInfo\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\x12\x12\n\x04\x64\x61ta\x18\x02 \x01(\x0cR\x04\x64\x61ta\x12\x1d\n\npublic_key\x18\x03 \x01(\tR\tpublicKey\x12\x16\n\x06number\x18\x04 \x01(\x05R\x06number\x12\x14\n\x05stake\x18\x05 \x01(\x03R\x05stake\x12.\n\x13last_bonding_height\x18\x06 \x01(\rR\x11lastBondingHeight\x12\x32\n\x15last_sortition_height\x18\x07 \x01(\rR\x13lastSortitionHeight\x12)\n\x10unbonding_height\x18\x08 \x01(\rR\x0funbondingHeight\x12\x18\n\x07\x61\x64\x64ress\x18\t \x01(\tR\x07\x61\x64\x64ress\"\x81\x01\n\x0b\x41\x63\x63ountInfo\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\x12\x12\n\x04\x64\x61ta\x18\x02 \x01(\x0cR\x04\x64\x61ta\x12\x16\n\x06number\x18\x03 \x01(\x05R\x06number\x12\x18\n\x07\x62\x61lance\x18\x04 \x01(\x03R\x07\x62\x61lance\x12\x18\n\x07\x61\x64\x64ress\x18\x05 \x01(\tR\x07\x61\x64\x64ress\"\xc4\x01\n\x0f\x42lockHeaderInfo\x12\x18\n\x07version\x18\x01 \x01(\x05R\x07version\x12&\n\x0fprev_block_hash\x18\x02 \x01(\x0cR\rprevBlockHash\x12\x1d\n\nstate_root\x18\x03 \x01(\x0cR\tstateRoot\x12%\n\x0esortition_seed\x18\x04 \x01(\x0cR\rsortitionSeed\x12)\n\x10proposer_address\x18\x05 \x01(\tR\x0fproposerAddress\"\x97\x01\n\x0f\x43\x65rtificateInfo\x12\x12\n\x04hash\x18\x01 \x01(\x0cR\x04hash\x12\x14\n\x05round\x18\x02 \x01(\x05R\x05round\x12\x1e\n\ncommitters\x18\x03 \x03(\x05R\ncommitters\x12\x1c\n\tabsentees\x18\x04 \x03(\x05R\tabsentees\x12\x1c\n\tsignature\x18\x05 \x01(\x0cR\tsignature\"{\n\x08VoteInfo\x12$\n\x04type\x18\x01 \x01(\x0e\x32\x10.pactus.VoteTypeR\x04type\x12\x14\n\x05voter\x18\x02 \x01(\tR\x05voter\x12\x1d\n\nblock_hash\x18\x03 \x01(\x0cR\tblockHash\x12\x14\n\x05round\x18\x04 \x01(\x05R\x05round\"\xd1\x02\n\rConsensusInfo\x12\x18\n\x07\x61\x64\x64ress\x18\x01 \x01(\tR\x07\x61\x64\x64ress\x12\x16\n\x06\x41\x63tive\x18\x02 \x01(\x08R\x06\x41\x63tive\x12\x16\n\x06height\x18\x03 \x01(\rR\x06height\x12\x14\n\x05round\x18\x04 \x01(\x05R\x05round\x12&\n\x05votes\x18\x05 \x03(\x0b\x32\x10.pactus.VoteInfoR\x05votes\x12\x38\n\rround_timings\x18\x06 \x03(\x0b\x32\x13.pactus.RoundTimingR\x0croundTimings\x12\x30\n\x08proposal\x18\x07 \x01(\x0b\x32\x14.pactus.ProposalInfoR\x08proposal\x12)\n\x10missed_proposals\x18\x08 \x01(\x03R\x0fmissedProposals\x12!\n\x0cmissed_votes\x18\t \x01(\x03R\x0bmissedVotes\"y\n\x0cProposalInfo\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x14\n\x05round\x18\x02 \x01(\x05R\x05round\x12\x1d\n\nblock_data\x18\x03 \x01(\x0cR\tblockData\x12\x1c\n\tsignature\x18\x04 \x01(\tR\tsignature\"z\n\x0bRoundTiming\x12\x16\n\x06height\x18\x01 \x01(\rR\x06height\x12\x14\n\x05round\x18\x02 \x01(\x05R\x05round\x12\x1f\n\x0b\x64uration_ms\x18\x03 \x01(\x03R\ndurationMs\x12\x1c\n\tcommitted\x18\x04 \x01(\x08R\tcommitted*Z\n\x0e\x42lockVerbosity\x12\x0e\n\nBLOCK_DATA\x10\x00\x12\x0e\n\nBLOCK_INFO\x10\x01\x12\x16\n\x12\x42LOCK_TRANSACTIONS\x10\x02\x12\x10\n\x0c\x42LOCK_HEADER\x10\x03*\\\n\x08VoteType\x12\x10\n\x0cVOTE_UNKNOWN\x10\x00\x12\x10\n\x0cVOTE_PREPARE\x10\x01\x12\x12\n\x0eVOTE_PRECOMMIT\x10\x02\x12\x18\n\x14VOTE_CHANGE_PROPOSER\x10\x03\x32\xae\x0e\n\nBlockchain\x12=\n\x08GetBlock\x12\x17.pactus.GetBlockRequest\x1a\x18.pactus.GetBlockResponse\x12\x46\n\x0bGetRawBlock\x12\x1a.pactus.GetRawBlockRequest\x1a\x1b.pactus.GetRawBlockResponse\x12R\n\x0fGetRecentBlocks\x12\x1e.pactus.GetRecentBlocksRequest\x1a\x1f.pactus.GetRecentBlocksResponse\x12\x61\n\x14GetBlockTransactions\x12#.pactus.GetBlockTransactionsRequest\x1a$.pactus.GetBlockTransactionsResponse\x12I\n\x0cGetBlockHash\x12\x1b.pactus.GetBlockHashRequest\x1a\x1c.pactus.GetBlockHashResponse\x12[\n\x12GetBlockHashByTime\x12!.pactus.GetBlockHashByTimeRequest\x1a\".pactus.GetBlockHashByTimeResponse\x12O\n\x0eGetBlockHeight\x12\x1d.pactus.GetBlockHeightRequest\x1a\x1e.pactus.GetBlockHeightResponse\x12X\n\x11GetBlockchainInfo\x12 .pactus.GetBlockchainInfoRequest\x1a!.pactus.GetBlockchainInfoResponse\x12U\n\x10GetConsensusInfo\x12\x1f.pactus.GetConsensusInfoRequest\x1a .pactus.GetConsensusInfoResponse\x12\x43\n\nGetAccount\x12\x19.pactus.GetAccountRequest\x1a\x1a.pactus.GetAccountResponse\x12\x46\n\x0bGetAccounts\x12\x1a.pactus.GetAccountsRequest\x1a\x1b.pactus.GetAccountsResponse\x12I\n\x0cGetValidator\x12\x1b.pactus.GetValidatorRequest\x1a\x1c.pactus.GetValidatorResponse\x12Y\n\x14GetValidatorByNumber\x12#.pactus.GetValidatorByNumberRequest\x1a\x1c.pactus.GetValidatorResponse\x12^\n\x13GetValidatorHistory\x12\".pactus.GetValidatorHistoryRequest\x1a#.pactus.GetValidatorHistoryResponse\x12\x64\n\x15GetValidatorAddresses\x12$.pactus.GetValidatorAddressesRequest\x1a%.pactus.GetValidatorAddressesResponse\x12I\n\x0cGetPublicKey\x12\x1b.pactus.GetPublicKeyRequest\x1a\x1c.pactus.GetPublicKeyResponse\x12\x64\n\x15GetAddressByPublicKey\x12$.pactus.GetAddressByPublicKeyRequest\x1a%.pactus.GetAddressByPublicKeyResponse\x12\x43\n\nGetGenesis\x12\x19.pactus.GetGenesisRequest\x1a\x1a.pactus.GetGenesisResponse\x12I\n\x0cGetCommittee\x12\x1b.pactus.GetCommitteeRequest\x1a\x1c.pactus.GetCommitteeResponse\x12\x63\n\x14SubscribeBlockHeight\x12#.pactus.SubscribeBlockHeightRequest\x1a$.pactus.SubscribeBlockHeightResponse0\x01\x12W\n\x10SubscribeAccount\x12\x1f.pactus.SubscribeAccountRequest\x1a .pactus.SubscribeAccountResponse0\x01\x12@\n\tGetParams\x12\x18.pactus.GetParamsRequest\x1a\x19.pactus.GetParamsResponseBE\n\x11pactus.blockchainZ0github.com/pactus-project/pactus/www/grpc/pactusb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'blockchain_pb2', globals())
@@ -22,60 +22,124 @@ if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\021pactus.blockchainZ0github.com/pactus-project/pactus/www/grpc/pactus'
-  _BLOCKVERBOSITY._serialized_start=2636
-  _BLOCKVERBOSITY._serialized_end=2708
-  _VOTETYPE._serialized_start=2710
-  _VOTETYPE._serialized_end=2802
+  _GETBLOCKCHAININFORESPONSE_TXCOUNTPERTYPEENTRY._options = None
+  _GETBLOCKCHAININFORESPONSE_TXCOUNTPERTYPEENTRY._serialized_options = b'8\001'
+  _BLOCKVERBOSITY._serialized_start=6526
+  _BLOCKVERBOSITY._serialized_end=6616
+  _VOTETYPE._serialized_start=6618
+  _VOTETYPE._serialized_end=6710
   _GETACCOUNTREQUEST._serialized_start=47
-  _GETACCOUNTREQUEST._serialized_end=92
-  _GETACCOUNTRESPONSE._serialized_start=94
-  _GETACCOUNTRESPONSE._serialized_end=161
-  _GETVALIDATORADDRESSESREQUEST._serialized_start=163
-  _GETVALIDATORADDRESSESREQUEST._serialized_end=193
-  _GETVALIDATORADDRESSESRESPONSE._serialized_start=195
-  _GETVALIDATORADDRESSESRESPONSE._serialized_end=256
-  _GETVALIDATORREQUEST._serialized_start=258
-  _GETVALIDATORREQUEST._serialized_end=305
-  _GETVALIDATORBYNUMBERREQUEST._serialized_start=307
-  _GETVALIDATORBYNUMBERREQUEST._serialized_end=360
-  _GETVALIDATORRESPONSE._serialized_start=362
-  _GETVALIDATORRESPONSE._serialized_end=437
-  _GETPUBLICKEYREQUEST._serialized_start=439
-  _GETPUBLICKEYREQUEST._serialized_end=486
-  _GETPUBLICKEYRESPONSE._serialized_start=488
-  _GETPUBLICKEYRESPONSE._serialized_end=541
-  _GETBLOCKREQUEST._serialized_start=543
-  _GETBLOCKREQUEST._serialized_end=638
-  _GETBLOCKRESPONSE._serialized_start=641
-  _GETBLOCKRESPONSE._serialized_end=900
-  _GETBLOCKHASHREQUEST._serialized_start=902
-  _GETBLOCKHASHREQUEST._serialized_end=947
-  _GETBLOCKHASHRESPONSE._serialized_start=949
-  _GETBLOCKHASHRESPONSE._serialized_end=991
-  _GETBLOCKHEIGHTREQUEST._serialized_start=993
-  _GETBLOCKHEIGHTREQUEST._serialized_end=1036
-  _GETBLOCKHEIGHTRESPONSE._serialized_start=1038
-  _GETBLOCKHEIGHTRESPONSE._serialized_end=1086
-  _GETBLOCKCHAININFOREQUEST._serialized_start=1088
-  _GETBLOCKCHAININFOREQUEST._serialized_end=1114
-  _GETBLOCKCHAININFORESPONSE._serialized_start=1117
-  _GETBLOCKCHAININFORESPONSE._serialized_end=1458
-  _GETCONSENSUSINFOREQUEST._serialized_start=1460
-  _GETCONSENSUSINFOREQUEST._serialized_end=1485
-  _GETCONSENSUSINFORESPONSE._serialized_start=1487
-  _GETCONSENSUSINFORESPONSE._serialized_end=1566
-  _VALIDATORINFO._serialized_start=1569
-  _VALIDATORINFO._serialized_end=1870
-  _ACCOUNTINFO._serialized_start=1873
-  _ACCOUNTINFO._serialized_end=2002
-  _BLOCKHEADERINFO._serialized_start=2005
-  _BLOCKHEADERINFO._serialized_end=2201
-  _CERTIFICATEINFO._serialized_start=2204
-  _CERTIFICATEINFO._serialized_end=2355
-  _VOTEINFO._serialized_start=2357
-  _VOTEINFO._serialized_end=2480
-  _CONSENSUSINFO._serialized_start=2483
-  _CONSENSUSINFO._serialized_end=2634
-  _BLOCKCHAIN._serialized_start=2805
-  _BLOCKCHAIN._serialized_end=3625
+  _GETACCOUNTREQUEST._serialized_end=116
+  _GETACCOUNTRESPONSE._serialized_start=118
+  _GETACCOUNTRESPONSE._serialized_end=185
+  _GETACCOUNTSREQUEST._serialized_start=187
+  _GETACCOUNTSREQUEST._serialized_end=237
+  _GETACCOUNTSRESPONSE._serialized_start=239
+  _GETACCOUNTSRESPONSE._serialized_end=354
+  _GETVALIDATORADDRESSESREQUEST._serialized_start=356
+  _GETVALIDATORADDRESSESREQUEST._serialized_end=386
+  _GETVALIDATORADDRESSESRESPONSE._serialized_start=388
+  _GETVALIDATORADDRESSESRESPONSE._serialized_end=511
+  _VALIDATORADDRESSINFO._serialized_start=513
+  _VALIDATORADDRESSINFO._serialized_end=616
+  _GETVALIDATORREQUEST._serialized_start=618
+  _GETVALIDATORREQUEST._serialized_end=665
+  _GETVALIDATORBYNUMBERREQUEST._serialized_start=667
+  _GETVALIDATORBYNUMBERREQUEST._serialized_end=720
+  _GETVALIDATORRESPONSE._serialized_start=723
+  _GETVALIDATORRESPONSE._serialized_end=921
+  _GETVALIDATORHISTORYREQUEST._serialized_start=923
+  _GETVALIDATORHISTORYREQUEST._serialized_end=977
+  _GETVALIDATORHISTORYRESPONSE._serialized_start=979
+  _GETVALIDATORHISTORYRESPONSE._serialized_end=1063
+  _VALIDATORHISTORYEVENT._serialized_start=1066
+  _VALIDATORHISTORYEVENT._serialized_end=1218
+  _GETPUBLICKEYREQUEST._serialized_start=1220
+  _GETPUBLICKEYREQUEST._serialized_end=1267
+  _GETPUBLICKEYRESPONSE._serialized_start=1269
+  _GETPUBLICKEYRESPONSE._serialized_end=1322
+  _GETADDRESSBYPUBLICKEYREQUEST._serialized_start=1324
+  _GETADDRESSBYPUBLICKEYREQUEST._serialized_end=1385
+  _GETADDRESSBYPUBLICKEYRESPONSE._serialized_start=1387
+  _GETADDRESSBYPUBLICKEYRESPONSE._serialized_end=1504
+  _GETGENESISREQUEST._serialized_start=1506
+  _GETGENESISREQUEST._serialized_end=1525
+  _GETGENESISRESPONSE._serialized_start=1527
+  _GETGENESISRESPONSE._serialized_end=1618
+  _GETPARAMSREQUEST._serialized_start=1620
+  _GETPARAMSREQUEST._serialized_end=1638
+  _GETPARAMSRESPONSE._serialized_start=1641
+  _GETPARAMSRESPONSE._serialized_end=2193
+  _GETBLOCKREQUEST._serialized_start=2195
+  _GETBLOCKREQUEST._serialized_end=2290
+  _GETBLOCKRESPONSE._serialized_start=2293
+  _GETBLOCKRESPONSE._serialized_end=2572
+  _GETRAWBLOCKREQUEST._serialized_start=2574
+  _GETRAWBLOCKREQUEST._serialized_end=2618
+  _GETRAWBLOCKRESPONSE._serialized_start=2620
+  _GETRAWBLOCKRESPONSE._serialized_end=2739
+  _GETRECENTBLOCKSREQUEST._serialized_start=2741
+  _GETRECENTBLOCKSREQUEST._serialized_end=2787
+  _GETRECENTBLOCKSRESPONSE._serialized_start=2789
+  _GETRECENTBLOCKSRESPONSE._serialized_end=2860
+  _BLOCKSUMMARY._serialized_start=2863
+  _BLOCKSUMMARY._serialized_end=3042
+  _GETBLOCKTRANSACTIONSREQUEST._serialized_start=3044
+  _GETBLOCKTRANSACTIONSREQUEST._serialized_end=3157
+  _GETBLOCKTRANSACTIONSRESPONSE._serialized_start=3159
+  _GETBLOCKTRANSACTIONSRESPONSE._serialized_end=3272
+  _GETBLOCKHASHREQUEST._serialized_start=3274
+  _GETBLOCKHASHREQUEST._serialized_end=3319
+  _GETBLOCKHASHRESPONSE._serialized_start=3321
+  _GETBLOCKHASHRESPONSE._serialized_end=3363
+  _GETBLOCKHASHBYTIMEREQUEST._serialized_start=3365
+  _GETBLOCKHASHBYTIMEREQUEST._serialized_end=3412
+  _GETBLOCKHASHBYTIMERESPONSE._serialized_start=3414
+  _GETBLOCKHASHBYTIMERESPONSE._serialized_end=3517
+  _GETBLOCKHEIGHTREQUEST._serialized_start=3519
+  _GETBLOCKHEIGHTREQUEST._serialized_end=3562
+  _GETBLOCKHEIGHTRESPONSE._serialized_start=3564
+  _GETBLOCKHEIGHTRESPONSE._serialized_end=3612
+  _SUBSCRIBEBLOCKHEIGHTREQUEST._serialized_start=3614
+  _SUBSCRIBEBLOCKHEIGHTREQUEST._serialized_end=3643
+  _SUBSCRIBEBLOCKHEIGHTRESPONSE._serialized_start=3645
+  _SUBSCRIBEBLOCKHEIGHTRESPONSE._serialized_end=3699
+  _SUBSCRIBEACCOUNTREQUEST._serialized_start=3701
+  _SUBSCRIBEACCOUNTREQUEST._serialized_end=3752
+  _SUBSCRIBEACCOUNTRESPONSE._serialized_start=3754
+  _SUBSCRIBEACCOUNTRESPONSE._serialized_end=3863
+  _GETBLOCKCHAININFOREQUEST._serialized_start=3865
+  _GETBLOCKCHAININFOREQUEST._serialized_end=3891
+  _GETBLOCKCHAININFORESPONSE._serialized_start=3894
+  _GETBLOCKCHAININFORESPONSE._serialized_end=4613
+  _GETBLOCKCHAININFORESPONSE_TXCOUNTPERTYPEENTRY._serialized_start=4548
+  _GETBLOCKCHAININFORESPONSE_TXCOUNTPERTYPEENTRY._serialized_end=4613
+  _GETCOMMITTEEREQUEST._serialized_start=4615
+  _GETCOMMITTEEREQUEST._serialized_end=4636
+  _GETCOMMITTEERESPONSE._serialized_start=4638
+  _GETCOMMITTEERESPONSE._serialized_end=4757
+  _COMMITTEEMEMBER._serialized_start=4760
+  _COMMITTEEMEMBER._serialized_end=4891
+  _GETCONSENSUSINFOREQUEST._serialized_start=4893
+  _GETCONSENSUSINFOREQUEST._serialized_end=4942
+  _GETCONSENSUSINFORESPONSE._serialized_start=4944
+  _GETCONSENSUSINFORESPONSE._serialized_end=5023
+  _VALIDATORINFO._serialized_start=5026
+  _VALIDATORINFO._serialized_end=5327
+  _ACCOUNTINFO._serialized_start=5330
+  _ACCOUNTINFO._serialized_end=5459
+  _BLOCKHEADERINFO._serialized_start=5462
+  _BLOCKHEADERINFO._serialized_end=5658
+  _CERTIFICATEINFO._serialized_start=5661
+  _CERTIFICATEINFO._serialized_end=5812
+  _VOTEINFO._serialized_start=5814
+  _VOTEINFO._serialized_end=5937
+  _CONSENSUSINFO._serialized_start=5940
+  _CONSENSUSINFO._serialized_end=6277
+  _PROPOSALINFO._serialized_start=6279
+  _PROPOSALINFO._serialized_end=6400
+  _ROUNDTIMING._serialized_start=6402
+  _ROUNDTIMING._serialized_end=6524
+  _BLOCKCHAIN._serialized_start=6713
+  _BLOCKCHAIN._serialized_end=8551
 # @@protoc_insertion_point(module_scope)
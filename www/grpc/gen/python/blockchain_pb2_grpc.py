@@ -19,11 +19,31 @@ class BlockchainStub(object):
                 request_serializer=blockchain__pb2.GetBlockRequest.SerializeToString,
                 response_deserializer=blockchain__pb2.GetBlockResponse.FromString,
                 )
+        self.GetRawBlock = channel.unary_unary(
+                '/pactus.Blockchain/GetRawBlock',
+                request_serializer=blockchain__pb2.GetRawBlockRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetRawBlockResponse.FromString,
+                )
+        self.GetRecentBlocks = channel.unary_unary(
+                '/pactus.Blockchain/GetRecentBlocks',
+                request_serializer=blockchain__pb2.GetRecentBlocksRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetRecentBlocksResponse.FromString,
+                )
+        self.GetBlockTransactions = channel.unary_unary(
+                '/pactus.Blockchain/GetBlockTransactions',
+                request_serializer=blockchain__pb2.GetBlockTransactionsRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetBlockTransactionsResponse.FromString,
+                )
         self.GetBlockHash = channel.unary_unary(
                 '/pactus.Blockchain/GetBlockHash',
                 request_serializer=blockchain__pb2.GetBlockHashRequest.SerializeToString,
                 response_deserializer=blockchain__pb2.GetBlockHashResponse.FromString,
                 )
+        self.GetBlockHashByTime = channel.unary_unary(
+                '/pactus.Blockchain/GetBlockHashByTime',
+                request_serializer=blockchain__pb2.GetBlockHashByTimeRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetBlockHashByTimeResponse.FromString,
+                )
         self.GetBlockHeight = channel.unary_unary(
                 '/pactus.Blockchain/GetBlockHeight',
                 request_serializer=blockchain__pb2.GetBlockHeightRequest.SerializeToString,
@@ -44,6 +64,11 @@ class BlockchainStub(object):
                 request_serializer=blockchain__pb2.GetAccountRequest.SerializeToString,
                 response_deserializer=blockchain__pb2.GetAccountResponse.FromString,
                 )
+        self.GetAccounts = channel.unary_unary(
+                '/pactus.Blockchain/GetAccounts',
+                request_serializer=blockchain__pb2.GetAccountsRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetAccountsResponse.FromString,
+                )
         self.GetValidator = channel.unary_unary(
                 '/pactus.Blockchain/GetValidator',
                 request_serializer=blockchain__pb2.GetValidatorRequest.SerializeToString,
@@ -54,6 +79,11 @@ class BlockchainStub(object):
                 request_serializer=blockchain__pb2.GetValidatorByNumberRequest.SerializeToString,
                 response_deserializer=blockchain__pb2.GetValidatorResponse.FromString,
                 )
+        self.GetValidatorHistory = channel.unary_unary(
+                '/pactus.Blockchain/GetValidatorHistory',
+                request_serializer=blockchain__pb2.GetValidatorHistoryRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetValidatorHistoryResponse.FromString,
+                )
         self.GetValidatorAddresses = channel.unary_unary(
                 '/pactus.Blockchain/GetValidatorAddresses',
                 request_serializer=blockchain__pb2.GetValidatorAddressesRequest.SerializeToString,
@@ -64,6 +94,36 @@ class BlockchainStub(object):
                 request_serializer=blockchain__pb2.GetPublicKeyRequest.SerializeToString,
                 response_deserializer=blockchain__pb2.GetPublicKeyResponse.FromString,
                 )
+        self.GetAddressByPublicKey = channel.unary_unary(
+                '/pactus.Blockchain/GetAddressByPublicKey',
+                request_serializer=blockchain__pb2.GetAddressByPublicKeyRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetAddressByPublicKeyResponse.FromString,
+                )
+        self.GetGenesis = channel.unary_unary(
+                '/pactus.Blockchain/GetGenesis',
+                request_serializer=blockchain__pb2.GetGenesisRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetGenesisResponse.FromString,
+                )
+        self.GetCommittee = channel.unary_unary(
+                '/pactus.Blockchain/GetCommittee',
+                request_serializer=blockchain__pb2.GetCommitteeRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetCommitteeResponse.FromString,
+                )
+        self.SubscribeBlockHeight = channel.unary_stream(
+                '/pactus.Blockchain/SubscribeBlockHeight',
+                request_serializer=blockchain__pb2.SubscribeBlockHeightRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.SubscribeBlockHeightResponse.FromString,
+                )
+        self.SubscribeAccount = channel.unary_stream(
+                '/pactus.Blockchain/SubscribeAccount',
+                request_serializer=blockchain__pb2.SubscribeAccountRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.SubscribeAccountResponse.FromString,
+                )
+        self.GetParams = channel.unary_unary(
+                '/pactus.Blockchain/GetParams',
+                request_serializer=blockchain__pb2.GetParamsRequest.SerializeToString,
+                response_deserializer=blockchain__pb2.GetParamsResponse.FromString,
+                )
 
 
 class BlockchainServicer(object):
@@ -75,12 +135,36 @@ class BlockchainServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetRawBlock(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetRecentBlocks(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetBlockTransactions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetBlockHash(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetBlockHashByTime(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetBlockHeight(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -105,6 +189,12 @@ class BlockchainServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetAccounts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetValidator(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -117,6 +207,12 @@ class BlockchainServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetValidatorHistory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetValidatorAddresses(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -129,6 +225,42 @@ class BlockchainServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetAddressByPublicKey(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetGenesis(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetCommittee(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SubscribeBlockHeight(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SubscribeAccount(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetParams(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_BlockchainServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -137,11 +269,31 @@ def add_BlockchainServicer_to_server(servicer, server):
                     request_deserializer=blockchain__pb2.GetBlockRequest.FromString,
                     response_serializer=blockchain__pb2.GetBlockResponse.SerializeToString,
             ),
+            'GetRawBlock': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetRawBlock,
+                    request_deserializer=blockchain__pb2.GetRawBlockRequest.FromString,
+                    response_serializer=blockchain__pb2.GetRawBlockResponse.SerializeToString,
+            ),
+            'GetRecentBlocks': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetRecentBlocks,
+                    request_deserializer=blockchain__pb2.GetRecentBlocksRequest.FromString,
+                    response_serializer=blockchain__pb2.GetRecentBlocksResponse.SerializeToString,
+            ),
+            'GetBlockTransactions': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetBlockTransactions,
+                    request_deserializer=blockchain__pb2.GetBlockTransactionsRequest.FromString,
+                    response_serializer=blockchain__pb2.GetBlockTransactionsResponse.SerializeToString,
+            ),
             'GetBlockHash': grpc.unary_unary_rpc_method_handler(
                     servicer.GetBlockHash,
                     request_deserializer=blockchain__pb2.GetBlockHashRequest.FromString,
                     response_serializer=blockchain__pb2.GetBlockHashResponse.SerializeToString,
             ),
+            'GetBlockHashByTime': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetBlockHashByTime,
+                    request_deserializer=blockchain__pb2.GetBlockHashByTimeRequest.FromString,
+                    response_serializer=blockchain__pb2.GetBlockHashByTimeResponse.SerializeToString,
+            ),
             'GetBlockHeight': grpc.unary_unary_rpc_method_handler(
                     servicer.GetBlockHeight,
                     request_deserializer=blockchain__pb2.GetBlockHeightRequest.FromString,
@@ -162,6 +314,11 @@ def add_BlockchainServicer_to_server(servicer, server):
                     request_deserializer=blockchain__pb2.GetAccountRequest.FromString,
                     response_serializer=blockchain__pb2.GetAccountResponse.SerializeToString,
             ),
+            'GetAccounts': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetAccounts,
+                    request_deserializer=blockchain__pb2.GetAccountsRequest.FromString,
+                    response_serializer=blockchain__pb2.GetAccountsResponse.SerializeToString,
+            ),
             'GetValidator': grpc.unary_unary_rpc_method_handler(
                     servicer.GetValidator,
                     request_deserializer=blockchain__pb2.GetValidatorRequest.FromString,
@@ -172,6 +329,11 @@ def add_BlockchainServicer_to_server(servicer, server):
                     request_deserializer=blockchain__pb2.GetValidatorByNumberRequest.FromString,
                     response_serializer=blockchain__pb2.GetValidatorResponse.SerializeToString,
             ),
+            'GetValidatorHistory': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetValidatorHistory,
+                    request_deserializer=blockchain__pb2.GetValidatorHistoryRequest.FromString,
+                    response_serializer=blockchain__pb2.GetValidatorHistoryResponse.SerializeToString,
+            ),
             'GetValidatorAddresses': grpc.unary_unary_rpc_method_handler(
                     servicer.GetValidatorAddresses,
                     request_deserializer=blockchain__pb2.GetValidatorAddressesRequest.FromString,
@@ -182,6 +344,36 @@ def add_BlockchainServicer_to_server(servicer, server):
                     request_deserializer=blockchain__pb2.GetPublicKeyRequest.FromString,
                     response_serializer=blockchain__pb2.GetPublicKeyResponse.SerializeToString,
             ),
+            'GetAddressByPublicKey': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetAddressByPublicKey,
+                    request_deserializer=blockchain__pb2.GetAddressByPublicKeyRequest.FromString,
+                    response_serializer=blockchain__pb2.GetAddressByPublicKeyResponse.SerializeToString,
+            ),
+            'GetGenesis': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetGenesis,
+                    request_deserializer=blockchain__pb2.GetGenesisRequest.FromString,
+                    response_serializer=blockchain__pb2.GetGenesisResponse.SerializeToString,
+            ),
+            'GetCommittee': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetCommittee,
+                    request_deserializer=blockchain__pb2.GetCommitteeRequest.FromString,
+                    response_serializer=blockchain__pb2.GetCommitteeResponse.SerializeToString,
+            ),
+            'SubscribeBlockHeight': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeBlockHeight,
+                    request_deserializer=blockchain__pb2.SubscribeBlockHeightRequest.FromString,
+                    response_serializer=blockchain__pb2.SubscribeBlockHeightResponse.SerializeToString,
+            ),
+            'SubscribeAccount': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeAccount,
+                    request_deserializer=blockchain__pb2.SubscribeAccountRequest.FromString,
+                    response_serializer=blockchain__pb2.SubscribeAccountResponse.SerializeToString,
+            ),
+            'GetParams': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetParams,
+                    request_deserializer=blockchain__pb2.GetParamsRequest.FromString,
+                    response_serializer=blockchain__pb2.GetParamsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'pactus.Blockchain', rpc_method_handlers)
@@ -209,6 +401,57 @@ class Blockchain(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetRawBlock(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetRawBlock',
+            blockchain__pb2.GetRawBlockRequest.SerializeToString,
+            blockchain__pb2.GetRawBlockResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetRecentBlocks(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetRecentBlocks',
+            blockchain__pb2.GetRecentBlocksRequest.SerializeToString,
+            blockchain__pb2.GetRecentBlocksResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetBlockTransactions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetBlockTransactions',
+            blockchain__pb2.GetBlockTransactionsRequest.SerializeToString,
+            blockchain__pb2.GetBlockTransactionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetBlockHash(request,
             target,
@@ -226,6 +469,23 @@ class Blockchain(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetBlockHashByTime(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetBlockHashByTime',
+            blockchain__pb2.GetBlockHashByTimeRequest.SerializeToString,
+            blockchain__pb2.GetBlockHashByTimeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetBlockHeight(request,
             target,
@@ -294,6 +554,23 @@ class Blockchain(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetAccounts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetAccounts',
+            blockchain__pb2.GetAccountsRequest.SerializeToString,
+            blockchain__pb2.GetAccountsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetValidator(request,
             target,
@@ -328,6 +605,23 @@ class Blockchain(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetValidatorHistory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetValidatorHistory',
+            blockchain__pb2.GetValidatorHistoryRequest.SerializeToString,
+            blockchain__pb2.GetValidatorHistoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetValidatorAddresses(request,
             target,
@@ -361,3 +655,105 @@ class Blockchain(object):
             blockchain__pb2.GetPublicKeyResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetAddressByPublicKey(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetAddressByPublicKey',
+            blockchain__pb2.GetAddressByPublicKeyRequest.SerializeToString,
+            blockchain__pb2.GetAddressByPublicKeyResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetGenesis(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetGenesis',
+            blockchain__pb2.GetGenesisRequest.SerializeToString,
+            blockchain__pb2.GetGenesisResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetCommittee(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetCommittee',
+            blockchain__pb2.GetCommitteeRequest.SerializeToString,
+            blockchain__pb2.GetCommitteeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SubscribeBlockHeight(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/pactus.Blockchain/SubscribeBlockHeight',
+            blockchain__pb2.SubscribeBlockHeightRequest.SerializeToString,
+            blockchain__pb2.SubscribeBlockHeightResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SubscribeAccount(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/pactus.Blockchain/SubscribeAccount',
+            blockchain__pb2.SubscribeAccountRequest.SerializeToString,
+            blockchain__pb2.SubscribeAccountResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetParams(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Blockchain/GetParams',
+            blockchain__pb2.GetParamsRequest.SerializeToString,
+            blockchain__pb2.GetParamsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -13,7 +13,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rnetwork.proto\x12\x06pactus\"\x17\n\x15GetNetworkInfoRequest\"\xe3\x03\n\x16GetNetworkInfoResponse\x12(\n\x10total_sent_bytes\x18\x01 \x01(\x05R\x0etotalSentBytes\x12\x30\n\x14total_received_bytes\x18\x02 \x01(\x05R\x12totalReceivedBytes\x12\x1d\n\nstarted_at\x18\x03 \x01(\x03R\tstartedAt\x12&\n\x05peers\x18\x04 \x03(\x0b\x32\x10.pactus.PeerInfoR\x05peers\x12L\n\nsent_bytes\x18\x05 \x03(\x0b\x32-.pactus.GetNetworkInfoResponse.SentBytesEntryR\tsentBytes\x12X\n\x0ereceived_bytes\x18\x06 \x03(\x0b\x32\x31.pactus.GetNetworkInfoResponse.ReceivedBytesEntryR\rreceivedBytes\x1a<\n\x0eSentBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\x1a@\n\x12ReceivedBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\"\x14\n\x12GetNodeInfoRequest\"^\n\x13GetNodeInfoResponse\x12\x18\n\x07moniker\x18\x01 \x01(\tR\x07moniker\x12\x14\n\x05\x61gent\x18\x02 \x01(\tR\x05\x61gent\x12\x17\n\x07peer_id\x18\x03 \x01(\x0cR\x06peerId\"\xae\x05\n\x08PeerInfo\x12\x16\n\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n\x07moniker\x18\x02 \x01(\tR\x07moniker\x12\x14\n\x05\x61gent\x18\x03 \x01(\tR\x05\x61gent\x12\x17\n\x07peer_id\x18\x04 \x01(\x0cR\x06peerId\x12%\n\x0e\x63onsensus_keys\x18\x05 \x03(\tR\rconsensusKeys\x12\x1a\n\x08services\x18\x06 \x01(\rR\x08services\x12&\n\x0flast_block_hash\x18\x07 \x01(\x0cR\rlastBlockHash\x12\x16\n\x06height\x18\x08 \x01(\rR\x06height\x12+\n\x11received_messages\x18\t \x01(\x05R\x10receivedMessages\x12)\n\x10invalid_messages\x18\n \x01(\x05R\x0finvalidMessages\x12\x1b\n\tlast_sent\x18\x0b \x01(\x03R\x08lastSent\x12#\n\rlast_received\x18\x0c \x01(\x03R\x0clastReceived\x12>\n\nsent_bytes\x18\r \x03(\x0b\x32\x1f.pactus.PeerInfo.SentBytesEntryR\tsentBytes\x12J\n\x0ereceived_bytes\x18\x0e \x03(\x0b\x32#.pactus.PeerInfo.ReceivedBytesEntryR\rreceivedBytes\x12\x18\n\x07\x61\x64\x64ress\x18\x0f \x01(\tR\x07\x61\x64\x64ress\x1a<\n\x0eSentBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\x1a@\n\x12ReceivedBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\x32\xa2\x01\n\x07Network\x12O\n\x0eGetNetworkInfo\x12\x1d.pactus.GetNetworkInfoRequest\x1a\x1e.pactus.GetNetworkInfoResponse\x12\x46\n\x0bGetNodeInfo\x12\x1a.pactus.GetNodeInfoRequest\x1a\x1b.pactus.GetNodeInfoResponseBB\n\x0epactus.networkZ0github.com/pactus-project/pactus/www/grpc/pactusb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rnetwork.proto\x12\x06pactus\"\x17\n\x15GetNetworkInfoRequest\"\x94\x04\n\x16GetNetworkInfoResponse\x12(\n\x10total_sent_bytes\x18\x01 \x01(\x05R\x0etotalSentBytes\x12\x30\n\x14total_received_bytes\x18\x02 \x01(\x05R\x12totalReceivedBytes\x12\x1d\n\nstarted_at\x18\x03 \x01(\x03R\tstartedAt\x12&\n\x05peers\x18\x04 \x03(\x0b\x32\x10.pactus.PeerInfoR\x05peers\x12L\n\nsent_bytes\x18\x05 \x03(\x0b\x32-.pactus.GetNetworkInfoResponse.SentBytesEntryR\tsentBytes\x12X\n\x0ereceived_bytes\x18\x06 \x03(\x0b\x32\x31.pactus.GetNetworkInfoResponse.ReceivedBytesEntryR\rreceivedBytes\x12/\n\x08sessions\x18\x07 \x03(\x0b\x32\x13.pactus.SessionInfoR\x08sessions\x1a<\n\x0eSentBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\x1a@\n\x12ReceivedBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\"\xa0\x02\n\x0bSessionInfo\x12\x1d\n\nsession_id\x18\x01 \x01(\x05R\tsessionId\x12\x17\n\x07peer_id\x18\x02 \x01(\x0cR\x06peerId\x12)\n\x10requested_blocks\x18\x03 \x01(\rR\x0frequestedBlocks\x12\'\n\x0freceived_blocks\x18\x04 \x01(\rR\x0ereceivedBlocks\x12(\n\x10last_activity_at\x18\x05 \x01(\x03R\x0elastActivityAt\x12\x1b\n\topened_at\x18\x06 \x01(\x03R\x08openedAt\x12\x10\n\x03\x61ge\x18\x07 \x01(\x03R\x03\x61ge\x12,\n\x12last_response_code\x18\x08 \x01(\tR\x10lastResponseCode\"\x14\n\x12GetSessionsRequest\"F\n\x13GetSessionsResponse\x12/\n\x08sessions\x18\x01 \x03(\x0b\x32\x13.pactus.SessionInfoR\x08sessions\"\x14\n\x12GetNodeInfoRequest\"\x7f\n\x13GetNodeInfoResponse\x12\x18\n\x07moniker\x18\x01 \x01(\tR\x07moniker\x12\x14\n\x05\x61gent\x18\x02 \x01(\tR\x05\x61gent\x12\x17\n\x07peer_id\x18\x03 \x01(\x0cR\x06peerId\x12\x1f\n\x0bsync_paused\x18\x04 \x01(\x08R\nsyncPaused\"E\n\x0e\x42\x61nPeerRequest\x12\x17\n\x07peer_id\x18\x01 \x01(\x0cR\x06peerId\x12\x1a\n\x08\x64uration\x18\x02 \x01(\x03R\x08\x64uration\"\x11\n\x0f\x42\x61nPeerResponse\"+\n\x10UnbanPeerRequest\x12\x17\n\x07peer_id\x18\x01 \x01(\x0cR\x06peerId\"\x13\n\x11UnbanPeerResponse\"\x12\n\x10PauseSyncRequest\"\x13\n\x11PauseSyncResponse\"\x13\n\x11ResumeSyncRequest\"\x14\n\x12ResumeSyncResponse\"\x1b\n\x19GetRewardAddressesRequest\"U\n\x1aGetRewardAddressesResponse\x12\x37\n\nvalidators\x18\x01 \x03(\x0b\x32\x17.pactus.ValidatorRewardR\nvalidators\"\xc1\x01\n\x0fValidatorReward\x12+\n\x11validator_address\x18\x01 \x01(\tR\x10validatorAddress\x12)\n\x10validator_number\x18\x02 \x01(\x05R\x0fvalidatorNumber\x12)\n\x10reward_addresses\x18\x03 \x03(\tR\x0frewardAddresses\x12+\n\x11rotation_interval\x18\x04 \x01(\rR\x10rotationInterval\"\x85\x06\n\x08PeerInfo\x12\x16\n\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n\x07moniker\x18\x02 \x01(\tR\x07moniker\x12\x14\n\x05\x61gent\x18\x03 \x01(\tR\x05\x61gent\x12\x17\n\x07peer_id\x18\x04 \x01(\x0cR\x06peerId\x12%\n\x0e\x63onsensus_keys\x18\x05 \x03(\tR\rconsensusKeys\x12\x1a\n\x08services\x18\x06 \x01(\rR\x08services\x12&\n\x0flast_block_hash\x18\x07 \x01(\x0cR\rlastBlockHash\x12\x16\n\x06height\x18\x08 \x01(\rR\x06height\x12+\n\x11received_messages\x18\t \x01(\x05R\x10receivedMessages\x12)\n\x10invalid_messages\x18\n \x01(\x05R\x0finvalidMessages\x12\x1b\n\tlast_sent\x18\x0b \x01(\x03R\x08lastSent\x12#\n\rlast_received\x18\x0c \x01(\x03R\x0clastReceived\x12>\n\nsent_bytes\x18\r \x03(\x0b\x32\x1f.pactus.PeerInfo.SentBytesEntryR\tsentBytes\x12J\n\x0ereceived_bytes\x18\x0e \x03(\x0b\x32#.pactus.PeerInfo.ReceivedBytesEntryR\rreceivedBytes\x12\x18\n\x07\x61\x64\x64ress\x18\x0f \x01(\tR\x07\x61\x64\x64ress\x12\x1d\n\nlatency_ms\x18\x10 \x01(\x03R\tlatencyMs\x12\x1c\n\tdirection\x18\x11 \x01(\tR\tdirection\x12\x18\n\x07relayed\x18\x12 \x01(\x08R\x07relayed\x1a<\n\x0eSentBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\x1a@\n\x12ReceivedBytesEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02\x38\x01\x32\xcc\x04\n\x07Network\x12O\n\x0eGetNetworkInfo\x12\x1d.pactus.GetNetworkInfoRequest\x1a\x1e.pactus.GetNetworkInfoResponse\x12\x46\n\x0bGetNodeInfo\x12\x1a.pactus.GetNodeInfoRequest\x1a\x1b.pactus.GetNodeInfoResponse\x12:\n\x07\x42\x61nPeer\x12\x16.pactus.BanPeerRequest\x1a\x17.pactus.BanPeerResponse\x12@\n\tUnbanPeer\x12\x18.pactus.UnbanPeerRequest\x1a\x19.pactus.UnbanPeerResponse\x12[\n\x12GetRewardAddresses\x12!.pactus.GetRewardAddressesRequest\x1a\".pactus.GetRewardAddressesResponse\x12@\n\tPauseSync\x12\x18.pactus.PauseSyncRequest\x1a\x19.pactus.PauseSyncResponse\x12\x43\n\nResumeSync\x12\x19.pactus.ResumeSyncRequest\x1a\x1a.pactus.ResumeSyncResponse\x12\x46\n\x0bGetSessions\x12\x1a.pactus.GetSessionsRequest\x1a\x1b.pactus.GetSessionsResponseBB\n\x0epactus.networkZ0github.com/pactus-project/pactus/www/grpc/pactusb\x06proto3')
 
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, globals())
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'network_pb2', globals())
@@ -32,21 +32,49 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _GETNETWORKINFOREQUEST._serialized_start=25
   _GETNETWORKINFOREQUEST._serialized_end=48
   _GETNETWORKINFORESPONSE._serialized_start=51
-  _GETNETWORKINFORESPONSE._serialized_end=534
-  _GETNETWORKINFORESPONSE_SENTBYTESENTRY._serialized_start=408
-  _GETNETWORKINFORESPONSE_SENTBYTESENTRY._serialized_end=468
-  _GETNETWORKINFORESPONSE_RECEIVEDBYTESENTRY._serialized_start=470
-  _GETNETWORKINFORESPONSE_RECEIVEDBYTESENTRY._serialized_end=534
-  _GETNODEINFOREQUEST._serialized_start=536
-  _GETNODEINFOREQUEST._serialized_end=556
-  _GETNODEINFORESPONSE._serialized_start=558
-  _GETNODEINFORESPONSE._serialized_end=652
-  _PEERINFO._serialized_start=655
-  _PEERINFO._serialized_end=1341
-  _PEERINFO_SENTBYTESENTRY._serialized_start=408
-  _PEERINFO_SENTBYTESENTRY._serialized_end=468
-  _PEERINFO_RECEIVEDBYTESENTRY._serialized_start=470
-  _PEERINFO_RECEIVEDBYTESENTRY._serialized_end=534
-  _NETWORK._serialized_start=1344
-  _NETWORK._serialized_end=1506
+  _GETNETWORKINFORESPONSE._serialized_end=583
+  _GETNETWORKINFORESPONSE_SENTBYTESENTRY._serialized_start=457
+  _GETNETWORKINFORESPONSE_SENTBYTESENTRY._serialized_end=517
+  _GETNETWORKINFORESPONSE_RECEIVEDBYTESENTRY._serialized_start=519
+  _GETNETWORKINFORESPONSE_RECEIVEDBYTESENTRY._serialized_end=583
+  _SESSIONINFO._serialized_start=586
+  _SESSIONINFO._serialized_end=874
+  _GETSESSIONSREQUEST._serialized_start=876
+  _GETSESSIONSREQUEST._serialized_end=896
+  _GETSESSIONSRESPONSE._serialized_start=898
+  _GETSESSIONSRESPONSE._serialized_end=968
+  _GETNODEINFOREQUEST._serialized_start=970
+  _GETNODEINFOREQUEST._serialized_end=990
+  _GETNODEINFORESPONSE._serialized_start=992
+  _GETNODEINFORESPONSE._serialized_end=1119
+  _BANPEERREQUEST._serialized_start=1121
+  _BANPEERREQUEST._serialized_end=1190
+  _BANPEERRESPONSE._serialized_start=1192
+  _BANPEERRESPONSE._serialized_end=1209
+  _UNBANPEERREQUEST._serialized_start=1211
+  _UNBANPEERREQUEST._serialized_end=1254
+  _UNBANPEERRESPONSE._serialized_start=1256
+  _UNBANPEERRESPONSE._serialized_end=1275
+  _PAUSESYNCREQUEST._serialized_start=1277
+  _PAUSESYNCREQUEST._serialized_end=1295
+  _PAUSESYNCRESPONSE._serialized_start=1297
+  _PAUSESYNCRESPONSE._serialized_end=1316
+  _RESUMESYNCREQUEST._serialized_start=1318
+  _RESUMESYNCREQUEST._serialized_end=1337
+  _RESUMESYNCRESPONSE._serialized_start=1339
+  _RESUMESYNCRESPONSE._serialized_end=1359
+  _GETREWARDADDRESSESREQUEST._serialized_start=1361
+  _GETREWARDADDRESSESREQUEST._serialized_end=1388
+  _GETREWARDADDRESSESRESPONSE._serialized_start=1390
+  _GETREWARDADDRESSESRESPONSE._serialized_end=1475
+  _VALIDATORREWARD._serialized_start=1478
+  _VALIDATORREWARD._serialized_end=1671
+  _PEERINFO._serialized_start=1674
+  _PEERINFO._serialized_end=2447
+  _PEERINFO_SENTBYTESENTRY._serialized_start=457
+  _PEERINFO_SENTBYTESENTRY._serialized_end=517
+  _PEERINFO_RECEIVEDBYTESENTRY._serialized_start=519
+  _PEERINFO_RECEIVEDBYTESENTRY._serialized_end=583
+  _NETWORK._serialized_start=2450
+  _NETWORK._serialized_end=3038
 # @@protoc_insertion_point(module_scope)
@@ -24,6 +24,36 @@ class NetworkStub(object):
                 request_serializer=network__pb2.GetNodeInfoRequest.SerializeToString,
                 response_deserializer=network__pb2.GetNodeInfoResponse.FromString,
                 )
+        self.BanPeer = channel.unary_unary(
+                '/pactus.Network/BanPeer',
+                request_serializer=network__pb2.BanPeerRequest.SerializeToString,
+                response_deserializer=network__pb2.BanPeerResponse.FromString,
+                )
+        self.UnbanPeer = channel.unary_unary(
+                '/pactus.Network/UnbanPeer',
+                request_serializer=network__pb2.UnbanPeerRequest.SerializeToString,
+                response_deserializer=network__pb2.UnbanPeerResponse.FromString,
+                )
+        self.GetRewardAddresses = channel.unary_unary(
+                '/pactus.Network/GetRewardAddresses',
+                request_serializer=network__pb2.GetRewardAddressesRequest.SerializeToString,
+                response_deserializer=network__pb2.GetRewardAddressesResponse.FromString,
+                )
+        self.PauseSync = channel.unary_unary(
+                '/pactus.Network/PauseSync',
+                request_serializer=network__pb2.PauseSyncRequest.SerializeToString,
+                response_deserializer=network__pb2.PauseSyncResponse.FromString,
+                )
+        self.ResumeSync = channel.unary_unary(
+                '/pactus.Network/ResumeSync',
+                request_serializer=network__pb2.ResumeSyncRequest.SerializeToString,
+                response_deserializer=network__pb2.ResumeSyncResponse.FromString,
+                )
+        self.GetSessions = channel.unary_unary(
+                '/pactus.Network/GetSessions',
+                request_serializer=network__pb2.GetSessionsRequest.SerializeToString,
+                response_deserializer=network__pb2.GetSessionsResponse.FromString,
+                )
 
 
 class NetworkServicer(object):
@@ -41,6 +71,42 @@ class NetworkServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def BanPeer(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UnbanPeer(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetRewardAddresses(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PauseSync(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ResumeSync(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSessions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_NetworkServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -54,6 +120,36 @@ def add_NetworkServicer_to_server(servicer, server):
                     request_deserializer=network__pb2.GetNodeInfoRequest.FromString,
                     response_serializer=network__pb2.GetNodeInfoResponse.SerializeToString,
             ),
+            'BanPeer': grpc.unary_unary_rpc_method_handler(
+                    servicer.BanPeer,
+                    request_deserializer=network__pb2.BanPeerRequest.FromString,
+                    response_serializer=network__pb2.BanPeerResponse.SerializeToString,
+            ),
+            'UnbanPeer': grpc.unary_unary_rpc_method_handler(
+                    servicer.UnbanPeer,
+                    request_deserializer=network__pb2.UnbanPeerRequest.FromString,
+                    response_serializer=network__pb2.UnbanPeerResponse.SerializeToString,
+            ),
+            'GetRewardAddresses': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetRewardAddresses,
+                    request_deserializer=network__pb2.GetRewardAddressesRequest.FromString,
+                    response_serializer=network__pb2.GetRewardAddressesResponse.SerializeToString,
+            ),
+            'PauseSync': grpc.unary_unary_rpc_method_handler(
+                    servicer.PauseSync,
+                    request_deserializer=network__pb2.PauseSyncRequest.FromString,
+                    response_serializer=network__pb2.PauseSyncResponse.SerializeToString,
+            ),
+            'ResumeSync': grpc.unary_unary_rpc_method_handler(
+                    servicer.ResumeSync,
+                    request_deserializer=network__pb2.ResumeSyncRequest.FromString,
+                    response_serializer=network__pb2.ResumeSyncResponse.SerializeToString,
+            ),
+            'GetSessions': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSessions,
+                    request_deserializer=network__pb2.GetSessionsRequest.FromString,
+                    response_serializer=network__pb2.GetSessionsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'pactus.Network', rpc_method_handlers)
@@ -97,3 +193,105 @@ class Network(object):
             network__pb2.GetNodeInfoResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def BanPeer(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Network/BanPeer',
+            network__pb2.BanPeerRequest.SerializeToString,
+            network__pb2.BanPeerResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UnbanPeer(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Network/UnbanPeer',
+            network__pb2.UnbanPeerRequest.SerializeToString,
+            network__pb2.UnbanPeerResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetRewardAddresses(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Network/GetRewardAddresses',
+            network__pb2.GetRewardAddressesRequest.SerializeToString,
+            network__pb2.GetRewardAddressesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PauseSync(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Network/PauseSync',
+            network__pb2.PauseSyncRequest.SerializeToString,
+            network__pb2.PauseSyncResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ResumeSync(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Network/ResumeSync',
+            network__pb2.ResumeSyncRequest.SerializeToString,
+            network__pb2.ResumeSyncResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetSessions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Network/GetSessions',
+            network__pb2.GetSessionsRequest.SerializeToString,
+            network__pb2.GetSessionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -19,16 +19,36 @@ class TransactionStub(object):
                 request_serializer=transaction__pb2.GetTransactionRequest.SerializeToString,
                 response_deserializer=transaction__pb2.GetTransactionResponse.FromString,
                 )
+        self.GetTransactionInclusion = channel.unary_unary(
+                '/pactus.Transaction/GetTransactionInclusion',
+                request_serializer=transaction__pb2.GetTransactionInclusionRequest.SerializeToString,
+                response_deserializer=transaction__pb2.GetTransactionInclusionResponse.FromString,
+                )
+        self.GetTransactionProof = channel.unary_unary(
+                '/pactus.Transaction/GetTransactionProof',
+                request_serializer=transaction__pb2.GetTransactionProofRequest.SerializeToString,
+                response_deserializer=transaction__pb2.GetTransactionProofResponse.FromString,
+                )
         self.CalculateFee = channel.unary_unary(
                 '/pactus.Transaction/CalculateFee',
                 request_serializer=transaction__pb2.CalculateFeeRequest.SerializeToString,
                 response_deserializer=transaction__pb2.CalculateFeeResponse.FromString,
                 )
+        self.EstimateFee = channel.unary_unary(
+                '/pactus.Transaction/EstimateFee',
+                request_serializer=transaction__pb2.EstimateFeeRequest.SerializeToString,
+                response_deserializer=transaction__pb2.EstimateFeeResponse.FromString,
+                )
         self.SendRawTransaction = channel.unary_unary(
                 '/pactus.Transaction/SendRawTransaction',
                 request_serializer=transaction__pb2.SendRawTransactionRequest.SerializeToString,
                 response_deserializer=transaction__pb2.SendRawTransactionResponse.FromString,
                 )
+        self.GetTxPoolStats = channel.unary_unary(
+                '/pactus.Transaction/GetTxPoolStats',
+                request_serializer=transaction__pb2.GetTxPoolStatsRequest.SerializeToString,
+                response_deserializer=transaction__pb2.GetTxPoolStatsResponse.FromString,
+                )
 
 
 class TransactionServicer(object):
@@ -40,18 +60,42 @@ class TransactionServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetTransactionInclusion(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetTransactionProof(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CalculateFee(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def EstimateFee(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def SendRawTransaction(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetTxPoolStats(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_TransactionServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -60,16 +104,36 @@ def add_TransactionServicer_to_server(servicer, server):
                     request_deserializer=transaction__pb2.GetTransactionRequest.FromString,
                     response_serializer=transaction__pb2.GetTransactionResponse.SerializeToString,
             ),
+            'GetTransactionInclusion': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTransactionInclusion,
+                    request_deserializer=transaction__pb2.GetTransactionInclusionRequest.FromString,
+                    response_serializer=transaction__pb2.GetTransactionInclusionResponse.SerializeToString,
+            ),
+            'GetTransactionProof': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTransactionProof,
+                    request_deserializer=transaction__pb2.GetTransactionProofRequest.FromString,
+                    response_serializer=transaction__pb2.GetTransactionProofResponse.SerializeToString,
+            ),
             'CalculateFee': grpc.unary_unary_rpc_method_handler(
                     servicer.CalculateFee,
                     request_deserializer=transaction__pb2.CalculateFeeRequest.FromString,
                     response_serializer=transaction__pb2.CalculateFeeResponse.SerializeToString,
             ),
+            'EstimateFee': grpc.unary_unary_rpc_method_handler(
+                    servicer.EstimateFee,
+                    request_deserializer=transaction__pb2.EstimateFeeRequest.FromString,
+                    response_serializer=transaction__pb2.EstimateFeeResponse.SerializeToString,
+            ),
             'SendRawTransaction': grpc.unary_unary_rpc_method_handler(
                     servicer.SendRawTransaction,
                     request_deserializer=transaction__pb2.SendRawTransactionRequest.FromString,
                     response_serializer=transaction__pb2.SendRawTransactionResponse.SerializeToString,
             ),
+            'GetTxPoolStats': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetTxPoolStats,
+                    request_deserializer=transaction__pb2.GetTxPoolStatsRequest.FromString,
+                    response_serializer=transaction__pb2.GetTxPoolStatsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'pactus.Transaction', rpc_method_handlers)
@@ -97,6 +161,40 @@ class Transaction(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetTransactionInclusion(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Transaction/GetTransactionInclusion',
+            transaction__pb2.GetTransactionInclusionRequest.SerializeToString,
+            transaction__pb2.GetTransactionInclusionResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetTransactionProof(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Transaction/GetTransactionProof',
+            transaction__pb2.GetTransactionProofRequest.SerializeToString,
+            transaction__pb2.GetTransactionProofResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def CalculateFee(request,
             target,
@@ -114,6 +212,23 @@ class Transaction(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def EstimateFee(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Transaction/EstimateFee',
+            transaction__pb2.EstimateFeeRequest.SerializeToString,
+            transaction__pb2.EstimateFeeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def SendRawTransaction(request,
             target,
@@ -130,3 +245,20 @@ class Transaction(object):
             transaction__pb2.SendRawTransactionResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetTxPoolStats(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pactus.Transaction/GetTxPoolStats',
+            transaction__pb2.GetTxPoolStatsRequest.SerializeToString,
+            transaction__pb2.GetTxPoolStatsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pactus-project/pactus/sync"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthCheckInterval is the interval for updating the serving status.
+var healthCheckInterval = time.Second
+
+// healthChecker reports the serving status through the standard gRPC health protocol.
+// The node is serving while the synchronizer reports it healthy,
+// meaning the node is synced and connected to enough peers, see `IsHealthy`.
+type healthChecker struct {
+	server *health.Server
+	sync   sync.Synchronizer
+}
+
+func newHealthChecker(syn sync.Synchronizer) *healthChecker {
+	server := health.NewServer()
+	// The node is not serving until the first check.
+	server.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return &healthChecker{
+		server: server,
+		sync:   syn,
+	}
+}
+
+// Start updates the serving status periodically until the context is canceled.
+func (h *healthChecker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(healthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			h.check()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Shutdown sets the serving status to NOT_SERVING permanently.
+func (h *healthChecker) Shutdown() {
+	h.server.Shutdown()
+}
+
+func (h *healthChecker) check() {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if h.sync.IsHealthy() {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	h.server.SetServingStatus("", status)
+}
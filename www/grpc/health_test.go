@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	mockSync := sync.MockingSync(ts)
+	checker := newHealthChecker(mockSync)
+
+	servingStatus := func() grpc_health_v1.HealthCheckResponse_ServingStatus {
+		res, err := checker.server.Check(tCtx, &grpc_health_v1.HealthCheckRequest{})
+		require.NoError(t, err)
+
+		return res.Status
+	}
+
+	t.Run("Not serving while initializing", func(t *testing.T) {
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus())
+
+		checker.check()
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus())
+	})
+
+	t.Run("Not serving when the sync is ready but not healthy", func(t *testing.T) {
+		mockSync.TestReady = true
+		checker.check()
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus())
+	})
+
+	t.Run("Serving when the sync is healthy", func(t *testing.T) {
+		mockSync.TestHealthy.Store(true)
+		checker.check()
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus())
+	})
+
+	t.Run("Not serving during shutdown", func(t *testing.T) {
+		checker.Shutdown()
+		checker.check()
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus())
+	})
+}
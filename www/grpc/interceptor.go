@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// slowCallInterceptor returns a unary interceptor that measures the duration of the handlers
+// and logs the calls that take longer than the threshold.
+func slowCallInterceptor(threshold time.Duration, log *logger.SubLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		duration := time.Since(start)
+
+		if duration > threshold {
+			log.Warn("slow gRPC call", "method", info.FullMethod, "duration", duration)
+		}
+
+		return res, err
+	}
+}
+
+// callLimiter limits the number of the calls that are handled at the same time,
+// across all the connections. The calls beyond the limit are rejected
+// with `ResourceExhausted`, so the clients can retry them later.
+type callLimiter struct {
+	slots chan struct{}
+	log   *logger.SubLogger
+}
+
+func newCallLimiter(maxCalls uint32, log *logger.SubLogger) *callLimiter {
+	return &callLimiter{
+		slots: make(chan struct{}, maxCalls),
+		log:   log,
+	}
+}
+
+func (l *callLimiter) acquire(method string) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+		l.log.Debug("too many concurrent gRPC calls", "method", method, "limit", cap(l.slots))
+
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent calls, try again later")
+	}
+}
+
+func (l *callLimiter) release() {
+	<-l.slots
+}
+
+func (l *callLimiter) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := l.acquire(info.FullMethod); err != nil {
+			return nil, err
+		}
+		defer l.release()
+
+		return handler(ctx, req)
+	}
+}
+
+// streamInterceptor limits the streaming calls.
+// A streaming call occupies its slot until the stream is closed.
+func (l *callLimiter) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := l.acquire(info.FullMethod); err != nil {
+			return err
+		}
+		defer l.release()
+
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSlowCallInterceptor(t *testing.T) {
+	interceptor := slowCallInterceptor(10*time.Millisecond, logger.NewSubLogger("_grpc", nil))
+	info := &grpc.UnaryServerInfo{FullMethod: "/pactus.Blockchain/GetBlock"}
+
+	t.Run("Fast call", func(t *testing.T) {
+		res, err := interceptor(context.Background(), "req", info,
+			func(_ context.Context, req interface{}) (interface{}, error) {
+				return req, nil
+			})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "req", res)
+	})
+
+	t.Run("Slow call, should return the handler's result", func(t *testing.T) {
+		handlerErr := errors.New("handler error")
+		res, err := interceptor(context.Background(), "req", info,
+			func(_ context.Context, _ interface{}) (interface{}, error) {
+				time.Sleep(20 * time.Millisecond)
+
+				return nil, handlerErr
+			})
+
+		assert.ErrorIs(t, err, handlerErr)
+		assert.Nil(t, res)
+	})
+}
+
+func TestCallLimiter(t *testing.T) {
+	limiter := newCallLimiter(1, logger.NewSubLogger("_grpc", nil))
+	interceptor := limiter.unaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/pactus.Blockchain/GetBlock"}
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		_, _ = interceptor(context.Background(), "req", info,
+			func(_ context.Context, req interface{}) (interface{}, error) {
+				close(started)
+				<-finish
+
+				return req, nil
+			})
+		close(done)
+	}()
+	<-started
+
+	t.Run("Should reject the calls beyond the limit", func(t *testing.T) {
+		res, err := interceptor(context.Background(), "req", info,
+			func(_ context.Context, req interface{}) (interface{}, error) {
+				return req, nil
+			})
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Nil(t, res)
+
+		streamInterceptor := limiter.streamInterceptor()
+		err = streamInterceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/pactus.Blockchain/SubscribeBlockHeight"},
+			func(_ interface{}, _ grpc.ServerStream) error {
+				return nil
+			})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	close(finish)
+	<-done
+
+	t.Run("Should accept the calls once a slot is released", func(t *testing.T) {
+		res, err := interceptor(context.Background(), "req", info,
+			func(_ context.Context, req interface{}) (interface{}, error) {
+				return req, nil
+			})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "req", res)
+	})
+}
@@ -2,18 +2,28 @@ package grpc
 
 import (
 	"context"
+	"time"
 	"unsafe"
 
 	"github.com/fxamacker/cbor/v2"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/version"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type networkServer struct {
-	sync   sync.Synchronizer
-	logger *logger.SubLogger
+	sync        sync.Synchronizer
+	state       state.Facade
+	consMgr     consensus.ManagerReader
+	enableAdmin bool
+	logger      *logger.SubLogger
 }
 
 func (s *networkServer) GetNetworkInfo(_ context.Context,
@@ -47,6 +57,9 @@ func (s *networkServer) GetNetworkInfo(_ context.Context,
 		p.Status = int32(peer.Status)
 		p.LastReceived = peer.LastReceived.Unix()
 		p.LastBlockHash = peer.LastBlockHash.Bytes()
+		p.LatencyMs = peer.Latency.Milliseconds()
+		p.Direction = peer.Direction.String()
+		p.Relayed = peer.Relayed
 
 		for _, key := range peer.ConsensusKeys {
 			p.ConsensusKeys = append(p.ConsensusKeys, key.String())
@@ -63,6 +76,7 @@ func (s *networkServer) GetNetworkInfo(_ context.Context,
 		ReceivedBytes:      *(*map[int32]int64)(unsafe.Pointer(&receivedBytes)),
 		StartedAt:          peerset.StartedAt().Unix(),
 		Peers:              peerInfos,
+		Sessions:           sessionsToProto(peerset.Sessions()),
 	}, nil
 }
 
@@ -70,8 +84,132 @@ func (s *networkServer) GetNodeInfo(_ context.Context,
 	_ *pactus.GetNodeInfoRequest,
 ) (*pactus.GetNodeInfoResponse, error) {
 	return &pactus.GetNodeInfoResponse{
-		Moniker: s.sync.Moniker(),
-		Agent:   version.Agent(),
-		PeerId:  []byte(s.sync.SelfID()),
+		Moniker:    s.sync.Moniker(),
+		Agent:      version.Agent(),
+		PeerId:     []byte(s.sync.SelfID()),
+		SyncPaused: s.sync.IsPaused(),
 	}, nil
 }
+
+func (s *networkServer) BanPeer(_ context.Context,
+	req *pactus.BanPeerRequest,
+) (*pactus.BanPeerResponse, error) {
+	if !s.enableAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "admin methods are disabled")
+	}
+	pid, err := lp2ppeer.IDFromBytes(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid peer id: %v", err)
+	}
+	if req.Duration < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "duration can't be negative")
+	}
+
+	s.sync.BanPeer(pid, time.Duration(req.Duration)*time.Second)
+
+	return &pactus.BanPeerResponse{}, nil
+}
+
+func (s *networkServer) UnbanPeer(_ context.Context,
+	req *pactus.UnbanPeerRequest,
+) (*pactus.UnbanPeerResponse, error) {
+	if !s.enableAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "admin methods are disabled")
+	}
+	pid, err := lp2ppeer.IDFromBytes(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid peer id: %v", err)
+	}
+
+	s.sync.UnbanPeer(pid)
+
+	return &pactus.UnbanPeerResponse{}, nil
+}
+
+// PauseSync pauses downloading and processing the blocks, for maintenance like taking a snapshot.
+func (s *networkServer) PauseSync(_ context.Context,
+	_ *pactus.PauseSyncRequest,
+) (*pactus.PauseSyncResponse, error) {
+	if !s.enableAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "admin methods are disabled")
+	}
+
+	s.sync.Pause()
+
+	return &pactus.PauseSyncResponse{}, nil
+}
+
+// ResumeSync resumes the sync, after it has been paused.
+func (s *networkServer) ResumeSync(_ context.Context,
+	_ *pactus.ResumeSyncRequest,
+) (*pactus.ResumeSyncResponse, error) {
+	if !s.enableAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "admin methods are disabled")
+	}
+
+	s.sync.Resume()
+
+	return &pactus.ResumeSyncResponse{}, nil
+}
+
+// GetSessions returns the open download sessions.
+func (s *networkServer) GetSessions(_ context.Context,
+	_ *pactus.GetSessionsRequest,
+) (*pactus.GetSessionsResponse, error) {
+	return &pactus.GetSessionsResponse{
+		Sessions: sessionsToProto(s.sync.PeerSet().Sessions()),
+	}, nil
+}
+
+// GetRewardAddresses returns the reward addresses of the validators running on this node.
+func (s *networkServer) GetRewardAddresses(_ context.Context,
+	_ *pactus.GetRewardAddressesRequest,
+) (*pactus.GetRewardAddressesResponse, error) {
+	if !s.enableAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "admin methods are disabled")
+	}
+
+	instances := s.consMgr.Instances()
+	validators := make([]*pactus.ValidatorReward, 0, len(instances))
+	for _, cons := range instances {
+		valAddr := cons.ConsensusKey().ValidatorAddress()
+		policy := cons.RewardPolicy()
+
+		number := int32(-1)
+		if val := s.state.ValidatorByAddress(valAddr); val != nil {
+			number = val.Number()
+		}
+
+		rewardAddrs := make([]string, len(policy.Addresses))
+		for i, addr := range policy.Addresses {
+			rewardAddrs[i] = addr.String()
+		}
+
+		validators = append(validators, &pactus.ValidatorReward{
+			ValidatorAddress: valAddr.String(),
+			ValidatorNumber:  number,
+			RewardAddresses:  rewardAddrs,
+			RotationInterval: policy.RotationInterval,
+		})
+	}
+
+	return &pactus.GetRewardAddressesResponse{Validators: validators}, nil
+}
+
+func sessionsToProto(sessions []peerset.SessionInfo) []*pactus.SessionInfo {
+	sessionInfos := make([]*pactus.SessionInfo, len(sessions))
+	for i, session := range sessions {
+		sessionInfos[i] = &pactus.SessionInfo{
+			SessionId:        int32(session.SessionID),
+			PeerId:           []byte(session.PeerID),
+			RequestedBlocks:  session.RequestedBlocks,
+			ReceivedBlocks:   session.ReceivedBlocks,
+			LastActivityAt:   session.LastActivityAt.Unix(),
+			OpenedAt:         session.OpenedAt.Unix(),
+			Age:              int64(session.Age().Seconds()),
+			LastResponseCode: session.LastResponseCode.String(),
+		}
+	}
+
+	return sessionInfos
+}
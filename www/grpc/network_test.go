@@ -4,9 +4,21 @@ import (
 	"testing"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/pactus-project/pactus/version"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetNetworkInfo(t *testing.T) {
@@ -38,6 +50,26 @@ func TestGetNetworkInfo(t *testing.T) {
 		}
 	})
 
+	t.Run("Should return open sessions", func(t *testing.T) {
+		ps := tMockSync.PeerSet()
+		pid := ps.GetPeerList()[0].PeerID
+		ps.UpdateStatus(pid, peerset.StatusCodeKnown)
+		session, err := ps.OpenSession(pid)
+		assert.NoError(t, err)
+		session.SetRequestedBlocks(10)
+		session.AddReceivedBlocks(4)
+
+		res, err := client.GetNetworkInfo(tCtx, &pactus.GetNetworkInfoRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, res.Sessions, 1)
+		assert.Equal(t, int32(session.SessionID()), res.Sessions[0].SessionId)
+		assert.Equal(t, []byte(pid), res.Sessions[0].PeerId)
+		assert.Equal(t, uint32(10), res.Sessions[0].RequestedBlocks)
+		assert.Equal(t, uint32(4), res.Sessions[0].ReceivedBlocks)
+
+		ps.CloseSession(session.SessionID())
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
@@ -53,3 +85,152 @@ func TestGetNodeInfo(t *testing.T) {
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
+
+func TestBanPeer(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockSync := sync.MockingSync(ts)
+	pid := ts.RandPeerID()
+
+	t.Run("Should return error if admin methods are disabled", func(t *testing.T) {
+		s := &networkServer{sync: mockSync}
+
+		_, err := s.BanPeer(tCtx, &pactus.BanPeerRequest{PeerId: []byte(pid)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = s.UnbanPeer(tCtx, &pactus.UnbanPeerRequest{PeerId: []byte(pid)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	s := &networkServer{sync: mockSync, enableAdmin: true}
+
+	t.Run("Should return error for invalid peer id", func(t *testing.T) {
+		_, err := s.BanPeer(tCtx, &pactus.BanPeerRequest{PeerId: []byte("invalid")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.UnbanPeer(tCtx, &pactus.UnbanPeerRequest{PeerId: []byte("invalid")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should return error for negative duration", func(t *testing.T) {
+		_, err := s.BanPeer(tCtx, &pactus.BanPeerRequest{PeerId: []byte(pid), Duration: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Should ban and unban the peer", func(t *testing.T) {
+		_, err := s.BanPeer(tCtx, &pactus.BanPeerRequest{PeerId: []byte(pid), Duration: 60})
+		assert.NoError(t, err)
+
+		p := mockSync.PeerSet().GetPeer(pid)
+		assert.True(t, p.IsBanned())
+
+		_, err = s.UnbanPeer(tCtx, &pactus.UnbanPeerRequest{PeerId: []byte(pid)})
+		assert.NoError(t, err)
+
+		p = mockSync.PeerSet().GetPeer(pid)
+		assert.False(t, p.IsBanned())
+	})
+}
+
+func TestPauseSync(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockSync := sync.MockingSync(ts)
+
+	t.Run("Should return error if admin methods are disabled", func(t *testing.T) {
+		s := &networkServer{sync: mockSync}
+
+		_, err := s.PauseSync(tCtx, &pactus.PauseSyncRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = s.ResumeSync(tCtx, &pactus.ResumeSyncRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	s := &networkServer{sync: mockSync, enableAdmin: true}
+
+	t.Run("Should pause and resume the sync", func(t *testing.T) {
+		_, err := s.PauseSync(tCtx, &pactus.PauseSyncRequest{})
+		assert.NoError(t, err)
+
+		info, _ := s.GetNodeInfo(tCtx, &pactus.GetNodeInfoRequest{})
+		assert.True(t, info.SyncPaused)
+
+		_, err = s.ResumeSync(tCtx, &pactus.ResumeSyncRequest{})
+		assert.NoError(t, err)
+
+		info, _ = s.GetNodeInfo(tCtx, &pactus.GetNodeInfoRequest{})
+		assert.False(t, info.SyncPaused)
+	})
+}
+
+func TestGetSessions(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockSync := sync.MockingSync(ts)
+	s := &networkServer{sync: mockSync}
+
+	t.Run("No open session", func(t *testing.T) {
+		res, err := s.GetSessions(tCtx, &pactus.GetSessionsRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Sessions)
+	})
+
+	t.Run("Should return the open sessions", func(t *testing.T) {
+		ps := mockSync.PeerSet()
+		pid := ps.GetPeerList()[0].PeerID
+		ps.UpdateStatus(pid, peerset.StatusCodeKnown)
+		session, err := ps.OpenSession(pid)
+		require.NoError(t, err)
+		session.SetRequestedBlocks(10)
+		session.AddReceivedBlocks(4)
+		session.SetLastResponseCode(message.ResponseCodeMoreBlocks)
+
+		res, err := s.GetSessions(tCtx, &pactus.GetSessionsRequest{})
+		assert.NoError(t, err)
+		require.Len(t, res.Sessions, 1)
+		assert.Equal(t, int32(session.SessionID()), res.Sessions[0].SessionId)
+		assert.Equal(t, []byte(pid), res.Sessions[0].PeerId)
+		assert.Equal(t, uint32(10), res.Sessions[0].RequestedBlocks)
+		assert.Equal(t, uint32(4), res.Sessions[0].ReceivedBlocks)
+		assert.Equal(t, session.OpenedAt().Unix(), res.Sessions[0].OpenedAt)
+		assert.GreaterOrEqual(t, res.Sessions[0].Age, int64(0))
+		assert.Equal(t, "more-blocks", res.Sessions[0].LastResponseCode)
+	})
+}
+
+func TestGetRewardAddresses(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	mockState := state.MockingState(ts)
+	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
+	consMgr, consMocks := consensus.MockingManager(ts, valKeys)
+
+	rewardAddrs := []crypto.Address{ts.RandAccAddress(), ts.RandAccAddress()}
+	consMocks[1].Reward = consensus.NewRotatingRewardPolicy(rewardAddrs, 100)
+
+	// Only the first validator is registered.
+	val := validator.NewValidator(valKeys[0].PublicKey(), 5)
+	mockState.TestStore.UpdateValidator(val)
+
+	t.Run("Should return error if admin methods are disabled", func(t *testing.T) {
+		s := &networkServer{state: mockState, consMgr: consMgr}
+
+		_, err := s.GetRewardAddresses(tCtx, &pactus.GetRewardAddressesRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Should return the reward addresses", func(t *testing.T) {
+		s := &networkServer{state: mockState, consMgr: consMgr, enableAdmin: true}
+
+		res, err := s.GetRewardAddresses(tCtx, &pactus.GetRewardAddressesRequest{})
+		assert.NoError(t, err)
+		require.Len(t, res.Validators, 2)
+
+		assert.Equal(t, valKeys[0].Address().String(), res.Validators[0].ValidatorAddress)
+		assert.Equal(t, int32(5), res.Validators[0].ValidatorNumber)
+		assert.Equal(t, []string{consMocks[0].Reward.Addresses[0].String()}, res.Validators[0].RewardAddresses)
+		assert.Zero(t, res.Validators[0].RotationInterval)
+
+		assert.Equal(t, valKeys[1].Address().String(), res.Validators[1].ValidatorAddress)
+		assert.Equal(t, int32(-1), res.Validators[1].ValidatorNumber)
+		assert.Equal(t, []string{rewardAddrs[0].String(), rewardAddrs[1].String()}, res.Validators[1].RewardAddresses)
+		assert.Equal(t, uint32(100), res.Validators[1].RotationInterval)
+	})
+}
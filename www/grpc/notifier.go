@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// blockNotifier receives the height of the committed blocks from the state and
+// fans it out to all the streaming subscribers.
+// It is shared between the subscriptions, so the state has only one subscriber,
+// regardless of the number of the streams.
+type blockNotifier struct {
+	lk sync.RWMutex
+
+	state       state.Facade
+	subscribers map[chan uint32]struct{}
+	logger      *logger.SubLogger
+}
+
+func newBlockNotifier(st state.Facade, log *logger.SubLogger) *blockNotifier {
+	return &blockNotifier{
+		state:       st,
+		subscribers: make(map[chan uint32]struct{}),
+		logger:      log,
+	}
+}
+
+// Start subscribes to the committed blocks until the context is canceled.
+func (n *blockNotifier) Start(ctx context.Context) {
+	ch, unsubscribe := n.state.SubscribeCommittedBlocks()
+	go func() {
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case height := <-ch:
+				n.notify(height)
+			}
+		}
+	}()
+}
+
+// subscribe registers a new subscriber. The returned function should be called
+// to unsubscribe, once the subscriber is no longer interested in the notifications.
+func (n *blockNotifier) subscribe() (<-chan uint32, func()) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	// The channel keeps only the last notification.
+	// A slow subscriber skips the heights it couldn't consume in time.
+	ch := make(chan uint32, 1)
+	n.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		n.lk.Lock()
+		defer n.lk.Unlock()
+
+		delete(n.subscribers, ch)
+	}
+}
+
+func (n *blockNotifier) notify(height uint32) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	n.logger.Trace("notifying subscribers", "height", height, "subscribers", len(n.subscribers))
+	for ch := range n.subscribers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- height
+	}
+}
@@ -2,7 +2,13 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
 	"net"
+	"net/http"
+	"os"
+	"strings"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/state"
@@ -10,25 +16,36 @@ import (
 	"github.com/pactus-project/pactus/util/logger"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
+// unixSocketPrefix is the prefix of the listen addresses for Unix domain sockets.
+const unixSocketPrefix = "unix://"
+
 type Server struct {
-	ctx      context.Context
-	config   *Config
-	listener net.Listener
-	address  string
-	grpc     *grpc.Server
-	state    state.Facade
-	sync     sync.Synchronizer
-	consMgr  consensus.ManagerReader
-	logger   *logger.SubLogger
+	ctx        context.Context
+	cancel     context.CancelFunc
+	config     *Config
+	listener   net.Listener
+	address    string
+	grpc       *grpc.Server
+	gwListener net.Listener
+	gwServer   *http.Server
+	health     *healthChecker
+	state      state.Facade
+	sync       sync.Synchronizer
+	consMgr    consensus.ManagerReader
+	logger     *logger.SubLogger
 }
 
 func NewServer(conf *Config, st state.Facade, syn sync.Synchronizer,
 	consMgr consensus.ManagerReader,
 ) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+
 	return &Server{
-		ctx:     context.Background(),
+		ctx:     ctx,
+		cancel:  cancel,
 		config:  conf,
 		state:   st,
 		sync:    syn,
@@ -37,6 +54,8 @@ func NewServer(conf *Config, st state.Facade, syn sync.Synchronizer,
 	}
 }
 
+// Address returns the address of the gRPC server.
+// For Unix domain sockets, it has the form "unix:<path>" that gRPC clients can dial.
 func (s *Server) Address() string {
 	return s.address
 }
@@ -46,19 +65,48 @@ func (s *Server) StartServer() error {
 		return nil
 	}
 
-	grpcServer := grpc.NewServer()
+	opts := []grpc.ServerOption{}
+	if s.config.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(s.config.MaxConcurrentStreams))
+	}
+	if s.config.MaxConcurrentCalls > 0 {
+		limiter := newCallLimiter(s.config.MaxConcurrentCalls, s.logger)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(limiter.unaryInterceptor()),
+			grpc.ChainStreamInterceptor(limiter.streamInterceptor()))
+	}
+	if s.config.SlowCallThreshold > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(
+			slowCallInterceptor(s.config.SlowCallThreshold, s.logger)))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	notifier := newBlockNotifier(s.state, s.logger)
+	notifier.Start(s.ctx)
 	blockchainServer := &blockchainServer{
-		state:   s.state,
-		consMgr: s.consMgr,
-		logger:  s.logger,
+		state:            s.state,
+		sync:             s.sync,
+		consMgr:          s.consMgr,
+		notifier:         notifier,
+		maxStreamBacklog: s.config.MaxStreamBacklog,
+		txStats:          newTxStatsCache(s.state),
+		logger:           s.logger,
+	}
+	if s.config.EnableValidatorHistory {
+		// Indexing the validator history scans the whole blockchain on start.
+		blockchainServer.validatorHistory = newValidatorHistoryIndex(s.state, s.logger)
+		blockchainServer.validatorHistory.Start(s.ctx, notifier)
 	}
 	transactionServer := &transactionServer{
 		state:  s.state,
 		logger: s.logger,
 	}
 	networkServer := &networkServer{
-		sync:   s.sync,
-		logger: s.logger,
+		sync:        s.sync,
+		state:       s.state,
+		consMgr:     s.consMgr,
+		enableAdmin: s.config.EnableAdmin,
+		logger:      s.logger,
 	}
 	network := s.state.Genesis().ChainType()
 	walletServer := &walletServer{
@@ -71,37 +119,118 @@ func (s *Server) StartServer() error {
 	pactus.RegisterNetworkServer(grpcServer, networkServer)
 	pactus.RegisterWalletServer(grpcServer, walletServer)
 
-	listener, err := net.Listen("tcp", s.config.Listen)
+	healthChecker := newHealthChecker(s.sync)
+	healthChecker.Start(s.ctx)
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthChecker.server)
+	s.health = healthChecker
+
+	listener, err := listen(s.config.Listen)
 	if err != nil {
 		return err
 	}
 
 	s.listener = listener
-	s.address = listener.Addr().String()
+	if path, ok := unixSocketPath(s.config.Listen); ok {
+		s.address = "unix:" + path
+	} else {
+		s.address = listener.Addr().String()
+	}
 	s.grpc = grpcServer
-	go func() {
-		if err := s.grpc.Serve(listener); err != nil {
-			s.logger.Error("error on grpc serve", "error", err)
+
+	if s.config.Gateway.Enable {
+		// When multiplexed, the gateway serves the gRPC requests on the same listener.
+		gwListener := listener
+		if !s.isMultiplexed() {
+			gwListener, err = listen(s.config.Gateway.Listen)
+			if err != nil {
+				_ = listener.Close()
+
+				return err
+			}
+			s.gwListener = gwListener
 		}
-	}()
 
-	go func() {
-		if err := s.startGateway(); err != nil {
-			s.logger.Error("error on grpc-gateway serve", "error", err)
+		gwServer, err := s.newGateway()
+		if err != nil {
+			_ = listener.Close()
+			if s.gwListener != nil {
+				_ = s.gwListener.Close()
+			}
+
+			return err
 		}
-	}()
+		s.gwServer = gwServer
+
+		go s.serveGateway(gwListener)
+	}
+
+	if !s.isMultiplexed() {
+		go func() {
+			if err := s.grpc.Serve(listener); err != nil {
+				s.logger.Error("error on grpc serve", "error", err)
+			}
+		}()
+	}
 
 	return nil
 }
 
 func (s *Server) StopServer() {
-	s.ctx.Done()
+	s.cancel()
+
+	if s.health != nil {
+		s.health.Shutdown()
+	}
+
+	if s.gwServer != nil {
+		s.gwServer.Close()
+	}
+
+	if s.gwListener != nil {
+		s.gwListener.Close()
+	}
 
 	if s.grpc != nil {
 		s.grpc.Stop()
 	}
 
+	// Closing the listener removes the Unix domain socket file.
 	if s.listener != nil {
 		s.listener.Close()
 	}
 }
+
+// listen creates a listener for the given address.
+// Addresses with the "unix://" prefix are Unix domain sockets, other addresses are TCP.
+func listen(address string) (net.Listener, error) {
+	path, ok := unixSocketPath(address)
+	if !ok {
+		return net.Listen("tcp", address)
+	}
+
+	// Removing the socket file, left by an unclean shutdown.
+	// Other files are never removed, to not delete a file by a misconfigured path.
+	info, err := os.Lstat(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, err
+	case info.Mode()&fs.ModeSocket == 0:
+		return nil, fmt.Errorf("unable to listen on %s: file exists and it is not a socket", path)
+	default:
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return net.Listen("unix", path)
+}
+
+// unixSocketPath returns the path of the Unix domain socket, if the address has the "unix://" prefix.
+func unixSocketPath(address string) (string, bool) {
+	if !strings.HasPrefix(address, unixSocketPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(address, unixSocketPrefix), true
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -15,6 +18,8 @@ import (
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
@@ -52,11 +57,17 @@ func init() {
 	tMockState.CommitTestBlocks(10)
 	subLogger := logger.NewSubLogger("_grpc", nil)
 
+	notifier := newBlockNotifier(tMockState, subLogger)
+	notifier.Start(tCtx)
+
 	s := grpc.NewServer()
 	blockchainServer := &blockchainServer{
-		state:   tMockState,
-		consMgr: consMgr,
-		logger:  subLogger,
+		state:    tMockState,
+		sync:     tMockSync,
+		consMgr:  consMgr,
+		notifier: notifier,
+		txStats:  newTxStatsCache(tMockState),
+		logger:   subLogger,
 	}
 	networkServer := &networkServer{
 		sync:   tMockSync,
@@ -129,3 +140,152 @@ func testWalletClient(t *testing.T) (*grpc.ClientConn, pactus.WalletClient) {
 	}
 	return conn, pactus.NewWalletClient(conn)
 }
+
+func TestUnixSocket(t *testing.T) {
+	dir := util.TempDirPath()
+	grpcSocket := filepath.Join(dir, "grpc.sock")
+	gwSocket := filepath.Join(dir, "gateway.sock")
+
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Listen = "unix://" + grpcSocket
+	conf.Gateway.Enable = true
+	conf.Gateway.Listen = "unix://" + gwSocket
+
+	server := NewServer(conf, tMockState, tMockSync, nil)
+	require.NoError(t, server.StartServer())
+	assert.Equal(t, "unix:"+grpcSocket, server.Address())
+	assert.FileExists(t, grpcSocket)
+	assert.FileExists(t, gwSocket)
+
+	t.Run("Should serve gRPC requests", func(t *testing.T) {
+		conn, err := grpc.DialContext(tCtx, server.Address(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+
+		res, err := pactus.NewBlockchainClient(conn).GetBlockchainInfo(tCtx,
+			&pactus.GetBlockchainInfoRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, tMockState.LastBlockHeight(), res.LastBlockHeight)
+		assert.NoError(t, conn.Close())
+	})
+
+	t.Run("Should serve gateway requests", func(t *testing.T) {
+		client := &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", gwSocket)
+				},
+			},
+		}
+
+		assert.Eventually(t, func() bool {
+			res, err := client.Get("http://unix/v1/blockchain/genesis")
+			if err != nil {
+				return false
+			}
+			defer res.Body.Close()
+
+			return res.StatusCode == http.StatusOK
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	server.StopServer()
+	assert.NoFileExists(t, grpcSocket)
+	assert.NoFileExists(t, gwSocket)
+}
+
+func TestMultiplexGateway(t *testing.T) {
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Listen = "127.0.0.1:0"
+	conf.Gateway.Enable = true
+	conf.Gateway.Multiplex = true
+
+	conf.MaxConcurrentStreams = 16
+
+	server := NewServer(conf, tMockState, tMockSync, nil)
+	require.NoError(t, server.StartServer())
+	assert.Nil(t, server.gwListener)
+	require.NotNil(t, server.gwServer)
+
+	t.Run("Should serve gRPC requests", func(t *testing.T) {
+		conn, err := grpc.DialContext(tCtx, server.Address(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			res, err := pactus.NewBlockchainClient(conn).GetBlockchainInfo(tCtx,
+				&pactus.GetBlockchainInfoRequest{})
+
+			return err == nil && res.LastBlockHeight == tMockState.LastBlockHeight()
+		}, 5*time.Second, 100*time.Millisecond)
+		assert.NoError(t, conn.Close())
+	})
+
+	t.Run("Should serve gateway requests on the same port", func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			res, err := http.Get("http://" + server.Address() + "/v1/blockchain/genesis")
+			if err != nil {
+				return false
+			}
+			defer res.Body.Close()
+
+			return res.StatusCode == http.StatusOK
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	server.StopServer()
+
+	t.Run("Should stop serving after stopping the server", func(t *testing.T) {
+		_, err := http.Get("http://" + server.Address() + "/v1/blockchain/genesis")
+		assert.Error(t, err)
+	})
+}
+
+func TestRemoveStaleUnixSocket(t *testing.T) {
+	socket := filepath.Join(util.TempDirPath(), "stale.sock")
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: socket, Net: "unix"})
+	require.NoError(t, err)
+	// Leaving the socket file behind, like an unclean shutdown.
+	stale.SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	require.FileExists(t, socket)
+
+	listener, err := listen("unix://" + socket)
+	require.NoError(t, err)
+	assert.Equal(t, "unix", listener.Addr().Network())
+	assert.NoError(t, listener.Close())
+}
+
+func TestKeepRegularFileOnUnixSocketPath(t *testing.T) {
+	path := filepath.Join(util.TempDirPath(), "data.txt")
+	require.NoError(t, util.WriteFile(path, []byte("data")))
+
+	_, err := listen("unix://" + path)
+	assert.Error(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+}
+
+func TestCloseListenerOnGatewayFailure(t *testing.T) {
+	socket := filepath.Join(util.TempDirPath(), "pactus.sock")
+	path := filepath.Join(util.TempDirPath(), "data.txt")
+	require.NoError(t, util.WriteFile(path, []byte("data")))
+
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Listen = "unix://" + socket
+	conf.Gateway.Enable = true
+	conf.Gateway.Listen = "unix://" + path
+
+	server := NewServer(conf, tMockState, tMockSync, nil)
+	assert.Error(t, server.StartServer())
+
+	// Closing the listener removes the Unix domain socket file.
+	assert.NoFileExists(t, socket)
+
+	server.StopServer()
+}
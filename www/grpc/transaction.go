@@ -1,14 +1,20 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"strconv"
 
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/logger"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -52,6 +58,97 @@ func (s *transactionServer) GetTransaction(_ context.Context,
 	return res, nil
 }
 
+// GetTransactionInclusion returns the block that the transaction is included in,
+// and the index of the transaction inside the block.
+func (s *transactionServer) GetTransactionInclusion(_ context.Context,
+	req *pactus.GetTransactionInclusionRequest,
+) (*pactus.GetTransactionInclusionResponse, error) {
+	blk, height, index, err := s.findTransaction(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.GetTransactionInclusionResponse{
+		BlockHeight: height,
+		BlockHash:   blk.Hash().Bytes(),
+		BlockTime:   blk.Header().UnixTime(),
+		Index:       int32(index),
+	}, nil
+}
+
+// GetTransactionProof returns the Merkle proof of the transaction inclusion,
+// so it can be verified against a block hash that is obtained independently.
+func (s *transactionServer) GetTransactionProof(_ context.Context,
+	req *pactus.GetTransactionProofRequest,
+) (*pactus.GetTransactionProofResponse, error) {
+	blk, height, index, err := s.findTransaction(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := blk.Transactions()
+	proof, ok := txs.Proof(index)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unable to make the proof for index %v", index)
+	}
+	proofBytes := make([][]byte, len(proof))
+	for i, h := range proof {
+		proofBytes[i] = h.Bytes()
+	}
+
+	w := &bytes.Buffer{}
+	if err := blk.Header().Encode(w); err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	var prevCertHash []byte
+	if blk.PrevCertificate() != nil {
+		prevCertHash = blk.PrevCertificate().Hash().Bytes()
+	}
+
+	return &pactus.GetTransactionProofResponse{
+		BlockHeight:  height,
+		BlockHash:    blk.Hash().Bytes(),
+		BlockHeader:  w.Bytes(),
+		PrevCertHash: prevCertHash,
+		TxsRoot:      txs.Root().Bytes(),
+		TxCount:      int32(txs.Len()),
+		Index:        int32(index),
+		Proof:        proofBytes,
+	}, nil
+}
+
+// findTransaction finds the committed block that includes the transaction,
+// and the index of the transaction inside the block.
+func (s *transactionServer) findTransaction(idBytes []byte) (*block.Block, uint32, int, error) {
+	id, err := hash.FromBytes(idBytes)
+	if err != nil {
+		return nil, 0, 0, status.Errorf(codes.InvalidArgument, "invalid transaction ID: %v", err.Error())
+	}
+
+	committedTx := s.state.CommittedTx(id)
+	if committedTx == nil {
+		return nil, 0, 0, status.Errorf(codes.NotFound, "transaction not found")
+	}
+
+	committedBlock := s.state.CommittedBlock(committedTx.Height)
+	if committedBlock == nil {
+		return nil, 0, 0, status.Errorf(codes.Internal, "block not found at height %v", committedTx.Height)
+	}
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return nil, 0, 0, status.Errorf(codes.Internal, err.Error())
+	}
+
+	for i, trx := range blk.Transactions() {
+		if trx.ID() == id {
+			return blk, committedTx.Height, i, nil
+		}
+	}
+
+	return nil, 0, 0, status.Errorf(codes.Internal, "transaction not found in block %v", committedTx.Height)
+}
+
 func (s *transactionServer) SendRawTransaction(_ context.Context,
 	req *pactus.SendRawTransactionRequest,
 ) (*pactus.SendRawTransactionResponse, error) {
@@ -65,7 +162,7 @@ func (s *transactionServer) SendRawTransaction(_ context.Context,
 	}
 
 	if err := s.state.AddPendingTxAndBroadcast(trx); err != nil {
-		return nil, status.Errorf(codes.Canceled, "couldn't add to transaction pool: %v", err.Error())
+		return nil, s.rejectedTxError(trx, err)
 	}
 
 	return &pactus.SendRawTransactionResponse{
@@ -73,6 +170,48 @@ func (s *transactionServer) SendRawTransaction(_ context.Context,
 	}, nil
 }
 
+// rejectedTxError returns an error for a transaction rejected by the transaction pool.
+// The error details contain the current height, the accepted lock time
+// and the state of the signer's account, so the client can fix and resubmit the transaction.
+func (s *transactionServer) rejectedTxError(trx *tx.Tx, err error) error {
+	reason := "TX_REJECTED"
+	metadata := map[string]string{
+		"lock_time":         strconv.FormatUint(uint64(trx.LockTime()), 10),
+		"last_block_height": strconv.FormatUint(uint64(s.state.LastBlockHeight()), 10),
+	}
+
+	var pastErr execution.PastLockTimeError
+	var futureErr execution.FutureLockTimeError
+	switch {
+	case errors.As(err, &pastErr):
+		reason = "PAST_LOCK_TIME"
+		metadata["min_lock_time"] = strconv.FormatUint(uint64(pastErr.MinLockTime), 10)
+
+	case errors.As(err, &futureErr):
+		reason = "FUTURE_LOCK_TIME"
+		metadata["max_lock_time"] = strconv.FormatUint(uint64(futureErr.MaxLockTime), 10)
+	}
+
+	signer := trx.Payload().Signer()
+	metadata["signer"] = signer.String()
+	if acc := s.state.AccountByAddress(signer); acc != nil {
+		metadata["signer_number"] = strconv.FormatInt(int64(acc.Number()), 10)
+		metadata["signer_balance"] = strconv.FormatInt(acc.Balance(), 10)
+	}
+
+	st := status.Newf(codes.Canceled, "couldn't add to transaction pool: %v", err.Error())
+	stWithDetails, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   "pactus",
+		Metadata: metadata,
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return stWithDetails.Err()
+}
+
 func (s *transactionServer) CalculateFee(_ context.Context,
 	req *pactus.CalculateFeeRequest,
 ) (*pactus.CalculateFeeResponse, error) {
@@ -86,6 +225,44 @@ func (s *transactionServer) CalculateFee(_ context.Context,
 	}, nil
 }
 
+// EstimateFee returns the fee of the transaction, along with the state of the transaction pool.
+// The fee is fixed by the consensus rules, so it doesn't depend on the congestion.
+func (s *transactionServer) EstimateFee(_ context.Context,
+	req *pactus.EstimateFeeRequest,
+) (*pactus.EstimateFeeResponse, error) {
+	fee, err := s.state.CalculateFee(req.Amount, payload.Type(req.PayloadType))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	stats := s.state.TxPoolStats()
+
+	return &pactus.EstimateFeeResponse{
+		Fee:             fee,
+		MinFee:          s.state.TxPoolMinFee(),
+		PendingTxs:      int32(stats.Total),
+		PoolUtilization: stats.Utilization(),
+	}, nil
+}
+
+func (s *transactionServer) GetTxPoolStats(_ context.Context,
+	_ *pactus.GetTxPoolStatsRequest,
+) (*pactus.GetTxPoolStatsResponse, error) {
+	stats := s.state.TxPoolStats()
+	perType := make(map[int32]int32, len(stats.PerType))
+	for typ, size := range stats.PerType {
+		perType[int32(typ)] = int32(size)
+	}
+
+	return &pactus.GetTxPoolStatsResponse{
+		Total:         int32(stats.Total),
+		PerType:       perType,
+		Capacity:      int32(stats.Capacity),
+		Utilization:   stats.Utilization(),
+		OldestTxAgeMs: stats.OldestTxAge.Milliseconds(),
+	}, nil
+}
+
 func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 	data, _ := trx.Bytes()
 	transaction := &pactus.TransactionInfo{
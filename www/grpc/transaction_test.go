@@ -1,12 +1,23 @@
 package grpc
 
 import (
+	"bytes"
+	"strconv"
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution"
+	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/simplemerkle"
 	"github.com/pactus-project/pactus/util/testsuite"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetTransaction(t *testing.T) {
@@ -72,6 +83,96 @@ func TestGetTransaction(t *testing.T) {
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
 
+func TestGetTransactionInclusion(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conn, client := testTransactionClient(t)
+
+	testBlock := tMockState.TestStore.AddTestBlock(1)
+
+	t.Run("Should return the block and the index of the transaction", func(t *testing.T) {
+		for i, trx := range testBlock.Transactions() {
+			res, err := client.GetTransactionInclusion(tCtx,
+				&pactus.GetTransactionInclusionRequest{Id: trx.ID().Bytes()})
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint32(1), res.BlockHeight)
+			assert.Equal(t, testBlock.Hash().Bytes(), res.BlockHash)
+			assert.Equal(t, testBlock.Header().UnixTime(), res.BlockTime)
+			assert.Equal(t, int32(i), res.Index)
+		}
+	})
+
+	t.Run("Should return error because transaction id is invalid", func(t *testing.T) {
+		res, err := client.GetTransactionInclusion(tCtx,
+			&pactus.GetTransactionInclusionRequest{Id: []byte("invalid_id")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return not found because transaction is not committed", func(t *testing.T) {
+		res, err := client.GetTransactionInclusion(tCtx,
+			&pactus.GetTransactionInclusionRequest{Id: ts.RandHash().Bytes()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetTransactionProof(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conn, client := testTransactionClient(t)
+
+	testBlock := tMockState.TestStore.AddTestBlock(1)
+
+	t.Run("Should return a verifiable proof", func(t *testing.T) {
+		for i, trx := range testBlock.Transactions() {
+			res, err := client.GetTransactionProof(tCtx,
+				&pactus.GetTransactionProofRequest{Id: trx.ID().Bytes()})
+			require.NoError(t, err)
+
+			assert.Equal(t, uint32(1), res.BlockHeight)
+			assert.Equal(t, testBlock.Hash().Bytes(), res.BlockHash)
+			assert.Equal(t, int32(i), res.Index)
+			assert.Equal(t, int32(testBlock.Transactions().Len()), res.TxCount)
+
+			// Linking the transaction ID to the transactions root.
+			txsRoot, _ := hash.FromBytes(res.TxsRoot)
+			proof := make([]hash.Hash, len(res.Proof))
+			for j, bs := range res.Proof {
+				proof[j], _ = hash.FromBytes(bs)
+			}
+			assert.True(t, simplemerkle.VerifyProof(trx.ID(), int(res.Index), proof, txsRoot))
+
+			// Linking the transactions root to the block hash.
+			w := &bytes.Buffer{}
+			w.Write(res.BlockHeader)
+			w.Write(res.PrevCertHash)
+			w.Write(res.TxsRoot)
+			w.Write(util.Int32ToSlice(res.TxCount))
+			assert.Equal(t, testBlock.Hash(), hash.CalcHash(w.Bytes()))
+		}
+	})
+
+	t.Run("Should return error because transaction id is invalid", func(t *testing.T) {
+		res, err := client.GetTransactionProof(tCtx,
+			&pactus.GetTransactionProofRequest{Id: []byte("invalid_id")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return not found because transaction is not committed", func(t *testing.T) {
+		res, err := client.GetTransactionProof(tCtx,
+			&pactus.GetTransactionProofRequest{Id: ts.RandHash().Bytes()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
 func TestSendRawTransaction(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -103,5 +204,84 @@ func TestSendRawTransaction(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
+	t.Run("Should return diagnostics for a stale lock time", func(t *testing.T) {
+		trx, _ := ts.GenerateTestTransferTx()
+		acc := account.NewAccount(ts.RandInt32(1000))
+		acc.AddToBalance(ts.RandInt64(1e9))
+		tMockState.TestStore.UpdateAccount(trx.Payload().Signer(), acc)
+		tMockState.TestPool.AppendErr = execution.PastLockTimeError{
+			LockTime:    trx.LockTime(),
+			MinLockTime: trx.LockTime() + 1,
+		}
+		defer func() { tMockState.TestPool.AppendErr = nil }()
+
+		data, _ := trx.Bytes()
+		res, err := client.SendRawTransaction(tCtx, &pactus.SendRawTransactionRequest{Data: data})
+		assert.Nil(t, res)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.Canceled, st.Code())
+		assert.Len(t, st.Details(), 1)
+
+		info := st.Details()[0].(*errdetails.ErrorInfo)
+		assert.Equal(t, "PAST_LOCK_TIME", info.Reason)
+		assert.Equal(t, strconv.FormatUint(uint64(trx.LockTime()+1), 10), info.Metadata["min_lock_time"])
+		assert.Equal(t, strconv.FormatInt(acc.Balance(), 10), info.Metadata["signer_balance"])
+		assert.Equal(t, strconv.FormatInt(int64(acc.Number()), 10), info.Metadata["signer_number"])
+	})
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestEstimateFee(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conn, client := testTransactionClient(t)
+
+	t.Run("Should return the fee and the pool state", func(t *testing.T) {
+		res, err := client.EstimateFee(tCtx, &pactus.EstimateFeeRequest{
+			Amount:      ts.RandInt64(1e9),
+			PayloadType: pactus.PayloadType_TRANSFER_PAYLOAD,
+		})
+		assert.NoError(t, err)
+
+		stats := tMockState.TxPoolStats()
+		assert.Equal(t, tMockState.TxPoolMinFee(), res.MinFee)
+		assert.Equal(t, int32(stats.Total), res.PendingTxs)
+		assert.Equal(t, stats.Utilization(), res.PoolUtilization)
+	})
+
+	t.Run("Should return zero fee for the free transactions", func(t *testing.T) {
+		res, err := client.EstimateFee(tCtx, &pactus.EstimateFeeRequest{
+			PayloadType: pactus.PayloadType_UNBOND_PAYLOAD,
+		})
+		assert.NoError(t, err)
+		assert.Zero(t, res.Fee)
+	})
+
+	t.Run("Should return an error for an invalid payload type", func(t *testing.T) {
+		res, err := client.EstimateFee(tCtx, &pactus.EstimateFeeRequest{
+			PayloadType: pactus.PayloadType(99),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestGetTxPoolStats(t *testing.T) {
+	conn, client := testTransactionClient(t)
+
+	res, err := client.GetTxPoolStats(tCtx, &pactus.GetTxPoolStatsRequest{})
+	assert.NoError(t, err)
+
+	stats := tMockState.TxPoolStats()
+	assert.Equal(t, int32(stats.Total), res.Total)
+	assert.Equal(t, int32(stats.Capacity), res.Capacity)
+	assert.Equal(t, stats.Utilization(), res.Utilization)
+	for typ, size := range stats.PerType {
+		assert.Equal(t, int32(size), res.PerType[int32(typ)])
+	}
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 }
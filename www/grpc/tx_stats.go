@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"sync"
+
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util"
+)
+
+// txStatsBlocks is the number of the recent blocks that the transaction statistics cover.
+const txStatsBlocks = uint32(8640) // 8640 blocks is about one day
+
+// txStatsCache keeps a rolling count of the transactions per payload type over the recent blocks.
+// The counts of each block are cached, so only the newly committed blocks are scanned,
+// and the blocks that fall out of the window are subtracted.
+type txStatsCache struct {
+	lk sync.Mutex
+
+	state      state.Facade
+	lastHeight uint32
+	perBlock   map[uint32]map[payload.Type]int32
+	totals     map[payload.Type]int32
+}
+
+func newTxStatsCache(st state.Facade) *txStatsCache {
+	return &txStatsCache{
+		state:    st,
+		perBlock: make(map[uint32]map[payload.Type]int32),
+		totals:   make(map[payload.Type]int32),
+	}
+}
+
+// stats returns the number of the transactions per payload type in the last `txStatsBlocks` blocks.
+// The blocks that are not available, for example in a pruned node, are not counted.
+func (c *txStatsCache) stats() map[int32]int32 {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	lastHeight := c.state.LastBlockHeight()
+	fromHeight := uint32(1)
+	if lastHeight > txStatsBlocks {
+		fromHeight = lastHeight - txStatsBlocks + 1
+	}
+
+	for height, counts := range c.perBlock {
+		if height < fromHeight {
+			for typ, count := range counts {
+				c.totals[typ] -= count
+			}
+			delete(c.perBlock, height)
+		}
+	}
+
+	for height := util.Max(fromHeight, c.lastHeight+1); height <= lastHeight; height++ {
+		c.addBlock(height)
+	}
+	c.lastHeight = lastHeight
+
+	perType := make(map[int32]int32, len(c.totals))
+	for typ, count := range c.totals {
+		if count > 0 {
+			perType[int32(typ)] = count
+		}
+	}
+
+	return perType
+}
+
+func (c *txStatsCache) addBlock(height uint32) {
+	committedBlock := c.state.CommittedBlock(height)
+	if committedBlock == nil {
+		return
+	}
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return
+	}
+
+	counts := make(map[payload.Type]int32)
+	for _, trx := range blk.Transactions() {
+		counts[trx.Payload().Type()]++
+	}
+	for typ, count := range counts {
+		c.totals[typ] += count
+	}
+	c.perBlock[height] = counts
+}
@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/logger"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatorHistoryIndex indexes the Bond, Unbond and Withdraw transactions of the validators.
+// It is built in the background on start, then it is updated with the newly committed blocks.
+// The stakes are tracked as well, so the unbonded stake can be reported.
+// The genesis validators have no stake, so all the stakes start from zero.
+type validatorHistoryIndex struct {
+	lk sync.Mutex
+
+	state         state.Facade
+	indexedHeight uint32
+	caughtUp      bool
+	stakes        map[crypto.Address]int64
+	events        map[crypto.Address][]*pactus.ValidatorHistoryEvent
+	logger        *logger.SubLogger
+}
+
+func newValidatorHistoryIndex(st state.Facade, log *logger.SubLogger) *validatorHistoryIndex {
+	return &validatorHistoryIndex{
+		state:  st,
+		stakes: make(map[crypto.Address]int64),
+		events: make(map[crypto.Address][]*pactus.ValidatorHistoryEvent),
+		logger: log,
+	}
+}
+
+// Start indexes the committed blocks in the background,
+// then it keeps the index updated with the new blocks until the context is canceled.
+func (idx *validatorHistoryIndex) Start(ctx context.Context, notifier *blockNotifier) {
+	ch, unsubscribe := notifier.subscribe()
+
+	go func() {
+		defer unsubscribe()
+
+		idx.indexBlocks(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				idx.indexBlocks(ctx)
+			}
+		}
+	}()
+}
+
+// history returns the events of the validator, from the oldest to the latest.
+// It returns an Unavailable error until the index catches up with the blockchain.
+func (idx *validatorHistoryIndex) history(addr crypto.Address) ([]*pactus.ValidatorHistoryEvent, error) {
+	idx.lk.Lock()
+	defer idx.lk.Unlock()
+
+	if !idx.caughtUp {
+		return nil, status.Errorf(codes.Unavailable,
+			"validator history is being indexed, indexed height: %d", idx.indexedHeight)
+	}
+
+	return slices.Clone(idx.events[addr]), nil
+}
+
+// indexBlocks indexes the blocks committed after the indexed height.
+// On failure, the indexing is retried on the next committed block.
+func (idx *validatorHistoryIndex) indexBlocks(ctx context.Context) {
+	idx.lk.Lock()
+	height := idx.indexedHeight
+	idx.lk.Unlock()
+
+	lastHeight := idx.state.LastBlockHeight()
+	for height < lastHeight {
+		if ctx.Err() != nil {
+			return
+		}
+
+		height++
+		if err := idx.indexBlock(height); err != nil {
+			idx.logger.Warn("unable to index the validator history", "height", height, "error", err)
+
+			return
+		}
+	}
+
+	idx.lk.Lock()
+	defer idx.lk.Unlock()
+
+	if !idx.caughtUp {
+		idx.logger.Info("validator history is indexed", "height", height)
+		idx.caughtUp = true
+	}
+}
+
+// indexBlock indexes the block at the given height.
+// The block is read outside the lock, so the queries are not blocked by reading the blocks.
+func (idx *validatorHistoryIndex) indexBlock(height uint32) error {
+	committedBlock := idx.state.CommittedBlock(height)
+	if committedBlock == nil {
+		return fmt.Errorf("block %d not found", height)
+	}
+	blk, err := committedBlock.ToBlock()
+	if err != nil {
+		return err
+	}
+
+	idx.lk.Lock()
+	defer idx.lk.Unlock()
+
+	for _, trx := range blk.Transactions() {
+		switch pld := trx.Payload().(type) {
+		case *payload.BondPayload:
+			idx.stakes[pld.To] += pld.Stake
+			idx.addEvent(pld.To, height, trx, pld.Stake)
+
+		case *payload.UnbondPayload:
+			idx.addEvent(pld.Validator, height, trx, idx.stakes[pld.Validator])
+
+		case *payload.WithdrawPayload:
+			// The fee is paid from the stake of the validator.
+			idx.stakes[pld.From] -= pld.Amount + trx.Fee()
+			idx.addEvent(pld.From, height, trx, pld.Amount)
+		}
+	}
+	idx.indexedHeight = height
+
+	return nil
+}
+
+func (idx *validatorHistoryIndex) addEvent(addr crypto.Address, height uint32, trx *tx.Tx, amount int64) {
+	idx.events[addr] = append(idx.events[addr], &pactus.ValidatorHistoryEvent{
+		Height:      height,
+		Transaction: transactionToProto(trx),
+		Amount:      amount,
+		Stake:       idx.stakes[addr],
+	})
+}
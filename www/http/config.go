@@ -1,14 +1,18 @@
 package http
 
 type Config struct {
-	Enable bool   `toml:"enable"`
-	Listen string `toml:"listen"`
+	Enable            bool     `toml:"enable"`
+	Listen            string   `toml:"listen"`
+	EnableCompression bool     `toml:"enable_compression"`
+	WebSocketOrigins  []string `toml:"websocket_origins"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Enable: false,
-		Listen: "80",
+		Enable:            false,
+		Listen:            "80",
+		EnableCompression: true,
+		WebSocketOrigins:  []string{},
 	}
 }
 
@@ -1,10 +1,13 @@
 package http
 
 import (
+	"compress/gzip"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -13,6 +16,7 @@ import (
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/pactus-project/pactus/www/grpc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var tTestData *testData
@@ -79,3 +83,78 @@ func TestRootHandler(t *testing.T) {
 	assert.Equal(t, w.Code, 200)
 	fmt.Println(w.Body)
 }
+
+func TestCompression(t *testing.T) {
+	td := setup(t)
+
+	newServer := func(enableCompression bool) *Server {
+		conf := DefaultConfig()
+		conf.EnableCompression = enableCompression
+		server := NewServer(conf)
+		server.router = td.httpServer.router
+
+		return server
+	}
+
+	t.Run("Should compress the response", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Encoding", "gzip")
+		newServer(true).handler().ServeHTTP(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+
+		reader, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "/blockchain/")
+	})
+
+	t.Run("Should not compress the response if client doesn't support it", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		newServer(true).handler().ServeHTTP(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Contains(t, w.Body.String(), "/blockchain/")
+	})
+
+	t.Run("Should not compress the response if compression is disabled", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Encoding", "gzip")
+		newServer(false).handler().ServeHTTP(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+	})
+}
+
+func TestReadyHandler(t *testing.T) {
+	td := setup(t)
+
+	status := func() int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/ready", nil)
+		td.httpServer.ReadyHandler(w, r)
+
+		return w.Code
+	}
+
+	t.Run("Not ready when the node is not healthy", func(t *testing.T) {
+		td.mockSync.TestHealthy.Store(false)
+		assert.Eventually(t, func() bool {
+			return status() == http.StatusServiceUnavailable
+		}, 3*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("Ready when the node is healthy", func(t *testing.T) {
+		td.mockSync.TestHealthy.Store(true)
+		assert.Eventually(t, func() bool {
+			return status() == http.StatusOK
+		}, 3*time.Second, 100*time.Millisecond)
+	})
+}
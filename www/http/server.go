@@ -18,6 +18,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type Server struct {
@@ -28,6 +29,7 @@ type Server struct {
 	blockchain  pactus.BlockchainClient
 	transaction pactus.TransactionClient
 	network     pactus.NetworkClient
+	health      grpc_health_v1.HealthClient
 	listener    net.Listener
 	logger      *logger.SubLogger
 }
@@ -59,6 +61,7 @@ func (s *Server) StartServer(grpcServer string) error {
 	s.blockchain = pactus.NewBlockchainClient(conn)
 	s.transaction = pactus.NewTransactionClient(conn)
 	s.network = pactus.NewNetworkClient(conn)
+	s.health = grpc_health_v1.NewHealthClient(conn)
 
 	s.router = mux.NewRouter()
 	s.router.HandleFunc("/", s.RootHandler)
@@ -66,14 +69,17 @@ func (s *Server) StartServer(grpcServer string) error {
 	s.router.HandleFunc("/consensus", s.ConsensusHandler)
 	s.router.HandleFunc("/network", s.NetworkHandler)
 	s.router.HandleFunc("/node", s.NodeHandler)
+	s.router.HandleFunc("/ready", s.ReadyHandler)
 	s.router.HandleFunc("/block/hash/{hash}", s.GetBlockByHashHandler)
 	s.router.HandleFunc("/block/height/{height}", s.GetBlockByHeightHandler)
 	s.router.HandleFunc("/transaction/id/{id}", s.GetTransactionHandler)
 	s.router.HandleFunc("/account/address/{address}", s.GetAccountHandler)
 	s.router.HandleFunc("/validator/address/{address}", s.GetValidatorHandler)
 	s.router.HandleFunc("/validator/number/{number}", s.GetValidatorByNumberHandler)
+	s.router.HandleFunc("/ws/blocks", s.WebSocketBlocksHandler)
+	s.router.HandleFunc("/ws/consensus", s.WebSocketConsensusHandler)
 	http.Handle("/metrics/prometheus", promhttp.Handler())
-	http.Handle("/", handlers.RecoveryHandler()(s.router))
+	http.Handle("/", s.handler())
 
 	l, err := net.Listen("tcp", s.config.Listen)
 	if err != nil {
@@ -100,6 +106,18 @@ func (s *Server) StartServer(grpcServer string) error {
 	return nil
 }
 
+// handler returns the handler for the routes.
+// If compression is enabled, the responses are compressed using gzip or deflate,
+// based on the "Accept-Encoding" header of the request.
+func (s *Server) handler() http.Handler {
+	handler := handlers.RecoveryHandler()(s.router)
+	if s.config.EnableCompression {
+		handler = handlers.CompressHandler(handler)
+	}
+
+	return handler
+}
+
 func (s *Server) StopServer() {
 	s.ctx.Done()
 
@@ -215,3 +233,17 @@ func (t *tableMaker) html() string {
 	t.w.WriteString("</table>")
 	return t.w.String()
 }
+
+// ReadyHandler responds with 200 when the node is healthy and ready to serve,
+// otherwise with 503. It is backed by the gRPC health service.
+func (s *Server) ReadyHandler(w http.ResponseWriter, _ *http.Request) {
+	res, err := s.health.Check(s.ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil || res.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("not ready"))
+
+		return
+	}
+
+	_, _ = w.Write([]byte("ready"))
+}
@@ -0,0 +1,263 @@
+package http
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// wsPollInterval is the interval for checking the consensus state for new updates.
+// The consensus state changes on receiving votes, which are not notified by the node.
+var wsPollInterval = time.Second
+
+// The kinds of the block events.
+// A rolled back event means a previously announced block is superseded by another block.
+// In this case, the subscribers should discard the announced block at this height.
+const (
+	wsBlockKindCommitted  = "committed"
+	wsBlockKindRolledBack = "rolled_back"
+)
+
+type wsBlockInfo struct {
+	Kind   string `json:"kind"`
+	Height uint32 `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+func (s *Server) newUpgrader() *websocket.Upgrader {
+	return &websocket.Upgrader{
+		CheckOrigin: s.checkOrigin,
+	}
+}
+
+// checkOrigin accepts the requests without origin (non-browser clients),
+// the same-origin requests and the requests from the allowed origins.
+func (s *Server) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err == nil && u.Host == r.Host {
+		return true
+	}
+
+	for _, allowed := range s.config.WebSocketOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebSocketBlocksHandler pushes the last block info to the client
+// whenever a new block is committed.
+// If the last announced block is superseded, it pushes a rolled back event before.
+func (s *Server) WebSocketBlocksHandler(w http.ResponseWriter, r *http.Request) {
+	var lastInfo *wsBlockInfo
+	s.serveWebSocket(w, r, s.subscribeBlockHeight, func(conn *websocket.Conn) error {
+		res, err := s.blockchain.GetBlockchainInfo(s.ctx,
+			&pactus.GetBlockchainInfoRequest{})
+		if err != nil {
+			return err
+		}
+		// The hash is queried by the height, since a block may be committed
+		// while the blockchain info is being read.
+		hashRes, err := s.blockchain.GetBlockHash(s.ctx,
+			&pactus.GetBlockHashRequest{Height: res.LastBlockHeight})
+		if err != nil {
+			return err
+		}
+		info := &wsBlockInfo{
+			Kind:   wsBlockKindCommitted,
+			Height: res.LastBlockHeight,
+			Hash:   hex.EncodeToString(hashRes.Hash),
+		}
+		if lastInfo != nil {
+			if *lastInfo == *info {
+				return nil
+			}
+
+			rolledBack, err := s.isRolledBack(lastInfo, res.LastBlockHeight)
+			if err != nil {
+				return err
+			}
+			if rolledBack {
+				err := conn.WriteJSON(wsBlockInfo{
+					Kind:   wsBlockKindRolledBack,
+					Height: lastInfo.Height,
+					Hash:   lastInfo.Hash,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		lastInfo = info
+
+		return conn.WriteJSON(info)
+	})
+}
+
+// isRolledBack checks if the announced block is superseded by another block
+// or is not in the blockchain anymore.
+func (s *Server) isRolledBack(announced *wsBlockInfo, lastHeight uint32) (bool, error) {
+	if lastHeight < announced.Height {
+		return true, nil
+	}
+
+	res, err := s.blockchain.GetBlockHash(s.ctx,
+		&pactus.GetBlockHashRequest{Height: announced.Height})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return hex.EncodeToString(res.Hash) != announced.Hash, nil
+}
+
+// WebSocketConsensusHandler pushes the consensus info to the client
+// whenever the consensus state changes, for example by receiving new votes.
+func (s *Server) WebSocketConsensusHandler(w http.ResponseWriter, r *http.Request) {
+	var lastInfo *pactus.GetConsensusInfoResponse
+	s.serveWebSocket(w, r, pollEvery(wsPollInterval), func(conn *websocket.Conn) error {
+		res, err := s.blockchain.GetConsensusInfo(s.ctx,
+			&pactus.GetConsensusInfoRequest{})
+		if err != nil {
+			return err
+		}
+		if proto.Equal(res, lastInfo) {
+			return nil
+		}
+		lastInfo = res
+
+		data, err := protojson.Marshal(res)
+		if err != nil {
+			return err
+		}
+
+		return conn.WriteMessage(websocket.TextMessage, data)
+	})
+}
+
+// subscribeBlockHeight notifies whenever a new block is committed,
+// using the block height subscription of the gRPC server.
+func (s *Server) subscribeBlockHeight(ctx context.Context) (<-chan struct{}, error) {
+	stream, err := s.blockchain.SubscribeBlockHeight(ctx,
+		&pactus.SubscribeBlockHeightRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyCh := make(chan struct{}, 1)
+	go func() {
+		defer close(notifyCh)
+
+		for {
+			if _, err := stream.Recv(); err != nil {
+				return
+			}
+
+			select {
+			case notifyCh <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return notifyCh, nil
+}
+
+// pollEvery notifies periodically, for the updates that are not notified by the node.
+func pollEvery(interval time.Duration) func(ctx context.Context) (<-chan struct{}, error) {
+	return func(ctx context.Context) (<-chan struct{}, error) {
+		notifyCh := make(chan struct{})
+		go func() {
+			defer close(notifyCh)
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case notifyCh <- struct{}{}:
+				}
+			}
+		}()
+
+		return notifyCh, nil
+	}
+}
+
+// serveWebSocket upgrades the connection and calls the update function once connected
+// and then on every notification of the subscription,
+// until the client closes the connection or an error occurs.
+func (s *Server) serveWebSocket(w http.ResponseWriter, r *http.Request,
+	subscribe func(ctx context.Context) (<-chan struct{}, error),
+	update func(conn *websocket.Conn) error,
+) {
+	conn, err := s.newUpgrader().Upgrade(w, r, nil)
+	if err != nil {
+		s.logger.Debug("unable to upgrade the connection", "error", err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	// Clients are not expected to send messages. Reading is required
+	// to detect when the client closes the connection.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	notifyCh, err := subscribe(ctx)
+	if err != nil {
+		s.logger.Debug("unable to subscribe for the updates", "error", err)
+		return
+	}
+
+	for {
+		if err := update(conn); err != nil {
+			s.logger.Debug("websocket connection closed", "error", err)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-notifyCh:
+			if !ok {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dialWebSocket(t *testing.T, td *testData, path string, header http.Header) (*websocket.Conn, *http.Response, error) {
+	t.Helper()
+
+	srv := httptest.NewServer(td.httpServer.router)
+	t.Cleanup(srv.Close)
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + path
+	return websocket.DefaultDialer.Dial(url, header)
+}
+
+func TestWebSocketBlocks(t *testing.T) {
+	td := setup(t)
+
+	td.mockState.CommitTestBlocks(10)
+
+	conn, _, err := dialWebSocket(t, td, "/ws/blocks", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	info := wsBlockInfo{}
+	assert.NoError(t, conn.ReadJSON(&info))
+	assert.Equal(t, wsBlockKindCommitted, info.Kind)
+	assert.Equal(t, td.mockState.LastBlockHeight(), info.Height)
+	assert.Equal(t, hex.EncodeToString(td.mockState.LastBlockHash().Bytes()), info.Hash)
+
+	t.Run("Should push the new block on committing", func(t *testing.T) {
+		td.mockState.CommitTestBlocks(1)
+
+		info := wsBlockInfo{}
+		assert.NoError(t, conn.ReadJSON(&info))
+		assert.Equal(t, wsBlockKindCommitted, info.Kind)
+		assert.Equal(t, td.mockState.LastBlockHeight(), info.Height)
+	})
+}
+
+func TestWebSocketRollback(t *testing.T) {
+	td := setup(t)
+
+	td.mockState.CommitTestBlocks(10)
+	lastHeight := td.mockState.LastBlockHeight()
+	announced := &wsBlockInfo{
+		Kind:   wsBlockKindCommitted,
+		Height: lastHeight,
+		Hash:   hex.EncodeToString(td.mockState.LastBlockHash().Bytes()),
+	}
+
+	t.Run("Announced block is in the blockchain", func(t *testing.T) {
+		rolledBack, err := td.httpServer.isRolledBack(announced, lastHeight)
+		assert.NoError(t, err)
+		assert.False(t, rolledBack)
+	})
+
+	t.Run("Blockchain height is less than the announced height", func(t *testing.T) {
+		rolledBack, err := td.httpServer.isRolledBack(announced, lastHeight-1)
+		assert.NoError(t, err)
+		assert.True(t, rolledBack)
+	})
+
+	t.Run("Announced block is superseded", func(t *testing.T) {
+		blk, cert := td.GenerateTestBlock(lastHeight)
+		td.mockState.TestStore.SaveBlock(blk, cert)
+
+		rolledBack, err := td.httpServer.isRolledBack(announced, lastHeight)
+		assert.NoError(t, err)
+		assert.True(t, rolledBack)
+	})
+}
+
+func TestWebSocketConsensus(t *testing.T) {
+	td := setup(t)
+
+	conn, _, err := dialWebSocket(t, td, "/ws/consensus", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, data, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "instances")
+}
+
+func TestWebSocketOrigin(t *testing.T) {
+	td := setup(t)
+
+	t.Run("Should reject not allowed origins", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Origin", "http://example.com")
+		_, res, err := dialWebSocket(t, td, "/ws/blocks", header)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("Should accept allowed origins", func(t *testing.T) {
+		td.httpServer.config.WebSocketOrigins = []string{"http://example.com"}
+		defer func() { td.httpServer.config.WebSocketOrigins = nil }()
+
+		header := http.Header{}
+		header.Set("Origin", "http://example.com")
+		conn, _, err := dialWebSocket(t, td, "/ws/blocks", header)
+		require.NoError(t, err)
+		conn.Close()
+	})
+}
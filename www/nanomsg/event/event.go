@@ -11,9 +11,10 @@ import (
 )
 
 const (
-	TopicBlock         = uint16(0x0101)
-	TopicTransaction   = uint16(0x0201)
-	TopicAccountChange = uint16(0x0301)
+	TopicBlock               = uint16(0x0101) // A new block is committed
+	TopicTransaction         = uint16(0x0201) // A transaction is committed
+	TopicRejectedTransaction = uint16(0x0202) // A transaction is rejected by the transaction pool
+	TopicAccountChange       = uint16(0x0301) // An account is changed
 )
 
 type Event []byte
@@ -44,6 +45,24 @@ func CreateTransactionEvent(txHash tx.ID, height uint32) Event {
 	return w.Bytes()
 }
 
+// CreateRejectedTransactionEvent creates an event when a transaction is rejected
+// by the transaction pool.
+// The rejected transaction event structure is like :
+// <topic_id><tx_hash><reason><sequence_number>.
+func CreateRejectedTransactionEvent(txHash tx.ID, reason string) Event {
+	buf := make([]byte, 0, 42+len(reason))
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicRejectedTransaction, txHash)
+	if err != nil {
+		logger.Error("error on encoding event in rejected transaction", "error", err)
+	}
+	err = encoding.WriteVarString(w, reason)
+	if err != nil {
+		logger.Error("error on encoding event in rejected transaction", "error", err)
+	}
+	return w.Bytes()
+}
+
 // CreateAccountChangeEvent creates an event when the new account is created.
 // The account event structure is like :
 // <topic_id><account_address><height><sequence_number>.
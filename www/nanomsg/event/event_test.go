@@ -39,3 +39,13 @@ func TestCreateAccountChangeEvent(t *testing.T) {
 		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
 	})
 }
+
+func TestCreateRejectedTransactionEvent(t *testing.T) {
+	h, _ := hash.FromString("000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f")
+	e := CreateRejectedTransactionEvent(h, "fee")
+	assert.Equal(t, e, Event{
+		0x2, 0x2, 0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9,
+		0xa, 0xb, 0xc, 0xd, 0xe, 0xf, 0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8,
+		0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf, 0x3, 0x66, 0x65, 0x65,
+	})
+}